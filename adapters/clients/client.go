@@ -19,6 +19,8 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 )
 
 type retryClient struct {
@@ -48,7 +50,7 @@ func (c *retryClient) doWithCustomMarshaller(timeout time.Duration,
 		}
 
 		if code := res.StatusCode; !success(code) {
-			return shouldRetry(code), fmt.Errorf("status code: %v, error: %s", code, respBody)
+			return shouldRetry(code), &unexpectedStatusError{code: code, body: respBody}
 		}
 
 		if err := decode(respBody); err != nil {
@@ -76,7 +78,7 @@ func (c *retryClient) do(timeout time.Duration, req *http.Request, body []byte,
 
 		if code = res.StatusCode; !success(code) {
 			b, _ := io.ReadAll(res.Body)
-			return shouldRetry(code), fmt.Errorf("status code: %v, error: %s", code, b)
+			return shouldRetry(code), &unexpectedStatusError{code: code, body: b}
 		}
 		if resp != nil {
 			if err := json.NewDecoder(res.Body).Decode(resp); err != nil {
@@ -88,6 +90,26 @@ func (c *retryClient) do(timeout time.Duration, req *http.Request, body []byte,
 	return code, c.retry(ctx, 9, try)
 }
 
+// unexpectedStatusError is returned if a node did not respond with a success
+// code
+type unexpectedStatusError struct {
+	code int
+	body []byte
+}
+
+func (e *unexpectedStatusError) Error() string {
+	return fmt.Sprintf("status code: %v, error: %s", e.code, e.body)
+}
+
+// Unwrap restores enterrors.ErrTenantQuotaExceeded if the node rejected a
+// write because of the tenant quota
+func (e *unexpectedStatusError) Unwrap() error {
+	if e.code == http.StatusInsufficientStorage {
+		return enterrors.ErrTenantQuotaExceeded
+	}
+	return nil
+}
+
 type retryer struct {
 	minBackOff  time.Duration
 	maxBackOff  time.Duration
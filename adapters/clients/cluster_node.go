@@ -12,6 +12,7 @@
 package clients
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"io"
@@ -94,3 +95,40 @@ func (c *RemoteNode) GetStatistics(ctx context.Context, hostName string) (*model
 
 	return &statistics, nil
 }
+
+func (c *RemoteNode) GetTenantUsage(ctx context.Context, hostName, className string,
+	tenants []string,
+) (map[string]*models.TenantUsage, error) {
+	p := path.Join("/nodes/usage", className)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: p}
+
+	reqBody, err := json.Marshal(tenants)
+	if err != nil {
+		return nil, enterrors.NewErrOpenHttpRequest(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url.String(), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, enterrors.NewErrOpenHttpRequest(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, enterrors.NewErrSendHttpRequest(err)
+	}
+
+	defer res.Body.Close()
+	body, _ := io.ReadAll(res.Body)
+	if res.StatusCode != http.StatusOK {
+		return nil, enterrors.NewErrUnexpectedStatusCode(res.StatusCode, body)
+	}
+
+	var usage map[string]*models.TenantUsage
+	err = json.Unmarshal(body, &usage)
+	if err != nil {
+		return nil, enterrors.NewErrUnmarshalBody(err)
+	}
+
+	return usage, nil
+}
@@ -140,8 +140,7 @@ func (c *RemoteIndex) BatchAddReferences(ctx context.Context, hostName, indexNam
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(res.Body)
-		return duplicateErr(errors.Errorf("unexpected status code %d (%s)",
-			res.StatusCode, body), len(refs))
+		return duplicateErr(&unexpectedStatusError{code: res.StatusCode, body: body}, len(refs))
 	}
 
 	if ct, ok := clusterapi.IndicesPayloads.ErrorList.
@@ -296,8 +295,7 @@ func (c *RemoteIndex) MergeObject(ctx context.Context, hostName, indexName,
 	defer res.Body.Close()
 	if res.StatusCode != http.StatusNoContent {
 		body, _ := io.ReadAll(res.Body)
-		return errors.Errorf("unexpected status code %d (%s)", res.StatusCode,
-			body)
+		return &unexpectedStatusError{code: res.StatusCode, body: body}
 	}
 
 	return nil
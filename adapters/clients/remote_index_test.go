@@ -34,6 +34,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
 )
 
 func TestRemoteIndexIncreaseRF(t *testing.T) {
@@ -256,6 +259,32 @@ func TestRemoteIndexPutFile(t *testing.T) {
 	})
 }
 
+func TestRemoteIndexPutObjectTenantQuotaExceeded(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx  = context.Background()
+		path = "/indices/C1/shards/S1/objects"
+		fs   = newFakeRemoteIndexServer(t, http.MethodPost, path)
+	)
+	ts := fs.server(t)
+	defer ts.Close()
+	client := newRemoteIndex(ts.Client())
+
+	fs.doAfter = func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "tenant quota exceeded", http.StatusInsufficientStorage)
+	}
+	obj := storobj.FromObject(&models.Object{
+		Class: "C1",
+		ID:    "8f1a4a37-6c4e-4c43-9a0e-2f1b4a1b7c11",
+	}, nil, nil, nil)
+
+	err := client.PutObject(ctx, fs.host, "C1", "S1", obj, 0)
+	assert.True(t, enterrors.IsTenantQuotaExceeded(err), "expected quota error, got %v", err)
+
+	errs := client.BatchPutObjects(ctx, fs.host, "C1", "S1", []*storobj.Object{obj}, nil, 0)
+	assert.True(t, enterrors.IsTenantQuotaExceeded(errs[0]), "expected quota error, got %v", errs[0])
+}
+
 func newRemoteIndex(httpClient *http.Client) *RemoteIndex {
 	ri := NewRemoteIndex(httpClient)
 	ri.minBackOff = time.Millisecond * 1
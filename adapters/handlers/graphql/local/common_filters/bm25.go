@@ -31,8 +31,39 @@ func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.
 		args.Query = query.(string)
 	}
 
+	if phrase, ok := source["phrase"]; ok {
+		args.Phrase = phrase.(bool)
+	}
+
+	if slop, ok := source["slop"]; ok {
+		args.Slop = slop.(int)
+	}
+
+	if maxEdits, ok := source["maxEdits"]; ok {
+		args.MaxEdits = maxEdits.(int)
+	}
+
+	if maxExpansions, ok := source["maxExpansions"]; ok {
+		args.MaxExpansions = maxExpansions.(int)
+	}
+
+	if searchOperator, ok := source["searchOperator"]; ok {
+		args.SearchOperator, args.MinimumShouldMatch = extractSearchOperator(searchOperator.(map[string]interface{}))
+	}
+
 	args.AdditionalExplanations = explainScore
 	args.Type = "bm25"
 
 	return args
 }
+
+func extractSearchOperator(source map[string]interface{}) (string, string) {
+	var operator, minimumShouldMatch string
+	if value, ok := source["operator"]; ok {
+		operator = value.(string)
+	}
+	if value, ok := source["minimumShouldMatch"]; ok {
+		minimumShouldMatch = value.(string)
+	}
+	return operator, minimumShouldMatch
+}
@@ -163,6 +163,26 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		}
 	}
 
+	if phrase, ok := source["phrase"]; ok {
+		args.Phrase = phrase.(bool)
+	}
+
+	if slop, ok := source["slop"]; ok {
+		args.Slop = slop.(int)
+	}
+
+	if maxEdits, ok := source["maxEdits"]; ok {
+		args.MaxEdits = maxEdits.(int)
+	}
+
+	if maxExpansions, ok := source["maxExpansions"]; ok {
+		args.MaxExpansions = maxExpansions.(int)
+	}
+
+	if searchOperator, ok := source["bm25SearchOperator"]; ok {
+		args.SearchOperator, args.MinimumShouldMatch = extractSearchOperator(searchOperator.(map[string]interface{}))
+	}
+
 	args.Type = "hybrid"
 
 	if args.NearTextParams != nil && args.NearVectorParams != nil {
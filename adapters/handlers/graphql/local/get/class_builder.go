@@ -179,6 +179,7 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["lastUpdateTimeUnix"] = b.additionalLastUpdateTimeUnix()
 	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["explainScore"] = b.additionalExplainScoreField()
+	additionalProperties["highlight"] = b.additionalHighlightField(class)
 	additionalProperties["group"] = b.additionalGroupField(classProperties, class)
 	if replicationEnabled(class) {
 		additionalProperties["isConsistent"] = b.isConsistentField()
@@ -276,6 +277,50 @@ func (b *classBuilder) additionalExplainScoreField() *graphql.Field {
 	}
 }
 
+func (b *classBuilder) additionalHighlightField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: "Highlights the terms of a bm25 or hybrid query in the returned text properties",
+		Args: graphql.FieldConfigArgument{
+			"properties": &graphql.ArgumentConfig{
+				Description: "The properties to highlight, defaults to the searched properties",
+				Type:        graphql.NewList(graphql.String),
+			},
+			"fragmentSize": &graphql.ArgumentConfig{
+				Description: "The length of a fragment in characters",
+				Type:        graphql.Int,
+			},
+			"fragments": &graphql.ArgumentConfig{
+				Description: "The maximum number of fragments per property value",
+				Type:        graphql.Int,
+			},
+			"preTag": &graphql.ArgumentConfig{
+				Description: "The tag inserted before a matched term in the fragments",
+				Type:        graphql.String,
+			},
+			"postTag": &graphql.ArgumentConfig{
+				Description: "The tag inserted after a matched term in the fragments",
+				Type:        graphql.String,
+			},
+		},
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalHighlight", class.Class),
+			Fields: graphql.Fields{
+				"property": &graphql.Field{Type: graphql.String},
+				"index":    &graphql.Field{Type: graphql.Int},
+				"matches": &graphql.Field{Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+					Name: fmt.Sprintf("%sAdditionalHighlightMatch", class.Class),
+					Fields: graphql.Fields{
+						"term":  &graphql.Field{Type: graphql.String},
+						"start": &graphql.Field{Type: graphql.Int},
+						"end":   &graphql.Field{Type: graphql.Int},
+					},
+				}))},
+				"fragments": &graphql.Field{Type: graphql.NewList(graphql.String)},
+			},
+		})),
+	}
+}
+
 func (b *classBuilder) additionalLastUpdateTimeUnix() *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.String,
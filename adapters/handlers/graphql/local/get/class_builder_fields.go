@@ -15,6 +15,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
@@ -590,7 +591,7 @@ func (ac *additionalCheck) isAdditional(parentName, name string) bool {
 			name == "distance" || name == "id" || name == "vector" || name == "vectors" ||
 			name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 			name == "score" || name == "explainScore" || name == "isConsistent" ||
-			name == "group" {
+			name == "group" || name == "highlight" {
 			return true
 		}
 		if ac.isModuleAdditional(name) {
@@ -613,6 +614,29 @@ func (ac *additionalCheck) isModuleAdditional(name string) bool {
 	return false
 }
 
+func extractHighlightParams(args []*ast.Argument) *additional.HighlightParams {
+	out := &additional.HighlightParams{}
+	for _, arg := range args {
+		switch arg.Name.Value {
+		case "properties":
+			for _, value := range arg.Value.GetValue().([]ast.Value) {
+				out.Properties = append(out.Properties, value.(*ast.StringValue).Value)
+			}
+		case "fragmentSize":
+			out.FragmentSize, _ = strconv.Atoi(arg.Value.GetValue().(string))
+		case "fragments":
+			out.Fragments, _ = strconv.Atoi(arg.Value.GetValue().(string))
+		case "preTag":
+			out.PreTag = arg.Value.GetValue().(string)
+		case "postTag":
+			out.PostTag = arg.Value.GetValue().(string)
+		default:
+			// ignore what we don't recognize
+		}
+	}
+	return out
+}
+
 func fieldNameIsOfObjectButNonReferenceType(field string) bool {
 	switch field {
 	case "latitude", "longitude":
@@ -697,6 +721,13 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.ExplainScore = true
 							continue
 						}
+						if additionalProperty == "highlight" {
+							additionalProps.Highlight = extractHighlightParams(s.Arguments)
+							for _, highlighted := range additionalProps.Highlight.Properties {
+								properties = append(properties, search.SelectProperty{Name: highlighted, IsPrimitive: true})
+							}
+							continue
+						}
 						if additionalProperty == "lastUpdateTimeUnix" {
 							additionalProps.LastUpdateTimeUnix = true
 							continue
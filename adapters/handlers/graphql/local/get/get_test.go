@@ -477,6 +477,41 @@ func TestExtractAdditionalFields(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "with _additional highlight",
+			query: `{ Get { SomeAction { _additional { highlight(fragmentSize: 40, preTag: "[", postTag: "]") { property index matches { term start end } fragments } } } } }`,
+			expectedParams: dto.GetParams{
+				ClassName: "SomeAction",
+				AdditionalProperties: additional.Properties{
+					Highlight: &additional.HighlightParams{FragmentSize: 40, PreTag: "[", PostTag: "]"},
+				},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"highlight": []*additional.Highlight{{
+							Property:  "description",
+							Matches:   []additional.HighlightMatch{{Term: "car", Start: 4, End: 7}},
+							Fragments: []string{"The [car] is fast"},
+						}},
+					},
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"_additional": map[string]interface{}{
+					"highlight": []interface{}{
+						map[string]interface{}{
+							"property": "description",
+							"index":    0,
+							"matches": []interface{}{
+								map[string]interface{}{"term": "car", "start": 4, "end": 7},
+							},
+							"fragments": []interface{}{"The [car] is fast"},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:  "with _additional classification",
 			query: "{ Get { SomeAction { _additional { classification { id completed classifiedFields scope basedOn }  } } } }",
@@ -2189,6 +2224,50 @@ func TestHybridWithSort(t *testing.T) {
 	resolver.AssertFailToResolve(t, query, "hybrid search is not compatible with sort")
 }
 
+func TestKeywordSearchOperator(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+	var emptySubsearches []searchparams.WeightedSearchResult
+
+	t.Run("bm25", func(t *testing.T) {
+		query := `{Get{SomeAction(bm25:{query:"apple pie",
+					searchOperator:{operator:Or, minimumShouldMatch:"75%"}}){intField}}}`
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			KeywordRanking: &searchparams.KeywordRanking{
+				Query:              "apple pie",
+				Type:               "bm25",
+				SearchOperator:     searchparams.SearchOperatorOr,
+				MinimumShouldMatch: "75%",
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("hybrid", func(t *testing.T) {
+		query := `{Get{SomeAction(hybrid:{query:"apple pie",
+					bm25SearchOperator:{operator:And}}){intField}}}`
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			HybridSearch: &searchparams.HybridSearch{
+				Query:           "apple pie",
+				Alpha:           0.75,
+				Type:            "hybrid",
+				FusionAlgorithm: 1,
+				SubSearches:     emptySubsearches,
+				SearchOperator:  searchparams.SearchOperatorAnd,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestHybridWithTargets(t *testing.T) {
 	t.Parallel()
 	resolver := newMockResolverWithNoModules()
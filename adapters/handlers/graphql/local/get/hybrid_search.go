@@ -67,6 +67,23 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Which properties should be included in the sparse search",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"phrase": &graphql.InputObjectFieldConfig{
+			Description: "Only match objects containing the query terms in the given order in the sparse search",
+			Type:        graphql.Boolean,
+		},
+		"slop": &graphql.InputObjectFieldConfig{
+			Description: "The number of positions the terms of a phrase may be moved apart or closer together in the sparse search",
+			Type:        graphql.Int,
+		},
+		"maxEdits": &graphql.InputObjectFieldConfig{
+			Description: "Also match terms within this number of edits (0-2) of a query term in the sparse search",
+			Type:        graphql.Int,
+		},
+		"maxExpansions": &graphql.InputObjectFieldConfig{
+			Description: "The maximum number of terms a query term is expanded to when matching fuzzily in the sparse search (default: 50)",
+			Type:        graphql.Int,
+		},
+		"bm25SearchOperator": searchOperatorField(fmt.Sprintf("GetObjects%sHybrid", class.Class)),
 		"fusionType": &graphql.InputObjectFieldConfig{
 			Description: "Algorithm used for fusing results from vector and keyword search",
 			Type:        fusionEnum,
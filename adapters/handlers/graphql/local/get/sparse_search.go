@@ -15,6 +15,7 @@ import (
 	"fmt"
 
 	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/entities/searchparams"
 )
 
 func bm25Argument(className string) *graphql.ArgumentConfig {
@@ -39,5 +40,48 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: "The properties to search in",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"phrase": &graphql.InputObjectFieldConfig{
+			Description: "Only match objects containing the query terms in the given order",
+			Type:        graphql.Boolean,
+		},
+		"slop": &graphql.InputObjectFieldConfig{
+			Description: "The number of positions the terms of a phrase may be moved apart or closer together",
+			Type:        graphql.Int,
+		},
+		"maxEdits": &graphql.InputObjectFieldConfig{
+			Description: "Also match terms within this number of edits (0-2) of a query term",
+			Type:        graphql.Int,
+		},
+		"maxExpansions": &graphql.InputObjectFieldConfig{
+			Description: "The maximum number of terms a query term is expanded to when matching fuzzily (default: 50)",
+			Type:        graphql.Int,
+		},
+		"searchOperator": searchOperatorField(fmt.Sprintf("%sBm25", prefix)),
+	}
+}
+
+func searchOperatorField(prefix string) *graphql.InputObjectFieldConfig {
+	return &graphql.InputObjectFieldConfig{
+		Description: "How many of the query tokens an object has to contain",
+		Type: graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: fmt.Sprintf("%sSearchOperatorInpObj", prefix),
+			Fields: graphql.InputObjectConfigFieldMap{
+				"operator": &graphql.InputObjectFieldConfig{
+					Description: "And requires all tokens, Or at least minimumShouldMatch of them",
+					Type: graphql.NewEnum(graphql.EnumConfig{
+						Name: fmt.Sprintf("%sSearchOperatorEnum", prefix),
+						Values: graphql.EnumValueConfigMap{
+							searchparams.SearchOperatorAnd: &graphql.EnumValueConfig{},
+							searchparams.SearchOperatorOr:  &graphql.EnumValueConfig{},
+						},
+					}),
+				},
+				"minimumShouldMatch": &graphql.InputObjectFieldConfig{
+					Description: "The number (e.g. 2) or percentage (e.g. 75%) of tokens that have to match, " +
+						"negative values are the number or percentage of tokens that may be missing",
+					Type: graphql.String,
+				},
+			},
+		}),
 	}
 }
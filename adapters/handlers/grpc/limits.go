@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	v1 "github.com/weaviate/weaviate/adapters/handlers/grpc/v1"
+)
+
+const (
+	healthMethodPrefix = "/grpc.health.v1.Health/"
+	batchStreamMethod  = "/weaviate.v1.Weaviate/BatchStream"
+)
+
+// ingestMethods count towards the ingestion volume
+var ingestMethods = map[string]struct{}{
+	"/weaviate.v1.Weaviate/BatchObjects":  {},
+	"/weaviate.v1.Weaviate/ObjectInsert":  {},
+	"/weaviate.v1.Weaviate/ObjectReplace": {},
+	"/weaviate.v1.Weaviate/ObjectMerge":   {},
+}
+
+type authenticateFn func(ctx context.Context) (*models.Principal, error)
+
+// makeLimitsInterceptor enforces the request rate, concurrency and ingestion
+// volume limits of the principal of each request. Requests which fail to
+// authenticate are passed on, the endpoint responds with the error.
+func makeLimitsInterceptor(limiter *ratelimiter.Principals, authenticate authenticateFn) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (any, error) {
+		if strings.HasPrefix(info.FullMethod, healthMethodPrefix) {
+			return handler(ctx, req)
+		}
+		principal, err := authenticate(ctx)
+		if err != nil {
+			return handler(ctx, req)
+		}
+		ctx = v1.ContextWithPrincipal(ctx, principal)
+
+		permit, err := limiter.Acquire(principal)
+		if err != nil {
+			return nil, limitStatus(err)
+		}
+		defer permit.Release()
+
+		if _, ok := ingestMethods[info.FullMethod]; ok {
+			if msg, ok := req.(proto.Message); ok {
+				if err := permit.Ingest(int64(proto.Size(msg))); err != nil {
+					return nil, limitStatus(err)
+				}
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// makeLimitsStreamInterceptor is the equivalent of makeLimitsInterceptor for
+// streams. A stream counts as a single request for as long as it is open,
+// the messages received by a batch stream count towards the ingestion
+// volume.
+func makeLimitsStreamInterceptor(limiter *ratelimiter.Principals, authenticate authenticateFn) grpc.StreamServerInterceptor {
+	return func(
+		srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		if strings.HasPrefix(info.FullMethod, healthMethodPrefix) {
+			return handler(srv, ss)
+		}
+		principal, err := authenticate(ss.Context())
+		if err != nil {
+			return handler(srv, ss)
+		}
+
+		permit, err := limiter.Acquire(principal)
+		if err != nil {
+			return limitStatus(err)
+		}
+		defer permit.Release()
+
+		return handler(srv, &limitedStream{
+			ServerStream: ss,
+			ctx:          v1.ContextWithPrincipal(ss.Context(), principal),
+			permit:       permit,
+			ingests:      info.FullMethod == batchStreamMethod,
+		})
+	}
+}
+
+type limitedStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	permit  *ratelimiter.Permit
+	ingests bool
+}
+
+func (s *limitedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *limitedStream) RecvMsg(m any) error {
+	if !s.ingests {
+		return s.ServerStream.RecvMsg(m)
+	}
+	if err := s.permit.Ingest(0); err != nil {
+		return limitStatus(err)
+	}
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		s.permit.Account(int64(proto.Size(msg)))
+	}
+	return nil
+}
+
+func limitStatus(err error) error {
+	return status.Error(codes.ResourceExhausted, err.Error())
+}
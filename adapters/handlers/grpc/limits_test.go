@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	pbv1 "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLimitsInterceptor(t *testing.T) {
+	cfg := ratelimiter.Config{Default: ratelimiter.Limits{MaxConcurrentRequests: 1, IngestBytesPerSecond: 1}}
+	limiter := ratelimiter.NewPrincipals(func() ratelimiter.Config { return cfg }, nil, nil)
+	alice := &models.Principal{Username: "alice"}
+	interceptor := makeLimitsInterceptor(limiter, func(ctx context.Context) (*models.Principal, error) {
+		return alice, nil
+	})
+	search := &grpc.UnaryServerInfo{FullMethod: "/weaviate.v1.Weaviate/Search"}
+	batch := &grpc.UnaryServerInfo{FullMethod: "/weaviate.v1.Weaviate/BatchObjects"}
+	ok := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+
+	t.Run("concurrent requests", func(t *testing.T) {
+		_, err := interceptor(context.Background(), &pbv1.SearchRequest{}, search,
+			func(ctx context.Context, req any) (any, error) {
+				_, err := interceptor(ctx, req, search, ok)
+				return nil, err
+			})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+		res, err := interceptor(context.Background(), &pbv1.SearchRequest{}, search, ok)
+		require.Nil(t, err)
+		assert.Equal(t, "ok", res)
+	})
+
+	t.Run("ingestion volume", func(t *testing.T) {
+		req := &pbv1.BatchObjectsRequest{Objects: []*pbv1.BatchObject{{Collection: "Class"}}}
+		_, err := interceptor(context.Background(), req, batch, ok)
+		require.Nil(t, err)
+		_, err = interceptor(context.Background(), req, batch, ok)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		// other requests are not affected
+		_, err = interceptor(context.Background(), &pbv1.SearchRequest{}, search, ok)
+		require.Nil(t, err)
+	})
+}
@@ -59,9 +59,32 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) *grpc.Se
 		o = append(o, grpc.Creds(c))
 	}
 
+	weaviateV1 := v1.NewService(
+		state.Traverser,
+		composer.New(
+			state.ServerConfig.Config.Authentication,
+			state.APIKey, state.OIDC),
+		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
+		state.SchemaManager,
+		state.BatchManager,
+		state.ObjectsManager,
+		state.DB,
+		state.DB,
+		state.MemWatch,
+		&state.ServerConfig.Config,
+		state.Authorizer,
+		state.Logger,
+	)
+
 	var interceptors []grpc.UnaryServerInterceptor
 
 	interceptors = append(interceptors, makeAuthInterceptor())
+	streamInterceptors := []grpc.StreamServerInterceptor{makeAuthStreamInterceptor()}
+
+	if state.PrincipalLimiter != nil {
+		interceptors = append(interceptors, makeLimitsInterceptor(state.PrincipalLimiter, weaviateV1.Authenticate))
+		streamInterceptors = append(streamInterceptors, makeLimitsStreamInterceptor(state.PrincipalLimiter, weaviateV1.Authenticate))
+	}
 
 	// If sentry is enabled add automatic spans on gRPC requests
 	if state.ServerConfig.Config.Sentry.Enabled {
@@ -78,20 +101,10 @@ func CreateGRPCServer(state *state.State, options ...grpc.ServerOption) *grpc.Se
 		o = append(o, grpc.ChainUnaryInterceptor(interceptors...))
 	}
 
+	o = append(o, grpc.ChainStreamInterceptor(streamInterceptors...))
+
 	s := grpc.NewServer(o...)
 	weaviateV0 := v0.NewService()
-	weaviateV1 := v1.NewService(
-		state.Traverser,
-		composer.New(
-			state.ServerConfig.Config.Authentication,
-			state.APIKey, state.OIDC),
-		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
-		state.SchemaManager,
-		state.BatchManager,
-		&state.ServerConfig.Config,
-		state.Authorizer,
-		state.Logger,
-	)
 	pbv0.RegisterWeaviateServer(s, weaviateV0)
 	pbv1.RegisterWeaviateServer(s, weaviateV1)
 	grpc_health_v1.RegisterHealthServer(s, weaviateV1)
@@ -149,6 +162,24 @@ func makeAuthInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
+func makeAuthStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+	) error {
+		err := handler(srv, ss)
+
+		if errors.As(err, &authErrs.Unauthenticated{}) {
+			return status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		if errors.As(err, &authErrs.Forbidden{}) {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+
+		return err
+	}
+}
+
 func StartAndListen(s *grpc.Server, state *state.State) error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d",
 		state.ServerConfig.Config.GRPC.Port))
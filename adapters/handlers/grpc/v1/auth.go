@@ -25,6 +25,10 @@ import (
 // should be called from a central place. This way we can make sure it's
 // impossible to forget to add it to a new endpoint.
 func (s *Service) principalFromContext(ctx context.Context) (*models.Principal, error) {
+	if principal, ok := ctx.Value(principalKey{}).(*models.Principal); ok {
+		return principal, nil
+	}
+
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return s.tryAnonymous()
@@ -49,6 +53,20 @@ func (s *Service) principalFromContext(ctx context.Context) (*models.Principal,
 	return s.authComposer(token, nil)
 }
 
+// Authenticate returns the principal of the request, nil for anonymous
+// requests
+func (s *Service) Authenticate(ctx context.Context) (*models.Principal, error) {
+	return s.principalFromContext(ctx)
+}
+
+type principalKey struct{}
+
+// ContextWithPrincipal stores the principal authenticated by an interceptor,
+// so that the endpoint does not authenticate the request again
+func ContextWithPrincipal(ctx context.Context, principal *models.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
 func (s *Service) tryAnonymous() (*models.Principal, error) {
 	if s.allowAnonymousAccess {
 		return nil, nil
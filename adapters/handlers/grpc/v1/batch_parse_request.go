@@ -41,8 +41,6 @@ func BatchFromProto(req *pb.BatchObjectsRequest, authorizedGetClass func(string,
 
 	insertCounter := 0
 	for i, obj := range objectsBatch {
-		var props map[string]interface{}
-
 		obj.Collection = schema.UppercaseClassName(obj.Collection)
 		class, err := authorizedGetClass(obj.Collection, obj.Tenant)
 		if err != nil {
@@ -50,83 +48,93 @@ func BatchFromProto(req *pb.BatchObjectsRequest, authorizedGetClass func(string,
 			continue
 		}
 
-		if obj.Properties != nil {
-			props = extractPrimitiveProperties(&pb.ObjectPropertiesValue{
-				NonRefProperties:       obj.Properties.NonRefProperties,
-				BooleanArrayProperties: obj.Properties.BooleanArrayProperties,
-				NumberArrayProperties:  obj.Properties.NumberArrayProperties,
-				TextArrayProperties:    obj.Properties.TextArrayProperties,
-				IntArrayProperties:     obj.Properties.IntArrayProperties,
-				ObjectProperties:       obj.Properties.ObjectProperties,
-				ObjectArrayProperties:  obj.Properties.ObjectArrayProperties,
-				EmptyListProps:         obj.Properties.EmptyListProps,
-			})
-			// If class is not in schema, continue as there is no ref to extract
-			if class != nil {
-				if err := extractSingleRefTarget(class, obj.Properties.SingleTargetRefProps, props); err != nil {
-					objectErrors[i] = err
-					continue
-				}
-				if err := extractMultiRefTarget(class, obj.Properties.MultiTargetRefProps, props); err != nil {
-					objectErrors[i] = err
-					continue
-				}
-			}
-		}
-
-		if _, err := uuid.Parse(obj.Uuid); err != nil {
+		object, err := objectFromProto(obj, class)
+		if err != nil {
 			objectErrors[i] = err
 			continue
 		}
 
-		var vector []float32 = nil
-		// bytes vector has precedent for being more efficient
-		if len(obj.VectorBytes) > 0 {
-			vector = byteops.Fp32SliceFromBytes(obj.VectorBytes)
-		} else if len(obj.Vector) > 0 {
-			vector = obj.Vector
-		}
+		objOriginalIndex[insertCounter] = i
+		objs = append(objs, object)
+		insertCounter += 1
+	}
+	return objs[:insertCounter], objOriginalIndex, objectErrors
+}
 
-		var vectors models.Vectors = nil
-		if len(obj.Vectors) > 0 {
-			parsedVectors := make(map[string][]float32)
-			parsedMultiVectors := make(map[string][][]float32)
-			for _, vec := range obj.Vectors {
-				switch vec.Type {
-				case *pb.Vectors_VECTOR_TYPE_UNSPECIFIED.Enum(), *pb.Vectors_VECTOR_TYPE_SINGLE_FP32.Enum():
-					parsedVectors[vec.Name] = byteops.Fp32SliceFromBytes(vec.VectorBytes)
-				case *pb.Vectors_VECTOR_TYPE_MULTI_FP32.Enum():
-					out, err := byteops.Fp32SliceOfSlicesFromBytes(vec.VectorBytes)
-					if err != nil {
-						objectErrors[i] = err
-						continue
-					}
-					parsedMultiVectors[vec.Name] = out
-				default:
-					// do nothing
-				}
-			}
-			vectors = make(models.Vectors, len(parsedVectors)+len(parsedMultiVectors))
-			for targetVector, vector := range parsedVectors {
-				vectors[targetVector] = vector
+// objectFromProto converts a single object of a batch request. If the class
+// is nil, i.e. it is not part of the schema yet, references cannot be
+// extracted and are ignored.
+func objectFromProto(obj *pb.BatchObject, class *models.Class) (*models.Object, error) {
+	var props map[string]interface{}
+	if obj.Properties != nil {
+		props = extractPrimitiveProperties(&pb.ObjectPropertiesValue{
+			NonRefProperties:       obj.Properties.NonRefProperties,
+			BooleanArrayProperties: obj.Properties.BooleanArrayProperties,
+			NumberArrayProperties:  obj.Properties.NumberArrayProperties,
+			TextArrayProperties:    obj.Properties.TextArrayProperties,
+			IntArrayProperties:     obj.Properties.IntArrayProperties,
+			ObjectProperties:       obj.Properties.ObjectProperties,
+			ObjectArrayProperties:  obj.Properties.ObjectArrayProperties,
+			EmptyListProps:         obj.Properties.EmptyListProps,
+		})
+		// If class is not in schema, continue as there is no ref to extract
+		if class != nil {
+			if err := extractSingleRefTarget(class, obj.Properties.SingleTargetRefProps, props); err != nil {
+				return nil, err
 			}
-			for targetVector, multiVector := range parsedMultiVectors {
-				vectors[targetVector] = multiVector
+			if err := extractMultiRefTarget(class, obj.Properties.MultiTargetRefProps, props); err != nil {
+				return nil, err
 			}
 		}
+	}
 
-		objOriginalIndex[insertCounter] = i
-		objs = append(objs, &models.Object{
-			Class:      obj.Collection,
-			Tenant:     obj.Tenant,
-			Vector:     vector,
-			Properties: props,
-			ID:         strfmt.UUID(obj.Uuid),
-			Vectors:    vectors,
-		})
-		insertCounter += 1
+	if _, err := uuid.Parse(obj.Uuid); err != nil {
+		return nil, err
 	}
-	return objs[:insertCounter], objOriginalIndex, objectErrors
+
+	var vector []float32 = nil
+	// bytes vector has precedent for being more efficient
+	if len(obj.VectorBytes) > 0 {
+		vector = byteops.Fp32SliceFromBytes(obj.VectorBytes)
+	} else if len(obj.Vector) > 0 {
+		vector = obj.Vector
+	}
+
+	var vectors models.Vectors = nil
+	if len(obj.Vectors) > 0 {
+		parsedVectors := make(map[string][]float32)
+		parsedMultiVectors := make(map[string][][]float32)
+		for _, vec := range obj.Vectors {
+			switch vec.Type {
+			case *pb.Vectors_VECTOR_TYPE_UNSPECIFIED.Enum(), *pb.Vectors_VECTOR_TYPE_SINGLE_FP32.Enum():
+				parsedVectors[vec.Name] = byteops.Fp32SliceFromBytes(vec.VectorBytes)
+			case *pb.Vectors_VECTOR_TYPE_MULTI_FP32.Enum():
+				out, err := byteops.Fp32SliceOfSlicesFromBytes(vec.VectorBytes)
+				if err != nil {
+					return nil, err
+				}
+				parsedMultiVectors[vec.Name] = out
+			default:
+				// do nothing
+			}
+		}
+		vectors = make(models.Vectors, len(parsedVectors)+len(parsedMultiVectors))
+		for targetVector, vector := range parsedVectors {
+			vectors[targetVector] = vector
+		}
+		for targetVector, multiVector := range parsedMultiVectors {
+			vectors[targetVector] = multiVector
+		}
+	}
+
+	return &models.Object{
+		Class:      obj.Collection,
+		Tenant:     obj.Tenant,
+		Vector:     vector,
+		Properties: props,
+		ID:         strfmt.UUID(obj.Uuid),
+		Vectors:    vectors,
+	}, nil
 }
 
 func extractSingleRefTarget(class *models.Class, properties []*pb.BatchObject_SingleTargetRefProps, props map[string]interface{}) error {
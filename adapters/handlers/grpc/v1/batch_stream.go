@@ -0,0 +1,235 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"errors"
+	"io"
+	"math"
+	"time"
+
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const (
+	// batchStreamMaxBatchSize is the batch size recommended to clients while
+	// the server is not under pressure
+	batchStreamMaxBatchSize = 1000
+	batchStreamMinBatchSize = 10
+	// batchStreamPressureLevels is the number of steps the pressure is
+	// quantized to, so that clients are not flooded with flow control
+	// messages for minor changes
+	batchStreamPressureLevels = 10
+)
+
+type vectorIndexQueueBacklogReader interface {
+	LocalVectorIndexQueueBacklog() int64
+}
+
+type memoryPressureReader interface {
+	Ratio() float64
+}
+
+type batchStreamRequest struct {
+	req *pb.BatchStreamRequest
+	err error
+}
+
+func (s *Service) BatchStream(stream pb.Weaviate_BatchStreamServer) error {
+	ctx := stream.Context()
+
+	// fail early, every chunk is authorized again when it is imported
+	if _, err := s.principalFromContext(ctx); err != nil {
+		return err
+	}
+
+	flowControl := newBatchFlowControl(s.config.GRPC.BatchStream, s.queueBacklog, s.memoryPressure)
+	signal := flowControl.signal()
+	if err := stream.Send(flowControlReply(signal)); err != nil {
+		return err
+	}
+
+	// a single buffered request keeps the receiver from reading ahead while
+	// the server backs off, which lets the gRPC flow control throttle the
+	// client as well
+	requests := make(chan batchStreamRequest, 1)
+	enterrors.GoWrapper(func() {
+		defer close(requests)
+		for {
+			req, err := stream.Recv()
+			select {
+			case requests <- batchStreamRequest{req: req, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}, s.logger)
+
+	var offset uint64
+	for {
+		var next batchStreamRequest
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case r, ok := <-requests:
+			if !ok {
+				return ctx.Err()
+			}
+			next = r
+		}
+
+		if errors.Is(next.err, io.EOF) {
+			return nil
+		}
+		if next.err != nil {
+			return next.err
+		}
+
+		reply, err := s.batchObjects(ctx, &pb.BatchObjectsRequest{
+			Objects:          next.req.Objects,
+			ConsistencyLevel: next.req.ConsistencyLevel,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(batchStreamResults(offset, next.req.Objects, reply)); err != nil {
+			return err
+		}
+		offset += uint64(len(next.req.Objects))
+
+		if current := flowControl.signal(); !current.equal(signal) {
+			signal = current
+			if err := stream.Send(flowControlReply(signal)); err != nil {
+				return err
+			}
+		}
+
+		if signal.backoff > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(signal.backoff):
+			}
+		}
+	}
+}
+
+func batchStreamResults(offset uint64, objects []*pb.BatchObject, reply *pb.BatchObjectsReply) *pb.BatchStreamReply {
+	errs := make(map[int32]string, len(reply.Errors))
+	for _, e := range reply.Errors {
+		errs[e.Index] = e.Error
+	}
+
+	results := make([]*pb.BatchStreamReply_Results_Result, len(objects))
+	for i, obj := range objects {
+		results[i] = &pb.BatchStreamReply_Results_Result{
+			Index: offset + uint64(i),
+			Uuid:  obj.Uuid,
+			Error: errs[int32(i)],
+		}
+	}
+
+	return &pb.BatchStreamReply{
+		Message: &pb.BatchStreamReply_Results_{
+			Results: &pb.BatchStreamReply_Results{
+				Took:   reply.Took,
+				Values: results,
+			},
+		},
+	}
+}
+
+func flowControlReply(signal batchFlowSignal) *pb.BatchStreamReply {
+	return &pb.BatchStreamReply{
+		Message: &pb.BatchStreamReply_FlowControl_{
+			FlowControl: &pb.BatchStreamReply_FlowControl{
+				BatchSize: int32(signal.batchSize),
+				BackoffMs: uint32(signal.backoff.Milliseconds()),
+				Reason:    signal.reason,
+			},
+		},
+	}
+}
+
+type batchFlowSignal struct {
+	level     int
+	batchSize int
+	backoff   time.Duration
+	reason    pb.BatchStreamReply_FlowControl_Reason
+}
+
+func (s batchFlowSignal) equal(other batchFlowSignal) bool {
+	return s.level == other.level && s.reason == other.reason
+}
+
+// batchFlowControl derives the pace of streaming imports from the backlog of
+// the vector index queues and the memory usage of the node.
+type batchFlowControl struct {
+	config         config.GRPCBatchStream
+	queueBacklog   vectorIndexQueueBacklogReader
+	memoryPressure memoryPressureReader
+}
+
+func newBatchFlowControl(cfg config.GRPCBatchStream, queueBacklog vectorIndexQueueBacklogReader,
+	memoryPressure memoryPressureReader,
+) *batchFlowControl {
+	return &batchFlowControl{
+		config:         cfg,
+		queueBacklog:   queueBacklog,
+		memoryPressure: memoryPressure,
+	}
+}
+
+func (f *batchFlowControl) signal() batchFlowSignal {
+	var queuePressure, memoryPressure float64
+	if f.queueBacklog != nil && f.config.MaxQueueBacklog > 0 {
+		queuePressure = float64(f.queueBacklog.LocalVectorIndexQueueBacklog()) / float64(f.config.MaxQueueBacklog)
+	}
+	if f.memoryPressure != nil {
+		ratio := f.memoryPressure.Ratio()
+		switch threshold := f.config.MemoryThreshold; {
+		case ratio >= 1:
+			memoryPressure = 1
+		case ratio > threshold:
+			memoryPressure = (ratio - threshold) / (1 - threshold)
+		}
+	}
+
+	pressure := queuePressure
+	reason := pb.BatchStreamReply_FlowControl_REASON_VECTOR_INDEX_QUEUE
+	if memoryPressure > pressure {
+		pressure = memoryPressure
+		reason = pb.BatchStreamReply_FlowControl_REASON_MEMORY
+	}
+
+	level := int(math.Ceil(math.Min(pressure, 1) * batchStreamPressureLevels))
+	if level <= 0 {
+		return batchFlowSignal{
+			batchSize: batchStreamMaxBatchSize,
+			reason:    pb.BatchStreamReply_FlowControl_REASON_UNSPECIFIED,
+		}
+	}
+
+	load := float64(level) / batchStreamPressureLevels
+	return batchFlowSignal{
+		level:     level,
+		batchSize: max(batchStreamMinBatchSize, int(batchStreamMaxBatchSize*(1-load))),
+		backoff:   time.Duration(load * float64(f.config.MaxBackoff)),
+		reason:    reason,
+	}
+}
@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+type fakeQueueBacklog int64
+
+func (f fakeQueueBacklog) LocalVectorIndexQueueBacklog() int64 { return int64(f) }
+
+type fakeMemoryPressure float64
+
+func (f fakeMemoryPressure) Ratio() float64 { return float64(f) }
+
+func TestBatchFlowControl(t *testing.T) {
+	cfg := config.GRPCBatchStream{
+		MaxQueueBacklog: 1000,
+		MemoryThreshold: 0.8,
+		MaxBackoff:      time.Second,
+	}
+
+	tests := []struct {
+		name      string
+		backlog   int64
+		memory    float64
+		batchSize int
+		backoff   time.Duration
+		reason    pb.BatchStreamReply_FlowControl_Reason
+	}{
+		{
+			name:      "idle",
+			batchSize: batchStreamMaxBatchSize,
+			reason:    pb.BatchStreamReply_FlowControl_REASON_UNSPECIFIED,
+		},
+		{
+			name:      "memory below threshold",
+			memory:    0.7,
+			batchSize: batchStreamMaxBatchSize,
+			reason:    pb.BatchStreamReply_FlowControl_REASON_UNSPECIFIED,
+		},
+		{
+			name:      "queue backlog",
+			backlog:   500,
+			memory:    0.5,
+			batchSize: 500,
+			backoff:   500 * time.Millisecond,
+			reason:    pb.BatchStreamReply_FlowControl_REASON_VECTOR_INDEX_QUEUE,
+		},
+		{
+			name:      "queue backlog rounds up to the next level",
+			backlog:   410,
+			batchSize: 500,
+			backoff:   500 * time.Millisecond,
+			reason:    pb.BatchStreamReply_FlowControl_REASON_VECTOR_INDEX_QUEUE,
+		},
+		{
+			name:      "memory pressure dominates",
+			backlog:   100,
+			memory:    0.9,
+			batchSize: 500,
+			backoff:   500 * time.Millisecond,
+			reason:    pb.BatchStreamReply_FlowControl_REASON_MEMORY,
+		},
+		{
+			name:      "queue backlog exceeded",
+			backlog:   5000,
+			batchSize: batchStreamMinBatchSize,
+			backoff:   time.Second,
+			reason:    pb.BatchStreamReply_FlowControl_REASON_VECTOR_INDEX_QUEUE,
+		},
+		{
+			name:      "memory limit reached",
+			memory:    1.2,
+			batchSize: batchStreamMinBatchSize,
+			backoff:   time.Second,
+			reason:    pb.BatchStreamReply_FlowControl_REASON_MEMORY,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fc := newBatchFlowControl(cfg, fakeQueueBacklog(tt.backlog), fakeMemoryPressure(tt.memory))
+			signal := fc.signal()
+			require.Equal(t, tt.batchSize, signal.batchSize)
+			require.Equal(t, tt.backoff, signal.backoff)
+			require.Equal(t, tt.reason, signal.reason)
+		})
+	}
+
+	t.Run("without sources", func(t *testing.T) {
+		signal := newBatchFlowControl(cfg, nil, nil).signal()
+		require.Equal(t, batchStreamMaxBatchSize, signal.batchSize)
+		require.Zero(t, signal.backoff)
+	})
+
+	t.Run("equal signals", func(t *testing.T) {
+		a := newBatchFlowControl(cfg, fakeQueueBacklog(410), nil).signal()
+		b := newBatchFlowControl(cfg, fakeQueueBacklog(480), nil).signal()
+		c := newBatchFlowControl(cfg, fakeQueueBacklog(510), nil).signal()
+		require.True(t, a.equal(b))
+		require.False(t, a.equal(c))
+	})
+}
+
+func TestBatchStreamResults(t *testing.T) {
+	objects := []*pb.BatchObject{{Uuid: "a"}, {Uuid: "b"}, {Uuid: "c"}}
+	reply := &pb.BatchObjectsReply{
+		Took:   1,
+		Errors: []*pb.BatchObjectsReply_BatchError{{Index: 1, Error: "failed"}},
+	}
+
+	results := batchStreamResults(10, objects, reply).GetResults()
+	require.NotNil(t, results)
+	require.Equal(t, float32(1), results.Took)
+	require.Equal(t, []*pb.BatchStreamReply_Results_Result{
+		{Index: 10, Uuid: "a"},
+		{Index: 11, Uuid: "b", Error: "failed"},
+		{Index: 12, Uuid: "c"},
+	}, results.Values)
+}
@@ -0,0 +1,178 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/storobj"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// changeStreamBatchSize is the number of changes read from the change log at
+// once while the consumer is catching up.
+const changeStreamBatchSize = 100
+
+type changeLogReader interface {
+	ReadChangeLog(ctx context.Context, className, shardName string,
+		after uint64, limit int) (*changelog.Page, error)
+}
+
+func (s *Service) ChangeStream(req *pb.ChangeStreamRequest, stream pb.Weaviate_ChangeStreamServer) error {
+	ctx := stream.Context()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("extract auth: %w", err)
+	}
+
+	if req.Collection == "" {
+		return fmt.Errorf("missing collection")
+	}
+
+	shard := req.GetShard()
+	if req.Tenant != nil {
+		shard = *req.Tenant
+	}
+
+	class, err := s.classGetterWithAuthzFunc(principal, req.GetTenant())(req.Collection)
+	if err != nil {
+		return err
+	}
+
+	var resumeFrom *changelog.Checkpoint
+	if req.Checkpoint != nil {
+		checkpoint, err := changelog.ParseCheckpoint(*req.Checkpoint)
+		if err != nil {
+			return err
+		}
+		if shard != "" && shard != checkpoint.Shard {
+			return fmt.Errorf("checkpoint was issued for shard %q, not %q", checkpoint.Shard, shard)
+		}
+		shard = checkpoint.Shard
+		resumeFrom = &checkpoint
+	}
+
+	var after uint64
+	if resumeFrom != nil {
+		after = resumeFrom.Sequence
+	}
+
+	for {
+		page, err := s.changeLog.ReadChangeLog(ctx, class.Class, shard, after, changeStreamBatchSize)
+		if err != nil {
+			return fmt.Errorf("read change log: %w", err)
+		}
+
+		if resumeFrom != nil {
+			if resumeFrom.Node != page.Node {
+				return fmt.Errorf("checkpoint was issued by node %q, the change log of shard %q "+
+					"can only be resumed there", resumeFrom.Node, resumeFrom.Shard)
+			}
+			resumeFrom = nil
+		}
+		shard = page.Shard
+
+		for i := range page.Records {
+			reply, err := changeToProto(class.Class, page, &page.Records[i])
+			if err != nil {
+				return fmt.Errorf("change %d: %w", page.Records[i].Sequence, err)
+			}
+			if err := stream.Send(reply); err != nil {
+				return err
+			}
+			after = page.Records[i].Sequence
+		}
+
+		if len(page.Records) == changeStreamBatchSize {
+			// there might be more changes already, no need to wait
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-page.Appended:
+		}
+	}
+}
+
+func changeToProto(className string, page *changelog.Page, rec *changelog.Record) (*pb.ChangeStreamReply, error) {
+	reply := &pb.ChangeStreamReply{
+		Collection:      className,
+		Shard:           page.Shard,
+		Uuid:            rec.UUID.String(),
+		TimestampUnixMs: rec.Timestamp,
+		Sequence:        rec.Sequence,
+		Checkpoint: changelog.Checkpoint{
+			Node:     page.Node,
+			Shard:    page.Shard,
+			Sequence: rec.Sequence,
+		}.Encode(),
+	}
+
+	switch rec.Operation {
+	case changelog.OperationDelete:
+		reply.Operation = pb.ChangeStreamReply_OPERATION_DELETE
+		return reply, nil
+	case changelog.OperationUpsert:
+		reply.Operation = pb.ChangeStreamReply_OPERATION_UPSERT
+	default:
+		return nil, fmt.Errorf("unknown operation %s", rec.Operation)
+	}
+
+	obj, err := storobj.FromBinary(rec.Object)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal object: %w", err)
+	}
+
+	if props := obj.Properties(); props != nil {
+		// properties are stored with their go types (e.g. geo coordinates),
+		// a json round trip turns them into values structpb can represent
+		propsJSON, err := json.Marshal(props)
+		if err != nil {
+			return nil, fmt.Errorf("marshal properties: %w", err)
+		}
+		var asMap map[string]interface{}
+		if err := json.Unmarshal(propsJSON, &asMap); err != nil {
+			return nil, fmt.Errorf("unmarshal properties: %w", err)
+		}
+		if reply.Properties, err = structpb.NewStruct(asMap); err != nil {
+			return nil, fmt.Errorf("convert properties: %w", err)
+		}
+	}
+
+	if len(obj.Vector) > 0 {
+		reply.VectorBytes = byteops.Fp32SliceToBytes(obj.Vector)
+	}
+	for name, vec := range obj.Vectors {
+		reply.Vectors = append(reply.Vectors, &pb.Vectors{
+			VectorBytes: byteops.Fp32SliceToBytes(vec),
+			Name:        name,
+			Type:        pb.Vectors_VECTOR_TYPE_SINGLE_FP32,
+		})
+	}
+	for name, vec := range obj.MultiVectors {
+		reply.Vectors = append(reply.Vectors, &pb.Vectors{
+			VectorBytes: byteops.Fp32SliceOfSlicesToBytes(vec),
+			Name:        name,
+			Type:        pb.Vectors_VECTOR_TYPE_MULTI_FP32,
+		})
+	}
+
+	return reply, nil
+}
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+func TestChangeToProto(t *testing.T) {
+	id := strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247")
+	page := &changelog.Page{Node: "node1", Shard: "shard1"}
+
+	t.Run("upsert", func(t *testing.T) {
+		obj := storobj.FromObject(&models.Object{
+			Class: "Article",
+			ID:    id,
+			Properties: map[string]interface{}{
+				"title": "hello",
+				"geo":   &models.GeoCoordinates{Latitude: ptFloat32(1), Longitude: ptFloat32(2)},
+			},
+			LastUpdateTimeUnix: 1000,
+		}, []float32{1, 2}, map[string][]float32{"named": {3, 4}}, nil)
+		data, err := obj.MarshalBinary()
+		require.Nil(t, err)
+
+		reply, err := changeToProto("Article", page, &changelog.Record{
+			Sequence:  7,
+			Operation: changelog.OperationUpsert,
+			UUID:      id,
+			Timestamp: 1000,
+			Object:    data,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, pb.ChangeStreamReply_OPERATION_UPSERT, reply.Operation)
+		assert.Equal(t, "Article", reply.Collection)
+		assert.Equal(t, "shard1", reply.Shard)
+		assert.Equal(t, id.String(), reply.Uuid)
+		assert.Equal(t, int64(1000), reply.TimestampUnixMs)
+		assert.Equal(t, uint64(7), reply.Sequence)
+		assert.Equal(t, "hello", reply.Properties.Fields["title"].GetStringValue())
+		assert.Equal(t, float64(2), reply.Properties.Fields["geo"].GetStructValue().Fields["longitude"].GetNumberValue())
+		assert.Equal(t, byteops.Fp32SliceToBytes([]float32{1, 2}), reply.VectorBytes)
+		require.Len(t, reply.Vectors, 1)
+		assert.Equal(t, "named", reply.Vectors[0].Name)
+
+		checkpoint, err := changelog.ParseCheckpoint(reply.Checkpoint)
+		require.Nil(t, err)
+		assert.Equal(t, changelog.Checkpoint{Node: "node1", Shard: "shard1", Sequence: 7}, checkpoint)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		reply, err := changeToProto("Article", page, &changelog.Record{
+			Sequence:  8,
+			Operation: changelog.OperationDelete,
+			UUID:      id,
+			Timestamp: 2000,
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, pb.ChangeStreamReply_OPERATION_DELETE, reply.Operation)
+		assert.Nil(t, reply.Properties)
+		assert.Equal(t, int64(2000), reply.TimestampUnixMs)
+	})
+}
+
+func ptFloat32(f float32) *float32 {
+	return &f
+}
@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func (s *Service) CollectionGet(ctx context.Context, req *pb.CollectionGetRequest) (*pb.CollectionGetReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if req.Collection == "" {
+		return nil, fmt.Errorf("missing collection")
+	}
+
+	class, _, err := s.schemaManager.GetConsistentClass(ctx, principal, req.Collection, req.GetConsistency())
+	if err != nil {
+		return nil, fmt.Errorf("get collection: %w", err)
+	}
+	if class == nil {
+		return nil, fmt.Errorf("collection %q not found", req.Collection)
+	}
+
+	collection, err := collectionToProto(class)
+	if err != nil {
+		return nil, fmt.Errorf("collection %q: %w", req.Collection, err)
+	}
+
+	return &pb.CollectionGetReply{
+		Took:       float32(time.Since(before).Seconds()),
+		Collection: collection,
+	}, nil
+}
+
+func (s *Service) CollectionCreate(ctx context.Context, req *pb.CollectionCreateRequest) (*pb.CollectionCreateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	class, err := collectionFromProto(req.Collection)
+	if err != nil {
+		return nil, fmt.Errorf("parse collection: %w", err)
+	}
+
+	created, _, err := s.schemaManager.AddClass(ctx, principal, class)
+	if err != nil {
+		return nil, fmt.Errorf("create collection: %w", err)
+	}
+
+	collection, err := collectionToProto(created)
+	if err != nil {
+		return nil, fmt.Errorf("collection %q: %w", created.Class, err)
+	}
+
+	return &pb.CollectionCreateReply{
+		Took:       float32(time.Since(before).Seconds()),
+		Collection: collection,
+	}, nil
+}
+
+func (s *Service) CollectionUpdate(ctx context.Context, req *pb.CollectionUpdateRequest) (*pb.CollectionUpdateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if req.Collection == "" {
+		return nil, fmt.Errorf("missing collection")
+	}
+
+	class, err := collectionFromProto(req.Config)
+	if err != nil {
+		return nil, fmt.Errorf("parse collection: %w", err)
+	}
+
+	if err := s.schemaManager.UpdateClass(ctx, principal, req.Collection, class); err != nil {
+		return nil, fmt.Errorf("update collection: %w", err)
+	}
+
+	return &pb.CollectionUpdateReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Service) CollectionDelete(ctx context.Context, req *pb.CollectionDeleteRequest) (*pb.CollectionDeleteReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if req.Collection == "" {
+		return nil, fmt.Errorf("missing collection")
+	}
+
+	if err := s.schemaManager.DeleteClass(ctx, principal, req.Collection); err != nil {
+		return nil, fmt.Errorf("delete collection: %w", err)
+	}
+
+	return &pb.CollectionDeleteReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Service) PropertyAdd(ctx context.Context, req *pb.PropertyAddRequest) (*pb.PropertyAddReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if req.Collection == "" {
+		return nil, fmt.Errorf("missing collection")
+	}
+
+	prop, err := propertyFromProto(req.Property)
+	if err != nil {
+		return nil, fmt.Errorf("parse property: %w", err)
+	}
+
+	_, _, err = s.schemaManager.AddClassProperty(ctx, principal,
+		s.schemaManager.ReadOnlyClass(req.Collection), req.Collection, false, prop)
+	if err != nil {
+		return nil, fmt.Errorf("add property: %w", err)
+	}
+
+	property, err := propertyToProto(prop)
+	if err != nil {
+		return nil, fmt.Errorf("property %q: %w", prop.Name, err)
+	}
+
+	return &pb.PropertyAddReply{
+		Took:     float32(time.Since(before).Seconds()),
+		Property: property,
+	}, nil
+}
@@ -0,0 +1,448 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func collectionFromProto(in *pb.Collection) (*models.Class, error) {
+	if in == nil {
+		return nil, fmt.Errorf("missing definition")
+	}
+
+	class := &models.Class{
+		Class:               in.Name,
+		Description:         in.Description,
+		InvertedIndexConfig: invertedIndexConfigFromProto(in.InvertedIndexConfig),
+		ModuleConfig:        configFromStruct(in.ModuleConfig),
+		MultiTenancyConfig:  multiTenancyConfigFromProto(in.MultiTenancyConfig),
+		ObjectTTLConfig:     objectTTLConfigFromProto(in.ObjectTtlConfig),
+		ReplicationConfig:   replicationConfigFromProto(in.ReplicationConfig),
+		ShardingConfig:      shardingConfigFromProto(in.ShardingConfig),
+		VectorIndexConfig:   configFromStruct(in.VectorIndexConfig),
+		VectorIndexType:     in.VectorIndexType,
+		Vectorizer:          in.Vectorizer,
+	}
+
+	for _, prop := range in.Properties {
+		property, err := propertyFromProto(prop)
+		if err != nil {
+			return nil, err
+		}
+		class.Properties = append(class.Properties, property)
+	}
+
+	if len(in.VectorConfig) > 0 {
+		class.VectorConfig = make(map[string]models.VectorConfig, len(in.VectorConfig))
+		for name, cfg := range in.VectorConfig {
+			if cfg == nil {
+				return nil, fmt.Errorf("vector config %q: missing definition", name)
+			}
+			class.VectorConfig[name] = models.VectorConfig{
+				Vectorizer:        configFromStruct(cfg.Vectorizer),
+				VectorIndexType:   cfg.VectorIndexType,
+				VectorIndexConfig: configFromStruct(cfg.VectorIndexConfig),
+			}
+		}
+	}
+
+	return class, nil
+}
+
+func collectionToProto(class *models.Class) (*pb.Collection, error) {
+	out := &pb.Collection{
+		Name:                class.Class,
+		Description:         class.Description,
+		InvertedIndexConfig: invertedIndexConfigToProto(class.InvertedIndexConfig),
+		MultiTenancyConfig:  multiTenancyConfigToProto(class.MultiTenancyConfig),
+		ObjectTtlConfig:     objectTTLConfigToProto(class.ObjectTTLConfig),
+		ReplicationConfig:   replicationConfigToProto(class.ReplicationConfig),
+		VectorIndexType:     class.VectorIndexType,
+		Vectorizer:          class.Vectorizer,
+	}
+
+	var err error
+	if out.ModuleConfig, err = configToStruct(class.ModuleConfig); err != nil {
+		return nil, fmt.Errorf("module config: %w", err)
+	}
+	if out.VectorIndexConfig, err = configToStruct(class.VectorIndexConfig); err != nil {
+		return nil, fmt.Errorf("vector index config: %w", err)
+	}
+	if out.ShardingConfig, err = shardingConfigToProto(class.ShardingConfig); err != nil {
+		return nil, fmt.Errorf("sharding config: %w", err)
+	}
+
+	for _, prop := range class.Properties {
+		property, err := propertyToProto(prop)
+		if err != nil {
+			return nil, err
+		}
+		out.Properties = append(out.Properties, property)
+	}
+
+	if len(class.VectorConfig) > 0 {
+		out.VectorConfig = make(map[string]*pb.VectorConfig, len(class.VectorConfig))
+		for name, cfg := range class.VectorConfig {
+			vectorizer, err := configToStruct(cfg.Vectorizer)
+			if err != nil {
+				return nil, fmt.Errorf("vectorizer of vector config %q: %w", name, err)
+			}
+			indexConfig, err := configToStruct(cfg.VectorIndexConfig)
+			if err != nil {
+				return nil, fmt.Errorf("vector index config of vector config %q: %w", name, err)
+			}
+			out.VectorConfig[name] = &pb.VectorConfig{
+				Vectorizer:        vectorizer,
+				VectorIndexType:   cfg.VectorIndexType,
+				VectorIndexConfig: indexConfig,
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func propertyFromProto(in *pb.Property) (*models.Property, error) {
+	if in == nil {
+		return nil, fmt.Errorf("missing definition")
+	}
+
+	return &models.Property{
+		Name:              in.Name,
+		DataType:          in.DataType,
+		Description:       in.Description,
+		Tokenization:      in.Tokenization,
+		IndexFilterable:   in.IndexFilterable,
+		IndexSearchable:   in.IndexSearchable,
+		IndexRangeFilters: in.IndexRangeFilters,
+		IndexInverted:     in.IndexInverted,
+		NestedProperties:  nestedPropertiesFromProto(in.NestedProperties),
+		ModuleConfig:      configFromStruct(in.ModuleConfig),
+	}, nil
+}
+
+func propertyToProto(prop *models.Property) (*pb.Property, error) {
+	moduleConfig, err := configToStruct(prop.ModuleConfig)
+	if err != nil {
+		return nil, fmt.Errorf("module config of property %q: %w", prop.Name, err)
+	}
+
+	return &pb.Property{
+		Name:              prop.Name,
+		DataType:          prop.DataType,
+		Description:       prop.Description,
+		Tokenization:      prop.Tokenization,
+		IndexFilterable:   prop.IndexFilterable,
+		IndexSearchable:   prop.IndexSearchable,
+		IndexRangeFilters: prop.IndexRangeFilters,
+		IndexInverted:     prop.IndexInverted,
+		NestedProperties:  nestedPropertiesToProto(prop.NestedProperties),
+		ModuleConfig:      moduleConfig,
+	}, nil
+}
+
+func nestedPropertiesFromProto(in []*pb.NestedProperty) []*models.NestedProperty {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]*models.NestedProperty, 0, len(in))
+	for _, prop := range in {
+		if prop == nil {
+			continue
+		}
+		out = append(out, &models.NestedProperty{
+			Name:              prop.Name,
+			DataType:          prop.DataType,
+			Description:       prop.Description,
+			Tokenization:      prop.Tokenization,
+			IndexFilterable:   prop.IndexFilterable,
+			IndexSearchable:   prop.IndexSearchable,
+			IndexRangeFilters: prop.IndexRangeFilters,
+			NestedProperties:  nestedPropertiesFromProto(prop.NestedProperties),
+		})
+	}
+	return out
+}
+
+func nestedPropertiesToProto(in []*models.NestedProperty) []*pb.NestedProperty {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]*pb.NestedProperty, 0, len(in))
+	for _, prop := range in {
+		out = append(out, &pb.NestedProperty{
+			Name:              prop.Name,
+			DataType:          prop.DataType,
+			Description:       prop.Description,
+			Tokenization:      prop.Tokenization,
+			IndexFilterable:   prop.IndexFilterable,
+			IndexSearchable:   prop.IndexSearchable,
+			IndexRangeFilters: prop.IndexRangeFilters,
+			NestedProperties:  nestedPropertiesToProto(prop.NestedProperties),
+		})
+	}
+	return out
+}
+
+func invertedIndexConfigFromProto(in *pb.InvertedIndexConfig) *models.InvertedIndexConfig {
+	if in == nil {
+		return nil
+	}
+
+	out := &models.InvertedIndexConfig{
+		CleanupIntervalSeconds: in.CleanupIntervalSeconds,
+		IndexTimestamps:        in.IndexTimestamps,
+		IndexNullState:         in.IndexNullState,
+		IndexPropertyLength:    in.IndexPropertyLength,
+		IndexTermPositions:     in.IndexTermPositions,
+		UsingBlockMaxWAND:      in.UsingBlockMaxWand,
+	}
+	if in.Bm25 != nil {
+		out.Bm25 = &models.BM25Config{B: in.Bm25.B, K1: in.Bm25.K1}
+	}
+	if in.Stopwords != nil {
+		out.Stopwords = &models.StopwordConfig{
+			Preset:    in.Stopwords.Preset,
+			Additions: in.Stopwords.Additions,
+			Removals:  in.Stopwords.Removals,
+		}
+	}
+	if in.Synonyms != nil {
+		out.Synonyms = &models.SynonymConfig{Sets: make([]*models.SynonymSet, 0, len(in.Synonyms.Sets))}
+		for _, set := range in.Synonyms.Sets {
+			if set == nil {
+				continue
+			}
+			out.Synonyms.Sets = append(out.Synonyms.Sets, &models.SynonymSet{Terms: set.Terms, Weight: set.Weight})
+		}
+	}
+	return out
+}
+
+func invertedIndexConfigToProto(in *models.InvertedIndexConfig) *pb.InvertedIndexConfig {
+	if in == nil {
+		return nil
+	}
+
+	out := &pb.InvertedIndexConfig{
+		CleanupIntervalSeconds: in.CleanupIntervalSeconds,
+		IndexTimestamps:        in.IndexTimestamps,
+		IndexNullState:         in.IndexNullState,
+		IndexPropertyLength:    in.IndexPropertyLength,
+		IndexTermPositions:     in.IndexTermPositions,
+		UsingBlockMaxWand:      in.UsingBlockMaxWAND,
+	}
+	if in.Bm25 != nil {
+		out.Bm25 = &pb.BM25Config{B: in.Bm25.B, K1: in.Bm25.K1}
+	}
+	if in.Stopwords != nil {
+		out.Stopwords = &pb.StopwordConfig{
+			Preset:    in.Stopwords.Preset,
+			Additions: in.Stopwords.Additions,
+			Removals:  in.Stopwords.Removals,
+		}
+	}
+	if in.Synonyms != nil {
+		out.Synonyms = &pb.SynonymConfig{Sets: make([]*pb.SynonymConfig_Set, 0, len(in.Synonyms.Sets))}
+		for _, set := range in.Synonyms.Sets {
+			out.Synonyms.Sets = append(out.Synonyms.Sets, &pb.SynonymConfig_Set{Terms: set.Terms, Weight: set.Weight})
+		}
+	}
+	return out
+}
+
+func multiTenancyConfigFromProto(in *pb.MultiTenancyConfig) *models.MultiTenancyConfig {
+	if in == nil {
+		return nil
+	}
+
+	out := &models.MultiTenancyConfig{
+		Enabled:              in.Enabled,
+		AutoTenantCreation:   in.AutoTenantCreation,
+		AutoTenantActivation: in.AutoTenantActivation,
+	}
+	if in.TenantQuota != nil {
+		out.TenantQuota = &models.TenantQuota{
+			MaxObjectCount: in.TenantQuota.MaxObjectCount,
+			MaxDiskBytes:   in.TenantQuota.MaxDiskBytes,
+		}
+	}
+	return out
+}
+
+func multiTenancyConfigToProto(in *models.MultiTenancyConfig) *pb.MultiTenancyConfig {
+	if in == nil {
+		return nil
+	}
+
+	out := &pb.MultiTenancyConfig{
+		Enabled:              in.Enabled,
+		AutoTenantCreation:   in.AutoTenantCreation,
+		AutoTenantActivation: in.AutoTenantActivation,
+	}
+	if in.TenantQuota != nil {
+		out.TenantQuota = &pb.TenantQuota{
+			MaxObjectCount: in.TenantQuota.MaxObjectCount,
+			MaxDiskBytes:   in.TenantQuota.MaxDiskBytes,
+		}
+	}
+	return out
+}
+
+func replicationConfigFromProto(in *pb.ReplicationConfig) *models.ReplicationConfig {
+	if in == nil {
+		return nil
+	}
+	return &models.ReplicationConfig{
+		Factor:           in.Factor,
+		AsyncEnabled:     in.AsyncEnabled,
+		DeletionStrategy: in.DeletionStrategy,
+	}
+}
+
+func replicationConfigToProto(in *models.ReplicationConfig) *pb.ReplicationConfig {
+	if in == nil {
+		return nil
+	}
+	return &pb.ReplicationConfig{
+		Factor:           in.Factor,
+		AsyncEnabled:     in.AsyncEnabled,
+		DeletionStrategy: in.DeletionStrategy,
+	}
+}
+
+func objectTTLConfigFromProto(in *pb.ObjectTTLConfig) *models.ObjectTTLConfig {
+	if in == nil {
+		return nil
+	}
+	return &models.ObjectTTLConfig{
+		Enabled:    in.Enabled,
+		DefaultTTL: in.DefaultTtl,
+		DeleteOn:   in.DeleteOn,
+	}
+}
+
+func objectTTLConfigToProto(in *models.ObjectTTLConfig) *pb.ObjectTTLConfig {
+	if in == nil {
+		return nil
+	}
+	return &pb.ObjectTTLConfig{
+		Enabled:    in.Enabled,
+		DefaultTtl: in.DefaultTTL,
+		DeleteOn:   in.DeleteOn,
+	}
+}
+
+// shardingConfigFromProto returns the sharding config in the shape the REST
+// endpoints decode it to, leaving out unset fields so that they default
+func shardingConfigFromProto(in *pb.ShardingConfig) interface{} {
+	if in == nil {
+		return nil
+	}
+
+	out := map[string]interface{}{}
+	for name, value := range map[string]*int64{
+		"virtualPerPhysical":  in.VirtualPerPhysical,
+		"desiredCount":        in.DesiredCount,
+		"actualCount":         in.ActualCount,
+		"desiredVirtualCount": in.DesiredVirtualCount,
+		"actualVirtualCount":  in.ActualVirtualCount,
+	} {
+		if value != nil {
+			out[name] = float64(*value)
+		}
+	}
+	for name, value := range map[string]*string{
+		"key":      in.Key,
+		"strategy": in.Strategy,
+		"function": in.Function,
+	} {
+		if value != nil {
+			out[name] = *value
+		}
+	}
+	return out
+}
+
+func shardingConfigToProto(in interface{}) (*pb.ShardingConfig, error) {
+	var cfg shardingConfig.Config
+	switch typed := in.(type) {
+	case nil:
+		return nil, nil
+	case shardingConfig.Config:
+		cfg = typed
+	default:
+		asJSON, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(asJSON, &cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	ptr := func(v int) *int64 {
+		asInt64 := int64(v)
+		return &asInt64
+	}
+	return &pb.ShardingConfig{
+		VirtualPerPhysical:  ptr(cfg.VirtualPerPhysical),
+		DesiredCount:        ptr(cfg.DesiredCount),
+		ActualCount:         ptr(cfg.ActualCount),
+		DesiredVirtualCount: ptr(cfg.DesiredVirtualCount),
+		ActualVirtualCount:  ptr(cfg.ActualVirtualCount),
+		Key:                 &cfg.Key,
+		Strategy:            &cfg.Strategy,
+		Function:            &cfg.Function,
+	}, nil
+}
+
+// configFromStruct returns a module or vector index config in the shape the
+// REST endpoints decode it to
+func configFromStruct(in *structpb.Struct) interface{} {
+	if in == nil {
+		return nil
+	}
+	return in.AsMap()
+}
+
+// configToStruct is the inverse of configFromStruct. Configs may be held as
+// typed structs (e.g. hnsw.UserConfig) and are converted through their JSON
+// representation.
+func configToStruct(in interface{}) (*structpb.Struct, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	asJSON, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	if string(asJSON) == "null" {
+		return nil, nil
+	}
+
+	out := &structpb.Struct{}
+	if err := out.UnmarshalJSON(asJSON); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
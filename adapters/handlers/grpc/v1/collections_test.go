@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestCollectionConversion(t *testing.T) {
+	vectorIndexConfig, err := structpb.NewStruct(map[string]interface{}{
+		"efConstruction": 64,
+	})
+	require.Nil(t, err)
+
+	virtualPerPhysical := int64(64)
+	in := &pb.Collection{
+		Name:       "Article",
+		Vectorizer: "none",
+		Properties: []*pb.Property{{
+			Name:            "title",
+			DataType:        []string{"text"},
+			IndexFilterable: func() *bool { b := true; return &b }(),
+			NestedProperties: []*pb.NestedProperty{{
+				Name:     "subtitle",
+				DataType: []string{"text"},
+			}},
+		}},
+		InvertedIndexConfig: &pb.InvertedIndexConfig{
+			Bm25:     &pb.BM25Config{B: 0.75, K1: 1.2},
+			Synonyms: &pb.SynonymConfig{Sets: []*pb.SynonymConfig_Set{{Terms: []string{"car", "auto"}, Weight: 0.5}}},
+		},
+		MultiTenancyConfig: &pb.MultiTenancyConfig{
+			Enabled:     true,
+			TenantQuota: &pb.TenantQuota{MaxObjectCount: 10},
+		},
+		ShardingConfig:    &pb.ShardingConfig{VirtualPerPhysical: &virtualPerPhysical},
+		VectorIndexConfig: vectorIndexConfig,
+		VectorConfig: map[string]*pb.VectorConfig{
+			"title": {VectorIndexType: "hnsw", VectorIndexConfig: vectorIndexConfig},
+		},
+	}
+
+	class, err := collectionFromProto(in)
+	require.Nil(t, err)
+	require.Equal(t, "Article", class.Class)
+	require.Equal(t, "none", class.Vectorizer)
+	require.Len(t, class.Properties, 1)
+	require.Equal(t, "title", class.Properties[0].Name)
+	require.Equal(t, []string{"text"}, class.Properties[0].DataType)
+	require.NotNil(t, class.Properties[0].IndexFilterable)
+	require.True(t, *class.Properties[0].IndexFilterable)
+	require.Equal(t, "subtitle", class.Properties[0].NestedProperties[0].Name)
+	require.Equal(t, float32(1.2), class.InvertedIndexConfig.Bm25.K1)
+	require.Equal(t, []string{"car", "auto"}, class.InvertedIndexConfig.Synonyms.Sets[0].Terms)
+	require.True(t, class.MultiTenancyConfig.Enabled)
+	require.Equal(t, int64(10), class.MultiTenancyConfig.TenantQuota.MaxObjectCount)
+	require.Nil(t, class.ReplicationConfig)
+	require.Equal(t, map[string]interface{}{"virtualPerPhysical": float64(64)}, class.ShardingConfig)
+	require.Equal(t, map[string]interface{}{"efConstruction": float64(64)}, class.VectorIndexConfig)
+	require.Equal(t, "hnsw", class.VectorConfig["title"].VectorIndexType)
+
+	// the schema holds the sharding config parsed to its typed struct
+	class.ShardingConfig = shardingConfig.Config{VirtualPerPhysical: 64, DesiredCount: 1, Key: "_id"}
+
+	out, err := collectionToProto(class)
+	require.Nil(t, err)
+	require.Equal(t, "Article", out.Name)
+	require.Equal(t, "title", out.Properties[0].Name)
+	require.Equal(t, "subtitle", out.Properties[0].NestedProperties[0].Name)
+	require.Equal(t, float32(0.5), out.InvertedIndexConfig.Synonyms.Sets[0].Weight)
+	require.Equal(t, int64(10), out.MultiTenancyConfig.TenantQuota.MaxObjectCount)
+	require.Equal(t, int64(64), out.ShardingConfig.GetVirtualPerPhysical())
+	require.Equal(t, int64(1), out.ShardingConfig.GetDesiredCount())
+	require.Equal(t, "_id", out.ShardingConfig.GetKey())
+	require.Equal(t, float64(64), out.VectorIndexConfig.Fields["efConstruction"].GetNumberValue())
+	require.Equal(t, float64(64), out.VectorConfig["title"].VectorIndexConfig.Fields["efConstruction"].GetNumberValue())
+
+	t.Run("typed vector index config", func(t *testing.T) {
+		out, err := collectionToProto(&models.Class{
+			Class:             "Article",
+			VectorIndexConfig: struct{ EF int }{EF: 100},
+		})
+		require.Nil(t, err)
+		require.Equal(t, float64(100), out.VectorIndexConfig.Fields["EF"].GetNumberValue())
+	})
+
+	t.Run("missing definition", func(t *testing.T) {
+		_, err := collectionFromProto(nil)
+		require.NotNil(t, err)
+		_, err = propertyFromProto(nil)
+		require.NotNil(t, err)
+	})
+
+	t.Run("missing vector config", func(t *testing.T) {
+		_, err := collectionFromProto(&pb.Collection{
+			Name:         "Article",
+			VectorConfig: map[string]*pb.VectorConfig{"title": nil},
+		})
+		require.NotNil(t, err)
+	})
+}
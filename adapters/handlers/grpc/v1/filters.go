@@ -22,11 +22,19 @@ import (
 
 func ExtractFilters(filterIn *pb.Filters, authorizedGetClass classGetterWithAuthzFunc, className, tenant string) (filters.Clause, error) {
 	returnFilter := filters.Clause{}
-	if filterIn.Operator == pb.Filters_OPERATOR_AND || filterIn.Operator == pb.Filters_OPERATOR_OR {
-		if filterIn.Operator == pb.Filters_OPERATOR_AND {
+	if filterIn.Operator == pb.Filters_OPERATOR_AND || filterIn.Operator == pb.Filters_OPERATOR_OR ||
+		filterIn.Operator == pb.Filters_OPERATOR_NOT {
+		switch filterIn.Operator {
+		case pb.Filters_OPERATOR_AND:
 			returnFilter.Operator = filters.OperatorAnd
-		} else {
+		case pb.Filters_OPERATOR_OR:
 			returnFilter.Operator = filters.OperatorOr
+		default:
+			if len(filterIn.Filters) != 1 {
+				return filters.Clause{}, fmt.Errorf(
+					"operator not requires exactly one filter, got %d", len(filterIn.Filters))
+			}
+			returnFilter.Operator = filters.OperatorNot
 		}
 
 		clauses := make([]filters.Clause, len(filterIn.Filters))
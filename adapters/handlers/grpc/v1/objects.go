@@ -0,0 +1,421 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	restCtx "github.com/weaviate/weaviate/adapters/handlers/rest/context"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// writeStatus maps writes rejected by the tenant quota to
+// codes.ResourceExhausted, other errors are returned unchanged
+func writeStatus(err error) error {
+	if enterrors.IsTenantQuotaExceeded(err) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return err
+}
+
+func (s *Service) ObjectGet(ctx context.Context, req *pb.ObjectGetRequest) (*pb.ObjectGetReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	id, err := parseObjectID(req.Collection, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := s.objectsManager.GetObject(ctx, principal, req.Collection, id,
+		additional.Properties{Vector: req.IncludeVector},
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant())
+	if err != nil {
+		return nil, fmt.Errorf("get object: %w", err)
+	}
+
+	class := s.schemaManager.ReadOnlyClass(obj.Class)
+	if class == nil {
+		return nil, fmt.Errorf("could not find class %s in schema", obj.Class)
+	}
+
+	result, err := objectToProto(&Mapper{uses125: true}, class, obj)
+	if err != nil {
+		return nil, fmt.Errorf("prepare reply: %w", err)
+	}
+
+	return &pb.ObjectGetReply{
+		Took:   float32(time.Since(before).Seconds()),
+		Object: result,
+	}, nil
+}
+
+func (s *Service) ObjectExists(ctx context.Context, req *pb.ObjectExistsRequest) (*pb.ObjectExistsReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	id, err := parseObjectID(req.Collection, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, objErr := s.objectsManager.HeadObject(ctx, principal, req.Collection, id,
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant())
+	if objErr != nil {
+		return nil, fmt.Errorf("check object: %w", objErr)
+	}
+
+	return &pb.ObjectExistsReply{
+		Took:   float32(time.Since(before).Seconds()),
+		Exists: exists,
+	}, nil
+}
+
+func (s *Service) ObjectInsert(ctx context.Context, req *pb.ObjectInsertRequest) (*pb.ObjectInsertReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if req.Object != nil && req.Object.Uuid == "" {
+		// the uuid is optional for inserts
+		req.Object.Uuid = uuid.NewString()
+	}
+	object, err := s.objectFromProto(req.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.objectsManager.AddObject(ctx, principal, object,
+		extractReplicationProperties(req.ConsistencyLevel))
+	if err != nil {
+		return nil, writeStatus(fmt.Errorf("insert object: %w", err))
+	}
+
+	return &pb.ObjectInsertReply{
+		Took: float32(time.Since(before).Seconds()),
+		Uuid: created.ID.String(),
+	}, nil
+}
+
+func (s *Service) ObjectReplace(ctx context.Context, req *pb.ObjectReplaceRequest) (*pb.ObjectReplaceReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	object, err := s.objectFromProto(req.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.objectsManager.UpdateObject(ctx, principal, object.Class, object.ID, object,
+		extractReplicationProperties(req.ConsistencyLevel))
+	if err != nil {
+		return nil, writeStatus(fmt.Errorf("replace object: %w", err))
+	}
+
+	return &pb.ObjectReplaceReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Service) ObjectMerge(ctx context.Context, req *pb.ObjectMergeRequest) (*pb.ObjectMergeReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	object, err := s.objectFromProto(req.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	if objErr := s.objectsManager.MergeObject(ctx, principal, object,
+		extractReplicationProperties(req.ConsistencyLevel)); objErr != nil {
+		return nil, writeStatus(fmt.Errorf("merge object: %w", objErr))
+	}
+
+	return &pb.ObjectMergeReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Service) ObjectDelete(ctx context.Context, req *pb.ObjectDeleteRequest) (*pb.ObjectDeleteReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	id, err := parseObjectID(req.Collection, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.objectsManager.DeleteObject(ctx, principal, req.Collection, id,
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant())
+	if err != nil {
+		return nil, fmt.Errorf("delete object: %w", err)
+	}
+
+	return &pb.ObjectDeleteReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Service) ReferenceAdd(ctx context.Context, req *pb.ReferenceAddRequest) (*pb.ReferenceAddReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	id, err := parseObjectID(req.Collection, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := referenceFromProto(req.PropName, req.GetTargetCollection(), req.TargetUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &objects.AddReferenceInput{
+		Class:    req.Collection,
+		ID:       id,
+		Property: req.PropName,
+		Ref:      *ref,
+	}
+	if objErr := s.objectsManager.AddObjectReference(ctx, principal, input,
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant()); objErr != nil {
+		return nil, writeStatus(fmt.Errorf("add reference: %w", objErr))
+	}
+
+	return &pb.ReferenceAddReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+func (s *Service) ReferenceDelete(ctx context.Context, req *pb.ReferenceDeleteRequest) (*pb.ReferenceDeleteReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	id, err := parseObjectID(req.Collection, req.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	ref, err := referenceFromProto(req.PropName, req.GetTargetCollection(), req.TargetUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &objects.DeleteReferenceInput{
+		Class:     req.Collection,
+		ID:        id,
+		Property:  req.PropName,
+		Reference: *ref,
+	}
+	if objErr := s.objectsManager.DeleteObjectReference(ctx, principal, input,
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant()); objErr != nil {
+		return nil, fmt.Errorf("delete reference: %w", objErr)
+	}
+
+	return &pb.ReferenceDeleteReply{
+		Took: float32(time.Since(before).Seconds()),
+	}, nil
+}
+
+// objectFromProto converts the object of a write request. The class is only
+// used to resolve reference targets, authorization is left to the objects
+// manager.
+func (s *Service) objectFromProto(obj *pb.BatchObject) (*models.Object, error) {
+	if obj == nil {
+		return nil, fmt.Errorf("missing object")
+	}
+	if obj.Collection == "" {
+		return nil, fmt.Errorf("missing collection")
+	}
+
+	obj.Collection = schema.UppercaseClassName(obj.Collection)
+	object, err := objectFromProto(obj, s.schemaManager.ReadOnlyClass(obj.Collection))
+	if err != nil {
+		return nil, fmt.Errorf("parse object: %w", err)
+	}
+
+	return object, nil
+}
+
+func parseObjectID(collection, id string) (strfmt.UUID, error) {
+	if collection == "" {
+		return "", fmt.Errorf("missing collection")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return "", fmt.Errorf("invalid uuid %q: %w", id, err)
+	}
+	return strfmt.UUID(id), nil
+}
+
+func referenceFromProto(propName, targetCollection, targetID string) (*models.SingleRef, error) {
+	if propName == "" {
+		return nil, fmt.Errorf("missing reference property")
+	}
+	if _, err := uuid.Parse(targetID); err != nil {
+		return nil, fmt.Errorf("invalid target uuid %q: %w", targetID, err)
+	}
+
+	return crossref.NewLocalhost(schema.UppercaseClassName(targetCollection),
+		strfmt.UUID(targetID)).SingleRef(), nil
+}
+
+func objectToProto(m *Mapper, class *models.Class, obj *models.Object) (*pb.ObjectResult, error) {
+	result := &pb.ObjectResult{
+		Collection:         obj.Class,
+		Uuid:               obj.ID.String(),
+		Tenant:             obj.Tenant,
+		CreationTimeUnix:   obj.CreationTimeUnix,
+		LastUpdateTimeUnix: obj.LastUpdateTimeUnix,
+	}
+
+	props, _ := obj.Properties.(map[string]interface{})
+	nonRefProps := &pb.Properties{Fields: make(map[string]*pb.Value, len(props))}
+	for _, prop := range class.Properties {
+		propRaw, ok := props[prop.Name]
+		if !ok {
+			continue
+		}
+
+		dataType, err := schema.GetPropertyDataType(class, prop.Name)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting property datatype")
+		}
+
+		switch *dataType {
+		case schema.DataTypeCRef:
+			refs, err := referencesToProto(prop.Name, propRaw)
+			if err != nil {
+				return nil, err
+			}
+			result.References = append(result.References, refs)
+		case schema.DataTypeObject, schema.DataTypeObjectArray:
+			nestedProps, err := getAllNonRefNonBlobNestedProperties(&Property{Property: prop})
+			if err != nil {
+				return nil, err
+			}
+			selectProp := search.SelectProperty{Name: prop.Name, IsObject: true, Props: nestedProps}
+			value, err := m.NewNestedValue(propRaw, *dataType, &Property{Property: prop}, selectProp)
+			if err != nil {
+				return nil, errors.Wrapf(err, "creating object value for %v", prop.Name)
+			}
+			nonRefProps.Fields[prop.Name] = value
+		default:
+			value, err := m.NewPrimitiveValue(propRaw, *dataType)
+			if err != nil {
+				return nil, errors.Wrapf(err, "creating primitive value for %v", prop.Name)
+			}
+			nonRefProps.Fields[prop.Name] = value
+		}
+	}
+	if len(nonRefProps.Fields) != 0 {
+		result.Properties = nonRefProps
+	}
+
+	if len(obj.Vector) > 0 {
+		result.VectorBytes = byteops.Fp32SliceToBytes(obj.Vector)
+	}
+	for name, vec := range obj.Vectors {
+		switch v := vec.(type) {
+		case []float32:
+			result.Vectors = append(result.Vectors, &pb.Vectors{
+				Name:        name,
+				VectorBytes: byteops.Fp32SliceToBytes(v),
+				Type:        pb.Vectors_VECTOR_TYPE_SINGLE_FP32,
+			})
+		case [][]float32:
+			result.Vectors = append(result.Vectors, &pb.Vectors{
+				Name:        name,
+				VectorBytes: byteops.Fp32SliceOfSlicesToBytes(v),
+				Type:        pb.Vectors_VECTOR_TYPE_MULTI_FP32,
+			})
+		default:
+			return nil, fmt.Errorf("vector %q: unsupported type %T", name, vec)
+		}
+	}
+
+	return result, nil
+}
+
+func referencesToProto(propName string, propRaw interface{}) (*pb.ObjectResult_References, error) {
+	refs, ok := propRaw.(models.MultipleRef)
+	if !ok {
+		return nil, fmt.Errorf("reference property %s: unexpected type %T", propName, propRaw)
+	}
+
+	out := &pb.ObjectResult_References{
+		PropName: propName,
+		Targets:  make([]*pb.ObjectResult_Reference, 0, len(refs)),
+	}
+	for _, ref := range refs {
+		parsed, err := crossref.ParseSingleRef(ref)
+		if err != nil {
+			return nil, fmt.Errorf("reference property %s: %w", propName, err)
+		}
+		out.Targets = append(out.Targets, &pb.ObjectResult_Reference{
+			Collection: parsed.Class,
+			Uuid:       parsed.TargetID.String(),
+		})
+	}
+	return out, nil
+}
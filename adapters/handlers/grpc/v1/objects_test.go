@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+)
+
+func TestObjectToProto(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString()},
+			{Name: "count", DataType: schema.DataTypeInt.PropString()},
+			{Name: "tags", DataType: schema.DataTypeTextArray.PropString()},
+			{
+				Name:     "meta",
+				DataType: schema.DataTypeObject.PropString(),
+				NestedProperties: []*models.NestedProperty{
+					{Name: "author", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+			{Name: "hasAuthor", DataType: []string{"Author"}},
+			{Name: "missing", DataType: schema.DataTypeText.PropString()},
+		},
+	}
+
+	obj := &models.Object{
+		Class:              "Article",
+		ID:                 "73f2eb5f-5abf-447a-81ca-74b1dd168247",
+		CreationTimeUnix:   1,
+		LastUpdateTimeUnix: 2,
+		Properties: map[string]interface{}{
+			"title": "hello",
+			"count": float64(3),
+			"tags":  []string{"a", "b"},
+			"meta":  map[string]interface{}{"author": "me"},
+			"hasAuthor": models.MultipleRef{
+				crossref.NewLocalhost("Author", "ea3f9f43-8c8b-4f6d-9d1b-4f2c3fb6a2f7").SingleRef(),
+			},
+		},
+		Vector:  []float32{1, 2, 3},
+		Vectors: models.Vectors{"colbert": [][]float32{{1, 2}, {3, 4}}},
+	}
+
+	result, err := objectToProto(&Mapper{uses125: true}, class, obj)
+	require.Nil(t, err)
+
+	require.Equal(t, "Article", result.Collection)
+	require.Equal(t, "73f2eb5f-5abf-447a-81ca-74b1dd168247", result.Uuid)
+	require.Equal(t, int64(1), result.CreationTimeUnix)
+	require.Equal(t, int64(2), result.LastUpdateTimeUnix)
+
+	fields := result.Properties.Fields
+	require.Len(t, fields, 4)
+	require.Equal(t, "hello", fields["title"].GetTextValue())
+	require.Equal(t, int64(3), fields["count"].GetIntValue())
+	require.Equal(t, []string{"a", "b"}, fields["tags"].GetListValue().GetTextValues().GetValues())
+	require.Equal(t, "me", fields["meta"].GetObjectValue().Fields["author"].GetTextValue())
+
+	require.Len(t, result.References, 1)
+	require.Equal(t, "hasAuthor", result.References[0].PropName)
+	require.Equal(t, []*pb.ObjectResult_Reference{{
+		Collection: "Author",
+		Uuid:       "ea3f9f43-8c8b-4f6d-9d1b-4f2c3fb6a2f7",
+	}}, result.References[0].Targets)
+
+	require.Equal(t, []float32{1, 2, 3}, byteops.Fp32SliceFromBytes(result.VectorBytes))
+	require.Len(t, result.Vectors, 1)
+	require.Equal(t, "colbert", result.Vectors[0].Name)
+	require.Equal(t, pb.Vectors_VECTOR_TYPE_MULTI_FP32, result.Vectors[0].Type)
+	multi, err := byteops.Fp32SliceOfSlicesFromBytes(result.Vectors[0].VectorBytes)
+	require.Nil(t, err)
+	require.Equal(t, [][]float32{{1, 2}, {3, 4}}, multi)
+}
+
+func TestReferenceFromProto(t *testing.T) {
+	t.Run("with target collection", func(t *testing.T) {
+		ref, err := referenceFromProto("hasAuthor", "author", "ea3f9f43-8c8b-4f6d-9d1b-4f2c3fb6a2f7")
+		require.Nil(t, err)
+		require.Equal(t, "weaviate://localhost/Author/ea3f9f43-8c8b-4f6d-9d1b-4f2c3fb6a2f7", ref.Beacon.String())
+	})
+
+	t.Run("without target collection", func(t *testing.T) {
+		ref, err := referenceFromProto("hasAuthor", "", "ea3f9f43-8c8b-4f6d-9d1b-4f2c3fb6a2f7")
+		require.Nil(t, err)
+		require.Equal(t, "weaviate://localhost/ea3f9f43-8c8b-4f6d-9d1b-4f2c3fb6a2f7", ref.Beacon.String())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := referenceFromProto("", "Author", "ea3f9f43-8c8b-4f6d-9d1b-4f2c3fb6a2f7")
+		require.NotNil(t, err)
+		_, err = referenceFromProto("hasAuthor", "Author", "not-a-uuid")
+		require.NotNil(t, err)
+	})
+}
+
+func TestParseObjectID(t *testing.T) {
+	id, err := parseObjectID("Article", "73f2eb5f-5abf-447a-81ca-74b1dd168247")
+	require.Nil(t, err)
+	require.Equal(t, "73f2eb5f-5abf-447a-81ca-74b1dd168247", id.String())
+
+	_, err = parseObjectID("", "73f2eb5f-5abf-447a-81ca-74b1dd168247")
+	require.NotNil(t, err)
+	_, err = parseObjectID("Article", "")
+	require.NotNil(t, err)
+}
@@ -99,12 +99,21 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 	if err != nil {
 		return dto.GetParams{}, errors.Wrap(err, "extract properties request")
 	}
+	// highlights are computed from the returned property values
+	if highlight := out.AdditionalProperties.Highlight; highlight != nil {
+		for _, name := range highlight.Properties {
+			if out.Properties.FindProperty(name) == nil {
+				out.Properties = append(out.Properties, search.SelectProperty{Name: name, IsPrimitive: true})
+			}
+		}
+	}
 	if len(out.Properties) == 0 {
 		out.AdditionalProperties.NoProps = true
 	}
 
 	if bm25 := req.Bm25Search; bm25 != nil {
-		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: schema.LowercaseFirstLetterOfStrings(bm25.Properties), Type: "bm25", AdditionalExplanations: out.AdditionalProperties.ExplainScore}
+		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: schema.LowercaseFirstLetterOfStrings(bm25.Properties), Type: "bm25", AdditionalExplanations: out.AdditionalProperties.ExplainScore, Phrase: bm25.Phrase, Slop: int(bm25.Slop), MaxEdits: int(bm25.MaxEdits), MaxExpansions: int(bm25.MaxExpansions)}
+		out.KeywordRanking.SearchOperator, out.KeywordRanking.MinimumShouldMatch = extractSearchOperator(bm25.SearchOperator)
 	}
 
 	if nv := req.NearVector; nv != nil {
@@ -288,7 +297,10 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 			TargetVectors:   targetVectors,
 			Distance:        distance,
 			WithDistance:    withDistance,
+			MaxEdits:        int(hs.MaxEdits),
+			MaxExpansions:   int(hs.MaxExpansions),
 		}
+		out.HybridSearch.SearchOperator, out.HybridSearch.MinimumShouldMatch = extractSearchOperator(hs.Bm25SearchOperator)
 
 		if nearVec != nil {
 			out.HybridSearch.NearVectorParams, out.TargetVectorCombination, err = parseNearVec(nearVec, targetVectors, class, out.TargetVectorCombination)
@@ -820,6 +832,16 @@ func extractAdditionalPropsFromMetadata(class *models.Class, prop *pb.MetadataRe
 		Vectors:            prop.Vectors,
 	}
 
+	if prop.Highlight != nil {
+		props.Highlight = &additional.HighlightParams{
+			Properties:   schema.LowercaseFirstLetterOfStrings(prop.Highlight.Properties),
+			FragmentSize: int(prop.Highlight.FragmentSize),
+			Fragments:    int(prop.Highlight.Fragments),
+			PreTag:       prop.Highlight.PreTag,
+			PostTag:      prop.Highlight.PostTag,
+		}
+	}
+
 	if vectorSearch && configvalidation.CheckCertaintyCompatibility(class, targetVectors) != nil {
 		props.Certainty = false
 	} else {
@@ -838,6 +860,20 @@ func extractAdditionalPropsFromMetadata(class *models.Class, prop *pb.MetadataRe
 	return props, nil
 }
 
+func extractSearchOperator(options *pb.SearchOperatorOptions) (string, string) {
+	if options == nil {
+		return "", ""
+	}
+	var operator string
+	switch options.Operator {
+	case pb.SearchOperatorOptions_OPERATOR_AND:
+		operator = searchparams.SearchOperatorAnd
+	case pb.SearchOperatorOptions_OPERATOR_OR:
+		operator = searchparams.SearchOperatorOr
+	}
+	return operator, options.GetMinimumShouldMatch()
+}
+
 func isIdOnlyRequest(metadata *pb.MetadataRequest) bool {
 	// could also use reflect here but this is more explicit
 	return (metadata != nil &&
@@ -849,7 +885,8 @@ func isIdOnlyRequest(metadata *pb.MetadataRequest) bool {
 		!metadata.Certainty &&
 		!metadata.Score &&
 		!metadata.ExplainScore &&
-		!metadata.IsConsistent)
+		!metadata.IsConsistent &&
+		metadata.Highlight == nil)
 }
 
 func getAllNonRefNonBlobProperties(authorizedGetClass classGetterWithAuthzFunc, className string) ([]search.SelectProperty, error) {
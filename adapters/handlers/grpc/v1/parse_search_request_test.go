@@ -268,6 +268,7 @@ func TestGRPCSearchRequest(t *testing.T) {
 	quorum := pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM
 	someString1 := "a word"
 	someString2 := "other"
+	minimumShouldMatch := "75%"
 
 	tests := []struct {
 		name  string
@@ -888,6 +889,19 @@ func TestGRPCSearchRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "hybrid fuzzy",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true, Certainty: false},
+				HybridSearch: &pb.Hybrid{Query: "qurey", FusionType: pb.Hybrid_FUSION_TYPE_RANKED, Alpha: 0.75, MaxEdits: 1, MaxExpansions: 10},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination, HybridSearch: &searchparams.HybridSearch{Query: "qurey", FusionAlgorithm: common_filters.HybridRankedFusion, Alpha: 0.75, MaxEdits: 1, MaxExpansions: 10},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
 		{
 			name: "hybrid ranked groupby",
 			req: &pb.SearchRequest{
@@ -957,6 +971,59 @@ func TestGRPCSearchRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "bm25 fuzzy",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{Query: "qurey", MaxEdits: 2, MaxExpansions: 5},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking:       &searchparams.KeywordRanking{Query: "qurey", Type: "bm25", MaxEdits: 2, MaxExpansions: 5},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
+		{
+			name: "bm25 search operator",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{Query: "query", SearchOperator: &pb.SearchOperatorOptions{
+					Operator: pb.SearchOperatorOptions_OPERATOR_OR, MinimumShouldMatch: &minimumShouldMatch,
+				}},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking: &searchparams.KeywordRanking{
+					Query: "query", Type: "bm25",
+					SearchOperator: searchparams.SearchOperatorOr, MinimumShouldMatch: "75%",
+				},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
+		{
+			name: "bm25 highlight",
+			req: &pb.SearchRequest{
+				Collection: classname,
+				Metadata: &pb.MetadataRequest{Highlight: &pb.HighlightRequest{
+					Properties: []string{"name"}, FragmentSize: 50, Fragments: 2, PreTag: "[", PostTag: "]",
+				}},
+				Properties: &pb.PropertiesRequest{NonRefProperties: []string{"number"}},
+				Bm25Search: &pb.BM25{Query: "query"},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking: &searchparams.KeywordRanking{Query: "query", Type: "bm25"},
+				Properties:     search.SelectProperties{{Name: "number", IsPrimitive: true}, {Name: "name", IsPrimitive: true}},
+				AdditionalProperties: additional.Properties{Highlight: &additional.HighlightParams{
+					Properties: []string{"name"}, FragmentSize: 50, Fragments: 2, PreTag: "[", PostTag: "]",
+				}},
+			},
+			error: false,
+		},
 		{
 			name: "bm25 groupby",
 			req: &pb.SearchRequest{
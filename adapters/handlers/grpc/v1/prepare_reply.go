@@ -328,6 +328,25 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 		}
 	}
 
+	if additionalPropsParams.Highlight != nil {
+		highlights, ok := additionalPropertiesMap["highlight"].([]*additional.Highlight)
+		if ok {
+			addProps.Metadata.Highlights = make([]*pb.Highlight, len(highlights))
+			for i, highlight := range highlights {
+				matches := make([]*pb.Highlight_Match, len(highlight.Matches))
+				for j, match := range highlight.Matches {
+					matches[j] = &pb.Highlight_Match{Term: match.Term, Start: uint32(match.Start), End: uint32(match.End)}
+				}
+				addProps.Metadata.Highlights[i] = &pb.Highlight{
+					Property:  highlight.Property,
+					Index:     uint32(highlight.Index),
+					Matches:   matches,
+					Fragments: highlight.Fragments,
+				}
+			}
+		}
+	}
+
 	if additionalPropsParams.Score {
 		addProps.Metadata.ScorePresent = false
 		score, ok := additionalPropertiesMap["score"]
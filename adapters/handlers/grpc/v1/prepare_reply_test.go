@@ -315,6 +315,30 @@ func TestGRPCReply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "highlight",
+			res: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"highlight": []*additional.Highlight{{
+							Property:  "text",
+							Index:     1,
+							Matches:   []additional.HighlightMatch{{Term: "car", Start: 4, End: 7}},
+							Fragments: []string{"The <em>car</em> is fast"},
+						}},
+					},
+				},
+			},
+			searchParams: dto.GetParams{AdditionalProperties: additional.Properties{Highlight: &additional.HighlightParams{}}},
+			outSearch: []*pb.SearchResult{
+				{Metadata: &pb.MetadataResult{Highlights: []*pb.Highlight{{
+					Property:  "text",
+					Index:     1,
+					Matches:   []*pb.Highlight_Match{{Term: "car", Start: 4, End: 7}},
+					Fragments: []string{"The <em>car</em> is fast"},
+				}}}, Properties: &pb.PropertiesResult{}},
+			},
+		},
 		{
 			name: "primitive properties",
 			res: []interface{}{
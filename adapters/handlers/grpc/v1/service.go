@@ -46,6 +46,10 @@ type Service struct {
 	allowAnonymousAccess bool
 	schemaManager        *schemaManager.Manager
 	batchManager         *objects.BatchManager
+	objectsManager       *objects.Manager
+	changeLog            changeLogReader
+	queueBacklog         vectorIndexQueueBacklogReader
+	memoryPressure       memoryPressureReader
 	config               *config.Config
 	authorizer           authorization.Authorizer
 	logger               logrus.FieldLogger
@@ -53,8 +57,10 @@ type Service struct {
 
 func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 	allowAnonymousAccess bool, schemaManager *schemaManager.Manager,
-	batchManager *objects.BatchManager, config *config.Config, authorization authorization.Authorizer,
-	logger logrus.FieldLogger,
+	batchManager *objects.BatchManager, objectsManager *objects.Manager,
+	changeLog changeLogReader, queueBacklog vectorIndexQueueBacklogReader,
+	memoryPressure memoryPressureReader, config *config.Config,
+	authorization authorization.Authorizer, logger logrus.FieldLogger,
 ) *Service {
 	return &Service{
 		traverser:            traverser,
@@ -62,6 +68,10 @@ func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 		allowAnonymousAccess: allowAnonymousAccess,
 		schemaManager:        schemaManager,
 		batchManager:         batchManager,
+		objectsManager:       objectsManager,
+		changeLog:            changeLog,
+		queueBacklog:         queueBacklog,
+		memoryPressure:       memoryPressure,
 		config:               config,
 		logger:               logger,
 		authorizer:           authorization,
@@ -138,6 +148,59 @@ func (s *Service) TenantsGet(ctx context.Context, req *pb.TenantsGetRequest) (*p
 	return result, nil
 }
 
+func (s *Service) TenantsCreate(ctx context.Context, req *pb.TenantsCreateRequest) (*pb.TenantsCreateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if err := s.tenantsCreate(ctx, principal, req); err != nil {
+		return nil, fmt.Errorf("create tenants: %w", err)
+	}
+
+	return &pb.TenantsCreateReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Service) TenantsUpdate(ctx context.Context, req *pb.TenantsUpdateRequest) (*pb.TenantsUpdateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	retTenants, err := s.tenantsUpdate(ctx, principal, req)
+	if err != nil {
+		return nil, fmt.Errorf("update tenants: %w", err)
+	}
+
+	result := &pb.TenantsUpdateReply{
+		Took:    float32(time.Since(before).Seconds()),
+		Tenants: retTenants,
+	}
+	return result, nil
+}
+
+func (s *Service) TenantsDelete(ctx context.Context, req *pb.TenantsDeleteRequest) (*pb.TenantsDeleteReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+	ctx = restCtx.AddPrincipalToContext(ctx, principal)
+
+	if err := s.tenantsDelete(ctx, principal, req); err != nil {
+		return nil, fmt.Errorf("delete tenants: %w", err)
+	}
+
+	return &pb.TenantsDeleteReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
 func (s *Service) BatchDelete(ctx context.Context, req *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error) {
 	var result *pb.BatchDeleteReply
 	var errInner error
@@ -14,6 +14,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/weaviate/weaviate/entities/models"
 	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
@@ -71,3 +72,96 @@ func tenantToGRPC(tenant *models.Tenant) (*pb.Tenant, error) {
 		ActivityStatus: pb.TenantActivityStatus(status),
 	}, nil
 }
+
+func (s *Service) tenantsCreate(ctx context.Context, principal *models.Principal, req *pb.TenantsCreateRequest) error {
+	if req.Collection == "" {
+		return fmt.Errorf("missing collection")
+	}
+	if len(req.Tenants) == 0 {
+		return fmt.Errorf("must specify at least one tenant")
+	}
+
+	tenants, err := tenantsFromGRPC(req.Tenants)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.schemaManager.AddTenants(ctx, principal, req.Collection, tenants)
+	return err
+}
+
+func (s *Service) tenantsUpdate(ctx context.Context, principal *models.Principal, req *pb.TenantsUpdateRequest) ([]*pb.Tenant, error) {
+	if req.Collection == "" {
+		return nil, fmt.Errorf("missing collection")
+	}
+	if len(req.Tenants) == 0 {
+		return nil, fmt.Errorf("must specify at least one tenant")
+	}
+
+	tenants, err := tenantsFromGRPC(req.Tenants)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.schemaManager.UpdateTenants(ctx, principal, req.Collection, tenants)
+	if err != nil {
+		return nil, err
+	}
+
+	retTenants := make([]*pb.Tenant, len(updated))
+	for i, tenant := range updated {
+		tenantGRPC, err := tenantToGRPC(tenant)
+		if err != nil {
+			return nil, err
+		}
+		retTenants[i] = tenantGRPC
+	}
+	return retTenants, nil
+}
+
+func (s *Service) tenantsDelete(ctx context.Context, principal *models.Principal, req *pb.TenantsDeleteRequest) error {
+	if req.Collection == "" {
+		return fmt.Errorf("missing collection")
+	}
+	if len(req.Tenants) == 0 {
+		return fmt.Errorf("must specify at least one tenant name")
+	}
+
+	return s.schemaManager.DeleteTenants(ctx, principal, req.Collection, req.Tenants)
+}
+
+func tenantsFromGRPC(tenants []*pb.Tenant) ([]*models.Tenant, error) {
+	out := make([]*models.Tenant, len(tenants))
+	for i, tenant := range tenants {
+		t, err := tenantFromGRPC(tenant)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = t
+	}
+	return out, nil
+}
+
+// tenantFromGRPC is the inverse of tenantToGRPC. An unspecified activity
+// status is left empty, so that the schema applies its default.
+func tenantFromGRPC(tenant *pb.Tenant) (*models.Tenant, error) {
+	if tenant.GetName() == "" {
+		return nil, fmt.Errorf("missing tenant name")
+	}
+
+	var status string
+	switch tenant.ActivityStatus {
+	case pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_UNSPECIFIED:
+	default:
+		name, ok := pb.TenantActivityStatus_name[int32(tenant.ActivityStatus)]
+		if !ok {
+			return nil, fmt.Errorf("unknown tenant activity status %d", tenant.ActivityStatus)
+		}
+		status = strings.TrimPrefix(name, "TENANT_ACTIVITY_STATUS_")
+	}
+
+	return &models.Tenant{
+		Name:           tenant.Name,
+		ActivityStatus: status,
+	}, nil
+}
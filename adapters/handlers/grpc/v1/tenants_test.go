@@ -58,3 +58,42 @@ func TestGRPCTenants(t *testing.T) {
 		})
 	}
 }
+
+func TestGRPCTenantsFromGRPC(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		for _, status := range []string{
+			models.TenantActivityStatusHOT,
+			models.TenantActivityStatusCOLD,
+			models.TenantActivityStatusFROZEN,
+			types.TenantActivityStatusFREEZING,
+			types.TenantActivityStatusUNFREEZING,
+		} {
+			tenantGRPC, err := tenantToGRPC(&models.Tenant{Name: "TestTenant", ActivityStatus: status})
+			require.Nil(t, err)
+
+			tenant, err := tenantFromGRPC(tenantGRPC)
+			require.Nil(t, err)
+			require.Equal(t, "TestTenant", tenant.Name)
+			require.Equal(t, status, tenant.ActivityStatus)
+		}
+	})
+
+	t.Run("unspecified status is left empty", func(t *testing.T) {
+		tenant, err := tenantFromGRPC(&pb.Tenant{Name: "TestTenant"})
+		require.Nil(t, err)
+		require.Equal(t, "", tenant.ActivityStatus)
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		_, err := tenantsFromGRPC([]*pb.Tenant{
+			{Name: "TestTenant"},
+			{ActivityStatus: pb.TenantActivityStatus_TENANT_ACTIVITY_STATUS_HOT},
+		})
+		require.NotNil(t, err)
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		_, err := tenantFromGRPC(&pb.Tenant{Name: "TestTenant", ActivityStatus: 100})
+		require.NotNil(t, err)
+	})
+}
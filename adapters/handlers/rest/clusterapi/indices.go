@@ -416,7 +416,7 @@ func (i *indices) postObjectSingle(w http.ResponseWriter, r *http.Request,
 	}
 
 	if err := i.shards.PutObject(r.Context(), index, shard, obj, schemaVersion); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), writeErrorStatus(err))
 		return
 	}
 
@@ -445,8 +445,10 @@ func (i *indices) postObjectBatch(w http.ResponseWriter, r *http.Request,
 	}
 
 	errs := i.shards.BatchPutObjects(r.Context(), index, shard, objs, schemaVersion)
-	if len(errs) > 0 && errors.Is(errs[0], reposdb.ErrShardNotFound) {
-		http.Error(w, errs[0].Error(), http.StatusInternalServerError)
+	if len(errs) > 0 && (errors.Is(errs[0], reposdb.ErrShardNotFound) ||
+		enterrors.IsTenantQuotaExceeded(errs[0])) {
+		// the whole batch was rejected
+		http.Error(w, errs[0].Error(), writeErrorStatus(errs[0]))
 		return
 	}
 	errsJSON, err := IndicesPayloads.ErrorList.Marshal(errs)
@@ -459,6 +461,16 @@ func (i *indices) postObjectBatch(w http.ResponseWriter, r *http.Request,
 	w.Write(errsJSON)
 }
 
+// writeErrorStatus is the status code of a failed write. Writes rejected by
+// the tenant quota get their own code, so that the client can restore
+// enterrors.ErrTenantQuotaExceeded.
+func writeErrorStatus(err error) int {
+	if enterrors.IsTenantQuotaExceeded(err) {
+		return http.StatusInsufficientStorage
+	}
+	return http.StatusInternalServerError
+}
+
 func (i *indices) getObject() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpObject.FindStringSubmatch(r.URL.Path)
@@ -648,7 +660,7 @@ func (i *indices) mergeObject() http.Handler {
 		}
 
 		if err = i.shards.MergeObject(r.Context(), index, shard, mergeDoc, schemaVersion); err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			http.Error(w, err.Error(), writeErrorStatus(err))
 			return
 		}
 
@@ -810,6 +822,11 @@ func (i *indices) postReferences() http.Handler {
 		}
 
 		errs := i.shards.BatchAddReferences(r.Context(), index, shard, refs, schemaVersion)
+		if len(errs) > 0 && enterrors.IsTenantQuotaExceeded(errs[0]) {
+			// the whole batch was rejected
+			http.Error(w, errs[0].Error(), writeErrorStatus(errs[0]))
+			return
+		}
 		errsJSON, err := IndicesPayloads.ErrorList.Marshal(errs)
 		if err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
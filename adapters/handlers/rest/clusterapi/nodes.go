@@ -27,6 +27,7 @@ import (
 type nodesManager interface {
 	GetNodeStatus(ctx context.Context, className, output string) (*models.NodeStatus, error)
 	GetStatistics(ctx context.Context) (*models.Statistics, error)
+	GetTenantUsage(ctx context.Context, className string, tenants []string) (map[string]*models.TenantUsage, error)
 }
 
 type nodes struct {
@@ -42,6 +43,7 @@ var (
 	regxNodes      = regexp.MustCompile(`/status`)
 	regxNodesClass = regexp.MustCompile(`/status/(` + entschema.ClassNameRegexCore + `)`)
 	regxStatistics = regexp.MustCompile(`/statistics`)
+	regxUsage      = regexp.MustCompile(`/usage/(` + entschema.ClassNameRegexCore + `)`)
 )
 
 func (s *nodes) Nodes() http.Handler {
@@ -52,6 +54,15 @@ func (s *nodes) nodesHandler() http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		path := r.URL.Path
 		switch {
+		case regxUsage.MatchString(path):
+			if r.Method != http.MethodPost {
+				msg := fmt.Sprintf("/nodes api path %q not found", path)
+				http.Error(w, msg, http.StatusMethodNotAllowed)
+				return
+			}
+
+			s.incomingTenantUsage().ServeHTTP(w, r)
+			return
 		case regxNodes.MatchString(path) || regxNodesClass.MatchString(path):
 			if r.Method != http.MethodGet {
 				msg := fmt.Sprintf("/nodes api path %q not found", path)
@@ -141,3 +152,35 @@ func (s *nodes) incomingStatistics() http.Handler {
 		w.Write(statisticsBytes)
 	})
 }
+
+func (s *nodes) incomingTenantUsage() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		// url is /nodes/usage/className
+		className := regxUsage.FindStringSubmatch(r.URL.Path)[1]
+
+		var tenants []string
+		if err := json.NewDecoder(r.Body).Decode(&tenants); err != nil {
+			http.Error(w, "/nodes unmarshal request: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		usage, err := s.nodesManager.GetTenantUsage(r.Context(), className, tenants)
+		if err != nil {
+			http.Error(w, "/nodes fulfill request: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		usageBytes, err := json.Marshal(usage)
+		if err != nil {
+			http.Error(w, "/nodes marshal response: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(usageBytes)
+	})
+}
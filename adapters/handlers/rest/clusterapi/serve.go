@@ -19,6 +19,7 @@ import (
 	sentryhttp "github.com/getsentry/sentry-go/http"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/tracing"
 )
 
 func Serve(appState *state.State) {
@@ -75,6 +76,15 @@ func Serve(appState *state.State) {
 		)
 	}
 
+	if appState.ServerConfig.Config.Tracing.Enabled {
+		// continues the traces of the nodes calling this one
+		route := staticRoute(mux)
+		handler = tracing.InstrumentHTTP(handler, func(r *http.Request) string {
+			_, label := route(r)
+			return label
+		})
+	}
+
 	http.ListenAndServe(fmt.Sprintf(":%d", port), handler)
 }
 
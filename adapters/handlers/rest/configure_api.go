@@ -65,7 +65,10 @@ import (
 	"github.com/weaviate/weaviate/cluster/router"
 	"github.com/weaviate/weaviate/entities/concurrency"
 	entcfg "github.com/weaviate/weaviate/entities/config"
+	"github.com/weaviate/weaviate/entities/encryption"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/logarchive"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/moduletools"
 	"github.com/weaviate/weaviate/entities/replication"
 	vectorIndex "github.com/weaviate/weaviate/entities/vectorindex"
@@ -88,6 +91,7 @@ import (
 	modgenerativeopenai "github.com/weaviate/weaviate/modules/generative-openai"
 	modgenerativexai "github.com/weaviate/weaviate/modules/generative-xai"
 	modimage "github.com/weaviate/weaviate/modules/img2vec-neural"
+	modkmsfs "github.com/weaviate/weaviate/modules/kms-filesystem"
 	modbind "github.com/weaviate/weaviate/modules/multi2vec-bind"
 	modclip "github.com/weaviate/weaviate/modules/multi2vec-clip"
 	modmulti2veccohere "github.com/weaviate/weaviate/modules/multi2vec-cohere"
@@ -138,11 +142,13 @@ import (
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"github.com/weaviate/weaviate/usecases/replica"
 	"github.com/weaviate/weaviate/usecases/scaler"
 	"github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
 	"github.com/weaviate/weaviate/usecases/telemetry"
+	"github.com/weaviate/weaviate/usecases/tracing"
 	"github.com/weaviate/weaviate/usecases/traverser"
 )
 
@@ -274,6 +280,15 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		}, appState.Logger)
 	}
 
+	shutdownTracing, err := tracing.Init(appState.ServerConfig.Config.Tracing,
+		appState.Cluster.LocalName(), build.Version, appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "startup").WithError(err).Fatal("failed to init tracing")
+	}
+	appState.ShutdownTracing = shutdownTracing
+
+	appState.PrincipalLimiter = configurePrincipalLimiter(appState)
+
 	if appState.ServerConfig.Config.Sentry.Enabled {
 		err := sentry.Init(sentry.ClientOptions{
 			// Setup related config
@@ -343,7 +358,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	limitResources(appState)
 
-	err := registerModules(appState)
+	err = registerModules(appState)
 	if err != nil {
 		appState.Logger.
 			WithField("action", "startup").WithError(err).
@@ -358,7 +373,8 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 			Fatal("invalid config")
 	}
 
-	appState.ClusterHttpClient = reasonableHttpClient(appState.ServerConfig.Config.Cluster.AuthConfig)
+	appState.ClusterHttpClient = reasonableHttpClient(appState.ServerConfig.Config.Cluster.AuthConfig,
+		appState.ServerConfig.Config.Tracing.Enabled)
 	appState.MemWatch = memwatch.NewMonitor(memwatch.LiveHeapReader, debug.SetMemoryLimit, 0.97)
 
 	var vectorRepo vectorRepo
@@ -374,6 +390,36 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	remoteIndexClient := clients.NewRemoteIndex(appState.ClusterHttpClient)
 	remoteNodesClient := clients.NewRemoteNode(appState.ClusterHttpClient)
 	replicationClient := clients.NewReplicationClient(appState.ClusterHttpClient)
+
+	var encryptionKeys encryption.KeyProvider
+	if keyfile := appState.ServerConfig.Config.Persistence.EncryptionKeyfile; keyfile != "" {
+		encryptionKeys, err = encryption.NewKeyfileProvider(keyfile)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("invalid encryption keyfile")
+		}
+	}
+
+	// modules are initialized later on, but before any tenant shard is loaded
+	tenantKeys, _ := appState.Modules.KeyProvider()
+
+	// logs are retired from the start, but only shipped once the backup
+	// backend module has been initialized
+	var (
+		logArchiver *backup.LogArchiver
+		archiver    logarchive.Archiver
+	)
+	if appState.ServerConfig.Config.Persistence.LogArchiveBackend != "" {
+		logArchiver, err = backup.NewLogArchiver(appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
+		if err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not create log archiver")
+		}
+		archiver = logArchiver
+	}
+
 	repo, err := db.New(appState.Logger, db.Config{
 		ServerVersion:                       config.ServerVersion,
 		GitHash:                             build.Revision,
@@ -397,11 +443,14 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		QueryNestedRefLimit:                 appState.ServerConfig.Config.QueryNestedCrossReferenceLimit,
 		MaxImportGoroutinesFactor:           appState.ServerConfig.Config.MaxImportGoroutinesFactor,
 		TrackVectorDimensions:               appState.ServerConfig.Config.TrackVectorDimensions,
+		ChangeDataCaptureEnabled:            appState.ServerConfig.Config.ChangeDataCapture.Enabled,
+		ChangeDataCaptureRetention:          appState.ServerConfig.Config.ChangeDataCapture.Retention,
 		ResourceUsage:                       appState.ServerConfig.Config.ResourceUsage,
 		AvoidMMap:                           appState.ServerConfig.Config.AvoidMmap,
 		DisableLazyLoadShards:               appState.ServerConfig.Config.DisableLazyLoadShards,
 		ForceFullReplicasSearch:             appState.ServerConfig.Config.ForceFullReplicasSearch,
 		LSMEnableSegmentsChecksumValidation: appState.ServerConfig.Config.Persistence.LSMEnableSegmentsChecksumValidation,
+		LSMObjectsBlockCompression:          appState.ServerConfig.Config.Persistence.LSMObjectsBlockCompression,
 		// Pass dummy replication config with minimum factor 1. Otherwise the
 		// setting is not backward-compatible. The user may have created a class
 		// with factor=1 before the change was introduced. Now their setup would no
@@ -414,6 +463,10 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 			AsyncReplicationDisabledFn: appState.ServerConfig.Config.Replication.AsyncReplicationDisabledFn,
 		},
 		MaximumConcurrentShardLoads: appState.ServerConfig.Config.MaximumConcurrentShardLoads,
+		Encryption:                  encryptionKeys,
+		TenantKeys:                  tenantKeys,
+		CreateMissingTenantKeys:     appState.ServerConfig.Config.Persistence.EncryptionCreateMissingTenantKeys,
+		LogArchiver:                 archiver,
 	}, remoteIndexClient, appState.Cluster, remoteNodesClient, replicationClient, appState.Metrics, appState.MemWatch) // TODO client
 	if err != nil {
 		appState.Logger.
@@ -438,6 +491,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	vectorRepo = repo
 	// migrator = vectorMigrator
 	explorer := traverser.NewExplorer(repo, appState.Logger, appState.Modules, traverser.NewMetrics(appState.Metrics), appState.ServerConfig.Config)
+	explorer.SetHighlightAnalyzer(inverted.NewHighlightAnalyzer())
 	schemaRepo := schemarepo.NewStore(appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
 	if err = schemaRepo.Open(); err != nil {
 		appState.Logger.
@@ -512,6 +566,8 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		AuthzController:        appState.AuthzController,
 		DynamicUserController:  appState.APIKey.Dynamic,
 		ReplicaCopier:          replicaCopier,
+		NodeStatus:             appState.DB,
+		Rebalance:              appState.ServerConfig.Config.Replication.Rebalance,
 		AuthNConfig:            appState.ServerConfig.Config.Authentication,
 	}
 	for _, name := range appState.ServerConfig.Config.Raft.Join[:rConfig.BootstrapExpect] {
@@ -593,6 +649,10 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 			Fatal("modules didn't initialize")
 	}
 
+	if logArchiver != nil {
+		startLogArchiver(appState, logArchiver)
+	}
+
 	var reindexCtx context.Context
 	reindexCtx, appState.ReindexCtxCancel = context.WithCancelCause(context.Background())
 	reindexer := configureReindexer(appState, reindexCtx)
@@ -778,15 +838,16 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.Metrics,
 		appState.Authorizer,
 		appState.Logger)
-	replicationHandlers.SetupHandlers(api, appState.ClusterService.Raft, appState.Metrics, appState.Authorizer, appState.Logger)
+	replicationHandlers.SetupHandlers(api, appState.ClusterService.Raft, appState.ClusterService.Rebalancer(), appState.Metrics, appState.Authorizer, appState.Logger)
 
 	remoteDbUsers := clients.NewRemoteUser(appState.ClusterHttpClient, appState.Cluster)
 	db_users.SetupHandlers(api, appState.ClusterService.Raft, appState.Authorizer, appState.ServerConfig.Config.Authentication, appState.ServerConfig.Config.Authorization, remoteDbUsers, appState.SchemaManager, appState.Logger)
 
-	setupSchemaHandlers(api, appState.SchemaManager, appState.Metrics, appState.Logger)
+	setupSchemaHandlers(api, appState.SchemaManager, appState.DB, appState.Metrics, appState.Logger)
 	objectsManager := objects.NewManager(appState.SchemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.DB, appState.Modules,
 		objects.NewMetrics(appState.Metrics), appState.MemWatch)
+	appState.ObjectsManager = objectsManager
 	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger,
 		appState.Modules, appState.Metrics)
 	setupObjectBatchHandlers(api, appState.BatchManager, appState.Metrics, appState.Logger)
@@ -803,9 +864,12 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	if appState.ServerConfig.Config.Monitoring.Enabled {
 		grpcInstrument = monitoring.InstrumentGrpc(appState.GRPCServerMetrics)
 	}
+	if appState.ServerConfig.Config.Tracing.Enabled {
+		grpcInstrument = append(grpcInstrument, tracing.InstrumentGrpc()...)
+	}
 
 	grpcServer := createGrpcServer(appState, grpcInstrument...)
-	setupMiddlewares := makeSetupMiddlewares(appState)
+	setupMiddlewares := makeSetupMiddlewares(appState, api.Context())
 	setupGlobalMiddleware := makeSetupGlobalMiddleware(appState, api.Context())
 
 	telemeter := telemetry.New(appState.DB, appState.SchemaManager, appState.Logger)
@@ -853,6 +917,13 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 		defer cancel()
 
+		if err := appState.ShutdownTracing(ctx); err != nil {
+			appState.Logger.
+				WithError(err).
+				WithField("action", "shutdown").
+				Errorf("failed to export pending spans")
+		}
+
 		if err := appState.ClusterService.Close(ctx); err != nil {
 			appState.Logger.
 				WithError(err).
@@ -873,6 +944,24 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
 }
 
+func startLogArchiver(appState *state.State, archiver *backup.LogArchiver) {
+	cfg := appState.ServerConfig.Config.Persistence
+	backend, err := appState.Modules.BackupBackend(cfg.LogArchiveBackend)
+	if err != nil {
+		appState.Logger.
+			WithField("action", "startup").WithError(err).
+			Fatalf("no backup backend %q to archive logs, did you enable the right module?", cfg.LogArchiveBackend)
+	}
+	interval := time.Duration(cfg.LogArchiveIntervalSeconds) * time.Second
+	enterrors.GoWrapper(func() {
+		if err := archiver.Run(context.Background(), backend, appState.Cluster.LocalName(), interval); err != nil {
+			appState.Logger.
+				WithField("action", "archive_logs").WithError(err).
+				Error("log archiver stopped")
+		}
+	}, appState.Logger)
+}
+
 func startBackupScheduler(appState *state.State) *backup.Scheduler {
 	backupScheduler := backup.NewScheduler(
 		appState.Authorizer,
@@ -1432,6 +1521,14 @@ func registerModules(appState *state.State) error {
 			Debug("enabled module")
 	}
 
+	if _, ok := enabledModules[modkmsfs.Name]; ok {
+		appState.Modules.Register(modkmsfs.New())
+		appState.Logger.
+			WithField("action", "startup").
+			WithField("module", modkmsfs.Name).
+			Debug("enabled module")
+	}
+
 	if _, ok := enabledModules[modstggcs.Name]; ok {
 		appState.Modules.Register(modstggcs.New())
 		appState.Logger.
@@ -1579,8 +1676,10 @@ func (c clientWithAuth) RoundTrip(r *http.Request) (*http.Response, error) {
 	return c.r.RoundTrip(r)
 }
 
-func reasonableHttpClient(authConfig cluster.AuthConfig) *http.Client {
-	t := &http.Transport{
+// reasonableHttpClient is used for the requests between nodes. If traced,
+// the trace context is propagated to the receiving node.
+func reasonableHttpClient(authConfig cluster.AuthConfig, traced bool) *http.Client {
+	var t http.RoundTripper = &http.Transport{
 		Proxy: http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
 			Timeout:   30 * time.Second,
@@ -1593,6 +1692,9 @@ func reasonableHttpClient(authConfig cluster.AuthConfig) *http.Client {
 		ExpectContinueTimeout: 1 * time.Second,
 	}
 
+	if traced {
+		t = tracing.Transport(t)
+	}
 	if authConfig.BasicAuth.Enabled() {
 		return &http.Client{Transport: clientWithAuth{r: t, basicAuth: authConfig.BasicAuth}}
 	}
@@ -1733,5 +1835,36 @@ func initRuntimeOverrides(appState *state.State) {
 		appState.ServerConfig.Config.SchemaHandlerConfig.MaximumAllowedCollectionsCountFn = rc.GetMaximumAllowedCollectionsCount
 		appState.ServerConfig.Config.AutoSchema.EnabledFn = rc.GetAutoSchemaEnabled
 		appState.ServerConfig.Config.Replication.AsyncReplicationDisabledFn = rc.GetAsyncReplicationDisabled
+		appState.ServerConfig.Config.RateLimitsFn = rc.GetRateLimits
+	}
+}
+
+// configurePrincipalLimiter limits the requests of each user, the limits can
+// be changed through runtime overrides. Roles are only considered with RBAC.
+func configurePrincipalLimiter(appState *state.State) *ratelimiter.Principals {
+	cfg := &appState.ServerConfig.Config
+	limits := func() ratelimiter.Config {
+		return configRuntime.GetOverrides(cfg.RateLimits, cfg.RateLimitsFn)
+	}
+
+	var roles ratelimiter.RolesFn
+	if appState.AuthzController != nil {
+		roles = func(username string, userType models.UserTypeInput) ([]string, error) {
+			policies, err := appState.AuthzController.GetRolesForUser(username, userType)
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(policies))
+			for name := range policies {
+				names = append(names, name)
+			}
+			return names, nil
+		}
+	}
+
+	var reg prometheus.Registerer = monitoring.NoopRegisterer
+	if cfg.Monitoring.Enabled {
+		reg = prometheus.DefaultRegisterer
 	}
+	return ratelimiter.NewPrincipals(limits, roles, reg)
 }
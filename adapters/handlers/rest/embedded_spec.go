@@ -3449,26 +3449,63 @@ func init() {
         ]
       }
     },
-    "/replication/replicate": {
+    "/replication/rebalance": {
+      "get": {
+        "description": "Returns the state and the moves of the last shard rebalance coordinated by the node receiving the request.",
+        "tags": [
+          "replication"
+        ],
+        "summary": "Get the state of the shard rebalance",
+        "operationId": "replicationRebalanceStatus",
+        "responses": {
+          "200": {
+            "description": "The state of the rebalance coordinated by this node",
+            "schema": {
+              "$ref": "#/definitions/ReplicationRebalanceStatus"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.replication.rebalance.get"
+        ]
+      },
       "post": {
+        "description": "Plans the moves which balance the number and the disk usage of shard replicas across the healthy nodes and carries them out in the background. A move replicates a shard to its target node and removes the replica from its source node afterwards. Only a limited number of moves run concurrently. The rebalance is coordinated by the node receiving the request and its state is kept in memory only. If that node restarts, the moves which did not start yet are dropped and the moves which are replicating complete without removing the replica from their source node.",
         "tags": [
           "replication"
         ],
-        "summary": "Start the async operation to replicate a replica between two nodes",
-        "operationId": "replicate",
+        "summary": "Start rebalancing the shard replicas across the nodes",
+        "operationId": "replicationRebalanceStart",
         "parameters": [
           {
             "name": "body",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/ReplicationReplicateReplicaRequest"
+              "$ref": "#/definitions/ReplicationRebalanceRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Replication operation registered successfully"
+            "description": "The state of the rebalance coordinated by this node",
+            "schema": {
+              "$ref": "#/definitions/ReplicationRebalanceStatus"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -3499,39 +3536,19 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.replication.replicate"
+          "weaviate.replication.rebalance"
         ]
-      }
-    },
-    "/replication/replicate/{id}": {
-      "get": {
-        "description": "Returns the details of a replication operation for a given shard, identified by the provided replication operation id.",
+      },
+      "delete": {
+        "description": "Cancels the moves of the ongoing rebalance which have not started yet. Moves which are already replicating a shard complete.",
         "tags": [
           "replication"
         ],
-        "summary": "Get the details of a replication operation.",
-        "operationId": "replicationDetails",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The replication operation id to get details for.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "Cancel the ongoing shard rebalance",
+        "operationId": "replicationRebalanceCancel",
         "responses": {
-          "200": {
-            "description": "The details of the replication operation.",
-            "schema": {
-              "$ref": "#/definitions/ReplicationReplicateDetailsReplicaResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Rebalance canceled successfully"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3542,8 +3559,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Shard replica operation not found"
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3553,32 +3573,23 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.replication.replicate.details"
+          "weaviate.replication.rebalance.cancel"
         ]
       }
     },
-    "/schema": {
-      "get": {
-        "description": "Fetch an array of all collection definitions from the schema.",
+    "/replication/rebalance/pause": {
+      "post": {
+        "description": "Stops starting new moves of the ongoing rebalance until it is resumed. Moves which are already replicating a shard complete.",
         "tags": [
-          "schema"
-        ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
-        "parameters": [
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
-          }
+          "replication"
         ],
+        "summary": "Pause the ongoing shard rebalance",
+        "operationId": "replicationRebalancePause",
         "responses": {
           "200": {
-            "description": "Successfully dumped the database schema.",
+            "description": "The state of the rebalance coordinated by this node",
             "schema": {
-              "$ref": "#/definitions/Schema"
+              "$ref": "#/definitions/ReplicationRebalanceStatus"
             }
           },
           "401": {
@@ -3590,6 +3601,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -3598,31 +3615,23 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.replication.rebalance.pause"
         ]
-      },
-      "post": {
-        "description": "Create a new data object collection. \u003cbr/\u003e\u003cbr/\u003eIf AutoSchema is enabled, Weaviate will attempt to infer the schema from the data at import time. However, manual schema definition is recommended for production environments.",
+      }
+    },
+    "/replication/rebalance/plan": {
+      "get": {
+        "description": "Returns the moves a rebalance would carry out and the load of the nodes before and after them, without moving any shard.",
         "tags": [
-          "schema"
-        ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
-        "parameters": [
-          {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
-          }
+          "replication"
         ],
+        "summary": "Plan a shard rebalance without starting it",
+        "operationId": "replicationRebalancePlan",
         "responses": {
           "200": {
-            "description": "Added the new Object class to the schema.",
+            "description": "The planned moves",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ReplicationRebalancePlan"
             }
           },
           "401": {
@@ -3634,12 +3643,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Object class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -3648,37 +3651,23 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.replication.rebalance.plan"
         ]
       }
     },
-    "/schema/{className}": {
-      "get": {
+    "/replication/rebalance/resume": {
+      "post": {
+        "description": "Continues starting the remaining moves of a paused rebalance.",
         "tags": [
-          "schema"
-        ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
-          }
+          "replication"
         ],
+        "summary": "Resume a paused shard rebalance",
+        "operationId": "replicationRebalanceResume",
         "responses": {
           "200": {
-            "description": "Found the Class, returned as body",
+            "description": "The state of the rebalance coordinated by this node",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ReplicationRebalanceStatus"
             }
           },
           "401": {
@@ -3690,8 +3679,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "This class does not exist"
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3701,37 +3693,35 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.replication.rebalance.resume"
         ]
-      },
-      "put": {
-        "description": "Add a property to an existing collection.",
+      }
+    },
+    "/replication/replicate": {
+      "post": {
         "tags": [
-          "schema"
+          "replication"
         ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
+        "summary": "Start the async operation to replicate a replica between two nodes",
+        "operationId": "replicate",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "objectClass",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ReplicationReplicateReplicaRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Class was updated successfully",
+            "description": "Replication operation registered successfully"
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -3743,14 +3733,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Class to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3763,30 +3747,36 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.replication.replicate"
         ]
-      },
-      "delete": {
-        "description": "Remove a collection from the schema. This will also delete all the objects in the collection.",
+      }
+    },
+    "/replication/replicate/{id}": {
+      "get": {
+        "description": "Returns the details of a replication operation for a given shard, identified by the provided replication operation id.",
         "tags": [
-          "schema"
+          "replication"
         ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
+        "summary": "Get the details of a replication operation.",
+        "operationId": "replicationDetails",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "The replication operation id to get details for.",
+            "name": "id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Removed the Object class from the schema."
+            "description": "The details of the replication operation.",
+            "schema": {
+              "$ref": "#/definitions/ReplicationReplicateDetailsReplicaResponse"
+            }
           },
           "400": {
-            "description": "Could not delete the Object class.",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3800,6 +3790,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Shard replica operation not found"
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -3808,38 +3801,39 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.replication.replicate.details"
         ]
       }
     },
-    "/schema/{className}/properties": {
+    "/replication/split": {
       "post": {
+        "description": "Reassigns the virtual shards of a shard of a single-tenant collection to new shards. Every replica of the shard copies its data into the new shards in the background. Once all replicas are done, requests are routed to the new shards and the shard is removed.",
         "tags": [
-          "schema"
+          "replication"
         ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
+        "summary": "Start the async operation to split a shard into new shards",
+        "operationId": "replicationSplitShard",
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/ReplicationSplitShardRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
+            "description": "Shard split registered successfully",
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/ReplicationSplitShardResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -3852,7 +3846,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid property.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3865,36 +3859,42 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.replication.split"
         ]
       }
     },
-    "/schema/{className}/shards": {
-      "get": {
-        "description": "Get the status of every shard in the cluster.",
+    "/replication/split/{collectionId}/{shardId}": {
+      "delete": {
+        "description": "Aborts the split of a shard which has not completed yet. The shard keeps all its objects and the new shards are removed.",
         "tags": [
-          "schema"
+          "replication"
         ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
+        "summary": "Cancel an ongoing split of a shard",
+        "operationId": "replicationCancelSplitShard",
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "description": "The collection name holding the shard.",
+            "name": "collectionId",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "tenant",
-            "in": "query"
+            "description": "The shard id of the shard being split.",
+            "name": "shardId",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Found the status of the shards, returned as body",
+          "204": {
+            "description": "Shard split canceled successfully"
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "$ref": "#/definitions/ShardStatusList"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -3906,8 +3906,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "This class does not exist",
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3920,45 +3920,76 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.replication.split.cancel"
         ]
       }
     },
-    "/schema/{className}/shards/{shardName}": {
-      "put": {
-        "description": "Update a shard status for a collection. For example, a shard may have been marked as ` + "`" + `READONLY` + "`" + ` because its disk was full. After providing more disk space, use this endpoint to set the shard status to ` + "`" + `READY` + "`" + ` again. There is also a convenience function in each client to set the status of all shards of a collection.",
+    "/schema": {
+      "get": {
+        "description": "Fetch an array of all collection definitions from the schema.",
         "tags": [
           "schema"
         ],
-        "summary": "Update a shard status.",
-        "operationId": "schema.objects.shards.update",
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully dumped the database schema.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
           },
-          {
-            "type": "string",
-            "name": "shardName",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "description": "Create a new data object collection. \u003cbr/\u003e\u003cbr/\u003eIf AutoSchema is enabled, Weaviate will attempt to infer the schema from the data at import time. However, manual schema definition is recommended for production environments.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
+        "parameters": [
           {
-            "name": "body",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status was updated successfully",
+            "description": "Added the new Object class to the schema.",
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -3970,14 +4001,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Shard to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Invalid Object class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3990,18 +4015,17 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.add.meta"
         ]
       }
     },
-    "/schema/{className}/tenants": {
+    "/schema/{className}": {
       "get": {
-        "description": "get all tenants from a specific class",
         "tags": [
           "schema"
         ],
-        "summary": "Get the list of tenants.",
-        "operationId": "tenants.get",
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
         "parameters": [
           {
             "type": "string",
@@ -4019,12 +4043,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "tenants from specified class.",
+            "description": "Found the Class, returned as body",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -4036,11 +4057,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "This class does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -4048,15 +4066,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
       },
       "put": {
-        "description": "Update tenant of a specific class",
+        "description": "Add a property to an existing collection.",
         "tags": [
           "schema"
         ],
-        "summary": "Update a tenant.",
-        "operationId": "tenants.update",
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
         "parameters": [
           {
             "type": "string",
@@ -4065,25 +4086,19 @@ func init() {
             "required": true
           },
           {
-            "name": "body",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Updated tenants of the specified class",
+            "description": "Class was updated successfully",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -4095,8 +4110,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4107,42 +4128,34 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       },
-      "post": {
-        "description": "Create a new tenant for a collection. Multi-tenancy must be enabled in the collection definition.",
+      "delete": {
+        "description": "Remove a collection from the schema. This will also delete all the objects in the collection.",
         "tags": [
           "schema"
         ],
-        "summary": "Create a new tenant",
-        "operationId": "tenants.create",
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added new tenants to the specified class",
+            "description": "Removed the Object class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Object class.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -4154,26 +4167,25 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "delete": {
-        "description": "delete tenants from a specific class",
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/properties": {
+      "post": {
         "tags": [
           "schema"
         ],
-        "operationId": "tenants.delete",
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
         "parameters": [
           {
             "type": "string",
@@ -4182,20 +4194,20 @@ func init() {
             "required": true
           },
           {
-            "name": "tenants",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/Property"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Deleted tenants from specified class."
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4207,7 +4219,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4218,17 +4230,20 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "/schema/{className}/tenants/{tenantName}": {
+    "/schema/{className}/shards": {
       "get": {
-        "description": "get a specific tenant for the given class",
+        "description": "Get the status of every shard in the cluster.",
         "tags": [
           "schema"
         ],
-        "summary": "Get a specific tenant",
-        "operationId": "tenants.get.one",
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
         "parameters": [
           {
             "type": "string",
@@ -4238,23 +4253,15 @@ func init() {
           },
           {
             "type": "string",
-            "name": "tenantName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "load the tenant given the specified class",
+            "description": "Found the status of the shards, returned as body",
             "schema": {
-              "$ref": "#/definitions/TenantResponse"
+              "$ref": "#/definitions/ShardStatusList"
             }
           },
           "401": {
@@ -4267,10 +4274,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Tenant not found"
-          },
-          "422": {
-            "description": "Invalid tenant or class",
+            "description": "This class does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4281,15 +4285,20 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "head": {
-        "description": "Check if a tenant exists for a specific class",
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update a shard status for a collection. For example, a shard may have been marked as ` + "`" + `READONLY` + "`" + ` because its disk was full. After providing more disk space, use this endpoint to set the shard status to ` + "`" + `READY` + "`" + ` again. There is also a convenience function in each client to set the status of all shards of a collection.",
         "tags": [
           "schema"
         ],
-        "summary": "Check whether a tenant exists",
-        "operationId": "tenant.exists",
+        "summary": "Update a shard status.",
+        "operationId": "schema.objects.shards.update",
         "parameters": [
           {
             "type": "string",
@@ -4299,21 +4308,25 @@ func init() {
           },
           {
             "type": "string",
-            "name": "tenantName",
+            "name": "shardName",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "The tenant exists in the specified class"
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4325,10 +4338,13 @@ func init() {
             }
           },
           "404": {
-            "description": "The tenant not found"
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4339,32 +4355,42 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "/users/db": {
+    "/schema/{className}/tenants": {
       "get": {
+        "description": "get all tenants from a specific class",
         "tags": [
-          "users"
+          "schema"
         ],
-        "summary": "list all db users",
-        "operationId": "listAllUsers",
+        "summary": "Get the list of tenants.",
+        "operationId": "tenants.get",
         "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "boolean",
-            "default": false,
-            "description": "Whether to include the last used time of the users",
-            "name": "includeLastUsedTime",
-            "in": "query"
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "Info about the users",
+            "description": "tenants from specified class.",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/DBUserInfo"
+                "$ref": "#/definitions/Tenant"
               }
             }
           },
@@ -4377,46 +4403,54 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.users.db.list_all"
-        ]
-      }
-    },
-    "/users/db/{user_id}": {
-      "get": {
+        }
+      },
+      "put": {
+        "description": "Update tenant of a specific class",
         "tags": [
-          "users"
+          "schema"
         ],
-        "summary": "get info relevant to user, e.g. username, roles",
-        "operationId": "getUserInfo",
+        "summary": "Update a tenant.",
+        "operationId": "tenants.update",
         "parameters": [
           {
             "type": "string",
-            "description": "user id",
-            "name": "user_id",
+            "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to include the last used time of the given user",
-            "name": "includeLastUsedTime",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Info about the user",
+            "description": "Updated tenants of the specified class",
             "schema": {
-              "$ref": "#/definitions/DBUserInfo"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
             }
           },
           "401": {
@@ -4428,11 +4462,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "user not found"
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "description": "Invalid Tenant class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4443,37 +4474,42 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.users.db.get"
-        ]
+        }
       },
       "post": {
+        "description": "Create a new tenant for a collection. Multi-tenancy must be enabled in the collection definition.",
         "tags": [
-          "users"
+          "schema"
         ],
-        "summary": "create new user",
-        "operationId": "createUser",
+        "summary": "Create a new tenant",
+        "operationId": "tenants.create",
         "parameters": [
           {
             "type": "string",
-            "description": "user id",
-            "name": "user_id",
+            "name": "className",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "User created successfully",
-            "schema": {
-              "$ref": "#/definitions/UserApiKey"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "Added new tenants to the specified class",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
             }
           },
           "401": {
@@ -4485,14 +4521,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "409": {
-            "description": "User already exists",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "description": "Invalid Tenant class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4503,35 +4533,36 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.users.db.create"
-        ]
+        }
       },
       "delete": {
+        "description": "delete tenants from a specific class",
         "tags": [
-          "users"
+          "schema"
         ],
-        "summary": "Delete User",
-        "operationId": "deleteUser",
+        "operationId": "tenants.delete",
         "parameters": [
           {
             "type": "string",
-            "description": "user name",
-            "name": "user_id",
+            "name": "className",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Successfully deleted."
           },
-          "400": {
-            "description": "Malformed request.",
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deleted tenants from specified class."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -4542,11 +4573,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "user not found"
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "description": "Invalid Tenant class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4557,36 +4585,43 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        },
-        "x-serviceIds": [
-          "weaviate.users.db.delete"
-        ]
+        }
       }
     },
-    "/users/db/{user_id}/activate": {
-      "post": {
+    "/schema/{className}/tenants/{tenantName}": {
+      "get": {
+        "description": "get a specific tenant for the given class",
         "tags": [
-          "users"
+          "schema"
         ],
-        "summary": "activate a deactivated user",
-        "operationId": "activateUser",
+        "summary": "Get a specific tenant",
+        "operationId": "tenants.get.one",
         "parameters": [
           {
             "type": "string",
-            "description": "user id",
-            "name": "user_id",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
-            "description": "User successfully activated"
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "load the tenant given the specified class",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/TenantResponse"
             }
           },
           "401": {
@@ -4599,13 +4634,112 @@ func init() {
             }
           },
           "404": {
-            "description": "user not found"
+            "description": "Tenant not found"
           },
-          "409": {
-            "description": "user already activated"
+          "422": {
+            "description": "Invalid tenant or class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "head": {
+        "description": "Check if a tenant exists for a specific class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Check whether a tenant exists",
+        "operationId": "tenant.exists",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The tenant exists in the specified class"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The tenant not found"
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/users/db": {
+      "get": {
+        "tags": [
+          "users"
+        ],
+        "summary": "list all db users",
+        "operationId": "listAllUsers",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to include the last used time of the users",
+            "name": "includeLastUsedTime",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Info about the users",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/DBUserInfo"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4618,17 +4752,17 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.users.db.activateUser"
+          "weaviate.users.db.list_all"
         ]
       }
     },
-    "/users/db/{user_id}/deactivate": {
-      "post": {
+    "/users/db/{user_id}": {
+      "get": {
         "tags": [
           "users"
         ],
-        "summary": "deactivate a user",
-        "operationId": "deactivateUser",
+        "summary": "get info relevant to user, e.g. username, roles",
+        "operationId": "getUserInfo",
         "parameters": [
           {
             "type": "string",
@@ -4638,28 +4772,18 @@ func init() {
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "revoke_key": {
-                  "description": "if the key should be revoked when deactivating the user",
-                  "type": "boolean",
-                  "default": false
-                }
-              }
-            }
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to include the last used time of the given user",
+            "name": "includeLastUsedTime",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "users successfully deactivated"
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Info about the user",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/DBUserInfo"
             }
           },
           "401": {
@@ -4674,11 +4798,8 @@ func init() {
           "404": {
             "description": "user not found"
           },
-          "409": {
-            "description": "user already deactivated"
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -4691,17 +4812,15 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.users.db.deactivateUser"
+          "weaviate.users.db.get"
         ]
-      }
-    },
-    "/users/db/{user_id}/rotate-key": {
+      },
       "post": {
         "tags": [
           "users"
         ],
-        "summary": "rotate user api key",
-        "operationId": "rotateUserApiKey",
+        "summary": "create new user",
+        "operationId": "createUser",
         "parameters": [
           {
             "type": "string",
@@ -4712,8 +4831,8 @@ func init() {
           }
         ],
         "responses": {
-          "200": {
-            "description": "ApiKey successfully changed",
+          "201": {
+            "description": "User created successfully",
             "schema": {
               "$ref": "#/definitions/UserApiKey"
             }
@@ -4733,8 +4852,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "user not found"
+          "409": {
+            "description": "User already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
@@ -4750,27 +4872,52 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.users.db.rotateApiKey"
+          "weaviate.users.db.create"
         ]
-      }
-    },
-    "/users/own-info": {
-      "get": {
+      },
+      "delete": {
         "tags": [
           "users"
         ],
-        "summary": "get info relevant to own user, e.g. username, roles",
-        "operationId": "getOwnInfo",
+        "summary": "Delete User",
+        "operationId": "deleteUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user name",
+            "name": "user_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
-          "200": {
-            "description": "Info about the user",
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "$ref": "#/definitions/UserOwnInfo"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "user not found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -4779,1216 +4926,2072 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.users.get.own-info"
+          "weaviate.users.db.delete"
         ]
       }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "(Response only) Additional meta information about a single object.",
-      "type": "object",
-      "additionalProperties": {
-        "type": "object"
-      }
     },
-    "BM25Config": {
-      "description": "tuning parameters for the BM25 algorithm",
-      "type": "object",
-      "properties": {
-        "b": {
-          "description": "Calibrates term-weight scaling based on the document length (default: 0.75).",
-          "type": "number",
-          "format": "float"
-        },
-        "k1": {
-          "description": "Calibrates term-weight scaling based on the term frequency within a document (default: 1.2).",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "BackupConfig": {
-      "description": "Backup custom configuration",
-      "type": "object",
-      "properties": {
-        "Bucket": {
-          "description": "Name of the bucket, container, volume, etc",
-          "type": "string"
-        },
-        "CPUPercentage": {
-          "description": "Desired CPU core utilization ranging from 1%-80%",
-          "type": "integer",
-          "default": 50,
-          "maximum": 80,
-          "minimum": 1,
-          "x-nullable": false
-        },
+    "/users/db/{user_id}/activate": {
+      "post": {
+        "tags": [
+          "users"
+        ],
+        "summary": "activate a deactivated user",
+        "operationId": "activateUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user id",
+            "name": "user_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "User successfully activated"
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "user not found"
+          },
+          "409": {
+            "description": "user already activated"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.users.db.activateUser"
+        ]
+      }
+    },
+    "/users/db/{user_id}/deactivate": {
+      "post": {
+        "tags": [
+          "users"
+        ],
+        "summary": "deactivate a user",
+        "operationId": "deactivateUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user id",
+            "name": "user_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "revoke_key": {
+                  "description": "if the key should be revoked when deactivating the user",
+                  "type": "boolean",
+                  "default": false
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "users successfully deactivated"
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "user not found"
+          },
+          "409": {
+            "description": "user already deactivated"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.users.db.deactivateUser"
+        ]
+      }
+    },
+    "/users/db/{user_id}/rotate-key": {
+      "post": {
+        "tags": [
+          "users"
+        ],
+        "summary": "rotate user api key",
+        "operationId": "rotateUserApiKey",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user id",
+            "name": "user_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ApiKey successfully changed",
+            "schema": {
+              "$ref": "#/definitions/UserApiKey"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "user not found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.users.db.rotateApiKey"
+        ]
+      }
+    },
+    "/users/own-info": {
+      "get": {
+        "tags": [
+          "users"
+        ],
+        "summary": "get info relevant to own user, e.g. username, roles",
+        "operationId": "getOwnInfo",
+        "responses": {
+          "200": {
+            "description": "Info about the user",
+            "schema": {
+              "$ref": "#/definitions/UserOwnInfo"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.users.get.own-info"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "AdditionalProperties": {
+      "description": "(Response only) Additional meta information about a single object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "BM25Config": {
+      "description": "tuning parameters for the BM25 algorithm",
+      "type": "object",
+      "properties": {
+        "b": {
+          "description": "Calibrates term-weight scaling based on the document length (default: 0.75).",
+          "type": "number",
+          "format": "float"
+        },
+        "k1": {
+          "description": "Calibrates term-weight scaling based on the term frequency within a document (default: 1.2).",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "BackupConfig": {
+      "description": "Backup custom configuration",
+      "type": "object",
+      "properties": {
+        "Bucket": {
+          "description": "Name of the bucket, container, volume, etc",
+          "type": "string"
+        },
+        "CPUPercentage": {
+          "description": "Desired CPU core utilization ranging from 1%-80%",
+          "type": "integer",
+          "default": 50,
+          "maximum": 80,
+          "minimum": 1,
+          "x-nullable": false
+        },
         "ChunkSize": {
           "description": "Aimed chunk size, with a minimum of 2MB, default of 128MB, and a maximum of 512MB. The actual chunk size may vary.",
           "type": "integer",
-          "default": 128,
-          "maximum": 512,
-          "minimum": 2,
-          "x-nullable": false
+          "default": 128,
+          "maximum": 512,
+          "minimum": 2,
+          "x-nullable": false
+        },
+        "CompressionLevel": {
+          "description": "compression level used by compression algorithm",
+          "type": "string",
+          "default": "DefaultCompression",
+          "enum": [
+            "DefaultCompression",
+            "BestSpeed",
+            "BestCompression"
+          ],
+          "x-nullable": false
+        },
+        "Endpoint": {
+          "description": "name of the endpoint, e.g. s3.amazonaws.com",
+          "type": "string"
+        },
+        "IncrementalBaseBackupID": {
+          "description": "ID of a previous backup on the same backend. Files which have not changed since then are referenced instead of being copied again, the base backup must be kept as long as this one.",
+          "type": "string"
+        },
+        "Path": {
+          "description": "Path or key within the bucket",
+          "type": "string"
+        }
+      }
+    },
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes",
+      "properties": {
+        "config": {
+          "description": "Custom configuration for the backup creation process",
+          "type": "object",
+          "$ref": "#/definitions/BackupConfig"
+        },
+        "exclude": {
+          "description": "List of collections to exclude from the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `include` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the backup (required). Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of collections to include in the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `exclude` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "BackupCreateResponse": {
+      "description": "The definition of a backup create response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "bucket": {
+          "description": "Name of the bucket, container, volume, etc",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup creation process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "Path within bucket of backup",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BackupCreateStatusResponse": {
+      "description": "The definition of a backup create metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup creation process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BackupListResponse": {
+      "description": "The definition of a backup create response body",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classes": {
+            "description": "The list of classes for which the existed backup process",
+            "type": "array",
+            "items": {
+              "type": "string"
+            }
+          },
+          "id": {
+            "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "type": "string"
+          },
+          "path": {
+            "description": "destination path of backup files proper to selected backend",
+            "type": "string"
+          },
+          "status": {
+            "description": "status of backup process",
+            "type": "string",
+            "enum": [
+              "STARTED",
+              "TRANSFERRING",
+              "TRANSFERRED",
+              "SUCCESS",
+              "FAILED",
+              "CANCELED"
+            ]
+          }
+        }
+      }
+    },
+    "BackupRestoreRequest": {
+      "description": "Request body for restoring a backup for a set of classes",
+      "properties": {
+        "config": {
+          "description": "Custom configuration for the backup restoration process",
+          "type": "object",
+          "$ref": "#/definitions/RestoreConfig"
+        },
+        "exclude": {
+          "description": "List of classes to exclude from the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "include": {
+          "description": "List of classes to include in the backup restoration process",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "node_mapping": {
+          "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "BackupRestoreResponse": {
+      "description": "The definition of a backup restore response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup restoration process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backend",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BackupRestoreStatusResponse": {
+      "description": "The definition of a backup restore metadata",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "error": {
+          "description": "error message if restoration failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "path": {
+          "description": "destination path of backup files proper to selected backup backend, contains bucket and path",
+          "type": "string"
+        },
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BatchDelete": {
+      "type": "object",
+      "properties": {
+        "deletionTimeUnixMilli": {
+          "description": "Timestamp of deletion in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
         },
-        "CompressionLevel": {
-          "description": "compression level used by compression algorithm",
+        "dryRun": {
+          "description": "If true, the call will show which objects would be matched using the specified filter without deleting any objects. \u003cbr/\u003e\u003cbr/\u003eDepending on the configured verbosity, you will either receive a count of affected objects, or a list of IDs.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        }
+      }
+    },
+    "BatchDeleteResponse": {
+      "description": "Delete Objects response.",
+      "type": "object",
+      "properties": {
+        "deletionTimeUnixMilli": {
+          "description": "Timestamp of deletion in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "dryRun": {
+          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
+          "type": "boolean",
+          "default": false
+        },
+        "match": {
+          "description": "Outlines how to find the objects to be deleted.",
+          "type": "object",
+          "properties": {
+            "class": {
+              "description": "Class (name) which objects will be deleted.",
+              "type": "string",
+              "example": "City"
+            },
+            "where": {
+              "description": "Filter to limit the objects to be deleted.",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
+        },
+        "output": {
+          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+          "type": "string",
+          "default": "minimal"
+        },
+        "results": {
+          "type": "object",
+          "properties": {
+            "failed": {
+              "description": "How many objects should have been deleted but could not be deleted.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "limit": {
+              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "matches": {
+              "description": "How many objects were matched by the filter.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            },
+            "objects": {
+              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
+              "type": "array",
+              "items": {
+                "description": "Results for this specific Object.",
+                "format": "object",
+                "properties": {
+                  "errors": {
+                    "$ref": "#/definitions/ErrorResponse"
+                  },
+                  "id": {
+                    "description": "ID of the Object.",
+                    "type": "string",
+                    "format": "uuid"
+                  },
+                  "status": {
+                    "type": "string",
+                    "default": "SUCCESS",
+                    "enum": [
+                      "SUCCESS",
+                      "DRYRUN",
+                      "FAILED"
+                    ]
+                  }
+                }
+              }
+            },
+            "successful": {
+              "description": "How many objects were successfully deleted in this round.",
+              "type": "number",
+              "format": "int64",
+              "x-omitempty": false
+            }
+          }
+        }
+      }
+    },
+    "BatchReference": {
+      "properties": {
+        "from": {
+          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
           "type": "string",
-          "default": "DefaultCompression",
-          "enum": [
-            "DefaultCompression",
-            "BestSpeed",
-            "BestCompression"
-          ],
-          "x-nullable": false
+          "format": "uri",
+          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
         },
-        "Endpoint": {
-          "description": "name of the endpoint, e.g. s3.amazonaws.com",
+        "tenant": {
+          "description": "Name of the reference tenant.",
           "type": "string"
         },
-        "Path": {
-          "description": "Path or key within the bucket",
+        "to": {
+          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri",
+          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        }
+      }
+    },
+    "BatchReferenceResponse": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/BatchReference"
+        },
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific reference.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "FAILED"
+                  ]
+                }
+              }
+            }
+          }
+        }
+      ]
+    },
+    "BatchStats": {
+      "description": "The summary of a nodes batch queue congestion status.",
+      "properties": {
+        "queueLength": {
+          "description": "How many objects are currently in the batch queue.",
+          "type": "number",
+          "format": "int",
+          "x-nullable": true,
+          "x-omitempty": true
+        },
+        "ratePerSecond": {
+          "description": "How many objects are approximately processed from the batch queue per second.",
+          "type": "number",
+          "format": "int",
+          "x-omitempty": false
+        }
+      }
+    },
+    "C11yExtension": {
+      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+      "properties": {
+        "concept": {
+          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
+          "type": "string",
+          "example": "foobarium"
+        },
+        "definition": {
+          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
           "type": "string"
+        },
+        "weight": {
+          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
+          "type": "number",
+          "format": "float"
         }
       }
     },
-    "BackupCreateRequest": {
-      "description": "Request body for creating a backup of a set of classes",
+    "C11yNearestNeighbors": {
+      "description": "C11y function to show the nearest neighbors to a word.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "distance": {
+            "type": "number",
+            "format": "float"
+          },
+          "word": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "C11yVector": {
+      "description": "A vector representation of the object in the Contextionary. If provided at object creation, this wil take precedence over any vectorizer setting.",
+      "type": "array",
+      "items": {
+        "type": "number",
+        "format": "float"
+      }
+    },
+    "C11yVectorBasedQuestion": {
+      "description": "Receive question based on array of classes, properties and values.",
+      "type": "array",
+      "items": {
+        "type": "object",
+        "properties": {
+          "classProps": {
+            "description": "Vectorized properties.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "object",
+              "properties": {
+                "propsVectors": {
+                  "type": "array",
+                  "items": {
+                    "type": "number",
+                    "format": "float"
+                  }
+                },
+                "value": {
+                  "description": "String with valuename.",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "classVectors": {
+            "description": "Vectorized classname.",
+            "type": "array",
+            "maxItems": 300,
+            "minItems": 300,
+            "items": {
+              "type": "number",
+              "format": "float"
+            }
+          }
+        }
+      }
+    },
+    "C11yWordsResponse": {
+      "description": "An array of available words and contexts.",
       "properties": {
-        "config": {
-          "description": "Custom configuration for the backup creation process",
+        "concatenatedWord": {
+          "description": "Weighted results for all words",
           "type": "object",
-          "$ref": "#/definitions/BackupConfig"
+          "properties": {
+            "concatenatedNearestNeighbors": {
+              "$ref": "#/definitions/C11yNearestNeighbors"
+            },
+            "concatenatedVector": {
+              "$ref": "#/definitions/C11yVector"
+            },
+            "concatenatedWord": {
+              "type": "string"
+            },
+            "singleWords": {
+              "type": "array",
+              "items": {
+                "format": "string"
+              }
+            }
+          }
         },
-        "exclude": {
-          "description": "List of collections to exclude from the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `include` + "`" + `.",
+        "individualWords": {
+          "description": "Weighted results for per individual word",
           "type": "array",
           "items": {
-            "type": "string"
+            "type": "object",
+            "properties": {
+              "info": {
+                "type": "object",
+                "properties": {
+                  "nearestNeighbors": {
+                    "$ref": "#/definitions/C11yNearestNeighbors"
+                  },
+                  "vector": {
+                    "$ref": "#/definitions/C11yVector"
+                  }
+                }
+              },
+              "present": {
+                "type": "boolean"
+              },
+              "word": {
+                "type": "string"
+              }
+            }
           }
+        }
+      }
+    },
+    "Class": {
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Name of the class (a.k.a. 'collection') (required). Multiple words should be concatenated in CamelCase, e.g. ` + "`" + `ArticleAuthor` + "`" + `.",
+          "type": "string"
         },
-        "id": {
-          "description": "The ID of the backup (required). Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+        "description": {
+          "description": "Description of the collection for metadata purposes.",
           "type": "string"
         },
-        "include": {
-          "description": "List of collections to include in the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `exclude` + "`" + `.",
+        "invertedIndexConfig": {
+          "$ref": "#/definitions/InvertedIndexConfig"
+        },
+        "moduleConfig": {
+          "description": "Configuration specific to modules in a collection context.",
+          "type": "object"
+        },
+        "multiTenancyConfig": {
+          "$ref": "#/definitions/MultiTenancyConfig"
+        },
+        "objectTtlConfig": {
+          "$ref": "#/definitions/ObjectTtlConfig"
+        },
+        "properties": {
+          "description": "Define properties of the collection.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/Property"
+          }
+        },
+        "replicationConfig": {
+          "$ref": "#/definitions/ReplicationConfig"
+        },
+        "shardingConfig": {
+          "description": "Manage how the index should be sharded and distributed in the cluster",
+          "type": "object"
+        },
+        "vectorConfig": {
+          "description": "Configure named vectors. Either use this field or ` + "`" + `vectorizer` + "`" + `, ` + "`" + `vectorIndexType` + "`" + `, and ` + "`" + `vectorIndexConfig` + "`" + ` fields. Available from ` + "`" + `v1.24.0` + "`" + `.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/VectorConfig"
           }
+        },
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
+        },
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
+          "type": "string"
+        },
+        "vectorizer": {
+          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
+          "type": "string"
         }
       }
     },
-    "BackupCreateResponse": {
-      "description": "The definition of a backup create response body",
+    "Classification": {
+      "description": "Manage classifications, trigger them and view status of past classifications.",
+      "type": "object",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
+        "basedOnProperties": {
+          "description": "base the text-based classification on these fields (of type text)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "description"
+          ]
         },
-        "bucket": {
-          "description": "Name of the bucket, container, volume, etc",
-          "type": "string"
+        "class": {
+          "description": "class (name) which is used in this classification",
+          "type": "string",
+          "example": "City"
         },
-        "classes": {
-          "description": "The list of classes for which the backup creation process was started",
+        "classifyProperties": {
+          "description": "which ref-property to set as part of the classification",
           "type": "array",
           "items": {
             "type": "string"
-          }
+          },
+          "example": [
+            "inCountry"
+          ]
         },
         "error": {
-          "description": "error message if creation failed",
-          "type": "string"
+          "description": "error message if status == failed",
+          "type": "string",
+          "default": "",
+          "example": "classify xzy: something went wrong"
+        },
+        "filters": {
+          "type": "object",
+          "properties": {
+            "sourceWhere": {
+              "description": "limit the objects to be classified",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "targetWhere": {
+              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            },
+            "trainingSetWhere": {
+              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
+              "type": "object",
+              "$ref": "#/definitions/WhereFilter"
+            }
+          }
         },
         "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
+          "description": "ID to uniquely identify this classification run",
+          "type": "string",
+          "format": "uuid",
+          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
         },
-        "path": {
-          "description": "Path within bucket of backup",
-          "type": "string"
+        "meta": {
+          "description": "additional meta information about the classification",
+          "type": "object",
+          "$ref": "#/definitions/ClassificationMeta"
+        },
+        "settings": {
+          "description": "classification-type specific settings",
+          "type": "object"
         },
         "status": {
-          "description": "phase of backup creation process",
+          "description": "status of this classification",
           "type": "string",
-          "default": "STARTED",
           "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
+            "running",
+            "completed",
+            "failed"
+          ],
+          "example": "running"
+        },
+        "type": {
+          "description": "which algorithm to use for classifications",
+          "type": "string"
         }
       }
     },
-    "BackupCreateStatusResponse": {
-      "description": "The definition of a backup create metadata",
+    "ClassificationMeta": {
+      "description": "Additional information to a specific classification",
+      "type": "object",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
+        "completed": {
+          "description": "time when this classification finished",
+          "type": "string",
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
         },
-        "error": {
-          "description": "error message if creation failed",
-          "type": "string"
+        "count": {
+          "description": "number of objects which were taken into consideration for classification",
+          "type": "integer",
+          "example": 147
         },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
+        "countFailed": {
+          "description": "number of objects which could not be classified - see error message for details",
+          "type": "integer",
+          "example": 7
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
-          "type": "string"
+        "countSucceeded": {
+          "description": "number of objects successfully classified",
+          "type": "integer",
+          "example": 140
         },
-        "status": {
-          "description": "phase of backup creation process",
+        "started": {
+          "description": "time when this classification was started",
           "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
-        }
-      }
-    },
-    "BackupListResponse": {
-      "description": "The definition of a backup create response body",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classes": {
-            "description": "The list of classes for which the existed backup process",
-            "type": "array",
-            "items": {
-              "type": "string"
-            }
-          },
-          "id": {
-            "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "type": "string"
-          },
-          "path": {
-            "description": "destination path of backup files proper to selected backend",
-            "type": "string"
-          },
-          "status": {
-            "description": "status of backup process",
-            "type": "string",
-            "enum": [
-              "STARTED",
-              "TRANSFERRING",
-              "TRANSFERRED",
-              "SUCCESS",
-              "FAILED",
-              "CANCELED"
-            ]
-          }
+          "format": "date-time",
+          "example": "2017-07-21T17:32:28Z"
         }
       }
     },
-    "BackupRestoreRequest": {
-      "description": "Request body for restoring a backup for a set of classes",
+    "ClusterStatisticsResponse": {
+      "description": "The cluster statistics of all of the Weaviate nodes",
+      "type": "object",
       "properties": {
-        "config": {
-          "description": "Custom configuration for the backup restoration process",
-          "type": "object",
-          "$ref": "#/definitions/RestoreConfig"
-        },
-        "exclude": {
-          "description": "List of classes to exclude from the backup restoration process",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "include": {
-          "description": "List of classes to include in the backup restoration process",
+        "statistics": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/Statistics"
           }
         },
-        "node_mapping": {
-          "description": "Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.",
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
+        "synchronized": {
+          "type": "boolean",
+          "x-omitempty": false
         }
       }
     },
-    "BackupRestoreResponse": {
-      "description": "The definition of a backup restore response body",
+    "DBUserInfo": {
+      "type": "object",
+      "required": [
+        "userId",
+        "dbUserType",
+        "roles",
+        "active"
+      ],
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
+        "active": {
+          "description": "activity status of the returned user",
+          "type": "boolean"
+        },
+        "apiKeyFirstLetters": {
+          "description": "First 3 letters of the associated API-key",
+          "type": [
+            "string",
+            "null"
+          ],
+          "maxLength": 3
+        },
+        "createdAt": {
+          "description": "Date and time in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ)",
+          "type": [
+            "string",
+            "null"
+          ],
+          "format": "date-time"
+        },
+        "dbUserType": {
+          "description": "type of the returned user",
+          "type": "string",
+          "enum": [
+            "db_user",
+            "db_env_user"
+          ]
+        },
+        "lastUsedAt": {
+          "description": "Date and time in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ)",
+          "type": [
+            "string",
+            "null"
+          ],
+          "format": "date-time"
         },
-        "classes": {
-          "description": "The list of classes for which the backup restoration process was started",
+        "roles": {
+          "description": "The role names associated to the user",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "error": {
-          "description": "error message if restoration failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "path": {
-          "description": "destination path of backup files proper to selected backend",
+        "userId": {
+          "description": "The user id of the given user",
           "type": "string"
-        },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
         }
       }
     },
-    "BackupRestoreStatusResponse": {
-      "description": "The definition of a backup restore metadata",
+    "Deprecation": {
+      "type": "object",
       "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "error": {
-          "description": "error message if restoration failed",
+        "apiType": {
+          "description": "Describes which API is effected, usually one of: REST, GraphQL",
           "type": "string"
         },
         "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
           "type": "string"
         },
-        "path": {
-          "description": "destination path of backup files proper to selected backup backend, contains bucket and path",
-          "type": "string"
+        "locations": {
+          "description": "The locations within the specified API affected by this deprecation",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
-        }
-      }
-    },
-    "BatchDelete": {
-      "type": "object",
-      "properties": {
-        "deletionTimeUnixMilli": {
-          "description": "Timestamp of deletion in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true
+        "mitigation": {
+          "description": "User-required object to not be affected by the (planned) removal",
+          "type": "string"
         },
-        "dryRun": {
-          "description": "If true, the call will show which objects would be matched using the specified filter without deleting any objects. \u003cbr/\u003e\u003cbr/\u003eDepending on the configured verbosity, you will either receive a count of affected objects, or a list of IDs.",
-          "type": "boolean",
-          "default": false
+        "msg": {
+          "description": "What this deprecation is about",
+          "type": "string"
         },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
+        "plannedRemovalVersion": {
+          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+          "type": "string"
         },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+        "removedIn": {
+          "description": "If the feature has already been removed, it was removed in this version",
           "type": "string",
-          "default": "minimal"
-        }
-      }
-    },
-    "BatchDeleteResponse": {
-      "description": "Delete Objects response.",
-      "type": "object",
-      "properties": {
-        "deletionTimeUnixMilli": {
-          "description": "Timestamp of deletion in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64",
           "x-nullable": true
         },
-        "dryRun": {
-          "description": "If true, objects will not be deleted yet, but merely listed. Defaults to false.",
-          "type": "boolean",
-          "default": false
-        },
-        "match": {
-          "description": "Outlines how to find the objects to be deleted.",
-          "type": "object",
-          "properties": {
-            "class": {
-              "description": "Class (name) which objects will be deleted.",
-              "type": "string",
-              "example": "City"
-            },
-            "where": {
-              "description": "Filter to limit the objects to be deleted.",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
-        },
-        "output": {
-          "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+        "removedTime": {
+          "description": "If the feature has already been removed, it was removed at this timestamp",
           "type": "string",
-          "default": "minimal"
+          "format": "date-time",
+          "x-nullable": true
         },
-        "results": {
-          "type": "object",
-          "properties": {
-            "failed": {
-              "description": "How many objects should have been deleted but could not be deleted.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "limit": {
-              "description": "The most amount of objects that can be deleted in a single query, equals QUERY_MAXIMUM_RESULTS.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "matches": {
-              "description": "How many objects were matched by the filter.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            },
-            "objects": {
-              "description": "With output set to \"minimal\" only objects with error occurred will the be described. Successfully deleted objects would be omitted. Output set to \"verbose\" will list all of the objets with their respective statuses.",
-              "type": "array",
-              "items": {
-                "description": "Results for this specific Object.",
-                "format": "object",
-                "properties": {
-                  "errors": {
-                    "$ref": "#/definitions/ErrorResponse"
-                  },
-                  "id": {
-                    "description": "ID of the Object.",
-                    "type": "string",
-                    "format": "uuid"
-                  },
-                  "status": {
-                    "type": "string",
-                    "default": "SUCCESS",
-                    "enum": [
-                      "SUCCESS",
-                      "DRYRUN",
-                      "FAILED"
-                    ]
-                  }
-                }
-              }
-            },
-            "successful": {
-              "description": "How many objects were successfully deleted in this round.",
-              "type": "number",
-              "format": "int64",
-              "x-omitempty": false
-            }
-          }
-        }
-      }
-    },
-    "BatchReference": {
-      "properties": {
-        "from": {
-          "description": "Long-form beacon-style URI to identify the source of the cross-ref including the property name. Should be in the form of weaviate://localhost/\u003ckinds\u003e/\u003cuuid\u003e/\u003cclassName\u003e/\u003cpropertyName\u003e, where \u003ckinds\u003e must be one of 'objects', 'objects' and \u003cclassName\u003e and \u003cpropertyName\u003e must represent the cross-ref property of source class to be used.",
+        "sinceTime": {
+          "description": "The deprecation was introduced in this version",
           "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/Zoo/a5d09582-4239-4702-81c9-92a6e0122bb4/hasAnimals"
+          "format": "date-time"
         },
-        "tenant": {
-          "description": "Name of the reference tenant.",
+        "sinceVersion": {
+          "description": "The deprecation was introduced in this version",
           "type": "string"
         },
-        "to": {
-          "description": "Short-form URI to point to the cross-ref. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri",
-          "example": "weaviate://localhost/97525810-a9a5-4eb0-858a-71449aeb007f"
+        "status": {
+          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
+          "type": "string"
         }
       }
     },
-    "BatchReferenceResponse": {
+    "ErrorResponse": {
+      "description": "An error response given by Weaviate end-points.",
       "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/BatchReference"
-        },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific reference.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "FAILED"
-                  ]
-                }
+      "properties": {
+        "error": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "message": {
+                "type": "string"
               }
             }
           }
         }
-      ]
+      }
     },
-    "BatchStats": {
-      "description": "The summary of a nodes batch queue congestion status.",
+    "GeoCoordinates": {
       "properties": {
-        "queueLength": {
-          "description": "How many objects are currently in the batch queue.",
+        "latitude": {
+          "description": "The latitude of the point on earth in decimal form",
           "type": "number",
-          "format": "int",
-          "x-nullable": true,
-          "x-omitempty": true
+          "format": "float",
+          "x-nullable": true
         },
-        "ratePerSecond": {
-          "description": "How many objects are approximately processed from the batch queue per second.",
+        "longitude": {
+          "description": "The longitude of the point on earth in decimal form",
           "type": "number",
-          "format": "int",
-          "x-omitempty": false
+          "format": "float",
+          "x-nullable": true
         }
       }
     },
-    "C11yExtension": {
-      "description": "A resource describing an extension to the contextinoary, containing both the identifier and the definition of the extension",
+    "GraphQLError": {
+      "description": "An error response caused by a GraphQL query.",
       "properties": {
-        "concept": {
-          "description": "The new concept you want to extend. Must be an all-lowercase single word, or a space delimited compound word. Examples: 'foobarium', 'my custom concept'",
-          "type": "string",
-          "example": "foobarium"
+        "locations": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "column": {
+                "type": "integer",
+                "format": "int64"
+              },
+              "line": {
+                "type": "integer",
+                "format": "int64"
+              }
+            }
+          }
         },
-        "definition": {
-          "description": "A list of space-delimited words or a sentence describing what the custom concept is about. Avoid using the custom concept itself. An Example definition for the custom concept 'foobarium': would be 'a naturally occurring element which can only be seen by programmers'",
+        "message": {
           "type": "string"
         },
-        "weight": {
-          "description": "Weight of the definition of the new concept where 1='override existing definition entirely' and 0='ignore custom definition'. Note that if the custom concept is not present in the contextionary yet, the weight cannot be less than 1.",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "C11yNearestNeighbors": {
-      "description": "C11y function to show the nearest neighbors to a word.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "distance": {
-            "type": "number",
-            "format": "float"
-          },
-          "word": {
+        "path": {
+          "type": "array",
+          "items": {
             "type": "string"
           }
         }
       }
     },
-    "C11yVector": {
-      "description": "A vector representation of the object in the Contextionary. If provided at object creation, this wil take precedence over any vectorizer setting.",
+    "GraphQLQueries": {
+      "description": "A list of GraphQL queries.",
       "type": "array",
       "items": {
-        "type": "number",
-        "format": "float"
+        "$ref": "#/definitions/GraphQLQuery"
       }
     },
-    "C11yVectorBasedQuestion": {
-      "description": "Receive question based on array of classes, properties and values.",
-      "type": "array",
-      "items": {
-        "type": "object",
-        "properties": {
-          "classProps": {
-            "description": "Vectorized properties.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "object",
-              "properties": {
-                "propsVectors": {
-                  "type": "array",
-                  "items": {
-                    "type": "number",
-                    "format": "float"
-                  }
-                },
-                "value": {
-                  "description": "String with valuename.",
-                  "type": "string"
-                }
-              }
-            }
-          },
-          "classVectors": {
-            "description": "Vectorized classname.",
-            "type": "array",
-            "maxItems": 300,
-            "minItems": 300,
-            "items": {
-              "type": "number",
-              "format": "float"
-            }
-          }
+    "GraphQLQuery": {
+      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
+      "type": "object",
+      "properties": {
+        "operationName": {
+          "description": "The name of the operation if multiple exist in the query.",
+          "type": "string"
+        },
+        "query": {
+          "description": "Query based on GraphQL syntax.",
+          "type": "string"
+        },
+        "variables": {
+          "description": "Additional variables for the query.",
+          "type": "object"
         }
       }
     },
-    "C11yWordsResponse": {
-      "description": "An array of available words and contexts.",
+    "GraphQLResponse": {
+      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
       "properties": {
-        "concatenatedWord": {
-          "description": "Weighted results for all words",
+        "data": {
+          "description": "GraphQL data object.",
           "type": "object",
-          "properties": {
-            "concatenatedNearestNeighbors": {
-              "$ref": "#/definitions/C11yNearestNeighbors"
-            },
-            "concatenatedVector": {
-              "$ref": "#/definitions/C11yVector"
-            },
-            "concatenatedWord": {
-              "type": "string"
-            },
-            "singleWords": {
-              "type": "array",
-              "items": {
-                "format": "string"
-              }
-            }
+          "additionalProperties": {
+            "$ref": "#/definitions/JsonObject"
           }
         },
-        "individualWords": {
-          "description": "Weighted results for per individual word",
+        "errors": {
+          "description": "Array with errors.",
           "type": "array",
           "items": {
-            "type": "object",
-            "properties": {
-              "info": {
-                "type": "object",
-                "properties": {
-                  "nearestNeighbors": {
-                    "$ref": "#/definitions/C11yNearestNeighbors"
-                  },
-                  "vector": {
-                    "$ref": "#/definitions/C11yVector"
-                  }
-                }
-              },
-              "present": {
-                "type": "boolean"
-              },
-              "word": {
-                "type": "string"
-              }
-            }
-          }
+            "$ref": "#/definitions/GraphQLError"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "GraphQLResponses": {
+      "description": "A list of GraphQL responses.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/GraphQLResponse"
+      }
+    },
+    "InvertedIndexConfig": {
+      "description": "Configure the inverted index built into Weaviate (default: 60).",
+      "type": "object",
+      "properties": {
+        "bm25": {
+          "$ref": "#/definitions/BM25Config"
+        },
+        "cleanupIntervalSeconds": {
+          "description": "Asynchronous index clean up happens every n seconds",
+          "type": "number",
+          "format": "int"
+        },
+        "indexNullState": {
+          "description": "Index each object with the null state (default: 'false').",
+          "type": "boolean"
+        },
+        "indexPropertyLength": {
+          "description": "Index length of properties (default: 'false').",
+          "type": "boolean"
+        },
+        "indexTermPositions": {
+          "description": "Index the positions of terms in text properties, required for phrase and proximity queries in BM25 (default: 'false').",
+          "type": "boolean"
+        },
+        "indexTimestamps": {
+          "description": "Index each object by its internal timestamps (default: 'false').",
+          "type": "boolean"
+        },
+        "stopwords": {
+          "$ref": "#/definitions/StopwordConfig"
+        },
+        "synonyms": {
+          "$ref": "#/definitions/SynonymConfig"
+        },
+        "usingBlockMaxWAND": {
+          "description": "Using BlockMax WAND for query execution (default: 'false', will be 'true' for new collections created after 1.30).",
+          "type": "boolean"
+        }
+      }
+    },
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "Link": {
+      "type": "object",
+      "properties": {
+        "documentationHref": {
+          "description": "weaviate documentation about this resource group",
+          "type": "string"
+        },
+        "href": {
+          "description": "target of the link",
+          "type": "string"
+        },
+        "name": {
+          "description": "human readable name of the resource group",
+          "type": "string"
+        },
+        "rel": {
+          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+          "type": "string"
         }
       }
     },
-    "Class": {
+    "Meta": {
+      "description": "Contains meta information of the current Weaviate instance.",
       "type": "object",
       "properties": {
-        "class": {
-          "description": "Name of the class (a.k.a. 'collection') (required). Multiple words should be concatenated in CamelCase, e.g. ` + "`" + `ArticleAuthor` + "`" + `.",
-          "type": "string"
-        },
-        "description": {
-          "description": "Description of the collection for metadata purposes.",
-          "type": "string"
-        },
-        "invertedIndexConfig": {
-          "$ref": "#/definitions/InvertedIndexConfig"
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules in a collection context.",
-          "type": "object"
-        },
-        "multiTenancyConfig": {
-          "$ref": "#/definitions/MultiTenancyConfig"
-        },
-        "properties": {
-          "description": "Define properties of the collection.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Property"
-          }
+        "grpcMaxMessageSize": {
+          "description": "Max message size for GRPC connection in bytes.",
+          "type": "integer"
         },
-        "replicationConfig": {
-          "$ref": "#/definitions/ReplicationConfig"
+        "hostname": {
+          "description": "The url of the host.",
+          "type": "string",
+          "format": "url"
         },
-        "shardingConfig": {
-          "description": "Manage how the index should be sharded and distributed in the cluster",
+        "modules": {
+          "description": "Module-specific meta information.",
           "type": "object"
         },
-        "vectorConfig": {
-          "description": "Configure named vectors. Either use this field or ` + "`" + `vectorizer` + "`" + `, ` + "`" + `vectorIndexType` + "`" + `, and ` + "`" + `vectorIndexConfig` + "`" + ` fields. Available from ` + "`" + `v1.24.0` + "`" + `.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/VectorConfig"
-          }
+        "version": {
+          "description": "The Weaviate server version.",
+          "type": "string"
+        }
+      }
+    },
+    "MultiTenancyConfig": {
+      "description": "Configuration related to multi-tenancy within a class",
+      "properties": {
+        "autoTenantActivation": {
+          "description": "Existing tenants should (not) be turned HOT implicitly when they are accessed and in another activity status (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
+        "autoTenantCreation": {
+          "description": "Nonexistent tenants should (not) be created implicitly (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
-          "type": "string"
+        "enabled": {
+          "description": "Whether or not multi-tenancy is enabled for this class (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "vectorizer": {
-          "description": "Specify how the vectors for this class should be determined. The options are either 'none' - this means you have to import a vector with each object yourself - or the name of a module that provides vectorization capabilities, such as 'text2vec-contextionary'. If left empty, it will use the globally configured default which can itself either be 'none' or a specific module.",
-          "type": "string"
+        "tenantQuota": {
+          "$ref": "#/definitions/TenantQuota"
         }
       }
     },
-    "Classification": {
-      "description": "Manage classifications, trigger them and view status of past classifications.",
+    "MultipleRef": {
+      "description": "Multiple instances of references to other objects.",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/SingleRef"
+      }
+    },
+    "NestedProperty": {
       "type": "object",
       "properties": {
-        "basedOnProperties": {
-          "description": "base the text-based classification on these fields (of type text)",
+        "dataType": {
           "type": "array",
           "items": {
             "type": "string"
-          },
-          "example": [
-            "description"
-          ]
-        },
-        "class": {
-          "description": "class (name) which is used in this classification",
-          "type": "string",
-          "example": "City"
+          }
         },
-        "classifyProperties": {
-          "description": "which ref-property to set as part of the classification",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "inCountry"
-          ]
+        "description": {
+          "type": "string"
         },
-        "error": {
-          "description": "error message if status == failed",
-          "type": "string",
-          "default": "",
-          "example": "classify xzy: something went wrong"
+        "indexFilterable": {
+          "type": "boolean",
+          "x-nullable": true
         },
-        "filters": {
-          "type": "object",
-          "properties": {
-            "sourceWhere": {
-              "description": "limit the objects to be classified",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "targetWhere": {
-              "description": "Limit the possible sources when using an algorithm which doesn't really on training data, e.g. 'contextual'. When using an algorithm with a training set, such as 'knn', limit the training set instead",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            },
-            "trainingSetWhere": {
-              "description": "Limit the training objects to be considered during the classification. Can only be used on types with explicit training sets, such as 'knn'",
-              "type": "object",
-              "$ref": "#/definitions/WhereFilter"
-            }
-          }
+        "indexRangeFilters": {
+          "type": "boolean",
+          "x-nullable": true
         },
-        "id": {
-          "description": "ID to uniquely identify this classification run",
-          "type": "string",
-          "format": "uuid",
-          "example": "ee722219-b8ec-4db1-8f8d-5150bb1a9e0c"
+        "indexSearchable": {
+          "type": "boolean",
+          "x-nullable": true
         },
-        "meta": {
-          "description": "additional meta information about the classification",
-          "type": "object",
-          "$ref": "#/definitions/ClassificationMeta"
+        "name": {
+          "type": "string"
         },
-        "settings": {
-          "description": "classification-type specific settings",
-          "type": "object"
+        "nestedProperties": {
+          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NestedProperty"
+          },
+          "x-omitempty": true
         },
-        "status": {
-          "description": "status of this classification",
+        "tokenization": {
           "type": "string",
           "enum": [
-            "running",
-            "completed",
-            "failed"
-          ],
-          "example": "running"
-        },
-        "type": {
-          "description": "which algorithm to use for classifications",
-          "type": "string"
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "trigram",
+            "gse",
+            "kagome_kr",
+            "kagome_ja",
+            "gse_ch"
+          ]
         }
       }
     },
-    "ClassificationMeta": {
-      "description": "Additional information to a specific classification",
-      "type": "object",
+    "NodeShardStatus": {
+      "description": "The definition of a node shard status response body",
       "properties": {
-        "completed": {
-          "description": "time when this classification finished",
+        "class": {
+          "description": "The name of shard's class.",
           "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+          "x-omitempty": false
         },
-        "count": {
-          "description": "number of objects which were taken into consideration for classification",
-          "type": "integer",
-          "example": 147
+        "compressed": {
+          "description": "The status of vector compression/quantization.",
+          "format": "boolean",
+          "x-omitempty": false
         },
-        "countFailed": {
-          "description": "number of objects which could not be classified - see error message for details",
-          "type": "integer",
-          "example": 7
+        "diskBytes": {
+          "description": "The size of the shard on disk in bytes.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "countSucceeded": {
-          "description": "number of objects successfully classified",
-          "type": "integer",
-          "example": 140
+        "loaded": {
+          "description": "The load status of the shard.",
+          "type": "boolean",
+          "x-omitempty": false
         },
-        "started": {
-          "description": "time when this classification was started",
+        "name": {
+          "description": "The name of the shard.",
           "type": "string",
-          "format": "date-time",
-          "example": "2017-07-21T17:32:28Z"
+          "x-omitempty": false
+        },
+        "objectCount": {
+          "description": "The number of objects in shard.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "vectorIndexingStatus": {
+          "description": "The status of the vector indexing process.",
+          "format": "string",
+          "x-omitempty": false
+        },
+        "vectorQueueLength": {
+          "description": "The length of the vector indexing queue.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         }
       }
-    },
-    "ClusterStatisticsResponse": {
-      "description": "The cluster statistics of all of the Weaviate nodes",
-      "type": "object",
-      "properties": {
-        "statistics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/Statistics"
-          }
+    },
+    "NodeStats": {
+      "description": "The summary of Weaviate's statistics.",
+      "properties": {
+        "objectCount": {
+          "description": "The total number of objects in DB.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "synchronized": {
-          "type": "boolean",
+        "shardCount": {
+          "description": "The count of Weaviate's shards. To see this value, set ` + "`" + `output` + "`" + ` to ` + "`" + `verbose` + "`" + `.",
+          "type": "number",
+          "format": "int",
           "x-omitempty": false
         }
       }
     },
-    "DBUserInfo": {
-      "type": "object",
-      "required": [
-        "userId",
-        "dbUserType",
-        "roles",
-        "active"
-      ],
+    "NodeStatus": {
+      "description": "The definition of a backup node status response body",
       "properties": {
-        "active": {
-          "description": "activity status of the returned user",
-          "type": "boolean"
+        "batchStats": {
+          "description": "Weaviate batch statistics.",
+          "type": "object",
+          "$ref": "#/definitions/BatchStats"
         },
-        "apiKeyFirstLetters": {
-          "description": "First 3 letters of the associated API-key",
-          "type": [
-            "string",
-            "null"
-          ],
-          "maxLength": 3
+        "gitHash": {
+          "description": "The gitHash of Weaviate.",
+          "type": "string"
         },
-        "createdAt": {
-          "description": "Date and time in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ)",
-          "type": [
-            "string",
-            "null"
-          ],
-          "format": "date-time"
+        "name": {
+          "description": "The name of the node.",
+          "type": "string"
         },
-        "dbUserType": {
-          "description": "type of the returned user",
+        "shards": {
+          "description": "The list of the shards with it's statistics.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeShardStatus"
+          }
+        },
+        "stats": {
+          "description": "Weaviate overall statistics.",
+          "type": "object",
+          "$ref": "#/definitions/NodeStats"
+        },
+        "status": {
+          "description": "Node's status.",
           "type": "string",
+          "default": "HEALTHY",
           "enum": [
-            "db_user",
-            "db_env_user"
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE",
+            "TIMEOUT"
           ]
         },
-        "lastUsedAt": {
-          "description": "Date and time in ISO 8601 format (YYYY-MM-DDTHH:MM:SSZ)",
-          "type": [
-            "string",
-            "null"
-          ],
-          "format": "date-time"
-        },
-        "roles": {
-          "description": "The role names associated to the user",
+        "version": {
+          "description": "The version of Weaviate.",
+          "type": "string"
+        }
+      }
+    },
+    "NodesStatusResponse": {
+      "description": "The status of all of the Weaviate nodes",
+      "type": "object",
+      "properties": {
+        "nodes": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/NodeStatus"
           }
-        },
-        "userId": {
-          "description": "The user id of the given user",
-          "type": "string"
         }
       }
     },
-    "Deprecation": {
+    "Object": {
       "type": "object",
       "properties": {
-        "apiType": {
-          "description": "Describes which API is effected, usually one of: REST, GraphQL",
-          "type": "string"
+        "additional": {
+          "$ref": "#/definitions/AdditionalProperties"
         },
-        "id": {
-          "description": "The id that uniquely identifies this particular deprecations (mostly used internally)",
+        "class": {
+          "description": "Class of the Object, defined in the schema.",
           "type": "string"
         },
-        "locations": {
-          "description": "The locations within the specified API affected by this deprecation",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "creationTimeUnix": {
+          "description": "(Response only) Timestamp of creation of this object in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
         },
-        "mitigation": {
-          "description": "User-required object to not be affected by the (planned) removal",
-          "type": "string"
+        "id": {
+          "description": "ID of the Object.",
+          "type": "string",
+          "format": "uuid"
         },
-        "msg": {
-          "description": "What this deprecation is about",
-          "type": "string"
+        "lastUpdateTimeUnix": {
+          "description": "(Response only) Timestamp of the last object update in milliseconds since epoch UTC.",
+          "type": "integer",
+          "format": "int64"
         },
-        "plannedRemovalVersion": {
-          "description": "A best-effort guess of which upcoming version will remove the feature entirely",
+        "properties": {
+          "$ref": "#/definitions/PropertySchema"
+        },
+        "tenant": {
+          "description": "Name of the Objects tenant.",
           "type": "string"
         },
-        "removedIn": {
-          "description": "If the feature has already been removed, it was removed in this version",
-          "type": "string",
-          "x-nullable": true
+        "vector": {
+          "description": "This field returns vectors associated with the Object. C11yVector, Vector or Vectors values are possible.",
+          "$ref": "#/definitions/C11yVector"
         },
-        "removedTime": {
-          "description": "If the feature has already been removed, it was removed at this timestamp",
-          "type": "string",
-          "format": "date-time",
-          "x-nullable": true
+        "vectorWeights": {
+          "$ref": "#/definitions/VectorWeights"
         },
-        "sinceTime": {
-          "description": "The deprecation was introduced in this version",
-          "type": "string",
-          "format": "date-time"
+        "vectors": {
+          "description": "This field returns vectors associated with the Object.",
+          "$ref": "#/definitions/Vectors"
+        }
+      }
+    },
+    "ObjectTtlConfig": {
+      "description": "Configure automatic expiry of objects within a class",
+      "type": "object",
+      "properties": {
+        "defaultTtl": {
+          "description": "Time-to-live of an object in seconds. Objects whose ` + "`" + `deleteOn` + "`" + ` timestamp is older than this are removed by a periodic background sweep.",
+          "type": "integer",
+          "format": "int64"
         },
-        "sinceVersion": {
-          "description": "The deprecation was introduced in this version",
+        "deleteOn": {
+          "description": "Timestamp the time-to-live is measured from. Either ` + "`" + `_creationTimeUnix` + "`" + ` (default), ` + "`" + `_lastUpdateTimeUnix` + "`" + ` or the name of a filterable property of data type ` + "`" + `date` + "`" + `. The internal timestamps require ` + "`" + `indexTimestamps` + "`" + ` to be enabled in the inverted index config.",
           "type": "string"
         },
-        "status": {
-          "description": "Whether the problematic API functionality is deprecated (planned to be removed) or already removed",
-          "type": "string"
+        "enabled": {
+          "description": "Whether or not objects in this class expire automatically (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
         }
       }
     },
-    "ErrorResponse": {
-      "description": "An error response given by Weaviate end-points.",
+    "ObjectsGetResponse": {
       "type": "object",
-      "properties": {
-        "error": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "message": {
-                "type": "string"
+      "allOf": [
+        {
+          "$ref": "#/definitions/Object"
+        },
+        {
+          "properties": {
+            "deprecations": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Deprecation"
               }
             }
           }
-        }
-      }
-    },
-    "GeoCoordinates": {
-      "properties": {
-        "latitude": {
-          "description": "The latitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
         },
-        "longitude": {
-          "description": "The longitude of the point on earth in decimal form",
-          "type": "number",
-          "format": "float",
-          "x-nullable": true
-        }
-      }
-    },
-    "GraphQLError": {
-      "description": "An error response caused by a GraphQL query.",
-      "properties": {
-        "locations": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "properties": {
-              "column": {
-                "type": "integer",
-                "format": "int64"
-              },
-              "line": {
-                "type": "integer",
-                "format": "int64"
+        {
+          "properties": {
+            "result": {
+              "description": "Results for this specific Object.",
+              "format": "object",
+              "properties": {
+                "errors": {
+                  "$ref": "#/definitions/ErrorResponse"
+                },
+                "status": {
+                  "type": "string",
+                  "default": "SUCCESS",
+                  "enum": [
+                    "SUCCESS",
+                    "FAILED"
+                  ]
+                }
               }
             }
           }
+        }
+      ]
+    },
+    "ObjectsListResponse": {
+      "description": "List of Objects.",
+      "type": "object",
+      "properties": {
+        "deprecations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Deprecation"
+          }
         },
-        "message": {
-          "type": "string"
-        },
-        "path": {
+        "objects": {
+          "description": "The actual list of Objects.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/Object"
           }
+        },
+        "totalResults": {
+          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "GraphQLQueries": {
-      "description": "A list of GraphQL queries.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/GraphQLQuery"
+    "PatchDocumentAction": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
+      "properties": {
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
+          "type": "string"
+        },
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
+          "type": "string"
+        },
+        "value": {
+          "description": "The value to be used within the operations.",
+          "type": "object"
+        }
       }
     },
-    "GraphQLQuery": {
-      "description": "GraphQL query based on: http://facebook.github.io/graphql/.",
-      "type": "object",
+    "PatchDocumentObject": {
+      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+      "required": [
+        "op",
+        "path"
+      ],
       "properties": {
-        "operationName": {
-          "description": "The name of the operation if multiple exist in the query.",
+        "from": {
+          "description": "A string containing a JSON Pointer value.",
           "type": "string"
         },
-        "query": {
-          "description": "Query based on GraphQL syntax.",
+        "merge": {
+          "$ref": "#/definitions/Object"
+        },
+        "op": {
+          "description": "The operation to be performed.",
+          "type": "string",
+          "enum": [
+            "add",
+            "remove",
+            "replace",
+            "move",
+            "copy",
+            "test"
+          ]
+        },
+        "path": {
+          "description": "A JSON-Pointer.",
           "type": "string"
         },
-        "variables": {
-          "description": "Additional variables for the query.",
+        "value": {
+          "description": "The value to be used within the operations.",
           "type": "object"
         }
       }
     },
-    "GraphQLResponse": {
-      "description": "GraphQL based response: http://facebook.github.io/graphql/.",
+    "PeerUpdate": {
+      "description": "A single peer in the network.",
       "properties": {
-        "data": {
-          "description": "GraphQL data object.",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/JsonObject"
-          }
+        "id": {
+          "description": "The session ID of the peer.",
+          "type": "string",
+          "format": "uuid"
         },
-        "errors": {
-          "description": "Array with errors.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/GraphQLError"
-          },
-          "x-omitempty": true
+        "name": {
+          "description": "Human readable name.",
+          "type": "string"
+        },
+        "schemaHash": {
+          "description": "The latest known hash of the peer's schema.",
+          "type": "string"
+        },
+        "uri": {
+          "description": "The location where the peer is exposed to the internet.",
+          "type": "string",
+          "format": "uri"
         }
       }
     },
-    "GraphQLResponses": {
-      "description": "A list of GraphQL responses.",
+    "PeerUpdateList": {
+      "description": "List of known peers.",
       "type": "array",
       "items": {
-        "$ref": "#/definitions/GraphQLResponse"
+        "$ref": "#/definitions/PeerUpdate"
       }
     },
-    "InvertedIndexConfig": {
-      "description": "Configure the inverted index built into Weaviate (default: 60).",
+    "Permission": {
+      "description": "permissions attached to a role.",
       "type": "object",
+      "required": [
+        "action"
+      ],
       "properties": {
-        "bm25": {
-          "$ref": "#/definitions/BM25Config"
+        "action": {
+          "description": "allowed actions in weaviate.",
+          "type": "string",
+          "enum": [
+            "manage_backups",
+            "read_cluster",
+            "create_data",
+            "read_data",
+            "update_data",
+            "delete_data",
+            "read_nodes",
+            "create_roles",
+            "read_roles",
+            "update_roles",
+            "delete_roles",
+            "create_collections",
+            "read_collections",
+            "update_collections",
+            "delete_collections",
+            "assign_and_revoke_users",
+            "create_users",
+            "read_users",
+            "update_users",
+            "delete_users",
+            "create_tenants",
+            "read_tenants",
+            "update_tenants",
+            "delete_tenants"
+          ]
         },
-        "cleanupIntervalSeconds": {
-          "description": "Asynchronous index clean up happens every n seconds",
-          "type": "number",
-          "format": "int"
+        "backups": {
+          "description": "resources applicable for backup actions",
+          "type": "object",
+          "properties": {
+            "collection": {
+              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            }
+          }
         },
-        "indexNullState": {
-          "description": "Index each object with the null state (default: 'false').",
-          "type": "boolean"
+        "collections": {
+          "description": "resources applicable for collection and/or tenant actions",
+          "type": "object",
+          "properties": {
+            "collection": {
+              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            }
+          }
         },
-        "indexPropertyLength": {
-          "description": "Index length of properties (default: 'false').",
-          "type": "boolean"
+        "data": {
+          "description": "resources applicable for data actions",
+          "type": "object",
+          "properties": {
+            "collection": {
+              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            },
+            "object": {
+              "description": "string or regex. if a specific object ID, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            },
+            "tenant": {
+              "description": "string or regex. if a specific tenant name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            }
+          }
         },
-        "indexTimestamps": {
-          "description": "Index each object by its internal timestamps (default: 'false').",
-          "type": "boolean"
+        "nodes": {
+          "description": "resources applicable for cluster actions",
+          "type": "object",
+          "properties": {
+            "collection": {
+              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            },
+            "verbosity": {
+              "description": "whether to allow (verbose) returning shards and stats data in the response",
+              "type": "string",
+              "default": "minimal",
+              "enum": [
+                "verbose",
+                "minimal"
+              ]
+            }
+          }
+        },
+        "roles": {
+          "description": "resources applicable for role actions",
+          "type": "object",
+          "properties": {
+            "role": {
+              "description": "string or regex. if a specific role name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            },
+            "scope": {
+              "description": "set the scope for the manage role permission",
+              "type": "string",
+              "default": "match",
+              "enum": [
+                "all",
+                "match"
+              ]
+            }
+          }
         },
-        "stopwords": {
-          "$ref": "#/definitions/StopwordConfig"
+        "tenants": {
+          "description": "resources applicable for tenant actions",
+          "type": "object",
+          "properties": {
+            "collection": {
+              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            },
+            "tenant": {
+              "description": "string or regex. if a specific tenant name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            }
+          }
         },
-        "usingBlockMaxWAND": {
-          "description": "Using BlockMax WAND for query execution (default: 'false', will be 'true' for new collections created after 1.30).",
-          "type": "boolean"
+        "users": {
+          "description": "resources applicable for user actions",
+          "type": "object",
+          "properties": {
+            "users": {
+              "description": "string or regex. if a specific name, if left empty it will be ALL or *",
+              "type": "string",
+              "default": "*"
+            }
+          }
         }
       }
     },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
-    "Link": {
-      "type": "object",
+    "PhoneNumber": {
       "properties": {
-        "documentationHref": {
-          "description": "weaviate documentation about this resource group",
-          "type": "string"
+        "countryCode": {
+          "description": "Read-only. The numerical country code (e.g. 49)",
+          "type": "number",
+          "format": "uint64"
         },
-        "href": {
-          "description": "target of the link",
+        "defaultCountry": {
+          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
           "type": "string"
         },
-        "name": {
-          "description": "human readable name of the resource group",
+        "input": {
+          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
           "type": "string"
         },
-        "rel": {
-          "description": "relationship if both resources are related, e.g. 'next', 'previous', 'parent', etc.",
+        "internationalFormatted": {
+          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
           "type": "string"
-        }
-      }
-    },
-    "Meta": {
-      "description": "Contains meta information of the current Weaviate instance.",
-      "type": "object",
-      "properties": {
-        "grpcMaxMessageSize": {
-          "description": "Max message size for GRPC connection in bytes.",
-          "type": "integer"
-        },
-        "hostname": {
-          "description": "The url of the host.",
-          "type": "string",
-          "format": "url"
         },
-        "modules": {
-          "description": "Module-specific meta information.",
-          "type": "object"
+        "national": {
+          "description": "Read-only. The numerical representation of the national part",
+          "type": "number",
+          "format": "uint64"
         },
-        "version": {
-          "description": "The Weaviate server version.",
+        "nationalFormatted": {
+          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
           "type": "string"
+        },
+        "valid": {
+          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
+          "type": "boolean"
         }
       }
     },
-    "MultiTenancyConfig": {
-      "description": "Configuration related to multi-tenancy within a class",
+    "Principal": {
+      "type": "object",
       "properties": {
-        "autoTenantActivation": {
-          "description": "Existing tenants should (not) be turned HOT implicitly when they are accessed and in another activity status (default: false).",
-          "type": "boolean",
-          "x-omitempty": false
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "autoTenantCreation": {
-          "description": "Nonexistent tenants should (not) be created implicitly (default: false).",
-          "type": "boolean",
-          "x-omitempty": false
+        "userType": {
+          "$ref": "#/definitions/UserTypeInput"
         },
-        "enabled": {
-          "description": "Whether or not multi-tenancy is enabled for this class (default: false).",
-          "type": "boolean",
-          "x-omitempty": false
+        "username": {
+          "description": "The username that was extracted either from the authentication information",
+          "type": "string"
         }
       }
     },
-    "MultipleRef": {
-      "description": "Multiple instances of references to other objects.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/SingleRef"
-      }
-    },
-    "NestedProperty": {
+    "Property": {
       "type": "object",
       "properties": {
         "dataType": {
+          "description": "Data type of the property (required). If it starts with a capital (for example Person), may be a reference to another type.",
           "type": "array",
           "items": {
             "type": "string"
           }
         },
         "description": {
+          "description": "Description of the property.",
           "type": "string"
         },
         "indexFilterable": {
+          "description": "Whether to include this property in the filterable, Roaring Bitmap index. If ` + "`" + `false` + "`" + `, this property cannot be used in ` + "`" + `where` + "`" + ` filters. \u003cbr/\u003e\u003cbr/\u003eNote: Unrelated to vectorization behavior.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "indexInverted": {
+          "description": "(Deprecated). Whether to include this property in the inverted index. If ` + "`" + `false` + "`" + `, this property cannot be used in ` + "`" + `where` + "`" + ` filters, ` + "`" + `bm25` + "`" + ` or ` + "`" + `hybrid` + "`" + ` search. \u003cbr/\u003e\u003cbr/\u003eUnrelated to vectorization behavior (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
           "type": "boolean",
           "x-nullable": true
         },
         "indexRangeFilters": {
+          "description": "Whether to include this property in the filterable, range-based Roaring Bitmap index. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date.",
           "type": "boolean",
           "x-nullable": true
         },
         "indexSearchable": {
+          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
           "type": "boolean",
           "x-nullable": true
         },
+        "moduleConfig": {
+          "description": "Configuration specific to modules this Weaviate instance has installed",
+          "type": "object"
+        },
         "name": {
+          "description": "The name of the property (required). Multiple words should be concatenated in camelCase, e.g. ` + "`" + `nameOfAuthor` + "`" + `.",
           "type": "string"
         },
         "nestedProperties": {
@@ -6000,6 +7003,7 @@ func init() {
           "x-omitempty": true
         },
         "tokenization": {
+          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
           "type": "string",
           "enum": [
             "word",
@@ -6015,1642 +7019,1786 @@ func init() {
         }
       }
     },
-    "NodeShardStatus": {
-      "description": "The definition of a node shard status response body",
+    "PropertySchema": {
+      "description": "Names and values of an individual property. A returned response may also contain additional metadata, such as from classification or feature projection.",
+      "type": "object"
+    },
+    "RaftStatistics": {
+      "description": "The definition of Raft statistics.",
       "properties": {
-        "class": {
-          "description": "The name of shard's class.",
-          "type": "string",
-          "x-omitempty": false
+        "appliedIndex": {
+          "type": "string"
         },
-        "compressed": {
-          "description": "The status of vector compression/quantization.",
-          "format": "boolean",
-          "x-omitempty": false
+        "commitIndex": {
+          "type": "string"
         },
-        "loaded": {
-          "description": "The load status of the shard.",
-          "type": "boolean",
-          "x-omitempty": false
+        "fsmPending": {
+          "type": "string"
         },
-        "name": {
-          "description": "The name of the shard.",
-          "type": "string",
-          "x-omitempty": false
+        "lastContact": {
+          "type": "string"
+        },
+        "lastLogIndex": {
+          "type": "string"
+        },
+        "lastLogTerm": {
+          "type": "string"
+        },
+        "lastSnapshotIndex": {
+          "type": "string"
+        },
+        "lastSnapshotTerm": {
+          "type": "string"
+        },
+        "latestConfiguration": {
+          "description": "Weaviate Raft nodes.",
+          "type": "object"
+        },
+        "latestConfigurationIndex": {
+          "type": "string"
+        },
+        "numPeers": {
+          "type": "string"
+        },
+        "protocolVersion": {
+          "type": "string"
+        },
+        "protocolVersionMax": {
+          "type": "string"
+        },
+        "protocolVersionMin": {
+          "type": "string"
+        },
+        "snapshotVersionMax": {
+          "type": "string"
+        },
+        "snapshotVersionMin": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "term": {
+          "type": "string"
+        }
+      }
+    },
+    "ReferenceMetaClassification": {
+      "description": "This meta field contains additional info about the classified reference property",
+      "properties": {
+        "closestLosingDistance": {
+          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "closestOverallDistance": {
+          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
+          "type": "number",
+          "format": "float32"
+        },
+        "closestWinningDistance": {
+          "description": "Closest distance of a neighbor from the winning group",
+          "type": "number",
+          "format": "float32"
+        },
+        "losingCount": {
+          "description": "size of the losing group, can be 0 if the winning group size equals k",
+          "type": "number",
+          "format": "int64"
+        },
+        "losingDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
+        },
+        "meanLosingDistance": {
+          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
+          "type": "number",
+          "format": "float32",
+          "x-nullable": true
         },
-        "objectCount": {
-          "description": "The number of objects in shard.",
+        "meanWinningDistance": {
+          "description": "Mean distance of all neighbors from the winning group",
           "type": "number",
-          "format": "int64",
-          "x-omitempty": false
+          "format": "float32"
         },
-        "vectorIndexingStatus": {
-          "description": "The status of the vector indexing process.",
-          "format": "string",
-          "x-omitempty": false
+        "overallCount": {
+          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
+          "type": "number",
+          "format": "int64"
         },
-        "vectorQueueLength": {
-          "description": "The length of the vector indexing queue.",
+        "winningCount": {
+          "description": "size of the winning group, a number between 1..k",
           "type": "number",
-          "format": "int64",
-          "x-omitempty": false
+          "format": "int64"
+        },
+        "winningDistance": {
+          "description": "deprecated - do not use, to be removed in 0.23.0",
+          "type": "number",
+          "format": "float32"
         }
       }
     },
-    "NodeStats": {
-      "description": "The summary of Weaviate's statistics.",
+    "ReplicationConfig": {
+      "description": "Configure how replication is executed in a cluster",
+      "type": "object",
       "properties": {
-        "objectCount": {
-          "description": "The total number of objects in DB.",
-          "type": "number",
-          "format": "int64",
+        "asyncEnabled": {
+          "description": "Enable asynchronous replication (default: false).",
+          "type": "boolean",
           "x-omitempty": false
         },
-        "shardCount": {
-          "description": "The count of Weaviate's shards. To see this value, set ` + "`" + `output` + "`" + ` to ` + "`" + `verbose` + "`" + `.",
-          "type": "number",
-          "format": "int",
-          "x-omitempty": false
+        "deletionStrategy": {
+          "description": "Conflict resolution strategy for deleted objects.",
+          "type": "string",
+          "enum": [
+            "NoAutomatedResolution",
+            "DeleteOnConflict",
+            "TimeBasedResolution"
+          ],
+          "x-omitempty": true
+        },
+        "factor": {
+          "description": "Number of times a class is replicated (default: 1).",
+          "type": "integer"
         }
       }
     },
-    "NodeStatus": {
-      "description": "The definition of a backup node status response body",
+    "ReplicationDeleteReplicaRequest": {
+      "description": "Request body to delete a replica of given shard of a given collection",
+      "type": "object",
+      "required": [
+        "nodeName",
+        "collectionId",
+        "shardId"
+      ],
       "properties": {
-        "batchStats": {
-          "description": "Weaviate batch statistics.",
-          "type": "object",
-          "$ref": "#/definitions/BatchStats"
-        },
-        "gitHash": {
-          "description": "The gitHash of Weaviate.",
+        "collectionId": {
+          "description": "The collection name holding the replica to be delete",
           "type": "string"
         },
-        "name": {
-          "description": "The name of the node.",
+        "nodeName": {
+          "description": "The node containing the replica to be deleted",
           "type": "string"
         },
-        "shards": {
-          "description": "The list of the shards with it's statistics.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NodeShardStatus"
-          }
-        },
-        "stats": {
-          "description": "Weaviate overall statistics.",
-          "type": "object",
-          "$ref": "#/definitions/NodeStats"
-        },
-        "status": {
-          "description": "Node's status.",
-          "type": "string",
-          "default": "HEALTHY",
-          "enum": [
-            "HEALTHY",
-            "UNHEALTHY",
-            "UNAVAILABLE",
-            "TIMEOUT"
-          ]
-        },
-        "version": {
-          "description": "The version of Weaviate.",
+        "shardId": {
+          "description": "The shard id holding the replica to be deleted",
           "type": "string"
         }
       }
     },
-    "NodesStatusResponse": {
-      "description": "The status of all of the Weaviate nodes",
+    "ReplicationDisableReplicaRequest": {
+      "description": "Request body to disable (soft-delete) a replica of given shard of a given collection",
       "type": "object",
+      "required": [
+        "nodeName",
+        "collectionId",
+        "shardId"
+      ],
       "properties": {
-        "nodes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NodeStatus"
-          }
+        "collectionId": {
+          "description": "The collection name holding the replica to be disabled",
+          "type": "string"
+        },
+        "nodeName": {
+          "description": "The node containing the replica to be disabled",
+          "type": "string"
+        },
+        "shardId": {
+          "description": "The shard id holding the replica to be disabled",
+          "type": "string"
         }
       }
     },
-    "Object": {
+    "ReplicationRebalanceMove": {
+      "description": "A move of a shard replica from one node to another",
       "type": "object",
       "properties": {
-        "additional": {
-          "$ref": "#/definitions/AdditionalProperties"
-        },
-        "class": {
-          "description": "Class of the Object, defined in the schema.",
+        "collectionId": {
+          "description": "The collection name holding the shard",
           "type": "string"
         },
-        "creationTimeUnix": {
-          "description": "(Response only) Timestamp of creation of this object in milliseconds since epoch UTC.",
-          "type": "integer",
-          "format": "int64"
-        },
-        "id": {
-          "description": "ID of the Object.",
-          "type": "string",
-          "format": "uuid"
-        },
-        "lastUpdateTimeUnix": {
-          "description": "(Response only) Timestamp of the last object update in milliseconds since epoch UTC.",
+        "diskBytes": {
+          "description": "The size of the shard on disk at the time the move was planned",
           "type": "integer",
           "format": "int64"
         },
-        "properties": {
-          "$ref": "#/definitions/PropertySchema"
+        "error": {
+          "description": "The reason a move failed",
+          "type": "string"
         },
-        "tenant": {
-          "description": "Name of the Objects tenant.",
+        "shardId": {
+          "description": "The id of the moved shard",
           "type": "string"
         },
-        "vector": {
-          "description": "This field returns vectors associated with the Object. C11yVector, Vector or Vectors values are possible.",
-          "$ref": "#/definitions/C11yVector"
+        "sourceNodeId": {
+          "description": "The node the replica is moved from",
+          "type": "string"
         },
-        "vectorWeights": {
-          "$ref": "#/definitions/VectorWeights"
+        "status": {
+          "description": "The state of the move, only set for moves of a started rebalance",
+          "type": "string",
+          "enum": [
+            "PENDING",
+            "REPLICATING",
+            "DONE",
+            "FAILED",
+            "CANCELED"
+          ]
         },
-        "vectors": {
-          "description": "This field returns vectors associated with the Object.",
-          "$ref": "#/definitions/Vectors"
+        "targetNodeId": {
+          "description": "The node the replica is moved to",
+          "type": "string"
         }
       }
     },
-    "ObjectsGetResponse": {
+    "ReplicationRebalanceNodeLoad": {
+      "description": "The shard replicas held by a node",
       "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Object"
+      "properties": {
+        "diskBytes": {
+          "description": "The size of the shard replicas held by the node on disk",
+          "type": "integer",
+          "format": "int64"
         },
-        {
-          "properties": {
-            "deprecations": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Deprecation"
-              }
-            }
-          }
+        "nodeId": {
+          "description": "The name of the node",
+          "type": "string"
         },
-        {
-          "properties": {
-            "result": {
-              "description": "Results for this specific Object.",
-              "format": "object",
-              "properties": {
-                "errors": {
-                  "$ref": "#/definitions/ErrorResponse"
-                },
-                "status": {
-                  "type": "string",
-                  "default": "SUCCESS",
-                  "enum": [
-                    "SUCCESS",
-                    "FAILED"
-                  ]
-                }
-              }
-            }
-          }
+        "shardCount": {
+          "description": "The number of shard replicas held by the node",
+          "type": "integer",
+          "format": "int64"
         }
-      ]
+      }
     },
-    "ObjectsListResponse": {
-      "description": "List of Objects.",
+    "ReplicationRebalancePlan": {
+      "description": "The moves which balance the shard replicas across the nodes",
       "type": "object",
       "properties": {
-        "deprecations": {
+        "current": {
+          "description": "The load of the nodes before the moves",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Deprecation"
+            "$ref": "#/definitions/ReplicationRebalanceNodeLoad"
           }
         },
-        "objects": {
-          "description": "The actual list of Objects.",
+        "moves": {
+          "description": "The planned moves",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Object"
+            "$ref": "#/definitions/ReplicationRebalanceMove"
           }
         },
-        "totalResults": {
-          "description": "The total number of Objects for the query. The number of items in a response may be smaller due to paging.",
+        "planned": {
+          "description": "The load of the nodes once all moves completed",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReplicationRebalanceNodeLoad"
+          }
+        }
+      }
+    },
+    "ReplicationRebalanceRequest": {
+      "description": "Options of a shard rebalance",
+      "type": "object",
+      "properties": {
+        "maxConcurrentMoves": {
+          "description": "The maximum number of moves replicating shards at the same time",
           "type": "integer",
-          "format": "int64"
+          "default": 2,
+          "minimum": 1
         }
       }
     },
-    "PatchDocumentAction": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
-      "required": [
-        "op",
-        "path"
-      ],
+    "ReplicationRebalanceStatus": {
+      "description": "The state of a shard rebalance",
+      "type": "object",
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
-          "type": "string"
+        "automatic": {
+          "description": "Whether the rebalance was started because nodes joined or left the cluster",
+          "type": "boolean"
         },
-        "merge": {
-          "$ref": "#/definitions/Object"
+        "moves": {
+          "description": "The moves of the rebalance",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReplicationRebalanceMove"
+          }
+        },
+        "startedAt": {
+          "description": "Timestamp of when the rebalance started",
+          "type": "string",
+          "format": "date-time"
         },
-        "op": {
-          "description": "The operation to be performed.",
+        "status": {
+          "description": "The state of the rebalance",
           "type": "string",
           "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
+            "IDLE",
+            "RUNNING",
+            "PAUSED",
+            "CANCELED",
+            "FINISHED"
           ]
-        },
-        "path": {
-          "description": "A JSON-Pointer.",
-          "type": "string"
-        },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
         }
       }
     },
-    "PatchDocumentObject": {
-      "description": "Either a JSONPatch document as defined by RFC 6902 (from, op, path, value), or a merge document (RFC 7396).",
+    "ReplicationReplicateDetailsReplicaResponse": {
+      "description": "The current status and details of a replication operation, including information about the resources involved in the replication process.",
       "required": [
-        "op",
-        "path"
+        "id",
+        "shardId",
+        "sourceNodeId",
+        "targetNodeId",
+        "collection",
+        "status"
       ],
       "properties": {
-        "from": {
-          "description": "A string containing a JSON Pointer value.",
+        "collection": {
+          "description": "The name of the collection holding data being replicated.",
           "type": "string"
         },
-        "merge": {
-          "$ref": "#/definitions/Object"
+        "id": {
+          "description": "The unique id of the replication operation.",
+          "type": "string"
         },
-        "op": {
-          "description": "The operation to be performed.",
+        "shardId": {
+          "description": "The id of the shard to collect replication details for.",
+          "type": "string"
+        },
+        "sourceNodeId": {
+          "description": "The id of the node where the source replica is allocated.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The current status of the replication operation, indicating the replication phase the operation is in.",
           "type": "string",
           "enum": [
-            "add",
-            "remove",
-            "replace",
-            "move",
-            "copy",
-            "test"
+            "READY",
+            "INDEXING",
+            "REPLICATION_FINALIZING",
+            "REPLICATION_HYDRATING",
+            "REPLICATION_DEHYDRATING"
           ]
         },
-        "path": {
-          "description": "A JSON-Pointer.",
+        "targetNodeId": {
+          "description": "The id of the node where the target replica is allocated.",
           "type": "string"
-        },
-        "value": {
-          "description": "The value to be used within the operations.",
-          "type": "object"
         }
       }
     },
-    "PeerUpdate": {
-      "description": "A single peer in the network.",
+    "ReplicationReplicateReplicaRequest": {
+      "description": "Request body to add a replica of given shard of a given collection",
+      "type": "object",
+      "required": [
+        "sourceNodeName",
+        "destinationNodeName",
+        "collectionId",
+        "shardId"
+      ],
       "properties": {
-        "id": {
-          "description": "The session ID of the peer.",
-          "type": "string",
-          "format": "uuid"
+        "collectionId": {
+          "description": "The collection name holding the shard",
+          "type": "string"
         },
-        "name": {
-          "description": "Human readable name.",
+        "destinationNodeName": {
+          "description": "The node to add a copy of the replica on",
           "type": "string"
         },
-        "schemaHash": {
-          "description": "The latest known hash of the peer's schema.",
+        "shardId": {
+          "description": "The shard id holding the replica to be copied",
           "type": "string"
         },
-        "uri": {
-          "description": "The location where the peer is exposed to the internet.",
-          "type": "string",
-          "format": "uri"
+        "sourceNodeName": {
+          "description": "The node containing the replica",
+          "type": "string"
         }
       }
     },
-    "PeerUpdateList": {
-      "description": "List of known peers.",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/PeerUpdate"
-      }
-    },
-    "Permission": {
-      "description": "permissions attached to a role.",
+    "ReplicationSplitShardRequest": {
+      "description": "Request body to split a shard of a single-tenant collection into new shards",
       "type": "object",
       "required": [
-        "action"
+        "collectionId",
+        "shardId"
       ],
-      "properties": {
-        "action": {
-          "description": "allowed actions in weaviate.",
-          "type": "string",
-          "enum": [
-            "manage_backups",
-            "read_cluster",
-            "create_data",
-            "read_data",
-            "update_data",
-            "delete_data",
-            "read_nodes",
-            "create_roles",
-            "read_roles",
-            "update_roles",
-            "delete_roles",
-            "create_collections",
-            "read_collections",
-            "update_collections",
-            "delete_collections",
-            "assign_and_revoke_users",
-            "create_users",
-            "read_users",
-            "update_users",
-            "delete_users",
-            "create_tenants",
-            "read_tenants",
-            "update_tenants",
-            "delete_tenants"
-          ]
-        },
-        "backups": {
-          "description": "resources applicable for backup actions",
-          "type": "object",
-          "properties": {
-            "collection": {
-              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
-            }
-          }
-        },
-        "collections": {
-          "description": "resources applicable for collection and/or tenant actions",
-          "type": "object",
-          "properties": {
-            "collection": {
-              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
-            }
-          }
-        },
-        "data": {
-          "description": "resources applicable for data actions",
-          "type": "object",
-          "properties": {
-            "collection": {
-              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
-            },
-            "object": {
-              "description": "string or regex. if a specific object ID, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
-            },
-            "tenant": {
-              "description": "string or regex. if a specific tenant name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
-            }
-          }
-        },
-        "nodes": {
-          "description": "resources applicable for cluster actions",
-          "type": "object",
-          "properties": {
-            "collection": {
-              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
-            },
-            "verbosity": {
-              "description": "whether to allow (verbose) returning shards and stats data in the response",
-              "type": "string",
-              "default": "minimal",
-              "enum": [
-                "verbose",
-                "minimal"
-              ]
-            }
-          }
-        },
-        "roles": {
-          "description": "resources applicable for role actions",
-          "type": "object",
-          "properties": {
-            "role": {
-              "description": "string or regex. if a specific role name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
-            },
-            "scope": {
-              "description": "set the scope for the manage role permission",
-              "type": "string",
-              "default": "match",
-              "enum": [
-                "all",
-                "match"
-              ]
-            }
-          }
-        },
-        "tenants": {
-          "description": "resources applicable for tenant actions",
-          "type": "object",
-          "properties": {
-            "collection": {
-              "description": "string or regex. if a specific collection name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
-            },
-            "tenant": {
-              "description": "string or regex. if a specific tenant name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
-            }
-          }
-        },
-        "users": {
-          "description": "resources applicable for user actions",
-          "type": "object",
-          "properties": {
-            "users": {
-              "description": "string or regex. if a specific name, if left empty it will be ALL or *",
-              "type": "string",
-              "default": "*"
-            }
-          }
+      "properties": {
+        "collectionId": {
+          "description": "The collection name holding the shard",
+          "type": "string"
+        },
+        "parts": {
+          "description": "The number of shards to split the shard into",
+          "type": "integer",
+          "default": 2
+        },
+        "shardId": {
+          "description": "The shard id of the shard to be split",
+          "type": "string"
         }
       }
     },
-    "PhoneNumber": {
+    "ReplicationSplitShardResponse": {
+      "description": "The shards a shard is being split into",
+      "type": "object",
       "properties": {
-        "countryCode": {
-          "description": "Read-only. The numerical country code (e.g. 49)",
-          "type": "number",
-          "format": "uint64"
-        },
-        "defaultCountry": {
-          "description": "Optional. The ISO 3166-1 alpha-2 country code. This is used to figure out the correct countryCode and international format if only a national number (e.g. 0123 4567) is provided",
+        "collectionId": {
+          "description": "The collection name holding the shard",
           "type": "string"
         },
-        "input": {
-          "description": "The raw input as the phone number is present in your raw data set. It will be parsed into the standardized formats if valid.",
+        "shardId": {
+          "description": "The shard id of the shard being split",
           "type": "string"
         },
-        "internationalFormatted": {
-          "description": "Read-only. Parsed result in the international format (e.g. +49 123 ...)",
+        "targetShardIds": {
+          "description": "The ids of the new shards, which replace the shard once the split completed",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "RestoreConfig": {
+      "description": "Backup custom configuration",
+      "type": "object",
+      "properties": {
+        "Bucket": {
+          "description": "Name of the bucket, container, volume, etc",
           "type": "string"
         },
-        "national": {
-          "description": "Read-only. The numerical representation of the national part",
-          "type": "number",
-          "format": "uint64"
+        "CPUPercentage": {
+          "description": "Desired CPU core utilization ranging from 1%-80%",
+          "type": "integer",
+          "default": 50,
+          "maximum": 80,
+          "minimum": 1,
+          "x-nullable": false
         },
-        "nationalFormatted": {
-          "description": "Read-only. Parsed result in the national format (e.g. 0123 456789)",
+        "Endpoint": {
+          "description": "name of the endpoint, e.g. s3.amazonaws.com",
           "type": "string"
         },
-        "valid": {
-          "description": "Read-only. Indicates whether the parsed number is a valid phone number",
-          "type": "boolean"
+        "Path": {
+          "description": "Path within the bucket",
+          "type": "string"
+        },
+        "RestoreUntilUnix": {
+          "description": "Point in time, in milliseconds since epoch UTC, up to which the logs archived after the backup are replayed. Requires log archiving to have been enabled when the backup was created. Logs are archived in rounds, every PERSISTENCE_LOG_ARCHIVE_INTERVAL_SECONDS, and only rounds archived up to this time are replayed, so writes made up to one interval before it may not be restored. The time actually restored to is logged by each node.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "Principal": {
+    "Role": {
       "type": "object",
+      "required": [
+        "name",
+        "permissions"
+      ],
       "properties": {
-        "groups": {
+        "name": {
+          "description": "role name",
+          "type": "string"
+        },
+        "permissions": {
           "type": "array",
           "items": {
-            "type": "string"
+            "description": "list of permissions (level, action, resource)",
+            "type": "object",
+            "$ref": "#/definitions/Permission"
           }
-        },
-        "userType": {
-          "$ref": "#/definitions/UserTypeInput"
-        },
-        "username": {
-          "description": "The username that was extracted either from the authentication information",
-          "type": "string"
         }
       }
     },
-    "Property": {
+    "RolesListResponse": {
+      "description": "list of roles",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/Role"
+      }
+    },
+    "Schema": {
+      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
       "type": "object",
       "properties": {
-        "dataType": {
-          "description": "Data type of the property (required). If it starts with a capital (for example Person), may be a reference to another type.",
+        "classes": {
+          "description": "Semantic classes that are available.",
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/Class"
           }
         },
-        "description": {
-          "description": "Description of the property.",
-          "type": "string"
+        "maintainer": {
+          "description": "Email of the maintainer.",
+          "type": "string",
+          "format": "email"
         },
-        "indexFilterable": {
-          "description": "Whether to include this property in the filterable, Roaring Bitmap index. If ` + "`" + `false` + "`" + `, this property cannot be used in ` + "`" + `where` + "`" + ` filters. \u003cbr/\u003e\u003cbr/\u003eNote: Unrelated to vectorization behavior.",
-          "type": "boolean",
-          "x-nullable": true
+        "name": {
+          "description": "Name of the schema.",
+          "type": "string"
+        }
+      }
+    },
+    "SchemaClusterStatus": {
+      "description": "Indicates the health of the schema in a cluster.",
+      "type": "object",
+      "properties": {
+        "error": {
+          "description": "Contains the sync check error if one occurred",
+          "type": "string",
+          "x-omitempty": true
         },
-        "indexInverted": {
-          "description": "(Deprecated). Whether to include this property in the inverted index. If ` + "`" + `false` + "`" + `, this property cannot be used in ` + "`" + `where` + "`" + ` filters, ` + "`" + `bm25` + "`" + ` or ` + "`" + `hybrid` + "`" + ` search. \u003cbr/\u003e\u003cbr/\u003eUnrelated to vectorization behavior (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)",
+        "healthy": {
+          "description": "True if the cluster is in sync, false if there is an issue (see error).",
           "type": "boolean",
-          "x-nullable": true
+          "x-omitempty": false
         },
-        "indexRangeFilters": {
-          "description": "Whether to include this property in the filterable, range-based Roaring Bitmap index. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date.",
-          "type": "boolean",
-          "x-nullable": true
+        "hostname": {
+          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
+          "type": "string"
         },
-        "indexSearchable": {
-          "description": "Optional. Should this property be indexed in the inverted index. Defaults to true. Applicable only to properties of data type text and text[]. If you choose false, you will not be able to use this property in bm25 or hybrid search. This property has no affect on vectorization decisions done by modules",
+        "ignoreSchemaSync": {
+          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
           "type": "boolean",
-          "x-nullable": true
-        },
-        "moduleConfig": {
-          "description": "Configuration specific to modules this Weaviate instance has installed",
-          "type": "object"
+          "x-omitempty": false
         },
+        "nodeCount": {
+          "description": "Number of nodes that participated in the sync check",
+          "type": "number",
+          "format": "int"
+        }
+      }
+    },
+    "SchemaHistory": {
+      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
+      "type": "object"
+    },
+    "ShardStatus": {
+      "description": "The status of a single shard",
+      "properties": {
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
+        }
+      }
+    },
+    "ShardStatusGetResponse": {
+      "description": "Response body of shard status get request",
+      "properties": {
         "name": {
-          "description": "The name of the property (required). Multiple words should be concatenated in camelCase, e.g. ` + "`" + `nameOfAuthor` + "`" + `.",
+          "description": "Name of the shard",
           "type": "string"
         },
-        "nestedProperties": {
-          "description": "The properties of the nested object(s). Applies to object and object[] data types.",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/NestedProperty"
-          },
-          "x-omitempty": true
+        "status": {
+          "description": "Status of the shard",
+          "type": "string"
         },
-        "tokenization": {
-          "description": "Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are ` + "`" + `word` + "`" + ` (default; splits on any non-alphanumerical, lowercases), ` + "`" + `lowercase` + "`" + ` (splits on white spaces, lowercases), ` + "`" + `whitespace` + "`" + ` (splits on white spaces), ` + "`" + `field` + "`" + ` (trims). Not supported for remaining data types",
-          "type": "string",
-          "enum": [
-            "word",
-            "lowercase",
-            "whitespace",
-            "field",
-            "trigram",
-            "gse",
-            "kagome_kr",
-            "kagome_ja",
-            "gse_ch"
-          ]
+        "vectorQueueSize": {
+          "description": "Size of the vector queue of the shard",
+          "type": "integer",
+          "x-omitempty": false
         }
       }
     },
-    "PropertySchema": {
-      "description": "Names and values of an individual property. A returned response may also contain additional metadata, such as from classification or feature projection.",
-      "type": "object"
+    "ShardStatusList": {
+      "description": "The status of all the shards of a Class",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/ShardStatusGetResponse"
+      }
     },
-    "RaftStatistics": {
-      "description": "The definition of Raft statistics.",
+    "SingleRef": {
+      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
       "properties": {
-        "appliedIndex": {
-          "type": "string"
+        "beacon": {
+          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
+          "type": "string",
+          "format": "uri"
         },
-        "commitIndex": {
-          "type": "string"
+        "class": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
+          "type": "string",
+          "format": "uri"
         },
-        "fsmPending": {
-          "type": "string"
+        "classification": {
+          "description": "Additional Meta information about classifications if the item was part of one",
+          "$ref": "#/definitions/ReferenceMetaClassification"
         },
-        "lastContact": {
-          "type": "string"
+        "href": {
+          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
+          "type": "string",
+          "format": "uri"
         },
-        "lastLogIndex": {
-          "type": "string"
+        "schema": {
+          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "Statistics": {
+      "description": "The definition of node statistics.",
+      "properties": {
+        "bootstrapped": {
+          "type": "boolean"
+        },
+        "candidates": {
+          "type": "object"
+        },
+        "dbLoaded": {
+          "type": "boolean"
         },
-        "lastLogTerm": {
-          "type": "string"
+        "initialLastAppliedIndex": {
+          "type": "number",
+          "format": "uint64"
         },
-        "lastSnapshotIndex": {
-          "type": "string"
+        "isVoter": {
+          "type": "boolean"
         },
-        "lastSnapshotTerm": {
-          "type": "string"
+        "lastAppliedIndex": {
+          "type": "number"
         },
-        "latestConfiguration": {
-          "description": "Weaviate Raft nodes.",
+        "leaderAddress": {
           "type": "object"
         },
-        "latestConfigurationIndex": {
-          "type": "string"
-        },
-        "numPeers": {
-          "type": "string"
+        "leaderId": {
+          "type": "object"
         },
-        "protocolVersion": {
+        "name": {
+          "description": "The name of the node.",
           "type": "string"
         },
-        "protocolVersionMax": {
-          "type": "string"
+        "open": {
+          "type": "boolean"
         },
-        "protocolVersionMin": {
-          "type": "string"
+        "raft": {
+          "description": "Weaviate Raft statistics.",
+          "type": "object",
+          "$ref": "#/definitions/RaftStatistics"
         },
-        "snapshotVersionMax": {
-          "type": "string"
+        "ready": {
+          "type": "boolean"
         },
-        "snapshotVersionMin": {
-          "type": "string"
+        "status": {
+          "description": "Node's status.",
+          "type": "string",
+          "default": "HEALTHY",
+          "enum": [
+            "HEALTHY",
+            "UNHEALTHY",
+            "UNAVAILABLE",
+            "TIMEOUT"
+          ]
+        }
+      }
+    },
+    "StopwordConfig": {
+      "description": "fine-grained control over stopword list usage",
+      "type": "object",
+      "properties": {
+        "additions": {
+          "description": "Stopwords to be considered additionally (default: []). Can be any array of custom strings.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "state": {
+        "preset": {
+          "description": "Pre-existing list of common words by language (default: 'en'). Options: ['en', 'none'].",
           "type": "string"
         },
-        "term": {
-          "type": "string"
+        "removals": {
+          "description": "Stopwords to be removed from consideration (default: []). Can be any array of custom strings.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "ReferenceMetaClassification": {
-      "description": "This meta field contains additional info about the classified reference property",
+    "SynonymConfig": {
+      "description": "Synonyms that the terms of keyword (BM25) queries are expanded with. Synonyms are matched case-insensitively and only apply to properties with 'word' or 'lowercase' tokenization.",
+      "type": "object",
       "properties": {
-        "closestLosingDistance": {
-          "description": "The lowest distance of a neighbor in the losing group. Optional. If k equals the size of the winning group, there is no losing group",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "closestOverallDistance": {
-          "description": "The lowest distance of any neighbor, regardless of whether they were in the winning or losing group",
-          "type": "number",
-          "format": "float32"
-        },
-        "closestWinningDistance": {
-          "description": "Closest distance of a neighbor from the winning group",
-          "type": "number",
-          "format": "float32"
-        },
-        "losingCount": {
-          "description": "size of the losing group, can be 0 if the winning group size equals k",
-          "type": "number",
-          "format": "int64"
-        },
-        "losingDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "meanLosingDistance": {
-          "description": "Mean distance of all neighbors from the losing group. Optional. If k equals the size of the winning group, there is no losing group.",
-          "type": "number",
-          "format": "float32",
-          "x-nullable": true
-        },
-        "meanWinningDistance": {
-          "description": "Mean distance of all neighbors from the winning group",
-          "type": "number",
-          "format": "float32"
-        },
-        "overallCount": {
-          "description": "overall neighbors checked as part of the classification. In most cases this will equal k, but could be lower than k - for example if not enough data was present",
-          "type": "number",
-          "format": "int64"
-        },
-        "winningCount": {
-          "description": "size of the winning group, a number between 1..k",
-          "type": "number",
-          "format": "int64"
+        "sets": {
+          "description": "Groups of equivalent terms (default: []).",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SynonymSet"
+          }
+        }
+      }
+    },
+    "SynonymSet": {
+      "description": "A group of equivalent terms, a query containing one of them also matches the others",
+      "type": "object",
+      "properties": {
+        "terms": {
+          "description": "The equivalent terms, each a single token.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "winningDistance": {
-          "description": "deprecated - do not use, to be removed in 0.23.0",
+        "weight": {
+          "description": "Weight of matches through a synonym relative to matches of the query term itself, between 0 and 1 (default: 1).",
           "type": "number",
-          "format": "float32"
+          "format": "float"
         }
       }
     },
-    "ReplicationConfig": {
-      "description": "Configure how replication is executed in a cluster",
+    "Tenant": {
+      "description": "attributes representing a single tenant within weaviate",
       "type": "object",
       "properties": {
-        "asyncEnabled": {
-          "description": "Enable asynchronous replication (default: false).",
-          "type": "boolean",
-          "x-omitempty": false
-        },
-        "deletionStrategy": {
-          "description": "Conflict resolution strategy for deleted objects.",
+        "activityStatus": {
+          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `ACTIVE` + "`" + `) and required for updating tenant. For creation, allowed values are ` + "`" + `ACTIVE` + "`" + ` - tenant is fully active and ` + "`" + `INACTIVE` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally. For updating, ` + "`" + `ACTIVE` + "`" + `, ` + "`" + `INACTIVE` + "`" + ` and also ` + "`" + `OFFLOADED` + "`" + ` - as INACTIVE, but files are stored on cloud storage. The following values are read-only and are set by the server for internal use: ` + "`" + `OFFLOADING` + "`" + ` - tenant is transitioning from ACTIVE/INACTIVE to OFFLOADED, ` + "`" + `ONLOADING` + "`" + ` - tenant is transitioning from OFFLOADED to ACTIVE/INACTIVE. We still accept deprecated names ` + "`" + `HOT` + "`" + ` (now ` + "`" + `ACTIVE` + "`" + `), ` + "`" + `COLD` + "`" + ` (now ` + "`" + `INACTIVE` + "`" + `), ` + "`" + `FROZEN` + "`" + ` (now ` + "`" + `OFFLOADED` + "`" + `), ` + "`" + `FREEZING` + "`" + ` (now ` + "`" + `OFFLOADING` + "`" + `), ` + "`" + `UNFREEZING` + "`" + ` (now ` + "`" + `ONLOADING` + "`" + `).",
           "type": "string",
           "enum": [
-            "NoAutomatedResolution",
-            "DeleteOnConflict",
-            "TimeBasedResolution"
-          ],
-          "x-omitempty": true
+            "ACTIVE",
+            "INACTIVE",
+            "OFFLOADED",
+            "OFFLOADING",
+            "ONLOADING",
+            "HOT",
+            "COLD",
+            "FROZEN",
+            "FREEZING",
+            "UNFREEZING"
+          ]
         },
-        "factor": {
-          "description": "Number of times a class is replicated (default: 1).",
-          "type": "integer"
+        "name": {
+          "description": "The name of the tenant (required).",
+          "type": "string"
+        },
+        "usage": {
+          "$ref": "#/definitions/TenantUsage"
         }
       }
     },
-    "ReplicationDeleteReplicaRequest": {
-      "description": "Request body to delete a replica of given shard of a given collection",
+    "TenantQuota": {
+      "description": "Limits applying to each tenant of a multi-tenant class. Writes which would exceed them are rejected.",
       "type": "object",
-      "required": [
-        "nodeName",
-        "collectionId",
-        "shardId"
-      ],
       "properties": {
-        "collectionId": {
-          "description": "The collection name holding the replica to be delete",
-          "type": "string"
-        },
-        "nodeName": {
-          "description": "The node containing the replica to be deleted",
-          "type": "string"
+        "maxDiskBytes": {
+          "description": "Maximum size on disk per tenant in bytes. Once reached, only deletes are accepted. 0 means unlimited (default).",
+          "type": "integer",
+          "format": "int64"
         },
-        "shardId": {
-          "description": "The shard id holding the replica to be deleted",
-          "type": "string"
+        "maxObjectCount": {
+          "description": "Maximum number of objects per tenant. 0 means unlimited (default).",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "ReplicationDisableReplicaRequest": {
-      "description": "Request body to disable (soft-delete) a replica of given shard of a given collection",
+    "TenantResponse": {
+      "description": "attributes representing a single tenant response within weaviate",
       "type": "object",
-      "required": [
-        "nodeName",
-        "collectionId",
-        "shardId"
-      ],
-      "properties": {
-        "collectionId": {
-          "description": "The collection name holding the replica to be disabled",
-          "type": "string"
+      "allOf": [
+        {
+          "$ref": "#/definitions/Tenant"
         },
-        "nodeName": {
-          "description": "The node containing the replica to be disabled",
-          "type": "string"
+        {
+          "properties": {
+            "belongsToNodes": {
+              "description": "The list of nodes that owns that tenant data.",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        }
+      ]
+    },
+    "TenantUsage": {
+      "description": "Resources used by a tenant, as measured periodically in the background. Only reported for tenants which are loaded. Read-only.",
+      "type": "object",
+      "properties": {
+        "diskBytes": {
+          "description": "The size of the tenant on disk in bytes.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         },
-        "shardId": {
-          "description": "The shard id holding the replica to be disabled",
-          "type": "string"
+        "objectCount": {
+          "description": "The number of objects of the tenant.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
         }
       }
     },
-    "ReplicationReplicateDetailsReplicaResponse": {
-      "description": "The current status and details of a replication operation, including information about the resources involved in the replication process.",
+    "UserApiKey": {
+      "type": "object",
       "required": [
-        "id",
-        "shardId",
-        "sourceNodeId",
-        "targetNodeId",
-        "collection",
-        "status"
+        "apikey"
       ],
       "properties": {
-        "collection": {
-          "description": "The name of the collection holding data being replicated.",
-          "type": "string"
-        },
-        "id": {
-          "description": "The unique id of the replication operation.",
-          "type": "string"
-        },
-        "shardId": {
-          "description": "The id of the shard to collect replication details for.",
-          "type": "string"
-        },
-        "sourceNodeId": {
-          "description": "The id of the node where the source replica is allocated.",
-          "type": "string"
-        },
-        "status": {
-          "description": "The current status of the replication operation, indicating the replication phase the operation is in.",
-          "type": "string",
-          "enum": [
-            "READY",
-            "INDEXING",
-            "REPLICATION_FINALIZING",
-            "REPLICATION_HYDRATING",
-            "REPLICATION_DEHYDRATING"
-          ]
-        },
-        "targetNodeId": {
-          "description": "The id of the node where the target replica is allocated.",
+        "apikey": {
+          "description": "The apikey",
           "type": "string"
         }
       }
     },
-    "ReplicationReplicateReplicaRequest": {
-      "description": "Request body to add a replica of given shard of a given collection",
+    "UserOwnInfo": {
       "type": "object",
       "required": [
-        "sourceNodeName",
-        "destinationNodeName",
-        "collectionId",
-        "shardId"
+        "username"
       ],
       "properties": {
-        "collectionId": {
-          "description": "The collection name holding the shard",
-          "type": "string"
-        },
-        "destinationNodeName": {
-          "description": "The node to add a copy of the replica on",
-          "type": "string"
+        "groups": {
+          "description": "The groups associated to the user",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "shardId": {
-          "description": "The shard id holding the replica to be copied",
-          "type": "string"
+        "roles": {
+          "type": "array",
+          "items": {
+            "description": "The roles assigned to own user",
+            "type": "object",
+            "$ref": "#/definitions/Role"
+          }
         },
-        "sourceNodeName": {
-          "description": "The node containing the replica",
+        "username": {
+          "description": "The username associated with the provided key",
           "type": "string"
         }
       }
     },
-    "RestoreConfig": {
-      "description": "Backup custom configuration",
+    "UserTypeInput": {
+      "description": "the type of user",
+      "type": "string",
+      "enum": [
+        "db",
+        "oidc"
+      ]
+    },
+    "UserTypeOutput": {
+      "description": "the type of user",
+      "type": "string",
+      "enum": [
+        "db_user",
+        "db_env_user",
+        "oidc"
+      ]
+    },
+    "Vector": {
+      "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting.",
+      "type": "object"
+    },
+    "VectorConfig": {
       "type": "object",
       "properties": {
-        "Bucket": {
-          "description": "Name of the bucket, container, volume, etc",
-          "type": "string"
-        },
-        "CPUPercentage": {
-          "description": "Desired CPU core utilization ranging from 1%-80%",
-          "type": "integer",
-          "default": 50,
-          "maximum": 80,
-          "minimum": 1,
-          "x-nullable": false
+        "vectorIndexConfig": {
+          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
+          "type": "object"
         },
-        "Endpoint": {
-          "description": "name of the endpoint, e.g. s3.amazonaws.com",
+        "vectorIndexType": {
+          "description": "Name of the vector index to use, eg. (HNSW)",
           "type": "string"
         },
-        "Path": {
-          "description": "Path within the bucket",
-          "type": "string"
+        "vectorizer": {
+          "description": "Configuration of a specific vectorizer used by this vector",
+          "type": "object"
         }
       }
     },
-    "Role": {
+    "VectorWeights": {
+      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
+      "type": "object"
+    },
+    "Vectors": {
+      "description": "A map of named vectors for multi-vector representations.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/Vector"
+      }
+    },
+    "WhereFilter": {
+      "description": "Filter search results using a where filter",
       "type": "object",
-      "required": [
-        "name",
-        "permissions"
-      ],
       "properties": {
-        "name": {
-          "description": "role name",
-          "type": "string"
+        "operands": {
+          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/WhereFilter"
+          }
+        },
+        "operator": {
+          "description": "operator to use",
+          "type": "string",
+          "enum": [
+            "And",
+            "Or",
+            "Equal",
+            "Like",
+            "NotEqual",
+            "GreaterThan",
+            "GreaterThanEqual",
+            "LessThan",
+            "LessThanEqual",
+            "WithinGeoRange",
+            "IsNull",
+            "ContainsAny",
+            "ContainsAll",
+            "Not"
+          ],
+          "example": "GreaterThanEqual"
+        },
+        "path": {
+          "description": "path to the property currently being filtered",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "example": [
+            "inCity",
+            "City",
+            "name"
+          ]
+        },
+        "valueBoolean": {
+          "description": "value as boolean",
+          "type": "boolean",
+          "x-nullable": true,
+          "example": false
+        },
+        "valueBooleanArray": {
+          "description": "value as boolean",
+          "type": "array",
+          "items": {
+            "type": "boolean"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            true,
+            false
+          ]
+        },
+        "valueDate": {
+          "description": "value as date (as string)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "TODO"
+        },
+        "valueDateArray": {
+          "description": "value as date (as string)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "TODO"
+        },
+        "valueGeoRange": {
+          "description": "value as geo coordinates and distance",
+          "type": "object",
+          "x-nullable": true,
+          "$ref": "#/definitions/WhereFilterGeoRange"
+        },
+        "valueInt": {
+          "description": "value as integer",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true,
+          "example": 2000
+        },
+        "valueIntArray": {
+          "description": "value as integer",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": "[100, 200]"
+        },
+        "valueNumber": {
+          "description": "value as number/float",
+          "type": "number",
+          "format": "float64",
+          "x-nullable": true,
+          "example": 3.14
+        },
+        "valueNumberArray": {
+          "description": "value as number/float",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float64"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            3.14
+          ]
+        },
+        "valueString": {
+          "description": "value as text (deprecated as of v1.19; alias for valueText)",
+          "type": "string",
+          "x-nullable": true,
+          "example": "my search term"
         },
-        "permissions": {
-          "type": "array",
-          "items": {
-            "description": "list of permissions (level, action, resource)",
-            "type": "object",
-            "$ref": "#/definitions/Permission"
-          }
-        }
-      }
-    },
-    "RolesListResponse": {
-      "description": "list of roles",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/Role"
-      }
-    },
-    "Schema": {
-      "description": "Definitions of semantic schemas (also see: https://github.com/weaviate/weaviate-semantic-schemas).",
-      "type": "object",
-      "properties": {
-        "classes": {
-          "description": "Semantic classes that are available.",
+        "valueStringArray": {
+          "description": "value as text (deprecated as of v1.19; alias for valueText)",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Class"
-          }
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
         },
-        "maintainer": {
-          "description": "Email of the maintainer.",
+        "valueText": {
+          "description": "value as text",
           "type": "string",
-          "format": "email"
+          "x-nullable": true,
+          "example": "my search term"
         },
-        "name": {
-          "description": "Name of the schema.",
-          "type": "string"
+        "valueTextArray": {
+          "description": "value as text",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-nullable": true,
+          "x-omitempty": true,
+          "example": [
+            "my search term"
+          ]
         }
       }
     },
-    "SchemaClusterStatus": {
-      "description": "Indicates the health of the schema in a cluster.",
+    "WhereFilterGeoRange": {
+      "description": "filter within a distance of a georange",
       "type": "object",
       "properties": {
-        "error": {
-          "description": "Contains the sync check error if one occurred",
-          "type": "string",
-          "x-omitempty": true
-        },
-        "healthy": {
-          "description": "True if the cluster is in sync, false if there is an issue (see error).",
-          "type": "boolean",
-          "x-omitempty": false
-        },
-        "hostname": {
-          "description": "Hostname of the coordinating node, i.e. the one that received the cluster. This can be useful information if the error message contains phrases such as 'other nodes agree, but local does not', etc.",
-          "type": "string"
-        },
-        "ignoreSchemaSync": {
-          "description": "The cluster check at startup can be ignored (to recover from an out-of-sync situation).",
-          "type": "boolean",
-          "x-omitempty": false
+        "distance": {
+          "type": "object",
+          "properties": {
+            "max": {
+              "type": "number",
+              "format": "float64"
+            }
+          }
         },
-        "nodeCount": {
-          "description": "Number of nodes that participated in the sync check",
-          "type": "number",
-          "format": "int"
+        "geoCoordinates": {
+          "x-nullable": false,
+          "$ref": "#/definitions/GeoCoordinates"
         }
       }
+    }
+  },
+  "parameters": {
+    "CommonAfterParameterQuery": {
+      "type": "string",
+      "description": "A threshold UUID of the objects to retrieve after, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `, typically in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `after` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eFor a null value similar to offset=0, set an empty string in the request, i.e. ` + "`" + `after=` + "`" + ` or ` + "`" + `after` + "`" + `.",
+      "name": "after",
+      "in": "query"
     },
-    "SchemaHistory": {
-      "description": "This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value OR a SingleRef definition.",
-      "type": "object"
+    "CommonClassParameterQuery": {
+      "type": "string",
+      "description": "The collection from which to query objects.  \u003cbr/\u003e\u003cbr/\u003eNote that if ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
+      "name": "class",
+      "in": "query"
     },
-    "ShardStatus": {
-      "description": "The status of a single shard",
-      "properties": {
-        "status": {
-          "description": "Status of the shard",
-          "type": "string"
-        }
-      }
+    "CommonConsistencyLevelParameterQuery": {
+      "type": "string",
+      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+      "name": "consistency_level",
+      "in": "query"
     },
-    "ShardStatusGetResponse": {
-      "description": "Response body of shard status get request",
-      "properties": {
-        "name": {
-          "description": "Name of the shard",
-          "type": "string"
-        },
-        "status": {
-          "description": "Status of the shard",
-          "type": "string"
-        },
-        "vectorQueueSize": {
-          "description": "Size of the vector queue of the shard",
-          "type": "integer",
-          "x-omitempty": false
-        }
-      }
+    "CommonIncludeParameterQuery": {
+      "type": "string",
+      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+      "name": "include",
+      "in": "query"
     },
-    "ShardStatusList": {
-      "description": "The status of all the shards of a Class",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/ShardStatusGetResponse"
-      }
+    "CommonLimitParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The maximum number of items to be returned per page. The default is 25 unless set otherwise as an environment variable.",
+      "name": "limit",
+      "in": "query"
+    },
+    "CommonNodeNameParameterQuery": {
+      "type": "string",
+      "description": "The target node which should fulfill the request",
+      "name": "node_name",
+      "in": "query"
+    },
+    "CommonOffsetParameterQuery": {
+      "type": "integer",
+      "format": "int64",
+      "default": 0,
+      "description": "The starting index of the result window. Note ` + "`" + `offset` + "`" + ` will retrieve ` + "`" + `offset+limit` + "`" + ` results and return ` + "`" + `limit` + "`" + ` results from the object with index ` + "`" + `offset` + "`" + ` onwards. Limited by the value of ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eShould be used in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `after` + "`" + `.",
+      "name": "offset",
+      "in": "query"
+    },
+    "CommonOrderParameterQuery": {
+      "type": "string",
+      "description": "Order parameter to tell how to order (asc or desc) data within given field. Should be used in conjunction with ` + "`" + `sort` + "`" + ` parameter. If providing multiple ` + "`" + `sort` + "`" + ` values, provide multiple ` + "`" + `order` + "`" + ` values in corresponding order, e.g.: ` + "`" + `sort=author_name,title\u0026order=desc,asc` + "`" + `.",
+      "name": "order",
+      "in": "query"
+    },
+    "CommonOutputVerbosityParameterQuery": {
+      "type": "string",
+      "default": "minimal",
+      "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+      "name": "output",
+      "in": "query"
+    },
+    "CommonSortParameterQuery": {
+      "type": "string",
+      "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
+      "name": "sort",
+      "in": "query"
+    },
+    "CommonTenantParameterQuery": {
+      "type": "string",
+      "description": "Specifies the tenant in a request targeting a multi-tenant class",
+      "name": "tenant",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "oidc": {
+      "description": "OIDC (OpenConnect ID - based on OAuth2)",
+      "type": "oauth2",
+      "flow": "implicit",
+      "authorizationUrl": "http://to-be-configured-in-the-application-config"
+    }
+  },
+  "security": [
+    {},
+    {
+      "oidc": []
+    }
+  ],
+  "tags": [
+    {
+      "name": "objects"
+    },
+    {
+      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
+      "name": "batch"
+    },
+    {
+      "name": "graphql"
+    },
+    {
+      "name": "meta"
+    },
+    {
+      "name": "P2P"
+    },
+    {
+      "description": "All functions related to the Contextionary.",
+      "name": "contextionary-API"
+    },
+    {
+      "description": "These operations enable manipulation of the schema in Weaviate schema.",
+      "name": "schema"
+    }
+  ],
+  "externalDocs": {
+    "url": "https://github.com/weaviate/weaviate"
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json",
+    "application/yaml"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "https"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "# Introduction\n Weaviate is an open source, AI-native vector database that helps developers create intuitive and reliable AI-powered applications. \n ### Base Path \nThe base path for the Weaviate server is structured as ` + "`" + `[YOUR-WEAVIATE-HOST]:[PORT]/v1` + "`" + `. As an example, if you wish to access the ` + "`" + `schema` + "`" + ` endpoint on a local instance, you would navigate to ` + "`" + `http://localhost:8080/v1/schema` + "`" + `. Ensure you replace ` + "`" + `[YOUR-WEAVIATE-HOST]` + "`" + ` and ` + "`" + `[PORT]` + "`" + ` with your actual server host and port number respectively. \n ### Questions? \nIf you have any comments or questions, please feel free to reach out to us at the community forum [https://forum.weaviate.io/](https://forum.weaviate.io/). \n### Issues? \nIf you find a bug or want to file a feature request, please open an issue on our GitHub repository for [Weaviate](https://github.com/weaviate/weaviate). \n### Want more documentation? \nFor a quickstart, code examples, concepts and more, please visit our [documentation page](https://weaviate.io/developers/weaviate).",
+    "title": "Weaviate",
+    "contact": {
+      "name": "Weaviate",
+      "url": "https://github.com/weaviate",
+      "email": "hello@weaviate.io"
     },
-    "SingleRef": {
-      "description": "Either set beacon (direct reference) or set class and schema (concept reference)",
-      "properties": {
-        "beacon": {
-          "description": "If using a direct reference, specify the URI to point to the cross-ref here. Should be in the form of weaviate://localhost/\u003cuuid\u003e for the example of a local cross-ref to an object",
-          "type": "string",
-          "format": "uri"
-        },
-        "class": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired class name here",
-          "type": "string",
-          "format": "uri"
-        },
-        "classification": {
-          "description": "Additional Meta information about classifications if the item was part of one",
-          "$ref": "#/definitions/ReferenceMetaClassification"
-        },
-        "href": {
-          "description": "If using a direct reference, this read-only fields provides a link to the referenced resource. If 'origin' is globally configured, an absolute URI is shown - a relative URI otherwise.",
-          "type": "string",
-          "format": "uri"
-        },
-        "schema": {
-          "description": "If using a concept reference (rather than a direct reference), specify the desired properties here",
-          "$ref": "#/definitions/PropertySchema"
+    "version": "1.31.0-dev"
+  },
+  "basePath": "/v1",
+  "paths": {
+    "/": {
+      "get": {
+        "description": "Get links to other endpoints to help discover the REST API",
+        "summary": "List available endpoints",
+        "operationId": "weaviate.root",
+        "responses": {
+          "200": {
+            "description": "Weaviate is alive and ready to serve content",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "links": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Link"
+                  }
+                }
+              }
+            }
+          }
         }
       }
     },
-    "Statistics": {
-      "description": "The definition of node statistics.",
-      "properties": {
-        "bootstrapped": {
-          "type": "boolean"
-        },
-        "candidates": {
-          "type": "object"
-        },
-        "dbLoaded": {
-          "type": "boolean"
-        },
-        "initialLastAppliedIndex": {
-          "type": "number",
-          "format": "uint64"
-        },
-        "isVoter": {
-          "type": "boolean"
-        },
-        "lastAppliedIndex": {
-          "type": "number"
-        },
-        "leaderAddress": {
-          "type": "object"
-        },
-        "leaderId": {
-          "type": "object"
-        },
-        "name": {
-          "description": "The name of the node.",
-          "type": "string"
-        },
-        "open": {
-          "type": "boolean"
-        },
-        "raft": {
-          "description": "Weaviate Raft statistics.",
-          "type": "object",
-          "$ref": "#/definitions/RaftStatistics"
-        },
-        "ready": {
-          "type": "boolean"
-        },
-        "status": {
-          "description": "Node's status.",
-          "type": "string",
-          "default": "HEALTHY",
-          "enum": [
-            "HEALTHY",
-            "UNHEALTHY",
-            "UNAVAILABLE",
-            "TIMEOUT"
-          ]
+    "/.well-known/live": {
+      "get": {
+        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
+        "summary": "Get application liveness.",
+        "operationId": "weaviate.wellknown.liveness",
+        "responses": {
+          "200": {
+            "description": "The application is able to respond to HTTP requests"
+          }
         }
       }
     },
-    "StopwordConfig": {
-      "description": "fine-grained control over stopword list usage",
-      "type": "object",
-      "properties": {
-        "additions": {
-          "description": "Stopwords to be considered additionally (default: []). Can be any array of custom strings.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                },
+                "scopes": {
+                  "description": "OAuth Scopes",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-omitempty": true
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "preset": {
-          "description": "Pre-existing list of common words by language (default: 'en'). Options: ['en', 'none'].",
-          "type": "string"
-        },
-        "removals": {
-          "description": "Stopwords to be removed from consideration (default: []). Can be any array of custom strings.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        }
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
       }
     },
-    "Tenant": {
-      "description": "attributes representing a single tenant within weaviate",
-      "type": "object",
-      "properties": {
-        "activityStatus": {
-          "description": "activity status of the tenant's shard. Optional for creating tenant (implicit ` + "`" + `ACTIVE` + "`" + `) and required for updating tenant. For creation, allowed values are ` + "`" + `ACTIVE` + "`" + ` - tenant is fully active and ` + "`" + `INACTIVE` + "`" + ` - tenant is inactive; no actions can be performed on tenant, tenant's files are stored locally. For updating, ` + "`" + `ACTIVE` + "`" + `, ` + "`" + `INACTIVE` + "`" + ` and also ` + "`" + `OFFLOADED` + "`" + ` - as INACTIVE, but files are stored on cloud storage. The following values are read-only and are set by the server for internal use: ` + "`" + `OFFLOADING` + "`" + ` - tenant is transitioning from ACTIVE/INACTIVE to OFFLOADED, ` + "`" + `ONLOADING` + "`" + ` - tenant is transitioning from OFFLOADED to ACTIVE/INACTIVE. We still accept deprecated names ` + "`" + `HOT` + "`" + ` (now ` + "`" + `ACTIVE` + "`" + `), ` + "`" + `COLD` + "`" + ` (now ` + "`" + `INACTIVE` + "`" + `), ` + "`" + `FROZEN` + "`" + ` (now ` + "`" + `OFFLOADED` + "`" + `), ` + "`" + `FREEZING` + "`" + ` (now ` + "`" + `OFFLOADING` + "`" + `), ` + "`" + `UNFREEZING` + "`" + ` (now ` + "`" + `ONLOADING` + "`" + `).",
-          "type": "string",
-          "enum": [
-            "ACTIVE",
-            "INACTIVE",
-            "OFFLOADED",
-            "OFFLOADING",
-            "ONLOADING",
-            "HOT",
-            "COLD",
-            "FROZEN",
-            "FREEZING",
-            "UNFREEZING"
-          ]
-        },
-        "name": {
-          "description": "The name of the tenant (required).",
-          "type": "string"
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "summary": "Get application readiness.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
         }
       }
     },
-    "TenantResponse": {
-      "description": "attributes representing a single tenant response within weaviate",
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/Tenant"
-        },
-        {
-          "properties": {
-            "belongsToNodes": {
-              "description": "The list of nodes that owns that tenant data.",
-              "type": "array",
-              "items": {
-                "type": "string"
+    "/authz/groups/{id}/assign": {
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Assign a role to a group",
+        "operationId": "assignRoleToGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "group name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "roles": {
+                  "description": "the roles that assigned to group",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
               }
             }
           }
-        }
-      ]
-    },
-    "UserApiKey": {
-      "type": "object",
-      "required": [
-        "apikey"
-      ],
-      "properties": {
-        "apikey": {
-          "description": "The apikey",
-          "type": "string"
-        }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role assigned successfully"
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "role or group is not found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.assign.role"
+        ]
       }
     },
-    "UserOwnInfo": {
-      "type": "object",
-      "required": [
-        "username"
-      ],
-      "properties": {
-        "groups": {
-          "description": "The groups associated to the user",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/authz/groups/{id}/revoke": {
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Revoke a role from a group",
+        "operationId": "revokeRoleFromGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "group name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "roles": {
+                  "description": "the roles that revoked from group",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
           }
-        },
-        "roles": {
-          "type": "array",
-          "items": {
-            "description": "The roles assigned to own user",
-            "type": "object",
-            "$ref": "#/definitions/Role"
+        ],
+        "responses": {
+          "200": {
+            "description": "Role revoked successfully"
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "role or group is not found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         },
-        "username": {
-          "description": "The username associated with the provided key",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.authz.revoke.role.group"
+        ]
       }
     },
-    "UserTypeInput": {
-      "description": "the type of user",
-      "type": "string",
-      "enum": [
-        "db",
-        "oidc"
-      ]
-    },
-    "UserTypeOutput": {
-      "description": "the type of user",
-      "type": "string",
-      "enum": [
-        "db_user",
-        "db_env_user",
-        "oidc"
-      ]
-    },
-    "Vector": {
-      "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting.",
-      "type": "object"
-    },
-    "VectorConfig": {
-      "type": "object",
-      "properties": {
-        "vectorIndexConfig": {
-          "description": "Vector-index config, that is specific to the type of index selected in vectorIndexType",
-          "type": "object"
-        },
-        "vectorIndexType": {
-          "description": "Name of the vector index to use, eg. (HNSW)",
-          "type": "string"
+    "/authz/roles": {
+      "get": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Get all roles",
+        "operationId": "getRoles",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "vectorizer": {
-          "description": "Configuration of a specific vectorizer used by this vector",
-          "type": "object"
-        }
-      }
-    },
-    "VectorWeights": {
-      "description": "Allow custom overrides of vector weights as math expressions. E.g. \"pancake\": \"7\" will set the weight for the word pancake to 7 in the vectorization, whereas \"w * 3\" would triple the originally calculated word. This is an open object, with OpenAPI Specification 3.0 this will be more detailed. See Weaviate docs for more info. In the future this will become a key/value (string/string) object.",
-      "type": "object"
-    },
-    "Vectors": {
-      "description": "A map of named vectors for multi-vector representations.",
-      "type": "object",
-      "additionalProperties": {
-        "$ref": "#/definitions/Vector"
+        "x-serviceIds": [
+          "weaviate.authz.get.roles"
+        ]
+      },
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "create new role",
+        "operationId": "createRole",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Role created successfully"
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Role already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.create.role"
+        ]
       }
     },
-    "WhereFilter": {
-      "description": "Filter search results using a where filter",
-      "type": "object",
-      "properties": {
-        "operands": {
-          "description": "combine multiple where filters, requires 'And' or 'Or' operator",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/WhereFilter"
+    "/authz/roles/{id}": {
+      "get": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Get a role",
+        "operationId": "getRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
-        },
-        "operator": {
-          "description": "operator to use",
-          "type": "string",
-          "enum": [
-            "And",
-            "Or",
-            "Equal",
-            "Like",
-            "NotEqual",
-            "GreaterThan",
-            "GreaterThanEqual",
-            "LessThan",
-            "LessThanEqual",
-            "WithinGeoRange",
-            "IsNull",
-            "ContainsAny",
-            "ContainsAll"
-          ],
-          "example": "GreaterThanEqual"
-        },
-        "path": {
-          "description": "path to the property currently being filtered",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
           },
-          "example": [
-            "inCity",
-            "City",
-            "name"
-          ]
-        },
-        "valueBoolean": {
-          "description": "value as boolean",
-          "type": "boolean",
-          "x-nullable": true,
-          "example": false
-        },
-        "valueBooleanArray": {
-          "description": "value as boolean",
-          "type": "array",
-          "items": {
-            "type": "boolean"
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            true,
-            false
-          ]
-        },
-        "valueDate": {
-          "description": "value as date (as string)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "TODO"
-        },
-        "valueDateArray": {
-          "description": "value as date (as string)",
-          "type": "array",
-          "items": {
-            "type": "string"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": "TODO"
-        },
-        "valueGeoRange": {
-          "description": "value as geo coordinates and distance",
-          "type": "object",
-          "x-nullable": true,
-          "$ref": "#/definitions/WhereFilterGeoRange"
-        },
-        "valueInt": {
-          "description": "value as integer",
-          "type": "integer",
-          "format": "int64",
-          "x-nullable": true,
-          "example": 2000
-        },
-        "valueIntArray": {
-          "description": "value as integer",
-          "type": "array",
-          "items": {
-            "type": "integer",
-            "format": "int64"
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": "[100, 200]"
-        },
-        "valueNumber": {
-          "description": "value as number/float",
-          "type": "number",
-          "format": "float64",
-          "x-nullable": true,
-          "example": 3.14
-        },
-        "valueNumberArray": {
-          "description": "value as number/float",
-          "type": "array",
-          "items": {
-            "type": "number",
-            "format": "float64"
+          "404": {
+            "description": "no role found"
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            3.14
-          ]
-        },
-        "valueString": {
-          "description": "value as text (deprecated as of v1.19; alias for valueText)",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
         },
-        "valueStringArray": {
-          "description": "value as text (deprecated as of v1.19; alias for valueText)",
-          "type": "array",
-          "items": {
-            "type": "string"
+        "x-serviceIds": [
+          "weaviate.authz.get.role"
+        ]
+      },
+      "delete": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Delete role",
+        "operationId": "deleteRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            "my search term"
-          ]
-        },
-        "valueText": {
-          "description": "value as text",
-          "type": "string",
-          "x-nullable": true,
-          "example": "my search term"
-        },
-        "valueTextArray": {
-          "description": "value as text",
-          "type": "array",
-          "items": {
-            "type": "string"
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
-          "x-nullable": true,
-          "x-omitempty": true,
-          "example": [
-            "my search term"
-          ]
-        }
-      }
-    },
-    "WhereFilterGeoRange": {
-      "description": "filter within a distance of a georange",
-      "type": "object",
-      "properties": {
-        "distance": {
-          "type": "object",
-          "properties": {
-            "max": {
-              "type": "number",
-              "format": "float64"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
         },
-        "geoCoordinates": {
-          "x-nullable": false,
-          "$ref": "#/definitions/GeoCoordinates"
-        }
+        "x-serviceIds": [
+          "weaviate.authz.delete.role"
+        ]
       }
-    }
-  },
-  "parameters": {
-    "CommonAfterParameterQuery": {
-      "type": "string",
-      "description": "A threshold UUID of the objects to retrieve after, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `, typically in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `after` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eFor a null value similar to offset=0, set an empty string in the request, i.e. ` + "`" + `after=` + "`" + ` or ` + "`" + `after` + "`" + `.",
-      "name": "after",
-      "in": "query"
-    },
-    "CommonClassParameterQuery": {
-      "type": "string",
-      "description": "The collection from which to query objects.  \u003cbr/\u003e\u003cbr/\u003eNote that if ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
-      "name": "class",
-      "in": "query"
-    },
-    "CommonConsistencyLevelParameterQuery": {
-      "type": "string",
-      "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-      "name": "consistency_level",
-      "in": "query"
-    },
-    "CommonIncludeParameterQuery": {
-      "type": "string",
-      "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-      "name": "include",
-      "in": "query"
-    },
-    "CommonLimitParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "description": "The maximum number of items to be returned per page. The default is 25 unless set otherwise as an environment variable.",
-      "name": "limit",
-      "in": "query"
-    },
-    "CommonNodeNameParameterQuery": {
-      "type": "string",
-      "description": "The target node which should fulfill the request",
-      "name": "node_name",
-      "in": "query"
-    },
-    "CommonOffsetParameterQuery": {
-      "type": "integer",
-      "format": "int64",
-      "default": 0,
-      "description": "The starting index of the result window. Note ` + "`" + `offset` + "`" + ` will retrieve ` + "`" + `offset+limit` + "`" + ` results and return ` + "`" + `limit` + "`" + ` results from the object with index ` + "`" + `offset` + "`" + ` onwards. Limited by the value of ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eShould be used in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `after` + "`" + `.",
-      "name": "offset",
-      "in": "query"
-    },
-    "CommonOrderParameterQuery": {
-      "type": "string",
-      "description": "Order parameter to tell how to order (asc or desc) data within given field. Should be used in conjunction with ` + "`" + `sort` + "`" + ` parameter. If providing multiple ` + "`" + `sort` + "`" + ` values, provide multiple ` + "`" + `order` + "`" + ` values in corresponding order, e.g.: ` + "`" + `sort=author_name,title\u0026order=desc,asc` + "`" + `.",
-      "name": "order",
-      "in": "query"
-    },
-    "CommonOutputVerbosityParameterQuery": {
-      "type": "string",
-      "default": "minimal",
-      "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-      "name": "output",
-      "in": "query"
-    },
-    "CommonSortParameterQuery": {
-      "type": "string",
-      "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
-      "name": "sort",
-      "in": "query"
-    },
-    "CommonTenantParameterQuery": {
-      "type": "string",
-      "description": "Specifies the tenant in a request targeting a multi-tenant class",
-      "name": "tenant",
-      "in": "query"
-    }
-  },
-  "securityDefinitions": {
-    "oidc": {
-      "description": "OIDC (OpenConnect ID - based on OAuth2)",
-      "type": "oauth2",
-      "flow": "implicit",
-      "authorizationUrl": "http://to-be-configured-in-the-application-config"
-    }
-  },
-  "security": [
-    {},
-    {
-      "oidc": []
-    }
-  ],
-  "tags": [
-    {
-      "name": "objects"
-    },
-    {
-      "description": "These operations allow to execute batch requests for Objects and Objects. Mostly used for importing large datasets.",
-      "name": "batch"
-    },
-    {
-      "name": "graphql"
-    },
-    {
-      "name": "meta"
-    },
-    {
-      "name": "P2P"
-    },
-    {
-      "description": "All functions related to the Contextionary.",
-      "name": "contextionary-API"
-    },
-    {
-      "description": "These operations enable manipulation of the schema in Weaviate schema.",
-      "name": "schema"
-    }
-  ],
-  "externalDocs": {
-    "url": "https://github.com/weaviate/weaviate"
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json",
-    "application/yaml"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "https"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "# Introduction\n Weaviate is an open source, AI-native vector database that helps developers create intuitive and reliable AI-powered applications. \n ### Base Path \nThe base path for the Weaviate server is structured as ` + "`" + `[YOUR-WEAVIATE-HOST]:[PORT]/v1` + "`" + `. As an example, if you wish to access the ` + "`" + `schema` + "`" + ` endpoint on a local instance, you would navigate to ` + "`" + `http://localhost:8080/v1/schema` + "`" + `. Ensure you replace ` + "`" + `[YOUR-WEAVIATE-HOST]` + "`" + ` and ` + "`" + `[PORT]` + "`" + ` with your actual server host and port number respectively. \n ### Questions? \nIf you have any comments or questions, please feel free to reach out to us at the community forum [https://forum.weaviate.io/](https://forum.weaviate.io/). \n### Issues? \nIf you find a bug or want to file a feature request, please open an issue on our GitHub repository for [Weaviate](https://github.com/weaviate/weaviate). \n### Want more documentation? \nFor a quickstart, code examples, concepts and more, please visit our [documentation page](https://weaviate.io/developers/weaviate).",
-    "title": "Weaviate",
-    "contact": {
-      "name": "Weaviate",
-      "url": "https://github.com/weaviate",
-      "email": "hello@weaviate.io"
     },
-    "version": "1.31.0-dev"
-  },
-  "basePath": "/v1",
-  "paths": {
-    "/": {
-      "get": {
-        "description": "Get links to other endpoints to help discover the REST API",
-        "summary": "List available endpoints",
-        "operationId": "weaviate.root",
-        "responses": {
-          "200": {
-            "description": "Weaviate is alive and ready to serve content",
+    "/authz/roles/{id}/add-permissions": {
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Add permission to a given role.",
+        "operationId": "addPermissions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
               "type": "object",
+              "required": [
+                "name",
+                "permissions"
+              ],
               "properties": {
-                "links": {
+                "permissions": {
+                  "description": "permissions to be added to the role",
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/Link"
+                    "$ref": "#/definitions/Permission"
                   }
                 }
               }
             }
           }
-        }
-      }
-    },
-    "/.well-known/live": {
-      "get": {
-        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
-        "summary": "Get application liveness.",
-        "operationId": "weaviate.wellknown.liveness",
+        ],
         "responses": {
           "200": {
-            "description": "The application is able to respond to HTTP requests"
+            "description": "Permissions added successfully"
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.add.role.permissions"
+        ]
       }
     },
-    "/.well-known/openid-configuration": {
-      "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+    "/authz/roles/{id}/has-permission": {
+      "post": {
         "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
+          "authz"
+        ],
+        "summary": "Check whether role possesses this permission.",
+        "operationId": "hasPermission",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Permission"
+            }
+          }
         ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
         "responses": {
           "200": {
-            "description": "Successful response, inspect body",
+            "description": "Permission check was successful",
             "schema": {
-              "type": "object",
-              "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
-                },
-                "scopes": {
-                  "description": "OAuth Scopes",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  },
-                  "x-omitempty": true
-                }
-              }
+              "type": "boolean"
             }
           },
-          "404": {
-            "description": "Not found, no oidc provider present"
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -7659,36 +8807,22 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
-      }
-    },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "summary": "Get application readiness.",
-        "operationId": "weaviate.wellknown.readiness",
-        "responses": {
-          "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
-          },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
-          }
-        }
+        "x-serviceIds": [
+          "weaviate.authz.has.role.permission"
+        ]
       }
     },
-    "/authz/groups/{id}/assign": {
+    "/authz/roles/{id}/remove-permissions": {
       "post": {
         "tags": [
           "authz"
         ],
-        "summary": "Assign a role to a group",
-        "operationId": "assignRoleToGroup",
+        "summary": "Remove permissions from a role. If this results in an empty role, the role will be deleted.",
+        "operationId": "removePermissions",
         "parameters": [
           {
             "type": "string",
-            "description": "group name",
+            "description": "role name",
             "name": "id",
             "in": "path",
             "required": true
@@ -7699,12 +8833,15 @@ func init() {
             "required": true,
             "schema": {
               "type": "object",
+              "required": [
+                "permissions"
+              ],
               "properties": {
-                "roles": {
-                  "description": "the roles that assigned to group",
+                "permissions": {
+                  "description": "permissions to remove from the role",
                   "type": "array",
                   "items": {
-                    "type": "string"
+                    "$ref": "#/definitions/Permission"
                   }
                 }
               }
@@ -7713,10 +8850,10 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Role assigned successfully"
+            "description": "Permissions removed successfully"
           },
           "400": {
-            "description": "Bad request",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7731,7 +8868,13 @@ func init() {
             }
           },
           "404": {
-            "description": "role or group is not found."
+            "description": "no role found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -7741,46 +8884,35 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.assign.role"
+          "weaviate.authz.remove.role.permissions"
         ]
       }
     },
-    "/authz/groups/{id}/revoke": {
-      "post": {
+    "/authz/roles/{id}/user-assignments": {
+      "get": {
         "tags": [
           "authz"
         ],
-        "summary": "Revoke a role from a group",
-        "operationId": "revokeRoleFromGroup",
+        "summary": "get users assigned to role",
+        "operationId": "getUsersForRole",
         "parameters": [
           {
             "type": "string",
-            "description": "group name",
+            "description": "role name",
             "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "roles": {
-                  "description": "the roles that revoked from group",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Role revoked successfully"
+            "description": "Users assigned to this role",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/GetUsersForRoleOKBodyItems0"
+              }
+            }
           },
           "400": {
             "description": "Bad request",
@@ -7798,7 +8930,7 @@ func init() {
             }
           },
           "404": {
-            "description": "role or group is not found."
+            "description": "no role found"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -7808,26 +8940,39 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.revoke.role.group"
+          "weaviate.authz.get.roles.users"
         ]
       }
     },
-    "/authz/roles": {
+    "/authz/roles/{id}/users": {
       "get": {
         "tags": [
           "authz"
         ],
-        "summary": "Get all roles",
-        "operationId": "getRoles",
+        "summary": "get users (db + OIDC) assigned to role. Deprecated, will be removed when 1.29 is not supported anymore",
+        "operationId": "getUsersForRoleDeprecated",
+        "deprecated": true,
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Users assigned to this role",
             "schema": {
-              "$ref": "#/definitions/RolesListResponse"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           },
           "400": {
-            "description": "Malformed request.",
+            "description": "Bad request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7841,6 +8986,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "no role found"
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -7849,31 +8997,125 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.roles"
+          "weaviate.authz.get.roles.users"
         ]
-      },
+      }
+    },
+    "/authz/users/{id}/assign": {
       "post": {
         "tags": [
           "authz"
         ],
-        "summary": "create new role",
-        "operationId": "createRole",
+        "summary": "Assign a role to a user",
+        "operationId": "assignRoleToUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "roles": {
+                  "description": "the roles that assigned to user",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "userType": {
+                  "$ref": "#/definitions/UserTypeInput"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role assigned successfully"
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "role or user is not found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.assign.role.user"
+        ]
+      }
+    },
+    "/authz/users/{id}/revoke": {
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Revoke a role from a user",
+        "operationId": "revokeRoleFromUser",
         "parameters": [
+          {
+            "type": "string",
+            "description": "user name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Role"
+              "type": "object",
+              "properties": {
+                "roles": {
+                  "description": "the roles that revoked from the key or user",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                },
+                "userType": {
+                  "$ref": "#/definitions/UserTypeInput"
+                }
+              }
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Role created successfully"
+          "200": {
+            "description": "Role revoked successfully"
           },
           "400": {
-            "description": "Malformed request.",
+            "description": "Bad request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7887,14 +9129,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "409": {
-            "description": "Role already exists",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+          "404": {
+            "description": "role or user is not found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7907,21 +9143,22 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.create.role"
+          "weaviate.authz.revoke.role.user"
         ]
       }
     },
-    "/authz/roles/{id}": {
+    "/authz/users/{id}/roles": {
       "get": {
         "tags": [
           "authz"
         ],
-        "summary": "Get a role",
-        "operationId": "getRole",
+        "summary": "get roles assigned to user (DB + OIDC). Deprecated, will be removed when 1.29 is not supported anymore",
+        "operationId": "getRolesForUserDeprecated",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "description": "role name",
+            "description": "user name",
             "name": "id",
             "in": "path",
             "required": true
@@ -7929,13 +9166,13 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Role assigned users",
             "schema": {
-              "$ref": "#/definitions/Role"
+              "$ref": "#/definitions/RolesListResponse"
             }
           },
           "400": {
-            "description": "Malformed request.",
+            "description": "Bad request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7950,7 +9187,13 @@ func init() {
             }
           },
           "404": {
-            "description": "no role found"
+            "description": "no role found for user"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -7960,27 +9203,50 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.role"
+          "weaviate.authz.get.users.roles"
         ]
-      },
-      "delete": {
+      }
+    },
+    "/authz/users/{id}/roles/{userType}": {
+      "get": {
         "tags": [
           "authz"
         ],
-        "summary": "Delete role",
-        "operationId": "deleteRole",
+        "summary": "get roles assigned to user",
+        "operationId": "getRolesForUser",
         "parameters": [
           {
             "type": "string",
-            "description": "role name",
+            "description": "user name",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "oidc",
+              "db"
+            ],
+            "type": "string",
+            "description": "The type of user",
+            "name": "userType",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Whether to include detailed role information needed the roles permission",
+            "name": "includeFullRoles",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Role assigned users",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
           },
           "400": {
             "description": "Bad request",
@@ -7997,6 +9263,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "no role found for user"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -8005,55 +9280,32 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.delete.role"
+          "weaviate.authz.get.users.roles"
         ]
       }
     },
-    "/authz/roles/{id}/add-permissions": {
-      "post": {
+    "/backups/{backend}": {
+      "get": {
+        "description": "[Coming soon] List all backups in progress not implemented yet.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Add permission to a given role.",
-        "operationId": "addPermissions",
+        "summary": "List backups in progress",
+        "operationId": "backups.list",
         "parameters": [
           {
             "type": "string",
-            "description": "role name",
-            "name": "id",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "required": [
-                "name",
-                "permissions"
-              ],
-              "properties": {
-                "permissions": {
-                  "description": "permissions to be added to the role",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Permission"
-                  }
-                }
-              }
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Permissions added successfully"
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Existed backups",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupListResponse"
             }
           },
           "401": {
@@ -8065,11 +9317,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "no role found"
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup list.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8082,22 +9331,21 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.add.role.permissions"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/authz/roles/{id}/has-permission": {
+      },
       "post": {
+        "description": "Start creating a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eNotes: \u003cbr/\u003e- Weaviate uses gzip compression by default. \u003cbr/\u003e- Weaviate stays usable while a backup process is ongoing.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Check whether role possesses this permission.",
-        "operationId": "hasPermission",
+        "summary": "Start a backup process",
+        "operationId": "backups.create",
         "parameters": [
           {
             "type": "string",
-            "description": "role name",
-            "name": "id",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
             "in": "path",
             "required": true
           },
@@ -8106,21 +9354,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Permission"
+              "$ref": "#/definitions/BackupCreateRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Permission check was successful",
-            "schema": {
-              "type": "boolean"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Backup create process successfully started.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupCreateResponse"
             }
           },
           "401": {
@@ -8133,7 +9375,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup creation attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8146,54 +9388,51 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.has.role.permission"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/authz/roles/{id}/remove-permissions": {
-      "post": {
+    "/backups/{backend}/{id}": {
+      "get": {
+        "description": "Returns status of backup creation attempt for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using this endpoint.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Remove permissions from a role. If this results in an empty role, the role will be deleted.",
-        "operationId": "removePermissions",
+        "summary": "Get backup process status",
+        "operationId": "backups.create.status",
         "parameters": [
           {
             "type": "string",
-            "description": "role name",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "required": [
-                "permissions"
-              ],
-              "properties": {
-                "permissions": {
-                  "description": "permissions to remove from the role",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Permission"
-                  }
-                }
-              }
-            }
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path within the bucket",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Permissions removed successfully"
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Backup creation status successfully returned",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupCreateStatusResponse"
             }
           },
           "401": {
@@ -8206,10 +9445,13 @@ func init() {
             }
           },
           "404": {
-            "description": "no role found"
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8221,42 +9463,48 @@ func init() {
             }
           }
         },
-        "x-serviceIds": [
-          "weaviate.authz.remove.role.permissions"
-        ]
-      }
-    },
-    "/authz/roles/{id}/user-assignments": {
-      "get": {
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "delete": {
+        "description": "Cancel created backup with specified ID",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "get users assigned to role",
-        "operationId": "getUsersForRole",
+        "summary": "Cancel backup",
+        "operationId": "backups.cancel",
         "parameters": [
           {
             "type": "string",
-            "description": "role name",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path within the bucket",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Users assigned to this role",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/GetUsersForRoleOKBodyItems0"
-              }
-            }
-          },
-          "400": {
-            "description": "Bad request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -8267,8 +9515,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "no role found"
+          "422": {
+            "description": "Invalid backup cancellation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -8278,41 +9529,51 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.roles.users"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/authz/roles/{id}/users": {
+    "/backups/{backend}/{id}/restore": {
       "get": {
+        "description": "Returns status of a backup restoration attempt for a set of classes. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using the this endpoint.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "get users (db + OIDC) assigned to role. Deprecated, will be removed when 1.29 is not supported anymore",
-        "operationId": "getUsersForRoleDeprecated",
-        "deprecated": true,
+        "summary": "Get restore process status",
+        "operationId": "backups.restore.status",
         "parameters": [
           {
             "type": "string",
-            "description": "role name",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path within the bucket",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Users assigned to this role",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          },
-          "400": {
-            "description": "Bad request",
+            "description": "Backup restoration status successfully returned",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupRestoreStatusResponse"
             }
           },
           "401": {
@@ -8325,7 +9586,10 @@ func init() {
             }
           },
           "404": {
-            "description": "no role found"
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -8335,21 +9599,27 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.roles.users"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/authz/users/{id}/assign": {
+      },
       "post": {
+        "description": "Starts a process of restoring a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAny backup can be restored to any machine, as long as the number of nodes between source and target are identical.\u003cbr/\u003e\u003cbr/\u003eRequrements:\u003cbr/\u003e\u003cbr/\u003e- None of the collections to be restored already exist on the target restoration node(s).\u003cbr/\u003e- The node names of the backed-up collections' must match those of the target restoration node(s).",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Assign a role to a user",
-        "operationId": "assignRoleToUser",
+        "summary": "Start a restoration process",
+        "operationId": "backups.restore",
         "parameters": [
           {
             "type": "string",
-            "description": "user name",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
@@ -8359,30 +9629,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "roles": {
-                  "description": "the roles that assigned to user",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                },
-                "userType": {
-                  "$ref": "#/definitions/UserTypeInput"
-                }
-              }
+              "$ref": "#/definitions/BackupRestoreRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Role assigned successfully"
-          },
-          "400": {
-            "description": "Bad request",
+            "description": "Backup restoration process successfully started.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupRestoreResponse"
             }
           },
           "401": {
@@ -8395,7 +9650,13 @@ func init() {
             }
           },
           "404": {
-            "description": "role or user is not found.",
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8408,25 +9669,20 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.assign.role.user"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/authz/users/{id}/revoke": {
+    "/batch/objects": {
       "post": {
+        "description": "Create new objects in bulk. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/batch/objects` + "`" + `**: \u003cbr/\u003e` + "`" + `POST /batch/objects` + "`" + ` is idempotent, and will overwrite any existing object given the same id.",
         "tags": [
-          "authz"
+          "batch",
+          "objects"
         ],
-        "summary": "Revoke a role from a user",
-        "operationId": "revokeRoleFromUser",
+        "summary": "Creates new Objects based on a Object template as a batch.",
+        "operationId": "batch.objects.create",
         "parameters": [
-          {
-            "type": "string",
-            "description": "user name",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
@@ -8434,26 +9690,49 @@ func init() {
             "schema": {
               "type": "object",
               "properties": {
-                "roles": {
-                  "description": "the roles that revoked from the key or user",
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
                   "type": "array",
                   "items": {
-                    "type": "string"
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
                   }
                 },
-                "userType": {
-                  "$ref": "#/definitions/UserTypeInput"
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
                 }
               }
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Role revoked successfully"
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
+            }
           },
           "400": {
-            "description": "Bad request",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8467,8 +9746,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "role or user is not found.",
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8480,37 +9759,51 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.revoke.role.user"
+          "weaviate.local.add"
         ]
-      }
-    },
-    "/authz/users/{id}/roles": {
-      "get": {
+      },
+      "delete": {
+        "description": "Batch delete objects that match a particular filter. \u003cbr/\u003e\u003cbr/\u003eThe request body takes a single ` + "`" + `where` + "`" + ` filter and will delete all objects matched. \u003cbr/\u003e\u003cbr/\u003eNote that there is a limit to the number of objects to be deleted at once using this filter, in order to protect against unexpected memory surges and very-long-running requests. The default limit is 10,000 and may be configured by setting the ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + ` environment variable. \u003cbr/\u003e\u003cbr/\u003eObjects are deleted in the same order that they would be returned in an equivalent Get query. To delete more objects than the limit, run the same query multiple times.",
         "tags": [
-          "authz"
+          "batch",
+          "objects"
         ],
-        "summary": "get roles assigned to user (DB + OIDC). Deprecated, will be removed when 1.29 is not supported anymore",
-        "operationId": "getRolesForUserDeprecated",
-        "deprecated": true,
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
         "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BatchDelete"
+            }
+          },
           {
             "type": "string",
-            "description": "user name",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Role assigned users",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/RolesListResponse"
+              "$ref": "#/definitions/BatchDeleteResponse"
             }
           },
           "400": {
-            "description": "Bad request",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8524,9 +9817,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "no role found for user"
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -8540,54 +9830,54 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.get.users.roles"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/authz/users/{id}/roles/{userType}": {
-      "get": {
+    "/batch/references": {
+      "post": {
+        "description": "Batch create cross-references between collections items (objects or objects) in bulk.",
         "tags": [
-          "authz"
+          "batch",
+          "references"
         ],
-        "summary": "get roles assigned to user",
-        "operationId": "getRolesForUser",
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
         "parameters": [
           {
-            "type": "string",
-            "description": "user name",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
           },
           {
-            "enum": [
-              "oidc",
-              "db"
-            ],
             "type": "string",
-            "description": "The type of user",
-            "name": "userType",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "default": false,
-            "description": "Whether to include detailed role information needed the roles permission",
-            "name": "includeFullRoles",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Role assigned users",
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
             "schema": {
-              "$ref": "#/definitions/RolesListResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
             }
           },
           "400": {
-            "description": "Bad request",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8601,9 +9891,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "no role found for user"
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -8617,33 +9904,43 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.authz.get.users.roles"
+          "weaviate.local.add"
         ]
       }
     },
-    "/backups/{backend}": {
-      "get": {
-        "description": "[Coming soon] List all backups in progress not implemented yet.",
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
         "tags": [
-          "backups"
+          "classifications"
         ],
-        "summary": "List backups in progress",
-        "operationId": "backups.list",
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Existed backups",
+          "201": {
+            "description": "Successfully started classification.",
             "schema": {
-              "$ref": "#/definitions/BackupListResponse"
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -8655,12 +9952,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup list.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -8669,38 +9960,32 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.classifications.post"
         ]
-      },
-      "post": {
-        "description": "Start creating a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eNotes: \u003cbr/\u003e- Weaviate uses gzip compression by default. \u003cbr/\u003e- Weaviate stays usable while a backup process is ongoing.",
+      }
+    },
+    "/classifications/{id}": {
+      "get": {
+        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "backups"
+          "classifications"
         ],
-        "summary": "Start a backup process",
-        "operationId": "backups.create",
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
-            "name": "backend",
+            "description": "classification id",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup create process successfully started.",
+            "description": "Found the classification, returned as body",
             "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
+              "$ref": "#/definitions/Classification"
             }
           },
           "401": {
@@ -8712,11 +9997,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup creation attempt.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Not Found - Classification does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -8726,51 +10008,23 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.classifications.get"
         ]
       }
     },
-    "/backups/{backend}/{id}": {
+    "/cluster/statistics": {
       "get": {
-        "description": "Returns status of backup creation attempt for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using this endpoint.",
+        "description": "Returns Raft cluster statistics of Weaviate DB.",
         "tags": [
-          "backups"
-        ],
-        "summary": "Get backup process status",
-        "operationId": "backups.create.status",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Name of the bucket, container, volume, etc",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The path within the bucket",
-            "name": "path",
-            "in": "query"
-          }
+          "cluster"
         ],
+        "summary": "See Raft cluster statistics",
+        "operationId": "cluster.get.statistics",
         "responses": {
           "200": {
-            "description": "Backup creation status successfully returned",
+            "description": "Cluster statistics successfully returned",
             "schema": {
-              "$ref": "#/definitions/BackupCreateStatusResponse"
+              "$ref": "#/definitions/ClusterStatisticsResponse"
             }
           },
           "401": {
@@ -8782,12 +10036,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
             "description": "Invalid backup restoration status attempt.",
             "schema": {
@@ -8802,47 +10050,35 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.cluster.statistics.get"
         ]
-      },
-      "delete": {
-        "description": "Cancel created backup with specified ID",
+      }
+    },
+    "/graphql": {
+      "post": {
+        "description": "Get a response based on a GraphQL query",
         "tags": [
-          "backups"
+          "graphql"
         ],
-        "summary": "Cancel backup",
-        "operationId": "backups.cancel",
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Name of the bucket, container, volume, etc",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The path within the bucket",
-            "name": "path",
-            "in": "query"
+            "description": "The GraphQL query request parameters.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQuery"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successful query (with select).",
+            "schema": {
+              "$ref": "#/definitions/GraphQLResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -8854,7 +10090,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup cancellation attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8866,52 +10102,40 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/backups/{backend}/{id}/restore": {
-      "get": {
-        "description": "Returns status of a backup restoration attempt for a set of classes. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using the this endpoint.",
+    "/graphql/batch": {
+      "post": {
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "backups"
+          "graphql"
         ],
-        "summary": "Get restore process status",
-        "operationId": "backups.restore.status",
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Name of the bucket, container, volume, etc",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The path within the bucket",
-            "name": "path",
-            "in": "query"
+            "description": "The GraphQL queries.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GraphQLQueries"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration status successfully returned",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreStatusResponse"
+              "$ref": "#/definitions/GraphQLResponses"
             }
           },
           "401": {
@@ -8923,8 +10147,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8936,46 +10160,76 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
-      "post": {
-        "description": "Starts a process of restoring a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAny backup can be restored to any machine, as long as the number of nodes between source and target are identical.\u003cbr/\u003e\u003cbr/\u003eRequrements:\u003cbr/\u003e\u003cbr/\u003e- None of the collections to be restored already exist on the target restoration node(s).\u003cbr/\u003e- The node names of the backed-up collections' must match those of the target restoration node(s).",
+      }
+    },
+    "/meta": {
+      "get": {
+        "description": "Returns meta information about the server. Can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "backups"
+          "meta"
         ],
-        "summary": "Start a restoration process",
-        "operationId": "backups.restore",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
-            "name": "backend",
-            "in": "path",
-            "required": true
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Meta"
+            }
           },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreRequest"
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      }
+    },
+    "/nodes": {
+      "get": {
+        "description": "Returns node information for the entire database.",
+        "tags": [
+          "nodes"
+        ],
+        "summary": "Node information for the database.",
+        "operationId": "nodes.get",
+        "parameters": [
+          {
+            "type": "string",
+            "default": "minimal",
+            "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+            "name": "output",
+            "in": "query"
+          }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration process successfully started.",
+            "description": "Nodes status successfully returned",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreResponse"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -8994,7 +10248,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup restoration attempt.",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9007,72 +10261,38 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.nodes.status.get"
         ]
       }
     },
-    "/batch/objects": {
-      "post": {
-        "description": "Create new objects in bulk. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/batch/objects` + "`" + `**: \u003cbr/\u003e` + "`" + `POST /batch/objects` + "`" + ` is idempotent, and will overwrite any existing object given the same id.",
+    "/nodes/{className}": {
+      "get": {
+        "description": "Returns node information for the nodes relevant to the collection.",
         "tags": [
-          "batch",
-          "objects"
+          "nodes"
         ],
-        "summary": "Creates new Objects based on a Object template as a batch.",
-        "operationId": "batch.objects.create",
+        "summary": "Node information for a collection.",
+        "operationId": "nodes.get.class",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Object"
-                  }
-                }
-              }
-            }
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
+            "default": "minimal",
+            "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
+            "name": "output",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Nodes status successfully returned",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -9084,8 +10304,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9097,33 +10323,63 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.nodes.status.get.class"
         ]
-      },
-      "delete": {
-        "description": "Batch delete objects that match a particular filter. \u003cbr/\u003e\u003cbr/\u003eThe request body takes a single ` + "`" + `where` + "`" + ` filter and will delete all objects matched. \u003cbr/\u003e\u003cbr/\u003eNote that there is a limit to the number of objects to be deleted at once using this filter, in order to protect against unexpected memory surges and very-long-running requests. The default limit is 10,000 and may be configured by setting the ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + ` environment variable. \u003cbr/\u003e\u003cbr/\u003eObjects are deleted in the same order that they would be returned in an equivalent Get query. To delete more objects than the limit, run the same query multiple times.",
+      }
+    },
+    "/objects": {
+      "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
         "tags": [
-          "batch",
           "objects"
         ],
-        "summary": "Deletes Objects based on a match filter as a batch.",
-        "operationId": "batch.objects.delete",
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BatchDelete"
-            }
+            "type": "string",
+            "description": "A threshold UUID of the objects to retrieve after, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `, typically in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `after` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eFor a null value similar to offset=0, set an empty string in the request, i.e. ` + "`" + `after=` + "`" + ` or ` + "`" + `after` + "`" + `.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The starting index of the result window. Note ` + "`" + `offset` + "`" + ` will retrieve ` + "`" + `offset+limit` + "`" + ` results and return ` + "`" + `limit` + "`" + ` results from the object with index ` + "`" + `offset` + "`" + ` onwards. Limited by the value of ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eShould be used in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `after` + "`" + `.",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The maximum number of items to be returned per page. The default is 25 unless set otherwise as an environment variable.",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
           },
           {
             "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
+            "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
+            "name": "sort",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Order parameter to tell how to order (asc or desc) data within given field. Should be used in conjunction with ` + "`" + `sort` + "`" + ` parameter. If providing multiple ` + "`" + `sort` + "`" + ` values, provide multiple ` + "`" + `order` + "`" + ` values in corresponding order, e.g.: ` + "`" + `sort=author_name,title\u0026order=desc,asc` + "`" + `.",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The collection from which to query objects.  \u003cbr/\u003e\u003cbr/\u003eNote that if ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
+            "name": "class",
             "in": "query"
           },
           {
@@ -9135,9 +10391,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
+            "description": "Successful response. \u003cbr/\u003e\u003cbr/\u003eIf ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
             "schema": {
-              "$ref": "#/definitions/BatchDeleteResponse"
+              "$ref": "#/definitions/ObjectsListResponse"
             }
           },
           "400": {
@@ -9155,6 +10411,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -9171,30 +10430,23 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
-      }
-    },
-    "/batch/references": {
+      },
       "post": {
-        "description": "Batch create cross-references between collections items (objects or objects) in bulk.",
+        "description": "Create a new object. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: Use ` + "`" + `/batch` + "`" + ` for importing many objects**: \u003cbr/\u003eIf you plan on importing a large number of objects, it's much more efficient to use the ` + "`" + `/batch` + "`" + ` endpoint. Otherwise, sending multiple single requests sequentially would incur a large performance penalty. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/objects` + "`" + `**: \u003cbr/\u003ePOST /objects will fail if an id is provided which already exists in the class. To update an existing object with the objects endpoint, use the PUT or PATCH method.",
         "tags": [
-          "batch",
-          "references"
+          "objects"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
+        "summary": "Create a new object.",
+        "operationId": "objects.create",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
+              "$ref": "#/definitions/Object"
             }
           },
           {
@@ -9206,12 +10458,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
+            "description": "Object created.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
+              "$ref": "#/definitions/Object"
             }
           },
           "400": {
@@ -9249,37 +10498,27 @@ func init() {
         ]
       }
     },
-    "/classifications/": {
+    "/objects/validate": {
       "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+        "description": "Validate an object's schema and meta-data without creating it. \u003cbr/\u003e\u003cbr/\u003eIf the schema of the object is valid, the request should return nothing with a plain RESTful request. Otherwise, an error object will be returned.",
         "tags": [
-          "classifications"
+          "objects"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Classification"
+              "$ref": "#/definitions/Object"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "200": {
+            "description": "Successfully validated."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9290,6 +10529,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -9297,126 +10542,73 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.local.query.meta"
         ]
       }
     },
-    "/classifications/{id}": {
+    "/objects/{className}/{id}": {
       "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+        "description": "Get a data object based on its collection and UUID. Also available as Websocket bus.",
         "tags": [
-          "classifications"
+          "objects"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
+        "operationId": "objects.class.get",
         "parameters": [
           {
             "type": "string",
-            "description": "classification id",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Found the classification, returned as body",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
+          {
+            "type": "string",
+            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
+            "name": "include",
+            "in": "query"
           },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           },
-          "404": {
-            "description": "Not Found - Classification does not exist"
+          {
+            "type": "string",
+            "description": "The target node which should fulfill the request",
+            "name": "node_name",
+            "in": "query"
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
-        },
-        "x-serviceIds": [
-          "weaviate.classifications.get"
-        ]
-      }
-    },
-    "/cluster/statistics": {
-      "get": {
-        "description": "Returns Raft cluster statistics of Weaviate DB.",
-        "tags": [
-          "cluster"
         ],
-        "summary": "See Raft cluster statistics",
-        "operationId": "cluster.get.statistics",
         "responses": {
           "200": {
-            "description": "Cluster statistics successfully returned",
-            "schema": {
-              "$ref": "#/definitions/ClusterStatisticsResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Object"
             }
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+          "400": {
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        },
-        "x-serviceIds": [
-          "weaviate.cluster.statistics.get"
-        ]
-      }
-    },
-    "/graphql": {
-      "post": {
-        "description": "Get a response based on a GraphQL query",
-        "tags": [
-          "graphql"
-        ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
-        "parameters": [
-          {
-            "description": "The GraphQL query request parameters.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Successful query (with select).",
-            "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
-            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9427,8 +10619,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9443,37 +10638,51 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.query"
         ]
-      }
-    },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+      },
+      "put": {
+        "description": "Update an object based on its uuid and collection. This (` + "`" + `put` + "`" + `) method replaces the object with the provided object.",
         "tags": [
-          "graphql"
+          "objects"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Update a class object based on its uuid",
+        "operationId": "objects.class.put",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
+              "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Successfully received.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -9485,6 +10694,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -9501,26 +10713,52 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/meta": {
-      "get": {
-        "description": "Returns meta information about the server. Can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+      },
+      "delete": {
+        "description": "Delete an object based on its collection and UUID. \u003cbr/\u003e\u003cbr/\u003eNote: For backward compatibility, beacons also support an older, deprecated format without the collection name. As a result, when deleting a reference, the beacon specified has to match the beacon to be deleted exactly. In other words, if a beacon is present using the old format (without collection name) you also need to specify it the same way. \u003cbr/\u003e\u003cbr/\u003eIn the beacon format, you need to always use ` + "`" + `localhost` + "`" + ` as the host, rather than the actual hostname. ` + "`" + `localhost` + "`" + ` here refers to the fact that the beacon's target is on the same Weaviate instance, as opposed to a foreign instance.",
         "tags": [
-          "meta"
+          "objects"
+        ],
+        "summary": "Delete object based on its class and UUID.",
+        "operationId": "objects.class.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
+          }
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
         "responses": {
-          "200": {
-            "description": "Successful response.",
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -9532,6 +10770,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -9539,36 +10786,51 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/nodes": {
-      "get": {
-        "description": "Returns node information for the entire database.",
+      },
+      "head": {
+        "description": "Checks if a data object exists based on its collection and uuid without retrieving it. \u003cbr/\u003e\u003cbr/\u003eInternally it skips reading the object from disk other than checking if it is present. Thus it does not use resources on marshalling, parsing, etc., and is faster. Note the resulting HTTP request has no body; the existence of an object is indicated solely by the status code.",
         "tags": [
-          "nodes"
+          "objects"
         ],
-        "summary": "Node information for the database.",
-        "operationId": "nodes.get",
+        "summary": "Checks object's existence based on its class and uuid.",
+        "operationId": "objects.class.head",
         "parameters": [
           {
             "type": "string",
-            "default": "minimal",
-            "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-            "name": "output",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The uuid of the data object",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
             "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Nodes status successfully returned",
-            "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9580,13 +10842,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Object doesn't exist."
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9598,39 +10857,58 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.nodes.status.get"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/nodes/{className}": {
-      "get": {
-        "description": "Returns node information for the nodes relevant to the collection.",
+      },
+      "patch": {
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
-          "nodes"
+          "objects"
         ],
-        "summary": "Node information for a collection.",
-        "operationId": "nodes.get.class",
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.class.patch",
         "parameters": [
           {
             "type": "string",
+            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "default": "minimal",
-            "description": "Controls the verbosity of the output, possible values are: \"minimal\", \"verbose\". Defaults to \"minimal\".",
-            "name": "output",
+            "format": "uuid",
+            "description": "The uuid of the data object to update.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
             "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Nodes status successfully returned",
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed.",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -9643,13 +10921,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9661,63 +10936,56 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.nodes.status.get.class"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/objects": {
-      "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace **all** references in cross-reference property of an object.",
         "tags": [
           "objects"
         ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.class.references.put",
         "parameters": [
           {
             "type": "string",
-            "description": "A threshold UUID of the objects to retrieve after, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `, typically in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `after` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eFor a null value similar to offset=0, set an empty string in the request, i.e. ` + "`" + `after=` + "`" + ` or ` + "`" + `after` + "`" + `.",
-            "name": "after",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "default": 0,
-            "description": "The starting index of the result window. Note ` + "`" + `offset` + "`" + ` will retrieve ` + "`" + `offset+limit` + "`" + ` results and return ` + "`" + `limit` + "`" + ` results from the object with index ` + "`" + `offset` + "`" + ` onwards. Limited by the value of ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eShould be used in conjunction with ` + "`" + `limit` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eCannot be used with ` + "`" + `after` + "`" + `.",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "The maximum number of items to be returned per page. The default is 25 unless set otherwise as an environment variable.",
-            "name": "limit",
-            "in": "query"
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
-            "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
-            "name": "sort",
-            "in": "query"
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "string",
-            "description": "Order parameter to tell how to order (asc or desc) data within given field. Should be used in conjunction with ` + "`" + `sort` + "`" + ` parameter. If providing multiple ` + "`" + `sort` + "`" + ` values, provide multiple ` + "`" + `order` + "`" + ` values in corresponding order, e.g.: ` + "`" + `sort=author_name,title\u0026order=desc,asc` + "`" + `.",
-            "name": "order",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
           },
           {
             "type": "string",
-            "description": "The collection from which to query objects.  \u003cbr/\u003e\u003cbr/\u003eNote that if ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
-            "name": "class",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
             "in": "query"
           },
           {
@@ -9729,10 +10997,7 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successful response. \u003cbr/\u003e\u003cbr/\u003eIf ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
-            "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
-            }
+            "description": "Successfully replaced all the references."
           },
           "400": {
             "description": "Malformed request.",
@@ -9750,10 +11015,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Source object doesn't exist."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9768,23 +11033,45 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate"
         ]
       },
       "post": {
-        "description": "Create a new object. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: Use ` + "`" + `/batch` + "`" + ` for importing many objects**: \u003cbr/\u003eIf you plan on importing a large number of objects, it's much more efficient to use the ` + "`" + `/batch` + "`" + ` endpoint. Otherwise, sending multiple single requests sequentially would incur a large performance penalty. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/objects` + "`" + `**: \u003cbr/\u003ePOST /objects will fail if an id is provided which already exists in the class. To update an existing object with the objects endpoint, use the PUT or PATCH method.",
+        "description": "Add a single reference to an object. This adds a reference to the array of cross-references of the given property in the source object specified by its collection name and id",
         "tags": [
           "objects"
         ],
-        "summary": "Create a new object.",
-        "operationId": "objects.create",
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.class.references.create",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
           },
           {
@@ -9792,14 +11079,17 @@ func init() {
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
             "name": "consistency_level",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Object created.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "description": "Successfully added the reference."
           },
           "400": {
             "description": "Malformed request.",
@@ -9816,8 +11106,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Source object doesn't exist."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9832,31 +11125,69 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects/validate": {
-      "post": {
-        "description": "Validate an object's schema and meta-data without creating it. \u003cbr/\u003e\u003cbr/\u003eIf the schema of the object is valid, the request should return nothing with a plain RESTful request. Otherwise, an error object will be returned.",
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
           "objects"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.class.references.delete",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully validated."
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -9867,8 +11198,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -9883,25 +11220,20 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/objects/{className}/{id}": {
+    "/objects/{id}": {
       "get": {
-        "description": "Get a data object based on its collection and UUID. Also available as Websocket bus.",
+        "description": "Get a specific object based on its UUID. Also available as Websocket bus.",
         "tags": [
           "objects"
         ],
-        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
-        "operationId": "objects.class.get",
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -9915,24 +11247,6 @@ func init() {
             "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
             "name": "include",
             "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The target node which should fulfill the request",
-            "name": "node_name",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
           }
         ],
         "responses": {
@@ -9960,12 +11274,6 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
-          "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -9980,23 +11288,18 @@ func init() {
         ]
       },
       "put": {
-        "description": "Update an object based on its uuid and collection. This (` + "`" + `put` + "`" + `) method replaces the object with the provided object.",
+        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
-        "summary": "Update a class object based on its uuid",
-        "operationId": "objects.class.put",
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The uuid of the data object to update.",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
@@ -10055,19 +11358,14 @@ func init() {
         ]
       },
       "delete": {
-        "description": "Delete an object based on its collection and UUID. \u003cbr/\u003e\u003cbr/\u003eNote: For backward compatibility, beacons also support an older, deprecated format without the collection name. As a result, when deleting a reference, the beacon specified has to match the beacon to be deleted exactly. In other words, if a beacon is present using the old format (without collection name) you also need to specify it the same way. \u003cbr/\u003e\u003cbr/\u003eIn the beacon format, you need to always use ` + "`" + `localhost` + "`" + ` as the host, rather than the actual hostname. ` + "`" + `localhost` + "`" + ` here refers to the fact that the beacon's target is on the same Weaviate instance, as opposed to a foreign instance.",
+        "description": "Deletes an object from the database based on its UUID.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete object based on its class and UUID.",
-        "operationId": "objects.class.delete",
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -10093,12 +11391,6 @@ func init() {
           "204": {
             "description": "Successfully deleted."
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -10111,12 +11403,6 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
-          "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -10131,39 +11417,21 @@ func init() {
         ]
       },
       "head": {
-        "description": "Checks if a data object exists based on its collection and uuid without retrieving it. \u003cbr/\u003e\u003cbr/\u003eInternally it skips reading the object from disk other than checking if it is present. Thus it does not use resources on marshalling, parsing, etc., and is faster. Note the resulting HTTP request has no body; the existence of an object is indicated solely by the status code.",
+        "description": "Checks if an object exists in the system based on its UUID.",
         "tags": [
           "objects"
         ],
-        "summary": "Checks object's existence based on its class and uuid.",
-        "operationId": "objects.class.head",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
+        "deprecated": true,
+        "parameters": [
           {
             "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
@@ -10182,12 +11450,6 @@ func init() {
           "404": {
             "description": "Object doesn't exist."
           },
-          "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -10198,28 +11460,22 @@ func init() {
         "x-available-in-mqtt": true,
         "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.objects.check"
         ]
       },
       "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
         "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.class.patch",
+        "operationId": "objects.patch",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The uuid of the data object to update.",
+            "description": "Unique ID of the Object.",
             "name": "id",
             "in": "path",
             "required": true
@@ -10244,10 +11500,7 @@ func init() {
             "description": "Successfully applied. No content provided."
           },
           "400": {
-            "description": "The patch-JSON is malformed.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "The patch-JSON is malformed."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -10281,22 +11534,16 @@ func init() {
         ]
       }
     },
-    "/objects/{className}/{id}/references/{propertyName}": {
+    "/objects/{id}/references/{propertyName}": {
       "put": {
-        "description": "Replace **all** references in cross-reference property of an object.",
+        "description": "Replace all references in cross-reference property of an object.",
         "tags": [
           "objects"
         ],
         "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "operationId": "objects.references.update",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -10320,12 +11567,6 @@ func init() {
               "$ref": "#/definitions/MultipleRef"
             }
           },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
           {
             "type": "string",
             "description": "Specifies the tenant in a request targeting a multi-tenant class",
@@ -10337,12 +11578,6 @@ func init() {
           "200": {
             "description": "Successfully replaced all the references."
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -10352,9 +11587,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Source object doesn't exist."
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
@@ -10375,20 +11607,14 @@ func init() {
         ]
       },
       "post": {
-        "description": "Add a single reference to an object. This adds a reference to the array of cross-references of the given property in the source object specified by its collection name and id",
+        "description": "Add a cross-reference.",
         "tags": [
           "objects"
         ],
         "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "operationId": "objects.references.create",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -10412,12 +11638,6 @@ func init() {
               "$ref": "#/definitions/SingleRef"
             }
           },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
           {
             "type": "string",
             "description": "Specifies the tenant in a request targeting a multi-tenant class",
@@ -10429,12 +11649,6 @@ func init() {
           "200": {
             "description": "Successfully added the reference."
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -10444,9 +11658,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Source object doesn't exist."
-          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
@@ -10472,15 +11683,9 @@ func init() {
           "objects"
         ],
         "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
+        "operationId": "objects.references.delete",
+        "deprecated": true,
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "format": "uuid",
@@ -10504,12 +11709,6 @@ func init() {
               "$ref": "#/definitions/SingleRef"
             }
           },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
           {
             "type": "string",
             "description": "Specifies the tenant in a request targeting a multi-tenant class",
@@ -10521,12 +11720,6 @@ func init() {
           "204": {
             "description": "Successfully deleted."
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -10542,12 +11735,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -10562,106 +11749,19 @@ func init() {
         ]
       }
     },
-    "/objects/{id}": {
+    "/replication/rebalance": {
       "get": {
-        "description": "Get a specific object based on its UUID. Also available as Websocket bus.",
-        "tags": [
-          "objects"
-        ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Include additional information, such as classification infos. Allowed values include: classification, vector, interpretation",
-            "name": "include",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
-        "x-serviceIds": [
-          "weaviate.local.query"
-        ]
-      },
-      "put": {
-        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+        "description": "Returns the state and the moves of the last shard rebalance coordinated by the node receiving the request.",
         "tags": [
-          "objects"
-        ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          }
+          "replication"
         ],
+        "summary": "Get the state of the shard rebalance",
+        "operationId": "replicationRebalanceStatus",
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "The state of the rebalance coordinated by this node",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ReplicationRebalanceStatus"
             }
           },
           "401": {
@@ -10673,15 +11773,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -10689,45 +11780,38 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.replication.rebalance.get"
         ]
       },
-      "delete": {
-        "description": "Deletes an object from the database based on its UUID.",
+      "post": {
+        "description": "Plans the moves which balance the number and the disk usage of shard replicas across the healthy nodes and carries them out in the background. A move replicates a shard to its target node and removes the replica from its source node afterwards. Only a limited number of moves run concurrently. The rebalance is coordinated by the node receiving the request and its state is kept in memory only. If that node restarts, the moves which did not start yet are dropped and the moves which are replicating complete without removing the replica from their source node.",
         "tags": [
-          "objects"
-        ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+          "replication"
+        ],
+        "summary": "Start rebalancing the shard replicas across the nodes",
+        "operationId": "replicationRebalanceStart",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/ReplicationRebalanceRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "The state of the rebalance coordinated by this node",
+            "schema": {
+              "$ref": "#/definitions/ReplicationRebalanceStatus"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -10738,8 +11822,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -10748,33 +11835,20 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.replication.rebalance"
         ]
       },
-      "head": {
-        "description": "Checks if an object exists in the system based on its UUID.",
+      "delete": {
+        "description": "Cancels the moves of the ongoing rebalance which have not started yet. Moves which are already replicating a shard complete.",
         "tags": [
-          "objects"
-        ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          }
+          "replication"
         ],
+        "summary": "Cancel the ongoing shard rebalance",
+        "operationId": "replicationRebalanceCancel",
         "responses": {
           "204": {
-            "description": "Object exists."
+            "description": "Rebalance canceled successfully"
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -10785,8 +11859,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Object doesn't exist."
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -10795,51 +11872,26 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.objects.check"
+          "weaviate.replication.rebalance.cancel"
         ]
-      },
-      "patch": {
-        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      }
+    },
+    "/replication/rebalance/pause": {
+      "post": {
+        "description": "Stops starting new moves of the ongoing rebalance until it is resumed. Moves which are already replicating a shard complete.",
         "tags": [
-          "objects"
+          "replication"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
-            "name": "body",
-            "in": "body",
+        "summary": "Pause the ongoing shard rebalance",
+        "operationId": "replicationRebalancePause",
+        "responses": {
+          "200": {
+            "description": "The state of the rebalance coordinated by this node",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ReplicationRebalanceStatus"
             }
           },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
-          },
-          "400": {
-            "description": "The patch-JSON is malformed."
-          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -10849,11 +11901,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -10865,56 +11914,61 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.replication.rebalance.pause"
         ]
       }
     },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references in cross-reference property of an object.",
+    "/replication/rebalance/plan": {
+      "get": {
+        "description": "Returns the moves a rebalance would carry out and the load of the nodes before and after them, without moving any shard.",
         "tags": [
-          "objects"
+          "replication"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
+        "summary": "Plan a shard rebalance without starting it",
+        "operationId": "replicationRebalancePlan",
+        "responses": {
+          "200": {
+            "description": "The planned moves",
+            "schema": {
+              "$ref": "#/definitions/ReplicationRebalancePlan"
+            }
           },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+          "403": {
+            "description": "Forbidden",
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
+        },
+        "x-serviceIds": [
+          "weaviate.replication.rebalance.plan"
+        ]
+      }
+    },
+    "/replication/rebalance/resume": {
+      "post": {
+        "description": "Continues starting the remaining moves of a paused rebalance.",
+        "tags": [
+          "replication"
         ],
+        "summary": "Resume a paused shard rebalance",
+        "operationId": "replicationRebalanceResume",
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
+            "description": "The state of the rebalance coordinated by this node",
+            "schema": {
+              "$ref": "#/definitions/ReplicationRebalanceStatus"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -10926,7 +11980,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -10938,54 +11992,37 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.replication.rebalance.resume"
         ]
-      },
+      }
+    },
+    "/replication/replicate": {
       "post": {
-        "description": "Add a cross-reference.",
         "tags": [
-          "objects"
+          "replication"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
-        "deprecated": true,
+        "summary": "Start the async operation to replicate a replica between two nodes",
+        "operationId": "replicate",
         "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/ReplicationReplicateReplicaRequest"
             }
-          },
-          {
-            "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Replication operation registered successfully"
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -10997,7 +12034,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -11009,54 +12046,40 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.replication.replicate"
         ]
-      },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      }
+    },
+    "/replication/replicate/{id}": {
+      "get": {
+        "description": "Returns the details of a replication operation for a given shard, identified by the provided replication operation id.",
         "tags": [
-          "objects"
-        ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
-        "deprecated": true,
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
+          "replication"
+        ],
+        "summary": "Get the details of a replication operation.",
+        "operationId": "replicationDetails",
+        "parameters": [
           {
             "type": "string",
-            "description": "Specifies the tenant in a request targeting a multi-tenant class",
-            "name": "tenant",
-            "in": "query"
+            "description": "The replication operation id to get details for.",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "The details of the replication operation.",
+            "schema": {
+              "$ref": "#/definitions/ReplicationReplicateDetailsReplicaResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -11068,10 +12091,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Shard replica operation not found"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -11080,33 +12100,35 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.replication.replicate.details"
         ]
       }
     },
-    "/replication/replicate": {
+    "/replication/split": {
       "post": {
+        "description": "Reassigns the virtual shards of a shard of a single-tenant collection to new shards. Every replica of the shard copies its data into the new shards in the background. Once all replicas are done, requests are routed to the new shards and the shard is removed.",
         "tags": [
           "replication"
         ],
-        "summary": "Start the async operation to replicate a replica between two nodes",
-        "operationId": "replicate",
+        "summary": "Start the async operation to split a shard into new shards",
+        "operationId": "replicationSplitShard",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ReplicationReplicateReplicaRequest"
+              "$ref": "#/definitions/ReplicationSplitShardRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Replication operation registered successfully"
+            "description": "Shard split registered successfully",
+            "schema": {
+              "$ref": "#/definitions/ReplicationSplitShardResponse"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -11137,33 +12159,37 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.replication.replicate"
+          "weaviate.replication.split"
         ]
       }
     },
-    "/replication/replicate/{id}": {
-      "get": {
-        "description": "Returns the details of a replication operation for a given shard, identified by the provided replication operation id.",
+    "/replication/split/{collectionId}/{shardId}": {
+      "delete": {
+        "description": "Aborts the split of a shard which has not completed yet. The shard keeps all its objects and the new shards are removed.",
         "tags": [
           "replication"
         ],
-        "summary": "Get the details of a replication operation.",
-        "operationId": "replicationDetails",
+        "summary": "Cancel an ongoing split of a shard",
+        "operationId": "replicationCancelSplitShard",
         "parameters": [
           {
             "type": "string",
-            "description": "The replication operation id to get details for.",
-            "name": "id",
+            "description": "The collection name holding the shard.",
+            "name": "collectionId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The shard id of the shard being split.",
+            "name": "shardId",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "The details of the replication operation.",
-            "schema": {
-              "$ref": "#/definitions/ReplicationReplicateDetailsReplicaResponse"
-            }
+          "204": {
+            "description": "Shard split canceled successfully"
           },
           "400": {
             "description": "Malformed request.",
@@ -11180,8 +12206,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Shard replica operation not found"
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -11191,7 +12220,7 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.replication.replicate.details"
+          "weaviate.replication.split.cancel"
         ]
       }
     },
@@ -12485,6 +13514,10 @@ func init() {
           "description": "name of the endpoint, e.g. s3.amazonaws.com",
           "type": "string"
         },
+        "IncrementalBaseBackupID": {
+          "description": "ID of a previous backup on the same backend. Files which have not changed since then are referenced instead of being copied again, the base backup must be kept as long as this one.",
+          "type": "string"
+        },
         "Path": {
           "description": "Path or key within the bucket",
           "type": "string"
@@ -13230,6 +14263,9 @@ func init() {
         "multiTenancyConfig": {
           "$ref": "#/definitions/MultiTenancyConfig"
         },
+        "objectTtlConfig": {
+          "$ref": "#/definitions/ObjectTtlConfig"
+        },
         "properties": {
           "description": "Define properties of the collection.",
           "type": "array",
@@ -13690,6 +14726,10 @@ func init() {
           "description": "Index length of properties (default: 'false').",
           "type": "boolean"
         },
+        "indexTermPositions": {
+          "description": "Index the positions of terms in text properties, required for phrase and proximity queries in BM25 (default: 'false').",
+          "type": "boolean"
+        },
         "indexTimestamps": {
           "description": "Index each object by its internal timestamps (default: 'false').",
           "type": "boolean"
@@ -13697,6 +14737,9 @@ func init() {
         "stopwords": {
           "$ref": "#/definitions/StopwordConfig"
         },
+        "synonyms": {
+          "$ref": "#/definitions/SynonymConfig"
+        },
         "usingBlockMaxWAND": {
           "description": "Using BlockMax WAND for query execution (default: 'false', will be 'true' for new collections created after 1.30).",
           "type": "boolean"
@@ -13768,6 +14811,9 @@ func init() {
           "description": "Whether or not multi-tenancy is enabled for this class (default: false).",
           "type": "boolean",
           "x-omitempty": false
+        },
+        "tenantQuota": {
+          "$ref": "#/definitions/TenantQuota"
         }
       }
     },
@@ -13842,6 +14888,12 @@ func init() {
           "format": "boolean",
           "x-omitempty": false
         },
+        "diskBytes": {
+          "description": "The size of the shard on disk in bytes.",
+          "type": "number",
+          "format": "int64",
+          "x-omitempty": false
+        },
         "loaded": {
           "description": "The load status of the shard.",
           "type": "boolean",
@@ -13990,6 +15042,26 @@ func init() {
         }
       }
     },
+    "ObjectTtlConfig": {
+      "description": "Configure automatic expiry of objects within a class",
+      "type": "object",
+      "properties": {
+        "defaultTtl": {
+          "description": "Time-to-live of an object in seconds. Objects whose ` + "`" + `deleteOn` + "`" + ` timestamp is older than this are removed by a periodic background sweep.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "deleteOn": {
+          "description": "Timestamp the time-to-live is measured from. Either ` + "`" + `_creationTimeUnix` + "`" + ` (default), ` + "`" + `_lastUpdateTimeUnix` + "`" + ` or the name of a filterable property of data type ` + "`" + `date` + "`" + `. The internal timestamps require ` + "`" + `indexTimestamps` + "`" + ` to be enabled in the inverted index config.",
+          "type": "string"
+        },
+        "enabled": {
+          "description": "Whether or not objects in this class expire automatically (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
     "ObjectsGetResponse": {
       "type": "object",
       "allOf": [
@@ -14743,6 +15815,140 @@ func init() {
         }
       }
     },
+    "ReplicationRebalanceMove": {
+      "description": "A move of a shard replica from one node to another",
+      "type": "object",
+      "properties": {
+        "collectionId": {
+          "description": "The collection name holding the shard",
+          "type": "string"
+        },
+        "diskBytes": {
+          "description": "The size of the shard on disk at the time the move was planned",
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "description": "The reason a move failed",
+          "type": "string"
+        },
+        "shardId": {
+          "description": "The id of the moved shard",
+          "type": "string"
+        },
+        "sourceNodeId": {
+          "description": "The node the replica is moved from",
+          "type": "string"
+        },
+        "status": {
+          "description": "The state of the move, only set for moves of a started rebalance",
+          "type": "string",
+          "enum": [
+            "PENDING",
+            "REPLICATING",
+            "DONE",
+            "FAILED",
+            "CANCELED"
+          ]
+        },
+        "targetNodeId": {
+          "description": "The node the replica is moved to",
+          "type": "string"
+        }
+      }
+    },
+    "ReplicationRebalanceNodeLoad": {
+      "description": "The shard replicas held by a node",
+      "type": "object",
+      "properties": {
+        "diskBytes": {
+          "description": "The size of the shard replicas held by the node on disk",
+          "type": "integer",
+          "format": "int64"
+        },
+        "nodeId": {
+          "description": "The name of the node",
+          "type": "string"
+        },
+        "shardCount": {
+          "description": "The number of shard replicas held by the node",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "ReplicationRebalancePlan": {
+      "description": "The moves which balance the shard replicas across the nodes",
+      "type": "object",
+      "properties": {
+        "current": {
+          "description": "The load of the nodes before the moves",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReplicationRebalanceNodeLoad"
+          }
+        },
+        "moves": {
+          "description": "The planned moves",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReplicationRebalanceMove"
+          }
+        },
+        "planned": {
+          "description": "The load of the nodes once all moves completed",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReplicationRebalanceNodeLoad"
+          }
+        }
+      }
+    },
+    "ReplicationRebalanceRequest": {
+      "description": "Options of a shard rebalance",
+      "type": "object",
+      "properties": {
+        "maxConcurrentMoves": {
+          "description": "The maximum number of moves replicating shards at the same time",
+          "type": "integer",
+          "default": 2,
+          "minimum": 1
+        }
+      }
+    },
+    "ReplicationRebalanceStatus": {
+      "description": "The state of a shard rebalance",
+      "type": "object",
+      "properties": {
+        "automatic": {
+          "description": "Whether the rebalance was started because nodes joined or left the cluster",
+          "type": "boolean"
+        },
+        "moves": {
+          "description": "The moves of the rebalance",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ReplicationRebalanceMove"
+          }
+        },
+        "startedAt": {
+          "description": "Timestamp of when the rebalance started",
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "description": "The state of the rebalance",
+          "type": "string",
+          "enum": [
+            "IDLE",
+            "RUNNING",
+            "PAUSED",
+            "CANCELED",
+            "FINISHED"
+          ]
+        }
+      }
+    },
     "ReplicationReplicateDetailsReplicaResponse": {
       "description": "The current status and details of a replication operation, including information about the resources involved in the replication process.",
       "required": [
@@ -14815,6 +16021,50 @@ func init() {
         }
       }
     },
+    "ReplicationSplitShardRequest": {
+      "description": "Request body to split a shard of a single-tenant collection into new shards",
+      "type": "object",
+      "required": [
+        "collectionId",
+        "shardId"
+      ],
+      "properties": {
+        "collectionId": {
+          "description": "The collection name holding the shard",
+          "type": "string"
+        },
+        "parts": {
+          "description": "The number of shards to split the shard into",
+          "type": "integer",
+          "default": 2
+        },
+        "shardId": {
+          "description": "The shard id of the shard to be split",
+          "type": "string"
+        }
+      }
+    },
+    "ReplicationSplitShardResponse": {
+      "description": "The shards a shard is being split into",
+      "type": "object",
+      "properties": {
+        "collectionId": {
+          "description": "The collection name holding the shard",
+          "type": "string"
+        },
+        "shardId": {
+          "description": "The shard id of the shard being split",
+          "type": "string"
+        },
+        "targetShardIds": {
+          "description": "The ids of the new shards, which replace the shard once the split completed",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "RestoreConfig": {
       "description": "Backup custom configuration",
       "type": "object",
@@ -14838,6 +16088,11 @@ func init() {
         "Path": {
           "description": "Path within the bucket",
           "type": "string"
+        },
+        "RestoreUntilUnix": {
+          "description": "Point in time, in milliseconds since epoch UTC, up to which the logs archived after the backup are replayed. Requires log archiving to have been enabled when the backup was created. Logs are archived in rounds, every PERSISTENCE_LOG_ARCHIVE_INTERVAL_SECONDS, and only rounds archived up to this time are replayed, so writes made up to one interval before it may not be restored. The time actually restored to is logged by each node.",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
@@ -15067,6 +16322,37 @@ func init() {
         }
       }
     },
+    "SynonymConfig": {
+      "description": "Synonyms that the terms of keyword (BM25) queries are expanded with. Synonyms are matched case-insensitively and only apply to properties with 'word' or 'lowercase' tokenization.",
+      "type": "object",
+      "properties": {
+        "sets": {
+          "description": "Groups of equivalent terms (default: []).",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SynonymSet"
+          }
+        }
+      }
+    },
+    "SynonymSet": {
+      "description": "A group of equivalent terms, a query containing one of them also matches the others",
+      "type": "object",
+      "properties": {
+        "terms": {
+          "description": "The equivalent terms, each a single token.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "weight": {
+          "description": "Weight of matches through a synonym relative to matches of the query term itself, between 0 and 1 (default: 1).",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
     "Tenant": {
       "description": "attributes representing a single tenant within weaviate",
       "type": "object",
@@ -15090,6 +16376,25 @@ func init() {
         "name": {
           "description": "The name of the tenant (required).",
           "type": "string"
+        },
+        "usage": {
+          "$ref": "#/definitions/TenantUsage"
+        }
+      }
+    },
+    "TenantQuota": {
+      "description": "Limits applying to each tenant of a multi-tenant class. Writes which would exceed them are rejected.",
+      "type": "object",
+      "properties": {
+        "maxDiskBytes": {
+          "description": "Maximum size on disk per tenant in bytes. Once reached, only deletes are accepted. 0 means unlimited (default).",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxObjectCount": {
+          "description": "Maximum number of objects per tenant. 0 means unlimited (default).",
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
@@ -15113,6 +16418,24 @@ func init() {
         }
       ]
     },
+    "TenantUsage": {
+      "description": "Resources used by a tenant, as measured periodically in the background. Only reported for tenants which are loaded. Read-only.",
+      "type": "object",
+      "properties": {
+        "diskBytes": {
+          "description": "The size of the tenant on disk in bytes.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "objectCount": {
+          "description": "The number of objects of the tenant.",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
     "UserApiKey": {
       "type": "object",
       "required": [
@@ -15228,7 +16551,8 @@ func init() {
             "WithinGeoRange",
             "IsNull",
             "ContainsAny",
-            "ContainsAll"
+            "ContainsAll",
+            "Not"
           ],
           "example": "GreaterThanEqual"
         },
@@ -90,6 +90,12 @@ func parseNestedFilter(in *models.WhereFilter,
 			operator.Name())
 	}
 
+	if operator == filters.OperatorNot && len(in.Operands) != 1 {
+		return nil, fmt.Errorf(
+			"operator '%s' requires exactly one operand, got %d - combine them "+
+				"with And/Or first", operator.Name(), len(in.Operands))
+	}
+
 	operands, err := parseOperands(in.Operands, rootClass)
 	if err != nil {
 		return nil, err
@@ -145,6 +151,8 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.ContainsAny, nil
 	case models.WhereFilterOperatorContainsAll:
 		return filters.ContainsAll, nil
+	case models.WhereFilterOperatorNot:
+		return filters.OperatorNot, nil
 	default:
 		return -1, fmt.Errorf("unrecognized operator: %s", in)
 	}
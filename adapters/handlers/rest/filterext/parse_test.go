@@ -265,6 +265,19 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				expectedErr: fmt.Errorf("invalid where filter: " +
 					"operator 'And', but no operands set - add at least one operand"),
 			},
+			{
+				name: "not operator and multiple operands set",
+				input: &models.WhereFilter{
+					Operator: "Not",
+					Operands: []*models.WhereFilter{
+						inputIntFilterWithValue(42),
+						inputIntFilterWithValue(43),
+					},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: " +
+					"operator 'Not' requires exactly one operand, got 2 - combine " +
+					"them with And/Or first"),
+			},
 			{
 				name: "equal operator and no values set",
 				input: &models.WhereFilter{
@@ -433,6 +446,33 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				},
 			},
+			{
+				name: "negated using not",
+				input: &models.WhereFilter{
+					Operator: "Not",
+					Operands: []*models.WhereFilter{
+						inputIntFilterWithValue(42),
+					},
+				},
+				expectedFilter: &filters.LocalFilter{
+					Root: &filters.Clause{
+						Operator: filters.OperatorNot,
+						Operands: []filters.Clause{
+							{
+								Operator: filters.OperatorEqual,
+								On: &filters.Path{
+									Class:    schema.AssertValidClassName("Todo"),
+									Property: schema.AssertValidPropertyName("intField"),
+								},
+								Value: &filters.Value{
+									Value: 42,
+									Type:  schema.DataTypeInt,
+								},
+							},
+						},
+					},
+				},
+			},
 		}
 
 		for _, test := range tests {
@@ -13,6 +13,7 @@ package rest
 
 import (
 	"errors"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/sirupsen/logrus"
@@ -96,18 +97,21 @@ func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 ) middleware.Responder {
 	overrideBucket := ""
 	overridePath := ""
+	baseBackupID := ""
 	if params.Body.Config != nil {
 		overrideBucket = params.Body.Config.Bucket
 		overridePath = params.Body.Config.Path
+		baseBackupID = params.Body.Config.IncrementalBaseBackupID
 	}
 	meta, err := s.manager.Backup(params.HTTPRequest.Context(), principal, &ubak.BackupRequest{
-		ID:          params.Body.ID,
-		Backend:     params.Backend,
-		Bucket:      overrideBucket,
-		Path:        overridePath,
-		Include:     params.Body.Include,
-		Exclude:     params.Body.Exclude,
-		Compression: compressionFromBCfg(params.Body.Config),
+		ID:           params.Body.ID,
+		Backend:      params.Backend,
+		Bucket:       overrideBucket,
+		Path:         overridePath,
+		Include:      params.Body.Include,
+		Exclude:      params.Body.Exclude,
+		Compression:  compressionFromBCfg(params.Body.Config),
+		BaseBackupID: baseBackupID,
 	})
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
@@ -175,19 +179,24 @@ func (s *backupHandlers) restoreBackup(params backups.BackupsRestoreParams,
 ) middleware.Responder {
 	bucket := ""
 	path := ""
+	var until time.Time
 	if params.Body.Config != nil {
 		bucket = params.Body.Config.Bucket
 		path = params.Body.Config.Path
+		if ms := params.Body.Config.RestoreUntilUnix; ms > 0 {
+			until = time.UnixMilli(ms).UTC()
+		}
 	}
 	meta, err := s.manager.Restore(params.HTTPRequest.Context(), principal, &ubak.BackupRequest{
-		ID:          params.ID,
-		Backend:     params.Backend,
-		Include:     params.Body.Include,
-		Exclude:     params.Body.Exclude,
-		NodeMapping: params.Body.NodeMapping,
-		Compression: compressionFromRCfg(params.Body.Config),
-		Bucket:      bucket,
-		Path:        path,
+		ID:           params.ID,
+		Backend:      params.Backend,
+		Include:      params.Body.Include,
+		Exclude:      params.Body.Exclude,
+		NodeMapping:  params.Body.NodeMapping,
+		Compression:  compressionFromRCfg(params.Body.Config),
+		Bucket:       bucket,
+		Path:         path,
+		RestoreUntil: until,
 	})
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
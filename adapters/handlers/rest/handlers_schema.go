@@ -12,6 +12,7 @@
 package rest
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
 	"github.com/weaviate/weaviate/entities/models"
+	entschema "github.com/weaviate/weaviate/entities/schema"
 	authzerrors "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	uco "github.com/weaviate/weaviate/usecases/objects"
@@ -30,7 +32,13 @@ import (
 
 type schemaHandlers struct {
 	manager             *schemaUC.Manager
+	usage               tenantUsageGetter
 	metricRequestsTotal restApiRequestsTotal
+	logger              logrus.FieldLogger
+}
+
+type tenantUsageGetter interface {
+	GetTenantUsage(ctx context.Context, className string, tenants []string) (map[string]*models.TenantUsage, error)
 }
 
 func (s *schemaHandlers) addClass(params schema.SchemaObjectsCreateParams,
@@ -301,7 +309,9 @@ func (s *schemaHandlers) getTenants(params schema.TenantsGetParams,
 	}
 
 	s.metricRequestsTotal.logOk(params.ClassName)
-	return schema.NewTenantsGetOK().WithPayload(schemaUC.TenantResponsesToTenants(tenants))
+	payload := schemaUC.TenantResponsesToTenants(tenants)
+	s.addTenantUsage(ctx, params.ClassName, payload...)
+	return schema.NewTenantsGetOK().WithPayload(payload)
 }
 
 func (s *schemaHandlers) getTenant(
@@ -334,6 +344,7 @@ func (s *schemaHandlers) getTenant(
 			WithPayload(errPayloadFromSingleErr(fmt.Errorf("tenant '%s' not found when it should have been", params.TenantName)))
 	}
 	s.metricRequestsTotal.logOk(params.ClassName)
+	s.addTenantUsage(ctx, params.ClassName, &tenant.Tenant)
 	return schema.NewTenantsGetOneOK().WithPayload(tenant)
 }
 
@@ -357,8 +368,34 @@ func (s *schemaHandlers) tenantExists(params schema.TenantExistsParams, principa
 	return schema.NewTenantExistsOK()
 }
 
-func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) {
-	h := &schemaHandlers{manager, newSchemaRequestsTotal(metrics, logger)}
+// addTenantUsage reports the usage of the tenants as last measured in the
+// background by the nodes holding them. Tenants which are not loaded or not
+// measured yet are reported without usage.
+func (s *schemaHandlers) addTenantUsage(ctx context.Context, className string, tenants ...*models.Tenant) {
+	if s.usage == nil || len(tenants) == 0 {
+		return
+	}
+
+	names := make([]string, len(tenants))
+	for i, tenant := range tenants {
+		names[i] = tenant.Name
+	}
+	usage, err := s.usage.GetTenantUsage(ctx, entschema.UppercaseClassName(className), names)
+	if err != nil {
+		s.logger.WithField("action", "tenant_usage").WithField("class", className).
+			WithError(err).Warn("could not get the usage of tenants")
+		return
+	}
+
+	for _, tenant := range tenants {
+		tenant.Usage = usage[tenant.Name]
+	}
+}
+
+func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager, usage tenantUsageGetter,
+	metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &schemaHandlers{manager, usage, newSchemaRequestsTotal(metrics, logger), logger}
 
 	api.SchemaSchemaObjectsCreateHandler = schema.
 		SchemaObjectsCreateHandlerFunc(h.addClass)
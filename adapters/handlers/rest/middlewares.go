@@ -13,9 +13,14 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math"
 	"net/http"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -27,9 +32,12 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/raft"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/state"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/swagger_middleware"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/modules"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+	"github.com/weaviate/weaviate/usecases/tracing"
 )
 
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
@@ -37,18 +45,115 @@ import (
 //
 // we are setting the middlewares from within configureAPI, as we need access
 // to some resources which are not exposed
-func makeSetupMiddlewares(appState *state.State) func(http.Handler) http.Handler {
+func makeSetupMiddlewares(appState *state.State, context *middleware.Context) func(http.Handler) http.Handler {
+	addPrincipalLimits := makeAddPrincipalLimits(appState.PrincipalLimiter, context)
 	return func(handler http.Handler) http.Handler {
+		limited := addPrincipalLimits(appState.AnonymousAccess.Middleware(handler))
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			if r.URL.String() == "/v1/.well-known/openid-configuration" || r.URL.String() == "/v1" {
 				handler.ServeHTTP(w, r)
 				return
 			}
-			appState.AnonymousAccess.Middleware(handler).ServeHTTP(w, r)
+			limited.ServeHTTP(w, r)
 		})
 	}
 }
 
+// makeAddPrincipalLimits enforces the request rate, concurrency and
+// ingestion volume limits of the principal of each request. The principal is
+// authenticated here already and cached in the request, so that the
+// operation does not authenticate it again. Requests which fail to
+// authenticate are passed on, the operation responds with the error.
+func makeAddPrincipalLimits(limiter *ratelimiter.Principals, context *middleware.Context) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if limiter == nil {
+			return next
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, rr, ok := context.RouteInfo(r)
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			r = rr
+			user, ar, err := context.Authorize(r, route)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if ar != nil {
+				r = ar
+			}
+
+			principal, _ := user.(*models.Principal)
+			permit, err := limiter.Acquire(principal)
+			if err != nil {
+				respondLimited(w, err)
+				return
+			}
+			defer permit.Release()
+
+			if !ingests(r.Method, route.PathPattern) {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if r.ContentLength >= 0 {
+				if err := permit.Ingest(r.ContentLength); err != nil {
+					respondLimited(w, err)
+					return
+				}
+				next.ServeHTTP(w, r)
+				return
+			}
+			// the size of chunked bodies is only known once they have been read
+			if err := permit.Ingest(0); err != nil {
+				respondLimited(w, err)
+				return
+			}
+			body := &countingReader{ReadCloser: r.Body}
+			r.Body = body
+			next.ServeHTTP(w, r)
+			permit.Account(body.n)
+		})
+	}
+}
+
+// ingests is true for the routes importing objects or references
+func ingests(method, pathPattern string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		if pathPattern == "/v1/objects/validate" {
+			// validated objects are not stored
+			return false
+		}
+		return strings.HasPrefix(pathPattern, "/v1/objects") ||
+			strings.HasPrefix(pathPattern, "/v1/batch/")
+	default:
+		return false
+	}
+}
+
+func respondLimited(w http.ResponseWriter, err error) {
+	var le *ratelimiter.LimitError
+	if errors.As(err, &le) && le.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(le.RetryAfter.Seconds()))))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	json.NewEncoder(w).Encode(errPayloadFromSingleErr(err))
+}
+
+type countingReader struct {
+	io.ReadCloser
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.ReadCloser.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
 func addHandleRoot(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.String() == "/" {
@@ -139,6 +244,13 @@ func makeSetupGlobalMiddleware(appState *state.State, context *middleware.Contex
 				appState.HTTPServerMetrics.ResponseBodySize,
 			)
 		}
+		if appState.ServerConfig.Config.Tracing.Enabled {
+			route := staticRoute(context)
+			handler = tracing.InstrumentHTTP(handler, func(r *http.Request) string {
+				_, label := route(r)
+				return label
+			})
+		}
 		// Must be the last middleware as it might skip the next handler
 		handler = addClusterHandlerMiddleware(handler, appState)
 		if appState.ServerConfig.Config.Sentry.Enabled {
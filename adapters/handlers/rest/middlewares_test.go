@@ -12,7 +12,9 @@
 package rest
 
 import (
+	"bytes"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/go-openapi/loads"
@@ -20,6 +22,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 func Test_staticRoute(t *testing.T) {
@@ -67,6 +71,65 @@ func Test_staticRoute(t *testing.T) {
 	}
 }
 
+func Test_principalLimits(t *testing.T) {
+	spec, err := loads.Embedded(SwaggerJSON, FlatSwaggerJSON)
+	require.NoError(t, err)
+
+	authenticated := 0
+	api := operations.NewWeaviateAPI(spec)
+	api.OidcAuth = func(token string, scopes []string) (*models.Principal, error) {
+		authenticated++
+		return &models.Principal{Username: token}, nil
+	}
+	api.Init()
+
+	router := middleware.DefaultRouter(spec, api)
+	ctx := middleware.NewRoutableContext(spec, api, router)
+
+	cfg := ratelimiter.Config{
+		Default: ratelimiter.Limits{RequestsPerSecond: 1},
+		Users:   map[string]ratelimiter.Limits{"importer": {IngestBytesPerSecond: 100}},
+	}
+	limiter := ratelimiter.NewPrincipals(func() ratelimiter.Config { return cfg }, nil, nil)
+
+	handler := makeAddPrincipalLimits(limiter, ctx)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// like the operation, which reuses the principal authenticated by
+		// the middleware
+		route, r, _ := ctx.RouteInfo(r)
+		_, _, err := ctx.Authorize(r, route)
+		require.NoError(t, err)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(method, path, user string, body []byte) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, path, bytes.NewReader(body))
+		r.Header.Set("Authorization", "Bearer "+user)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("request rate", func(t *testing.T) {
+		assert.Equal(t, http.StatusOK, serve("GET", "/v1/schema", "alice", nil).Code)
+		assert.Equal(t, 1, authenticated)
+		w := serve("GET", "/v1/schema", "alice", nil)
+		assert.Equal(t, http.StatusTooManyRequests, w.Code)
+		assert.Equal(t, "1", w.Header().Get("Retry-After"))
+		assert.Contains(t, w.Body.String(), `requests_per_second of \"alice\" exceeded`)
+
+		assert.Equal(t, http.StatusOK, serve("GET", "/v1/schema", "bob", nil).Code)
+	})
+
+	t.Run("ingestion volume", func(t *testing.T) {
+		body := make([]byte, 150)
+		// queries do not count towards the ingestion volume
+		assert.Equal(t, http.StatusOK, serve("POST", "/v1/graphql", "importer", body).Code)
+		assert.Equal(t, http.StatusOK, serve("POST", "/v1/objects/validate", "importer", body).Code)
+		assert.Equal(t, http.StatusOK, serve("POST", "/v1/batch/objects", "importer", body).Code)
+		assert.Equal(t, http.StatusTooManyRequests, serve("POST", "/v1/batch/objects", "importer", body).Code)
+	})
+}
+
 func newRequest(t *testing.T, path string) *http.Request {
 	t.Helper()
 
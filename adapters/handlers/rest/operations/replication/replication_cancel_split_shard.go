@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationCancelSplitShardHandlerFunc turns a function with the right signature into a replication cancel split shard handler
+type ReplicationCancelSplitShardHandlerFunc func(ReplicationCancelSplitShardParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReplicationCancelSplitShardHandlerFunc) Handle(params ReplicationCancelSplitShardParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ReplicationCancelSplitShardHandler interface for that can handle valid replication cancel split shard params
+type ReplicationCancelSplitShardHandler interface {
+	Handle(ReplicationCancelSplitShardParams, *models.Principal) middleware.Responder
+}
+
+// NewReplicationCancelSplitShard creates a new http.Handler for the replication cancel split shard operation
+func NewReplicationCancelSplitShard(ctx *middleware.Context, handler ReplicationCancelSplitShardHandler) *ReplicationCancelSplitShard {
+	return &ReplicationCancelSplitShard{Context: ctx, Handler: handler}
+}
+
+/*
+	ReplicationCancelSplitShard swagger:route DELETE /replication/split/{collectionId}/{shardId} replication replicationCancelSplitShard
+
+# Cancel an ongoing split of a shard
+
+Aborts the split of a shard which has not completed yet. The shard keeps all its objects and the new shards are removed.
+*/
+type ReplicationCancelSplitShard struct {
+	Context *middleware.Context
+	Handler ReplicationCancelSplitShardHandler
+}
+
+func (o *ReplicationCancelSplitShard) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReplicationCancelSplitShardParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
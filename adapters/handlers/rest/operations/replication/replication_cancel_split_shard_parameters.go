@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReplicationCancelSplitShardParams creates a new ReplicationCancelSplitShardParams object
+//
+// There are no default values defined in the spec.
+func NewReplicationCancelSplitShardParams() ReplicationCancelSplitShardParams {
+
+	return ReplicationCancelSplitShardParams{}
+}
+
+// ReplicationCancelSplitShardParams contains all the bound params for the replication cancel split shard operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters replicationCancelSplitShard
+type ReplicationCancelSplitShardParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*The collection name holding the shard.
+	  Required: true
+	  In: path
+	*/
+	CollectionID string
+	/*The shard id of the shard being split.
+	  Required: true
+	  In: path
+	*/
+	ShardID string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewReplicationCancelSplitShardParams() beforehand.
+func (o *ReplicationCancelSplitShardParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rCollectionID, rhkCollectionID, _ := route.Params.GetOK("collectionId")
+	if err := o.bindCollectionID(rCollectionID, rhkCollectionID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rShardID, rhkShardID, _ := route.Params.GetOK("shardId")
+	if err := o.bindShardID(rShardID, rhkShardID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindCollectionID binds and validates parameter CollectionID from path.
+func (o *ReplicationCancelSplitShardParams) bindCollectionID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.CollectionID = raw
+
+	return nil
+}
+
+// bindShardID binds and validates parameter ShardID from path.
+func (o *ReplicationCancelSplitShardParams) bindShardID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.ShardID = raw
+
+	return nil
+}
@@ -0,0 +1,255 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationCancelSplitShardNoContentCode is the HTTP code returned for type ReplicationCancelSplitShardNoContent
+const ReplicationCancelSplitShardNoContentCode int = 204
+
+/*
+ReplicationCancelSplitShardNoContent Shard split canceled successfully
+
+swagger:response replicationCancelSplitShardNoContent
+*/
+type ReplicationCancelSplitShardNoContent struct {
+}
+
+// NewReplicationCancelSplitShardNoContent creates ReplicationCancelSplitShardNoContent with default headers values
+func NewReplicationCancelSplitShardNoContent() *ReplicationCancelSplitShardNoContent {
+
+	return &ReplicationCancelSplitShardNoContent{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationCancelSplitShardNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// ReplicationCancelSplitShardBadRequestCode is the HTTP code returned for type ReplicationCancelSplitShardBadRequest
+const ReplicationCancelSplitShardBadRequestCode int = 400
+
+/*
+ReplicationCancelSplitShardBadRequest Malformed request.
+
+swagger:response replicationCancelSplitShardBadRequest
+*/
+type ReplicationCancelSplitShardBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationCancelSplitShardBadRequest creates ReplicationCancelSplitShardBadRequest with default headers values
+func NewReplicationCancelSplitShardBadRequest() *ReplicationCancelSplitShardBadRequest {
+
+	return &ReplicationCancelSplitShardBadRequest{}
+}
+
+// WithPayload adds the payload to the replication cancel split shard bad request response
+func (o *ReplicationCancelSplitShardBadRequest) WithPayload(payload *models.ErrorResponse) *ReplicationCancelSplitShardBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication cancel split shard bad request response
+func (o *ReplicationCancelSplitShardBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationCancelSplitShardBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationCancelSplitShardUnauthorizedCode is the HTTP code returned for type ReplicationCancelSplitShardUnauthorized
+const ReplicationCancelSplitShardUnauthorizedCode int = 401
+
+/*
+ReplicationCancelSplitShardUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationCancelSplitShardUnauthorized
+*/
+type ReplicationCancelSplitShardUnauthorized struct {
+}
+
+// NewReplicationCancelSplitShardUnauthorized creates ReplicationCancelSplitShardUnauthorized with default headers values
+func NewReplicationCancelSplitShardUnauthorized() *ReplicationCancelSplitShardUnauthorized {
+
+	return &ReplicationCancelSplitShardUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationCancelSplitShardUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationCancelSplitShardForbiddenCode is the HTTP code returned for type ReplicationCancelSplitShardForbidden
+const ReplicationCancelSplitShardForbiddenCode int = 403
+
+/*
+ReplicationCancelSplitShardForbidden Forbidden
+
+swagger:response replicationCancelSplitShardForbidden
+*/
+type ReplicationCancelSplitShardForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationCancelSplitShardForbidden creates ReplicationCancelSplitShardForbidden with default headers values
+func NewReplicationCancelSplitShardForbidden() *ReplicationCancelSplitShardForbidden {
+
+	return &ReplicationCancelSplitShardForbidden{}
+}
+
+// WithPayload adds the payload to the replication cancel split shard forbidden response
+func (o *ReplicationCancelSplitShardForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationCancelSplitShardForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication cancel split shard forbidden response
+func (o *ReplicationCancelSplitShardForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationCancelSplitShardForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationCancelSplitShardUnprocessableEntityCode is the HTTP code returned for type ReplicationCancelSplitShardUnprocessableEntity
+const ReplicationCancelSplitShardUnprocessableEntityCode int = 422
+
+/*
+ReplicationCancelSplitShardUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response replicationCancelSplitShardUnprocessableEntity
+*/
+type ReplicationCancelSplitShardUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationCancelSplitShardUnprocessableEntity creates ReplicationCancelSplitShardUnprocessableEntity with default headers values
+func NewReplicationCancelSplitShardUnprocessableEntity() *ReplicationCancelSplitShardUnprocessableEntity {
+
+	return &ReplicationCancelSplitShardUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the replication cancel split shard unprocessable entity response
+func (o *ReplicationCancelSplitShardUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ReplicationCancelSplitShardUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication cancel split shard unprocessable entity response
+func (o *ReplicationCancelSplitShardUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationCancelSplitShardUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationCancelSplitShardInternalServerErrorCode is the HTTP code returned for type ReplicationCancelSplitShardInternalServerError
+const ReplicationCancelSplitShardInternalServerErrorCode int = 500
+
+/*
+ReplicationCancelSplitShardInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationCancelSplitShardInternalServerError
+*/
+type ReplicationCancelSplitShardInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationCancelSplitShardInternalServerError creates ReplicationCancelSplitShardInternalServerError with default headers values
+func NewReplicationCancelSplitShardInternalServerError() *ReplicationCancelSplitShardInternalServerError {
+
+	return &ReplicationCancelSplitShardInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication cancel split shard internal server error response
+func (o *ReplicationCancelSplitShardInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationCancelSplitShardInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication cancel split shard internal server error response
+func (o *ReplicationCancelSplitShardInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationCancelSplitShardInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+)
+
+// ReplicationCancelSplitShardURL generates an URL for the replication cancel split shard operation
+type ReplicationCancelSplitShardURL struct {
+	CollectionID string
+	ShardID      string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ReplicationCancelSplitShardURL) WithBasePath(bp string) *ReplicationCancelSplitShardURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ReplicationCancelSplitShardURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *ReplicationCancelSplitShardURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/replication/split/{collectionId}/{shardId}"
+
+	collectionID := o.CollectionID
+	if collectionID != "" {
+		_path = strings.Replace(_path, "{collectionId}", collectionID, -1)
+	} else {
+		return nil, errors.New("collectionId is required on ReplicationCancelSplitShardURL")
+	}
+
+	shardID := o.ShardID
+	if shardID != "" {
+		_path = strings.Replace(_path, "{shardId}", shardID, -1)
+	} else {
+		return nil, errors.New("shardId is required on ReplicationCancelSplitShardURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/v1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *ReplicationCancelSplitShardURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *ReplicationCancelSplitShardURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *ReplicationCancelSplitShardURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on ReplicationCancelSplitShardURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on ReplicationCancelSplitShardURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *ReplicationCancelSplitShardURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalanceCancelHandlerFunc turns a function with the right signature into a replication rebalance cancel handler
+type ReplicationRebalanceCancelHandlerFunc func(ReplicationRebalanceCancelParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReplicationRebalanceCancelHandlerFunc) Handle(params ReplicationRebalanceCancelParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ReplicationRebalanceCancelHandler interface for that can handle valid replication rebalance cancel params
+type ReplicationRebalanceCancelHandler interface {
+	Handle(ReplicationRebalanceCancelParams, *models.Principal) middleware.Responder
+}
+
+// NewReplicationRebalanceCancel creates a new http.Handler for the replication rebalance cancel operation
+func NewReplicationRebalanceCancel(ctx *middleware.Context, handler ReplicationRebalanceCancelHandler) *ReplicationRebalanceCancel {
+	return &ReplicationRebalanceCancel{Context: ctx, Handler: handler}
+}
+
+/*
+	ReplicationRebalanceCancel swagger:route DELETE /replication/rebalance replication replicationRebalanceCancel
+
+# Cancel the ongoing shard rebalance
+
+Cancels the moves of the ongoing rebalance which have not started yet. Moves which are already replicating a shard complete.
+*/
+type ReplicationRebalanceCancel struct {
+	Context *middleware.Context
+	Handler ReplicationRebalanceCancelHandler
+}
+
+func (o *ReplicationRebalanceCancel) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReplicationRebalanceCancelParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
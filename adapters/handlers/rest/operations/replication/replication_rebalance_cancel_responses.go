@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalanceCancelNoContentCode is the HTTP code returned for type ReplicationRebalanceCancelNoContent
+const ReplicationRebalanceCancelNoContentCode int = 204
+
+/*
+ReplicationRebalanceCancelNoContent Rebalance canceled successfully
+
+swagger:response replicationRebalanceCancelNoContent
+*/
+type ReplicationRebalanceCancelNoContent struct {
+}
+
+// NewReplicationRebalanceCancelNoContent creates ReplicationRebalanceCancelNoContent with default headers values
+func NewReplicationRebalanceCancelNoContent() *ReplicationRebalanceCancelNoContent {
+
+	return &ReplicationRebalanceCancelNoContent{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceCancelNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// ReplicationRebalanceCancelUnauthorizedCode is the HTTP code returned for type ReplicationRebalanceCancelUnauthorized
+const ReplicationRebalanceCancelUnauthorizedCode int = 401
+
+/*
+ReplicationRebalanceCancelUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationRebalanceCancelUnauthorized
+*/
+type ReplicationRebalanceCancelUnauthorized struct {
+}
+
+// NewReplicationRebalanceCancelUnauthorized creates ReplicationRebalanceCancelUnauthorized with default headers values
+func NewReplicationRebalanceCancelUnauthorized() *ReplicationRebalanceCancelUnauthorized {
+
+	return &ReplicationRebalanceCancelUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceCancelUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationRebalanceCancelForbiddenCode is the HTTP code returned for type ReplicationRebalanceCancelForbidden
+const ReplicationRebalanceCancelForbiddenCode int = 403
+
+/*
+ReplicationRebalanceCancelForbidden Forbidden
+
+swagger:response replicationRebalanceCancelForbidden
+*/
+type ReplicationRebalanceCancelForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceCancelForbidden creates ReplicationRebalanceCancelForbidden with default headers values
+func NewReplicationRebalanceCancelForbidden() *ReplicationRebalanceCancelForbidden {
+
+	return &ReplicationRebalanceCancelForbidden{}
+}
+
+// WithPayload adds the payload to the replication rebalance cancel forbidden response
+func (o *ReplicationRebalanceCancelForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationRebalanceCancelForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance cancel forbidden response
+func (o *ReplicationRebalanceCancelForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceCancelForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalanceCancelUnprocessableEntityCode is the HTTP code returned for type ReplicationRebalanceCancelUnprocessableEntity
+const ReplicationRebalanceCancelUnprocessableEntityCode int = 422
+
+/*
+ReplicationRebalanceCancelUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response replicationRebalanceCancelUnprocessableEntity
+*/
+type ReplicationRebalanceCancelUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceCancelUnprocessableEntity creates ReplicationRebalanceCancelUnprocessableEntity with default headers values
+func NewReplicationRebalanceCancelUnprocessableEntity() *ReplicationRebalanceCancelUnprocessableEntity {
+
+	return &ReplicationRebalanceCancelUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the replication rebalance cancel unprocessable entity response
+func (o *ReplicationRebalanceCancelUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ReplicationRebalanceCancelUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance cancel unprocessable entity response
+func (o *ReplicationRebalanceCancelUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceCancelUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalanceCancelInternalServerErrorCode is the HTTP code returned for type ReplicationRebalanceCancelInternalServerError
+const ReplicationRebalanceCancelInternalServerErrorCode int = 500
+
+/*
+ReplicationRebalanceCancelInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationRebalanceCancelInternalServerError
+*/
+type ReplicationRebalanceCancelInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceCancelInternalServerError creates ReplicationRebalanceCancelInternalServerError with default headers values
+func NewReplicationRebalanceCancelInternalServerError() *ReplicationRebalanceCancelInternalServerError {
+
+	return &ReplicationRebalanceCancelInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication rebalance cancel internal server error response
+func (o *ReplicationRebalanceCancelInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationRebalanceCancelInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance cancel internal server error response
+func (o *ReplicationRebalanceCancelInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceCancelInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
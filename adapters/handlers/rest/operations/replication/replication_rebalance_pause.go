@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalancePauseHandlerFunc turns a function with the right signature into a replication rebalance pause handler
+type ReplicationRebalancePauseHandlerFunc func(ReplicationRebalancePauseParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReplicationRebalancePauseHandlerFunc) Handle(params ReplicationRebalancePauseParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ReplicationRebalancePauseHandler interface for that can handle valid replication rebalance pause params
+type ReplicationRebalancePauseHandler interface {
+	Handle(ReplicationRebalancePauseParams, *models.Principal) middleware.Responder
+}
+
+// NewReplicationRebalancePause creates a new http.Handler for the replication rebalance pause operation
+func NewReplicationRebalancePause(ctx *middleware.Context, handler ReplicationRebalancePauseHandler) *ReplicationRebalancePause {
+	return &ReplicationRebalancePause{Context: ctx, Handler: handler}
+}
+
+/*
+	ReplicationRebalancePause swagger:route POST /replication/rebalance/pause replication replicationRebalancePause
+
+# Pause the ongoing shard rebalance
+
+Stops starting new moves of the ongoing rebalance until it is resumed. Moves which are already replicating a shard complete.
+*/
+type ReplicationRebalancePause struct {
+	Context *middleware.Context
+	Handler ReplicationRebalancePauseHandler
+}
+
+func (o *ReplicationRebalancePause) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReplicationRebalancePauseParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
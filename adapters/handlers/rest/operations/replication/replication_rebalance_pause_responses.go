@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalancePauseOKCode is the HTTP code returned for type ReplicationRebalancePauseOK
+const ReplicationRebalancePauseOKCode int = 200
+
+/*
+ReplicationRebalancePauseOK The state of the rebalance coordinated by this node
+
+swagger:response replicationRebalancePauseOK
+*/
+type ReplicationRebalancePauseOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ReplicationRebalanceStatus `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancePauseOK creates ReplicationRebalancePauseOK with default headers values
+func NewReplicationRebalancePauseOK() *ReplicationRebalancePauseOK {
+
+	return &ReplicationRebalancePauseOK{}
+}
+
+// WithPayload adds the payload to the replication rebalance pause o k response
+func (o *ReplicationRebalancePauseOK) WithPayload(payload *models.ReplicationRebalanceStatus) *ReplicationRebalancePauseOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance pause o k response
+func (o *ReplicationRebalancePauseOK) SetPayload(payload *models.ReplicationRebalanceStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancePauseOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalancePauseUnauthorizedCode is the HTTP code returned for type ReplicationRebalancePauseUnauthorized
+const ReplicationRebalancePauseUnauthorizedCode int = 401
+
+/*
+ReplicationRebalancePauseUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationRebalancePauseUnauthorized
+*/
+type ReplicationRebalancePauseUnauthorized struct {
+}
+
+// NewReplicationRebalancePauseUnauthorized creates ReplicationRebalancePauseUnauthorized with default headers values
+func NewReplicationRebalancePauseUnauthorized() *ReplicationRebalancePauseUnauthorized {
+
+	return &ReplicationRebalancePauseUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancePauseUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationRebalancePauseForbiddenCode is the HTTP code returned for type ReplicationRebalancePauseForbidden
+const ReplicationRebalancePauseForbiddenCode int = 403
+
+/*
+ReplicationRebalancePauseForbidden Forbidden
+
+swagger:response replicationRebalancePauseForbidden
+*/
+type ReplicationRebalancePauseForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancePauseForbidden creates ReplicationRebalancePauseForbidden with default headers values
+func NewReplicationRebalancePauseForbidden() *ReplicationRebalancePauseForbidden {
+
+	return &ReplicationRebalancePauseForbidden{}
+}
+
+// WithPayload adds the payload to the replication rebalance pause forbidden response
+func (o *ReplicationRebalancePauseForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationRebalancePauseForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance pause forbidden response
+func (o *ReplicationRebalancePauseForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancePauseForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalancePauseUnprocessableEntityCode is the HTTP code returned for type ReplicationRebalancePauseUnprocessableEntity
+const ReplicationRebalancePauseUnprocessableEntityCode int = 422
+
+/*
+ReplicationRebalancePauseUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response replicationRebalancePauseUnprocessableEntity
+*/
+type ReplicationRebalancePauseUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancePauseUnprocessableEntity creates ReplicationRebalancePauseUnprocessableEntity with default headers values
+func NewReplicationRebalancePauseUnprocessableEntity() *ReplicationRebalancePauseUnprocessableEntity {
+
+	return &ReplicationRebalancePauseUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the replication rebalance pause unprocessable entity response
+func (o *ReplicationRebalancePauseUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ReplicationRebalancePauseUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance pause unprocessable entity response
+func (o *ReplicationRebalancePauseUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancePauseUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalancePauseInternalServerErrorCode is the HTTP code returned for type ReplicationRebalancePauseInternalServerError
+const ReplicationRebalancePauseInternalServerErrorCode int = 500
+
+/*
+ReplicationRebalancePauseInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationRebalancePauseInternalServerError
+*/
+type ReplicationRebalancePauseInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancePauseInternalServerError creates ReplicationRebalancePauseInternalServerError with default headers values
+func NewReplicationRebalancePauseInternalServerError() *ReplicationRebalancePauseInternalServerError {
+
+	return &ReplicationRebalancePauseInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication rebalance pause internal server error response
+func (o *ReplicationRebalancePauseInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationRebalancePauseInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance pause internal server error response
+func (o *ReplicationRebalancePauseInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancePauseInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalancePlanOKCode is the HTTP code returned for type ReplicationRebalancePlanOK
+const ReplicationRebalancePlanOKCode int = 200
+
+/*
+ReplicationRebalancePlanOK The planned moves
+
+swagger:response replicationRebalancePlanOK
+*/
+type ReplicationRebalancePlanOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ReplicationRebalancePlan `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancePlanOK creates ReplicationRebalancePlanOK with default headers values
+func NewReplicationRebalancePlanOK() *ReplicationRebalancePlanOK {
+
+	return &ReplicationRebalancePlanOK{}
+}
+
+// WithPayload adds the payload to the replication rebalance plan o k response
+func (o *ReplicationRebalancePlanOK) WithPayload(payload *models.ReplicationRebalancePlan) *ReplicationRebalancePlanOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance plan o k response
+func (o *ReplicationRebalancePlanOK) SetPayload(payload *models.ReplicationRebalancePlan) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancePlanOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalancePlanUnauthorizedCode is the HTTP code returned for type ReplicationRebalancePlanUnauthorized
+const ReplicationRebalancePlanUnauthorizedCode int = 401
+
+/*
+ReplicationRebalancePlanUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationRebalancePlanUnauthorized
+*/
+type ReplicationRebalancePlanUnauthorized struct {
+}
+
+// NewReplicationRebalancePlanUnauthorized creates ReplicationRebalancePlanUnauthorized with default headers values
+func NewReplicationRebalancePlanUnauthorized() *ReplicationRebalancePlanUnauthorized {
+
+	return &ReplicationRebalancePlanUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancePlanUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationRebalancePlanForbiddenCode is the HTTP code returned for type ReplicationRebalancePlanForbidden
+const ReplicationRebalancePlanForbiddenCode int = 403
+
+/*
+ReplicationRebalancePlanForbidden Forbidden
+
+swagger:response replicationRebalancePlanForbidden
+*/
+type ReplicationRebalancePlanForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancePlanForbidden creates ReplicationRebalancePlanForbidden with default headers values
+func NewReplicationRebalancePlanForbidden() *ReplicationRebalancePlanForbidden {
+
+	return &ReplicationRebalancePlanForbidden{}
+}
+
+// WithPayload adds the payload to the replication rebalance plan forbidden response
+func (o *ReplicationRebalancePlanForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationRebalancePlanForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance plan forbidden response
+func (o *ReplicationRebalancePlanForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancePlanForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalancePlanInternalServerErrorCode is the HTTP code returned for type ReplicationRebalancePlanInternalServerError
+const ReplicationRebalancePlanInternalServerErrorCode int = 500
+
+/*
+ReplicationRebalancePlanInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationRebalancePlanInternalServerError
+*/
+type ReplicationRebalancePlanInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalancePlanInternalServerError creates ReplicationRebalancePlanInternalServerError with default headers values
+func NewReplicationRebalancePlanInternalServerError() *ReplicationRebalancePlanInternalServerError {
+
+	return &ReplicationRebalancePlanInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication rebalance plan internal server error response
+func (o *ReplicationRebalancePlanInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationRebalancePlanInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance plan internal server error response
+func (o *ReplicationRebalancePlanInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalancePlanInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
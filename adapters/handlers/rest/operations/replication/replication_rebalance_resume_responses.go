@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalanceResumeOKCode is the HTTP code returned for type ReplicationRebalanceResumeOK
+const ReplicationRebalanceResumeOKCode int = 200
+
+/*
+ReplicationRebalanceResumeOK The state of the rebalance coordinated by this node
+
+swagger:response replicationRebalanceResumeOK
+*/
+type ReplicationRebalanceResumeOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ReplicationRebalanceStatus `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceResumeOK creates ReplicationRebalanceResumeOK with default headers values
+func NewReplicationRebalanceResumeOK() *ReplicationRebalanceResumeOK {
+
+	return &ReplicationRebalanceResumeOK{}
+}
+
+// WithPayload adds the payload to the replication rebalance resume o k response
+func (o *ReplicationRebalanceResumeOK) WithPayload(payload *models.ReplicationRebalanceStatus) *ReplicationRebalanceResumeOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance resume o k response
+func (o *ReplicationRebalanceResumeOK) SetPayload(payload *models.ReplicationRebalanceStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceResumeOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalanceResumeUnauthorizedCode is the HTTP code returned for type ReplicationRebalanceResumeUnauthorized
+const ReplicationRebalanceResumeUnauthorizedCode int = 401
+
+/*
+ReplicationRebalanceResumeUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationRebalanceResumeUnauthorized
+*/
+type ReplicationRebalanceResumeUnauthorized struct {
+}
+
+// NewReplicationRebalanceResumeUnauthorized creates ReplicationRebalanceResumeUnauthorized with default headers values
+func NewReplicationRebalanceResumeUnauthorized() *ReplicationRebalanceResumeUnauthorized {
+
+	return &ReplicationRebalanceResumeUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceResumeUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationRebalanceResumeForbiddenCode is the HTTP code returned for type ReplicationRebalanceResumeForbidden
+const ReplicationRebalanceResumeForbiddenCode int = 403
+
+/*
+ReplicationRebalanceResumeForbidden Forbidden
+
+swagger:response replicationRebalanceResumeForbidden
+*/
+type ReplicationRebalanceResumeForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceResumeForbidden creates ReplicationRebalanceResumeForbidden with default headers values
+func NewReplicationRebalanceResumeForbidden() *ReplicationRebalanceResumeForbidden {
+
+	return &ReplicationRebalanceResumeForbidden{}
+}
+
+// WithPayload adds the payload to the replication rebalance resume forbidden response
+func (o *ReplicationRebalanceResumeForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationRebalanceResumeForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance resume forbidden response
+func (o *ReplicationRebalanceResumeForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceResumeForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalanceResumeUnprocessableEntityCode is the HTTP code returned for type ReplicationRebalanceResumeUnprocessableEntity
+const ReplicationRebalanceResumeUnprocessableEntityCode int = 422
+
+/*
+ReplicationRebalanceResumeUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response replicationRebalanceResumeUnprocessableEntity
+*/
+type ReplicationRebalanceResumeUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceResumeUnprocessableEntity creates ReplicationRebalanceResumeUnprocessableEntity with default headers values
+func NewReplicationRebalanceResumeUnprocessableEntity() *ReplicationRebalanceResumeUnprocessableEntity {
+
+	return &ReplicationRebalanceResumeUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the replication rebalance resume unprocessable entity response
+func (o *ReplicationRebalanceResumeUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ReplicationRebalanceResumeUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance resume unprocessable entity response
+func (o *ReplicationRebalanceResumeUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceResumeUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalanceResumeInternalServerErrorCode is the HTTP code returned for type ReplicationRebalanceResumeInternalServerError
+const ReplicationRebalanceResumeInternalServerErrorCode int = 500
+
+/*
+ReplicationRebalanceResumeInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationRebalanceResumeInternalServerError
+*/
+type ReplicationRebalanceResumeInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceResumeInternalServerError creates ReplicationRebalanceResumeInternalServerError with default headers values
+func NewReplicationRebalanceResumeInternalServerError() *ReplicationRebalanceResumeInternalServerError {
+
+	return &ReplicationRebalanceResumeInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication rebalance resume internal server error response
+func (o *ReplicationRebalanceResumeInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationRebalanceResumeInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance resume internal server error response
+func (o *ReplicationRebalanceResumeInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceResumeInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
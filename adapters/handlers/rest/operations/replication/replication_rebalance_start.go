@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalanceStartHandlerFunc turns a function with the right signature into a replication rebalance start handler
+type ReplicationRebalanceStartHandlerFunc func(ReplicationRebalanceStartParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReplicationRebalanceStartHandlerFunc) Handle(params ReplicationRebalanceStartParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ReplicationRebalanceStartHandler interface for that can handle valid replication rebalance start params
+type ReplicationRebalanceStartHandler interface {
+	Handle(ReplicationRebalanceStartParams, *models.Principal) middleware.Responder
+}
+
+// NewReplicationRebalanceStart creates a new http.Handler for the replication rebalance start operation
+func NewReplicationRebalanceStart(ctx *middleware.Context, handler ReplicationRebalanceStartHandler) *ReplicationRebalanceStart {
+	return &ReplicationRebalanceStart{Context: ctx, Handler: handler}
+}
+
+/*
+	ReplicationRebalanceStart swagger:route POST /replication/rebalance replication replicationRebalanceStart
+
+# Start rebalancing the shard replicas across the nodes
+
+Plans the moves which balance the number and the disk usage of shard replicas across the healthy nodes and carries them out in the background. A move replicates a shard to its target node and removes the replica from its source node afterwards. Only a limited number of moves run concurrently. The rebalance is coordinated by the node receiving the request and its state is kept in memory only. If that node restarts, the moves which did not start yet are dropped and the moves which are replicating complete without removing the replica from their source node.
+*/
+type ReplicationRebalanceStart struct {
+	Context *middleware.Context
+	Handler ReplicationRebalanceStartHandler
+}
+
+func (o *ReplicationRebalanceStart) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReplicationRebalanceStartParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalanceStartOKCode is the HTTP code returned for type ReplicationRebalanceStartOK
+const ReplicationRebalanceStartOKCode int = 200
+
+/*
+ReplicationRebalanceStartOK The state of the rebalance coordinated by this node
+
+swagger:response replicationRebalanceStartOK
+*/
+type ReplicationRebalanceStartOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ReplicationRebalanceStatus `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceStartOK creates ReplicationRebalanceStartOK with default headers values
+func NewReplicationRebalanceStartOK() *ReplicationRebalanceStartOK {
+
+	return &ReplicationRebalanceStartOK{}
+}
+
+// WithPayload adds the payload to the replication rebalance start o k response
+func (o *ReplicationRebalanceStartOK) WithPayload(payload *models.ReplicationRebalanceStatus) *ReplicationRebalanceStartOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance start o k response
+func (o *ReplicationRebalanceStartOK) SetPayload(payload *models.ReplicationRebalanceStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceStartOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalanceStartBadRequestCode is the HTTP code returned for type ReplicationRebalanceStartBadRequest
+const ReplicationRebalanceStartBadRequestCode int = 400
+
+/*
+ReplicationRebalanceStartBadRequest Malformed request.
+
+swagger:response replicationRebalanceStartBadRequest
+*/
+type ReplicationRebalanceStartBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceStartBadRequest creates ReplicationRebalanceStartBadRequest with default headers values
+func NewReplicationRebalanceStartBadRequest() *ReplicationRebalanceStartBadRequest {
+
+	return &ReplicationRebalanceStartBadRequest{}
+}
+
+// WithPayload adds the payload to the replication rebalance start bad request response
+func (o *ReplicationRebalanceStartBadRequest) WithPayload(payload *models.ErrorResponse) *ReplicationRebalanceStartBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance start bad request response
+func (o *ReplicationRebalanceStartBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceStartBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalanceStartUnauthorizedCode is the HTTP code returned for type ReplicationRebalanceStartUnauthorized
+const ReplicationRebalanceStartUnauthorizedCode int = 401
+
+/*
+ReplicationRebalanceStartUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationRebalanceStartUnauthorized
+*/
+type ReplicationRebalanceStartUnauthorized struct {
+}
+
+// NewReplicationRebalanceStartUnauthorized creates ReplicationRebalanceStartUnauthorized with default headers values
+func NewReplicationRebalanceStartUnauthorized() *ReplicationRebalanceStartUnauthorized {
+
+	return &ReplicationRebalanceStartUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceStartUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationRebalanceStartForbiddenCode is the HTTP code returned for type ReplicationRebalanceStartForbidden
+const ReplicationRebalanceStartForbiddenCode int = 403
+
+/*
+ReplicationRebalanceStartForbidden Forbidden
+
+swagger:response replicationRebalanceStartForbidden
+*/
+type ReplicationRebalanceStartForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceStartForbidden creates ReplicationRebalanceStartForbidden with default headers values
+func NewReplicationRebalanceStartForbidden() *ReplicationRebalanceStartForbidden {
+
+	return &ReplicationRebalanceStartForbidden{}
+}
+
+// WithPayload adds the payload to the replication rebalance start forbidden response
+func (o *ReplicationRebalanceStartForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationRebalanceStartForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance start forbidden response
+func (o *ReplicationRebalanceStartForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceStartForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalanceStartUnprocessableEntityCode is the HTTP code returned for type ReplicationRebalanceStartUnprocessableEntity
+const ReplicationRebalanceStartUnprocessableEntityCode int = 422
+
+/*
+ReplicationRebalanceStartUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response replicationRebalanceStartUnprocessableEntity
+*/
+type ReplicationRebalanceStartUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceStartUnprocessableEntity creates ReplicationRebalanceStartUnprocessableEntity with default headers values
+func NewReplicationRebalanceStartUnprocessableEntity() *ReplicationRebalanceStartUnprocessableEntity {
+
+	return &ReplicationRebalanceStartUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the replication rebalance start unprocessable entity response
+func (o *ReplicationRebalanceStartUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ReplicationRebalanceStartUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance start unprocessable entity response
+func (o *ReplicationRebalanceStartUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceStartUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalanceStartInternalServerErrorCode is the HTTP code returned for type ReplicationRebalanceStartInternalServerError
+const ReplicationRebalanceStartInternalServerErrorCode int = 500
+
+/*
+ReplicationRebalanceStartInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationRebalanceStartInternalServerError
+*/
+type ReplicationRebalanceStartInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceStartInternalServerError creates ReplicationRebalanceStartInternalServerError with default headers values
+func NewReplicationRebalanceStartInternalServerError() *ReplicationRebalanceStartInternalServerError {
+
+	return &ReplicationRebalanceStartInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication rebalance start internal server error response
+func (o *ReplicationRebalanceStartInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationRebalanceStartInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance start internal server error response
+func (o *ReplicationRebalanceStartInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceStartInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalanceStatusHandlerFunc turns a function with the right signature into a replication rebalance status handler
+type ReplicationRebalanceStatusHandlerFunc func(ReplicationRebalanceStatusParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReplicationRebalanceStatusHandlerFunc) Handle(params ReplicationRebalanceStatusParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ReplicationRebalanceStatusHandler interface for that can handle valid replication rebalance status params
+type ReplicationRebalanceStatusHandler interface {
+	Handle(ReplicationRebalanceStatusParams, *models.Principal) middleware.Responder
+}
+
+// NewReplicationRebalanceStatus creates a new http.Handler for the replication rebalance status operation
+func NewReplicationRebalanceStatus(ctx *middleware.Context, handler ReplicationRebalanceStatusHandler) *ReplicationRebalanceStatus {
+	return &ReplicationRebalanceStatus{Context: ctx, Handler: handler}
+}
+
+/*
+	ReplicationRebalanceStatus swagger:route GET /replication/rebalance replication replicationRebalanceStatus
+
+# Get the state of the shard rebalance
+
+Returns the state and the moves of the last shard rebalance coordinated by the node receiving the request.
+*/
+type ReplicationRebalanceStatus struct {
+	Context *middleware.Context
+	Handler ReplicationRebalanceStatusHandler
+}
+
+func (o *ReplicationRebalanceStatus) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReplicationRebalanceStatusParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,185 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalanceStatusOKCode is the HTTP code returned for type ReplicationRebalanceStatusOK
+const ReplicationRebalanceStatusOKCode int = 200
+
+/*
+ReplicationRebalanceStatusOK The state of the rebalance coordinated by this node
+
+swagger:response replicationRebalanceStatusOK
+*/
+type ReplicationRebalanceStatusOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ReplicationRebalanceStatus `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceStatusOK creates ReplicationRebalanceStatusOK with default headers values
+func NewReplicationRebalanceStatusOK() *ReplicationRebalanceStatusOK {
+
+	return &ReplicationRebalanceStatusOK{}
+}
+
+// WithPayload adds the payload to the replication rebalance status o k response
+func (o *ReplicationRebalanceStatusOK) WithPayload(payload *models.ReplicationRebalanceStatus) *ReplicationRebalanceStatusOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance status o k response
+func (o *ReplicationRebalanceStatusOK) SetPayload(payload *models.ReplicationRebalanceStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceStatusOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalanceStatusUnauthorizedCode is the HTTP code returned for type ReplicationRebalanceStatusUnauthorized
+const ReplicationRebalanceStatusUnauthorizedCode int = 401
+
+/*
+ReplicationRebalanceStatusUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationRebalanceStatusUnauthorized
+*/
+type ReplicationRebalanceStatusUnauthorized struct {
+}
+
+// NewReplicationRebalanceStatusUnauthorized creates ReplicationRebalanceStatusUnauthorized with default headers values
+func NewReplicationRebalanceStatusUnauthorized() *ReplicationRebalanceStatusUnauthorized {
+
+	return &ReplicationRebalanceStatusUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceStatusUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationRebalanceStatusForbiddenCode is the HTTP code returned for type ReplicationRebalanceStatusForbidden
+const ReplicationRebalanceStatusForbiddenCode int = 403
+
+/*
+ReplicationRebalanceStatusForbidden Forbidden
+
+swagger:response replicationRebalanceStatusForbidden
+*/
+type ReplicationRebalanceStatusForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceStatusForbidden creates ReplicationRebalanceStatusForbidden with default headers values
+func NewReplicationRebalanceStatusForbidden() *ReplicationRebalanceStatusForbidden {
+
+	return &ReplicationRebalanceStatusForbidden{}
+}
+
+// WithPayload adds the payload to the replication rebalance status forbidden response
+func (o *ReplicationRebalanceStatusForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationRebalanceStatusForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance status forbidden response
+func (o *ReplicationRebalanceStatusForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceStatusForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationRebalanceStatusInternalServerErrorCode is the HTTP code returned for type ReplicationRebalanceStatusInternalServerError
+const ReplicationRebalanceStatusInternalServerErrorCode int = 500
+
+/*
+ReplicationRebalanceStatusInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationRebalanceStatusInternalServerError
+*/
+type ReplicationRebalanceStatusInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationRebalanceStatusInternalServerError creates ReplicationRebalanceStatusInternalServerError with default headers values
+func NewReplicationRebalanceStatusInternalServerError() *ReplicationRebalanceStatusInternalServerError {
+
+	return &ReplicationRebalanceStatusInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication rebalance status internal server error response
+func (o *ReplicationRebalanceStatusInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationRebalanceStatusInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication rebalance status internal server error response
+func (o *ReplicationRebalanceStatusInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationRebalanceStatusInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationSplitShardHandlerFunc turns a function with the right signature into a replication split shard handler
+type ReplicationSplitShardHandlerFunc func(ReplicationSplitShardParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReplicationSplitShardHandlerFunc) Handle(params ReplicationSplitShardParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ReplicationSplitShardHandler interface for that can handle valid replication split shard params
+type ReplicationSplitShardHandler interface {
+	Handle(ReplicationSplitShardParams, *models.Principal) middleware.Responder
+}
+
+// NewReplicationSplitShard creates a new http.Handler for the replication split shard operation
+func NewReplicationSplitShard(ctx *middleware.Context, handler ReplicationSplitShardHandler) *ReplicationSplitShard {
+	return &ReplicationSplitShard{Context: ctx, Handler: handler}
+}
+
+/*
+	ReplicationSplitShard swagger:route POST /replication/split replication replicationSplitShard
+
+# Start the async operation to split a shard into new shards
+
+Reassigns the virtual shards of a shard of a single-tenant collection to new shards. Every replica of the shard copies its data into the new shards in the background. Once all replicas are done, requests are routed to the new shards and the shard is removed.
+*/
+type ReplicationSplitShard struct {
+	Context *middleware.Context
+	Handler ReplicationSplitShardHandler
+}
+
+func (o *ReplicationSplitShard) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReplicationSplitShardParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,275 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationSplitShardOKCode is the HTTP code returned for type ReplicationSplitShardOK
+const ReplicationSplitShardOKCode int = 200
+
+/*
+ReplicationSplitShardOK Shard split registered successfully
+
+swagger:response replicationSplitShardOK
+*/
+type ReplicationSplitShardOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ReplicationSplitShardResponse `json:"body,omitempty"`
+}
+
+// NewReplicationSplitShardOK creates ReplicationSplitShardOK with default headers values
+func NewReplicationSplitShardOK() *ReplicationSplitShardOK {
+
+	return &ReplicationSplitShardOK{}
+}
+
+// WithPayload adds the payload to the replication split shard o k response
+func (o *ReplicationSplitShardOK) WithPayload(payload *models.ReplicationSplitShardResponse) *ReplicationSplitShardOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication split shard o k response
+func (o *ReplicationSplitShardOK) SetPayload(payload *models.ReplicationSplitShardResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationSplitShardOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationSplitShardBadRequestCode is the HTTP code returned for type ReplicationSplitShardBadRequest
+const ReplicationSplitShardBadRequestCode int = 400
+
+/*
+ReplicationSplitShardBadRequest Malformed request.
+
+swagger:response replicationSplitShardBadRequest
+*/
+type ReplicationSplitShardBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationSplitShardBadRequest creates ReplicationSplitShardBadRequest with default headers values
+func NewReplicationSplitShardBadRequest() *ReplicationSplitShardBadRequest {
+
+	return &ReplicationSplitShardBadRequest{}
+}
+
+// WithPayload adds the payload to the replication split shard bad request response
+func (o *ReplicationSplitShardBadRequest) WithPayload(payload *models.ErrorResponse) *ReplicationSplitShardBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication split shard bad request response
+func (o *ReplicationSplitShardBadRequest) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationSplitShardBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationSplitShardUnauthorizedCode is the HTTP code returned for type ReplicationSplitShardUnauthorized
+const ReplicationSplitShardUnauthorizedCode int = 401
+
+/*
+ReplicationSplitShardUnauthorized Unauthorized or invalid credentials.
+
+swagger:response replicationSplitShardUnauthorized
+*/
+type ReplicationSplitShardUnauthorized struct {
+}
+
+// NewReplicationSplitShardUnauthorized creates ReplicationSplitShardUnauthorized with default headers values
+func NewReplicationSplitShardUnauthorized() *ReplicationSplitShardUnauthorized {
+
+	return &ReplicationSplitShardUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *ReplicationSplitShardUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// ReplicationSplitShardForbiddenCode is the HTTP code returned for type ReplicationSplitShardForbidden
+const ReplicationSplitShardForbiddenCode int = 403
+
+/*
+ReplicationSplitShardForbidden Forbidden
+
+swagger:response replicationSplitShardForbidden
+*/
+type ReplicationSplitShardForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationSplitShardForbidden creates ReplicationSplitShardForbidden with default headers values
+func NewReplicationSplitShardForbidden() *ReplicationSplitShardForbidden {
+
+	return &ReplicationSplitShardForbidden{}
+}
+
+// WithPayload adds the payload to the replication split shard forbidden response
+func (o *ReplicationSplitShardForbidden) WithPayload(payload *models.ErrorResponse) *ReplicationSplitShardForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication split shard forbidden response
+func (o *ReplicationSplitShardForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationSplitShardForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationSplitShardUnprocessableEntityCode is the HTTP code returned for type ReplicationSplitShardUnprocessableEntity
+const ReplicationSplitShardUnprocessableEntityCode int = 422
+
+/*
+ReplicationSplitShardUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+
+swagger:response replicationSplitShardUnprocessableEntity
+*/
+type ReplicationSplitShardUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationSplitShardUnprocessableEntity creates ReplicationSplitShardUnprocessableEntity with default headers values
+func NewReplicationSplitShardUnprocessableEntity() *ReplicationSplitShardUnprocessableEntity {
+
+	return &ReplicationSplitShardUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the replication split shard unprocessable entity response
+func (o *ReplicationSplitShardUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *ReplicationSplitShardUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication split shard unprocessable entity response
+func (o *ReplicationSplitShardUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationSplitShardUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ReplicationSplitShardInternalServerErrorCode is the HTTP code returned for type ReplicationSplitShardInternalServerError
+const ReplicationSplitShardInternalServerErrorCode int = 500
+
+/*
+ReplicationSplitShardInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response replicationSplitShardInternalServerError
+*/
+type ReplicationSplitShardInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewReplicationSplitShardInternalServerError creates ReplicationSplitShardInternalServerError with default headers values
+func NewReplicationSplitShardInternalServerError() *ReplicationSplitShardInternalServerError {
+
+	return &ReplicationSplitShardInternalServerError{}
+}
+
+// WithPayload adds the payload to the replication split shard internal server error response
+func (o *ReplicationSplitShardInternalServerError) WithPayload(payload *models.ErrorResponse) *ReplicationSplitShardInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the replication split shard internal server error response
+func (o *ReplicationSplitShardInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReplicationSplitShardInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
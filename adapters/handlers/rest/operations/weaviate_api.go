@@ -244,9 +244,33 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ReplicationReplicateHandler: replication.ReplicateHandlerFunc(func(params replication.ReplicateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation replication.Replicate has not yet been implemented")
 		}),
+		ReplicationReplicationCancelSplitShardHandler: replication.ReplicationCancelSplitShardHandlerFunc(func(params replication.ReplicationCancelSplitShardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationCancelSplitShard has not yet been implemented")
+		}),
 		ReplicationReplicationDetailsHandler: replication.ReplicationDetailsHandlerFunc(func(params replication.ReplicationDetailsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation replication.ReplicationDetails has not yet been implemented")
 		}),
+		ReplicationReplicationRebalanceCancelHandler: replication.ReplicationRebalanceCancelHandlerFunc(func(params replication.ReplicationRebalanceCancelParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationRebalanceCancel has not yet been implemented")
+		}),
+		ReplicationReplicationRebalancePauseHandler: replication.ReplicationRebalancePauseHandlerFunc(func(params replication.ReplicationRebalancePauseParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationRebalancePause has not yet been implemented")
+		}),
+		ReplicationReplicationRebalancePlanHandler: replication.ReplicationRebalancePlanHandlerFunc(func(params replication.ReplicationRebalancePlanParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationRebalancePlan has not yet been implemented")
+		}),
+		ReplicationReplicationRebalanceResumeHandler: replication.ReplicationRebalanceResumeHandlerFunc(func(params replication.ReplicationRebalanceResumeParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationRebalanceResume has not yet been implemented")
+		}),
+		ReplicationReplicationRebalanceStartHandler: replication.ReplicationRebalanceStartHandlerFunc(func(params replication.ReplicationRebalanceStartParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationRebalanceStart has not yet been implemented")
+		}),
+		ReplicationReplicationRebalanceStatusHandler: replication.ReplicationRebalanceStatusHandlerFunc(func(params replication.ReplicationRebalanceStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationRebalanceStatus has not yet been implemented")
+		}),
+		ReplicationReplicationSplitShardHandler: replication.ReplicationSplitShardHandlerFunc(func(params replication.ReplicationSplitShardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation replication.ReplicationSplitShard has not yet been implemented")
+		}),
 		AuthzRevokeRoleFromGroupHandler: authz.RevokeRoleFromGroupHandlerFunc(func(params authz.RevokeRoleFromGroupParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.RevokeRoleFromGroup has not yet been implemented")
 		}),
@@ -490,8 +514,24 @@ type WeaviateAPI struct {
 	AuthzRemovePermissionsHandler authz.RemovePermissionsHandler
 	// ReplicationReplicateHandler sets the operation handler for the replicate operation
 	ReplicationReplicateHandler replication.ReplicateHandler
+	// ReplicationReplicationCancelSplitShardHandler sets the operation handler for the replication cancel split shard operation
+	ReplicationReplicationCancelSplitShardHandler replication.ReplicationCancelSplitShardHandler
 	// ReplicationReplicationDetailsHandler sets the operation handler for the replication details operation
 	ReplicationReplicationDetailsHandler replication.ReplicationDetailsHandler
+	// ReplicationReplicationRebalanceCancelHandler sets the operation handler for the replication rebalance cancel operation
+	ReplicationReplicationRebalanceCancelHandler replication.ReplicationRebalanceCancelHandler
+	// ReplicationReplicationRebalancePauseHandler sets the operation handler for the replication rebalance pause operation
+	ReplicationReplicationRebalancePauseHandler replication.ReplicationRebalancePauseHandler
+	// ReplicationReplicationRebalancePlanHandler sets the operation handler for the replication rebalance plan operation
+	ReplicationReplicationRebalancePlanHandler replication.ReplicationRebalancePlanHandler
+	// ReplicationReplicationRebalanceResumeHandler sets the operation handler for the replication rebalance resume operation
+	ReplicationReplicationRebalanceResumeHandler replication.ReplicationRebalanceResumeHandler
+	// ReplicationReplicationRebalanceStartHandler sets the operation handler for the replication rebalance start operation
+	ReplicationReplicationRebalanceStartHandler replication.ReplicationRebalanceStartHandler
+	// ReplicationReplicationRebalanceStatusHandler sets the operation handler for the replication rebalance status operation
+	ReplicationReplicationRebalanceStatusHandler replication.ReplicationRebalanceStatusHandler
+	// ReplicationReplicationSplitShardHandler sets the operation handler for the replication split shard operation
+	ReplicationReplicationSplitShardHandler replication.ReplicationSplitShardHandler
 	// AuthzRevokeRoleFromGroupHandler sets the operation handler for the revoke role from group operation
 	AuthzRevokeRoleFromGroupHandler authz.RevokeRoleFromGroupHandler
 	// AuthzRevokeRoleFromUserHandler sets the operation handler for the revoke role from user operation
@@ -790,9 +830,33 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ReplicationReplicateHandler == nil {
 		unregistered = append(unregistered, "replication.ReplicateHandler")
 	}
+	if o.ReplicationReplicationCancelSplitShardHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationCancelSplitShardHandler")
+	}
 	if o.ReplicationReplicationDetailsHandler == nil {
 		unregistered = append(unregistered, "replication.ReplicationDetailsHandler")
 	}
+	if o.ReplicationReplicationRebalanceCancelHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationRebalanceCancelHandler")
+	}
+	if o.ReplicationReplicationRebalancePauseHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationRebalancePauseHandler")
+	}
+	if o.ReplicationReplicationRebalancePlanHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationRebalancePlanHandler")
+	}
+	if o.ReplicationReplicationRebalanceResumeHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationRebalanceResumeHandler")
+	}
+	if o.ReplicationReplicationRebalanceStartHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationRebalanceStartHandler")
+	}
+	if o.ReplicationReplicationRebalanceStatusHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationRebalanceStatusHandler")
+	}
+	if o.ReplicationReplicationSplitShardHandler == nil {
+		unregistered = append(unregistered, "replication.ReplicationSplitShardHandler")
+	}
 	if o.AuthzRevokeRoleFromGroupHandler == nil {
 		unregistered = append(unregistered, "authz.RevokeRoleFromGroupHandler")
 	}
@@ -1185,10 +1249,42 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/replication/replicate"] = replication.NewReplicate(o.context, o.ReplicationReplicateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/replication/split/{collectionId}/{shardId}"] = replication.NewReplicationCancelSplitShard(o.context, o.ReplicationReplicationCancelSplitShardHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/replication/replicate/{id}"] = replication.NewReplicationDetails(o.context, o.ReplicationReplicationDetailsHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/replication/rebalance"] = replication.NewReplicationRebalanceCancel(o.context, o.ReplicationReplicationRebalanceCancelHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/replication/rebalance/pause"] = replication.NewReplicationRebalancePause(o.context, o.ReplicationReplicationRebalancePauseHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/replication/rebalance/plan"] = replication.NewReplicationRebalancePlan(o.context, o.ReplicationReplicationRebalancePlanHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/replication/rebalance/resume"] = replication.NewReplicationRebalanceResume(o.context, o.ReplicationReplicationRebalanceResumeHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/replication/rebalance"] = replication.NewReplicationRebalanceStart(o.context, o.ReplicationReplicationRebalanceStartHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/replication/rebalance"] = replication.NewReplicationRebalanceStatus(o.context, o.ReplicationReplicationRebalanceStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/replication/split"] = replication.NewReplicationSplitShard(o.context, o.ReplicationReplicationSplitShardHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	cerrors "github.com/weaviate/weaviate/adapters/handlers/rest/errors"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
+	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+func (h *replicationHandler) planRebalance(params replication.ReplicationRebalancePlanParams, principal *models.Principal) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, authorization.READ, authorization.ShardsMetadata("")...); err != nil {
+		return replication.NewReplicationRebalancePlanForbidden().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	plan, err := h.rebalancer.Plan(params.HTTPRequest.Context())
+	if err != nil {
+		return replication.NewReplicationRebalancePlanInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	return replication.NewReplicationRebalancePlanOK().WithPayload(&models.ReplicationRebalancePlan{
+		Moves:   rebalanceMovesToModel(plan.Moves),
+		Current: nodeLoadsToModel(plan.Current),
+		Planned: nodeLoadsToModel(plan.Planned),
+	})
+}
+
+func (h *replicationHandler) startRebalance(params replication.ReplicationRebalanceStartParams, principal *models.Principal) middleware.Responder {
+	var maxConcurrentMoves int
+	if params.Body != nil {
+		if err := params.Body.Validate(nil /* pass nil as we don't validate formatting here*/); err != nil {
+			return replication.NewReplicationRebalanceStartBadRequest().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+		}
+		maxConcurrentMoves = int(params.Body.MaxConcurrentMoves)
+	}
+
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.ShardsMetadata("")...); err != nil {
+		return replication.NewReplicationRebalanceStartForbidden().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	status, err := h.rebalancer.Rebalance(params.HTTPRequest.Context(), maxConcurrentMoves)
+	if err != nil {
+		if errors.Is(err, replicationTypes.ErrInvalidRequest) {
+			return replication.NewReplicationRebalanceStartUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+		}
+		return replication.NewReplicationRebalanceStartInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	h.logger.WithFields(logrus.Fields{
+		"action": "replication_rebalancer",
+		"op":     "start",
+		"moves":  len(status.Moves),
+	}).Info("rebalance started")
+
+	return replication.NewReplicationRebalanceStartOK().WithPayload(rebalanceStatusToModel(status))
+}
+
+func (h *replicationHandler) getRebalanceStatus(params replication.ReplicationRebalanceStatusParams, principal *models.Principal) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, authorization.READ, authorization.ShardsMetadata("")...); err != nil {
+		return replication.NewReplicationRebalanceStatusForbidden().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	return replication.NewReplicationRebalanceStatusOK().WithPayload(rebalanceStatusToModel(h.rebalancer.Status()))
+}
+
+func (h *replicationHandler) pauseRebalance(params replication.ReplicationRebalancePauseParams, principal *models.Principal) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.ShardsMetadata("")...); err != nil {
+		return replication.NewReplicationRebalancePauseForbidden().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	status, err := h.rebalancer.Pause()
+	if err != nil {
+		if errors.Is(err, replicationTypes.ErrInvalidRequest) {
+			return replication.NewReplicationRebalancePauseUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+		}
+		return replication.NewReplicationRebalancePauseInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	return replication.NewReplicationRebalancePauseOK().WithPayload(rebalanceStatusToModel(status))
+}
+
+func (h *replicationHandler) resumeRebalance(params replication.ReplicationRebalanceResumeParams, principal *models.Principal) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.ShardsMetadata("")...); err != nil {
+		return replication.NewReplicationRebalanceResumeForbidden().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	status, err := h.rebalancer.Resume()
+	if err != nil {
+		if errors.Is(err, replicationTypes.ErrInvalidRequest) {
+			return replication.NewReplicationRebalanceResumeUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+		}
+		return replication.NewReplicationRebalanceResumeInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	return replication.NewReplicationRebalanceResumeOK().WithPayload(rebalanceStatusToModel(status))
+}
+
+func (h *replicationHandler) cancelRebalance(params replication.ReplicationRebalanceCancelParams, principal *models.Principal) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, authorization.UPDATE, authorization.ShardsMetadata("")...); err != nil {
+		return replication.NewReplicationRebalanceCancelForbidden().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	if err := h.rebalancer.Cancel(); err != nil {
+		if errors.Is(err, replicationTypes.ErrInvalidRequest) {
+			return replication.NewReplicationRebalanceCancelUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+		}
+		return replication.NewReplicationRebalanceCancelInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	h.logger.WithFields(logrus.Fields{
+		"action": "replication_rebalancer",
+		"op":     "cancel",
+	}).Info("rebalance canceled")
+
+	return replication.NewReplicationRebalanceCancelNoContent()
+}
+
+func rebalanceStatusToModel(status replicationTypes.RebalanceStatus) *models.ReplicationRebalanceStatus {
+	moves := make([]*models.ReplicationRebalanceMove, len(status.Moves))
+	for i, move := range status.Moves {
+		moves[i] = rebalanceMoveToModel(move.RebalanceMove)
+		moves[i].Status = string(move.State)
+		moves[i].Error = move.Error
+	}
+	out := &models.ReplicationRebalanceStatus{
+		Status:    string(status.State),
+		Automatic: status.Automatic,
+		Moves:     moves,
+	}
+	if !status.StartedAt.IsZero() {
+		out.StartedAt = strfmt.DateTime(status.StartedAt)
+	}
+	return out
+}
+
+func rebalanceMovesToModel(moves []replicationTypes.RebalanceMove) []*models.ReplicationRebalanceMove {
+	out := make([]*models.ReplicationRebalanceMove, len(moves))
+	for i, move := range moves {
+		out[i] = rebalanceMoveToModel(move)
+	}
+	return out
+}
+
+func rebalanceMoveToModel(move replicationTypes.RebalanceMove) *models.ReplicationRebalanceMove {
+	return &models.ReplicationRebalanceMove{
+		CollectionID: move.Collection,
+		ShardID:      move.Shard,
+		SourceNodeID: move.SourceNode,
+		TargetNodeID: move.TargetNode,
+		DiskBytes:    move.DiskBytes,
+	}
+}
+
+func nodeLoadsToModel(loads []replicationTypes.NodeLoad) []*models.ReplicationRebalanceNodeLoad {
+	out := make([]*models.ReplicationRebalanceNodeLoad, len(loads))
+	for i, load := range loads {
+		out[i] = &models.ReplicationRebalanceNodeLoad{
+			NodeID:     load.Node,
+			ShardCount: load.Shards,
+			DiskBytes:  load.DiskBytes,
+		}
+	}
+	return out
+}
@@ -0,0 +1,208 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
+	"github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/models"
+	replicationMocks "github.com/weaviate/weaviate/mocks/cluster/replication/types"
+	authorizationMocks "github.com/weaviate/weaviate/mocks/usecases/auth/authorization"
+)
+
+func createRebalanceHandlerWithMocks(t *testing.T) (*replicationHandler, *authorizationMocks.Authorizer, *replicationMocks.Rebalancer) {
+	t.Helper()
+	handler, mockAuthorizer, _ := createReplicationHandlerWithMocks(t, createNullLogger(t))
+	mockRebalancer := replicationMocks.NewRebalancer(t)
+	handler.rebalancer = mockRebalancer
+	return handler, mockAuthorizer, mockRebalancer
+}
+
+func TestReplicationRebalancePlan(t *testing.T) {
+	params := replication.ReplicationRebalancePlanParams{HTTPRequest: &http.Request{}}
+
+	t.Run("successful plan", func(t *testing.T) {
+		handler, mockAuthorizer, mockRebalancer := createRebalanceHandlerWithMocks(t)
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRebalancer.EXPECT().Plan(mock.Anything).Return(types.RebalancePlan{
+			Moves: []types.RebalanceMove{
+				{Collection: "Collection", Shard: "shard", SourceNode: "node1", TargetNode: "node2", DiskBytes: 42},
+			},
+			Current: []types.NodeLoad{{Node: "node1", Shards: 2, DiskBytes: 84}, {Node: "node2"}},
+			Planned: []types.NodeLoad{{Node: "node1", Shards: 1, DiskBytes: 42}, {Node: "node2", Shards: 1, DiskBytes: 42}},
+		}, nil)
+
+		response := handler.planRebalance(params, &models.Principal{})
+
+		ok, isOK := response.(*replication.ReplicationRebalancePlanOK)
+		require.True(t, isOK)
+		require.Len(t, ok.Payload.Moves, 1)
+		assert.Equal(t, "node2", ok.Payload.Moves[0].TargetNodeID)
+		assert.Equal(t, int64(42), ok.Payload.Moves[0].DiskBytes)
+		require.Len(t, ok.Payload.Planned, 2)
+		assert.Equal(t, int64(1), ok.Payload.Planned[1].ShardCount)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		handler, mockAuthorizer, _ := createRebalanceHandlerWithMocks(t)
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("forbidden"))
+
+		response := handler.planRebalance(params, &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationRebalancePlanForbidden{}, response)
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		handler, mockAuthorizer, mockRebalancer := createRebalanceHandlerWithMocks(t)
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRebalancer.EXPECT().Plan(mock.Anything).Return(types.RebalancePlan{}, errors.New("boom"))
+
+		response := handler.planRebalance(params, &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationRebalancePlanInternalServerError{}, response)
+	})
+}
+
+func TestReplicationRebalanceStart(t *testing.T) {
+	startParams := func(body *models.ReplicationRebalanceRequest) replication.ReplicationRebalanceStartParams {
+		return replication.ReplicationRebalanceStartParams{HTTPRequest: &http.Request{}, Body: body}
+	}
+
+	t.Run("successful start without body", func(t *testing.T) {
+		handler, mockAuthorizer, mockRebalancer := createRebalanceHandlerWithMocks(t)
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRebalancer.EXPECT().Rebalance(mock.Anything, 0).Return(types.RebalanceStatus{State: types.RebalanceStateRunning}, nil)
+
+		response := handler.startRebalance(startParams(nil), &models.Principal{})
+
+		ok, isOK := response.(*replication.ReplicationRebalanceStartOK)
+		require.True(t, isOK)
+		assert.Equal(t, string(types.RebalanceStateRunning), ok.Payload.Status)
+	})
+
+	t.Run("successful start with concurrency", func(t *testing.T) {
+		handler, mockAuthorizer, mockRebalancer := createRebalanceHandlerWithMocks(t)
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRebalancer.EXPECT().Rebalance(mock.Anything, 4).Return(types.RebalanceStatus{State: types.RebalanceStateRunning}, nil)
+
+		response := handler.startRebalance(startParams(&models.ReplicationRebalanceRequest{MaxConcurrentMoves: 4}), &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationRebalanceStartOK{}, response)
+	})
+
+	t.Run("invalid concurrency", func(t *testing.T) {
+		handler, _, _ := createRebalanceHandlerWithMocks(t)
+
+		response := handler.startRebalance(startParams(&models.ReplicationRebalanceRequest{MaxConcurrentMoves: -1}), &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationRebalanceStartBadRequest{}, response)
+	})
+
+	t.Run("already running", func(t *testing.T) {
+		handler, mockAuthorizer, mockRebalancer := createRebalanceHandlerWithMocks(t)
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRebalancer.EXPECT().Rebalance(mock.Anything, 0).Return(types.RebalanceStatus{}, fmt.Errorf("%w: rebalance already running", types.ErrInvalidRequest))
+
+		response := handler.startRebalance(startParams(nil), &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationRebalanceStartUnprocessableEntity{}, response)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		handler, mockAuthorizer, _ := createRebalanceHandlerWithMocks(t)
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("forbidden"))
+
+		response := handler.startRebalance(startParams(nil), &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationRebalanceStartForbidden{}, response)
+	})
+}
+
+func TestReplicationRebalanceControl(t *testing.T) {
+	t.Run("status", func(t *testing.T) {
+		handler, mockAuthorizer, mockRebalancer := createRebalanceHandlerWithMocks(t)
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRebalancer.EXPECT().Status().Return(types.RebalanceStatus{
+			State: types.RebalanceStateRunning,
+			Moves: []types.RebalanceMoveStatus{
+				{RebalanceMove: types.RebalanceMove{Collection: "Collection", Shard: "shard"}, State: types.RebalanceMoveFailed, Error: "boom"},
+			},
+		})
+
+		response := handler.getRebalanceStatus(replication.ReplicationRebalanceStatusParams{HTTPRequest: &http.Request{}}, &models.Principal{})
+
+		ok, isOK := response.(*replication.ReplicationRebalanceStatusOK)
+		require.True(t, isOK)
+		require.Len(t, ok.Payload.Moves, 1)
+		assert.Equal(t, string(types.RebalanceMoveFailed), ok.Payload.Moves[0].Status)
+		assert.Equal(t, "boom", ok.Payload.Moves[0].Error)
+	})
+
+	t.Run("pause", func(t *testing.T) {
+		handler, mockAuthorizer, mockRebalancer := createRebalanceHandlerWithMocks(t)
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRebalancer.EXPECT().Pause().Return(types.RebalanceStatus{State: types.RebalanceStatePaused}, nil)
+
+		response := handler.pauseRebalance(replication.ReplicationRebalancePauseParams{HTTPRequest: &http.Request{}}, &models.Principal{})
+
+		ok, isOK := response.(*replication.ReplicationRebalancePauseOK)
+		require.True(t, isOK)
+		assert.Equal(t, string(types.RebalanceStatePaused), ok.Payload.Status)
+	})
+
+	t.Run("pause when not running", func(t *testing.T) {
+		handler, mockAuthorizer, mockRebalancer := createRebalanceHandlerWithMocks(t)
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRebalancer.EXPECT().Pause().Return(types.RebalanceStatus{}, fmt.Errorf("%w: no running rebalance", types.ErrInvalidRequest))
+
+		response := handler.pauseRebalance(replication.ReplicationRebalancePauseParams{HTTPRequest: &http.Request{}}, &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationRebalancePauseUnprocessableEntity{}, response)
+	})
+
+	t.Run("resume", func(t *testing.T) {
+		handler, mockAuthorizer, mockRebalancer := createRebalanceHandlerWithMocks(t)
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRebalancer.EXPECT().Resume().Return(types.RebalanceStatus{State: types.RebalanceStateRunning}, nil)
+
+		response := handler.resumeRebalance(replication.ReplicationRebalanceResumeParams{HTTPRequest: &http.Request{}}, &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationRebalanceResumeOK{}, response)
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		handler, mockAuthorizer, mockRebalancer := createRebalanceHandlerWithMocks(t)
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockRebalancer.EXPECT().Cancel().Return(nil)
+
+		response := handler.cancelRebalance(replication.ReplicationRebalanceCancelParams{HTTPRequest: &http.Request{}}, &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationRebalanceCancelNoContent{}, response)
+	})
+
+	t.Run("cancel forbidden", func(t *testing.T) {
+		handler, mockAuthorizer, _ := createRebalanceHandlerWithMocks(t)
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("forbidden"))
+
+		response := handler.cancelRebalance(replication.ReplicationRebalanceCancelParams{HTTPRequest: &http.Request{}}, &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationRebalanceCancelForbidden{}, response)
+	})
+}
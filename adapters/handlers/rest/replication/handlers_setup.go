@@ -23,19 +23,29 @@ import (
 type replicationHandler struct {
 	authorizer         authorization.Authorizer
 	replicationManager replicationTypes.Manager
+	rebalancer         replicationTypes.Rebalancer
 
 	logger  logrus.FieldLogger
 	metrics *monitoring.PrometheusMetrics
 }
 
-func SetupHandlers(api *operations.WeaviateAPI, replicationManager replicationTypes.Manager, metrics *monitoring.PrometheusMetrics, authorizer authorization.Authorizer, logger logrus.FieldLogger,
+func SetupHandlers(api *operations.WeaviateAPI, replicationManager replicationTypes.Manager, rebalancer replicationTypes.Rebalancer, metrics *monitoring.PrometheusMetrics, authorizer authorization.Authorizer, logger logrus.FieldLogger,
 ) {
 	h := &replicationHandler{
 		authorizer:         authorizer,
 		replicationManager: replicationManager,
+		rebalancer:         rebalancer,
 		logger:             logger,
 		metrics:            metrics,
 	}
 	api.ReplicationReplicateHandler = replication.ReplicateHandlerFunc(h.replicate)
 	api.ReplicationReplicationDetailsHandler = replication.ReplicationDetailsHandlerFunc(h.getReplicationDetailsByReplicationId)
+	api.ReplicationReplicationSplitShardHandler = replication.ReplicationSplitShardHandlerFunc(h.splitShard)
+	api.ReplicationReplicationCancelSplitShardHandler = replication.ReplicationCancelSplitShardHandlerFunc(h.cancelSplitShard)
+	api.ReplicationReplicationRebalancePlanHandler = replication.ReplicationRebalancePlanHandlerFunc(h.planRebalance)
+	api.ReplicationReplicationRebalanceStartHandler = replication.ReplicationRebalanceStartHandlerFunc(h.startRebalance)
+	api.ReplicationReplicationRebalanceStatusHandler = replication.ReplicationRebalanceStatusHandlerFunc(h.getRebalanceStatus)
+	api.ReplicationReplicationRebalancePauseHandler = replication.ReplicationRebalancePauseHandlerFunc(h.pauseRebalance)
+	api.ReplicationReplicationRebalanceResumeHandler = replication.ReplicationRebalanceResumeHandlerFunc(h.resumeRebalance)
+	api.ReplicationReplicationRebalanceCancelHandler = replication.ReplicationRebalanceCancelHandlerFunc(h.cancelRebalance)
 }
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"errors"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	cerrors "github.com/weaviate/weaviate/adapters/handlers/rest/errors"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
+	replicationTypes "github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+func (h *replicationHandler) splitShard(params replication.ReplicationSplitShardParams, principal *models.Principal) middleware.Responder {
+	if err := params.Body.Validate(nil /* pass nil as we don't validate formatting here*/); err != nil {
+		return replication.NewReplicationSplitShardBadRequest().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	collection, shard := *params.Body.CollectionID, *params.Body.ShardID
+	if err := h.authorizer.Authorize(principal, authorization.CREATE, authorization.ShardsMetadata(collection, shard)...); err != nil {
+		return replication.NewReplicationSplitShardForbidden().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	parts := 2
+	if params.Body.Parts != nil {
+		parts = int(*params.Body.Parts)
+	}
+
+	targets, err := h.replicationManager.ReplicationSplitShard(collection, shard, parts)
+	if err != nil {
+		if errors.Is(err, replicationTypes.ErrInvalidRequest) {
+			return replication.NewReplicationSplitShardUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+		}
+		return replication.NewReplicationSplitShardInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	h.logger.WithFields(logrus.Fields{
+		"action":     "shard_split_engine",
+		"op":         "split",
+		"collection": collection,
+		"shardId":    shard,
+		"targets":    targets,
+	}).Info("shard split registered")
+
+	return replication.NewReplicationSplitShardOK().WithPayload(&models.ReplicationSplitShardResponse{
+		CollectionID:   collection,
+		ShardID:        shard,
+		TargetShardIds: targets,
+	})
+}
+
+func (h *replicationHandler) cancelSplitShard(params replication.ReplicationCancelSplitShardParams, principal *models.Principal) middleware.Responder {
+	if err := h.authorizer.Authorize(principal, authorization.DELETE, authorization.ShardsMetadata(params.CollectionID, params.ShardID)...); err != nil {
+		return replication.NewReplicationCancelSplitShardForbidden().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	if err := h.replicationManager.ReplicationCancelSplitShard(params.CollectionID, params.ShardID); err != nil {
+		if errors.Is(err, replicationTypes.ErrInvalidRequest) {
+			return replication.NewReplicationCancelSplitShardUnprocessableEntity().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+		}
+		return replication.NewReplicationCancelSplitShardInternalServerError().WithPayload(cerrors.ErrPayloadFromSingleErr(err))
+	}
+
+	h.logger.WithFields(logrus.Fields{
+		"action":     "shard_split_engine",
+		"op":         "cancel_split",
+		"collection": params.CollectionID,
+		"shardId":    params.ShardID,
+	}).Info("shard split canceled")
+
+	return replication.NewReplicationCancelSplitShardNoContent()
+}
@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/replication"
+	"github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestReplicationSplitShard(t *testing.T) {
+	collection := "Collection"
+	shard := "shard"
+	splitParams := func(parts *int64) replication.ReplicationSplitShardParams {
+		return replication.ReplicationSplitShardParams{
+			HTTPRequest: &http.Request{},
+			Body: &models.ReplicationSplitShardRequest{
+				CollectionID: &collection,
+				ShardID:      &shard,
+				Parts:        parts,
+			},
+		}
+	}
+
+	t.Run("successful split with default parts", func(t *testing.T) {
+		handler, mockAuthorizer, mockReplicationManager := createReplicationHandlerWithMocks(t, createNullLogger(t))
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockReplicationManager.On("ReplicationSplitShard", collection, shard, 2).Return([]string{"a", "b"}, nil)
+
+		response := handler.splitShard(splitParams(nil), &models.Principal{})
+
+		ok, isOK := response.(*replication.ReplicationSplitShardOK)
+		assert.True(t, isOK)
+		assert.Equal(t, []string{"a", "b"}, ok.Payload.TargetShardIds)
+		mockReplicationManager.AssertExpectations(t)
+	})
+
+	t.Run("successful split into more parts", func(t *testing.T) {
+		handler, mockAuthorizer, mockReplicationManager := createReplicationHandlerWithMocks(t, createNullLogger(t))
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockReplicationManager.On("ReplicationSplitShard", collection, shard, 3).Return([]string{"a", "b", "c"}, nil)
+
+		parts := int64(3)
+		response := handler.splitShard(splitParams(&parts), &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationSplitShardOK{}, response)
+		mockReplicationManager.AssertExpectations(t)
+	})
+
+	t.Run("missing shard", func(t *testing.T) {
+		handler, _, mockReplicationManager := createReplicationHandlerWithMocks(t, createNullLogger(t))
+		params := splitParams(nil)
+		params.Body.ShardID = nil
+
+		response := handler.splitShard(params, &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationSplitShardBadRequest{}, response)
+		mockReplicationManager.AssertNotCalled(t, "ReplicationSplitShard", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		handler, mockAuthorizer, mockReplicationManager := createReplicationHandlerWithMocks(t, createNullLogger(t))
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("forbidden"))
+
+		response := handler.splitShard(splitParams(nil), &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationSplitShardForbidden{}, response)
+		mockReplicationManager.AssertNotCalled(t, "ReplicationSplitShard", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("invalid split", func(t *testing.T) {
+		handler, mockAuthorizer, mockReplicationManager := createReplicationHandlerWithMocks(t, createNullLogger(t))
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockReplicationManager.On("ReplicationSplitShard", collection, shard, 2).
+			Return(nil, fmt.Errorf("%w: shard is already being split", types.ErrInvalidRequest))
+
+		response := handler.splitShard(splitParams(nil), &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationSplitShardUnprocessableEntity{}, response)
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		handler, mockAuthorizer, mockReplicationManager := createReplicationHandlerWithMocks(t, createNullLogger(t))
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockReplicationManager.On("ReplicationSplitShard", collection, shard, 2).Return(nil, errors.New("no leader"))
+
+		response := handler.splitShard(splitParams(nil), &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationSplitShardInternalServerError{}, response)
+	})
+}
+
+func TestReplicationCancelSplitShard(t *testing.T) {
+	params := replication.ReplicationCancelSplitShardParams{
+		HTTPRequest:  &http.Request{},
+		CollectionID: "Collection",
+		ShardID:      "shard",
+	}
+
+	t.Run("successful cancel", func(t *testing.T) {
+		handler, mockAuthorizer, mockReplicationManager := createReplicationHandlerWithMocks(t, createNullLogger(t))
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockReplicationManager.On("ReplicationCancelSplitShard", "Collection", "shard").Return(nil)
+
+		response := handler.cancelSplitShard(params, &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationCancelSplitShardNoContent{}, response)
+		mockReplicationManager.AssertExpectations(t)
+	})
+
+	t.Run("not being split", func(t *testing.T) {
+		handler, mockAuthorizer, mockReplicationManager := createReplicationHandlerWithMocks(t, createNullLogger(t))
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		mockReplicationManager.On("ReplicationCancelSplitShard", "Collection", "shard").
+			Return(fmt.Errorf("%w: shard is not being split", types.ErrInvalidRequest))
+
+		response := handler.cancelSplitShard(params, &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationCancelSplitShardUnprocessableEntity{}, response)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		handler, mockAuthorizer, mockReplicationManager := createReplicationHandlerWithMocks(t, createNullLogger(t))
+		mockAuthorizer.On("Authorize", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("forbidden"))
+
+		response := handler.cancelSplitShard(params, &models.Principal{})
+
+		assert.IsType(t, &replication.ReplicationCancelSplitShardForbidden{}, response)
+		mockReplicationManager.AssertNotCalled(t, "ReplicationCancelSplitShard", mock.Anything, mock.Anything)
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/encryption"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
 	"github.com/pkg/errors"
@@ -155,6 +156,41 @@ func (db *DB) ReleaseBackup(ctx context.Context, bakID, class string) (err error
 	return nil
 }
 
+// ValidateEncryptionKeys checks that every key the files in desc are
+// encrypted with can be resolved, either by the configured key provider or
+// as a key of the tenant the shard belongs to.
+func (db *DB) ValidateEncryptionKeys(ctx context.Context, desc *backup.BackupDescriptor) error {
+	for _, class := range desc.Classes {
+		for _, shard := range class.Shards {
+			for _, id := range shard.EncryptionKeyIDs {
+				if err := db.validateEncryptionKey(ctx, class.Name, shard.Name, id); err != nil {
+					return fmt.Errorf("class %q shard %q: encryption key %q: %w",
+						class.Name, shard.Name, id, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (db *DB) validateEncryptionKey(ctx context.Context, class, shard, id string) error {
+	if db.config.Encryption == nil && db.config.TenantKeys == nil {
+		return fmt.Errorf("no key provider is configured")
+	}
+	if db.config.Encryption != nil {
+		_, err := db.config.Encryption.Key(id)
+		if err == nil || !errors.Is(err, encryption.ErrKeyNotFound) || db.config.TenantKeys == nil {
+			return err
+		}
+	}
+	keys, err := db.config.TenantKeys.TenantKeys(ctx, class, shard)
+	if err != nil {
+		return err
+	}
+	_, err = keys.Key(id)
+	return err
+}
+
 func (db *DB) ClassExists(name string) bool {
 	return db.IndexExists(schema.ClassName(name))
 }
@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestBM25FFuzzy(t *testing.T) {
+	for _, blockMax := range []bool{false, true} {
+		t.Run(fmt.Sprintf("blockmax %v", blockMax), func(t *testing.T) {
+			defaultUsingBlockMaxWAND := config.DefaultUsingBlockMaxWAND
+			config.DefaultUsingBlockMaxWAND = blockMax
+			defer func() { config.DefaultUsingBlockMaxWAND = defaultUsingBlockMaxWAND }()
+
+			repo, schemaGetter, logger := newPhraseTestRepo(t)
+			defer repo.Shutdown(context.Background())
+
+			props := SetupPhraseClass(t, repo, schemaGetter, logger, false)
+			idx := repo.GetIndex("PhraseClass")
+			require.NotNil(t, idx)
+
+			search := func(t *testing.T, query string, maxEdits, maxExpansions int) []string {
+				kwr := &searchparams.KeywordRanking{
+					Type: "bm25", Properties: []string{"title"}, Query: query,
+					MaxEdits: maxEdits, MaxExpansions: maxExpansions,
+				}
+				res, scores, err := idx.objectSearch(context.Background(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+				require.Nil(t, err)
+				titles := make([]string, len(res))
+				for i := range res {
+					titles[i] = res[i].Object.Properties.(map[string]interface{})["title"].(string)
+					assert.Greater(t, scores[i], float32(0))
+				}
+				return titles
+			}
+
+			for _, location := range []string{"memory", "disk"} {
+				t.Run("exact match only without edits "+location, func(t *testing.T) {
+					assert.Empty(t, search(t, "beginers", 0, 0))
+				})
+
+				t.Run("single edit "+location, func(t *testing.T) {
+					assert.Equal(t, []string{"machine learning for beginners"}, search(t, "beginers", 1, 0))
+					assert.Len(t, search(t, "machin", 1, 0), 4)
+				})
+
+				t.Run("two edits "+location, func(t *testing.T) {
+					assert.Empty(t, search(t, "lernin beginrs", 1, 0))
+					assert.ElementsMatch(t, []string{
+						"machine learning for beginners",
+						"learning about the machine",
+						"machine assisted deep learning",
+						"the state of the art in machine learning, machine learning everywhere",
+					}, search(t, "lernin beginrs", 2, 0))
+				})
+
+				t.Run("expansion cap "+location, func(t *testing.T) {
+					// "drp" is two edits away from both "deep" and "art", "art" is
+					// kept as it is more frequent
+					assert.ElementsMatch(t, []string{
+						"machine assisted deep learning",
+						"the state of the art in machine learning, machine learning everywhere",
+						"state of the art",
+					}, search(t, "drp", 2, 0))
+					assert.ElementsMatch(t, []string{
+						"the state of the art in machine learning, machine learning everywhere",
+						"state of the art",
+					}, search(t, "drp", 2, 1))
+				})
+
+				t.Run("no expansion to stopwords "+location, func(t *testing.T) {
+					assert.Empty(t, search(t, "thw", 1, 0))
+				})
+
+				for _, index := range repo.indices {
+					index.ForEachShard(func(name string, shard ShardLike) error {
+						require.Nil(t, shard.Store().FlushMemtables(context.Background()))
+						return nil
+					})
+				}
+			}
+
+			t.Run("deleted objects", func(t *testing.T) {
+				id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", 0)).String())
+				require.Nil(t, repo.DeleteObject(context.Background(), "PhraseClass", id, time.Now(), nil, "", 0))
+				assert.Empty(t, search(t, "beginers", 1, 0))
+			})
+		})
+	}
+}
+
+func TestBM25FFuzzyValidation(t *testing.T) {
+	repo, schemaGetter, logger := newPhraseTestRepo(t)
+	defer repo.Shutdown(context.Background())
+
+	props := SetupPhraseClass(t, repo, schemaGetter, logger, true)
+	idx := repo.GetIndex("PhraseClass")
+	require.NotNil(t, idx)
+
+	for _, kwr := range []*searchparams.KeywordRanking{
+		{Type: "bm25", Properties: []string{"title"}, Query: "machine", MaxEdits: 3},
+		{Type: "bm25", Properties: []string{"title"}, Query: "machine", MaxExpansions: 10},
+		{Type: "bm25", Properties: []string{"title"}, Query: "machine learning", MaxEdits: 1, Phrase: true},
+	} {
+		_, _, err := idx.objectSearch(context.Background(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+		assert.NotNil(t, err)
+	}
+}
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestBM25FSearchOperator(t *testing.T) {
+	for _, blockMax := range []bool{false, true} {
+		t.Run(fmt.Sprintf("blockmax %v", blockMax), func(t *testing.T) {
+			defaultUsingBlockMaxWAND := config.DefaultUsingBlockMaxWAND
+			config.DefaultUsingBlockMaxWAND = blockMax
+			defer func() { config.DefaultUsingBlockMaxWAND = defaultUsingBlockMaxWAND }()
+
+			repo, schemaGetter, logger := newPhraseTestRepo(t)
+			defer repo.Shutdown(context.Background())
+
+			props := SetupPhraseClass(t, repo, schemaGetter, logger, false)
+			idx := repo.GetIndex("PhraseClass")
+			require.NotNil(t, idx)
+
+			search := func(t *testing.T, kwr *searchparams.KeywordRanking) []string {
+				kwr.Type = "bm25"
+				kwr.Properties = []string{"title"}
+				res, scores, err := idx.objectSearch(context.Background(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+				require.Nil(t, err)
+				titles := make([]string, len(res))
+				for i := range res {
+					titles[i] = res[i].Object.Properties.(map[string]interface{})["title"].(string)
+					assert.Greater(t, scores[i], float32(0))
+				}
+				return titles
+			}
+
+			for _, location := range []string{"memory", "disk"} {
+				t.Run("or "+location, func(t *testing.T) {
+					assert.Len(t, search(t, &searchparams.KeywordRanking{Query: "machine deep art"}), 5)
+					assert.Len(t, search(t, &searchparams.KeywordRanking{
+						Query: "machine deep art", SearchOperator: searchparams.SearchOperatorOr,
+					}), 5)
+				})
+
+				t.Run("and "+location, func(t *testing.T) {
+					assert.Equal(t, []string{"machine learning for beginners"}, search(t, &searchparams.KeywordRanking{
+						Query: "machine beginners", SearchOperator: searchparams.SearchOperatorAnd,
+					}))
+					assert.Empty(t, search(t, &searchparams.KeywordRanking{
+						Query: "machine deep art", SearchOperator: searchparams.SearchOperatorAnd,
+					}))
+				})
+
+				t.Run("and ignores stopwords "+location, func(t *testing.T) {
+					assert.Equal(t, []string{"machine learning for beginners"}, search(t, &searchparams.KeywordRanking{
+						Query: "the machine beginners", SearchOperator: searchparams.SearchOperatorAnd,
+					}))
+				})
+
+				t.Run("minimum should match "+location, func(t *testing.T) {
+					expected := []string{
+						"machine assisted deep learning",
+						"the state of the art in machine learning, machine learning everywhere",
+					}
+					for _, minimum := range []string{"2", "67%", "-1", "-34%"} {
+						assert.ElementsMatch(t, expected, search(t, &searchparams.KeywordRanking{
+							Query: "machine deep art", SearchOperator: searchparams.SearchOperatorOr, MinimumShouldMatch: minimum,
+						}), minimum)
+					}
+				})
+
+				t.Run("and with fuzzy terms "+location, func(t *testing.T) {
+					assert.Equal(t, []string{"machine learning for beginners"}, search(t, &searchparams.KeywordRanking{
+						Query: "machin beginers", SearchOperator: searchparams.SearchOperatorAnd, MaxEdits: 1,
+					}))
+				})
+
+				for _, index := range repo.indices {
+					index.ForEachShard(func(name string, shard ShardLike) error {
+						require.Nil(t, shard.Store().FlushMemtables(context.Background()))
+						return nil
+					})
+				}
+			}
+
+			t.Run("deleted objects", func(t *testing.T) {
+				id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", 0)).String())
+				require.Nil(t, repo.DeleteObject(context.Background(), "PhraseClass", id, time.Now(), nil, "", 0))
+				assert.Empty(t, search(t, &searchparams.KeywordRanking{
+					Query: "machine beginners", SearchOperator: searchparams.SearchOperatorAnd,
+				}))
+			})
+		})
+	}
+}
+
+func TestBM25FSearchOperatorValidation(t *testing.T) {
+	repo, schemaGetter, logger := newPhraseTestRepo(t)
+	defer repo.Shutdown(context.Background())
+
+	props := SetupPhraseClass(t, repo, schemaGetter, logger, true)
+	idx := repo.GetIndex("PhraseClass")
+	require.NotNil(t, idx)
+
+	for _, kwr := range []*searchparams.KeywordRanking{
+		{Type: "bm25", Properties: []string{"title"}, Query: "machine", SearchOperator: "Xor"},
+		{Type: "bm25", Properties: []string{"title"}, Query: "machine", SearchOperator: searchparams.SearchOperatorAnd, MinimumShouldMatch: "1"},
+		{Type: "bm25", Properties: []string{"title"}, Query: "machine", MinimumShouldMatch: "many"},
+		{Type: "bm25", Properties: []string{"title"}, Query: "machine learning", SearchOperator: searchparams.SearchOperatorAnd, Phrase: true},
+	} {
+		_, _, err := idx.objectSearch(context.Background(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+		assert.NotNil(t, err)
+	}
+}
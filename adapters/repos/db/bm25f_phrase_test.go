@@ -0,0 +1,237 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func SetupPhraseClass(t require.TestingT, repo *DB, schemaGetter *fakeSchemaGetter, logger logrus.FieldLogger,
+	indexTermPositions bool,
+) []string {
+	vTrue := true
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "en")
+	invertedConfig.IndexTermPositions = indexTermPositions
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               "PhraseClass",
+		Properties: []*models.Property{
+			{
+				Name:            "title",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexSearchable: &vTrue,
+			},
+			{
+				Name:            "tags",
+				DataType:        schema.DataTypeTextArray.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexSearchable: &vTrue,
+			},
+		},
+	}
+	props := make([]string, len(class.Properties))
+	for i, prop := range class.Properties {
+		props[i] = prop.Name
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	migrator := NewMigrator(repo, logger)
+	migrator.AddClass(context.Background(), class, schemaGetter.shardState)
+
+	testData := []map[string]interface{}{
+		{"title": "machine learning for beginners"},
+		{"title": "learning about the machine"},
+		{"title": "machine assisted deep learning", "tags": []string{"machine", "learning"}},
+		{"title": "the state of the art in machine learning, machine learning everywhere"},
+		{"title": "state of the art", "tags": []string{"deep learning"}},
+	}
+	for i, data := range testData {
+		putPhraseObject(t, repo, i, data)
+	}
+	return props
+}
+
+func putPhraseObject(t require.TestingT, repo *DB, i int, data map[string]interface{}) {
+	id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+	obj := &models.Object{Class: "PhraseClass", ID: id, Properties: data, CreationTimeUnix: 1565612833955, LastUpdateTimeUnix: 10000020}
+	err := repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil, nil, nil, 0)
+	require.Nil(t, err)
+}
+
+func TestBM25FPhrase(t *testing.T) {
+	// term positions are stored in the inverted segments, which are searched
+	// with block-max WAND unless it is disabled
+	for _, useBlockMaxWAND := range []string{"true", "false"} {
+		t.Run(fmt.Sprintf("USE_BLOCKMAX_WAND %v", useBlockMaxWAND), func(t *testing.T) {
+			t.Setenv("USE_BLOCKMAX_WAND", useBlockMaxWAND)
+
+			defaultUsingBlockMaxWAND := config.DefaultUsingBlockMaxWAND
+			config.DefaultUsingBlockMaxWAND = true
+			defer func() { config.DefaultUsingBlockMaxWAND = defaultUsingBlockMaxWAND }()
+
+			repo, schemaGetter, logger := newPhraseTestRepo(t)
+			defer repo.Shutdown(context.Background())
+
+			props := SetupPhraseClass(t, repo, schemaGetter, logger, true)
+			idx := repo.GetIndex("PhraseClass")
+			require.NotNil(t, idx)
+
+			search := func(t *testing.T, query string, slop int, properties ...string) []string {
+				kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: properties, Query: query, Phrase: true, Slop: slop}
+				res, scores, err := idx.objectSearch(context.Background(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+				require.Nil(t, err)
+				titles := make([]string, len(res))
+				for i := range res {
+					titles[i] = res[i].Object.Properties.(map[string]interface{})["title"].(string)
+					assert.Greater(t, scores[i], float32(0))
+				}
+				return titles
+			}
+
+			for _, location := range []string{"memory", "disk"} {
+				t.Run("exact phrase "+location, func(t *testing.T) {
+					titles := search(t, "machine learning", 0, "title")
+					assert.ElementsMatch(t, []string{
+						"machine learning for beginners",
+						"the state of the art in machine learning, machine learning everywhere",
+					}, titles)
+				})
+
+				t.Run("phrase with slop "+location, func(t *testing.T) {
+					titles := search(t, "machine learning", 2, "title")
+					assert.ElementsMatch(t, []string{
+						"machine learning for beginners",
+						"machine assisted deep learning",
+						"the state of the art in machine learning, machine learning everywhere",
+					}, titles)
+				})
+
+				t.Run("phrase with stopwords "+location, func(t *testing.T) {
+					titles := search(t, "state of the art", 0, "title")
+					assert.ElementsMatch(t, []string{
+						"the state of the art in machine learning, machine learning everywhere",
+						"state of the art",
+					}, titles)
+				})
+
+				t.Run("phrase does not span text array elements "+location, func(t *testing.T) {
+					assert.Empty(t, search(t, "machine learning", 0, "tags"))
+					assert.Equal(t, []string{"state of the art"}, search(t, "deep learning", 0, "tags"))
+				})
+
+				t.Run("phrase in multiple properties "+location, func(t *testing.T) {
+					titles := search(t, "deep learning", 1, "title", "tags")
+					assert.ElementsMatch(t, []string{
+						"machine assisted deep learning",
+						"state of the art",
+					}, titles)
+				})
+
+				for _, index := range repo.indices {
+					index.ForEachShard(func(name string, shard ShardLike) error {
+						require.Nil(t, shard.Store().FlushMemtables(context.Background()))
+						return nil
+					})
+				}
+			}
+
+			t.Run("updated objects", func(t *testing.T) {
+				putPhraseObject(t, repo, 0, map[string]interface{}{"title": "learning for machine beginners"})
+				putPhraseObject(t, repo, 1, map[string]interface{}{"title": "machine learning about the machine"})
+
+				titles := search(t, "machine learning", 0, "title")
+				assert.ElementsMatch(t, []string{
+					"the state of the art in machine learning, machine learning everywhere",
+					"machine learning about the machine",
+				}, titles)
+			})
+		})
+	}
+}
+
+func TestBM25FPhraseRequiresTermPositions(t *testing.T) {
+	repo, schemaGetter, logger := newPhraseTestRepo(t)
+	defer repo.Shutdown(context.Background())
+
+	props := SetupPhraseClass(t, repo, schemaGetter, logger, false)
+	idx := repo.GetIndex("PhraseClass")
+	require.NotNil(t, idx)
+
+	kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"title"}, Query: "machine learning", Phrase: true}
+	_, _, err := idx.objectSearch(context.Background(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "indexTermPositions")
+
+	kwr = &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"title"}, Query: "machine learning", Slop: 1}
+	_, _, err = idx.objectSearch(context.Background(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+	require.NotNil(t, err)
+}
+
+func TestBM25FPhraseRequiresInvertedIndex(t *testing.T) {
+	defaultUsingBlockMaxWAND := config.DefaultUsingBlockMaxWAND
+	config.DefaultUsingBlockMaxWAND = false
+	defer func() { config.DefaultUsingBlockMaxWAND = defaultUsingBlockMaxWAND }()
+
+	repo, schemaGetter, logger := newPhraseTestRepo(t)
+	defer repo.Shutdown(context.Background())
+
+	props := SetupPhraseClass(t, repo, schemaGetter, logger, true)
+	idx := repo.GetIndex("PhraseClass")
+	require.NotNil(t, idx)
+
+	kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"title"}, Query: "machine learning", Phrase: true}
+	_, _, err := idx.objectSearch(context.Background(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+	require.NotNil(t, err)
+	assert.Contains(t, err.Error(), "usingBlockMaxWAND")
+}
+
+func newPhraseTestRepo(t *testing.T) (*DB, *fakeSchemaGetter, logrus.FieldLogger) {
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.Background()))
+	return repo, schemaGetter, logger
+}
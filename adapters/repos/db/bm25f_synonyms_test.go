@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func SetupSynonymClass(t require.TestingT, repo *DB, schemaGetter *fakeSchemaGetter, logger logrus.FieldLogger,
+	synonyms *models.SynonymConfig,
+) *models.Class {
+	vTrue := true
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "en")
+	invertedConfig.Synonyms = synonyms
+
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               "SynonymClass",
+		Properties: []*models.Property{
+			{
+				Name:            "title",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexSearchable: &vTrue,
+			},
+			{
+				Name:            "code",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationField,
+				IndexSearchable: &vTrue,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{class},
+		},
+	}
+
+	migrator := NewMigrator(repo, logger)
+	migrator.AddClass(context.Background(), class, schemaGetter.shardState)
+
+	testData := []map[string]interface{}{
+		{"title": "TV remote", "code": "tv"},
+		{"title": "television stand", "code": "television"},
+		{"title": "telly addict"},
+		{"title": "radio alarm"},
+	}
+	for i, data := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: data, CreationTimeUnix: 1565612833955, LastUpdateTimeUnix: 10000020}
+		err := repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}, nil, nil, nil, 0)
+		require.Nil(t, err)
+	}
+	return class
+}
+
+func TestBM25FSynonyms(t *testing.T) {
+	for _, blockMax := range []bool{false, true} {
+		t.Run(fmt.Sprintf("blockmax %v", blockMax), func(t *testing.T) {
+			defaultUsingBlockMaxWAND := config.DefaultUsingBlockMaxWAND
+			config.DefaultUsingBlockMaxWAND = blockMax
+			defer func() { config.DefaultUsingBlockMaxWAND = defaultUsingBlockMaxWAND }()
+
+			repo, schemaGetter, logger := newPhraseTestRepo(t)
+			defer repo.Shutdown(context.Background())
+
+			class := SetupSynonymClass(t, repo, schemaGetter, logger, &models.SynonymConfig{
+				Sets: []*models.SynonymSet{
+					{Terms: []string{"TV", "television"}},
+					{Terms: []string{"tv", "telly"}, Weight: 0.5},
+				},
+			})
+			idx := repo.GetIndex("SynonymClass")
+			require.NotNil(t, idx)
+
+			search := func(t *testing.T, query string, properties ...string) []string {
+				kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: properties, Query: query}
+				res, _, err := idx.objectSearch(context.Background(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, []string{"title", "code"})
+				require.Nil(t, err)
+				titles := make([]string, len(res))
+				for i := range res {
+					titles[i] = res[i].Object.Properties.(map[string]interface{})["title"].(string)
+				}
+				return titles
+			}
+
+			t.Run("query terms match their synonyms", func(t *testing.T) {
+				titles := search(t, "tv", "title")
+				require.Len(t, titles, 3)
+				assert.ElementsMatch(t, []string{"TV remote", "television stand"}, titles[:2])
+				// the weight of "telly" lowers its score
+				assert.Equal(t, "telly addict", titles[2])
+
+				assert.Equal(t, []string{"television stand"}, search(t, "television", "title")[:1])
+				assert.Equal(t, []string{"radio alarm"}, search(t, "radio", "title"))
+			})
+
+			t.Run("synonyms do not apply to field tokenization", func(t *testing.T) {
+				assert.Equal(t, []string{"TV remote"}, search(t, "tv", "code"))
+			})
+
+			t.Run("updated synonyms", func(t *testing.T) {
+				class.InvertedIndexConfig.Synonyms = &models.SynonymConfig{
+					Sets: []*models.SynonymSet{{Terms: []string{"radio", "telly"}}},
+				}
+
+				assert.Equal(t, []string{"TV remote"}, search(t, "tv", "title"))
+				assert.ElementsMatch(t, []string{"radio alarm", "telly addict"}, search(t, "radio", "title"))
+			})
+		})
+	}
+}
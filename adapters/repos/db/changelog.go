@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/changelog"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// ReadChangeLog returns up to limit changes of the local replica of a shard
+// which were recorded after the given sequence number. For multi-tenant
+// collections shardName is the tenant. It may be left empty for collections
+// which consist of a single shard.
+func (db *DB) ReadChangeLog(ctx context.Context, className, shardName string,
+	after uint64, limit int,
+) (*changelog.Page, error) {
+	if !db.config.ChangeDataCaptureEnabled {
+		return nil, fmt.Errorf("change data capture is not enabled")
+	}
+
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, fmt.Errorf("collection %q not found", className)
+	}
+
+	shardName, err := idx.changeLogShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+
+	shard, release, err := idx.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return nil, fmt.Errorf("shard %q: %w", shardName, err)
+	}
+	defer release()
+
+	records, appended, err := shard.readChangeLog(after, limit)
+	if err != nil {
+		return nil, fmt.Errorf("shard %q: %w", shardName, err)
+	}
+
+	return &changelog.Page{
+		Node:     db.schemaGetter.NodeName(),
+		Shard:    shardName,
+		Records:  records,
+		Appended: appended,
+	}, nil
+}
+
+// changeLogShard resolves and validates the shard the change log is to be
+// read from. Change logs are local to a replica, therefore only shards
+// hosted on this node can be read.
+func (i *Index) changeLogShard(ctx context.Context, shardName string) (string, error) {
+	if i.partitioningEnabled {
+		if err := i.validateMultiTenancy(shardName); err != nil {
+			return "", err
+		}
+		status, err := i.getSchema.TenantsShards(ctx, i.Config.ClassName.String(), shardName)
+		if err != nil {
+			return "", err
+		}
+		switch status[shardName] {
+		case "":
+			return "", fmt.Errorf("%w: %q", enterrors.ErrTenantNotFound, shardName)
+		case models.TenantActivityStatusHOT:
+		default:
+			return "", fmt.Errorf("%w: %q", enterrors.ErrTenantNotActive, shardName)
+		}
+	}
+
+	shardState := i.shardState()
+	if shardName == "" {
+		shards := shardState.AllPhysicalShards()
+		if len(shards) != 1 {
+			return "", fmt.Errorf("collection %q has %d shards, shard must be specified",
+				i.Config.ClassName, len(shards))
+		}
+		shardName = shards[0]
+	}
+
+	if _, ok := shardState.Physical[shardName]; !ok {
+		return "", fmt.Errorf("shard %q not found", shardName)
+	}
+	if !shardState.IsLocalShard(shardName) {
+		return "", fmt.Errorf("shard %q is not hosted on node %q",
+			shardName, i.getSchema.NodeName())
+	}
+
+	return shardName, nil
+}
@@ -293,6 +293,10 @@ func (f *fakeRemoteNodeClient) GetStatistics(ctx context.Context, hostName strin
 	return &models.Statistics{}, nil
 }
 
+func (f *fakeRemoteNodeClient) GetTenantUsage(ctx context.Context, hostName, className string, tenants []string) (map[string]*models.TenantUsage, error) {
+	return map[string]*models.TenantUsage{}, nil
+}
+
 type fakeReplicationClient struct{}
 
 var _ replica.Client = (*fakeReplicationClient)(nil)
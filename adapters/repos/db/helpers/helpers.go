@@ -23,6 +23,7 @@ var (
 	VectorsCompressedBucketLSM = "vectors_compressed"
 	VectorsBucketLSM           = "vectors"
 	DimensionsBucketLSM        = "dimensions"
+	ChangeLogBucketLSM         = "changelog"
 )
 
 const ObjectsBucketLSMDocIDSecondaryIndex int = 0
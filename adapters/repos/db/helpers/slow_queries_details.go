@@ -13,9 +13,13 @@ package helpers
 
 import (
 	"context"
+	"fmt"
 	"maps"
 	"sync"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type SlowQueryDetails struct {
@@ -35,6 +39,12 @@ func InitSlowQueryDetails(ctx context.Context) context.Context {
 }
 
 func AnnotateSlowQueryLog(ctx context.Context, key string, value any) {
+	// the details are recorded on the span of the query as well, no matter
+	// if the query turns out to be slow
+	if span := trace.SpanFromContext(ctx); span.IsRecording() {
+		span.SetAttributes(spanAttribute(key, value))
+	}
+
 	val := ctx.Value("slow_query_details")
 	if val == nil {
 		return
@@ -55,6 +65,27 @@ func AnnotateSlowQueryLog(ctx context.Context, key string, value any) {
 	}
 }
 
+func spanAttribute(key string, value any) attribute.KeyValue {
+	switch v := value.(type) {
+	case string:
+		return attribute.String(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case int64:
+		return attribute.Int64(key, v)
+	case uint64:
+		return attribute.Int64(key, int64(v))
+	case float64:
+		return attribute.Float64(key, v)
+	case time.Duration:
+		return attribute.String(key, v.String())
+	default:
+		return attribute.String(key, fmt.Sprint(v))
+	}
+}
+
 func AnnotateSlowQueryLogAppend(ctx context.Context, key string, value any) {
 	val := ctx.Value("slow_query_details")
 	if val == nil {
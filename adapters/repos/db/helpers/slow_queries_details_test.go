@@ -16,9 +16,13 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 func TestSlowQueryDetailsJourney(t *testing.T) {
@@ -44,3 +48,31 @@ func TestSlowQueryDetailsJourney(t *testing.T) {
 		assert.Equal(t, value, details[key])
 	}
 }
+
+type recordingSpan struct {
+	noop.Span
+	attributes []attribute.KeyValue
+}
+
+func (s *recordingSpan) IsRecording() bool { return true }
+
+func (s *recordingSpan) SetAttributes(kv ...attribute.KeyValue) {
+	s.attributes = append(s.attributes, kv...)
+}
+
+func TestSlowQueryDetailsSpan(t *testing.T) {
+	span := &recordingSpan{}
+	ctx := trace.ContextWithSpan(context.Background(), span)
+
+	// annotated on the span even if slow query details are not initialized
+	AnnotateSlowQueryLog(ctx, "filters_ids_matched", 12)
+	AnnotateSlowQueryLog(ctx, "took", 1500*time.Millisecond)
+	AnnotateSlowQueryLog(ctx, "ids", []uint64{1, 2})
+
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.Int("filters_ids_matched", 12),
+		attribute.String("took", "1.5s"),
+		attribute.String("ids", "[1 2]"),
+	}, span.attributes)
+	assert.Nil(t, ExtractSlowQueryDetails(ctx))
+}
@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package helpers
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Token is a term together with the part of the input it was produced from,
+// Start and End are rune offsets into the input
+type Token struct {
+	Term  string
+	Start int
+	End   int
+}
+
+// TokenizeWithOffsets produces the same terms as Tokenize, but also returns
+// where in the input each of them was found. Trigrams overlap and cannot be
+// mapped back to the words they were built from, so trigram tokenization is
+// not supported and returns no tokens.
+func TokenizeWithOffsets(tokenization string, in string) []Token {
+	switch tokenization {
+	case models.PropertyTokenizationWord:
+		return splitWithOffsets(in, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		}, true)
+	case models.PropertyTokenizationLowercase:
+		return splitWithOffsets(in, unicode.IsSpace, true)
+	case models.PropertyTokenizationWhitespace:
+		return splitWithOffsets(in, unicode.IsSpace, false)
+	case models.PropertyTokenizationField:
+		return fieldWithOffsets(in)
+	case models.PropertyTokenizationGse, models.PropertyTokenizationGseCh,
+		models.PropertyTokenizationKagomeKr, models.PropertyTokenizationKagomeJa:
+		return locateTokens(in, Tokenize(tokenization, in))
+	default:
+		return []Token{}
+	}
+}
+
+func splitWithOffsets(in string, isSeparator func(rune) bool, lower bool) []Token {
+	runes := []rune(in)
+	tokens := []Token{}
+	start := -1
+	for i := 0; i <= len(runes); i++ {
+		if i < len(runes) && !isSeparator(runes[i]) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			term := string(runes[start:i])
+			if lower {
+				term = strings.ToLower(term)
+			}
+			tokens = append(tokens, Token{Term: term, Start: start, End: i})
+			start = -1
+		}
+	}
+	return tokens
+}
+
+func fieldWithOffsets(in string) []Token {
+	runes := []rune(in)
+	start, end := 0, len(runes)
+	for start < end && unicode.IsSpace(runes[start]) {
+		start++
+	}
+	for end > start && unicode.IsSpace(runes[end-1]) {
+		end--
+	}
+	return []Token{{Term: string(runes[start:end]), Start: start, End: end}}
+}
+
+// locateTokens finds the terms of the dictionary based tokenizers in the
+// input. Those may return overlapping terms, so the search for a term starts
+// at the beginning of the previous one. Terms are compared case-insensitively
+// as some of the tokenizers lowercase them.
+func locateTokens(in string, terms []string) []Token {
+	runes := []rune(in)
+	lowered := []rune(strings.ToLower(in))
+	if len(lowered) != len(runes) {
+		lowered = runes
+	}
+
+	tokens := make([]Token, 0, len(terms))
+	from := 0
+	for _, term := range terms {
+		termRunes := []rune(term)
+		pos := indexRunes(runes, termRunes, from)
+		if pos < 0 {
+			pos = indexRunes(lowered, []rune(strings.ToLower(term)), from)
+		}
+		if pos < 0 {
+			continue
+		}
+		tokens = append(tokens, Token{Term: term, Start: pos, End: pos + len(termRunes)})
+		from = pos
+	}
+	return tokens
+}
+
+func indexRunes(haystack, needle []rune, from int) int {
+	if len(needle) == 0 {
+		return -1
+	}
+outer:
+	for i := from; i+len(needle) <= len(haystack); i++ {
+		for j := range needle {
+			if haystack[i+j] != needle[j] {
+				continue outer
+			}
+		}
+		return i
+	}
+	return -1
+}
@@ -12,9 +12,11 @@
 package helpers
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -265,3 +267,60 @@ func TestTokenizeAndCountDuplicates(t *testing.T) {
 		})
 	}
 }
+
+func TestTokenizeWithOffsets(t *testing.T) {
+	input := " Hello Wörld*-beautiful_world?!"
+
+	t.Run("terms match Tokenize", func(t *testing.T) {
+		for _, tokenization := range []string{
+			models.PropertyTokenizationField,
+			models.PropertyTokenizationWhitespace,
+			models.PropertyTokenizationLowercase,
+			models.PropertyTokenizationWord,
+		} {
+			tokens := TokenizeWithOffsets(tokenization, input)
+			terms := make([]string, len(tokens))
+			for i, token := range tokens {
+				terms[i] = token.Term
+			}
+			assert.Equal(t, Tokenize(tokenization, input), terms, tokenization)
+		}
+	})
+
+	t.Run("offsets are in runes", func(t *testing.T) {
+		tokens := TokenizeWithOffsets(models.PropertyTokenizationWord, input)
+		assert.Equal(t, []Token{
+			{Term: "hello", Start: 1, End: 6},
+			{Term: "wörld", Start: 7, End: 12},
+			{Term: "beautiful", Start: 14, End: 23},
+			{Term: "world", Start: 24, End: 29},
+		}, tokens)
+
+		runes := []rune(input)
+		for _, token := range TokenizeWithOffsets(models.PropertyTokenizationWhitespace, input) {
+			assert.Equal(t, token.Term, string(runes[token.Start:token.End]))
+		}
+	})
+
+	t.Run("field", func(t *testing.T) {
+		tokens := TokenizeWithOffsets(models.PropertyTokenizationField, input)
+		assert.Equal(t, []Token{{Term: "Hello Wörld*-beautiful_world?!", Start: 1, End: 31}}, tokens)
+	})
+
+	t.Run("trigram is not supported", func(t *testing.T) {
+		assert.Empty(t, TokenizeWithOffsets(models.PropertyTokenizationTrigram, input))
+	})
+
+	t.Run("dictionary based tokenizers", func(t *testing.T) {
+		t.Setenv("ENABLE_TOKENIZER_KAGOME_JA", "true")
+		_ = initializeKagomeTokenizerJa()
+
+		in := "The quick 東京 fox"
+		runes := []rune(in)
+		tokens := TokenizeWithOffsets(models.PropertyTokenizationKagomeJa, in)
+		require.NotEmpty(t, tokens)
+		for _, token := range tokens {
+			assert.True(t, strings.EqualFold(token.Term, string(runes[token.Start:token.End])))
+		}
+	})
+}
@@ -44,9 +44,11 @@ import (
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/autocut"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/logarchive"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/multi"
@@ -69,6 +71,9 @@ import (
 	"github.com/weaviate/weaviate/usecases/replica"
 	schemaUC "github.com/weaviate/weaviate/usecases/schema"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -205,6 +210,7 @@ type Index struct {
 
 	shardTransferMutex shardTransfer
 	lastBackup         atomic.Pointer[BackupState]
+	shardSplits        shardSplits
 
 	// canceled when either Shutdown or Drop called
 	closingCtx    context.Context
@@ -318,6 +324,9 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 	index.cycleCallbacks.compactionCycle.Start()
 	index.cycleCallbacks.compactionAuxCycle.Start()
 	index.cycleCallbacks.flushCycle.Start()
+	index.cycleCallbacks.objectTTLCycle.Start()
+	index.cycleCallbacks.changeLogCycle.Start()
+	index.cycleCallbacks.usageCycle.Start()
 
 	return index, nil
 }
@@ -669,8 +678,16 @@ type IndexConfig struct {
 	DisableLazyLoadShards               bool
 	ForceFullReplicasSearch             bool
 	LSMEnableSegmentsChecksumValidation bool
+	LSMObjectsBlockCompression          string
 	TrackVectorDimensions               bool
+	ChangeDataCaptureEnabled            bool
+	ChangeDataCaptureRetention          time.Duration
 	ShardLoadLimiter                    ShardLoadLimiter
+	ObjectTTLMetrics                    *ObjectTTLMetrics
+	Encryption                          encryption.KeyProvider
+	TenantKeys                          modulecapabilities.KeyProvider
+	CreateMissingTenantKeys             bool
+	LogArchiver                         logarchive.Archiver
 }
 
 func indexID(class schema.ClassName) string {
@@ -1347,11 +1364,20 @@ func (i *Index) IncomingExists(ctx context.Context, shardName string,
 	return shard.Exists(ctx, id)
 }
 
+// startSearchSpan starts the span of a search across the shards of the index
+func (i *Index) startSearchSpan(ctx context.Context, name, tenant string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, name, attribute.String("class", i.Config.ClassName.String()),
+		attribute.String("tenant", tenant))
+}
+
 func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	addlProps additional.Properties, replProps *additional.ReplicationProperties, tenant string, autoCut int,
 	properties []string,
-) ([]*storobj.Object, []float32, error) {
+) (_ []*storobj.Object, _ []float32, err error) {
+	ctx, span := i.startSearchSpan(ctx, "index.ObjectSearch", tenant)
+	defer func() { tracing.End(span, err) }()
+
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, nil, err
 	}
@@ -1722,7 +1748,10 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors []models.V
 	targetVectors []string, dist float32, limit int, localFilters *filters.LocalFilter, sort []filters.Sort,
 	groupBy *searchparams.GroupBy, additionalProps additional.Properties,
 	replProps *additional.ReplicationProperties, tenant string, targetCombination *dto.TargetCombination, properties []string,
-) ([]*storobj.Object, []float32, error) {
+) (_ []*storobj.Object, _ []float32, err error) {
+	ctx, span := i.startSearchSpan(ctx, "index.ObjectVectorSearch", tenant)
+	defer func() { tracing.End(span, err) }()
+
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, nil, err
 	}
@@ -2314,6 +2343,20 @@ func (i *Index) dropShards(names []string) error {
 	return ec.ToError()
 }
 
+// dropLocalShards drops the named shards and removes their files, including
+// the files of shards which are not loaded
+func (i *Index) dropLocalShards(names []string) error {
+	if err := i.dropShards(names); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := os.RemoveAll(shardPath(i.path(), name)); err != nil {
+			return fmt.Errorf("remove shard %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
 func (i *Index) dropCloudShards(ctx context.Context, cloud modulecapabilities.OffloadCloud, names []string, nodeId string) error {
 	i.shardTransferMutex.RLock()
 	defer i.shardTransferMutex.RUnlock()
@@ -2404,6 +2447,15 @@ func (i *Index) stopCycleManagers(ctx context.Context, usecase string) error {
 	if err := i.cycleCallbacks.geoPropsTombstoneCleanupCycle.StopAndWait(ctx); err != nil {
 		return fmt.Errorf("%s: stop geo props tombstone cleanup cycle: %w", usecase, err)
 	}
+	if err := i.cycleCallbacks.objectTTLCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("%s: stop object ttl cycle: %w", usecase, err)
+	}
+	if err := i.cycleCallbacks.changeLogCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("%s: stop change log cycle: %w", usecase, err)
+	}
+	if err := i.cycleCallbacks.usageCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("%s: stop usage cycle: %w", usecase, err)
+	}
 	return nil
 }
 
@@ -38,6 +38,15 @@ type indexCycleCallbacks struct {
 	geoPropsCommitLoggerCycle         cyclemanager.CycleManager
 	geoPropsTombstoneCleanupCallbacks cyclemanager.CycleCallbackGroup
 	geoPropsTombstoneCleanupCycle     cyclemanager.CycleManager
+
+	objectTTLCallbacks cyclemanager.CycleCallbackGroup
+	objectTTLCycle     cyclemanager.CycleManager
+
+	changeLogCallbacks cyclemanager.CycleCallbackGroup
+	changeLogCycle     cyclemanager.CycleManager
+
+	usageCallbacks cyclemanager.CycleCallbackGroup
+	usageCycle     cyclemanager.CycleManager
 }
 
 func (index *Index) initCycleCallbacks() {
@@ -120,6 +129,21 @@ func (index *Index) initCycleCallbacks() {
 		cyclemanager.NewFixedTicker(hnsw.DefaultCleanupIntervalSeconds*time.Second),
 		geoPropsTombstoneCleanupCallbacks.CycleCallback, index.logger)
 
+	objectTTLCallbacks := cyclemanager.NewCallbackGroup(id("object_ttl"), index.logger, routinesN)
+	objectTTLCycle := cyclemanager.NewManager(
+		cyclemanager.ObjectTTLCycleTicker(),
+		objectTTLCallbacks.CycleCallback, index.logger)
+
+	changeLogCallbacks := cyclemanager.NewCallbackGroup(id("changelog"), index.logger, routinesN)
+	changeLogCycle := cyclemanager.NewManager(
+		cyclemanager.NewFixedTicker(cyclemanager.ChangeLogRetentionInterval),
+		changeLogCallbacks.CycleCallback, index.logger)
+
+	usageCallbacks := cyclemanager.NewCallbackGroup(id("usage"), index.logger, routinesN)
+	usageCycle := cyclemanager.NewManager(
+		cyclemanager.NewFixedTicker(shardUsageRefreshInterval),
+		usageCallbacks.CycleCallback, index.logger)
+
 	index.cycleCallbacks = &indexCycleCallbacks{
 		compactionCallbacks:    compactionCallbacks,
 		compactionCycle:        compactionCycle,
@@ -137,6 +161,15 @@ func (index *Index) initCycleCallbacks() {
 		geoPropsCommitLoggerCycle:         geoPropsCommitLoggerCycle,
 		geoPropsTombstoneCleanupCallbacks: geoPropsTombstoneCleanupCallbacks,
 		geoPropsTombstoneCleanupCycle:     geoPropsTombstoneCleanupCycle,
+
+		objectTTLCallbacks: objectTTLCallbacks,
+		objectTTLCycle:     objectTTLCycle,
+
+		changeLogCallbacks: changeLogCallbacks,
+		changeLogCycle:     changeLogCycle,
+
+		usageCallbacks: usageCallbacks,
+		usageCycle:     usageCycle,
 	}
 }
 
@@ -158,5 +191,14 @@ func (index *Index) initCycleCallbacksNoop() {
 		geoPropsCommitLoggerCycle:         cyclemanager.NewManagerNoop(),
 		geoPropsTombstoneCleanupCallbacks: cyclemanager.NewCallbackGroupNoop(),
 		geoPropsTombstoneCleanupCycle:     cyclemanager.NewManagerNoop(),
+
+		objectTTLCallbacks: cyclemanager.NewCallbackGroupNoop(),
+		objectTTLCycle:     cyclemanager.NewManagerNoop(),
+
+		changeLogCallbacks: cyclemanager.NewCallbackGroupNoop(),
+		changeLogCycle:     cyclemanager.NewManagerNoop(),
+
+		usageCallbacks: cyclemanager.NewCallbackGroupNoop(),
+		usageCycle:     cyclemanager.NewManagerNoop(),
 	}
 }
@@ -0,0 +1,466 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// shardSplitDeleteBatchSize is the amount of objects removed at once from
+// split targets which don't own them
+const shardSplitDeleteBatchSize = 1000
+
+// shardSplits tracks the objects changed in local shards which are being
+// split. The targets of a split are copied from a snapshot of the source
+// and catch up by replaying the changes the source received afterwards.
+type shardSplits struct {
+	active atomic.Int32
+
+	sync.Mutex
+	// changed maps sources to the ids of their changed objects
+	changed map[string]map[string]struct{}
+}
+
+func (s *shardSplits) start(source string) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.changed == nil {
+		s.changed = make(map[string]map[string]struct{})
+	}
+	if _, ok := s.changed[source]; !ok {
+		s.active.Add(1)
+	}
+	s.changed[source] = make(map[string]struct{})
+}
+
+func (s *shardSplits) stop(source string) {
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.changed[source]; ok {
+		delete(s.changed, source)
+		s.active.Add(-1)
+	}
+}
+
+func (s *shardSplits) track(shard string, idBytes []byte) {
+	if s.active.Load() == 0 {
+		return
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	if changed, ok := s.changed[shard]; ok {
+		changed[string(idBytes)] = struct{}{}
+	}
+}
+
+// drain returns the ids of the objects changed in source since the last
+// call. ok is false if the changes of source aren't tracked.
+func (s *shardSplits) drain(source string) (ids [][]byte, ok bool) {
+	s.Lock()
+	defer s.Unlock()
+
+	changed, ok := s.changed[source]
+	if !ok {
+		return nil, false
+	}
+
+	ids = make([][]byte, 0, len(changed))
+	for id := range changed {
+		ids = append(ids, []byte(id))
+	}
+	s.changed[source] = make(map[string]struct{})
+	return ids, true
+}
+
+// restore tracks ids as changed again after replaying them failed
+func (s *shardSplits) restore(source string, ids [][]byte) {
+	s.Lock()
+	defer s.Unlock()
+
+	if changed, ok := s.changed[source]; ok {
+		for _, id := range ids {
+			changed[string(id)] = struct{}{}
+		}
+	}
+}
+
+// trackWrite records a write to the object with the given uuid if the shard
+// is being split, so that it is replayed to the targets of the split. It must
+// be called after the write was applied while holding the lock of the uuid.
+func (s *Shard) trackWrite(idBytes []byte) {
+	s.index.shardSplits.track(s.name, idBytes)
+}
+
+// PrepareShardSplit starts tracking the changes of the local shard source,
+// so that the targets of its split can catch up after they were copied from
+// it. Local leftovers of the targets from earlier attempts are removed.
+func (i *Index) PrepareShardSplit(ctx context.Context, source string, targets []string) error {
+	if err := i.dropLocalShards(targets); err != nil {
+		return fmt.Errorf("remove leftovers of split targets: %w", err)
+	}
+	i.shardSplits.start(source)
+	return nil
+}
+
+// ResumeShardMaintenance resumes the background processes of a local shard
+// which were halted to copy its files
+func (i *Index) ResumeShardMaintenance(ctx context.Context, shardName string) error {
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	if shard == nil {
+		return fmt.Errorf("shard %q does not exist locally", shardName)
+	}
+	defer release()
+
+	return shard.resumeMaintenanceCycles(ctx)
+}
+
+// LoadShardSplit loads the targets of the split of source after they were
+// copied from it. Each target keeps only the objects it will own and catches
+// up with the changes the source received in the meantime.
+func (i *Index) LoadShardSplit(ctx context.Context, source string, targets []string) error {
+	targetOf, ok := i.shardState().SplitTargets(source)
+	if !ok {
+		return fmt.Errorf("shard %q is not being split", source)
+	}
+
+	shards := make(map[string]ShardLike, len(targets))
+	for _, target := range targets {
+		shard, release, err := i.loadSplitTarget(ctx, target)
+		if err != nil {
+			return err
+		}
+		defer release()
+		shards[target] = shard
+
+		if err := removeObjects(ctx, shard, func(id strfmt.UUID, _ []byte) bool {
+			return targetOf(id.String()) != target
+		}); err != nil {
+			return fmt.Errorf("remove objects not owned by split target %q: %w", target, err)
+		}
+	}
+
+	ids, ok := i.shardSplits.drain(source)
+	if !ok {
+		return fmt.Errorf("changes of shard %q are not tracked", source)
+	}
+	return i.replayShardSplit(ctx, source, ids, shards, targetOf)
+}
+
+// FinishShardSplit replaces the local replica of source with the targets of
+// its split once the split was committed. The source turns read-only, the
+// targets replay the changes it received since they caught up and the source
+// is dropped. It is retried by the split engine until it succeeds and returns
+// early if the source was dropped already.
+//
+// If the changes weren't tracked, because the node restarted since the
+// targets were copied, the targets are reconciled with the whole source
+// instead. Objects the targets received after the commit are never replaced
+// by older versions from the source.
+func (i *Index) FinishShardSplit(ctx context.Context, source string, targets []string) error {
+	src, releaseSource, err := i.GetShard(ctx, source)
+	if err != nil {
+		return err
+	}
+	if src == nil {
+		// replaced already
+		i.shardSplits.stop(source)
+		return nil
+	}
+
+	shards := make(map[string]ShardLike, len(targets))
+	for _, target := range targets {
+		shard, release, err := i.loadSplitTarget(ctx, target)
+		if err != nil {
+			releaseSource()
+			return err
+		}
+		defer release()
+		shards[target] = shard
+	}
+
+	if err := src.SetStatusReadonly("shard split"); err != nil {
+		releaseSource()
+		return fmt.Errorf("set shard %q read-only: %w", source, err)
+	}
+
+	state := i.shardState()
+	targetOf := func(id string) string { return state.PhysicalShard([]byte(id)) }
+
+	if ids, tracked := i.shardSplits.drain(source); tracked {
+		// requests which started before the source turned read-only
+		// might still write to it
+		releaseSource()
+		if err := waitUntilUnused(ctx, src); err != nil {
+			// keep the drained changes for the next attempt
+			i.shardSplits.restore(source, ids)
+			return err
+		}
+		more, _ := i.shardSplits.drain(source)
+		if err := i.replayShardSplit(ctx, source, append(ids, more...), shards, targetOf); err != nil {
+			i.shardSplits.restore(source, append(ids, more...))
+			return err
+		}
+	} else {
+		err := reconcileShardSplit(ctx, src, shards, targetOf)
+		releaseSource()
+		if err != nil {
+			return err
+		}
+	}
+
+	i.shardSplits.stop(source)
+	return i.dropShards([]string{source})
+}
+
+// AbortShardSplit stops tracking the changes of source and drops the local
+// copies of the targets of its split
+func (i *Index) AbortShardSplit(ctx context.Context, source string, targets []string) error {
+	i.shardSplits.stop(source)
+	return i.dropLocalShards(targets)
+}
+
+func (i *Index) loadSplitTarget(ctx context.Context, target string) (ShardLike, func(), error) {
+	if err := i.LoadLocalShard(ctx, target); err != nil {
+		return nil, nil, fmt.Errorf("load split target %q: %w", target, err)
+	}
+	shard, release, err := i.GetShard(ctx, target)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load split target %q: %w", target, err)
+	}
+	if shard == nil {
+		return nil, nil, fmt.Errorf("split target %q does not exist locally", target)
+	}
+	return shard, release, nil
+}
+
+// replayShardSplit copies the current state of the given objects from source
+// to the targets which own them
+func (i *Index) replayShardSplit(ctx context.Context, source string, ids [][]byte,
+	targets map[string]ShardLike, targetOf func(id string) string,
+) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	src, release, err := i.GetShard(ctx, source)
+	if err != nil {
+		return err
+	}
+	if src == nil {
+		return fmt.Errorf("shard %q does not exist locally", source)
+	}
+	defer release()
+
+	bucket := src.Store().Bucket(helpers.ObjectsBucketLSM)
+	for _, idBytes := range ids {
+		parsed, err := uuid.FromBytes(idBytes)
+		if err != nil {
+			return fmt.Errorf("parse uuid: %w", err)
+		}
+		id := strfmt.UUID(parsed.String())
+
+		target, ok := targets[targetOf(id.String())]
+		if !ok {
+			return fmt.Errorf("object %s does not belong to any split target", id)
+		}
+
+		data, err := bucket.Get(idBytes)
+		if err != nil {
+			return fmt.Errorf("get object %s: %w", id, err)
+		}
+		if data == nil {
+			err = target.DeleteObject(ctx, id, time.Time{})
+		} else {
+			var upToDate bool
+			if upToDate, err = splitTargetUpToDate(target, idBytes, data); err == nil && !upToDate {
+				err = putSplitObject(ctx, target, data)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("replay object %s on split target %q: %w", id, target.Name(), err)
+		}
+	}
+	return nil
+}
+
+// reconcileShardSplit makes the targets match the objects they own in source
+func reconcileShardSplit(ctx context.Context, src ShardLike,
+	targets map[string]ShardLike, targetOf func(id string) string,
+) error {
+	bucket := src.Store().Bucket(helpers.ObjectsBucketLSM)
+
+	if err := reconcileSplitObjects(ctx, bucket, targets, targetOf); err != nil {
+		return err
+	}
+
+	for name, target := range targets {
+		err := removeObjects(ctx, target, func(id strfmt.UUID, idBytes []byte) bool {
+			if targetOf(id.String()) != name {
+				return true
+			}
+			data, err := bucket.Get(idBytes)
+			return err == nil && data == nil
+		})
+		if err != nil {
+			return fmt.Errorf("remove objects not owned by split target %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// reconcileSplitObjects copies all objects of the source bucket to the
+// targets which own them, unless they are up to date already
+func reconcileSplitObjects(ctx context.Context, bucket *lsmkv.Bucket,
+	targets map[string]ShardLike, targetOf func(id string) string,
+) error {
+	cursor := bucket.Cursor()
+	defer cursor.Close()
+
+	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		parsed, err := uuid.FromBytes(k)
+		if err != nil {
+			return fmt.Errorf("parse uuid: %w", err)
+		}
+		target, ok := targets[targetOf(parsed.String())]
+		if !ok {
+			return fmt.Errorf("object %s does not belong to any split target", parsed)
+		}
+
+		upToDate, err := splitTargetUpToDate(target, k, v)
+		if err != nil {
+			return fmt.Errorf("get object %s: %w", parsed, err)
+		}
+		if upToDate {
+			continue
+		}
+
+		if err := putSplitObject(ctx, target, v); err != nil {
+			return fmt.Errorf("reconcile object %s on split target %q: %w", parsed, target.Name(), err)
+		}
+	}
+	return nil
+}
+
+// splitTargetUpToDate is true if target holds the version of the object in
+// data or a newer one, which it received after the split was committed
+func splitTargetUpToDate(target ShardLike, idBytes, data []byte) (bool, error) {
+	existing, err := target.Store().Bucket(helpers.ObjectsBucketLSM).Get(idBytes)
+	if err != nil || existing == nil {
+		return false, err
+	}
+	_, updated, err := storobj.DocIDAndTimeFromBinary(existing)
+	if err != nil {
+		return false, err
+	}
+	_, expected, err := storobj.DocIDAndTimeFromBinary(data)
+	if err != nil {
+		return false, err
+	}
+	return updated >= expected, nil
+}
+
+func putSplitObject(ctx context.Context, target ShardLike, data []byte) error {
+	// the binary is reused by the bucket, the object must not refer to it
+	obj, err := storobj.FromBinary(append([]byte{}, data...))
+	if err != nil {
+		return fmt.Errorf("unmarshal object: %w", err)
+	}
+	return target.PutObject(ctx, obj)
+}
+
+// removeObjects deletes all objects of shard matching remove in batches
+func removeObjects(ctx context.Context, shard ShardLike, remove func(id strfmt.UUID, idBytes []byte) bool) error {
+	bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+
+	var last []byte
+	for {
+		batch := make([]strfmt.UUID, 0, shardSplitDeleteBatchSize)
+		func() {
+			cursor := bucket.Cursor()
+			defer cursor.Close()
+
+			k, _ := cursor.First()
+			if last != nil {
+				k, _ = cursor.Seek(last)
+			}
+			for ; k != nil && len(batch) < shardSplitDeleteBatchSize; k, _ = cursor.Next() {
+				last = append(last[:0], k...)
+				parsed, err := uuid.FromBytes(k)
+				if err != nil {
+					continue
+				}
+				if id := strfmt.UUID(parsed.String()); remove(id, k) {
+					batch = append(batch, id)
+				}
+			}
+		}()
+
+		if len(batch) == 0 {
+			return ctx.Err()
+		}
+		for _, res := range shard.DeleteObjectBatch(ctx, batch, time.Time{}, false) {
+			if res.Err != nil {
+				return fmt.Errorf("delete object %s: %w", res.UUID, res.Err)
+			}
+		}
+	}
+}
+
+// waitUntilUnused waits until no requests hold the shard anymore
+func waitUntilUnused(ctx context.Context, shard ShardLike) error {
+	inUse := func() int64 { return 0 }
+	switch s := shard.(type) {
+	case *Shard:
+		inUse = s.inUseCounter.Load
+	case *LazyLoadShard:
+		if err := s.Load(ctx); err != nil {
+			return err
+		}
+		inUse = s.shard.inUseCounter.Load
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for inUse() > 0 {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for requests to shard %q: %w", shard.Name(), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestIndex_ShardSplit(t *testing.T) {
+	ctx := testCtx()
+
+	setup := func(t *testing.T) (*Index, *sharding.State, string, []string, map[strfmt.UUID]bool) {
+		withMetrics := func(idx *Index) { idx.metrics = NewMetrics(idx.logger, nil, "SplitClass", "") }
+		shd, idx := testShard(t, ctx, "SplitClass", withMetrics)
+		source := shd.Name()
+
+		live := map[strfmt.UUID]bool{}
+		for i := 0; i < 200; i++ {
+			obj := testObject("SplitClass")
+			require.NoError(t, shd.PutObject(ctx, obj))
+			live[obj.ID()] = true
+		}
+
+		state := idx.getSchema.(*fakeSchemaGetter).shardState
+		split, err := state.PlanSplit(source, 2)
+		require.NoError(t, err)
+		require.NoError(t, state.StartSplit(split))
+		targets := split.TargetNames()
+
+		require.NoError(t, idx.PrepareShardSplit(ctx, source, targets))
+		copyShardSplitFiles(t, ctx, idx, source, targets)
+
+		return idx, state, source, targets, live
+	}
+
+	// write changes to the source which are not part of the copied files
+	write := func(t *testing.T, idx *Index, source string, live map[strfmt.UUID]bool) {
+		shd, release, err := idx.GetShard(ctx, source)
+		require.NoError(t, err)
+		defer release()
+
+		for i := 0; i < 20; i++ {
+			obj := testObject("SplitClass")
+			require.NoError(t, shd.PutObject(ctx, obj))
+			live[obj.ID()] = true
+		}
+		deleted := 0
+		for id, ok := range live {
+			if !ok {
+				continue
+			}
+			require.NoError(t, shd.DeleteObject(ctx, id, time.Time{}))
+			live[id] = false
+			if deleted++; deleted == 10 {
+				break
+			}
+		}
+	}
+
+	t.Run("commit", func(t *testing.T) {
+		idx, state, source, targets, live := setup(t)
+		write(t, idx, source, live)
+
+		require.NoError(t, idx.LoadShardSplit(ctx, source, targets))
+		targetOf, ok := state.SplitTargets(source)
+		require.True(t, ok)
+		for _, target := range targets {
+			for id := range shardObjectIDs(t, ctx, idx, target) {
+				assert.Equal(t, target, targetOf(id.String()))
+				assert.True(t, live[id])
+			}
+		}
+
+		// the source keeps receiving writes until the split is committed
+		write(t, idx, source, live)
+
+		require.NoError(t, state.SetSplitReady(source, "node1"))
+		_, err := state.CommitSplit(source)
+		require.NoError(t, err)
+		require.NoError(t, idx.FinishShardSplit(ctx, source, targets))
+
+		assertShardSplit(t, ctx, idx, source, targets, targetOf, live)
+
+		// retries after the source was dropped succeed
+		require.NoError(t, idx.FinishShardSplit(ctx, source, targets))
+	})
+
+	t.Run("commit after restart", func(t *testing.T) {
+		idx, state, source, targets, live := setup(t)
+		require.NoError(t, idx.LoadShardSplit(ctx, source, targets))
+		targetOf, _ := state.SplitTargets(source)
+
+		// changes are not tracked anymore after a restart
+		idx.shardSplits.stop(source)
+		write(t, idx, source, live)
+
+		require.NoError(t, state.SetSplitReady(source, "node1"))
+		_, err := state.CommitSplit(source)
+		require.NoError(t, err)
+		require.NoError(t, idx.FinishShardSplit(ctx, source, targets))
+		assertShardSplit(t, ctx, idx, source, targets, targetOf, live)
+	})
+
+	t.Run("abort", func(t *testing.T) {
+		idx, state, source, targets, live := setup(t)
+		require.NoError(t, idx.LoadShardSplit(ctx, source, targets))
+
+		_, err := state.AbortSplit(source)
+		require.NoError(t, err)
+		require.NoError(t, idx.AbortShardSplit(ctx, source, targets))
+
+		for _, target := range targets {
+			assert.Nil(t, idx.shards.Load(target))
+			assert.NoDirExists(t, shardPath(idx.path(), target))
+		}
+		assert.Len(t, shardObjectIDs(t, ctx, idx, source), len(live))
+		assert.Zero(t, idx.shardSplits.active.Load())
+	})
+}
+
+func assertShardSplit(t *testing.T, ctx context.Context, idx *Index, source string, targets []string,
+	targetOf func(string) string, live map[strfmt.UUID]bool,
+) {
+	assert.Nil(t, idx.shards.Load(source))
+	assert.NoDirExists(t, shardPath(idx.path(), source))
+	assert.Zero(t, idx.shardSplits.active.Load())
+
+	found := map[strfmt.UUID]bool{}
+	for _, target := range targets {
+		for id := range shardObjectIDs(t, ctx, idx, target) {
+			assert.Equal(t, target, targetOf(id.String()))
+			found[id] = true
+		}
+	}
+	for id, ok := range live {
+		assert.Equal(t, ok, found[id], id)
+	}
+}
+
+func shardObjectIDs(t *testing.T, ctx context.Context, idx *Index, name string) map[strfmt.UUID]struct{} {
+	shd, release, err := idx.GetShard(ctx, name)
+	require.NoError(t, err)
+	require.NotNil(t, shd)
+	defer release()
+
+	ids := map[strfmt.UUID]struct{}{}
+	cursor := shd.Store().Bucket(helpers.ObjectsBucketLSM).Cursor()
+	defer cursor.Close()
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		id, err := uuid.FromBytes(k)
+		require.NoError(t, err)
+		ids[strfmt.UUID(id.String())] = struct{}{}
+	}
+	return ids
+}
+
+// copyShardSplitFiles copies the files of source into the targets like
+// copier.Copier.CopyShardSplit
+func copyShardSplitFiles(t *testing.T, ctx context.Context, idx *Index, source string, targets []string) {
+	files, err := idx.IncomingPauseAndListFiles(ctx, source)
+	require.NoError(t, err)
+
+	for _, file := range files {
+		parts := strings.SplitN(file, "/", 3)
+		require.Len(t, parts, 3)
+		for _, target := range targets {
+			func() {
+				in, err := os.Open(filepath.Join(idx.Config.RootPath, file))
+				require.NoError(t, err)
+				defer in.Close()
+
+				dst := filepath.Join(idx.Config.RootPath, parts[0], target, parts[2])
+				require.NoError(t, os.MkdirAll(filepath.Dir(dst), os.ModePerm))
+				out, err := os.Create(dst)
+				require.NoError(t, err)
+				defer out.Close()
+
+				_, err = io.Copy(out, in)
+				require.NoError(t, err)
+			}()
+		}
+	}
+
+	require.NoError(t, idx.ResumeShardMaintenance(ctx, source))
+}
@@ -101,14 +101,22 @@ func (db *DB) init(ctx context.Context) error {
 				HNSWAcornFilterRatio:                db.config.HNSWAcornFilterRatio,
 				VisitedListPoolMaxSize:              db.config.VisitedListPoolMaxSize,
 				TrackVectorDimensions:               db.config.TrackVectorDimensions,
+				ChangeDataCaptureEnabled:            db.config.ChangeDataCaptureEnabled,
+				ChangeDataCaptureRetention:          db.config.ChangeDataCaptureRetention,
 				AvoidMMap:                           db.config.AvoidMMap,
 				DisableLazyLoadShards:               db.config.DisableLazyLoadShards,
 				ForceFullReplicasSearch:             db.config.ForceFullReplicasSearch,
 				LSMEnableSegmentsChecksumValidation: db.config.LSMEnableSegmentsChecksumValidation,
+				LSMObjectsBlockCompression:          db.config.LSMObjectsBlockCompression,
 				ReplicationFactor:                   class.ReplicationConfig.Factor,
 				AsyncReplicationEnabled:             class.ReplicationConfig.AsyncEnabled,
 				DeletionStrategy:                    class.ReplicationConfig.DeletionStrategy,
 				ShardLoadLimiter:                    db.shardLoadLimiter,
+				ObjectTTLMetrics:                    db.objectTTLMetrics,
+				Encryption:                          db.config.Encryption,
+				TenantKeys:                          db.config.TenantKeys,
+				CreateMissingTenantKeys:             db.config.CreateMissingTenantKeys,
+				LogArchiver:                         db.config.LogArchiver,
 			}, db.schemaGetter.CopyShardingState(class.Class),
 				inverted.ConfigFromModel(invertedConfig),
 				convertToVectorIndexConfig(class.VectorIndexConfig),
@@ -25,6 +25,9 @@ type IsFallbackToSearchable func() bool
 type Countable struct {
 	Data          []byte
 	TermFrequency float32
+	// Positions of the term within a text property, only set if the analyzer
+	// records term positions
+	Positions []uint32
 }
 
 type Property struct {
@@ -63,6 +66,14 @@ func DedupItems(props []Property) []Property {
 
 type Analyzer struct {
 	isFallbackToSearchable IsFallbackToSearchable
+	termPositions          bool
+}
+
+// WithTermPositions makes the analyzer record the positions of text terms,
+// as required for phrase queries
+func (a *Analyzer) WithTermPositions() *Analyzer {
+	a.termPositions = true
+	return a
 }
 
 // Text tokenizes given input according to selected tokenization,
@@ -74,6 +85,10 @@ func (a *Analyzer) Text(tokenization, in string) []Countable {
 // TextArray tokenizes given input according to selected tokenization,
 // then aggregates duplicates
 func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
+	if a.termPositions {
+		return a.textArrayWithPositions(tokenization, inArr)
+	}
+
 	var terms []string
 	for _, in := range inArr {
 		terms = append(terms, helpers.Tokenize(tokenization, in)...)
@@ -96,6 +111,30 @@ func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
 	return countable
 }
 
+func (a *Analyzer) textArrayWithPositions(tokenization string, inArr []string) []Countable {
+	positions := map[string][]uint32{}
+	pos := uint32(0)
+	for i, in := range inArr {
+		if i > 0 {
+			pos += textArrayPositionGap
+		}
+		for _, term := range helpers.Tokenize(tokenization, in) {
+			positions[term] = append(positions[term], pos)
+			pos++
+		}
+	}
+
+	countable := make([]Countable, 0, len(positions))
+	for term, termPositions := range positions {
+		countable = append(countable, Countable{
+			Data:          []byte(term),
+			TermFrequency: float32(len(termPositions)),
+			Positions:     termPositions,
+		})
+	}
+	return countable
+}
+
 // Int requires no analysis, so it's actually just a simple conversion to a
 // string-formatted byte slice of the int
 func (a *Analyzer) Int(in int64) ([]Countable, error) {
@@ -19,6 +19,7 @@ import (
 	"runtime/debug"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/concurrency"
@@ -29,6 +30,7 @@ import (
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
@@ -58,9 +60,51 @@ type propLengthRetriever interface {
 type termListRequest struct {
 	term               string
 	termId             int
-	duplicateTextBoost int
+	duplicateTextBoost float64
 	propertyNames      []string
 	propertyBoosts     map[string]float32
+	phrase             *phraseQuery
+}
+
+// phraseQuery holds the terms of a phrase in query order together with their
+// positions within the tokenized query
+type phraseQuery struct {
+	terms   []string
+	offsets []int
+	slop    int
+}
+
+// errPhraseRequiresInvertedIndex is returned for phrase queries on properties
+// whose searchable buckets predate the inverted format, as only its segments
+// store term positions
+var errPhraseRequiresInvertedIndex = errors.New("phrase queries require the searchable index " +
+	"to use the inverted format (usingBlockMaxWAND)")
+
+func newPhraseQuery(tokenization, query string, detector *stopwords.Detector, slop int) *phraseQuery {
+	out := &phraseQuery{slop: slop}
+	for i, term := range helpers.Tokenize(tokenization, query) {
+		// stopwords are skipped like in regular queries, the offsets keep track
+		// of the gaps they leave
+		if tokenization == models.PropertyTokenizationWord && detector != nil && detector.IsStopword(term) {
+			continue
+		}
+		out.terms = append(out.terms, term)
+		out.offsets = append(out.offsets, i)
+	}
+	return out
+}
+
+// frequency returns how often the phrase occurs in a document, positions holds
+// the encoded document positions of every phrase term
+func (p *phraseQuery) frequency(positions [][]byte) (int, error) {
+	decoded := make([][]uint32, len(positions))
+	for i := range positions {
+		var err error
+		if decoded[i], err = DecodePositions(positions[i]); err != nil {
+			return 0, err
+		}
+	}
+	return PhraseFrequency(decoded, p.offsets, p.slop), nil
 }
 
 func NewBM25Searcher(config schema.BM25Config, store *lsmkv.Store,
@@ -95,10 +139,31 @@ func (b *BM25Searcher) BM25F(ctx context.Context, filterDocIds helpers.AllowList
 		return nil, nil, fmt.Errorf("could not find class %s in schema", className)
 	}
 
+	if err := keywordRanking.ValidatePhrase(); err != nil {
+		return nil, nil, err
+	}
+	if err := keywordRanking.ValidateFuzzy(); err != nil {
+		return nil, nil, err
+	}
+	if err := keywordRanking.ValidateSearchOperator(); err != nil {
+		return nil, nil, err
+	}
+	if keywordRanking.Phrase && (class.InvertedIndexConfig == nil || !class.InvertedIndexConfig.IndexTermPositions) {
+		return nil, nil, fmt.Errorf("phrase queries require term positions to be indexed, " +
+			"add `indexTermPositions: true` to the invertedIndexConfig")
+	}
+
 	var objs []*storobj.Object
 	var scores []float32
 	var err error
 
+	if keywordRanking.RequiresMultipleTokens() {
+		filterDocIds, err = b.searchOperatorAllowList(ctx, filterDocIds, class, keywordRanking)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "search operator")
+		}
+	}
+
 	// TODO: amourao - move this to the global config
 	if os.Getenv("USE_BLOCKMAX_WAND") == "false" {
 		objs, scores, err = b.wand(ctx, filterDocIds, class, keywordRanking, limit, additional)
@@ -116,7 +181,7 @@ func (b *BM25Searcher) GetPropertyLengthTracker() *JsonShardMetaData {
 	return b.propLenTracker.(*JsonShardMetaData)
 }
 
-func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params searchparams.KeywordRanking) (bool, float64, map[string][]string, map[string][]string, map[string][]int, map[string]float32, float64, error) {
+func (b *BM25Searcher) generateQueryTermsAndStats(ctx context.Context, class *models.Class, params searchparams.KeywordRanking) (bool, float64, map[string][]string, map[string][]string, map[string][]float64, map[string]float32, float64, error) {
 	N := float64(b.store.Bucket(helpers.ObjectsBucketLSM).Count())
 
 	// This flag checks whether all buckets are of the inverted strategy,
@@ -124,26 +189,27 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 	// which would require the old WAND implementation.
 	allBucketsAreInverted := true
 
-	var stopWordDetector *stopwords.Detector
-	if class.InvertedIndexConfig != nil && class.InvertedIndexConfig.Stopwords != nil {
-		var err error
-		stopWordDetector, err = stopwords.NewDetectorFromConfig(*(class.InvertedIndexConfig.Stopwords))
-		if err != nil {
-			return false, 0, nil, nil, nil, nil, 0, err
-		}
+	stopWordDetector, err := b.stopwordDetector(class)
+	if err != nil {
+		return false, 0, nil, nil, nil, nil, 0, err
 	}
+	synonymExpander := b.synonymExpander(class)
 
 	// There are currently cases, for different tokenization:
 	// word, lowercase, whitespace and field.
 	// Query is tokenized and respective properties are then searched for the search terms,
 	// results at the end are combined using WAND
 	queryTermsByTokenization := map[string][]string{}
-	duplicateBoostsByTokenization := map[string][]int{}
+	duplicateBoostsByTokenization := map[string][]float64{}
 	propNamesByTokenization := map[string][]string{}
 	propertyBoosts := make(map[string]float32, len(params.Properties))
 
 	for _, tokenization := range helpers.Tokenizations {
-		queryTerms, dupBoosts := helpers.TokenizeAndCountDuplicates(tokenization, params.Query)
+		queryTerms, dupCounts := helpers.TokenizeAndCountDuplicates(tokenization, params.Query)
+		dupBoosts := make([]float64, len(dupCounts))
+		for i, count := range dupCounts {
+			dupBoosts[i] = float64(count)
+		}
 		queryTermsByTokenization[tokenization] = queryTerms
 		duplicateBoostsByTokenization[tokenization] = dupBoosts
 
@@ -155,6 +221,14 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 			duplicateBoostsByTokenization[tokenization] = dupBoosts
 		}
 
+		// synonyms are lowercase, so they only apply to lowercased terms
+		if tokenization == models.PropertyTokenizationWord || tokenization == models.PropertyTokenizationLowercase {
+			queryTerms, dupBoosts = b.addSynonymsToQueryTerms(queryTermsByTokenization[tokenization],
+				duplicateBoostsByTokenization[tokenization], synonymExpander)
+			queryTermsByTokenization[tokenization] = queryTerms
+			duplicateBoostsByTokenization[tokenization] = dupBoosts
+		}
+
 		propNamesByTokenization[tokenization] = make([]string, 0)
 	}
 
@@ -210,6 +284,22 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 		}
 	}
 
+	if params.MaxEdits > 0 {
+		for _, tokenization := range helpers.Tokenizations {
+			if len(propNamesByTokenization[tokenization]) == 0 {
+				continue
+			}
+			queryTerms, dupBoosts, err := b.expandFuzzyTerms(ctx, propNamesByTokenization[tokenization],
+				queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization],
+				params.MaxEdits, params.MaxExpansions, stopWordDetector, tokenization)
+			if err != nil {
+				return false, 0, nil, nil, nil, nil, 0, err
+			}
+			queryTermsByTokenization[tokenization] = queryTerms
+			duplicateBoostsByTokenization[tokenization] = dupBoosts
+		}
+	}
+
 	averagePropLength = averagePropLength / float64(averagePropLengthCount)
 
 	// If this value is zero or NaN, the prop length tracker is fully corrupted.
@@ -222,10 +312,96 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 	return allBucketsAreInverted, N, propNamesByTokenization, queryTermsByTokenization, duplicateBoostsByTokenization, propertyBoosts, averagePropLength, nil
 }
 
+// expandFuzzyTerms adds the terms of the given properties within maxEdits of
+// a query term to the query terms. Expansions are scored like the term they
+// were expanded from. Terms that are not longer than maxEdits are not
+// expanded, as they would match almost every short term.
+func (b *BM25Searcher) expandFuzzyTerms(ctx context.Context, propNames []string,
+	queryTerms []string, duplicateBoosts []float64, maxEdits, maxExpansions int,
+	detector *stopwords.Detector, tokenization string,
+) ([]string, []float64, error) {
+	if maxExpansions == 0 {
+		maxExpansions = DefaultFuzzyMaxExpansions
+	}
+
+	seen := make(map[string]struct{}, len(queryTerms))
+	for _, term := range queryTerms {
+		seen[term] = struct{}{}
+	}
+
+	expandedTerms := queryTerms
+	expandedBoosts := duplicateBoosts
+	for i, queryTerm := range queryTerms {
+		if utf8.RuneCountInString(queryTerm) <= maxEdits {
+			continue
+		}
+
+		expansions, err := b.fuzzyExpansions(ctx, propNames, queryTerm, maxEdits, maxExpansions)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, term := range expansions {
+			if _, ok := seen[term]; ok {
+				continue
+			}
+			if tokenization == models.PropertyTokenizationWord && detector != nil && detector.IsStopword(term) {
+				continue
+			}
+			seen[term] = struct{}{}
+			expandedTerms = append(expandedTerms, term)
+			expandedBoosts = append(expandedBoosts, duplicateBoosts[i])
+		}
+	}
+	return expandedTerms, expandedBoosts, nil
+}
+
+// fuzzyExpansions returns the terms of the given properties within maxEdits
+// of the query term, the closest and most frequent first
+func (b *BM25Searcher) fuzzyExpansions(ctx context.Context, propNames []string,
+	queryTerm string, maxEdits, maxExpansions int,
+) ([]string, error) {
+	automaton := newLevenshteinAutomaton(queryTerm, maxEdits)
+	candidates := map[string]*fuzzyCandidate{}
+	for _, propName := range propNames {
+		if err := b.collectFuzzyCandidates(ctx, automaton, propName, candidates); err != nil {
+			return nil, errors.Wrapf(err, "expand fuzzy term %q", queryTerm)
+		}
+	}
+	return topFuzzyCandidates(candidates, maxExpansions), nil
+}
+
+func (b *BM25Searcher) collectFuzzyCandidates(ctx context.Context, automaton *levenshteinAutomaton,
+	propName string, candidates map[string]*fuzzyCandidate,
+) error {
+	bucket := b.GetBucket(propName)
+	if bucket == nil {
+		return fmt.Errorf("could not find bucket for property %v", propName)
+	}
+	cursor := bucket.MapCursor()
+	defer cursor.Close()
+
+	return automaton.collect(ctx, cursor, candidates)
+}
+
+func (b *BM25Searcher) synonymExpander(class *models.Class) *synonyms.Expander {
+	if class.InvertedIndexConfig == nil || class.InvertedIndexConfig.Synonyms == nil {
+		return nil
+	}
+	return synonyms.NewExpanderFromConfig(*class.InvertedIndexConfig.Synonyms)
+}
+
+func (b *BM25Searcher) stopwordDetector(class *models.Class) (*stopwords.Detector, error) {
+	if class.InvertedIndexConfig == nil || class.InvertedIndexConfig.Stopwords == nil {
+		return nil, nil
+	}
+	return stopwords.NewDetectorFromConfig(*(class.InvertedIndexConfig.Stopwords))
+}
+
 func (b *BM25Searcher) wand(
 	ctx context.Context, filterDocIds helpers.AllowList, class *models.Class, params searchparams.KeywordRanking, limit int, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
-	_, N, propNamesByTokenization, queryTermsByTokenization, duplicateBoostsByTokenization, propertyBoosts, averagePropLength, err := b.generateQueryTermsAndStats(class, params)
+	_, N, propNamesByTokenization, queryTermsByTokenization, duplicateBoostsByTokenization, propertyBoosts, averagePropLength, err := b.generateQueryTermsAndStats(ctx, class, params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -233,9 +409,32 @@ func (b *BM25Searcher) wand(
 	allRequests := make([]termListRequest, 0, 1000)
 	allQueryTerms := make([]string, 0, 1000)
 
+	var stopWordDetector *stopwords.Detector
+	if params.Phrase {
+		if stopWordDetector, err = b.stopwordDetector(class); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	for _, tokenization := range helpers.Tokenizations {
 		propNames := propNamesByTokenization[tokenization]
-		if len(propNames) > 0 {
+		if len(propNames) > 0 && params.Phrase {
+			// the whole phrase is scored as a single term
+			phrase := newPhraseQuery(tokenization, params.Query, stopWordDetector, params.Slop)
+			if len(phrase.terms) == 0 {
+				continue
+			}
+			phraseTerm := strings.Join(phrase.terms, " ")
+			allRequests = append(allRequests, termListRequest{
+				term:               phraseTerm,
+				termId:             len(allRequests),
+				duplicateTextBoost: 1,
+				propertyNames:      propNames,
+				propertyBoosts:     propertyBoosts,
+				phrase:             phrase,
+			})
+			allQueryTerms = append(allQueryTerms, phraseTerm)
+		} else if len(propNames) > 0 {
 			queryTerms, duplicateBoosts := queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization]
 			for queryTermIndex, queryTerm := range queryTerms {
 				allRequests = append(allRequests, termListRequest{
@@ -260,6 +459,7 @@ func (b *BM25Searcher) wand(
 		termId := request.termId
 		propNames := request.propertyNames
 		duplicateBoost := request.duplicateTextBoost
+		phrase := request.phrase
 
 		eg.Go(func() (err error) {
 			defer func() {
@@ -275,7 +475,13 @@ func (b *BM25Searcher) wand(
 				}
 			}()
 
-			termResult, termErr := b.createTerm(N, filterDocIds, term, termId, propNames, propertyBoosts, duplicateBoost, ctx)
+			var termResult *terms.Term
+			var termErr error
+			if phrase != nil {
+				termResult, termErr = b.createPhraseTerm(N, filterDocIds, phrase, termId, propNames, propertyBoosts, ctx)
+			} else {
+				termResult, termErr = b.createTerm(N, filterDocIds, term, termId, propNames, propertyBoosts, duplicateBoost, ctx)
+			}
 			if termErr != nil {
 				err = termErr
 				return
@@ -314,9 +520,48 @@ func (b *BM25Searcher) wand(
 	return b.getTopKObjects(topKHeap, params.AdditionalExplanations, allQueryTerms, additional)
 }
 
+// addSynonymsToQueryTerms adds the synonyms of the query terms to them. The
+// boost of a synonym is the boost of the term it was found for scaled by the
+// weight of the synonym. Synonyms that are query terms themselves are skipped.
+func (b *BM25Searcher) addSynonymsToQueryTerms(queryTerms []string,
+	duplicateBoost []float64, expander *synonyms.Expander,
+) ([]string, []float64) {
+	if expander == nil || len(queryTerms) == 0 {
+		return queryTerms, duplicateBoost
+	}
+
+	isQueryTerm := make(map[string]struct{}, len(queryTerms))
+	for _, term := range queryTerms {
+		isQueryTerm[term] = struct{}{}
+	}
+
+	synonymBoosts := map[string]float64{}
+	var synonymTerms []string
+	for i, term := range queryTerms {
+		for _, synonym := range expander.Expand(term) {
+			if _, ok := isQueryTerm[synonym.Term]; ok {
+				continue
+			}
+			boost := duplicateBoost[i] * synonym.Weight
+			if prev, ok := synonymBoosts[synonym.Term]; !ok {
+				synonymTerms = append(synonymTerms, synonym.Term)
+				synonymBoosts[synonym.Term] = boost
+			} else if boost > prev {
+				synonymBoosts[synonym.Term] = boost
+			}
+		}
+	}
+
+	for _, term := range synonymTerms {
+		queryTerms = append(queryTerms, term)
+		duplicateBoost = append(duplicateBoost, synonymBoosts[term])
+	}
+	return queryTerms, duplicateBoost
+}
+
 func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string,
-	duplicateBoost []int, detector *stopwords.Detector,
-) ([]string, []int) {
+	duplicateBoost []float64, detector *stopwords.Detector,
+) ([]string, []float64) {
 	if detector == nil || len(queryTerms) == 0 {
 		return queryTerms, duplicateBoost
 	}
@@ -413,7 +658,42 @@ func (b *BM25Searcher) getTopKIds(topKHeap *priorityqueue.Queue[[]*terms.DocPoin
 	return ids, scores, explanations, nil
 }
 
-func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, query string, queryTermIndex int, propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost int, ctx context.Context) (*terms.Term, error) {
+func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, query string, queryTermIndex int, propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost float64, ctx context.Context) (*terms.Term, error) {
+	return b.createTermFromPostings(N, filterDocIds, query, queryTermIndex, propertyNames, duplicateTextBoost, ctx,
+		func(ctx context.Context, propName string) ([]terms.DocPointerWithScore, error) {
+			bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+			if bucket == nil {
+				return nil, fmt.Errorf("could not find bucket for property %v", propName)
+			}
+			return bucket.DocPointerWithScoreList(ctx, []byte(query), propertyBoosts[propName])
+		})
+}
+
+func (b *BM25Searcher) createPhraseTerm(N float64, filterDocIds helpers.AllowList, phrase *phraseQuery, queryTermIndex int, propertyNames []string, propertyBoosts map[string]float32, ctx context.Context) (*terms.Term, error) {
+	return b.createTermFromPostings(N, filterDocIds, strings.Join(phrase.terms, " "), queryTermIndex, propertyNames, 1, ctx,
+		func(ctx context.Context, propName string) ([]terms.DocPointerWithScore, error) {
+			return b.phrasePostings(ctx, phrase, propName, propertyBoosts[propName])
+		})
+}
+
+// phrasePostings returns the objects matching the phrase in the given
+// property, with the number of phrase occurrences as their frequency
+func (b *BM25Searcher) phrasePostings(ctx context.Context, phrase *phraseQuery, propName string, propBoost float32) ([]terms.DocPointerWithScore, error) {
+	bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+	if bucket == nil {
+		return nil, fmt.Errorf("could not find bucket for property %v", propName)
+	}
+	if bucket.Strategy() != lsmkv.StrategyInverted {
+		return nil, errPhraseRequiresInvertedIndex
+	}
+	return bucket.PhrasePostings(ctx, phrase.terms, phrase.frequency, propBoost)
+}
+
+// createTermFromPostings combines the postings of all properties into a
+// single term, postings provides the postings of a single property
+func (b *BM25Searcher) createTermFromPostings(N float64, filterDocIds helpers.AllowList, query string, queryTermIndex int, propertyNames []string, duplicateTextBoost float64, ctx context.Context,
+	postings func(ctx context.Context, propName string) ([]terms.DocPointerWithScore, error),
+) (*terms.Term, error) {
 	termResult := terms.NewTerm(query, queryTermIndex, float32(1.0), b.config)
 
 	var filteredDocIDs *sroar.Bitmap
@@ -433,11 +713,7 @@ func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, que
 
 		eg.Go(
 			func() error {
-				bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
-				if bucket == nil {
-					return fmt.Errorf("could not find bucket for property %v", propName)
-				}
-				preM, err := bucket.DocPointerWithScoreList(ctx, []byte(query), propertyBoosts[propName])
+				preM, err := postings(ctx, propName)
 				if err != nil {
 					return err
 				}
@@ -500,7 +776,7 @@ func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, que
 		if filterDocIds != nil {
 			n += float64(filteredDocIDs.GetCardinality())
 		}
-		termResult.SetIdf(math.Log(float64(1)+(N-float64(n)+0.5)/(float64(n)+0.5)) * float64(duplicateTextBoost))
+		termResult.SetIdf(math.Log(float64(1)+(N-float64(n)+0.5)/(float64(n)+0.5)) * duplicateTextBoost)
 		termResult.SetPosPointer(0)
 		termResult.SetIdPointer(termResult.Data[0].Id)
 		return termResult, nil
@@ -575,7 +851,7 @@ func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, que
 	if filterDocIds != nil {
 		n += float64(filteredDocIDs.GetCardinality())
 	}
-	termResult.SetIdf(math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * float64(duplicateTextBoost))
+	termResult.SetIdf(math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * duplicateTextBoost)
 
 	// catch special case where there are no results and would panic termResult.data[0].id
 	// related to #4125
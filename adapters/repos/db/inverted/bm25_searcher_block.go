@@ -19,9 +19,11 @@ import (
 	"slices"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -35,11 +37,16 @@ import (
 
 // var metrics = lsmkv.BlockMetrics{}
 
-func (b *BM25Searcher) createBlockTerm(N float64, filterDocIds helpers.AllowList, query []string, propName string, propertyBoost float32, duplicateTextBoosts []int, averagePropLength float64, config schema.BM25Config, ctx context.Context) ([][]*lsmkv.SegmentBlockMax, map[string]uint64, func(), error) {
+func (b *BM25Searcher) createBlockTerm(N float64, filterDocIds helpers.AllowList, query []string, propName string, propertyBoost float32, duplicateTextBoosts []float64, averagePropLength float64, config schema.BM25Config, ctx context.Context) ([][]*lsmkv.SegmentBlockMax, map[string]uint64, func(), error) {
 	bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
 	return bucket.CreateDiskTerm(N, filterDocIds, query, propName, propertyBoost, duplicateTextBoosts, averagePropLength, config, ctx)
 }
 
+func (b *BM25Searcher) createBlockPhraseTerm(N float64, filterDocIds helpers.AllowList, phrase *phraseQuery, propName string, propertyBoost float32, averagePropLength float64, config schema.BM25Config, ctx context.Context) ([][]*lsmkv.SegmentBlockMax, map[string]uint64, func(), error) {
+	bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+	return bucket.CreatePhraseTerm(N, filterDocIds, phrase.terms, phrase.frequency, 0, propertyBoost, averagePropLength, config, ctx)
+}
+
 func (b *BM25Searcher) wandBlock(
 	ctx context.Context, filterDocIds helpers.AllowList, class *models.Class, params searchparams.KeywordRanking, limit int, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
@@ -58,16 +65,26 @@ func (b *BM25Searcher) wandBlock(
 		return []*storobj.Object{}, []float32{}, nil
 	}
 
-	allBucketsAreInverted, N, propNamesByTokenization, queryTermsByTokenization, duplicateBoostsByTokenization, propertyBoosts, averagePropLength, err := b.generateQueryTermsAndStats(class, params)
+	allBucketsAreInverted, N, propNamesByTokenization, queryTermsByTokenization, duplicateBoostsByTokenization, propertyBoosts, averagePropLength, err := b.generateQueryTermsAndStats(ctx, class, params)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// fallback to the old search process if not all buckets are inverted
 	if !allBucketsAreInverted {
+		if params.Phrase {
+			return nil, nil, errPhraseRequiresInvertedIndex
+		}
 		return b.wand(ctx, filterDocIds, class, params, limit, additional)
 	}
 
+	var stopWordDetector *stopwords.Detector
+	if params.Phrase {
+		if stopWordDetector, err = b.stopwordDetector(class); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	allResults := make([][][]*lsmkv.SegmentBlockMax, 0, len(params.Properties))
 	termCounts := make([][]string, 0, len(params.Properties))
 
@@ -89,14 +106,30 @@ func (b *BM25Searcher) wandBlock(
 		if len(propNames) > 0 {
 			lenAllResults := len(allResults)
 			queryTerms, duplicateBoosts := queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization]
-			duplicateBoostsByTerm := make(map[string]int, len(duplicateBoosts))
+			var phrase *phraseQuery
+			if params.Phrase {
+				// the whole phrase is scored as a single term
+				phrase = newPhraseQuery(tokenization, params.Query, stopWordDetector, params.Slop)
+				if len(phrase.terms) == 0 {
+					continue
+				}
+				queryTerms, duplicateBoosts = []string{strings.Join(phrase.terms, " ")}, []float64{1}
+			}
+			duplicateBoostsByTerm := make(map[string]float64, len(duplicateBoosts))
 			for i, term := range queryTerms {
 				duplicateBoostsByTerm[term] = duplicateBoosts[i]
 			}
 			globalIdfCounts := make(map[string]uint64, len(queryTerms))
 			nonZeroTerms := make(map[string]uint64, len(queryTerms))
 			for _, propName := range propNames {
-				results, idfCounts, release, err := b.createBlockTerm(N, filterDocIds, queryTerms, propName, propertyBoosts[propName], duplicateBoosts, averagePropLength, b.config, ctx)
+				var results [][]*lsmkv.SegmentBlockMax
+				var idfCounts map[string]uint64
+				var release func()
+				if phrase != nil {
+					results, idfCounts, release, err = b.createBlockPhraseTerm(N, filterDocIds, phrase, propName, propertyBoosts[propName], averagePropLength, b.config, ctx)
+				} else {
+					results, idfCounts, release, err = b.createBlockTerm(N, filterDocIds, queryTerms, propName, propertyBoosts[propName], duplicateBoosts, averagePropLength, b.config, ctx)
+				}
 				if err != nil {
 					return nil, nil, err
 				}
@@ -121,7 +154,7 @@ func (b *BM25Searcher) wandBlock(
 				}
 				n := globalIdfCounts[term] / nonZeroTerms[term]
 
-				globalIdfs[term] = math.Log(float64(1)+(N-float64(n)+0.5)/(float64(n)+0.5)) * float64(duplicateBoostsByTerm[term])
+				globalIdfs[term] = math.Log(float64(1)+(N-float64(n)+0.5)/(float64(n)+0.5)) * duplicateBoostsByTerm[term]
 			}
 			for _, result := range allResults[lenAllResults:] {
 				if len(result) == 0 {
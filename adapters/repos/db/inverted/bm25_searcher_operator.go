@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// searchOperatorAllowList restricts the allow list to the objects containing
+// the minimum number of query tokens required by the search operator of the
+// ranking. A token is contained in an object if any of the searched
+// properties contains the token, one of its synonyms or one of its fuzzy
+// expansions. Properties are grouped by tokenization, as the query has a
+// different number of tokens for each of them, and an object matches if it
+// has enough tokens in any of the groups.
+//
+// Both the WAND and the BlockMax WAND search only score objects in the
+// allow list, so the operator applies to both of them.
+func (b *BM25Searcher) searchOperatorAllowList(ctx context.Context, filterDocIds helpers.AllowList,
+	class *models.Class, params searchparams.KeywordRanking,
+) (helpers.AllowList, error) {
+	detector, err := b.stopwordDetector(class)
+	if err != nil {
+		return nil, err
+	}
+	expander := b.synonymExpander(class)
+
+	propNamesByTokenization := map[string][]string{}
+	for _, propertyWithBoost := range params.Properties {
+		propName := strings.Split(propertyWithBoost, "^")[0]
+		prop, err := schema.GetPropertyByName(class, propName)
+		if err != nil {
+			return nil, err
+		}
+		propNamesByTokenization[prop.Tokenization] = append(propNamesByTokenization[prop.Tokenization], propName)
+	}
+
+	maxExpansions := params.MaxExpansions
+	if maxExpansions == 0 {
+		maxExpansions = DefaultFuzzyMaxExpansions
+	}
+
+	matching := sroar.NewBitmap()
+	for _, tokenization := range helpers.Tokenizations {
+		propNames := propNamesByTokenization[tokenization]
+		if len(propNames) == 0 {
+			continue
+		}
+
+		var tokens []string
+		seen := map[string]struct{}{}
+		for _, token := range helpers.Tokenize(tokenization, params.Query) {
+			if _, ok := seen[token]; ok {
+				continue
+			}
+			seen[token] = struct{}{}
+			if tokenization == models.PropertyTokenizationWord && detector != nil && detector.IsStopword(token) {
+				continue
+			}
+			tokens = append(tokens, token)
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+
+		tokenDocIDs := make([]*sroar.Bitmap, 0, len(tokens))
+		for _, token := range tokens {
+			variants := []string{token}
+			if tokenization == models.PropertyTokenizationWord || tokenization == models.PropertyTokenizationLowercase {
+				for _, synonym := range expander.Expand(token) {
+					variants = append(variants, synonym.Term)
+				}
+			}
+			if params.MaxEdits > 0 && utf8.RuneCountInString(token) > params.MaxEdits {
+				expansions, err := b.fuzzyExpansions(ctx, propNames, token, params.MaxEdits, maxExpansions)
+				if err != nil {
+					return nil, err
+				}
+				variants = append(variants, expansions...)
+			}
+
+			docIDs, err := b.docIDsContainingAny(ctx, propNames, variants)
+			if err != nil {
+				return nil, err
+			}
+			tokenDocIDs = append(tokenDocIDs, docIDs)
+		}
+
+		matching.Or(containedInAtLeast(tokenDocIDs, params.MinimumTokensMatch(len(tokens))))
+	}
+
+	if filterDocIds != nil {
+		if bitmapAllowList, ok := filterDocIds.(*helpers.BitmapAllowList); ok {
+			matching.And(bitmapAllowList.Bm)
+		} else {
+			for _, docID := range matching.ToArray() {
+				if !filterDocIds.Contains(docID) {
+					matching.Remove(docID)
+				}
+			}
+		}
+	}
+
+	return helpers.NewAllowListFromBitmap(matching), nil
+}
+
+// containedInAtLeast returns the objects contained in at least required of
+// the bitmaps. atLeast[i] holds the objects seen in at least i+1 of the
+// bitmaps merged so far, so the count is kept with one bitmap per level
+// instead of a counter per object.
+func containedInAtLeast(bitmaps []*sroar.Bitmap, required int) *sroar.Bitmap {
+	if required < 1 {
+		required = 1
+	}
+	if required > len(bitmaps) {
+		return sroar.NewBitmap()
+	}
+	if required == len(bitmaps) {
+		intersection := bitmaps[0].Clone()
+		for _, bm := range bitmaps[1:] {
+			intersection.And(bm)
+		}
+		return intersection
+	}
+
+	atLeast := make([]*sroar.Bitmap, required)
+	for i := range atLeast {
+		atLeast[i] = sroar.NewBitmap()
+	}
+	for _, bm := range bitmaps {
+		for i := required - 1; i > 0; i-- {
+			atLeast[i].Or(sroar.And(atLeast[i-1], bm))
+		}
+		atLeast[0].Or(bm)
+	}
+	return atLeast[required-1]
+}
+
+// docIDsContainingAny returns the objects containing any of the terms in any
+// of the properties
+func (b *BM25Searcher) docIDsContainingAny(ctx context.Context, propNames []string, terms []string) (*sroar.Bitmap, error) {
+	docIDs := sroar.NewBitmap()
+	for _, propName := range propNames {
+		bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+		if bucket == nil {
+			return nil, fmt.Errorf("could not find bucket for property %v", propName)
+		}
+		for _, term := range terms {
+			postings, err := bucket.DocPointerWithScoreList(ctx, []byte(term), 1)
+			if err != nil {
+				return nil, err
+			}
+			for _, posting := range postings {
+				// a frequency of 0 marks a deleted object
+				if posting.Frequency != 0 {
+					docIDs.Set(posting.Id)
+				}
+			}
+		}
+	}
+	return docIDs, nil
+}
@@ -0,0 +1,52 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/sroar"
+)
+
+func TestContainedInAtLeast(t *testing.T) {
+	bitmap := func(ids ...uint64) *sroar.Bitmap {
+		bm := sroar.NewBitmap()
+		bm.SetMany(ids)
+		return bm
+	}
+	bitmaps := func() []*sroar.Bitmap {
+		return []*sroar.Bitmap{
+			bitmap(1, 2, 3, 4),
+			bitmap(2, 3, 5),
+			bitmap(3, 4, 5, 6),
+		}
+	}
+
+	tests := []struct {
+		required int
+		expected []uint64
+	}{
+		{required: 0, expected: []uint64{1, 2, 3, 4, 5, 6}},
+		{required: 1, expected: []uint64{1, 2, 3, 4, 5, 6}},
+		{required: 2, expected: []uint64{2, 3, 4, 5}},
+		{required: 3, expected: []uint64{3}},
+		{required: 4, expected: []uint64{}},
+	}
+
+	for _, test := range tests {
+		in := bitmaps()
+		assert.ElementsMatch(t, test.expected, containedInAtLeast(in, test.required).ToArray(),
+			"required %d", test.required)
+		assert.Equal(t, []uint64{1, 2, 3, 4}, in[0].ToArray(), "input must not be modified")
+	}
+}
@@ -14,6 +14,7 @@ package inverted
 import (
 	"runtime"
 	"strings"
+	"unicode"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
@@ -39,6 +40,11 @@ func ValidateConfig(conf *models.InvertedIndexConfig) error {
 		return err
 	}
 
+	err = validateSynonymConfig(conf.Synonyms)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -48,6 +54,7 @@ func ConfigFromModel(iicm *models.InvertedIndexConfig) schema.InvertedIndexConfi
 	conf.IndexTimestamps = iicm.IndexTimestamps
 	conf.IndexNullState = iicm.IndexNullState
 	conf.IndexPropertyLength = iicm.IndexPropertyLength
+	conf.IndexTermPositions = iicm.IndexTermPositions
 
 	if iicm.Bm25 == nil {
 		conf.BM25.K1 = float64(config.DefaultBM25k1)
@@ -67,6 +74,10 @@ func ConfigFromModel(iicm *models.InvertedIndexConfig) schema.InvertedIndexConfi
 		conf.Stopwords.Removals = iicm.Stopwords.Removals
 	}
 
+	if iicm.Synonyms != nil {
+		conf.Synonyms.Sets = iicm.Synonyms.Sets
+	}
+
 	conf.UsingBlockMaxWAND = iicm.UsingBlockMaxWAND
 
 	return conf
@@ -166,3 +177,27 @@ func removeStopwordAdditionsIfInPreset(conf *models.StopwordConfig, foundAdditio
 	}
 	conf.Additions = trimmedAdditions
 }
+
+func validateSynonymConfig(conf *models.SynonymConfig) error {
+	if conf == nil {
+		return nil
+	}
+
+	for i, set := range conf.Sets {
+		if set == nil || len(set.Terms) < 2 {
+			return errors.Errorf("synonyms.sets[%d] must contain at least two terms", i)
+		}
+
+		for _, term := range set.Terms {
+			if term == "" || strings.ContainsFunc(term, unicode.IsSpace) {
+				return errors.Errorf("synonym '%s' in synonyms.sets[%d] must be a single term without whitespace", term, i)
+			}
+		}
+
+		if set.Weight < 0 || set.Weight > 1 {
+			return errors.Errorf("synonyms.sets[%d].weight must be between 0 and 1", i)
+		}
+	}
+
+	return nil
+}
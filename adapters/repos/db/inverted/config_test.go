@@ -163,6 +163,49 @@ func TestValidateConfig(t *testing.T) {
 			assert.Equal(t, test.expectedLength, len(in.Stopwords.Additions))
 		}
 	})
+
+	t.Run("with synonyms", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			set         *models.SynonymSet
+			expectedErr string
+		}{
+			{
+				name: "valid",
+				set:  &models.SynonymSet{Terms: []string{"tv", "television"}, Weight: 0.5},
+			},
+			{
+				name:        "single term",
+				set:         &models.SynonymSet{Terms: []string{"tv"}},
+				expectedErr: "synonyms.sets[0] must contain at least two terms",
+			},
+			{
+				name:        "multiple words",
+				set:         &models.SynonymSet{Terms: []string{"tv", "smart tv"}},
+				expectedErr: "synonym 'smart tv' in synonyms.sets[0] must be a single term without whitespace",
+			},
+			{
+				name:        "weight out of range",
+				set:         &models.SynonymSet{Terms: []string{"tv", "television"}, Weight: 1.5},
+				expectedErr: "synonyms.sets[0].weight must be between 0 and 1",
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				in := &models.InvertedIndexConfig{
+					Synonyms: &models.SynonymConfig{Sets: []*models.SynonymSet{test.set}},
+				}
+
+				err := ValidateConfig(in)
+				if test.expectedErr == "" {
+					assert.Nil(t, err)
+				} else {
+					assert.EqualError(t, err, test.expectedErr)
+				}
+			})
+		}
+	})
 }
 
 func TestConfigFromModel(t *testing.T) {
@@ -36,6 +36,11 @@ func ValidateUserConfigUpdate(initial, updated *models.InvertedIndexConfig) erro
 		return err
 	}
 
+	err = validateSynonymsConfigUpdate(initial, updated)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -65,6 +70,10 @@ func validateInvertedIndexConfigUpdate(initial, updated *models.InvertedIndexCon
 		return errors.New("IndexNullState cannot be changed when updating a schema")
 	}
 
+	if updated.IndexTermPositions != initial.IndexTermPositions {
+		return errors.New("IndexTermPositions cannot be changed when updating a schema")
+	}
+
 	return nil
 }
 
@@ -85,3 +94,12 @@ func validateStopwordsConfigUpdate(initial, updated *models.InvertedIndexConfig)
 
 	return nil
 }
+
+func validateSynonymsConfigUpdate(initial, updated *models.InvertedIndexConfig) error {
+	if updated.Synonyms == nil {
+		updated.Synonyms = initial.Synonyms
+		return nil
+	}
+
+	return validateSynonymConfig(updated.Synonyms)
+}
@@ -74,6 +74,41 @@ func TestValidateUserConfigUpdate(t *testing.T) {
 		assert.Equal(t, validInitial.Stopwords.Removals, updated.Stopwords.Removals)
 	})
 
+	t.Run("with valid updated Synonyms config", func(t *testing.T) {
+		synonyms := &models.SynonymConfig{
+			Sets: []*models.SynonymSet{{Terms: []string{"tv", "television"}}},
+		}
+		initial := &models.InvertedIndexConfig{
+			Bm25:      validInitial.Bm25,
+			Stopwords: validInitial.Stopwords,
+			Synonyms:  synonyms,
+		}
+
+		updated := &models.InvertedIndexConfig{
+			Synonyms: &models.SynonymConfig{
+				Sets: []*models.SynonymSet{{Terms: []string{"tv", "telly"}, Weight: 0.5}},
+			},
+		}
+		err := ValidateUserConfigUpdate(initial, updated)
+		require.Nil(t, err)
+
+		updated = &models.InvertedIndexConfig{}
+		err = ValidateUserConfigUpdate(initial, updated)
+		require.Nil(t, err)
+		assert.Equal(t, synonyms, updated.Synonyms)
+	})
+
+	t.Run("with invalid updated Synonyms config", func(t *testing.T) {
+		updated := &models.InvertedIndexConfig{
+			Synonyms: &models.SynonymConfig{
+				Sets: []*models.SynonymSet{{Terms: []string{"tv"}}},
+			},
+		}
+
+		err := ValidateUserConfigUpdate(validInitial, updated)
+		require.EqualError(t, err, "synonyms.sets[0] must contain at least two terms")
+	})
+
 	t.Run("with invalid cleanup interval", func(t *testing.T) {
 		updated := &models.InvertedIndexConfig{
 			CleanupIntervalSeconds: -1,
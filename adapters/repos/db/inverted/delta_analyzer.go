@@ -11,7 +11,10 @@
 
 package inverted
 
-import "bytes"
+import (
+	"bytes"
+	"slices"
+)
 
 type DeltaResults struct {
 	ToDelete []Property
@@ -201,7 +204,8 @@ func countableDelta(prev, next []Countable) ([]Countable, []Countable, bool) {
 
 	for _, nextItem := range next {
 		prev, ok := seenInPrev[string(nextItem.Data)]
-		if ok && prev.TermFrequency == nextItem.TermFrequency {
+		if ok && prev.TermFrequency == nextItem.TermFrequency &&
+			slices.Equal(prev.Positions, nextItem.Positions) {
 			cleaned = true
 			// we have an identical overlap, delete from old list
 			delete(seenInPrev, string(nextItem.Data))
@@ -233,7 +237,8 @@ func listsIdentical(a []Countable, b []Countable) bool {
 
 	for i := range a {
 		if !bytes.Equal(a[i].Data, b[i].Data) ||
-			a[i].TermFrequency != b[i].TermFrequency {
+			a[i].TermFrequency != b[i].TermFrequency ||
+			!slices.Equal(a[i].Positions, b[i].Positions) {
 			// return as soon as an item didn't match
 			return false
 		}
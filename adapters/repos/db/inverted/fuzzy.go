@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// DefaultFuzzyMaxExpansions is the number of dictionary terms a fuzzy query
+// term is expanded to, if the query does not set a cap itself
+const DefaultFuzzyMaxExpansions = 50
+
+// levenshteinAutomaton accepts all terms within maxEdits insertions,
+// deletions or substitutions of the query term. Its state is the row of edit
+// distances between the consumed input and every prefix of the query, which
+// allows it to tell early that no continuation of a prefix can match.
+type levenshteinAutomaton struct {
+	query    []rune
+	maxEdits int
+}
+
+func newLevenshteinAutomaton(query string, maxEdits int) *levenshteinAutomaton {
+	return &levenshteinAutomaton{query: []rune(query), maxEdits: maxEdits}
+}
+
+func (a *levenshteinAutomaton) start() []int {
+	row := make([]int, len(a.query)+1)
+	for i := range row {
+		row[i] = i
+	}
+	return row
+}
+
+func (a *levenshteinAutomaton) step(row []int, r rune) []int {
+	next := make([]int, len(row))
+	next[0] = row[0] + 1
+	for i := 1; i < len(row); i++ {
+		cost := 1
+		if a.query[i-1] == r {
+			cost = 0
+		}
+		next[i] = min(row[i]+1, next[i-1]+1, row[i-1]+cost)
+	}
+	return next
+}
+
+func (a *levenshteinAutomaton) canMatch(row []int) bool {
+	for _, distance := range row {
+		if distance <= a.maxEdits {
+			return true
+		}
+	}
+	return false
+}
+
+// run feeds the term into the automaton. It returns the edit distance of the
+// term to the query, or the length of the shortest prefix of the term which
+// no term starting with it can match, -1 if there is none.
+func (a *levenshteinAutomaton) run(term []byte) (distance int, deadPrefix int) {
+	row := a.start()
+	for pos := 0; pos < len(term); {
+		r, size := utf8.DecodeRune(term[pos:])
+		pos += size
+		row = a.step(row, r)
+		if !a.canMatch(row) {
+			return 0, pos
+		}
+	}
+	return row[len(row)-1], -1
+}
+
+// WithinEditDistance reports whether the term is at most maxEdits
+// insertions, deletions or substitutions away from the query term
+func WithinEditDistance(query, term string, maxEdits int) bool {
+	distance, deadPrefix := newLevenshteinAutomaton(query, maxEdits).run([]byte(term))
+	return deadPrefix < 0 && distance <= maxEdits
+}
+
+// fuzzyTermCursor iterates the sorted terms of a searchable bucket
+type fuzzyTermCursor interface {
+	First(ctx context.Context) ([]byte, []lsmkv.MapPair)
+	Seek(ctx context.Context, key []byte) ([]byte, []lsmkv.MapPair)
+	Next(ctx context.Context) ([]byte, []lsmkv.MapPair)
+}
+
+type fuzzyCandidate struct {
+	term     string
+	distance int
+	docFreq  int
+}
+
+// collect walks the terms of the cursor and adds all terms within the edit
+// distance, except the query term itself, to candidates. Whenever a prefix
+// cannot lead to a match anymore, all terms sharing it are skipped by seeking
+// past them.
+func (a *levenshteinAutomaton) collect(ctx context.Context, cursor fuzzyTermCursor,
+	candidates map[string]*fuzzyCandidate,
+) error {
+	key, pairs := cursor.First(ctx)
+	for key != nil {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		distance, deadPrefix := a.run(key)
+		if deadPrefix >= 0 {
+			next := prefixSuccessor(key[:deadPrefix])
+			if next == nil {
+				return nil
+			}
+			key, pairs = cursor.Seek(ctx, next)
+			continue
+		}
+
+		if distance > 0 && distance <= a.maxEdits {
+			if c, ok := candidates[string(key)]; ok {
+				c.docFreq += len(pairs)
+			} else {
+				candidates[string(key)] = &fuzzyCandidate{
+					term:     string(key),
+					distance: distance,
+					docFreq:  len(pairs),
+				}
+			}
+		}
+		key, pairs = cursor.Next(ctx)
+	}
+	return nil
+}
+
+// prefixSuccessor returns the smallest key that is larger than all keys
+// starting with prefix, nil if there is none
+func prefixSuccessor(prefix []byte) []byte {
+	out := bytes.Clone(prefix)
+	for i := len(out) - 1; i >= 0; i-- {
+		if out[i] < 0xff {
+			out[i]++
+			return out[:i+1]
+		}
+	}
+	return nil
+}
+
+// topFuzzyCandidates returns the terms of at most limit candidates, closest
+// matches first and more frequent terms first among equally close ones
+func topFuzzyCandidates(candidates map[string]*fuzzyCandidate, limit int) []string {
+	sorted := make([]*fuzzyCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		sorted = append(sorted, c)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].distance != sorted[j].distance {
+			return sorted[i].distance < sorted[j].distance
+		}
+		if sorted[i].docFreq != sorted[j].docFreq {
+			return sorted[i].docFreq > sorted[j].docFreq
+		}
+		return sorted[i].term < sorted[j].term
+	})
+
+	if len(sorted) > limit {
+		sorted = sorted[:limit]
+	}
+	out := make([]string, len(sorted))
+	for i, c := range sorted {
+		out[i] = c.term
+	}
+	return out
+}
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+type fakeTermCursor struct {
+	terms []string
+	pos   int
+	seeks int
+}
+
+func newFakeTermCursor(terms ...string) *fakeTermCursor {
+	sort.Strings(terms)
+	return &fakeTermCursor{terms: terms}
+}
+
+func (c *fakeTermCursor) First(ctx context.Context) ([]byte, []lsmkv.MapPair) {
+	c.pos = 0
+	return c.Next(ctx)
+}
+
+func (c *fakeTermCursor) Seek(ctx context.Context, key []byte) ([]byte, []lsmkv.MapPair) {
+	c.seeks++
+	c.pos = sort.Search(len(c.terms), func(i int) bool {
+		return bytes.Compare([]byte(c.terms[i]), key) >= 0
+	})
+	return c.Next(ctx)
+}
+
+func (c *fakeTermCursor) Next(ctx context.Context) ([]byte, []lsmkv.MapPair) {
+	if c.pos >= len(c.terms) {
+		return nil, nil
+	}
+	term := c.terms[c.pos]
+	c.pos++
+	// the term length doubles as its document frequency
+	return []byte(term), make([]lsmkv.MapPair, len(term))
+}
+
+func TestLevenshteinAutomaton(t *testing.T) {
+	type test struct {
+		query    string
+		term     string
+		distance int
+	}
+
+	tests := []test{
+		{query: "machine", term: "machine", distance: 0},
+		{query: "machine", term: "machin", distance: 1},
+		{query: "machine", term: "machines", distance: 1},
+		{query: "machine", term: "mashine", distance: 1},
+		{query: "machine", term: "amchine", distance: 2},
+		{query: "café", term: "cafe", distance: 1},
+		{query: "über", term: "uber", distance: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query+" "+test.term, func(t *testing.T) {
+			distance, deadPrefix := newLevenshteinAutomaton(test.query, 2).run([]byte(test.term))
+			assert.Equal(t, -1, deadPrefix)
+			assert.Equal(t, test.distance, distance)
+		})
+	}
+
+	t.Run("dead prefix", func(t *testing.T) {
+		_, deadPrefix := newLevenshteinAutomaton("machine", 1).run([]byte("mxyz"))
+		assert.Equal(t, 3, deadPrefix)
+	})
+
+	t.Run("within edit distance", func(t *testing.T) {
+		assert.True(t, WithinEditDistance("machine", "mashine", 1))
+		assert.False(t, WithinEditDistance("machine", "amchine", 1))
+		assert.False(t, WithinEditDistance("machine", "mac", 1))
+		assert.False(t, WithinEditDistance("machine", "mxyz", 2))
+	})
+}
+
+func TestLevenshteinAutomatonCollect(t *testing.T) {
+	cursor := newFakeTermCursor("learn", "learning", "leaning", "earning", "yearning", "leasing",
+		"machine", "machines", "mach", "xaaa", "xaab", "xaac", "xab", "zzz")
+
+	candidates := map[string]*fuzzyCandidate{}
+	require.Nil(t, newLevenshteinAutomaton("learning", 1).collect(context.Background(), cursor, candidates))
+	assert.ElementsMatch(t, []string{"leaning", "earning", "yearning"}, topFuzzyCandidates(candidates, 10))
+
+	candidates = map[string]*fuzzyCandidate{}
+	require.Nil(t, newLevenshteinAutomaton("learning", 2).collect(context.Background(), cursor, candidates))
+	// closest first, then the most frequent
+	assert.Equal(t, []string{"yearning", "earning", "leaning", "leasing"}, topFuzzyCandidates(candidates, 10))
+	assert.Equal(t, []string{"yearning"}, topFuzzyCandidates(candidates, 1))
+
+	t.Run("skips terms with a dead prefix", func(t *testing.T) {
+		cursor := newFakeTermCursor("xaaa", "xaab", "xaac", "xab", "xb", "zzz")
+		candidates := map[string]*fuzzyCandidate{}
+		require.Nil(t, newLevenshteinAutomaton("ab", 1).collect(context.Background(), cursor, candidates))
+		assert.Equal(t, []string{"xab", "xb"}, topFuzzyCandidates(candidates, 10))
+		// "xaa" cannot match, the other terms starting with it are skipped
+		assert.Equal(t, 2, cursor.seeks)
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := newLevenshteinAutomaton("learning", 1).collect(ctx, newFakeTermCursor("learning"), map[string]*fuzzyCandidate{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestPrefixSuccessor(t *testing.T) {
+	assert.Equal(t, []byte("ab"), prefixSuccessor([]byte("aa")))
+	assert.Equal(t, []byte("b"), prefixSuccessor([]byte{'a', 0xff}))
+	assert.Nil(t, prefixSuccessor([]byte{0xff, 0xff}))
+	assert.Nil(t, prefixSuccessor(nil))
+}
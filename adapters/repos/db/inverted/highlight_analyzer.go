@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// HighlightAnalyzer analyzes queries and property values for the highlight
+// additional property the same way they are analyzed by keyword searches, so
+// the highlighted terms are the ones an object was scored for
+type HighlightAnalyzer struct{}
+
+func NewHighlightAnalyzer() *HighlightAnalyzer {
+	return &HighlightAnalyzer{}
+}
+
+// QueryTerms returns the terms a property with the given tokenization is
+// searched for. Stopwords are removed and synonyms added like in BM25 search.
+func (a *HighlightAnalyzer) QueryTerms(class *models.Class, tokenization, query string) ([]string, error) {
+	var detector *stopwords.Detector
+	var expander *synonyms.Expander
+	if cfg := class.InvertedIndexConfig; cfg != nil {
+		if cfg.Stopwords != nil {
+			var err error
+			if detector, err = stopwords.NewDetectorFromConfig(*cfg.Stopwords); err != nil {
+				return nil, err
+			}
+		}
+		if cfg.Synonyms != nil {
+			expander = synonyms.NewExpanderFromConfig(*cfg.Synonyms)
+		}
+	}
+
+	var terms []string
+	for _, term := range helpers.Tokenize(tokenization, query) {
+		if tokenization == models.PropertyTokenizationWord && detector != nil && detector.IsStopword(term) {
+			continue
+		}
+		terms = append(terms, term)
+		if tokenization == models.PropertyTokenizationWord || tokenization == models.PropertyTokenizationLowercase {
+			for _, synonym := range expander.Expand(term) {
+				terms = append(terms, synonym.Term)
+			}
+		}
+	}
+	return terms, nil
+}
+
+// Matches tokenizes the value and returns the occurrences of the terms match
+// returns true for, with their text as found in the value
+func (a *HighlightAnalyzer) Matches(tokenization, value string, match func(term string) bool) []additional.HighlightMatch {
+	var runes []rune
+	var out []additional.HighlightMatch
+	for _, token := range helpers.TokenizeWithOffsets(tokenization, value) {
+		if !match(token.Term) {
+			continue
+		}
+		if runes == nil {
+			runes = []rune(value)
+		}
+		out = append(out, additional.HighlightMatch{
+			Term:  string(runes[token.Start:token.End]),
+			Start: token.Start,
+			End:   token.End,
+		})
+	}
+	return out
+}
+
+// WithinEditDistance reports whether the term is at most maxEdits edits away
+// from the query term, like the terms matched by fuzzy searches
+func (a *HighlightAnalyzer) WithinEditDistance(query, term string, maxEdits int) bool {
+	return WithinEditDistance(query, term, maxEdits)
+}
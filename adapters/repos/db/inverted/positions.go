@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// textArrayPositionGap is added to the position of the first token of every
+// element of a text array after the first one, so that phrases cannot match
+// across element boundaries
+const textArrayPositionGap = 100
+
+// EncodePositions delta-encodes the ascending token positions of a term as
+// uvarints
+func EncodePositions(positions []uint32) []byte {
+	out := make([]byte, 0, len(positions)*2)
+	prev := uint32(0)
+	for _, pos := range positions {
+		out = binary.AppendUvarint(out, uint64(pos-prev))
+		prev = pos
+	}
+	return out
+}
+
+// DecodePositions is the inverse of EncodePositions
+func DecodePositions(data []byte) ([]uint32, error) {
+	out := make([]uint32, 0, len(data))
+	prev := uint32(0)
+	for len(data) > 0 {
+		delta, n := binary.Uvarint(data)
+		if n <= 0 {
+			return nil, fmt.Errorf("decode term positions: invalid uvarint")
+		}
+		prev += uint32(delta)
+		out = append(out, prev)
+		data = data[n:]
+	}
+	return out, nil
+}
+
+// PhraseFrequency counts the positions at which a phrase starts in a
+// document. positions holds the ascending document positions of every phrase
+// term and offsets the positions of the same terms within the query, so that
+// terms removed from the query (e.g. stopwords) still account for the gaps
+// they leave. A match requires the terms to appear in query order, with the
+// gaps between them deviating from the gaps in the query by at most slop
+// positions in total.
+func PhraseFrequency(positions [][]uint32, offsets []int, slop int) int {
+	if len(positions) == 0 {
+		return 0
+	}
+
+	freq := 0
+	for _, start := range positions[0] {
+		if matchPhraseFrom(positions, offsets, 1, start, slop) {
+			freq++
+		}
+	}
+	return freq
+}
+
+func matchPhraseFrom(positions [][]uint32, offsets []int, term int, prev uint32, slop int) bool {
+	if term == len(positions) {
+		return true
+	}
+
+	want := int(prev) + offsets[term] - offsets[term-1]
+	for _, pos := range positions[term] {
+		if pos <= prev {
+			continue
+		}
+		cost := int(pos) - want
+		if cost > slop {
+			// positions are ascending, the remaining ones only get further away
+			break
+		}
+		if cost < 0 {
+			cost = -cost
+		}
+		if cost > slop {
+			continue
+		}
+		if matchPhraseFrom(positions, offsets, term+1, pos, slop-cost) {
+			return true
+		}
+	}
+	return false
+}
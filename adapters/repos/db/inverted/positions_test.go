@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPositionsEncoding(t *testing.T) {
+	for _, positions := range [][]uint32{
+		{},
+		{0},
+		{3, 4, 200, 201, 100000},
+	} {
+		decoded, err := DecodePositions(EncodePositions(positions))
+		require.Nil(t, err)
+		assert.Equal(t, positions, decoded)
+	}
+
+	_, err := DecodePositions([]byte{0x80})
+	assert.NotNil(t, err)
+}
+
+func TestPhraseFrequency(t *testing.T) {
+	type test struct {
+		name      string
+		positions [][]uint32
+		offsets   []int
+		slop      int
+		expected  int
+	}
+
+	tests := []test{
+		{
+			name:      "exact phrase",
+			positions: [][]uint32{{0, 7}, {1, 5}},
+			offsets:   []int{0, 1},
+			expected:  1,
+		},
+		{
+			name:      "exact phrase occurring twice",
+			positions: [][]uint32{{0, 7}, {1, 8}},
+			offsets:   []int{0, 1},
+			expected:  2,
+		},
+		{
+			name:      "terms in wrong order",
+			positions: [][]uint32{{1}, {0}},
+			offsets:   []int{0, 1},
+			slop:      3,
+			expected:  0,
+		},
+		{
+			name:      "terms too far apart",
+			positions: [][]uint32{{0}, {3}},
+			offsets:   []int{0, 1},
+			slop:      1,
+			expected:  0,
+		},
+		{
+			name:      "terms within slop",
+			positions: [][]uint32{{0}, {3}},
+			offsets:   []int{0, 1},
+			slop:      2,
+			expected:  1,
+		},
+		{
+			name:      "slop is shared between all gaps",
+			positions: [][]uint32{{0}, {2}, {4}},
+			offsets:   []int{0, 1, 2},
+			slop:      1,
+			expected:  0,
+		},
+		{
+			name:      "gap of a removed stopword",
+			positions: [][]uint32{{0}, {3}},
+			offsets:   []int{0, 3},
+			expected:  1,
+		},
+		{
+			name:      "closer than the gap of a removed stopword",
+			positions: [][]uint32{{0}, {1}},
+			offsets:   []int{0, 2},
+			slop:      1,
+			expected:  1,
+		},
+		{
+			name:      "picks the position within slop",
+			positions: [][]uint32{{0}, {2, 5}, {3}},
+			offsets:   []int{0, 1, 2},
+			slop:      1,
+			expected:  1,
+		},
+		{
+			name:      "repeated term",
+			positions: [][]uint32{{0, 1, 2}, {0, 1, 2}},
+			offsets:   []int{0, 1},
+			expected:  2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected,
+				PhraseFrequency(test.positions, test.offsets, test.slop))
+		})
+	}
+}
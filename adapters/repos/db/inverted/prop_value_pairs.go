@@ -116,6 +116,13 @@ func (pv *propValuePair) fetchDocIDs(ctx context.Context, s *Searcher, limit int
 		if err := eg.Wait(); err != nil {
 			return fmt.Errorf("nested query: %w", err)
 		}
+
+		if pv.operator == filters.OperatorNot {
+			// the negated operand is removed from all doc ids of the shard when
+			// merging
+			allDocIDs, release := s.bitmapFactory.GetBitmap()
+			pv.docIDs = docBitmap{docIDs: allDocIDs, release: release}
+		}
 	}
 
 	return nil
@@ -130,6 +137,8 @@ func (pv *propValuePair) mergeDocIDs() (*docBitmap, error) {
 	case filters.OperatorAnd:
 	case filters.OperatorOr:
 		// ok
+	case filters.OperatorNot:
+		return pv.mergeNotDocIDs()
 	default:
 		return nil, fmt.Errorf("unsupported operator: %s", pv.operator.Name())
 	}
@@ -174,6 +183,27 @@ func (pv *propValuePair) mergeDocIDs() (*docBitmap, error) {
 	return dbms[0], nil
 }
 
+// mergeNotDocIDs complements the doc ids of the only operand against the doc
+// ids of the shard, which were set while fetching.
+func (pv *propValuePair) mergeNotDocIDs() (*docBitmap, error) {
+	if len(pv.children) != 1 {
+		return nil, fmt.Errorf("operator %s requires exactly one operand, got %d",
+			pv.operator.Name(), len(pv.children))
+	}
+	if pv.docIDs.docIDs == nil {
+		return nil, fmt.Errorf("doc ids of the shard are not set for operator %s",
+			pv.operator.Name())
+	}
+
+	dbm, err := pv.children[0].mergeDocIDs()
+	if err != nil {
+		return nil, errors.Wrap(err, "retrieve doc bitmap of negated operand")
+	}
+	pv.docIDs.docIDs.AndNotConc(dbm.docIDs, concurrency.SROAR_MERGE)
+	dbm.release()
+	return &pv.docIDs, nil
+}
+
 func (pv *propValuePair) getBucketName() string {
 	if pv.hasRangeableIndex {
 		switch pv.operator {
@@ -153,3 +153,69 @@ func TestPropValuePairs_Merging(t *testing.T) {
 		}
 	})
 }
+
+func TestPropValuePairs_MergingNot(t *testing.T) {
+	allDocIDs := func() docBitmap {
+		return docBitmap{docIDs: sroar.Prefill(11), release: noopRelease}
+	}
+	leaf := func(ids ...uint64) *propValuePair {
+		return &propValuePair{
+			operator: filters.OperatorEqual,
+			docIDs:   docBitmap{docIDs: roaringset.NewBitmap(ids...), release: noopRelease},
+		}
+	}
+
+	t.Run("not on value", func(t *testing.T) {
+		pv := &propValuePair{
+			operator: filters.OperatorNot,
+			docIDs:   allDocIDs(),
+			children: []*propValuePair{leaf(1, 3, 5, 7, 9, 11)},
+		}
+
+		dbm, err := pv.mergeDocIDs()
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 2, 4, 6, 8, 10}, dbm.IDs())
+	})
+
+	t.Run("not (A and B)", func(t *testing.T) {
+		pv := &propValuePair{
+			operator: filters.OperatorNot,
+			docIDs:   allDocIDs(),
+			children: []*propValuePair{{
+				operator: filters.OperatorAnd,
+				children: []*propValuePair{leaf(7, 8, 9, 10, 11), leaf(1, 3, 5, 7, 9, 11)},
+			}},
+		}
+
+		dbm, err := pv.mergeDocIDs()
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 1, 2, 3, 4, 5, 6, 8, 10}, dbm.IDs())
+	})
+
+	t.Run("not not", func(t *testing.T) {
+		pv := &propValuePair{
+			operator: filters.OperatorNot,
+			docIDs:   allDocIDs(),
+			children: []*propValuePair{{
+				operator: filters.OperatorNot,
+				docIDs:   allDocIDs(),
+				children: []*propValuePair{leaf(2, 4)},
+			}},
+		}
+
+		dbm, err := pv.mergeDocIDs()
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{2, 4}, dbm.IDs())
+	})
+
+	t.Run("not with multiple operands", func(t *testing.T) {
+		pv := &propValuePair{
+			operator: filters.OperatorNot,
+			docIDs:   allDocIDs(),
+			children: []*propValuePair{leaf(1), leaf(2)},
+		}
+
+		_, err := pv.mergeDocIDs()
+		require.NotNil(t, err)
+	})
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package synonyms
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// Synonym is a term a query term is expanded with. Weight scales the score of
+// matches through the synonym relative to matches of the query term itself.
+type Synonym struct {
+	Term   string
+	Weight float64
+}
+
+// Expander looks up the synonyms of query terms
+type Expander struct {
+	synonyms map[string][]Synonym
+}
+
+// NewExpanderFromConfig builds an Expander from the synonym sets of a class.
+// Every term of a set is a synonym of all other terms of the same set. If a
+// term is a synonym of another term through several sets, the highest weight
+// wins.
+func NewExpanderFromConfig(config models.SynonymConfig) *Expander {
+	weights := map[string]map[string]float64{}
+	for _, set := range config.Sets {
+		if set == nil {
+			continue
+		}
+
+		weight := float64(set.Weight)
+		if weight == 0 {
+			weight = 1
+		}
+
+		for _, term := range set.Terms {
+			term = strings.ToLower(term)
+			for _, synonym := range set.Terms {
+				synonym = strings.ToLower(synonym)
+				if synonym == term {
+					continue
+				}
+				if weights[term] == nil {
+					weights[term] = map[string]float64{}
+				}
+				if weight > weights[term][synonym] {
+					weights[term][synonym] = weight
+				}
+			}
+		}
+	}
+
+	e := &Expander{synonyms: make(map[string][]Synonym, len(weights))}
+	for term, synonyms := range weights {
+		for synonym, weight := range synonyms {
+			e.synonyms[term] = append(e.synonyms[term], Synonym{Term: synonym, Weight: weight})
+		}
+		sort.Slice(e.synonyms[term], func(i, j int) bool {
+			return e.synonyms[term][i].Term < e.synonyms[term][j].Term
+		})
+	}
+	return e
+}
+
+// Expand returns the synonyms of the lowercased term, nil if there are none
+func (e *Expander) Expand(term string) []Synonym {
+	if e == nil {
+		return nil
+	}
+	return e.synonyms[term]
+}
@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package synonyms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestExpander(t *testing.T) {
+	e := NewExpanderFromConfig(models.SynonymConfig{
+		Sets: []*models.SynonymSet{
+			{Terms: []string{"tv", "Television"}},
+			{Terms: []string{"tv", "telly", "screen"}, Weight: 0.5},
+			{Terms: []string{"screen", "display", "monitor"}, Weight: 0.75},
+			nil,
+		},
+	})
+
+	t.Run("terms of a set are synonyms of each other", func(t *testing.T) {
+		assert.Equal(t, []Synonym{
+			{Term: "screen", Weight: 0.5},
+			{Term: "television", Weight: 1},
+			{Term: "telly", Weight: 0.5},
+		}, e.Expand("tv"))
+		assert.Equal(t, []Synonym{{Term: "tv", Weight: 1}}, e.Expand("television"))
+	})
+
+	t.Run("highest weight wins", func(t *testing.T) {
+		assert.Equal(t, []Synonym{
+			{Term: "display", Weight: 0.75},
+			{Term: "monitor", Weight: 0.75},
+			{Term: "telly", Weight: 0.5},
+			{Term: "tv", Weight: 0.5},
+		}, e.Expand("screen"))
+	})
+
+	t.Run("no synonyms", func(t *testing.T) {
+		assert.Nil(t, e.Expand("radio"))
+		assert.Nil(t, (*Expander)(nil).Expand("tv"))
+	})
+}
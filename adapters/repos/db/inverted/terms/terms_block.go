@@ -59,14 +59,21 @@ type BlockDataDecoded struct {
 type BlockData struct {
 	DocIds []byte
 	Tfs    []byte
+	// Positions holds the term positions of every document of the block, it
+	// is only set for segments which store term positions
+	Positions []byte
 }
 
 func (b *BlockData) Size() int {
-	return 2*2 + len(b.DocIds) + len(b.Tfs)
+	size := 2*2 + len(b.DocIds) + len(b.Tfs)
+	if b.Positions != nil {
+		size += 4 + len(b.Positions)
+	}
+	return size
 }
 
 func (b *BlockData) Encode() []byte {
-	out := make([]byte, len(b.DocIds)+len(b.Tfs)+4)
+	out := make([]byte, b.Size())
 	offset := 0
 	// write the lengths of the slices
 	binary.LittleEndian.PutUint16(out[offset:], uint16(len(b.DocIds)))
@@ -75,7 +82,15 @@ func (b *BlockData) Encode() []byte {
 	offset += 2
 
 	offset += copy(out[offset:], b.DocIds)
-	copy(out[offset:], b.Tfs)
+	offset += copy(out[offset:], b.Tfs)
+
+	if b.Positions != nil {
+		// positions of a block can easily exceed 64KB, so their length is
+		// stored with 4 bytes
+		binary.LittleEndian.PutUint32(out[offset:], uint32(len(b.Positions)))
+		offset += 4
+		copy(out[offset:], b.Positions)
+	}
 	return out
 }
 
@@ -94,3 +109,19 @@ func DecodeBlockDataReusable(data []byte, out *BlockData) {
 	out.DocIds = data[4 : 4+docIdsLen]
 	out.Tfs = data[4+docIdsLen : 4+docIdsLen+termFreqsLen]
 }
+
+// DecodeBlockDataWithPositions decodes blocks of segments which store term
+// positions
+func DecodeBlockDataWithPositions(data []byte) *BlockData {
+	out := &BlockData{}
+	DecodeBlockDataWithPositionsReusable(data, out)
+	return out
+}
+
+func DecodeBlockDataWithPositionsReusable(data []byte, out *BlockData) {
+	DecodeBlockDataReusable(data, out)
+	offset := 4 + len(out.DocIds) + len(out.Tfs)
+	positionsLen := int(binary.LittleEndian.Uint32(data[offset:]))
+	offset += 4
+	out.Positions = data[offset : offset+positionsLen]
+}
@@ -532,8 +532,12 @@ func (t *ShardReindexTask_MapToBlockmax) OnAfterLsmInitAsync(ctx context.Context
 				for _, invprop := range md.props {
 					if bucket, ok := bucketsByPropName[invprop.Name]; ok {
 						propLen := t.calcPropLenInverted(invprop.Items)
+						withPositions := storesTermPositions(bucket, shard.Index().invertedIndexConfig)
 						for _, item := range invprop.Items {
 							pair := shard.pairPropertyWithFrequency(md.docID, item.TermFrequency, propLen)
+							if withPositions {
+								pair = withTermPositions(pair, item)
+							}
 							if err := shard.addToPropertyMapBucket(bucket, pair, item.Data); err != nil {
 								breakCh <- true
 								err = fmt.Errorf("adding object '%s' prop '%s': %w", md.key.String(), invprop.Name, err)
@@ -945,8 +949,12 @@ func (t *ShardReindexTask_MapToBlockmax) duplicateToBuckets(shard *Shard, props
 		bucketName := bucketNamer(property.Name)
 		bucket := s.store.Bucket(bucketName)
 		propLen := calcPropLen(property.Items)
+		withPositions := storesTermPositions(bucket, s.index.invertedIndexConfig)
 		for _, item := range property.Items {
 			pair := s.pairPropertyWithFrequency(docID, item.TermFrequency, propLen)
+			if withPositions {
+				pair = withTermPositions(pair, item)
+			}
 			if err := s.addToPropertyMapBucket(bucket, pair, item.Data); err != nil {
 				return fmt.Errorf("adding prop '%s' to bucket '%s': %w", item.Data, bucketName, err)
 			}
@@ -35,7 +35,9 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/interval"
+	"github.com/weaviate/weaviate/entities/logarchive"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/storagestate"
@@ -143,6 +145,21 @@ type Bucket struct {
 	// introduces latency of segment availability, for the tradeoff of
 	// ensuring segment files have integrity before reading them.
 	enableChecksumValidation bool
+
+	// optional block compression of the data section of new segments, both
+	// flushed and compacted ones. Segments are readable regardless of this
+	// setting, as the compression is stored in the segment header.
+	compression segmentindex.Compression
+
+	// optional envelope encryption of segments and write-ahead-logs. New
+	// files are encrypted with the provider's current key, existing files
+	// are readable with any key the provider knows, plaintext files written
+	// before encryption was enabled remain readable.
+	encryption encryption.KeyProvider
+
+	// optional archiver which keeps write-ahead-logs after their memtable
+	// has been flushed
+	archiver logarchive.Archiver
 }
 
 func NewBucketCreator() *Bucket { return &Bucket{} }
@@ -210,6 +227,8 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 			maxSegmentSize:           b.maxSegmentSize,
 			cleanupInterval:          b.segmentsCleanupInterval,
 			enableChecksumValidation: b.enableChecksumValidation,
+			compression:              b.compression,
+			encryption:               b.encryption,
 		}, b.allocChecker)
 	if err != nil {
 		return nil, fmt.Errorf("init disk segments: %w", err)
@@ -1073,16 +1092,17 @@ func (b *Bucket) DeleteWith(key []byte, deletionTime time.Time, opts ...Secondar
 func (b *Bucket) setNewActiveMemtable() error {
 	path := filepath.Join(b.dir, fmt.Sprintf("segment-%d", time.Now().UnixNano()))
 
-	cl, err := newLazyCommitLogger(path)
+	cl, err := newLazyCommitLogger(path, b.encryption)
 	if err != nil {
 		return errors.Wrap(err, "init commit logger")
 	}
 
 	mt, err := newMemtable(path, b.strategy, b.secondaryIndices, cl,
-		b.metrics, b.logger, b.enableChecksumValidation)
+		b.metrics, b.logger, b.enableChecksumValidation, b.compression, b.encryption)
 	if err != nil {
 		return err
 	}
+	mt.archiver = b.archiver
 
 	b.active = mt
 	return nil
@@ -1553,7 +1573,7 @@ func (b *Bucket) DocPointerWithScoreList(ctx context.Context, key []byte, propBo
 	return terms.NewSortedDocPointerWithScoreMerger().Do(ctx, segments)
 }
 
-func (b *Bucket) CreateDiskTerm(N float64, filterDocIds helpers.AllowList, query []string, propName string, propertyBoost float32, duplicateTextBoosts []int, averagePropLength float64, config schema.BM25Config, ctx context.Context) ([][]*SegmentBlockMax, map[string]uint64, func(), error) {
+func (b *Bucket) CreateDiskTerm(N float64, filterDocIds helpers.AllowList, query []string, propName string, propertyBoost float32, duplicateTextBoosts []float64, averagePropLength float64, config schema.BM25Config, ctx context.Context) ([][]*SegmentBlockMax, map[string]uint64, func(), error) {
 	release := func() {}
 
 	defer func() {
@@ -1644,7 +1664,7 @@ func (b *Bucket) CreateDiskTerm(N float64, filterDocIds helpers.AllowList, query
 		}
 
 		// we can only know the full n after we have checked all segments and all memtables
-		idfs[i] = math.Log(float64(1)+(N-float64(n)+0.5)/(float64(n)+0.5)) * float64(duplicateTextBoosts[i])
+		idfs[i] = math.Log(float64(1)+(N-float64(n)+0.5)/(float64(n)+0.5)) * duplicateTextBoosts[i]
 
 		active.idf = idfs[i]
 		active.currentBlockImpact = float32(idfs[i])
@@ -15,6 +15,9 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/encryption"
+	"github.com/weaviate/weaviate/entities/logarchive"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 )
 
@@ -186,6 +189,50 @@ func WithForceCompaction(opt bool) BucketOption {
 	}
 }
 
+// WithBlockCompression compresses the data section of disk segments in
+// blocks, using either "snappy" or "zstd". It is only supported for the
+// replace, set and map strategies, so it needs to be passed after
+// WithStrategy.
+func WithBlockCompression(compression string) BucketOption {
+	return func(b *Bucket) error {
+		c, err := segmentindex.ParseCompression(compression)
+		if err != nil {
+			return err
+		}
+
+		if c != segmentindex.CompressionNone {
+			switch b.strategy {
+			case StrategyReplace, StrategySetCollection, StrategyMapCollection:
+			default:
+				return errors.Errorf("block compression not supported on %q buckets",
+					b.strategy)
+			}
+		}
+
+		b.compression = c
+		return nil
+	}
+}
+
+// WithLogArchiver hands write-ahead-logs to the given archiver before they
+// are deleted after a flush.
+func WithLogArchiver(a logarchive.Archiver) BucketOption {
+	return func(b *Bucket) error {
+		b.archiver = a
+		return nil
+	}
+}
+
+// WithEncryption encrypts new segments and write-ahead-logs with the current
+// key of the given provider. A nil provider disables encryption for new
+// files, existing encrypted files can then no longer be read.
+func WithEncryption(keys encryption.KeyProvider) BucketOption {
+	return func(b *Bucket) error {
+		b.encryption = keys
+		return nil
+	}
+}
+
 func WithDisableCompaction(disable bool) BucketOption {
 	return func(b *Bucket) error {
 		b.disableCompaction = disable
@@ -0,0 +1,361 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/lsmkv"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// PhraseFrequencyFunc returns how often a phrase occurs in a document. It is
+// passed the encoded term positions of the document for every term of the
+// phrase, in phrase order.
+type PhraseFrequencyFunc func(positions [][]byte) (int, error)
+
+// phraseTermIterator iterates the postings of a single phrase term in doc id
+// order together with their term positions
+type phraseTermIterator interface {
+	AdvanceAtLeast(docId uint64)
+	Advance()
+	IdPointer() uint64
+	Exhausted() bool
+	positions() []byte
+}
+
+// memtablePhraseIterator is the phraseTermIterator of a memtable posting list
+type memtablePhraseIterator struct {
+	pairs []MapPair
+	idx   int
+}
+
+func newMemtablePhraseIterator(m *Memtable, key []byte, tombstones *sroar.Bitmap) (*memtablePhraseIterator, error) {
+	pairs, err := m.getMap(key)
+	if err != nil && !errors.Is(err, lsmkv.NotFound) {
+		return nil, err
+	}
+
+	it := &memtablePhraseIterator{pairs: make([]MapPair, 0, len(pairs))}
+	for _, pair := range pairs {
+		if pair.Tombstone || len(pair.Value) < 8 {
+			continue
+		}
+		if tombstones != nil && tombstones.Contains(binary.BigEndian.Uint64(pair.Key)) {
+			continue
+		}
+		it.pairs = append(it.pairs, pair)
+	}
+	sort.Slice(it.pairs, func(a, b int) bool {
+		return binary.BigEndian.Uint64(it.pairs[a].Key) < binary.BigEndian.Uint64(it.pairs[b].Key)
+	})
+	return it, nil
+}
+
+func (it *memtablePhraseIterator) AdvanceAtLeast(docId uint64) {
+	for !it.Exhausted() && it.IdPointer() < docId {
+		it.idx++
+	}
+}
+
+func (it *memtablePhraseIterator) Advance() {
+	if !it.Exhausted() {
+		it.idx++
+	}
+}
+
+func (it *memtablePhraseIterator) IdPointer() uint64 {
+	if it.Exhausted() {
+		return math.MaxUint64
+	}
+	return binary.BigEndian.Uint64(it.pairs[it.idx].Key)
+}
+
+func (it *memtablePhraseIterator) Exhausted() bool {
+	return it.idx >= len(it.pairs)
+}
+
+func (it *memtablePhraseIterator) positions() []byte {
+	return termPositions(it.pairs[it.idx].Value)
+}
+
+// phraseMatch is a document matching a phrase
+type phraseMatch struct {
+	docId      uint64
+	freq       int
+	propLength uint32
+}
+
+// matchPhrase intersects the postings of all phrase terms and calls
+// phraseFrequency for every document containing all of them. Only the
+// postings which are needed for the intersection are read from the segments.
+func matchPhrase(iterators []phraseTermIterator, phraseFrequency PhraseFrequencyFunc,
+	propLength func(docId uint64) uint32,
+) ([]phraseMatch, error) {
+	for _, it := range iterators {
+		if it == nil || it.Exhausted() {
+			return nil, nil
+		}
+	}
+
+	var out []phraseMatch
+	positions := make([][]byte, len(iterators))
+	target := iterators[0].IdPointer()
+	for {
+		found := true
+		for _, it := range iterators {
+			it.AdvanceAtLeast(target)
+			if it.Exhausted() {
+				return out, nil
+			}
+			if id := it.IdPointer(); id != target {
+				target = id
+				found = false
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+
+		for i, it := range iterators {
+			positions[i] = it.positions()
+		}
+		freq, err := phraseFrequency(positions)
+		if err != nil {
+			return nil, err
+		}
+		if freq > 0 {
+			out = append(out, phraseMatch{
+				docId:      target,
+				freq:       freq,
+				propLength: propLength(target),
+			})
+		}
+
+		iterators[0].Advance()
+		if iterators[0].Exhausted() {
+			return out, nil
+		}
+		target = iterators[0].IdPointer()
+	}
+}
+
+// phraseMatches returns the documents matching the phrase per layer of the
+// bucket, ordered like the output of CreateDiskTerm: disk segments from
+// oldest to newest, followed by the flushing and the active memtable. Newer
+// layers shadow the documents of older ones through their tombstones, so
+// every document is part of a single layer at most.
+func (b *Bucket) phraseMatches(ctx context.Context, phrase []string,
+	phraseFrequency PhraseFrequencyFunc,
+) ([][]phraseMatch, error) {
+	if b.strategy != StrategyInverted {
+		return nil, errors.Errorf("phrase matching only supported for strategy %q, got %q",
+			StrategyInverted, b.strategy)
+	}
+
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	segmentsDisk, release := b.disk.getAndLockSegments()
+	defer release()
+
+	out := make([][]phraseMatch, len(segmentsDisk)+2)
+	tombstones := sroar.NewBitmap()
+
+	memtables := []*Memtable{b.flushing, b.active}
+	for i := len(memtables) - 1; i >= 0; i-- {
+		memtable := memtables[i]
+		if memtable == nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		iterators := make([]phraseTermIterator, len(phrase))
+		for j, term := range phrase {
+			it, err := newMemtablePhraseIterator(memtable, []byte(term), tombstones)
+			if err != nil {
+				return nil, err
+			}
+			iterators[j] = it
+		}
+		matches, err := matchPhrase(iterators, phraseFrequency, func(docId uint64) uint32 {
+			it := iterators[0].(*memtablePhraseIterator)
+			value := it.pairs[it.idx].Value
+			return uint32(math.Float32frombits(binary.LittleEndian.Uint32(value[4:8])))
+		})
+		if err != nil {
+			return nil, err
+		}
+		out[len(segmentsDisk)+i] = matches
+
+		memTombstones, err := memtable.ReadOnlyTombstones()
+		if err != nil && !errors.Is(err, lsmkv.NotFound) {
+			return nil, err
+		}
+		if memTombstones != nil {
+			tombstones.Or(memTombstones)
+		}
+	}
+
+	for i := len(segmentsDisk) - 1; i >= 0; i-- {
+		segment := segmentsDisk[i]
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if segment.strategy != segmentindex.StrategyInverted {
+			continue
+		}
+
+		// segments written without term positions cannot match any phrase
+		if segment.invertedHeader.HasTermPositions() {
+			propLengths, err := segment.GetPropertyLengths()
+			if err != nil {
+				return nil, err
+			}
+
+			iterators := make([]phraseTermIterator, len(phrase))
+			for j, term := range phrase {
+				if it := NewSegmentBlockMax(segment, []byte(term), j, 0, 1, tombstones, nil, 1, schema.BM25Config{}); it != nil {
+					iterators[j] = it
+				}
+			}
+			matches, err := matchPhrase(iterators, phraseFrequency, func(docId uint64) uint32 {
+				return propLengths[docId]
+			})
+			if err != nil {
+				return nil, err
+			}
+			out[i] = matches
+		}
+
+		segTombstones, err := segment.ReadOnlyTombstones()
+		if err != nil {
+			return nil, err
+		}
+		if segTombstones != nil {
+			tombstones.Or(segTombstones)
+		}
+	}
+
+	return out, nil
+}
+
+// CreatePhraseTerm is the equivalent of CreateDiskTerm for a phrase, which
+// is scored as a single term with the number of phrase occurrences as its
+// term frequency. The phrase matches are computed per segment, so the
+// returned release function is a no-op.
+func (b *Bucket) CreatePhraseTerm(N float64, filterDocIds helpers.AllowList, phrase []string,
+	phraseFrequency PhraseFrequencyFunc, queryTermIndex int, propertyBoost float32,
+	averagePropLength float64, config schema.BM25Config, ctx context.Context,
+) ([][]*SegmentBlockMax, map[string]uint64, func(), error) {
+	layers, err := b.phraseMatches(ctx, phrase, phraseFrequency)
+	if err != nil {
+		return nil, nil, func() {}, err
+	}
+
+	key := []byte(strings.Join(phrase, " "))
+
+	n := uint64(0)
+	for _, matches := range layers {
+		n += uint64(len(matches))
+	}
+	idf := math.Log(float64(1) + (N-float64(n)+0.5)/(float64(n)+0.5))
+
+	output := make([][]*SegmentBlockMax, len(layers))
+	for i, matches := range layers {
+		term := NewSegmentBlockMaxDecoded(key, queryTermIndex, propertyBoost, nil, averagePropLength, config)
+		addPhraseMatchesToTerm(matches, filterDocIds, term)
+		if term.Exhausted() {
+			continue
+		}
+		term.SetIdf(idf)
+		output[i] = []*SegmentBlockMax{term}
+	}
+
+	return output, map[string]uint64{string(key): n}, func() {}, nil
+}
+
+func addPhraseMatchesToTerm(matches []phraseMatch, filterDocIds helpers.AllowList, term *SegmentBlockMax) {
+	term.blockDataDecoded = &terms.BlockDataDecoded{
+		DocIds: make([]uint64, 0, len(matches)),
+		Tfs:    make([]uint64, 0, len(matches)),
+	}
+	term.propLengths = make(map[uint64]uint32, len(matches))
+
+	maxImpact := float32(0)
+	maxImpactEntry := &terms.BlockEntry{}
+	for _, match := range matches {
+		if filterDocIds != nil && !filterDocIds.Contains(match.docId) {
+			continue
+		}
+		term.blockDataDecoded.DocIds = append(term.blockDataDecoded.DocIds, match.docId)
+		term.blockDataDecoded.Tfs = append(term.blockDataDecoded.Tfs, uint64(match.freq))
+		term.propLengths[match.docId] = match.propLength
+
+		tf := float32(match.freq)
+		impact := tf / (tf + term.k1*(1-term.b+term.b*(float32(match.propLength)/term.averagePropLength)))
+		if impact > maxImpact {
+			maxImpact = impact
+			maxImpactEntry.MaxImpactTf = uint32(match.freq)
+			maxImpactEntry.MaxImpactPropLength = match.propLength
+		}
+	}
+	if len(term.blockDataDecoded.DocIds) == 0 {
+		return
+	}
+
+	maxImpactEntry.MaxId = term.blockDataDecoded.DocIds[len(term.blockDataDecoded.DocIds)-1]
+	term.exhausted = false
+	term.blockEntries = []*terms.BlockEntry{maxImpactEntry}
+	term.currentBlockMaxId = maxImpactEntry.MaxId
+	term.docCount = uint64(len(term.blockDataDecoded.DocIds))
+	term.blockDataSize = len(term.blockDataDecoded.DocIds)
+	term.idPointer = term.blockDataDecoded.DocIds[0]
+}
+
+// PhrasePostings returns the documents matching the phrase with the number of
+// phrase occurrences as their frequency, sorted by doc id
+func (b *Bucket) PhrasePostings(ctx context.Context, phrase []string,
+	phraseFrequency PhraseFrequencyFunc, propBoost float32,
+) ([]terms.DocPointerWithScore, error) {
+	layers, err := b.phraseMatches(ctx, phrase, phraseFrequency)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []terms.DocPointerWithScore
+	for _, matches := range layers {
+		for _, match := range matches {
+			out = append(out, terms.DocPointerWithScore{
+				Id:         match.docId,
+				Frequency:  float32(match.freq) * propBoost,
+				PropLength: float32(match.propLength),
+			})
+		}
+	}
+	sort.Slice(out, func(a, b int) bool {
+		return out[a].Id < out[b].Id
+	})
+	return out, nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/encryption"
 )
 
 var logOnceWhenRecoveringFromWAL sync.Once
@@ -83,7 +84,7 @@ func (b *Bucket) mayRecoverFromCommitLogs(ctx context.Context) error {
 	for _, fname := range walFileNames {
 		path := filepath.Join(b.dir, strings.TrimSuffix(fname, ".wal"))
 
-		cl, err := newCommitLogger(path)
+		cl, err := newCommitLogger(path, b.encryption)
 		if err != nil {
 			return errors.Wrap(err, "init commit logger")
 		}
@@ -93,12 +94,18 @@ func (b *Bucket) mayRecoverFromCommitLogs(ctx context.Context) error {
 		defer cl.unpause()
 
 		mt, err := newMemtable(path, b.strategy, b.secondaryIndices,
-			cl, b.metrics, b.logger, b.enableChecksumValidation)
+			cl, b.metrics, b.logger, b.enableChecksumValidation, b.compression, b.encryption)
 		if err != nil {
 			return err
 		}
+		mt.archiver = b.archiver
 
-		meteredReader := diskio.NewMeteredReader(bufio.NewReader(cl.file), b.metrics.TrackStartupReadWALDiskIO)
+		walReader, err := encryption.NewReader(cl.file, b.encryption)
+		if err != nil {
+			return errors.Wrapf(err, "open commit log %q", fname)
+		}
+
+		meteredReader := diskio.NewMeteredReader(bufio.NewReader(walReader), b.metrics.TrackStartupReadWALDiskIO)
 
 		err = newCommitLoggerParser(b.strategy, meteredReader, mt).Do()
 		if err != nil {
@@ -307,3 +307,41 @@ func TestBucketGetBySecondary(t *testing.T) {
 	_, err = b.GetBySecondary(1, []byte("bonjour"))
 	require.Error(t, err)
 }
+
+type retiredLogs struct {
+	paths    []string
+	contents [][]byte
+}
+
+func (r *retiredLogs) Retire(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	r.paths = append(r.paths, path)
+	r.contents = append(r.contents, data)
+	return nil
+}
+
+func TestBucketRetiresLogOnFlush(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	archiver := &retiredLogs{}
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace), WithLogArchiver(archiver))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, b.Shutdown(ctx))
+	})
+
+	require.NoError(t, b.Put([]byte("hello"), []byte("world")))
+	wal := b.active.path + ".wal"
+	require.NoError(t, b.FlushAndSwitch())
+
+	require.Equal(t, []string{wal}, archiver.paths)
+	assert.NotEmpty(t, archiver.contents[0])
+	assert.NoFileExists(t, wal)
+}
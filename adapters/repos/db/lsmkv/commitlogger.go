@@ -15,12 +15,15 @@ import (
 	"bufio"
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"sync"
 	"sync/atomic"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/usecases/integrity"
 )
 
@@ -43,6 +46,7 @@ var (
 
 type lazyCommitLogger struct {
 	path         string
+	keys         encryption.KeyProvider
 	commitLogger *commitLogger
 	mux          sync.Mutex
 }
@@ -55,7 +59,7 @@ func (cl *lazyCommitLogger) mayInitCommitLogger() error {
 		return nil
 	}
 
-	commitLogger, err := newCommitLogger(cl.path)
+	commitLogger, err := newCommitLogger(cl.path, cl.keys)
 	if err != nil {
 		return err
 	}
@@ -216,13 +220,14 @@ func (ct CommitType) Is(checkedCommitType CommitType) bool {
 	return ct == checkedCommitType
 }
 
-func newLazyCommitLogger(path string) (*lazyCommitLogger, error) {
+func newLazyCommitLogger(path string, keys encryption.KeyProvider) (*lazyCommitLogger, error) {
 	return &lazyCommitLogger{
 		path: path,
+		keys: keys,
 	}, nil
 }
 
-func newCommitLogger(path string) (*commitLogger, error) {
+func newCommitLogger(path string, keys encryption.KeyProvider) (*commitLogger, error) {
 	out := &commitLogger{
 		path: walPath(path),
 	}
@@ -234,7 +239,22 @@ func newCommitLogger(path string) (*commitLogger, error) {
 
 	out.file = f
 
-	out.writer = bufio.NewWriter(f)
+	var w io.Writer = f
+	if keys != nil {
+		sw, err := encryption.NewStreamWriter(f, keys)
+		switch {
+		case err == nil:
+			w = sw
+		case errors.Is(err, encryption.ErrNotEncrypted):
+			// a log written before encryption was enabled, which is only ever
+			// opened to be recovered
+		default:
+			f.Close()
+			return nil, fmt.Errorf("encrypt commit log %q: %w", out.path, err)
+		}
+	}
+
+	out.writer = bufio.NewWriter(w)
 	out.checksumWriter = integrity.NewCRC32Writer(out.writer)
 
 	out.bufNode = bytes.NewBuffer(nil)
@@ -159,7 +159,15 @@ func (c *compactorInverted) init() error {
 	// we will seek to the beginning and overwrite the actual header at the very
 	// end
 
-	if len(c.c1.segment.invertedHeader.DataFields) != len(c.c2.segment.invertedHeader.DataFields) {
+	// segments written before term positions were enabled for a property do
+	// not store them, any other difference in the data fields is an error
+	dataFields := c.c1.segment.invertedHeader.DataFields
+	otherFields := c.c2.segment.invertedHeader.DataFields
+	if len(otherFields) > len(dataFields) {
+		dataFields, otherFields = otherFields, dataFields
+	}
+	if len(dataFields) != len(otherFields) &&
+		(len(dataFields) != len(otherFields)+1 || dataFields[len(otherFields)] != varenc.TermPositions) {
 		return errors.Errorf("inverted header data fields mismatch: %d != %d",
 			len(c.c1.segment.invertedHeader.DataFields),
 			len(c.c2.segment.invertedHeader.DataFields))
@@ -168,10 +176,10 @@ func (c *compactorInverted) init() error {
 	if _, err := c.bufw.Write(make([]byte, segmentindex.HeaderSize)); err != nil {
 		return errors.Wrap(err, "write empty header")
 	}
-	if _, err := c.bufw.Write(make([]byte, segmentindex.SegmentInvertedDefaultHeaderSize+len(c.c1.segment.invertedHeader.DataFields))); err != nil {
+	if _, err := c.bufw.Write(make([]byte, segmentindex.SegmentInvertedDefaultHeaderSize+len(dataFields))); err != nil {
 		return errors.Wrap(err, "write empty inverted header")
 	}
-	c.offset = segmentindex.HeaderSize + segmentindex.SegmentInvertedDefaultHeaderSize + len(c.c1.segment.invertedHeader.DataFields)
+	c.offset = segmentindex.HeaderSize + segmentindex.SegmentInvertedDefaultHeaderSize + len(dataFields)
 
 	c.invertedHeader = &segmentindex.HeaderInverted{
 		// TODO: checksums currently not supported for StrategyInverted,
@@ -182,8 +190,8 @@ func (c *compactorInverted) init() error {
 		TombstoneOffset:       0,
 		PropertyLengthsOffset: 0,
 		BlockSize:             uint8(segmentindex.SegmentInvertedDefaultBlockSize),
-		DataFieldCount:        uint8(len(c.c1.segment.invertedHeader.DataFields)),
-		DataFields:            c.c1.segment.invertedHeader.DataFields,
+		DataFieldCount:        uint8(len(dataFields)),
+		DataFields:            dataFields,
 	}
 
 	c.docIdEncoder = varenc.GetVarEncEncoder64(c.invertedHeader.DataFields[0])
@@ -350,10 +358,11 @@ func (c *compactorInverted) writeIndividualNode(offset int, key []byte,
 	copy(keyCopy, key)
 
 	return segmentInvertedNode{
-		values:      values,
-		primaryKey:  keyCopy,
-		offset:      offset,
-		propLengths: propertyLengths,
+		values:        values,
+		primaryKey:    keyCopy,
+		offset:        offset,
+		propLengths:   propertyLengths,
+		withPositions: c.invertedHeader.HasTermPositions(),
 	}.KeyIndexAndWriteTo(c.bufw, c.docIdEncoder, c.tfEncoder)
 }
 
@@ -46,12 +46,13 @@ type compactorMap struct {
 	requiresSorting bool
 
 	enableChecksumValidation bool
+	compression              segmentindex.Compression
 }
 
 func newCompactorMapCollection(w io.WriteSeeker,
 	c1, c2 *segmentCursorCollectionReusable, level, secondaryIndexCount uint16,
 	scratchSpacePath string, requiresSorting bool, cleanupTombstones bool,
-	enableChecksumValidation bool,
+	enableChecksumValidation bool, compression segmentindex.Compression,
 ) *compactorMap {
 	return &compactorMap{
 		c1:                       c1,
@@ -64,6 +65,7 @@ func newCompactorMapCollection(w io.WriteSeeker,
 		scratchSpacePath:         scratchSpacePath,
 		requiresSorting:          requiresSorting,
 		enableChecksumValidation: enableChecksumValidation,
+		compression:              compression,
 	}
 }
 
@@ -75,6 +77,7 @@ func (c *compactorMap) do() error {
 	segmentFile := segmentindex.NewSegmentFile(
 		segmentindex.WithBufferedWriter(c.bufw),
 		segmentindex.WithChecksumsDisabled(!c.enableChecksumValidation),
+		segmentindex.WithCompression(c.compression),
 	)
 
 	kis, err := c.writeKeys(segmentFile)
@@ -98,7 +101,7 @@ func (c *compactorMap) do() error {
 
 	version := segmentindex.ChooseHeaderVersion(c.enableChecksumValidation)
 	if err := c.writeHeader(segmentFile, c.currentLevel, version,
-		c.secondaryIndexCount, dataEnd); err != nil {
+		c.secondaryIndexCount, segmentFile.IndexStart(dataEnd)); err != nil {
 		return errors.Wrap(err, "write header")
 	}
 
@@ -272,6 +275,7 @@ func (c *compactorMap) writeHeader(f *segmentindex.SegmentFile,
 		SecondaryIndices: secondaryIndices,
 		Strategy:         segmentindex.StrategyMapCollection,
 		IndexStart:       startOfIndex,
+		Compression:      c.compression,
 	}
 	// We have to write directly to compactor writer,
 	// since it has seeked back to start. The following
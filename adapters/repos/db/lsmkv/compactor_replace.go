@@ -41,12 +41,13 @@ type compactorReplace struct {
 	scratchSpacePath string
 
 	enableChecksumValidation bool
+	compression              segmentindex.Compression
 }
 
 func newCompactorReplace(w io.WriteSeeker,
 	c1, c2 *segmentCursorReplace, level, secondaryIndexCount uint16,
 	scratchSpacePath string, cleanupTombstones bool,
-	enableChecksumValidation bool,
+	enableChecksumValidation bool, compression segmentindex.Compression,
 ) *compactorReplace {
 	return &compactorReplace{
 		c1:                       c1,
@@ -58,6 +59,7 @@ func newCompactorReplace(w io.WriteSeeker,
 		secondaryIndexCount:      secondaryIndexCount,
 		scratchSpacePath:         scratchSpacePath,
 		enableChecksumValidation: enableChecksumValidation,
+		compression:              compression,
 	}
 }
 
@@ -69,6 +71,7 @@ func (c *compactorReplace) do() error {
 	segmentFile := segmentindex.NewSegmentFile(
 		segmentindex.WithBufferedWriter(c.bufw),
 		segmentindex.WithChecksumsDisabled(!c.enableChecksumValidation),
+		segmentindex.WithCompression(c.compression),
 	)
 
 	kis, err := c.writeKeys(segmentFile)
@@ -92,7 +95,7 @@ func (c *compactorReplace) do() error {
 
 	version := segmentindex.ChooseHeaderVersion(c.enableChecksumValidation)
 	if err := c.writeHeader(segmentFile, c.currentLevel,
-		version, c.secondaryIndexCount, dataEnd); err != nil {
+		version, c.secondaryIndexCount, segmentFile.IndexStart(dataEnd)); err != nil {
 		return fmt.Errorf("write header: %w", err)
 	}
 
@@ -219,6 +222,7 @@ func (c *compactorReplace) writeHeader(f *segmentindex.SegmentFile,
 		SecondaryIndices: secondaryIndices,
 		Strategy:         segmentindex.StrategyReplace,
 		IndexStart:       startOfIndex,
+		Compression:      c.compression,
 	}
 	// We have to write directly to compactor writer,
 	// since it has seeked back to start. The following
@@ -41,12 +41,13 @@ type compactorSet struct {
 	scratchSpacePath string
 
 	enableChecksumValidation bool
+	compression              segmentindex.Compression
 }
 
 func newCompactorSetCollection(w io.WriteSeeker,
 	c1, c2 *segmentCursorCollection, level, secondaryIndexCount uint16,
 	scratchSpacePath string, cleanupTombstones bool,
-	enableChecksumValidation bool,
+	enableChecksumValidation bool, compression segmentindex.Compression,
 ) *compactorSet {
 	return &compactorSet{
 		c1:                       c1,
@@ -58,6 +59,7 @@ func newCompactorSetCollection(w io.WriteSeeker,
 		secondaryIndexCount:      secondaryIndexCount,
 		scratchSpacePath:         scratchSpacePath,
 		enableChecksumValidation: enableChecksumValidation,
+		compression:              compression,
 	}
 }
 
@@ -69,6 +71,7 @@ func (c *compactorSet) do() error {
 	segmentFile := segmentindex.NewSegmentFile(
 		segmentindex.WithBufferedWriter(c.bufw),
 		segmentindex.WithChecksumsDisabled(!c.enableChecksumValidation),
+		segmentindex.WithCompression(c.compression),
 	)
 
 	kis, err := c.writeKeys(segmentFile)
@@ -92,7 +95,7 @@ func (c *compactorSet) do() error {
 
 	version := segmentindex.ChooseHeaderVersion(c.enableChecksumValidation)
 	if err := c.writeHeader(segmentFile, c.currentLevel,
-		version, c.secondaryIndexCount, dataEnd); err != nil {
+		version, c.secondaryIndexCount, segmentFile.IndexStart(dataEnd)); err != nil {
 		return errors.Wrap(err, "write header")
 	}
 
@@ -214,6 +217,7 @@ func (c *compactorSet) writeHeader(f *segmentindex.SegmentFile,
 		SecondaryIndices: secondaryIndices,
 		Strategy:         segmentindex.StrategySetCollection,
 		IndexStart:       startOfIndex,
+		Compression:      c.compression,
 	}
 	// We have to write directly to compactor writer,
 	// since it has seeked back to start. The following
@@ -14,15 +14,15 @@ package lsmkv
 import (
 	"encoding/binary"
 
-	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
 type segmentCursorInvertedReusable struct {
-	segment     *segment
-	nextOffset  uint64
-	nodeBuf     *binarySearchNodeMap
-	propLengths map[uint64]uint32
+	segment       *segment
+	nextOffset    uint64
+	nodeBuf       *binarySearchNodeMap
+	propLengths   map[uint64]uint32
+	withPositions bool
 }
 
 func (s *segment) newInvertedCursorReusable() *segmentCursorInvertedReusable {
@@ -31,8 +31,9 @@ func (s *segment) newInvertedCursorReusable() *segmentCursorInvertedReusable {
 		return nil
 	}
 	return &segmentCursorInvertedReusable{
-		segment:     s,
-		propLengths: propLengths,
+		segment:       s,
+		propLengths:   propLengths,
+		withPositions: s.invertedHeader.HasTermPositions(),
 	}
 }
 
@@ -42,13 +43,13 @@ func (s *segmentCursorInvertedReusable) seek(key []byte) ([]byte, []MapPair, err
 		return nil, nil, err
 	}
 
-	err = s.parseInvertedNodeInto(nodeOffset{node.Start, node.End})
+	// the end of the index node includes the key, while the parser expects
+	// the end of the values, so let it determine the end itself
+	err = s.parseInvertedNodeInto(nodeOffset{start: node.Start})
 	if err != nil {
 		return s.nodeBuf.key, nil, err
 	}
 
-	s.nextOffset = node.End
-
 	return s.nodeBuf.key, s.nodeBuf.values, nil
 }
 
@@ -92,7 +93,7 @@ func (s *segmentCursorInvertedReusable) parseInvertedNodeInto(offset nodeOffset)
 		}
 		docCount := binary.LittleEndian.Uint64(buffer[:8])
 		end := uint64(20)
-		if docCount > uint64(terms.ENCODE_AS_FULL_BYTES) {
+		if docCount > uint64(encodeSingleSeparate(s.withPositions)) {
 			end = binary.LittleEndian.Uint64(buffer[8:16]) + 16
 		}
 		offset.end = offset.start + end + 4
@@ -110,7 +111,7 @@ func (s *segmentCursorInvertedReusable) parseInvertedNodeInto(offset nodeOffset)
 		return err
 	}
 
-	nodes, _ := decodeAndConvertFromBlocks(allBytes)
+	nodes, _ := decodeAndConvertFromBlocks(allBytes, s.withPositions)
 
 	keyLen := binary.LittleEndian.Uint32(allBytes[len(allBytes)-4:])
 
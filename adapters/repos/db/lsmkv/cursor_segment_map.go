@@ -158,5 +158,5 @@ func (s *segmentCursorMap) parseInvertedNode(offset nodeOffset) (segmentCollecti
 	if err != nil {
 		return segmentCollectionNode{}, err
 	}
-	return ParseInvertedNode(r)
+	return ParseInvertedNode(r, s.segment.invertedHeader.HasTermPositions())
 }
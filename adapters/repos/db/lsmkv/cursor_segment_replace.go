@@ -164,8 +164,7 @@ func (s *segmentCursorReplace) seek(key []byte) ([]byte, []byte, error) {
 
 	s.currOffset = node.Start
 
-	err = s.parseReplaceNodeInto(nodeOffset{start: node.Start, end: node.End},
-		s.segment.contents[node.Start:node.End])
+	err = s.parseReplaceNodeInto(nodeOffset{start: node.Start, end: node.End})
 	if err != nil {
 		return s.keyFn(s.reusableNode), nil, err
 	}
@@ -185,8 +184,7 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 
 	s.currOffset = nextOffset
 
-	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset},
-		s.segment.contents[s.currOffset:])
+	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset})
 	if err != nil {
 		return s.keyFn(s.reusableNode), nil, err
 	}
@@ -202,8 +200,7 @@ func (s *segmentCursorReplace) first() ([]byte, []byte, error) {
 
 	s.currOffset = firstOffset
 
-	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset},
-		s.segment.contents[s.currOffset:])
+	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset})
 	if err != nil {
 		return s.keyFn(s.reusableNode), nil, err
 	}
@@ -257,9 +254,12 @@ func (s *segmentCursorReplace) parseReplaceNode(offset nodeOffset) (segmentRepla
 	return out, err
 }
 
-func (s *segmentCursorReplace) parseReplaceNodeInto(offset nodeOffset, buf []byte) error {
-	if s.segment.mmapContents {
-		return s.parse(buf)
+func (s *segmentCursorReplace) parseReplaceNodeInto(offset nodeOffset) error {
+	if s.segment.mmapContents && s.segment.blocks == nil {
+		if offset.end != 0 {
+			return s.parse(s.segment.contents[offset.start:offset.end])
+		}
+		return s.parse(s.segment.contents[offset.start:])
 	}
 
 	r, err := s.segment.newNodeReader(offset)
@@ -21,8 +21,11 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringsetrange"
+	"github.com/weaviate/weaviate/entities/encryption"
+	"github.com/weaviate/weaviate/entities/logarchive"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
@@ -48,11 +51,15 @@ type Memtable struct {
 	tombstones *sroar.Bitmap
 
 	enableChecksumValidation bool
+	compression              segmentindex.Compression
+	encryption               encryption.KeyProvider
+	archiver                 logarchive.Archiver
 }
 
 func newMemtable(path string, strategy string, secondaryIndices uint16,
 	cl memtableCommitLogger, metrics *Metrics, logger logrus.FieldLogger,
-	enableChecksumValidation bool,
+	enableChecksumValidation bool, compression segmentindex.Compression,
+	keys encryption.KeyProvider,
 ) (*Memtable, error) {
 	m := &Memtable{
 		key:                      &binarySearchTree{},
@@ -69,6 +76,8 @@ func newMemtable(path string, strategy string, secondaryIndices uint16,
 		createdAt:                time.Now(),
 		metrics:                  newMemtableMetrics(metrics, filepath.Dir(path), strategy),
 		enableChecksumValidation: enableChecksumValidation,
+		compression:              compression,
+		encryption:               keys,
 	}
 
 	if m.secondaryIndices > 0 {
@@ -14,7 +14,7 @@ package lsmkv
 import (
 	"bufio"
 	"fmt"
-	"os"
+	"io"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
@@ -41,14 +41,16 @@ func (m *Memtable) flush() error {
 		return nil
 	}
 
-	f, err := os.OpenFile(m.path+".db", os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
+	f, err := createSegmentFile(m.path+".db", m.encryption)
 	if err != nil {
 		return err
 	}
 	bufw := bufio.NewWriter(f)
+	compression := m.blockCompression()
 	segmentFile := segmentindex.NewSegmentFile(
 		segmentindex.WithBufferedWriter(bufw),
 		segmentindex.WithChecksumsDisabled(!m.enableChecksumValidation),
+		segmentindex.WithCompression(compression),
 	)
 
 	var keys []segmentindex.Key
@@ -109,6 +111,12 @@ func (m *Memtable) flush() error {
 		}
 	}
 
+	if compression != segmentindex.CompressionNone {
+		if err := m.rewriteCompressedHeader(f, bufw, segmentFile); err != nil {
+			return err
+		}
+	}
+
 	// TODO: Currently no checksum validation support for StrategyInverted.
 	//       This condition can be removed once support is added, and for
 	//       all strategies we can simply `segmentFile.WriteChecksum()`
@@ -129,9 +137,52 @@ func (m *Memtable) flush() error {
 	// only now that the file has been flushed is it safe to delete the commit log
 	// TODO: there might be an interest in keeping the commit logs around for
 	// longer as they might come in handy for replication
+	if m.archiver != nil {
+		if err := m.archiver.Retire(m.path + ".wal"); err != nil {
+			return fmt.Errorf("archive commit log: %w", err)
+		}
+	}
 	return m.commitlog.delete()
 }
 
+// blockCompression returns the compression to be used for the flushed
+// segment, only strategies with a replace or collection layout support it
+func (m *Memtable) blockCompression() segmentindex.Compression {
+	switch m.strategy {
+	case StrategyReplace, StrategySetCollection, StrategyMapCollection:
+		return m.compression
+	default:
+		return segmentindex.CompressionNone
+	}
+}
+
+// rewriteCompressedHeader overwrites the header that was written before the
+// data. For compressed segments the physical start of the indexes is only
+// known once all blocks were written.
+func (m *Memtable) rewriteCompressedHeader(f io.WriteSeeker, bufw *bufio.Writer,
+	segmentFile *segmentindex.SegmentFile,
+) error {
+	header := segmentFile.Header()
+	header.IndexStart = segmentFile.IndexStart(header.IndexStart)
+
+	// flush buffered, so we can safely seek on underlying writer
+	if err := bufw.Flush(); err != nil {
+		return fmt.Errorf("flush buffered: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("seek to beginning to write header: %w", err)
+	}
+	if _, err := header.WriteTo(f); err != nil {
+		return fmt.Errorf("write header: %w", err)
+	}
+	if _, err := f.Seek(0, io.SeekEnd); err != nil {
+		return fmt.Errorf("seek to end after writing header: %w", err)
+	}
+	bufw.Reset(f)
+
+	return nil
+}
+
 func (m *Memtable) flushDataReplace(f *segmentindex.SegmentFile) ([]segmentindex.Key, error) {
 	flat := m.key.flattenInOrder()
 
@@ -144,6 +195,7 @@ func (m *Memtable) flushDataReplace(f *segmentindex.SegmentFile) ([]segmentindex
 		Version:          segmentindex.ChooseHeaderVersion(m.enableChecksumValidation),
 		SecondaryIndices: m.secondaryIndices,
 		Strategy:         SegmentStrategyFromString(m.strategy),
+		Compression:      m.compression,
 	}
 
 	n, err := f.WriteHeader(header)
@@ -221,6 +273,7 @@ func (m *Memtable) flushDataCollection(f *segmentindex.SegmentFile,
 		Version:          segmentindex.ChooseHeaderVersion(m.enableChecksumValidation),
 		SecondaryIndices: m.secondaryIndices,
 		Strategy:         SegmentStrategyFromString(m.strategy),
+		Compression:      m.compression,
 	}
 
 	n, err := f.WriteHeader(header)
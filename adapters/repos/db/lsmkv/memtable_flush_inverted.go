@@ -18,14 +18,13 @@ import (
 	"encoding/gob"
 	"io"
 	"math"
-	"os"
 
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/varenc"
 )
 
-func (m *Memtable) flushDataInverted(f *bufio.Writer, ff *os.File) ([]segmentindex.Key, *sroar.Bitmap, error) {
+func (m *Memtable) flushDataInverted(f *bufio.Writer, ff segmentWriteFile) ([]segmentindex.Key, *sroar.Bitmap, error) {
 	m.RLock()
 	flatA := m.keyMap.flattenInOrder()
 	m.RUnlock()
@@ -42,6 +41,9 @@ func (m *Memtable) flushDataInverted(f *bufio.Writer, ff *os.File) ([]segmentind
 	propLengthSum := uint64(0)
 	propLengthCount := uint64(0)
 
+	// term positions are stored by all postings of a bucket or by none
+	withPositions := false
+
 	for i, mapNode := range flatA {
 		flat[i] = &binarySearchNodeMap{
 			key:    mapNode.key,
@@ -53,6 +55,7 @@ func (m *Memtable) flushDataInverted(f *bufio.Writer, ff *os.File) ([]segmentind
 			if !mapNode.values[j].Tombstone {
 				fieldLength := math.Float32frombits(binary.LittleEndian.Uint32(mapNode.values[j].Value[4:]))
 				flat[i].values = append(flat[i].values, mapNode.values[j])
+				withPositions = withPositions || len(termPositions(mapNode.values[j].Value)) > 0
 				actuallyWritten++
 				actuallyWrittenKeys[string(mapNode.key)] = struct{}{}
 				if _, ok := docIdsLengths[docId]; !ok {
@@ -92,6 +95,11 @@ func (m *Memtable) flushDataInverted(f *bufio.Writer, ff *os.File) ([]segmentind
 		DataFieldCount:        uint8(segmentindex.SegmentInvertedDefaultFieldCount),
 		DataFields:            []varenc.VarEncDataType{varenc.DeltaVarIntUint64, varenc.VarIntUint64},
 	}
+	if withPositions {
+		headerInverted.DataFieldCount++
+		headerInverted.DataFields = append(headerInverted.DataFields, varenc.TermPositions)
+		headerInverted.KeysOffset++
+	}
 
 	docIdEncoder := varenc.GetVarEncEncoder64(headerInverted.DataFields[0])
 	tfEncoder := varenc.GetVarEncEncoder64(headerInverted.DataFields[1])
@@ -124,7 +132,7 @@ func (m *Memtable) flushDataInverted(f *bufio.Writer, ff *os.File) ([]segmentind
 				ValueStart: totalWritten,
 			}
 
-			blocksEncoded, _ := createAndEncodeBlocksWithLengths(mapNode.values, docIdEncoder, tfEncoder)
+			blocksEncoded, _ := createAndEncodeBlocksWithLengths(mapNode.values, withPositions, docIdEncoder, tfEncoder)
 
 			if _, err := f.Write(blocksEncoded); err != nil {
 				return nil, nil, err
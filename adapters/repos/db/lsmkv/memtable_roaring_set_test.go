@@ -18,6 +18,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 )
 
@@ -28,10 +29,10 @@ func TestMemtableRoaringSet(t *testing.T) {
 	}
 
 	t.Run("inserting individual entries", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath())
+		cl, err := newCommitLogger(memPath(), nil)
 		require.NoError(t, err)
 
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -60,10 +61,10 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("inserting lists", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath())
+		cl, err := newCommitLogger(memPath(), nil)
 		require.NoError(t, err)
 
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -90,10 +91,10 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("inserting bitmaps", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath())
+		cl, err := newCommitLogger(memPath(), nil)
 		require.NoError(t, err)
 
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -122,10 +123,10 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("removing individual entries", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath())
+		cl, err := newCommitLogger(memPath(), nil)
 		require.NoError(t, err)
 
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -148,10 +149,10 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("removing lists", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath())
+		cl, err := newCommitLogger(memPath(), nil)
 		require.NoError(t, err)
 
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -178,10 +179,10 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("removing bitmaps", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath())
+		cl, err := newCommitLogger(memPath(), nil)
 		require.NoError(t, err)
 
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
@@ -208,10 +209,10 @@ func TestMemtableRoaringSet(t *testing.T) {
 	})
 
 	t.Run("adding/removing slices", func(t *testing.T) {
-		cl, err := newCommitLogger(memPath())
+		cl, err := newCommitLogger(memPath(), nil)
 		require.NoError(t, err)
 
-		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false)
+		m, err := newMemtable(memPath(), StrategyRoaringSet, 0, cl, nil, logger, false, segmentindex.CompressionNone, nil)
 		require.Nil(t, err)
 
 		key1, key2 := []byte("key1"), []byte("key2")
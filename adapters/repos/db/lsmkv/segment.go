@@ -23,6 +23,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	entsentry "github.com/weaviate/weaviate/entities/sentry"
 	"github.com/willf/bloom"
@@ -38,7 +39,7 @@ type segment struct {
 	dataStartPos        uint64
 	dataEndPos          uint64
 	contents            []byte
-	contentFile         *os.File
+	contentFile         segmentContentFile
 	strategy            segmentindex.Strategy
 	index               diskIndex
 	secondaryIndices    []diskIndex
@@ -46,6 +47,9 @@ type segment struct {
 	metrics             *Metrics
 	size                int64
 	mmapContents        bool
+	// set for encrypted segments whose data section is not held in contents,
+	// it has to be read through contentFile
+	partialContents bool
 
 	useBloomFilter        bool // see bucket for more datails
 	bloomFilter           *bloom.BloomFilter
@@ -58,6 +62,10 @@ type segment struct {
 
 	invertedHeader *segmentindex.HeaderInverted
 	invertedData   *segmentInvertedData
+
+	// only set for segments written with block compression, all reads of the
+	// data section need to go through it
+	blocks *segmentBlocks
 }
 
 type diskIndex interface {
@@ -86,6 +94,7 @@ type segmentConfig struct {
 	calcCountNetAdditions    bool
 	overwriteDerived         bool
 	enableChecksumValidation bool
+	encryption               encryption.KeyProvider
 }
 
 // newSegment creates a new segment structure, representing an LSM disk segment.
@@ -116,12 +125,12 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 	if err != nil {
 		return nil, fmt.Errorf("stat file: %w", err)
 	}
-	size := fileInfo.Size()
 
-	contents, err := mmap.MapRegion(file, int(fileInfo.Size()), mmap.RDONLY, 0, 0)
+	mapped, err := mapSegmentContents(file, fileInfo.Size(), cfg.encryption)
 	if err != nil {
-		return nil, fmt.Errorf("mmap file: %w", err)
+		return nil, err
 	}
+	contents, size := mapped.contents, mapped.size
 
 	header, err := segmentindex.ParseHeader(bytes.NewReader(contents[:segmentindex.HeaderSize]))
 	if err != nil {
@@ -133,7 +142,12 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 	}
 
 	if header.Version >= segmentindex.SegmentV1 && cfg.enableChecksumValidation {
-		segmentFile := segmentindex.NewSegmentFile(segmentindex.WithReader(file))
+		var checksumSource io.Reader = file
+		if mapped.encrypted {
+			checksumSource = io.NewSectionReader(mapped.file, 0, size)
+			fileInfo = plaintextFileInfo{FileInfo: fileInfo, size: size}
+		}
+		segmentFile := segmentindex.NewSegmentFile(segmentindex.WithReader(checksumSource))
 		if err := segmentFile.ValidateChecksum(fileInfo); err != nil {
 			return nil, fmt.Errorf("validate segment %q: %w", path, err)
 		}
@@ -151,7 +165,7 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 
 	var invertedHeader *segmentindex.HeaderInverted
 	if header.Strategy == segmentindex.StrategyInverted {
-		invertedHeader, err = segmentindex.LoadHeaderInverted(contents[segmentindex.HeaderSize : segmentindex.HeaderSize+segmentindex.HeaderInvertedSize])
+		invertedHeader, err = segmentindex.LoadHeaderInverted(contents[segmentindex.HeaderSize:])
 		if err != nil {
 			return nil, errors.Wrap(err, "load inverted header")
 		}
@@ -159,6 +173,10 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		dataEndPos = invertedHeader.TombstoneOffset
 	}
 
+	// the data section of encrypted segments is not held in memory, it is
+	// decrypted on demand when reading from the content file
+	mmapContents := cfg.mmapContents && !mapped.partial
+
 	seg := &segment{
 		level:                 header.Level,
 		path:                  path,
@@ -174,7 +192,8 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		logger:                logger,
 		metrics:               metrics,
 		size:                  size,
-		mmapContents:          cfg.mmapContents,
+		mmapContents:          mmapContents,
+		partialContents:       mapped.partial,
 		useBloomFilter:        cfg.useBloomFilter,
 		calcCountNetAdditions: cfg.calcCountNetAdditions,
 		invertedHeader:        invertedHeader,
@@ -185,9 +204,17 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 
 	// Using pread strategy requires file to remain open for segment lifetime
 	if seg.mmapContents {
-		defer file.Close()
+		defer mapped.file.Close()
 	} else {
-		seg.contentFile = file
+		seg.contentFile = mapped.file
+	}
+
+	if header.Compression != segmentindex.CompressionNone {
+		seg.blocks, err = newSegmentBlocks(header, contents, seg.contentFile)
+		if err != nil {
+			return nil, err
+		}
+		seg.dataEndPos = seg.blocks.dataEnd()
 	}
 
 	if seg.secondaryIndexCount > 0 {
@@ -350,6 +377,10 @@ func (s *segment) Size() int {
 
 // PayloadSize is only the payload of the index, excluding the index
 func (s *segment) PayloadSize() int {
+	if s.blocks != nil {
+		// the size on disk rather than the logical size of the compressed data
+		return int(s.segmentStartPos)
+	}
 	return int(s.dataEndPos)
 }
 
@@ -370,7 +401,9 @@ func (s *segment) newNodeReader(offset nodeOffset) (*nodeReader, error) {
 		r   io.Reader
 		err error
 	)
-	if s.mmapContents {
+	if s.blocks != nil {
+		r, err = s.blocksReaderAt(offset)
+	} else if s.mmapContents {
 		contents := s.contents[offset.start:]
 		if offset.end != 0 {
 			contents = s.contents[offset.start:offset.end]
@@ -386,6 +419,10 @@ func (s *segment) newNodeReader(offset nodeOffset) (*nodeReader, error) {
 }
 
 func (s *segment) copyNode(b []byte, offset nodeOffset) error {
+	if s.blocks != nil {
+		_, err := s.blocks.ReadAt(b, int64(offset.start))
+		return err
+	}
 	if s.mmapContents {
 		copy(b, s.contents[offset.start:offset.end])
 		return nil
@@ -413,3 +450,16 @@ func (s *segment) bufferedReaderAt(offset uint64) (*bufio.Reader, error) {
 	r := io.NewSectionReader(s.contentFile, int64(offset), s.size)
 	return bufio.NewReader(r), nil
 }
+
+func (s *segment) blocksReaderAt(offset nodeOffset) (*bufio.Reader, error) {
+	end := s.blocks.dataEnd()
+	if offset.end != 0 {
+		end = offset.end
+	}
+	if offset.start >= end {
+		return nil, lsmkv.NotFound
+	}
+
+	r := io.NewSectionReader(s.blocks, int64(offset.start), int64(end-offset.start))
+	return bufio.NewReader(r), nil
+}
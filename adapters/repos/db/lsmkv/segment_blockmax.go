@@ -25,6 +25,8 @@ import (
 )
 
 func (s *segment) loadBlockEntries(node segmentindex.Node) ([]*terms.BlockEntry, uint64, *terms.BlockDataDecoded, error) {
+	singleValueCount := uint64(encodeSingleSeparate(s.invertedHeader.HasTermPositions()))
+
 	var buf []byte
 	if s.mmapContents {
 		buf = s.contents[node.Start : node.Start+uint64(8+12*terms.ENCODE_AS_FULL_BYTES)]
@@ -44,7 +46,7 @@ func (s *segment) loadBlockEntries(node segmentindex.Node) ([]*terms.BlockEntry,
 
 	docCount := binary.LittleEndian.Uint64(buf)
 
-	if docCount <= uint64(terms.ENCODE_AS_FULL_BYTES) {
+	if docCount <= singleValueCount {
 		data := convertFixedLengthFromMemory(buf, int(docCount))
 		entries := make([]*terms.BlockEntry, 1)
 		propLength := s.invertedData.propertyLengths[data.DocIds[0]]
@@ -86,8 +88,13 @@ func (s *segment) loadBlockEntries(node segmentindex.Node) ([]*terms.BlockEntry,
 
 // todo: check if there is a performance impact of starting to sectionReader at offset and not have to pass offset here
 func (s *segment) loadBlockDataReusable(sectionReader *io.SectionReader, offset, offsetStart, offsetEnd uint64, buf []byte, encoded *terms.BlockData) error {
+	decode := terms.DecodeBlockDataReusable
+	if s.invertedHeader.HasTermPositions() {
+		decode = terms.DecodeBlockDataWithPositionsReusable
+	}
+
 	if s.mmapContents {
-		terms.DecodeBlockDataReusable(s.contents[offsetStart:offsetEnd], encoded)
+		decode(s.contents[offsetStart:offsetEnd], encoded)
 		return nil
 	} else {
 
@@ -100,7 +107,7 @@ func (s *segment) loadBlockDataReusable(sectionReader *io.SectionReader, offset,
 		if err != nil {
 			return err
 		}
-		terms.DecodeBlockDataReusable(buf[:offsetEnd-offsetStart], encoded)
+		decode(buf[:offsetEnd-offsetStart], encoded)
 	}
 	return nil
 }
@@ -154,6 +161,12 @@ type SegmentBlockMax struct {
 	blockDatasTest []*terms.BlockData
 
 	sectionReader *io.SectionReader
+
+	// set for segments which store term positions, positionsBuffer holds the
+	// positions of the current block once they were requested
+	withPositions   bool
+	positionsBuffer [][]byte
+	positionsSplit  bool
 }
 
 func generateSingleFilter(tombstones *sroar.Bitmap, filterDocIds helpers.AllowList) (*sroar.Bitmap, *sroar.Bitmap) {
@@ -195,6 +208,9 @@ func NewSegmentBlockMax(s *segment, key []byte, queryTermIndex int, idf float64,
 	decoders := make([]varenc.VarEncEncoder[uint64], len(codecs))
 
 	for i, codec := range codecs {
+		if codec == varenc.TermPositions {
+			continue
+		}
 		decoders[i] = varenc.GetVarEncEncoder64(codec)
 		decoders[i].Init(terms.BLOCK_SIZE)
 	}
@@ -218,6 +234,7 @@ func NewSegmentBlockMax(s *segment, key []byte, queryTermIndex int, idf float64,
 		filterDocIds:      filterSroar,
 		tombstones:        tombstones,
 		sectionReader:     sectionReader,
+		withPositions:     s.invertedHeader.HasTermPositions(),
 	}
 
 	err = output.reset()
@@ -382,7 +399,8 @@ func (s *SegmentBlockMax) decodeBlock() error {
 	}
 
 	s.blockDataIdx = 0
-	if s.docCount <= uint64(terms.ENCODE_AS_FULL_BYTES) {
+	s.positionsSplit = false
+	if s.docCount <= uint64(encodeSingleSeparate(s.withPositions)) {
 		s.idPointer = s.blockDataDecoded.DocIds[s.blockDataIdx]
 		s.blockDataSize = int(s.docCount)
 		s.freqDecoded = true
@@ -398,6 +416,10 @@ func (s *SegmentBlockMax) decodeBlock() error {
 		if s.blockEntryIdx < len(s.blockEntries)-1 {
 			endOffset = uint64(s.blockEntries[s.blockEntryIdx+1].Offset) + s.blockDataStartOffset
 		}
+		if int(endOffset-startOffset) > len(s.blockDataBuffer) {
+			// blocks with term positions are not bounded by the block size
+			s.blockDataBuffer = make([]byte, endOffset-startOffset)
+		}
 		err = s.segment.loadBlockDataReusable(s.sectionReader, s.node.Start, startOffset, endOffset, s.blockDataBuffer, s.blockDataEncoded)
 		if err != nil {
 			return err
@@ -533,6 +555,22 @@ func (s *SegmentBlockMax) Score(averagePropLength float64, additionalExplanation
 	return s.idPointer, float64(tf) * s.idf * float64(s.propertyBoost), doc
 }
 
+// positions returns the encoded term positions of the current document, it
+// is only supported for segments which store term positions
+func (s *SegmentBlockMax) positions() []byte {
+	if s.exhausted || !s.withPositions || s.blockDataEncoded == nil {
+		return nil
+	}
+	if !s.positionsSplit {
+		s.positionsBuffer = splitBlockPositions(s.blockDataEncoded.Positions, s.positionsBuffer)
+		s.positionsSplit = true
+	}
+	if s.blockDataIdx >= len(s.positionsBuffer) {
+		return nil
+	}
+	return s.positionsBuffer[s.blockDataIdx]
+}
+
 func (s *SegmentBlockMax) Advance() {
 	if s.exhausted {
 		return
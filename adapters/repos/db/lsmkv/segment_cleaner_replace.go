@@ -32,11 +32,13 @@ type segmentCleanerReplace struct {
 	secondaryIndexCount      uint16
 	scratchSpacePath         string
 	enableChecksumValidation bool
+	compression              segmentindex.Compression
 }
 
 func newSegmentCleanerReplace(w io.WriteSeeker, cursor *segmentCursorReplace,
 	keyExistsFn keyExistsOnUpperSegmentsFunc, level, secondaryIndexCount uint16,
 	scratchSpacePath string, enableChecksumValidation bool,
+	compression segmentindex.Compression,
 ) *segmentCleanerReplace {
 	return &segmentCleanerReplace{
 		w:                        w,
@@ -48,6 +50,7 @@ func newSegmentCleanerReplace(w io.WriteSeeker, cursor *segmentCursorReplace,
 		secondaryIndexCount:      secondaryIndexCount,
 		scratchSpacePath:         scratchSpacePath,
 		enableChecksumValidation: enableChecksumValidation,
+		compression:              compression,
 	}
 }
 
@@ -59,6 +62,7 @@ func (p *segmentCleanerReplace) do(shouldAbort cyclemanager.ShouldAbortCallback)
 	segmentFile := segmentindex.NewSegmentFile(
 		segmentindex.WithBufferedWriter(p.bufw),
 		segmentindex.WithChecksumsDisabled(!p.enableChecksumValidation),
+		segmentindex.WithCompression(p.compression),
 	)
 
 	indexKeys, err := p.writeKeys(segmentFile, shouldAbort)
@@ -80,7 +84,7 @@ func (p *segmentCleanerReplace) do(shouldAbort cyclemanager.ShouldAbortCallback)
 		dataEnd = uint64(indexKeys[l-1].ValueEnd)
 	}
 
-	if err := p.writeHeader(segmentFile, dataEnd); err != nil {
+	if err := p.writeHeader(segmentFile, segmentFile.IndexStart(dataEnd)); err != nil {
 		return fmt.Errorf("write header: %w", err)
 	}
 
@@ -172,6 +176,7 @@ func (p *segmentCleanerReplace) writeHeader(f *segmentindex.SegmentFile,
 		SecondaryIndices: p.secondaryIndexCount,
 		Strategy:         segmentindex.StrategyReplace,
 		IndexStart:       startOfIndex,
+		Compression:      p.compression,
 	}
 	// We have to write directly to compactor writer,
 	// since it has seeked back to start. The following
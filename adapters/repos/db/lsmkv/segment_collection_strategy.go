@@ -100,11 +100,11 @@ func (s *segment) collectionStratParseDataInverted(in []byte) ([]value, error) {
 
 	values := make([]value, valuesLen)
 
-	nodes, _ := decodeAndConvertFromBlocks(in)
+	nodes, _ := decodeAndConvertFromBlocks(in, s.invertedHeader.HasTermPositions())
 
 	valueIndex := 0
 	for _, node := range nodes {
-		buf := make([]byte, 16)
+		buf := make([]byte, 8+len(node.Value))
 		copy(buf, node.Key)
 		copy(buf[8:], node.Value)
 		values[valueIndex].tombstone = node.Tombstone
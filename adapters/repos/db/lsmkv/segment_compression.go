@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+// segmentBlocks provides access to the logical (uncompressed) data section of
+// a segment that was written with block compression. All offsets in the
+// segment's indexes are logical offsets and are resolved through the block
+// table. It implements io.ReaderAt on the logical offsets, so it can be used
+// in place of the raw contents wherever nodes are read.
+type segmentBlocks struct {
+	compression segmentindex.Compression
+	table       *segmentindex.BlockTable
+	// contents is used if the segment is mmapped, contentFile otherwise
+	contents    []byte
+	contentFile segmentContentFile
+
+	// recently decompressed blocks are kept around, as cursors and
+	// neighboring keys typically hit the same blocks repeatedly. Decompressed
+	// blocks are never modified, so they can be shared with readers.
+	cache *blockCache
+}
+
+// segmentBlocksCacheSize is the number of decompressed blocks kept per
+// segment. With the default block size this bounds the cache to 128KB per
+// segment, while still serving concurrent readers on a few hot blocks.
+const segmentBlocksCacheSize = 8
+
+// blockCache is a small LRU of decompressed blocks. The number of entries is
+// tiny, so a slice ordered from most to least recently used is cheaper than a
+// map plus linked list.
+type blockCache struct {
+	sync.Mutex
+	size    int
+	entries []cachedBlock
+}
+
+type cachedBlock struct {
+	index int
+	block []byte
+}
+
+func newBlockCache(size int) *blockCache {
+	return &blockCache{size: size, entries: make([]cachedBlock, 0, size)}
+}
+
+func (c *blockCache) get(index int) ([]byte, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	for i, entry := range c.entries {
+		if entry.index == index {
+			copy(c.entries[1:i+1], c.entries[:i])
+			c.entries[0] = entry
+			return entry.block, true
+		}
+	}
+	return nil, false
+}
+
+func (c *blockCache) put(index int, block []byte) {
+	c.Lock()
+	defer c.Unlock()
+
+	for i, entry := range c.entries {
+		if entry.index == index {
+			// another reader decompressed the same block concurrently
+			copy(c.entries[1:i+1], c.entries[:i])
+			c.entries[0] = entry
+			return
+		}
+	}
+
+	if len(c.entries) < c.size {
+		c.entries = append(c.entries, cachedBlock{})
+	}
+	copy(c.entries[1:], c.entries[:len(c.entries)-1])
+	c.entries[0] = cachedBlock{index: index, block: block}
+}
+
+func newSegmentBlocks(header *segmentindex.Header, contents []byte,
+	contentFile segmentContentFile,
+) (*segmentBlocks, error) {
+	table, err := header.ParseBlockTable(contents)
+	if err != nil {
+		return nil, fmt.Errorf("parse block table: %w", err)
+	}
+
+	return &segmentBlocks{
+		compression: header.Compression,
+		table:       table,
+		contents:    contents,
+		contentFile: contentFile,
+		cache:       newBlockCache(segmentBlocksCacheSize),
+	}, nil
+}
+
+func (b *segmentBlocks) dataEnd() uint64 {
+	return b.table.DataEnd
+}
+
+func (b *segmentBlocks) ReadAt(p []byte, off int64) (int, error) {
+	offset := uint64(off)
+	if offset >= b.table.DataEnd {
+		return 0, io.EOF
+	}
+
+	n := 0
+	for n < len(p) && offset < b.table.DataEnd {
+		index, within := b.table.Locate(offset)
+		block, err := b.block(index)
+		if err != nil {
+			return n, err
+		}
+		if within >= uint64(len(block)) {
+			return n, fmt.Errorf("offset %d beyond block %d of length %d", offset,
+				index, len(block))
+		}
+
+		copied := copy(p[n:], block[within:])
+		n += copied
+		offset += uint64(copied)
+	}
+
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (b *segmentBlocks) block(index int) ([]byte, error) {
+	if block, ok := b.cache.get(index); ok {
+		return block, nil
+	}
+
+	if index >= b.table.Blocks() {
+		return nil, fmt.Errorf("block %d out of range with %d blocks", index,
+			b.table.Blocks())
+	}
+
+	start, end := b.table.BlockRange(index)
+	var compressed []byte
+	if b.contentFile == nil {
+		compressed = b.contents[start:end]
+	} else {
+		compressed = make([]byte, end-start)
+		if _, err := b.contentFile.ReadAt(compressed, int64(start)); err != nil {
+			return nil, fmt.Errorf("read block %d: %w", index, err)
+		}
+	}
+
+	block, err := b.compression.Decode(compressed)
+	if err != nil {
+		return nil, fmt.Errorf("decompress block %d: %w", index, err)
+	}
+
+	b.cache.put(index, block)
+
+	return block, nil
+}
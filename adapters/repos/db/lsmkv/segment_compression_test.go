@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockCache(t *testing.T) {
+	cache := newBlockCache(2)
+
+	_, ok := cache.get(0)
+	assert.False(t, ok)
+
+	cache.put(0, []byte("zero"))
+	cache.put(1, []byte("one"))
+
+	block, ok := cache.get(0)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("zero"), block)
+
+	// block 1 is the least recently used one now and gets evicted
+	cache.put(2, []byte("two"))
+	_, ok = cache.get(1)
+	assert.False(t, ok)
+
+	block, ok = cache.get(0)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("zero"), block)
+	block, ok = cache.get(2)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("two"), block)
+
+	// putting a cached block again does not duplicate it
+	cache.put(2, []byte("two"))
+	assert.Len(t, cache.entries, 2)
+}
@@ -0,0 +1,263 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/edsrzf/mmap-go"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/encryption"
+)
+
+// segmentWriteFile is the destination of memtable flushes, compactions and
+// cleanups. It is either a plain *os.File or an encrypting writer.
+type segmentWriteFile interface {
+	io.WriteSeeker
+	Sync() error
+	Close() error
+}
+
+// createSegmentFile creates the file for a new segment. If a key provider is
+// configured the segment is encrypted with its current key. As compactions
+// write new segments, this is also how existing data gradually moves to a
+// rotated key.
+func createSegmentFile(path string, keys encryption.KeyProvider) (segmentWriteFile, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+	if keys == nil {
+		return f, nil
+	}
+
+	w, err := encryption.NewBlockWriter(f, keys)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("encrypt segment %q: %w", path, err)
+	}
+	return w, nil
+}
+
+// segmentContentFile is what a segment reads its data section from if it is
+// not mmapped. It is either the plain *os.File or an encryptedContentFile.
+type segmentContentFile interface {
+	io.ReaderAt
+	Close() error
+}
+
+// segmentContents is the result of mapSegmentContents.
+type segmentContents struct {
+	// contents maps the plaintext of the segment. For encrypted segments only
+	// the parts that are parsed on load are decrypted into it, see partial.
+	contents mmap.MMap
+	// file reads the plaintext of the segment, decrypting it on demand
+	file segmentContentFile
+	// size is the size of the plaintext
+	size      int64
+	encrypted bool
+	// partial is set if the data section of an encrypted segment is not held
+	// in contents. It then has to be read through file.
+	partial bool
+}
+
+// mapSegmentContents maps the contents of a segment file into memory.
+// Plaintext segments are mapped directly. Encrypted segments are never
+// decrypted as a whole: only the header, the block table of compressed
+// segments and the indexes are decrypted into an anonymous mapping, which
+// keeps the offsets of the file. The pages of the data section are never
+// touched, so they take no memory, and the data is read through the returned
+// file instead. Roaring set cursors only work on mapped contents, so these
+// segments are the exception and are decrypted completely.
+func mapSegmentContents(file *os.File, size int64,
+	keys encryption.KeyProvider,
+) (*segmentContents, error) {
+	encrypted, err := encryption.IsEncrypted(file)
+	if err != nil {
+		return nil, fmt.Errorf("detect encryption: %w", err)
+	}
+
+	if !encrypted {
+		contents, err := mmap.MapRegion(file, int(size), mmap.RDONLY, 0, 0)
+		if err != nil {
+			return nil, fmt.Errorf("mmap file: %w", err)
+		}
+		return &segmentContents{contents: contents, file: file, size: size}, nil
+	}
+
+	r, err := encryption.NewReaderAt(file, size, keys)
+	if err != nil {
+		return nil, fmt.Errorf("open encrypted segment: %w", err)
+	}
+
+	contents, err := mmap.MapRegion(nil, int(r.Size()), mmap.RDWR, mmap.ANON, 0)
+	if err != nil {
+		return nil, fmt.Errorf("mmap decrypted contents: %w", err)
+	}
+	partial, err := decryptSegmentMetadata(contents, r)
+	if err != nil {
+		contents.Unmap()
+		return nil, fmt.Errorf("decrypt segment: %w", err)
+	}
+
+	return &segmentContents{
+		contents:  contents,
+		file:      newEncryptedContentFile(file, r),
+		size:      r.Size(),
+		encrypted: true,
+		partial:   partial,
+	}, nil
+}
+
+// decryptSegmentMetadata decrypts everything that is parsed when a segment is
+// loaded into contents, at the same offsets as in the plaintext. It reports
+// whether the data section was left out.
+func decryptSegmentMetadata(contents []byte, r io.ReaderAt) (bool, error) {
+	decrypt := func(start, end uint64) error {
+		if start > end || end > uint64(len(contents)) {
+			return fmt.Errorf("invalid range %d-%d for segment of size %d",
+				start, end, len(contents))
+		}
+		_, err := r.ReadAt(contents[start:end], int64(start))
+		if err != nil && !(errors.Is(err, io.EOF) && end == uint64(len(contents))) {
+			return err
+		}
+		return nil
+	}
+
+	if err := decrypt(0, segmentindex.HeaderSize); err != nil {
+		return false, fmt.Errorf("header: %w", err)
+	}
+	header, err := segmentindex.ParseHeader(bytes.NewReader(contents[:segmentindex.HeaderSize]))
+	if err != nil {
+		return false, fmt.Errorf("parse header: %w", err)
+	}
+
+	if header.Strategy == segmentindex.StrategyRoaringSet {
+		if err := decrypt(segmentindex.HeaderSize, uint64(len(contents))); err != nil {
+			return false, err
+		}
+		return false, nil
+	}
+
+	if header.Strategy == segmentindex.StrategyInverted {
+		keysOffsetEnd := uint64(segmentindex.HeaderSize + 8)
+		if err := decrypt(segmentindex.HeaderSize, keysOffsetEnd); err != nil {
+			return false, fmt.Errorf("inverted header: %w", err)
+		}
+		keysOffset := binary.LittleEndian.Uint64(contents[segmentindex.HeaderSize:keysOffsetEnd])
+		if err := decrypt(keysOffsetEnd, keysOffset); err != nil {
+			return false, fmt.Errorf("inverted header: %w", err)
+		}
+	}
+
+	indexStart := header.IndexStart
+	if header.Compression != segmentindex.CompressionNone {
+		if err := decrypt(indexStart-segmentindex.CompressionTrailerSize, indexStart); err != nil {
+			return false, fmt.Errorf("block table trailer: %w", err)
+		}
+		indexStart, err = header.BlockTableStart(contents)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if err := decrypt(indexStart, uint64(len(contents))); err != nil {
+		return false, fmt.Errorf("indexes: %w", err)
+	}
+	return true, nil
+}
+
+// encryptedBlocksCacheSize is the number of decrypted blocks kept per
+// encrypted segment. With 64KB blocks this bounds the cache to 1MB per
+// segment.
+const encryptedBlocksCacheSize = 16
+
+// encryptedContentFile reads the plaintext of an encrypted segment. Blocks are
+// decrypted on demand and the most recently used ones are cached, as node
+// readers typically read a node in several small reads.
+type encryptedContentFile struct {
+	file   *os.File
+	reader *encryption.ReaderAt
+	cache  *blockCache
+}
+
+func newEncryptedContentFile(file *os.File, reader *encryption.ReaderAt) *encryptedContentFile {
+	return &encryptedContentFile{
+		file:   file,
+		reader: reader,
+		cache:  newBlockCache(encryptedBlocksCacheSize),
+	}
+}
+
+func (f *encryptedContentFile) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("negative offset %d", off)
+	}
+
+	n := 0
+	for n < len(p) && off < f.reader.Size() {
+		index := int(off / encryption.BlockSize)
+		block, err := f.block(index)
+		if err != nil {
+			return n, err
+		}
+
+		copied := copy(p[n:], block[off%encryption.BlockSize:])
+		n += copied
+		off += int64(copied)
+	}
+
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+func (f *encryptedContentFile) block(index int) ([]byte, error) {
+	if block, ok := f.cache.get(index); ok {
+		return block, nil
+	}
+
+	start := int64(index) * encryption.BlockSize
+	size := f.reader.Size() - start
+	if size > encryption.BlockSize {
+		size = encryption.BlockSize
+	}
+	block := make([]byte, size)
+	if _, err := f.reader.ReadAt(block, start); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("decrypt block %d: %w", index, err)
+	}
+
+	f.cache.put(index, block)
+	return block, nil
+}
+
+func (f *encryptedContentFile) Close() error {
+	return f.file.Close()
+}
+
+// plaintextFileInfo reports the plaintext size of an encrypted segment, which
+// is what checksum validation expects.
+type plaintextFileInfo struct {
+	os.FileInfo
+	size int64
+}
+
+func (i plaintextFileInfo) Size() int64 {
+	return i.size
+}
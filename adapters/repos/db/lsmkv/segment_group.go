@@ -27,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/usecases/memwatch"
@@ -75,6 +76,8 @@ type SegmentGroup struct {
 	calcCountNetAdditions    bool // see bucket for more details
 	compactLeftOverSegments  bool // see bucket for more details
 	enableChecksumValidation bool
+	compression              segmentindex.Compression // see bucket for more details
+	encryption               encryption.KeyProvider   // see bucket for more details
 
 	allocChecker   memwatch.AllocChecker
 	maxSegmentSize int64
@@ -98,6 +101,8 @@ type sgConfig struct {
 	maxSegmentSize           int64
 	cleanupInterval          time.Duration
 	enableChecksumValidation bool
+	compression              segmentindex.Compression
+	encryption               encryption.KeyProvider
 }
 
 func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
@@ -126,6 +131,8 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		maxSegmentSize:           cfg.maxSegmentSize,
 		cleanupInterval:          cfg.cleanupInterval,
 		enableChecksumValidation: cfg.enableChecksumValidation,
+		compression:              cfg.compression,
+		encryption:               cfg.encryption,
 		allocChecker:             allocChecker,
 		lastCompactionCall:       now,
 		lastCleanupCall:          now,
@@ -207,6 +214,7 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 					calcCountNetAdditions:    sg.calcCountNetAdditions,
 					overwriteDerived:         false,
 					enableChecksumValidation: sg.enableChecksumValidation,
+					encryption:               sg.encryption,
 				})
 			if err != nil {
 				return nil, fmt.Errorf("init already compacted right segment %s: %w", rightSegmentFilename, err)
@@ -255,6 +263,7 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 				calcCountNetAdditions:    sg.calcCountNetAdditions,
 				overwriteDerived:         true,
 				enableChecksumValidation: sg.enableChecksumValidation,
+				encryption:               sg.encryption,
 			},
 		)
 		if err != nil {
@@ -326,6 +335,7 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 				calcCountNetAdditions:    sg.calcCountNetAdditions,
 				overwriteDerived:         false,
 				enableChecksumValidation: sg.enableChecksumValidation,
+				encryption:               sg.encryption,
 			})
 		if err != nil {
 			return nil, fmt.Errorf("init segment %s: %w", entry.Name(), err)
@@ -405,6 +415,7 @@ func (sg *SegmentGroup) add(path string) error {
 			calcCountNetAdditions:    sg.calcCountNetAdditions,
 			overwriteDerived:         true,
 			enableChecksumValidation: sg.enableChecksumValidation,
+			encryption:               sg.encryption,
 		})
 	if err != nil {
 		return fmt.Errorf("init segment %s: %w", path, err)
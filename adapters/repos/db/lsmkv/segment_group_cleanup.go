@@ -14,7 +14,6 @@ package lsmkv
 import (
 	"encoding/binary"
 	"fmt"
-	"os"
 	"path/filepath"
 	"strconv"
 	"time"
@@ -490,7 +489,7 @@ func (c *segmentCleanerCommon) cleanupOnce(shouldAbort cyclemanager.ShouldAbortC
 		}
 	}()
 
-	file, err := os.Create(tmpSegmentPath)
+	file, err := createSegmentFile(tmpSegmentPath, c.sg.encryption)
 	if err != nil {
 		return false, err
 	}
@@ -499,7 +498,8 @@ func (c *segmentCleanerCommon) cleanupOnce(shouldAbort cyclemanager.ShouldAbortC
 	case StrategyReplace:
 		c := newSegmentCleanerReplace(file, oldSegment.newCursor(),
 			c.sg.makeKeyExistsOnUpperSegments(startIdx, lastIdx), oldSegment.level,
-			oldSegment.secondaryIndexCount, scratchSpacePath, c.sg.enableChecksumValidation)
+			oldSegment.secondaryIndexCount, scratchSpacePath, c.sg.enableChecksumValidation,
+			c.sg.compression)
 		if err = c.do(shouldAbort); err != nil {
 			return false, err
 		}
@@ -576,7 +576,7 @@ func (sg *SegmentGroup) replaceSegment(segmentIdx int, tmpSegmentPath string,
 	countNetAdditions := oldSegment.countNetAdditions
 
 	precomputedFiles, err := preComputeSegmentMeta(tmpSegmentPath, countNetAdditions,
-		sg.logger, sg.useBloomFilter, sg.calcCountNetAdditions, sg.enableChecksumValidation)
+		sg.logger, sg.useBloomFilter, sg.calcCountNetAdditions, sg.enableChecksumValidation, sg.encryption)
 	if err != nil {
 		return nil, fmt.Errorf("precompute segment meta: %w", err)
 	}
@@ -640,6 +640,7 @@ func (sg *SegmentGroup) replaceSegmentBlocking(
 			calcCountNetAdditions:    sg.calcCountNetAdditions,
 			overwriteDerived:         false,
 			enableChecksumValidation: sg.enableChecksumValidation,
+			encryption:               sg.encryption,
 		})
 	if err != nil {
 		return nil, fmt.Errorf("create new segment %q: %w", segmentPath, err)
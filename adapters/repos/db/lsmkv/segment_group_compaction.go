@@ -225,7 +225,7 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 
 	path := filepath.Join(sg.dir, "segment-"+segmentID(leftSegment.path)+"_"+segmentID(rightSegment.path)+".db.tmp")
 
-	f, err := os.Create(path)
+	f, err := createSegmentFile(path, sg.encryption)
 	if err != nil {
 		return false, err
 	}
@@ -247,7 +247,8 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 	case segmentindex.StrategyReplace:
 		c := newCompactorReplace(f, leftSegment.newCursor(),
 			rightSegment.newCursor(), level, secondaryIndices,
-			scratchSpacePath, cleanupTombstones, sg.enableChecksumValidation)
+			scratchSpacePath, cleanupTombstones, sg.enableChecksumValidation,
+			sg.compression)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionReplace.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -260,7 +261,8 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 	case segmentindex.StrategySetCollection:
 		c := newCompactorSetCollection(f, leftSegment.newCollectionCursor(),
 			rightSegment.newCollectionCursor(), level, secondaryIndices,
-			scratchSpacePath, cleanupTombstones, sg.enableChecksumValidation)
+			scratchSpacePath, cleanupTombstones, sg.enableChecksumValidation,
+			sg.compression)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionSet.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -276,7 +278,7 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 			rightSegment.newCollectionCursorReusable(),
 			level, secondaryIndices, scratchSpacePath,
 			sg.mapRequiresSorting, cleanupTombstones,
-			sg.enableChecksumValidation)
+			sg.enableChecksumValidation, sg.compression)
 
 		if sg.metrics != nil {
 			sg.metrics.CompactionMap.With(prometheus.Labels{"path": pathLabel}).Inc()
@@ -362,7 +364,7 @@ func (sg *SegmentGroup) replaceCompactedSegments(old1, old2 int,
 	// WIP: we could add a random suffix to the tmp file to avoid conflicts
 	precomputedFiles, err := preComputeSegmentMeta(newPathTmp,
 		updatedCountNetAdditions, sg.logger, sg.useBloomFilter,
-		sg.calcCountNetAdditions, sg.enableChecksumValidation)
+		sg.calcCountNetAdditions, sg.enableChecksumValidation, sg.encryption)
 	if err != nil {
 		return fmt.Errorf("precompute segment meta: %w", err)
 	}
@@ -466,6 +468,7 @@ func (sg *SegmentGroup) replaceCompactedSegmentsBlocking(
 			calcCountNetAdditions:    sg.calcCountNetAdditions,
 			overwriteDerived:         false,
 			enableChecksumValidation: sg.enableChecksumValidation,
+			encryption:               sg.encryption,
 		})
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "create new segment")
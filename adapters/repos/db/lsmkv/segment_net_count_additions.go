@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
 // ErrInvalidChecksum indicates that the read file should not be trusted. For
@@ -94,10 +95,18 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn, overwrit
 		}
 	}
 
-	extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
-		s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
+	if s.blocks != nil || s.partialContents {
+		// the extractor operates on the raw contents, compressed and encrypted
+		// segments are read through a cursor instead
+		if err := s.forEachKeyAndTombstone(cb); err != nil {
+			return err
+		}
+	} else {
+		extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
+			s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
 
-	extr.do()
+		extr.do()
+	}
 
 	s.countNetAdditions = countNet
 
@@ -112,6 +121,20 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn, overwrit
 	return nil
 }
 
+func (s *segment) forEachKeyAndTombstone(cb func(key []byte, tombstone bool)) error {
+	c := s.newCursor()
+
+	var node segmentReplaceNode
+	var err error
+	for node, err = c.firstWithAllKeys(); err == nil || errors.Is(err, lsmkv.Deleted); node, err = c.nextWithAllKeys() {
+		cb(node.primaryKey, node.tombstone)
+	}
+	if !errors.Is(err, lsmkv.NotFound) {
+		return fmt.Errorf("iterate keys: %w", err)
+	}
+	return nil
+}
+
 func (s *segment) storeCountNetOnDisk() error {
 	return storeCountNetOnDisk(s.countNetPath(), s.countNetAdditions)
 }
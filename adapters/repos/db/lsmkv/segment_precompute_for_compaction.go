@@ -14,13 +14,14 @@ package lsmkv
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
-	"github.com/edsrzf/mmap-go"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/encryption"
 )
 
 // preComputeSegmentMeta has no side-effects for an already running store. As a
@@ -34,7 +35,7 @@ import (
 // able to find a way to unify the two -- there are subtle differences.
 func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 	logger logrus.FieldLogger, useBloomFilter bool, calcCountNetAdditions bool,
-	enableChecksumValidation bool,
+	enableChecksumValidation bool, keys encryption.KeyProvider,
 ) ([]string, error) {
 	out := []string{path}
 
@@ -55,12 +56,12 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 	if err != nil {
 		return nil, fmt.Errorf("stat file: %w", err)
 	}
-	size := fileInfo.Size()
 
-	contents, err := mmap.MapRegion(file, int(fileInfo.Size()), mmap.RDONLY, 0, 0)
+	mapped, err := mapSegmentContents(file, fileInfo.Size(), keys)
 	if err != nil {
-		return nil, fmt.Errorf("mmap file: %w", err)
+		return nil, err
 	}
+	contents, size := mapped.contents, mapped.size
 
 	defer contents.Unmap()
 
@@ -74,7 +75,12 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 	}
 
 	if header.Version >= segmentindex.SegmentV1 && enableChecksumValidation {
-		segmentFile := segmentindex.NewSegmentFile(segmentindex.WithReader(file))
+		var checksumSource io.Reader = file
+		if mapped.encrypted {
+			checksumSource = io.NewSectionReader(mapped.file, 0, size)
+			fileInfo = plaintextFileInfo{FileInfo: fileInfo, size: size}
+		}
+		segmentFile := segmentindex.NewSegmentFile(segmentindex.WithReader(checksumSource))
 		if err := segmentFile.ValidateChecksum(fileInfo); err != nil {
 			return nil, fmt.Errorf("validate segment %q: %w", path, err)
 		}
@@ -92,7 +98,7 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 
 	var invertedHeader *segmentindex.HeaderInverted
 	if header.Strategy == segmentindex.StrategyInverted {
-		invertedHeader, err = segmentindex.LoadHeaderInverted(contents[segmentindex.HeaderSize : segmentindex.HeaderSize+segmentindex.HeaderInvertedSize])
+		invertedHeader, err = segmentindex.LoadHeaderInverted(contents[segmentindex.HeaderSize:])
 		if err != nil {
 			return nil, errors.Wrap(err, "load inverted header")
 		}
@@ -109,7 +115,8 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		// segment.tmp.bloom.tmp, whereas we want to end up with segment.bloom.tmp
 		path:                  strings.TrimSuffix(path, ".tmp"),
 		contents:              contents,
-		contentFile:           file,
+		contentFile:           mapped.file,
+		partialContents:       mapped.partial,
 		version:               header.Version,
 		secondaryIndexCount:   header.SecondaryIndices,
 		segmentStartPos:       header.IndexStart,
@@ -126,6 +133,14 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		invertedData:          &segmentInvertedData{},
 	}
 
+	if header.Compression != segmentindex.CompressionNone {
+		seg.blocks, err = newSegmentBlocks(header, contents, seg.contentFile)
+		if err != nil {
+			return nil, err
+		}
+		seg.dataEndPos = seg.blocks.dataEnd()
+	}
+
 	if seg.secondaryIndexCount > 0 {
 		seg.secondaryIndices = make([]diskIndex, seg.secondaryIndexCount)
 		for i := range seg.secondaryIndices {
@@ -83,7 +83,59 @@ func encodeBlockParam(nodes []MapPair, deltaEnc, tfEnc varenc.VarEncEncoder[uint
 	return packed
 }
 
-func createBlocks(nodes []MapPair, propLengths map[uint64]uint32, deltaEnc, tfEnc varenc.VarEncEncoder[uint64]) ([]*terms.BlockEntry, []*terms.BlockData, *sroar.Bitmap, map[uint64]uint32) {
+// encodeSingleSeparate returns up to which number of documents postings are
+// encoded as full bytes instead of blocks. Segments which store term positions
+// always use blocks.
+func encodeSingleSeparate(withPositions bool) int {
+	if withPositions {
+		return 0
+	}
+	return terms.ENCODE_AS_FULL_BYTES
+}
+
+// termPositions returns the encoded term positions of an inverted value, which
+// follow its term frequency and property length
+func termPositions(value []byte) []byte {
+	if len(value) <= 8 {
+		return nil
+	}
+	return value[8:]
+}
+
+// encodeBlockPositions concatenates the term positions of the postings of a
+// block, each prefixed by its length
+func encodeBlockPositions(nodes []MapPair) []byte {
+	size := 0
+	for _, n := range nodes {
+		size += binary.MaxVarintLen32 + len(termPositions(n.Value))
+	}
+
+	out := make([]byte, 0, size)
+	for _, n := range nodes {
+		positions := termPositions(n.Value)
+		out = binary.AppendUvarint(out, uint64(len(positions)))
+		out = append(out, positions...)
+	}
+	return out
+}
+
+// splitBlockPositions is the inverse of encodeBlockPositions, the returned
+// slices point into data. Malformed data ends the split early, documents
+// without positions in turn never match a phrase.
+func splitBlockPositions(data []byte, out [][]byte) [][]byte {
+	out = out[:0]
+	for len(data) > 0 {
+		length, n := binary.Uvarint(data)
+		if n <= 0 || uint64(len(data)-n) < length {
+			break
+		}
+		out = append(out, data[n:n+int(length)])
+		data = data[n+int(length):]
+	}
+	return out
+}
+
+func createBlocks(nodes []MapPair, propLengths map[uint64]uint32, withPositions bool, deltaEnc, tfEnc varenc.VarEncEncoder[uint64]) ([]*terms.BlockEntry, []*terms.BlockData, *sroar.Bitmap, map[uint64]uint32) {
 	tombstones, values := extractTombstones(nodes)
 	externalPropLengths := len(propLengths) != 0
 
@@ -125,6 +177,9 @@ func createBlocks(nodes []MapPair, propLengths map[uint64]uint32, deltaEnc, tfEn
 
 		maxId := binary.BigEndian.Uint64(values[end-1].Key)
 		blockDataEncoded[i] = encodeBlockParam(values[start:end], deltaEnc, tfEnc)
+		if withPositions {
+			blockDataEncoded[i].Positions = encodeBlockPositions(values[start:end])
+		}
 
 		blockMetadata[i] = &terms.BlockEntry{
 			MaxId:               maxId,
@@ -183,24 +238,24 @@ func createAndEncodeSingleValue(mapPairs []MapPair, propLengths map[uint64]uint3
 	return buffer[:offset], tombstones
 }
 
-func createAndEncodeBlocksTest(nodes []MapPair, propLengths map[uint64]uint32, encodeSingleSeparate int, deltaEnc, tfEnc varenc.VarEncEncoder[uint64]) ([]byte, *sroar.Bitmap) {
+func createAndEncodeBlocksTest(nodes []MapPair, propLengths map[uint64]uint32, encodeSingleSeparate int, withPositions bool, deltaEnc, tfEnc varenc.VarEncEncoder[uint64]) ([]byte, *sroar.Bitmap) {
 	if len(nodes) <= encodeSingleSeparate {
 		return createAndEncodeSingleValue(nodes, propLengths)
 	}
-	blockEntries, blockDatas, tombstones, _ := createBlocks(nodes, propLengths, deltaEnc, tfEnc)
+	blockEntries, blockDatas, tombstones, _ := createBlocks(nodes, propLengths, withPositions, deltaEnc, tfEnc)
 	return encodeBlocks(blockEntries, blockDatas, uint64(len(nodes))), tombstones
 }
 
-func createAndEncodeBlocksWithLengths(nodes []MapPair, deltaEnc, tfEnc varenc.VarEncEncoder[uint64]) ([]byte, *sroar.Bitmap) {
+func createAndEncodeBlocksWithLengths(nodes []MapPair, withPositions bool, deltaEnc, tfEnc varenc.VarEncEncoder[uint64]) ([]byte, *sroar.Bitmap) {
 	propLengths := make(map[uint64]uint32)
-	return createAndEncodeBlocksTest(nodes, propLengths, terms.ENCODE_AS_FULL_BYTES, deltaEnc, tfEnc)
+	return createAndEncodeBlocksTest(nodes, propLengths, encodeSingleSeparate(withPositions), withPositions, deltaEnc, tfEnc)
 }
 
-func createAndEncodeBlocks(nodes []MapPair, propLengths map[uint64]uint32, deltaEnc, tfEnc varenc.VarEncEncoder[uint64]) ([]byte, *sroar.Bitmap) {
-	return createAndEncodeBlocksTest(nodes, propLengths, terms.ENCODE_AS_FULL_BYTES, deltaEnc, tfEnc)
+func createAndEncodeBlocks(nodes []MapPair, propLengths map[uint64]uint32, withPositions bool, deltaEnc, tfEnc varenc.VarEncEncoder[uint64]) ([]byte, *sroar.Bitmap) {
+	return createAndEncodeBlocksTest(nodes, propLengths, encodeSingleSeparate(withPositions), withPositions, deltaEnc, tfEnc)
 }
 
-func decodeBlocks(data []byte) ([]*terms.BlockEntry, []*terms.BlockData, int) {
+func decodeBlocks(data []byte, withPositions bool) ([]*terms.BlockEntry, []*terms.BlockData, int) {
 	offset := 0
 	docCount := int(binary.LittleEndian.Uint64(data))
 	offset += 16
@@ -216,7 +271,11 @@ func decodeBlocks(data []byte) ([]*terms.BlockEntry, []*terms.BlockData, int) {
 	for i := 0; i < blockCount; i++ {
 		blockEntries[i] = terms.DecodeBlockEntry(data[offset:])
 		dataOffset := int(blockEntries[i].Offset) + blockDataInitialOffset
-		blockDatas[i] = terms.DecodeBlockData(data[dataOffset:])
+		if withPositions {
+			blockDatas[i] = terms.DecodeBlockDataWithPositions(data[dataOffset:])
+		} else {
+			blockDatas[i] = terms.DecodeBlockData(data[dataOffset:])
+		}
 		offset += blockEntries[i].Size()
 	}
 	dataOffset := int(blockEntries[blockCount-1].Offset) + blockDataInitialOffset + blockDatas[blockCount-1].Size()
@@ -224,11 +283,11 @@ func decodeBlocks(data []byte) ([]*terms.BlockEntry, []*terms.BlockData, int) {
 	return blockEntries, blockDatas, dataOffset
 }
 
-func decodeAndConvertValuesFromBlocks(data []byte) ([]value, int) {
-	return decodeAndConvertValuesFromBlocksTest(data, terms.ENCODE_AS_FULL_BYTES, &varenc.VarIntDeltaEncoder{}, &varenc.VarIntEncoder{})
+func decodeAndConvertValuesFromBlocks(data []byte, withPositions bool) ([]value, int) {
+	return decodeAndConvertValuesFromBlocksTest(data, encodeSingleSeparate(withPositions), withPositions, &varenc.VarIntDeltaEncoder{}, &varenc.VarIntEncoder{})
 }
 
-func decodeAndConvertValuesFromBlocksTest(data []byte, encodeSingleSeparate int, deltaEnc, tfEnc varenc.VarEncEncoder[uint64]) ([]value, int) {
+func decodeAndConvertValuesFromBlocksTest(data []byte, encodeSingleSeparate int, withPositions bool, deltaEnc, tfEnc varenc.VarEncEncoder[uint64]) ([]value, int) {
 	collectionSize := binary.LittleEndian.Uint64(data)
 
 	if collectionSize <= uint64(encodeSingleSeparate) {
@@ -245,15 +304,15 @@ func decodeAndConvertValuesFromBlocksTest(data []byte, encodeSingleSeparate int,
 		}
 		return values, offset
 	}
-	blockEntries, blockDatas, offset := decodeBlocks(data)
+	blockEntries, blockDatas, offset := decodeBlocks(data, withPositions)
 	return convertFromBlocksValue(blockEntries, blockDatas, collectionSize, deltaEnc, tfEnc), offset
 }
 
-func decodeAndConvertFromBlocks(data []byte) ([]MapPair, int) {
-	return decodeAndConvertFromBlocksTest(data, terms.ENCODE_AS_FULL_BYTES, &varenc.VarIntDeltaEncoder{}, &varenc.VarIntEncoder{})
+func decodeAndConvertFromBlocks(data []byte, withPositions bool) ([]MapPair, int) {
+	return decodeAndConvertFromBlocksTest(data, encodeSingleSeparate(withPositions), withPositions, &varenc.VarIntDeltaEncoder{}, &varenc.VarIntEncoder{})
 }
 
-func decodeAndConvertFromBlocksTest(data []byte, encodeSingleSeparate int, deltaEnc, tfEnc varenc.VarEncEncoder[uint64]) ([]MapPair, int) {
+func decodeAndConvertFromBlocksTest(data []byte, encodeSingleSeparate int, withPositions bool, deltaEnc, tfEnc varenc.VarEncEncoder[uint64]) ([]MapPair, int) {
 	collectionSize := binary.LittleEndian.Uint64(data)
 
 	if collectionSize <= uint64(encodeSingleSeparate) {
@@ -272,7 +331,7 @@ func decodeAndConvertFromBlocksTest(data []byte, encodeSingleSeparate int, delta
 		}
 		return values, offset
 	}
-	blockEntries, blockDatas, offset := decodeBlocks(data)
+	blockEntries, blockDatas, offset := decodeBlocks(data, withPositions)
 	return convertFromBlocks(blockEntries, blockDatas, collectionSize, deltaEnc, tfEnc), offset
 }
 
@@ -288,16 +347,22 @@ func convertFromBlocksValue(blockEntries []*terms.BlockEntry, encodedBlocks []*t
 		blockSizeInt := int(blockSize)
 
 		docIds, tfs := packedDecode(encodedBlocks[i], blockSizeInt, deltaEnc, tfEnc)
+		positions := splitBlockPositions(encodedBlocks[i].Positions, nil)
 
 		for j := 0; j < blockSizeInt; j++ {
 			docId := docIds[j]
 			tf := float32(tfs[j])
 			// pl := float32(propLengths[j])
 
-			val := make([]byte, 16)
+			var docPositions []byte
+			if j < len(positions) {
+				docPositions = positions[j]
+			}
+			val := make([]byte, 16+len(docPositions))
 			binary.BigEndian.PutUint64(val, docId)
 			binary.LittleEndian.PutUint32(val[8:], math.Float32bits(tf))
 			// binary.LittleEndian.PutUint32(value[4:], math.Float32bits(pl))
+			copy(val[16:], docPositions)
 
 			out = append(out, value{
 				value:     val,
@@ -320,6 +385,7 @@ func convertFromBlocks(blockEntries []*terms.BlockEntry, encodedBlocks []*terms.
 		blockSizeInt := int(blockSize)
 
 		docIds, tfs := packedDecode(encodedBlocks[i], blockSizeInt, deltaEnc, tfEnc)
+		positions := splitBlockPositions(encodedBlocks[i].Positions, nil)
 
 		for j := 0; j < blockSizeInt; j++ {
 			docId := docIds[j]
@@ -329,9 +395,14 @@ func convertFromBlocks(blockEntries []*terms.BlockEntry, encodedBlocks []*terms.
 			key := make([]byte, 8)
 			binary.BigEndian.PutUint64(key, docId)
 
-			value := make([]byte, 8)
+			var docPositions []byte
+			if j < len(positions) {
+				docPositions = positions[j]
+			}
+			value := make([]byte, 8+len(docPositions))
 			binary.LittleEndian.PutUint32(value, math.Float32bits(tf))
 			// binary.LittleEndian.PutUint32(value[4:], math.Float32bits(pl))
+			copy(value[8:], docPositions)
 
 			out = append(out, MapPair{
 				Key:   key,
@@ -360,10 +431,11 @@ func convertFixedLengthFromMemory(data []byte, blockSize int) *terms.BlockDataDe
 
 // a single node of strategy "inverted"
 type segmentInvertedNode struct {
-	values      []MapPair
-	primaryKey  []byte
-	offset      int
-	propLengths map[uint64]uint32
+	values        []MapPair
+	primaryKey    []byte
+	offset        int
+	propLengths   map[uint64]uint32
+	withPositions bool
 }
 
 var invPayloadLen = 16
@@ -373,7 +445,7 @@ func (s segmentInvertedNode) KeyIndexAndWriteTo(w io.Writer, deltaEnc, tfEnc var
 	written := 0
 	buf := make([]byte, 8) // uint64 size
 
-	blocksEncoded, _ := createAndEncodeBlocks(s.values, s.propLengths, deltaEnc, tfEnc)
+	blocksEncoded, _ := createAndEncodeBlocks(s.values, s.propLengths, s.withPositions, deltaEnc, tfEnc)
 	n, err := w.Write(blocksEncoded)
 	if err != nil {
 		return out, errors.Wrapf(err, "write values for node")
@@ -411,7 +483,9 @@ func (s segmentInvertedNode) KeyIndexAndWriteTo(w io.Writer, deltaEnc, tfEnc var
 // When we already have a finite and manageable []byte (i.e. when we have already seeked to an
 // lsmkv node and have start+end offset), r should be constructed as a *bytes.Reader, since the
 // contents have already been `pread` from the segment contentFile.
-func ParseInvertedNode(r io.Reader) (segmentCollectionNode, error) {
+//
+// withPositions needs to be set for segments which store term positions.
+func ParseInvertedNode(r io.Reader, withPositions bool) (segmentCollectionNode, error) {
 	out := segmentCollectionNode{}
 	buffer := make([]byte, 24)
 
@@ -421,7 +495,7 @@ func ParseInvertedNode(r io.Reader) (segmentCollectionNode, error) {
 	out.offset = 24
 	docCount := binary.LittleEndian.Uint64(buffer[:8])
 	allBytes := buffer
-	if docCount > uint64(terms.ENCODE_AS_FULL_BYTES) {
+	if docCount > uint64(encodeSingleSeparate(withPositions)) {
 		toRead := binary.LittleEndian.Uint64(buffer[8:16]) + 4
 		bufferSize := 24 + toRead
 		allBytes = make([]byte, bufferSize)
@@ -433,7 +507,7 @@ func ParseInvertedNode(r io.Reader) (segmentCollectionNode, error) {
 		out.offset += int(toRead)
 	}
 
-	nodes, _ := decodeAndConvertValuesFromBlocks(allBytes)
+	nodes, _ := decodeAndConvertValuesFromBlocks(allBytes, withPositions)
 
 	keyLen := binary.LittleEndian.Uint32(allBytes[len(allBytes)-4:])
 
@@ -0,0 +1,269 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package segmentindex
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Compression identifies the codec used to compress the data section of a
+// segment. It is stored in the upper bits of the header version field, which
+// means segments without compression are unchanged on disk, while older
+// versions refuse to load compressed segments instead of misreading them.
+type Compression uint16
+
+const (
+	CompressionNone Compression = iota
+	CompressionSnappy
+	CompressionZstd
+)
+
+const (
+	compressionVersionShift = 12
+	versionMask             = uint16(1<<compressionVersionShift - 1)
+
+	// CompressionBlockSize is the amount of uncompressed data that is
+	// compressed into a single block. Reading a single key needs to
+	// decompress at least one full block, so this is a tradeoff between the
+	// compression ratio and the cost of point reads.
+	CompressionBlockSize = 16 * 1024
+
+	// CompressionTrailerSize describes the fixed part of the block table, it
+	// is composed of 8 bytes for the logical end of the data, 4 bytes for the
+	// block size and 4 bytes for the block count
+	CompressionTrailerSize = 16
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+func ParseCompression(in string) (Compression, error) {
+	switch in {
+	case "", "none":
+		return CompressionNone, nil
+	case "snappy":
+		return CompressionSnappy, nil
+	case "zstd":
+		return CompressionZstd, nil
+	default:
+		return CompressionNone, fmt.Errorf("unsupported block compression %q", in)
+	}
+}
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionSnappy:
+		return "snappy"
+	case CompressionZstd:
+		return "zstd"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint16(c))
+	}
+}
+
+func (c Compression) encode(dst, src []byte) ([]byte, error) {
+	switch c {
+	case CompressionSnappy:
+		return snappy.Encode(dst[:cap(dst)], src), nil
+	case CompressionZstd:
+		return zstdEncoder.EncodeAll(src, dst[:0]), nil
+	default:
+		return nil, fmt.Errorf("encode block: unsupported compression %s", c)
+	}
+}
+
+// Decode decompresses a single block. The returned slice is newly allocated
+// and never reused by the decoder, so it can be shared freely.
+func (c Compression) Decode(src []byte) ([]byte, error) {
+	switch c {
+	case CompressionSnappy:
+		return snappy.Decode(nil, src)
+	case CompressionZstd:
+		return zstdDecoder.DecodeAll(src, nil)
+	default:
+		return nil, fmt.Errorf("decode block: unsupported compression %s", c)
+	}
+}
+
+// blockWriter compresses everything written to it in blocks of
+// CompressionBlockSize bytes. The offsets tracked by the callers (e.g. in the
+// [Key] of a node) remain logical, i.e. uncompressed, offsets. The block
+// table written on close maps those to the physical position of the block
+// containing them. The resulting layout of a segment is:
+//
+//	header | block 0 | ... | block n | block table | indexes | checksum
+type blockWriter struct {
+	w           io.Writer
+	compression Compression
+	pending     []byte
+	scratch     []byte
+	offsets     []uint64
+	logical     uint64
+	physical    uint64
+}
+
+func newBlockWriter(w io.Writer, compression Compression) *blockWriter {
+	return &blockWriter{
+		w:           w,
+		compression: compression,
+		pending:     make([]byte, 0, CompressionBlockSize),
+		logical:     HeaderSize,
+		physical:    HeaderSize,
+	}
+}
+
+func (b *blockWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), CompressionBlockSize-len(b.pending))
+		b.pending = append(b.pending, p[:n]...)
+		p = p[n:]
+		written += n
+
+		if len(b.pending) == CompressionBlockSize {
+			if err := b.flushBlock(); err != nil {
+				return written, err
+			}
+		}
+	}
+	b.logical += uint64(written)
+	return written, nil
+}
+
+func (b *blockWriter) flushBlock() error {
+	if len(b.pending) == 0 {
+		return nil
+	}
+
+	compressed, err := b.compression.encode(b.scratch, b.pending)
+	if err != nil {
+		return err
+	}
+	b.scratch = compressed
+
+	if _, err := b.w.Write(compressed); err != nil {
+		return fmt.Errorf("write compressed block: %w", err)
+	}
+
+	b.offsets = append(b.offsets, b.physical)
+	b.physical += uint64(len(compressed))
+	b.pending = b.pending[:0]
+	return nil
+}
+
+// close flushes the last partial block and writes the block table. It
+// returns the physical end of the data section, which is where the indexes
+// start.
+func (b *blockWriter) close() (uint64, error) {
+	if err := b.flushBlock(); err != nil {
+		return 0, err
+	}
+
+	table := make([]byte, 8*len(b.offsets)+CompressionTrailerSize)
+	for i, offset := range b.offsets {
+		binary.LittleEndian.PutUint64(table[i*8:], offset)
+	}
+	trailer := table[8*len(b.offsets):]
+	binary.LittleEndian.PutUint64(trailer[0:8], b.logical)
+	binary.LittleEndian.PutUint32(trailer[8:12], CompressionBlockSize)
+	binary.LittleEndian.PutUint32(trailer[12:16], uint32(len(b.offsets)))
+
+	if _, err := b.w.Write(table); err != nil {
+		return 0, fmt.Errorf("write block table: %w", err)
+	}
+
+	b.physical += uint64(len(table))
+	return b.physical, nil
+}
+
+// BlockTable locates the compressed blocks of a segment's data section.
+type BlockTable struct {
+	// DataEnd is the logical (uncompressed) end of the data section. The
+	// data section always logically starts at HeaderSize.
+	DataEnd   uint64
+	BlockSize uint64
+	// offsets holds the physical start of each block, followed by the start
+	// of the block table itself, so the end of block i is offsets[i+1]
+	offsets []uint64
+}
+
+// BlockTableStart returns the offset at which the block table of a compressed
+// segment starts, the table ends at the index start. Only the trailer right
+// before the index start is read from source.
+func (h *Header) BlockTableStart(source []byte) (uint64, error) {
+	if h.Compression == CompressionNone {
+		return 0, fmt.Errorf("segment is not compressed")
+	}
+	if h.IndexStart < HeaderSize+CompressionTrailerSize || h.IndexStart > uint64(len(source)) {
+		return 0, fmt.Errorf("invalid index start %d for compressed segment", h.IndexStart)
+	}
+
+	trailer := source[h.IndexStart-CompressionTrailerSize : h.IndexStart]
+	count := uint64(binary.LittleEndian.Uint32(trailer[12:16]))
+	if 8*count > h.IndexStart-CompressionTrailerSize-HeaderSize {
+		return 0, fmt.Errorf("invalid block table with %d blocks", count)
+	}
+	return h.IndexStart - CompressionTrailerSize - 8*count, nil
+}
+
+// ParseBlockTable reads the block table which ends right before the indexes
+// of a compressed segment.
+func (h *Header) ParseBlockTable(source []byte) (*BlockTable, error) {
+	tableStart, err := h.BlockTableStart(source)
+	if err != nil {
+		return nil, err
+	}
+
+	trailer := source[h.IndexStart-CompressionTrailerSize : h.IndexStart]
+	t := &BlockTable{
+		DataEnd:   binary.LittleEndian.Uint64(trailer[0:8]),
+		BlockSize: uint64(binary.LittleEndian.Uint32(trailer[8:12])),
+	}
+	if t.BlockSize == 0 {
+		return nil, fmt.Errorf("invalid block table with block size 0")
+	}
+	count := (h.IndexStart - CompressionTrailerSize - tableStart) / 8
+
+	t.offsets = make([]uint64, count+1)
+	for i := range count {
+		t.offsets[i] = binary.LittleEndian.Uint64(source[tableStart+8*i:])
+	}
+	t.offsets[count] = tableStart
+
+	return t, nil
+}
+
+// Blocks returns the number of compressed blocks
+func (t *BlockTable) Blocks() int {
+	return len(t.offsets) - 1
+}
+
+// Locate returns the block containing the logical offset and the position of
+// the offset within the uncompressed block.
+func (t *BlockTable) Locate(logical uint64) (int, uint64) {
+	rel := logical - HeaderSize
+	return int(rel / t.BlockSize), rel % t.BlockSize
+}
+
+// BlockRange returns the physical start and end of the compressed block.
+func (t *BlockTable) BlockRange(block int) (uint64, uint64) {
+	return t.offsets[block], t.offsets[block+1]
+}
@@ -0,0 +1,111 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package segmentindex
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderCompression(t *testing.T) {
+	for _, compression := range []Compression{CompressionNone, CompressionSnappy, CompressionZstd} {
+		t.Run(compression.String(), func(t *testing.T) {
+			header := &Header{
+				Level:            3,
+				Version:          SegmentV1,
+				SecondaryIndices: 1,
+				Strategy:         StrategyReplace,
+				IndexStart:       1234,
+				Compression:      compression,
+			}
+
+			buf := &bytes.Buffer{}
+			_, err := header.WriteTo(buf)
+			require.Nil(t, err)
+			require.Equal(t, HeaderSize, buf.Len())
+
+			parsed, err := ParseHeader(buf)
+			require.Nil(t, err)
+			assert.Equal(t, header, parsed)
+		})
+	}
+
+	t.Run("unknown compression", func(t *testing.T) {
+		header := &Header{Version: SegmentV1, Compression: CompressionZstd + 1}
+		buf := &bytes.Buffer{}
+		_, err := header.WriteTo(buf)
+		require.Nil(t, err)
+
+		_, err = ParseHeader(buf)
+		require.NotNil(t, err)
+	})
+}
+
+func TestBlockWriter(t *testing.T) {
+	random := make([]byte, 3*CompressionBlockSize)
+	_, err := rand.Read(random)
+	require.Nil(t, err)
+
+	inputs := map[string][]byte{
+		"empty":          nil,
+		"single block":   []byte("hello hello hello hello"),
+		"many blocks":    bytes.Repeat([]byte("0123456789"), 10*CompressionBlockSize/7),
+		"incompressible": random,
+	}
+
+	for _, compression := range []Compression{CompressionSnappy, CompressionZstd} {
+		for name, input := range inputs {
+			t.Run(fmt.Sprintf("%s %s", compression, name), func(t *testing.T) {
+				buf := &bytes.Buffer{}
+				buf.Write(make([]byte, HeaderSize))
+
+				w := newBlockWriter(buf, compression)
+				// write in odd chunks to cross block boundaries mid-write
+				for rest := input; len(rest) > 0; {
+					n := min(len(rest), 1000)
+					_, err := w.Write(rest[:n])
+					require.Nil(t, err)
+					rest = rest[n:]
+				}
+				indexStart, err := w.close()
+				require.Nil(t, err)
+				require.Equal(t, uint64(buf.Len()), indexStart)
+
+				header := &Header{IndexStart: indexStart, Compression: compression}
+				table, err := header.ParseBlockTable(buf.Bytes())
+				require.Nil(t, err)
+				assert.Equal(t, uint64(HeaderSize+len(input)), table.DataEnd)
+
+				var decoded []byte
+				for i := 0; i < table.Blocks(); i++ {
+					start, end := table.BlockRange(i)
+					block, err := compression.Decode(buf.Bytes()[start:end])
+					require.Nil(t, err)
+					decoded = append(decoded, block...)
+				}
+				assert.Equal(t, len(input), len(decoded))
+				assert.True(t, bytes.Equal(input, decoded))
+
+				if len(input) > 0 {
+					block, within := table.Locate(uint64(HeaderSize + len(input) - 1))
+					assert.Equal(t, table.Blocks()-1, block)
+					assert.Equal(t, uint64(len(input)-1)%table.BlockSize, within)
+				}
+			})
+		}
+	}
+}
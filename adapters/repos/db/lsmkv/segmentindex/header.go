@@ -37,13 +37,18 @@ type Header struct {
 	SecondaryIndices uint16
 	Strategy         Strategy
 	IndexStart       uint64
+	// Compression is encoded in the upper bits of the version, when set the
+	// data section consists of compressed blocks followed by a block table
+	// and IndexStart is the physical position of the indexes
+	Compression Compression
 }
 
 func (h *Header) WriteTo(w io.Writer) (int64, error) {
 	if err := binary.Write(w, binary.LittleEndian, &h.Level); err != nil {
 		return -1, err
 	}
-	if err := binary.Write(w, binary.LittleEndian, &h.Version); err != nil {
+	version := h.Version | uint16(h.Compression)<<compressionVersionShift
+	if err := binary.Write(w, binary.LittleEndian, &version); err != nil {
 		return -1, err
 	}
 	if err := binary.Write(w, binary.LittleEndian, &h.SecondaryIndices); err != nil {
@@ -127,10 +132,17 @@ func ParseHeader(r io.Reader) (*Header, error) {
 		return nil, err
 	}
 
+	out.Compression = Compression(out.Version >> compressionVersionShift)
+	out.Version &= versionMask
+
 	if out.Version > CurrentSegmentVersion {
 		return nil, fmt.Errorf("unsupported version %d", out.Version)
 	}
 
+	if out.Compression > CompressionZstd {
+		return nil, fmt.Errorf("unsupported compression %d", out.Compression)
+	}
+
 	if err := binary.Read(r, binary.LittleEndian, &out.Strategy); err != nil {
 		return nil, err
 	}
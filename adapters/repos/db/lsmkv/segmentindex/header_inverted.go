@@ -37,6 +37,17 @@ type HeaderInverted struct {
 	DataFields            []varenc.VarEncDataType
 }
 
+// HasTermPositions returns whether the segment stores the term positions of
+// its postings
+func (h *HeaderInverted) HasTermPositions() bool {
+	for _, df := range h.DataFields {
+		if df == varenc.TermPositions {
+			return true
+		}
+	}
+	return false
+}
+
 func LoadHeaderInverted(headerBytes []byte) (*HeaderInverted, error) {
 	header := &HeaderInverted{}
 
@@ -48,8 +59,8 @@ func LoadHeaderInverted(headerBytes []byte) (*HeaderInverted, error) {
 	header.DataFieldCount = headerBytes[26]
 
 	header.DataFields = make([]varenc.VarEncDataType, header.DataFieldCount)
-	for i, b := range headerBytes[27:] {
-		header.DataFields[i] = varenc.VarEncDataType(b)
+	for i := range header.DataFields {
+		header.DataFields[i] = varenc.VarEncDataType(headerBytes[27+i])
 	}
 
 	return header, nil
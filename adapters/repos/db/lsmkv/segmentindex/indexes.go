@@ -28,11 +28,17 @@ type Indexes struct {
 	Keys                []Key
 	SecondaryIndexCount uint16
 	ScratchSpacePath    string
+	// IndexStart is the physical position the indexes are written to. It only
+	// needs to be set if it differs from the end of the last key, which is the
+	// case for compressed segments.
+	IndexStart uint64
 }
 
 func (s *Indexes) WriteTo(w io.Writer) (int64, error) {
 	var currentOffset uint64 = HeaderSize
-	if len(s.Keys) > 0 {
+	if s.IndexStart != 0 {
+		currentOffset = s.IndexStart
+	} else if len(s.Keys) > 0 {
 		currentOffset = uint64(s.Keys[len(s.Keys)-1].ValueEnd)
 	}
 	var written int64
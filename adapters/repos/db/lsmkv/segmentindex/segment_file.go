@@ -60,6 +60,10 @@ type SegmentFile struct {
 	// when it is later re-written
 	writtenTo         bool
 	checksumsDisabled bool
+	// optional block compression of the data section, see blockWriter
+	compression Compression
+	blocks      *blockWriter
+	indexStart  uint64
 }
 
 type SegmentFileOption func(*SegmentFile)
@@ -92,6 +96,16 @@ func WithChecksumsDisabled(disable bool) SegmentFileOption {
 
 // NewSegmentFile creates a new instance of SegmentFile.
 // Be sure to include a writer or reader option depending on your needs.
+// WithCompression compresses everything written through the BodyWriter in
+// blocks. Callers keep tracking logical (uncompressed) offsets, the physical
+// start of the indexes can be retrieved through IndexStart after the indexes
+// were written.
+func WithCompression(compression Compression) SegmentFileOption {
+	return func(segmentFile *SegmentFile) {
+		segmentFile.compression = compression
+	}
+}
+
 func NewSegmentFile(opts ...SegmentFileOption) *SegmentFile {
 	s := &SegmentFile{
 		checksumsDisabled: true,
@@ -115,12 +129,41 @@ func NewSegmentFile(opts ...SegmentFileOption) *SegmentFile {
 func (f *SegmentFile) BodyWriter() io.Writer {
 	f.writtenTo = true
 
+	if f.compression != CompressionNone {
+		return f.blockWriter()
+	}
+	return f.uncompressedBodyWriter()
+}
+
+func (f *SegmentFile) uncompressedBodyWriter() io.Writer {
 	if f.checksumsDisabled {
 		return f.writer
 	}
 	return f.checksumWriter
 }
 
+func (f *SegmentFile) blockWriter() *blockWriter {
+	if f.blocks == nil {
+		f.blocks = newBlockWriter(f.uncompressedBodyWriter(), f.compression)
+	}
+	return f.blocks
+}
+
+// Header returns the header previously passed to WriteHeader
+func (f *SegmentFile) Header() *Header {
+	return f.header
+}
+
+// IndexStart returns the physical position of the indexes. For uncompressed
+// segments this is the logical end of the data that was passed in, for
+// compressed segments it is only known after WriteIndexes was called.
+func (f *SegmentFile) IndexStart(dataEnd uint64) uint64 {
+	if f.compression == CompressionNone {
+		return dataEnd
+	}
+	return f.indexStart
+}
+
 // WriteHeader writes the header struct to the underlying writer.
 // This method resets the internal hash, so that the header can be written
 // to the checksum last. For more details see SegmentFile.
@@ -131,6 +174,7 @@ func (f *SegmentFile) WriteHeader(header *Header) (int64, error) {
 	}
 
 	if f.checksumsDisabled {
+		f.header = header
 		if f.writtenTo {
 			return 0, nil
 		}
@@ -165,6 +209,15 @@ func (f *SegmentFile) WriteIndexes(indexes *Indexes) (int64, error) {
 			"try adding one with segmentindex.WithBufferedWriter(*bufio.Writer)")
 	}
 
+	if f.compression != CompressionNone {
+		indexStart, err := f.blockWriter().close()
+		if err != nil {
+			return 0, fmt.Errorf("write segment file blocks: %w", err)
+		}
+		f.indexStart = indexStart
+		indexes.IndexStart = indexStart
+	}
+
 	if f.checksumsDisabled {
 		return indexes.WriteTo(f.writer)
 	}
@@ -29,7 +29,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/entities/logarchive"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	wsync "github.com/weaviate/weaviate/entities/sync"
 )
@@ -58,6 +60,11 @@ type Store struct {
 
 	closeLock sync.RWMutex
 	closed    bool
+
+	// applied to all buckets of the store, see WithEncryption
+	encryption encryption.KeyProvider
+	// applied to all buckets of the store, see WithLogArchiver
+	archiver logarchive.Archiver
 }
 
 // New initializes a new [Store] based on the root dir. If state is present on
@@ -126,6 +133,34 @@ func (s *Store) init() error {
 	return nil
 }
 
+// SetEncryption configures the key provider used by all buckets which are
+// created or loaded afterwards.
+func (s *Store) SetEncryption(keys encryption.KeyProvider) {
+	s.encryption = keys
+}
+
+// SetLogArchiver configures the archiver used by all buckets which are
+// created or loaded afterwards.
+func (s *Store) SetLogArchiver(a logarchive.Archiver) {
+	s.archiver = a
+}
+
+// bucketOptions prepends the store-wide defaults, so that they can still be
+// overridden per bucket.
+func (s *Store) bucketOptions(opts []BucketOption) []BucketOption {
+	var defaults []BucketOption
+	if s.encryption != nil {
+		defaults = append(defaults, WithEncryption(s.encryption))
+	}
+	if s.archiver != nil {
+		defaults = append(defaults, WithLogArchiver(s.archiver))
+	}
+	if len(defaults) == 0 {
+		return opts
+	}
+	return append(defaults, opts...)
+}
+
 func (s *Store) bucketDir(bucketName string) string {
 	return path.Join(s.dir, bucketName)
 }
@@ -191,7 +226,7 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	// bucket can be concurrently loaded with another buckets but
 	// the same bucket will be loaded only once
 	b, err := s.bcreator.NewBucket(ctx, s.bucketDir(bucketName), s.rootDir, s.logger, s.metrics,
-		compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.bucketOptions(opts)...)
 	if err != nil {
 		return err
 	}
@@ -448,7 +483,7 @@ func (s *Store) CreateBucket(ctx context.Context, bucketName string,
 	}
 
 	b, err := s.bcreator.NewBucket(ctx, bucketDir, s.rootDir, s.logger, s.metrics,
-		compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.bucketOptions(opts)...)
 	if err != nil {
 		return err
 	}
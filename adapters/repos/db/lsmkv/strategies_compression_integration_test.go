@@ -0,0 +1,278 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestBlockCompression(t *testing.T) {
+	ctx := testCtx()
+	for _, compression := range []string{"snappy", "zstd"} {
+		t.Run(compression, func(t *testing.T) {
+			tests := bucketIntegrationTests{
+				{
+					name: "replaceInsertAndUpdate_WithSecondaryKeys",
+					f:    replaceInsertAndUpdate_WithSecondaryKeys,
+					opts: []BucketOption{
+						WithStrategy(StrategyReplace),
+						WithSecondaryIndices(1),
+						WithBlockCompression(compression),
+					},
+				},
+				{
+					name: "replaceInsertAndDelete",
+					f:    replaceInsertAndDelete,
+					opts: []BucketOption{
+						WithStrategy(StrategyReplace),
+						WithBlockCompression(compression),
+					},
+				},
+				{
+					name: "replaceCursors",
+					f:    replaceCursors,
+					opts: []BucketOption{
+						WithStrategy(StrategyReplace),
+						WithBlockCompression(compression),
+					},
+				},
+				{
+					name: "compactionReplaceStrategy",
+					f: func(ctx context.Context, t *testing.T, opts []BucketOption) {
+						// the upper bound is the size of the uncompressed segment
+						compactionReplaceStrategy(ctx, t, opts, 1, 12116)
+					},
+					opts: []BucketOption{
+						WithStrategy(StrategyReplace),
+						WithBlockCompression(compression),
+					},
+				},
+				{
+					name: "compactionReplaceStrategy_WithSecondaryKeys",
+					f:    compactionReplaceStrategy_WithSecondaryKeys,
+					opts: []BucketOption{
+						WithStrategy(StrategyReplace),
+						WithSecondaryIndices(1),
+						WithBlockCompression(compression),
+					},
+				},
+				{
+					name: "compactionReplaceStrategy_FrequentPutDeleteOperations_WithSecondaryKeys",
+					f:    compactionReplaceStrategy_FrequentPutDeleteOperations_WithSecondaryKeys,
+					opts: []BucketOption{
+						WithStrategy(StrategyReplace),
+						WithSecondaryIndices(1),
+						WithBlockCompression(compression),
+					},
+				},
+				{
+					name: "compactionSetStrategy_RemoveUnnecessary",
+					f:    compactionSetStrategy_RemoveUnnecessary,
+					opts: []BucketOption{
+						WithStrategy(StrategySetCollection),
+						WithBlockCompression(compression),
+					},
+				},
+				{
+					name: "compactionSetStrategy_FrequentPutDeleteOperations",
+					f:    compactionSetStrategy_FrequentPutDeleteOperations,
+					opts: []BucketOption{
+						WithStrategy(StrategySetCollection),
+						WithBlockCompression(compression),
+					},
+				},
+				{
+					name: "compactionMapStrategy_RemoveUnnecessary",
+					f:    compactionMapStrategy_RemoveUnnecessary,
+					opts: []BucketOption{
+						WithStrategy(StrategyMapCollection),
+						WithBlockCompression(compression),
+					},
+				},
+				{
+					name: "compactionMapStrategy_FrequentPutDeleteOperations",
+					f:    compactionMapStrategy_FrequentPutDeleteOperations,
+					opts: []BucketOption{
+						WithStrategy(StrategyMapCollection),
+						WithBlockCompression(compression),
+					},
+				},
+				{
+					name: "blockCompressionAcrossRestarts",
+					f:    blockCompressionAcrossRestarts,
+					opts: []BucketOption{
+						WithStrategy(StrategyReplace),
+						WithBlockCompression(compression),
+					},
+				},
+			}
+			tests.run(ctx, t)
+		})
+	}
+}
+
+func TestBlockCompressionUnsupportedStrategy(t *testing.T) {
+	ctx := testCtx()
+
+	_, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", nullLogger(), nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyRoaringSet), WithBlockCompression("zstd"))
+	require.NotNil(t, err)
+
+	_, err = NewBucketCreator().NewBucket(ctx, t.TempDir(), "", nullLogger(), nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace), WithBlockCompression("lz4"))
+	require.NotNil(t, err)
+}
+
+func blockCompressionAcrossRestarts(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+	// values spanning multiple blocks, as well as many small ones sharing a
+	// block
+	value := func(i int) []byte {
+		if i%100 == 0 {
+			return bytes.Repeat([]byte(fmt.Sprintf(`{"id":%d,"large":true}`, i)), 2000)
+		}
+		return []byte(fmt.Sprintf(`{"id":%d,"name":"object number %d","tags":["a","b","c"]}`, i, i))
+	}
+	key := func(i int) []byte {
+		return []byte(fmt.Sprintf("key-%05d", i))
+	}
+	count := 1000
+
+	newBucket := func(t *testing.T, opts ...BucketOption) *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, dirName, "", nullLogger(), nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+		require.Nil(t, err)
+		b.SetMemtableThreshold(1e9)
+		return b
+	}
+
+	verify := func(t *testing.T, b *Bucket) {
+		for i := 0; i < count; i++ {
+			v, err := b.Get(key(i))
+			require.Nil(t, err)
+			if i%3 == 0 {
+				assert.Nil(t, v)
+			} else {
+				assert.Equal(t, value(i), v)
+			}
+		}
+
+		var expectedKeys, keys [][]byte
+		expectedValues := map[string][]byte{}
+		for i := 0; i < count; i++ {
+			if i%3 != 0 {
+				expectedKeys = append(expectedKeys, key(i))
+				expectedValues[string(key(i))] = value(i)
+			}
+		}
+
+		c := b.Cursor()
+		defer c.Close()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			keys = append(keys, copyByteSlice(k))
+			assert.Equal(t, expectedValues[string(k)], v)
+		}
+		assert.Equal(t, expectedKeys, keys)
+		assert.Equal(t, len(expectedKeys), b.Count())
+	}
+
+	t.Run("write two compressed segments", func(t *testing.T) {
+		b := newBucket(t, opts...)
+		defer b.Shutdown(ctx)
+
+		for i := 0; i < count; i++ {
+			require.Nil(t, b.Put(key(i), value(i)))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+
+		for i := 0; i < count; i += 3 {
+			require.Nil(t, b.Delete(key(i)))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+
+		verify(t, b)
+	})
+
+	t.Run("segments are compressed", func(t *testing.T) {
+		files, err := os.ReadDir(dirName)
+		require.Nil(t, err)
+
+		segments := 0
+		for _, f := range files {
+			if filepath.Ext(f.Name()) != ".db" {
+				continue
+			}
+			contents, err := os.ReadFile(filepath.Join(dirName, f.Name()))
+			require.Nil(t, err)
+			header, err := segmentindex.ParseHeader(bytes.NewReader(contents))
+			require.Nil(t, err)
+			assert.NotEqual(t, segmentindex.CompressionNone, header.Compression)
+			segments++
+		}
+		assert.Equal(t, 2, segments)
+	})
+
+	t.Run("reopen with checksum validation and compact", func(t *testing.T) {
+		b := newBucket(t, append(opts, WithSegmentsChecksumValidationEnabled(true))...)
+		defer b.Shutdown(ctx)
+
+		verify(t, b)
+
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		require.True(t, compacted)
+
+		verify(t, b)
+
+		b.disk.maintenanceLock.RLock()
+		defer b.disk.maintenanceLock.RUnlock()
+		require.Len(t, b.disk.segments, 1)
+		seg := b.disk.segments[0]
+		assert.NotNil(t, seg.blocks)
+		assert.Less(t, seg.PayloadSize(), int(seg.dataEndPos)/2)
+	})
+
+	t.Run("reopen without compression", func(t *testing.T) {
+		b := newBucket(t, WithStrategy(StrategyReplace))
+		defer b.Shutdown(ctx)
+
+		verify(t, b)
+
+		require.Nil(t, b.Put(key(0), value(0)))
+		require.Nil(t, b.FlushAndSwitch())
+
+		b.disk.maintenanceLock.RLock()
+		require.Len(t, b.disk.segments, 2)
+		assert.NotNil(t, b.disk.segments[0].blocks)
+		assert.Nil(t, b.disk.segments[1].blocks)
+		b.disk.maintenanceLock.RUnlock()
+
+		v, err := b.Get(key(0))
+		require.Nil(t, err)
+		assert.Equal(t, value(0), v)
+	})
+}
@@ -0,0 +1,382 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/encryption"
+)
+
+// testKeys is a static in-memory key provider, the current key can be
+// rotated at any time.
+type testKeys struct {
+	sync.Mutex
+	current string
+	ids     map[string]struct{}
+}
+
+func newTestKeys(current string, ids ...string) *testKeys {
+	k := &testKeys{current: current, ids: map[string]struct{}{}}
+	for _, id := range append(ids, current) {
+		k.ids[id] = struct{}{}
+	}
+	return k
+}
+
+func (k *testKeys) rotate(current string) {
+	k.Lock()
+	defer k.Unlock()
+	k.current = current
+	k.ids[current] = struct{}{}
+}
+
+func (k *testKeys) CurrentKey() (encryption.Key, error) {
+	k.Lock()
+	current := k.current
+	k.Unlock()
+	return k.Key(current)
+}
+
+func (k *testKeys) Key(id string) (encryption.Key, error) {
+	k.Lock()
+	defer k.Unlock()
+	if _, ok := k.ids[id]; !ok {
+		return encryption.Key{}, encryption.ErrKeyNotFound
+	}
+	material := sha256.Sum256([]byte(id))
+	return encryption.Key{ID: id, Material: material[:]}, nil
+}
+
+func TestEncryption(t *testing.T) {
+	ctx := testCtx()
+	keys := newTestKeys("k1")
+	tests := bucketIntegrationTests{
+		{
+			name: "replaceInsertAndUpdate_WithSecondaryKeys",
+			f:    replaceInsertAndUpdate_WithSecondaryKeys,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithSecondaryIndices(1),
+				WithEncryption(keys),
+			},
+		},
+		{
+			name: "replaceCursors",
+			f:    replaceCursors,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithEncryption(keys),
+			},
+		},
+		{
+			name: "compactionReplaceStrategy_WithSecondaryKeys",
+			f:    compactionReplaceStrategy_WithSecondaryKeys,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithSecondaryIndices(1),
+				WithEncryption(keys),
+			},
+		},
+		{
+			name: "compactionSetStrategy_RemoveUnnecessary",
+			f:    compactionSetStrategy_RemoveUnnecessary,
+			opts: []BucketOption{
+				WithStrategy(StrategySetCollection),
+				WithEncryption(keys),
+			},
+		},
+		{
+			name: "compactionMapStrategy_RemoveUnnecessary",
+			f:    compactionMapStrategy_RemoveUnnecessary,
+			opts: []BucketOption{
+				WithStrategy(StrategyMapCollection),
+				WithEncryption(keys),
+			},
+		},
+		{
+			name: "compactionRoaringSetStrategy_RemoveUnnecessary",
+			f:    compactionRoaringSetStrategy_RemoveUnnecessary,
+			opts: []BucketOption{
+				WithStrategy(StrategyRoaringSet),
+				WithEncryption(keys),
+			},
+		},
+		{
+			name: "compactionRoaringSetRangeStrategy_RemoveUnnecessary",
+			f:    compactionRoaringSetRangeStrategy_RemoveUnnecessary,
+			opts: []BucketOption{
+				WithStrategy(StrategyRoaringSetRange),
+				WithEncryption(keys),
+			},
+		},
+		{
+			name: "compactionInvertedStrategy_RemoveUnnecessary",
+			f:    compactionInvertedStrategy_RemoveUnnecessary,
+			opts: []BucketOption{
+				WithStrategy(StrategyInverted),
+				WithEncryption(keys),
+			},
+		},
+	}
+	tests.run(ctx, t)
+}
+
+func TestEncryptionRotationAndRecovery(t *testing.T) {
+	ctx := testCtx()
+	dirName := t.TempDir()
+	keys := newTestKeys("k1")
+	secret := []byte("top secret value")
+
+	key := func(i int) []byte {
+		return []byte(fmt.Sprintf("key-%05d", i))
+	}
+	value := func(i int) []byte {
+		return append(append([]byte{}, secret...), []byte(fmt.Sprintf(" %d", i))...)
+	}
+	count := 500
+
+	newBucket := func(t *testing.T, dir string, keys encryption.KeyProvider) *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", nullLogger(), nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace), WithEncryption(keys),
+			WithSegmentsChecksumValidationEnabled(true))
+		require.Nil(t, err)
+		b.SetMemtableThreshold(1e9)
+		return b
+	}
+
+	verify := func(t *testing.T, b *Bucket, upTo int) {
+		for i := 0; i < upTo; i++ {
+			v, err := b.Get(key(i))
+			require.Nil(t, err)
+			if i%3 == 0 {
+				assert.Nil(t, v)
+			} else {
+				assert.Equal(t, value(i), v)
+			}
+		}
+	}
+
+	// keyIDs returns the key of each file which contains data, and makes sure
+	// that none of them reveals the values
+	keyIDs := func(t *testing.T, dir string) map[string]string {
+		files, err := os.ReadDir(dir)
+		require.Nil(t, err)
+
+		ids := map[string]string{}
+		for _, f := range files {
+			switch filepath.Ext(f.Name()) {
+			case ".db", ".wal":
+			default:
+				continue
+			}
+			path := filepath.Join(dir, f.Name())
+			contents, err := os.ReadFile(path)
+			require.Nil(t, err)
+			assert.False(t, bytes.Contains(contents, secret), f.Name())
+
+			ids[f.Name()], err = encryption.KeyID(path)
+			require.Nil(t, err)
+		}
+		return ids
+	}
+
+	t.Run("write segments with two different keys", func(t *testing.T) {
+		b := newBucket(t, dirName, keys)
+		defer b.Shutdown(ctx)
+
+		for i := 0; i < count; i++ {
+			require.Nil(t, b.Put(key(i), value(i)))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+
+		keys.rotate("k2")
+		for i := 0; i < count; i += 3 {
+			require.Nil(t, b.Delete(key(i)))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+
+		verify(t, b, count)
+	})
+
+	t.Run("segments are encrypted with the key current at flush", func(t *testing.T) {
+		ids := keyIDs(t, dirName)
+		var used []string
+		for _, id := range ids {
+			used = append(used, id)
+		}
+		assert.ElementsMatch(t, []string{"k1", "k2"}, used)
+	})
+
+	t.Run("compaction re-encrypts with the current key", func(t *testing.T) {
+		b := newBucket(t, dirName, keys)
+		defer b.Shutdown(ctx)
+
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		require.True(t, compacted)
+		verify(t, b, count)
+
+		for name, id := range keyIDs(t, dirName) {
+			assert.Equal(t, "k2", id, name)
+		}
+	})
+
+	t.Run("old key is no longer needed", func(t *testing.T) {
+		b := newBucket(t, dirName, newTestKeys("k2"))
+		defer b.Shutdown(ctx)
+
+		verify(t, b, count)
+	})
+
+	t.Run("missing key fails to load", func(t *testing.T) {
+		_, err := NewBucketCreator().NewBucket(ctx, dirName, "", nullLogger(), nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace), WithEncryption(newTestKeys("k3")))
+		require.NotNil(t, err)
+
+		_, err = NewBucketCreator().NewBucket(ctx, dirName, "", nullLogger(), nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace))
+		require.NotNil(t, err)
+	})
+
+	t.Run("recover from an encrypted WAL", func(t *testing.T) {
+		b := newBucket(t, dirName, keys)
+		for i := count; i < 2*count; i++ {
+			require.Nil(t, b.Put(key(i), value(i)))
+		}
+		for i := count; i < 2*count; i++ {
+			if i%3 == 0 {
+				require.Nil(t, b.Delete(key(i)))
+			}
+		}
+		require.Nil(t, b.WriteWAL())
+
+		ids := keyIDs(t, dirName)
+		walFound := false
+		for name, id := range ids {
+			if filepath.Ext(name) == ".wal" {
+				walFound = true
+				assert.Equal(t, "k2", id)
+			}
+		}
+		require.True(t, walFound)
+
+		// copy the state as if the process crashed
+		recovered := t.TempDir()
+		for name := range ids {
+			copyFile(t, filepath.Join(dirName, name), filepath.Join(recovered, name))
+		}
+		require.Nil(t, b.Shutdown(ctx))
+
+		bRec := newBucket(t, recovered, keys)
+		defer bRec.Shutdown(ctx)
+		verify(t, bRec, 2*count)
+	})
+}
+
+func TestEncryptionDecryptsDataOnDemand(t *testing.T) {
+	ctx := testCtx()
+	secret := []byte("top secret value")
+	count := 2000
+
+	key := func(i int) []byte {
+		return []byte(fmt.Sprintf("key-%05d", i))
+	}
+	value := func(i int) []byte {
+		return append(append([]byte{}, secret...), []byte(fmt.Sprintf(" %d", i))...)
+	}
+
+	for _, tt := range []struct {
+		name string
+		opts []BucketOption
+	}{
+		{name: "mmap", opts: []BucketOption{WithStrategy(StrategyReplace)}},
+		{name: "pread", opts: []BucketOption{WithStrategy(StrategyReplace), WithPread(true)}},
+		{name: "compressed", opts: []BucketOption{
+			WithStrategy(StrategyReplace), WithBlockCompression("zstd"),
+		}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := append(tt.opts, WithEncryption(newTestKeys("k1")),
+				WithSegmentsChecksumValidationEnabled(true), WithCalcCountNetAdditions(true))
+			b, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", nullLogger(), nil,
+				cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+				opts...)
+			require.Nil(t, err)
+			defer b.Shutdown(ctx)
+
+			for i := 0; i < count; i++ {
+				require.Nil(t, b.Put(key(i), value(i)))
+			}
+			require.Nil(t, b.FlushAndSwitch())
+
+			segments, release := b.disk.getAndLockSegments()
+			defer release()
+			require.Len(t, segments, 1)
+			seg := segments[0]
+
+			// only the header and the indexes are held in memory, the
+			// values are decrypted when they are read
+			assert.True(t, seg.partialContents)
+			assert.False(t, seg.mmapContents)
+			assert.False(t, bytes.Contains(seg.contents, secret))
+			assert.Equal(t, count, seg.countNetAdditions)
+
+			for i := 0; i < count; i++ {
+				v, err := b.Get(key(i))
+				require.Nil(t, err)
+				require.Equal(t, value(i), v)
+			}
+
+			c := b.Cursor()
+			defer c.Close()
+			i := 0
+			for k, v := c.First(); k != nil; k, v = c.Next() {
+				require.Equal(t, key(i), k)
+				require.Equal(t, value(i), v)
+				i++
+			}
+			assert.Equal(t, count, i)
+		})
+	}
+}
+
+func copyFile(t *testing.T, src, dst string) {
+	t.Helper()
+
+	in, err := os.Open(src)
+	require.Nil(t, err)
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	require.Nil(t, err)
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	require.Nil(t, err)
+}
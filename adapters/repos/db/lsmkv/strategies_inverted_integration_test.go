@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package lsmkv
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+func TestInvertedStrategyCursorSeek(t *testing.T) {
+	ctx := testCtx()
+	dirName := t.TempDir()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", nullLogger(), nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyInverted))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	for i := 0; i < 20; i++ {
+		for docID := 0; docID <= i%3; docID++ {
+			pair := NewMapPairFromDocIdAndTf(uint64(docID), float32(i), float32(i), false)
+			require.Nil(t, b.MapSet([]byte(fmt.Sprintf("row-%03d", i)), pair))
+		}
+	}
+
+	seek := func(t *testing.T, key string) ([]string, []int) {
+		var keys []string
+		var counts []int
+		c := b.MapCursor()
+		defer c.Close()
+		for k, v := c.Seek(ctx, []byte(key)); k != nil; k, v = c.Next(ctx) {
+			keys = append(keys, string(k))
+			counts = append(counts, len(v))
+		}
+		return keys, counts
+	}
+
+	for _, location := range []string{"memtable", "segment"} {
+		t.Run("seek to existing key in "+location, func(t *testing.T) {
+			keys, counts := seek(t, "row-016")
+			assert.Equal(t, []string{"row-016", "row-017", "row-018", "row-019"}, keys)
+			assert.Equal(t, []int{2, 3, 1, 2}, counts)
+		})
+
+		t.Run("seek between keys in "+location, func(t *testing.T) {
+			keys, _ := seek(t, "row-0175")
+			assert.Equal(t, []string{"row-018", "row-019"}, keys)
+		})
+
+		t.Run("seek past the last key in "+location, func(t *testing.T) {
+			keys, _ := seek(t, "row-1")
+			assert.Empty(t, keys)
+		})
+
+		require.Nil(t, b.FlushAndSwitch())
+	}
+}
+
+func TestInvertedStrategyPhrase(t *testing.T) {
+	ctx := testCtx()
+	dirName := t.TempDir()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", nullLogger(), nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyInverted))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	put := func(t *testing.T, docID uint64, tokens ...string) {
+		positions := map[string][]byte{}
+		for pos, token := range tokens {
+			positions[token] = binary.AppendUvarint(positions[token], uint64(pos))
+		}
+		for token, encoded := range positions {
+			pair := NewMapPairFromDocIdAndTf(docID, float32(len(encoded)), float32(len(tokens)), false)
+			pair.Value = append(pair.Value, encoded...)
+			require.Nil(t, b.MapSet([]byte(token), pair))
+		}
+	}
+
+	// counts the occurrences of the second term right after the first one
+	phraseFrequency := func(positions [][]byte) (int, error) {
+		following := map[uint64]bool{}
+		for data := positions[1]; len(data) > 0; {
+			pos, n := binary.Uvarint(data)
+			following[pos] = true
+			data = data[n:]
+		}
+		freq := 0
+		for data := positions[0]; len(data) > 0; {
+			pos, n := binary.Uvarint(data)
+			if following[pos+1] {
+				freq++
+			}
+			data = data[n:]
+		}
+		return freq, nil
+	}
+
+	phrase := func(t *testing.T) map[uint64]float32 {
+		postings, err := b.PhrasePostings(ctx, []string{"quick", "fox"}, phraseFrequency, 1)
+		require.Nil(t, err)
+		out := map[uint64]float32{}
+		for _, p := range postings {
+			out[p.Id] = p.Frequency
+		}
+		return out
+	}
+
+	put(t, 0, "the", "quick", "fox")
+	put(t, 1, "fox", "quick")
+	put(t, 2, "quick", "brown", "fox")
+	put(t, 3, "quick", "fox", "quick", "fox")
+
+	t.Run("memtable", func(t *testing.T) {
+		assert.Equal(t, map[uint64]float32{0: 1, 3: 2}, phrase(t))
+	})
+
+	require.Nil(t, b.FlushAndSwitch())
+	t.Run("segment", func(t *testing.T) {
+		assert.Equal(t, map[uint64]float32{0: 1, 3: 2}, phrase(t))
+	})
+
+	put(t, 4, "quick", "fox")
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, 0)
+	for _, token := range []string{"the", "quick", "fox"} {
+		require.Nil(t, b.MapDeleteKey([]byte(token), key))
+	}
+	t.Run("newer memtable", func(t *testing.T) {
+		assert.Equal(t, map[uint64]float32{3: 2, 4: 1}, phrase(t))
+	})
+
+	require.Nil(t, b.FlushAndSwitch())
+	var compacted bool
+	for compacted, err = b.disk.compactOnce(); err == nil && compacted; compacted, err = b.disk.compactOnce() {
+	}
+	require.Nil(t, err)
+	require.Len(t, b.disk.segments, 1)
+	assert.True(t, b.disk.segments[0].invertedHeader.HasTermPositions())
+	t.Run("compacted segment", func(t *testing.T) {
+		assert.Equal(t, map[uint64]float32{3: 2, 4: 1}, phrase(t))
+	})
+
+	t.Run("block-max term", func(t *testing.T) {
+		results, counts, release, err := b.CreatePhraseTerm(10, nil, []string{"quick", "fox"}, phraseFrequency,
+			0, 1, 3, schema.BM25Config{K1: 1.2, B: 0.75}, ctx)
+		require.Nil(t, err)
+		defer release()
+
+		assert.Equal(t, map[string]uint64{"quick fox": 2}, counts)
+		ids := map[uint64]float64{}
+		for _, layer := range results {
+			for _, term := range layer {
+				for ; !term.Exhausted(); term.Advance() {
+					id, score, _ := term.Score(3, false)
+					ids[id] = score
+				}
+			}
+		}
+		require.Len(t, ids, 2)
+		assert.Greater(t, ids[3], ids[4])
+	})
+}
@@ -279,8 +279,9 @@ func (kv *MapPair) BytesInverted() ([]byte, error) {
 		return nil, errors.Errorf("inverted mapCollection key must be 8 bytes, got %d", len(kv.Key))
 	}
 
-	if len(kv.Value) != 8 {
-		return nil, errors.Errorf("inverted mapCollection value must be 8 bytes, got %d", len(kv.Value))
+	// term frequency and property length, optionally followed by term positions
+	if len(kv.Value) < 8 {
+		return nil, errors.Errorf("inverted mapCollection value must be at least 8 bytes, got %d", len(kv.Value))
 	}
 
 	out := bytes.NewBuffer(nil)
@@ -400,7 +401,9 @@ func (kv *MapPair) FromBytesInverted(in []byte, keyOnly bool) error {
 		return errors.Errorf("inverted map pair with value must be at least 16 bytes, got %d", len(in))
 	}
 
-	kv.Value = in[read : read+8]
+	// the value holds the term frequency and property length, optionally
+	// followed by the term positions
+	kv.Value = in[read:]
 	return nil
 }
 
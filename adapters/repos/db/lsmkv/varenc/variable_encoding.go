@@ -24,6 +24,11 @@ const (
 
 	// Add new data types here
 	DeltaVarIntUint64 = VarIntUint64 + 64
+
+	// TermPositions marks the term positions stored next to the doc ids and
+	// term frequencies of inverted segments. They are opaque to the segment
+	// and not decoded with a VarEncEncoder.
+	TermPositions = DeltaVarIntUint64 + 1
 )
 
 type VarEncEncoder[T any] interface {
@@ -21,9 +21,11 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ivf"
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
@@ -126,14 +128,22 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			HNSWAcornFilterRatio:                m.db.config.HNSWAcornFilterRatio,
 			VisitedListPoolMaxSize:              m.db.config.VisitedListPoolMaxSize,
 			TrackVectorDimensions:               m.db.config.TrackVectorDimensions,
+			ChangeDataCaptureEnabled:            m.db.config.ChangeDataCaptureEnabled,
+			ChangeDataCaptureRetention:          m.db.config.ChangeDataCaptureRetention,
 			AvoidMMap:                           m.db.config.AvoidMMap,
 			DisableLazyLoadShards:               m.db.config.DisableLazyLoadShards,
 			ForceFullReplicasSearch:             m.db.config.ForceFullReplicasSearch,
 			LSMEnableSegmentsChecksumValidation: m.db.config.LSMEnableSegmentsChecksumValidation,
+			LSMObjectsBlockCompression:          m.db.config.LSMObjectsBlockCompression,
 			ReplicationFactor:                   class.ReplicationConfig.Factor,
 			AsyncReplicationEnabled:             class.ReplicationConfig.AsyncEnabled,
 			DeletionStrategy:                    class.ReplicationConfig.DeletionStrategy,
 			ShardLoadLimiter:                    m.db.shardLoadLimiter,
+			ObjectTTLMetrics:                    m.db.objectTTLMetrics,
+			Encryption:                          m.db.config.Encryption,
+			TenantKeys:                          m.db.config.TenantKeys,
+			CreateMissingTenantKeys:             m.db.config.CreateMissingTenantKeys,
+			LogArchiver:                         m.db.config.LogArchiver,
 		},
 		shardState,
 		// no backward-compatibility check required, since newly added classes will
@@ -165,6 +175,12 @@ func (m *Migrator) DropClass(ctx context.Context, className string, hasFrozen bo
 		return err
 	}
 
+	if keys := m.db.config.TenantKeys; keys != nil {
+		if err := keys.DeleteClassKeys(ctx, className); err != nil {
+			return fmt.Errorf("delete encryption keys: %w", err)
+		}
+	}
+
 	if m.cloud != nil && hasFrozen {
 		return m.cloud.Delete(ctx, className, "", "")
 	}
@@ -188,6 +204,22 @@ func (m *Migrator) AddReplicaToShard(ctx context.Context, class string, shard st
 	return idx.LoadLocalShard(ctx, shard)
 }
 
+func (m *Migrator) DeleteReplicaFromShard(ctx context.Context, class string, shard string) error {
+	idx := m.db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return fmt.Errorf("could not find collection %s", class)
+	}
+	return idx.dropLocalShards([]string{shard})
+}
+
+func (m *Migrator) AbortShardSplit(ctx context.Context, class, source string, targets []string) error {
+	idx := m.db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return fmt.Errorf("could not find collection %s", class)
+	}
+	return idx.AbortShardSplit(ctx, source, targets)
+}
+
 // UpdateIndex ensures that the local index is up2date with the latest sharding
 // state (shards/tenants) and index properties that may have been added in the
 // case that the local node was down during a class update operation.
@@ -580,6 +612,14 @@ func (m *Migrator) DeleteTenants(ctx context.Context, class string, tenants []*m
 		return err
 	}
 
+	// deleting the keys makes any remaining copies of the tenants' data,
+	// such as backups, unreadable
+	if keys := m.db.config.TenantKeys; keys != nil {
+		if err := keys.DeleteTenantKeys(ctx, class, allTenantNames); err != nil {
+			return fmt.Errorf("delete encryption keys of tenants %v: %w", allTenantNames, err)
+		}
+	}
+
 	if m.cloud != nil && len(frozenTenants) > 0 {
 		if err := idx.dropCloudShards(ctx, m.cloud, frozenTenants, m.nodeId); err != nil {
 			return fmt.Errorf("drop tenant shards %v during update index: %w", frozenTenants, err)
@@ -634,6 +674,10 @@ func (m *Migrator) ValidateVectorIndexConfigUpdate(
 		return flat.ValidateUserConfigUpdate(old, updated)
 	case vectorindex.VectorIndexTypeDYNAMIC:
 		return dynamic.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeDISKANN:
+		return diskann.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeIVF:
+		return ivf.ValidateUserConfigUpdate(old, updated)
 	}
 	return fmt.Errorf("invalid index type: %s", old.IndexType())
 }
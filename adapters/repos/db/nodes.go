@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"sync"
 
 	"github.com/pkg/errors"
 
@@ -163,6 +164,28 @@ func (db *DB) localNodeBatchStats() *models.BatchStats {
 	return stats
 }
 
+// LocalVectorIndexQueueBacklog returns the number of vectors waiting in the
+// vector index queues of all loaded shards on this node. It is used to slow
+// down imports before the async indexing falls too far behind.
+func (db *DB) LocalVectorIndexQueueBacklog() int64 {
+	db.indexLock.RLock()
+	defer db.indexLock.RUnlock()
+
+	var backlog int64
+	for _, idx := range db.indices {
+		if idx == nil {
+			continue
+		}
+		_ = idx.ForEachLoadedShard(func(_ string, shard ShardLike) error {
+			return shard.ForEachVectorQueue(func(_ string, queue *VectorIndexQueue) error {
+				backlog += queue.Size()
+				return nil
+			})
+		})
+	}
+	return backlog
+}
+
 func (i *Index) getShardsNodeStatus(ctx context.Context,
 	status *[]*models.NodeShardStatus,
 ) (totalCount, shardCount int64) {
@@ -202,6 +225,8 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 			return nil
 		})
 
+		_, diskBytes, _ := shard.usage()
+
 		shardStatus := &models.NodeShardStatus{
 			Name:                 name,
 			Class:                shard.Index().Config.ClassName.String(),
@@ -209,6 +234,7 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 			VectorIndexingStatus: shard.GetStatus().String(),
 			VectorQueueLength:    queueLen,
 			Compressed:           compressed,
+			DiskBytes:            diskBytes,
 			Loaded:               true,
 		}
 		*status = append(*status, shardStatus)
@@ -218,6 +244,92 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 	return
 }
 
+// GetTenantUsage returns the usage of the tenants as measured last by the
+// nodes holding them, only the nodes holding one of the tenants are asked.
+// Replicas may differ slightly, the largest usage is reported. Tenants which
+// are not loaded or not measured yet on any reachable node are left out.
+func (db *DB) GetTenantUsage(ctx context.Context, className string, tenants []string) (map[string]*models.TenantUsage, error) {
+	tenantsByNode := map[string][]string{}
+	for _, tenant := range tenants {
+		nodes, err := db.schemaGetter.ShardReplicas(className, tenant)
+		if err != nil {
+			return nil, fmt.Errorf("replicas of tenant %q: %w", tenant, err)
+		}
+		for _, node := range nodes {
+			tenantsByNode[node] = append(tenantsByNode[node], tenant)
+		}
+	}
+
+	var (
+		usage = make(map[string]*models.TenantUsage, len(tenants))
+		mu    sync.Mutex
+	)
+	eg := enterrors.NewErrorGroupWrapper(db.logger)
+	eg.SetLimit(_NUMCPU)
+	for nodeName, nodeTenants := range tenantsByNode {
+		nodeName, nodeTenants := nodeName, nodeTenants
+		eg.Go(func() error {
+			nodeUsage, err := db.getTenantUsage(ctx, nodeName, className, nodeTenants)
+			if err != nil {
+				// the other replicas may still report the tenants
+				db.logger.WithField("action", "tenant_usage").WithField("node", nodeName).
+					WithError(err).Warn("could not get the usage of tenants")
+				return nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for tenant, u := range nodeUsage {
+				if prev, ok := usage[tenant]; ok {
+					u.ObjectCount = max(u.ObjectCount, prev.ObjectCount)
+					u.DiskBytes = max(u.DiskBytes, prev.DiskBytes)
+				}
+				usage[tenant] = u
+			}
+			return nil
+		}, nodeName)
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+func (db *DB) getTenantUsage(ctx context.Context, nodeName, className string, tenants []string) (map[string]*models.TenantUsage, error) {
+	if db.schemaGetter.NodeName() == nodeName {
+		return db.localTenantUsage(className, tenants), nil
+	}
+	return db.remoteNode.GetTenantUsage(ctx, nodeName, className, tenants)
+}
+
+func (db *DB) IncomingGetTenantUsage(ctx context.Context, className string, tenants []string) (map[string]*models.TenantUsage, error) {
+	return db.localTenantUsage(className, tenants), nil
+}
+
+// localTenantUsage reads the usage of the tenants loaded on this node from
+// the values cached by the usage cycle, it neither loads nor measures shards
+func (db *DB) localTenantUsage(className string, tenants []string) map[string]*models.TenantUsage {
+	usage := map[string]*models.TenantUsage{}
+	index := db.GetIndex(schema.ClassName(className))
+	if index == nil {
+		return usage
+	}
+
+	for _, tenant := range tenants {
+		shard := index.shards.Load(tenant)
+		if shard == nil {
+			continue
+		}
+		objectCount, diskBytes, measured := shard.usage()
+		if !measured {
+			continue
+		}
+		usage[tenant] = &models.TenantUsage{ObjectCount: objectCount, DiskBytes: diskBytes}
+	}
+	return usage
+}
+
 func (db *DB) GetNodeStatistics(ctx context.Context) ([]*models.Statistics, error) {
 	nodeStatistics := make([]*models.Statistics, len(db.schemaGetter.Nodes()))
 	eg := enterrors.NewErrorGroupWrapper(db.logger)
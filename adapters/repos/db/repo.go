@@ -20,6 +20,9 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/weaviate/weaviate/entities/encryption"
+	"github.com/weaviate/weaviate/entities/logarchive"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/storobj"
 
 	"github.com/cenkalti/backoff/v4"
@@ -92,6 +95,7 @@ type DB struct {
 	metricsObserver *nodeWideMetricsObserver
 
 	shardLoadLimiter ShardLoadLimiter
+	objectTTLMetrics *ObjectTTLMetrics
 
 	reindexer ShardReindexerV3
 }
@@ -165,6 +169,7 @@ func New(logger logrus.FieldLogger, config Config,
 		resourceScanState:   newResourceScanState(),
 		memMonitor:          memMonitor,
 		shardLoadLimiter:    NewShardLoadLimiter(metricsRegisterer, config.MaximumConcurrentShardLoads),
+		objectTTLMetrics:    NewObjectTTLMetrics(metricsRegisterer, promMetrics != nil && promMetrics.Group),
 		reindexer:           NewShardReindexerV3Noop(),
 	}
 
@@ -219,15 +224,22 @@ type Config struct {
 	HNSWAcornFilterRatio                float64
 	VisitedListPoolMaxSize              int
 	TrackVectorDimensions               bool
+	ChangeDataCaptureEnabled            bool
+	ChangeDataCaptureRetention          time.Duration
 	ServerVersion                       string
 	GitHash                             string
 	AvoidMMap                           bool
 	DisableLazyLoadShards               bool
 	ForceFullReplicasSearch             bool
 	LSMEnableSegmentsChecksumValidation bool
+	LSMObjectsBlockCompression          string
 	Replication                         replication.GlobalConfig
 	MaximumConcurrentShardLoads         int
 	CycleManagerRoutinesFactor          int
+	Encryption                          encryption.KeyProvider
+	TenantKeys                          modulecapabilities.KeyProvider
+	CreateMissingTenantKeys             bool
+	LogArchiver                         logarchive.Archiver
 }
 
 // GetIndex returns the index if it exists or nil if it doesn't
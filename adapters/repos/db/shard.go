@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/weaviate/weaviate/cluster/router/types"
+	"github.com/weaviate/weaviate/entities/encryption"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"go.etcd.io/bbolt"
 
@@ -37,6 +38,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/changelog"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
@@ -118,6 +120,7 @@ type ShardLike interface {
 
 	isReadOnly() error
 	pathLSM() string
+	usage() (objectCount, diskBytes int64, measured bool)
 
 	preparePutObject(context.Context, string, *storobj.Object) replica.SimpleResponse
 	preparePutObjects(context.Context, string, []*storobj.Object) replica.SimpleResponse
@@ -151,6 +154,7 @@ type ShardLike interface {
 	batchDeleteObject(ctx context.Context, id strfmt.UUID, deletionTime time.Time) error
 	putObjectLSM(object *storobj.Object, idBytes []byte) (objectInsertStatus, error)
 	mayUpsertObjectHashTree(object *storobj.Object, idBytes []byte, status objectInsertStatus) error
+	readChangeLog(after uint64, limit int) ([]changelog.Record, <-chan struct{}, error)
 	mutableMergeObjectLSM(merge objects.MergeDocument, idBytes []byte) (mutableMergeResult, error)
 	batchExtendInvertedIndexItemsLSMNoFrequency(b *lsmkv.Bucket, item inverted.MergeItem) error
 	updatePropertySpecificIndices(ctx context.Context, object *storobj.Object, status objectInsertStatus) error
@@ -260,6 +264,14 @@ type Shard struct {
 	searchableBlockmaxPropNamesLock *sync.Mutex
 
 	usingBlockMaxWAND bool
+
+	// nil if change data capture is disabled
+	changeLog *shardChangeLog
+
+	// nil if encryption at rest is disabled
+	encryption encryption.KeyProvider
+
+	quotaUsage shardUsage
 }
 
 func (s *Shard) ID() string {
@@ -16,8 +16,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/encryption"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 )
 
@@ -80,14 +82,44 @@ func (s *Shard) ListBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 		return err
 	}
 
-	return s.ForEachVectorIndex(func(targetVector string, idx VectorIndex) error {
+	if err := s.ForEachVectorIndex(func(targetVector string, idx VectorIndex) error {
 		files, err := idx.ListFiles(ctx, s.index.Config.RootPath)
 		if err != nil {
 			return fmt.Errorf("list files of vector %q: %w", targetVector, err)
 		}
 		ret.Files = append(ret.Files, files...)
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	ret.EncryptionKeyIDs, err = s.encryptionKeyIDs(ret.Files)
+	return err
+}
+
+// encryptionKeyIDs returns the keys the given files are encrypted with, so
+// that a restore can verify they are available before copying any data.
+func (s *Shard) encryptionKeyIDs(files []string) ([]string, error) {
+	if s.encryption == nil {
+		// the shard could not have been loaded with encrypted files
+		return nil, nil
+	}
+	set := map[string]struct{}{}
+	for _, f := range files {
+		id, err := encryption.KeyID(filepath.Join(s.index.Config.RootPath, f))
+		if err != nil {
+			return nil, fmt.Errorf("read encryption header of %s: %w", f, err)
+		}
+		if id != "" {
+			set[id] = struct{}{}
+		}
+	}
+	ids := make([]string, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
 }
 
 func (s *Shard) resumeMaintenanceCycles(ctx context.Context) error {
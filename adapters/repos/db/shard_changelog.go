@@ -0,0 +1,334 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// changeLogLastSequenceKey holds the last sequence number handed out. All
+// records are keyed by their 8 byte sequence number, so the longer key can
+// never collide with a record.
+var changeLogLastSequenceKey = []byte("last_sequence")
+
+// changeLogTrimBatchSize limits how many records are removed by a single
+// retention cycle, so that a large backlog is trimmed in steps.
+const changeLogTrimBatchSize = 10_000
+
+// shardChangeLog is the ordered log of all object mutations of a shard
+// replica. It is only maintained if change data capture is enabled.
+type shardChangeLog struct {
+	sync.Mutex
+	bucket   *lsmkv.Bucket
+	lastSeq  uint64
+	appended chan struct{}
+}
+
+func (s *Shard) initChangeLog(ctx context.Context) error {
+	err := s.store.CreateOrLoadBucket(ctx, helpers.ChangeLogBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithAllocChecker(s.index.allocChecker),
+		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
+		s.dynamicMemtableSizing(),
+		s.memtableDirtyConfig(),
+		s.segmentCleanupConfig(),
+	)
+	if err != nil {
+		return fmt.Errorf("create change log bucket: %w", err)
+	}
+
+	changeLog, err := newShardChangeLog(s.store.Bucket(helpers.ChangeLogBucketLSM))
+	if err != nil {
+		return err
+	}
+
+	s.changeLog = changeLog
+	return nil
+}
+
+func newShardChangeLog(bucket *lsmkv.Bucket) (*shardChangeLog, error) {
+	last, err := bucket.Get(changeLogLastSequenceKey)
+	if err != nil {
+		return nil, fmt.Errorf("read last change log sequence: %w", err)
+	}
+
+	var lastSeq uint64
+	if len(last) == 8 {
+		lastSeq = binary.BigEndian.Uint64(last)
+	}
+
+	return &shardChangeLog{
+		bucket:   bucket,
+		lastSeq:  lastSeq,
+		appended: make(chan struct{}),
+	}, nil
+}
+
+func changeLogKey(seq uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, seq)
+	return key
+}
+
+func (l *shardChangeLog) append(rec *changelog.Record) error {
+	l.Lock()
+	defer l.Unlock()
+
+	rec.Sequence = l.lastSeq + 1
+	data, err := rec.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("marshal change log record: %w", err)
+	}
+
+	key := changeLogKey(rec.Sequence)
+	if err := l.bucket.Put(key, data); err != nil {
+		return fmt.Errorf("put change log record: %w", err)
+	}
+	if err := l.bucket.Put(changeLogLastSequenceKey, key); err != nil {
+		return fmt.Errorf("put last change log sequence: %w", err)
+	}
+
+	l.lastSeq = rec.Sequence
+	close(l.appended)
+	l.appended = make(chan struct{})
+
+	return nil
+}
+
+// read returns up to limit records with a sequence number greater than
+// after. The returned channel is closed once a newer record is appended.
+func (l *shardChangeLog) read(after uint64, limit int) ([]changelog.Record, <-chan struct{}, error) {
+	l.Lock()
+	appended := l.appended
+	lastSeq := l.lastSeq
+	l.Unlock()
+
+	if after >= lastSeq {
+		return nil, appended, nil
+	}
+
+	c := l.bucket.Cursor()
+	defer c.Close()
+
+	records := make([]changelog.Record, 0, min(limit, int(lastSeq-after)))
+	for k, v := c.Seek(changeLogKey(after + 1)); k != nil && len(records) < limit; k, v = c.Next() {
+		if len(k) != 8 {
+			continue
+		}
+
+		var rec changelog.Record
+		if err := rec.UnmarshalBinary(v); err != nil {
+			return nil, nil, fmt.Errorf("change log record %d: %w", binary.BigEndian.Uint64(k), err)
+		}
+		if rec.Sequence > lastSeq {
+			// appended after the read started, will be served with the next read
+			break
+		}
+		records = append(records, rec)
+	}
+
+	return records, appended, nil
+}
+
+// trim removes the records which are older than the given cutoff. As
+// records are appended in order, trimming stops at the first record which
+// is still within the retention.
+func (l *shardChangeLog) trim(cutoff time.Time) (int, error) {
+	cutoffMilli := cutoff.UnixMilli()
+
+	var expired [][]byte
+	func() {
+		c := l.bucket.Cursor()
+		defer c.Close()
+
+		for k, v := c.First(); k != nil && len(expired) < changeLogTrimBatchSize; k, v = c.Next() {
+			if len(k) != 8 {
+				continue
+			}
+
+			var rec changelog.Record
+			if err := rec.UnmarshalBinary(v); err != nil || rec.Timestamp >= cutoffMilli {
+				break
+			}
+			expired = append(expired, append([]byte{}, k...))
+		}
+	}()
+
+	for i, key := range expired {
+		if err := l.bucket.Delete(key); err != nil {
+			return i, fmt.Errorf("delete change log record: %w", err)
+		}
+	}
+
+	return len(expired), nil
+}
+
+// mayAppendChangeLogUpsert records the upsert of object, whose binary
+// representation was just written to the objects bucket. It must be called
+// while holding the lock of the object's uuid, so that the records of an
+// object are in the order its writes were applied.
+//
+// A failed append is logged rather than returned. The object is already
+// written at that point, failing the request would make the client retry a
+// write that landed and record it twice.
+func (s *Shard) mayAppendChangeLogUpsert(object *storobj.Object, objBinary []byte) {
+	if s.changeLog == nil {
+		return
+	}
+
+	err := s.changeLog.append(&changelog.Record{
+		Operation: changelog.OperationUpsert,
+		UUID:      object.ID(),
+		Timestamp: object.LastUpdateTimeUnix(),
+		Object:    objBinary,
+	})
+	if err != nil {
+		s.changeLogAppendFailed(object.ID(), err)
+	}
+}
+
+// mayAppendChangeLogDelete records the deletion of the object with the given
+// uuid, see mayAppendChangeLogUpsert
+func (s *Shard) mayAppendChangeLogDelete(uuidBytes []byte, deletionTime time.Time) {
+	if s.changeLog == nil {
+		return
+	}
+
+	id, err := uuid.FromBytes(uuidBytes)
+	if err != nil {
+		s.changeLogAppendFailed("", fmt.Errorf("parse uuid: %w", err))
+		return
+	}
+
+	if deletionTime.IsZero() {
+		deletionTime = time.Now()
+	}
+
+	err = s.changeLog.append(&changelog.Record{
+		Operation: changelog.OperationDelete,
+		UUID:      strfmt.UUID(id.String()),
+		Timestamp: deletionTime.UnixMilli(),
+	})
+	if err != nil {
+		s.changeLogAppendFailed(strfmt.UUID(id.String()), err)
+	}
+}
+
+func (s *Shard) changeLogAppendFailed(id strfmt.UUID, err error) {
+	s.index.logger.WithFields(logrus.Fields{
+		"action": "change_log_append",
+		"shard":  s.name,
+		"id":     id,
+	}).WithError(err).Error("failed to append to change log, the change is missing from it")
+}
+
+// deleteObjectFromBucket deletes the object with the given uuid from the
+// objects bucket and records the deletion in the change log while holding
+// the lock of the uuid
+func (s *Shard) deleteObjectFromBucket(bucket *lsmkv.Bucket, idBytes []byte, deletionTime time.Time) error {
+	// see comment in shard_write_put.go::putObjectLSM
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	return s.deleteObjectFromBucketLocked(bucket, idBytes, deletionTime)
+}
+
+// deleteObjectFromBucketIf is like deleteObjectFromBucket, but reads the
+// object while holding the lock of the uuid and only deletes it if cond
+// holds for it. It returns the deleted object, or nil if the object does not
+// exist or cond did not hold.
+func (s *Shard) deleteObjectFromBucketIf(bucket *lsmkv.Bucket, idBytes []byte, deletionTime time.Time,
+	cond func(existing []byte) (bool, error),
+) ([]byte, error) {
+	lock := &s.docIdLock[s.uuidToIdLockPoolId(idBytes)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	existing, err := bucket.Get(idBytes)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected error on previous lookup: %w", err)
+	}
+	if existing == nil {
+		return nil, nil
+	}
+	if ok, err := cond(existing); err != nil || !ok {
+		return nil, err
+	}
+
+	if err := s.deleteObjectFromBucketLocked(bucket, idBytes, deletionTime); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (s *Shard) deleteObjectFromBucketLocked(bucket *lsmkv.Bucket, idBytes []byte, deletionTime time.Time) error {
+	var err error
+	if deletionTime.IsZero() {
+		err = bucket.Delete(idBytes)
+	} else {
+		err = bucket.DeleteWith(idBytes, deletionTime)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.trackWrite(idBytes)
+	s.mayAppendChangeLogDelete(idBytes, deletionTime)
+	return nil
+}
+
+func (s *Shard) readChangeLog(after uint64, limit int) ([]changelog.Record, <-chan struct{}, error) {
+	if s.changeLog == nil {
+		return nil, nil, fmt.Errorf("change data capture is not enabled")
+	}
+
+	return s.changeLog.read(after, limit)
+}
+
+// trimChangeLog is registered as the shard's change log cycle callback and
+// removes all records older than the configured retention.
+func (s *Shard) trimChangeLog(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	if s.changeLog == nil || s.index.Config.ChangeDataCaptureRetention <= 0 {
+		return false
+	}
+
+	release, err := s.preventShutdown()
+	if err != nil {
+		return false
+	}
+	defer release()
+
+	trimmed, err := s.changeLog.trim(time.Now().Add(-s.index.Config.ChangeDataCaptureRetention))
+	if err != nil {
+		s.index.logger.WithFields(logrus.Fields{
+			"action": "change_log_trim",
+			"shard":  s.name,
+		}).WithError(err).Error("failed to trim change log")
+	}
+
+	return trimmed > 0
+}
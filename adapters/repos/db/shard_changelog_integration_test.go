@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestShard_ChangeLogFollowsApplyOrder(t *testing.T) {
+	ctx := context.Background()
+	shd, _ := testShard(t, ctx, "ChangeLogClass", func(idx *Index) {
+		idx.Config.ChangeDataCaptureEnabled = true
+		idx.Config.DisableLazyLoadShards = true
+	})
+	shard := shd.(*Shard)
+
+	obj := testObject("ChangeLogClass")
+	id := obj.ID()
+
+	// concurrent writes of the same object must be recorded in the order in
+	// which they were applied, so that the last record is the final state
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if (i+j)%3 == 0 {
+					assert.NoError(t, shard.DeleteObject(ctx, id, time.Time{}))
+					continue
+				}
+				next := storobj.FromObject(&models.Object{
+					ID:                 id,
+					Class:              obj.Class().String(),
+					Properties:         map[string]interface{}{"writer": float64(i), "write": float64(j)},
+					LastUpdateTimeUnix: time.Now().UnixMilli(),
+				}, nil, nil, nil)
+				assert.NoError(t, shard.PutObject(ctx, next))
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	var records []changelog.Record
+	for after := uint64(0); ; {
+		page, _, err := shard.readChangeLog(after, 100)
+		require.NoError(t, err)
+		if len(page) == 0 {
+			break
+		}
+		records = append(records, page...)
+		after = page[len(page)-1].Sequence
+	}
+	require.NotEmpty(t, records)
+	last := records[len(records)-1]
+
+	stored, err := shard.ObjectByID(ctx, id, nil, additional.Properties{})
+	require.NoError(t, err)
+	if stored == nil {
+		assert.Equal(t, changelog.OperationDelete, last.Operation)
+		return
+	}
+	require.Equal(t, changelog.OperationUpsert, last.Operation)
+	recorded, err := storobj.FromBinary(last.Object)
+	require.NoError(t, err)
+	assert.Equal(t, stored.Properties(), recorded.Properties())
+}
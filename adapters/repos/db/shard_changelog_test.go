@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/changelog"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestShardChangeLog(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+
+	newBucket := func() *lsmkv.Bucket {
+		bucket, err := lsmkv.NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			lsmkv.WithStrategy(lsmkv.StrategyReplace))
+		require.Nil(t, err)
+		return bucket
+	}
+
+	id := strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247")
+	now := time.Now()
+
+	bucket := newBucket()
+	log, err := newShardChangeLog(bucket)
+	require.Nil(t, err)
+
+	t.Run("read empty log", func(t *testing.T) {
+		records, appended, err := log.read(0, 10)
+		require.Nil(t, err)
+		assert.Empty(t, records)
+		assert.NotNil(t, appended)
+	})
+
+	t.Run("append notifies waiting readers", func(t *testing.T) {
+		_, appended, err := log.read(0, 10)
+		require.Nil(t, err)
+
+		require.Nil(t, log.append(&changelog.Record{
+			Operation: changelog.OperationUpsert,
+			UUID:      id,
+			Timestamp: now.Add(-2 * time.Hour).UnixMilli(),
+			Object:    []byte("first"),
+		}))
+
+		select {
+		case <-appended:
+		default:
+			t.Fatal("expected appended channel to be closed")
+		}
+	})
+
+	require.Nil(t, log.append(&changelog.Record{
+		Operation: changelog.OperationUpsert,
+		UUID:      id,
+		Timestamp: now.Add(-time.Hour).UnixMilli(),
+		Object:    []byte("second"),
+	}))
+	require.Nil(t, log.append(&changelog.Record{
+		Operation: changelog.OperationDelete,
+		UUID:      id,
+		Timestamp: now.UnixMilli(),
+	}))
+
+	t.Run("read in order", func(t *testing.T) {
+		records, _, err := log.read(0, 10)
+		require.Nil(t, err)
+		require.Len(t, records, 3)
+		for i, rec := range records {
+			assert.Equal(t, uint64(i+1), rec.Sequence)
+			assert.Equal(t, id, rec.UUID)
+		}
+		assert.Equal(t, []byte("second"), records[1].Object)
+		assert.Equal(t, changelog.OperationDelete, records[2].Operation)
+		assert.Empty(t, records[2].Object)
+	})
+
+	t.Run("resume after sequence", func(t *testing.T) {
+		records, _, err := log.read(1, 1)
+		require.Nil(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, uint64(2), records[0].Sequence)
+
+		records, _, err = log.read(3, 10)
+		require.Nil(t, err)
+		assert.Empty(t, records)
+	})
+
+	t.Run("trim to retention", func(t *testing.T) {
+		trimmed, err := log.trim(now.Add(-90 * time.Minute))
+		require.Nil(t, err)
+		assert.Equal(t, 1, trimmed)
+
+		records, _, err := log.read(0, 10)
+		require.Nil(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, uint64(2), records[0].Sequence)
+	})
+
+	t.Run("sequence survives restart", func(t *testing.T) {
+		require.Nil(t, bucket.Shutdown(ctx))
+
+		bucket = newBucket()
+		log, err = newShardChangeLog(bucket)
+		require.Nil(t, err)
+
+		require.Nil(t, log.append(&changelog.Record{
+			Operation: changelog.OperationDelete,
+			UUID:      id,
+			Timestamp: now.UnixMilli(),
+		}))
+
+		records, _, err := log.read(3, 10)
+		require.Nil(t, err)
+		require.Len(t, records, 1)
+		assert.Equal(t, uint64(4), records[0].Sequence)
+	})
+
+	require.Nil(t, bucket.Shutdown(ctx))
+}
@@ -33,6 +33,10 @@ type shardCycleCallbacks struct {
 	geoPropsCommitLoggerCallbacks     cyclemanager.CycleCallbackGroup
 	geoPropsTombstoneCleanupCallbacks cyclemanager.CycleCallbackGroup
 	geoPropsCombinedCallbacksCtrl     cyclemanager.CycleCallbackCtrl
+
+	objectTTLCallbacksCtrl cyclemanager.CycleCallbackCtrl
+	changeLogCallbacksCtrl cyclemanager.CycleCallbackCtrl
+	usageCallbacksCtrl     cyclemanager.CycleCallbackCtrl
 }
 
 func (s *Shard) initCycleCallbacks() {
@@ -103,6 +107,19 @@ func (s *Shard) initCycleCallbacks() {
 	geoPropsCombinedCallbacksCtrl := cyclemanager.NewCombinedCallbackCtrl(2, s.index.logger,
 		geoPropsCommitLoggerCallbacksCtrl, geoPropsTombstoneCleanupCallbacksCtrl)
 
+	objectTTLId := id("object_ttl")
+	objectTTLCallbacksCtrl := s.index.cycleCallbacks.objectTTLCallbacks.Register(
+		objectTTLId, s.expireObjects,
+		cyclemanager.WithIntervals(cyclemanager.ObjectTTLCycleIntervals()))
+
+	// fixed interval on class level, no need to specify separate on shard level
+	changeLogCallbacksCtrl := s.index.cycleCallbacks.changeLogCallbacks.Register(
+		id("changelog"), s.trimChangeLog)
+
+	// fixed interval on class level, no need to specify separate on shard level
+	usageCallbacksCtrl := s.index.cycleCallbacks.usageCallbacks.Register(
+		id("usage"), s.measureUsageCycle)
+
 	s.cycleCallbacks = &shardCycleCallbacks{
 		compactionCallbacks:        compactionCallbacks,
 		compactionCallbacksCtrl:    compactionCallbacksCtrl,
@@ -119,5 +136,9 @@ func (s *Shard) initCycleCallbacks() {
 		geoPropsCommitLoggerCallbacks:     geoPropsCommitLoggerCallbacks,
 		geoPropsTombstoneCleanupCallbacks: geoPropsTombstoneCleanupCallbacks,
 		geoPropsCombinedCallbacksCtrl:     geoPropsCombinedCallbacksCtrl,
+
+		objectTTLCallbacksCtrl: objectTTLCallbacksCtrl,
+		changeLogCallbacksCtrl: changeLogCallbacksCtrl,
+		usageCallbacksCtrl:     usageCallbacksCtrl,
 	}
 }
@@ -33,6 +33,7 @@ func (s *Shard) drop() (err error) {
 	s.reindexer.Stop(s, fmt.Errorf("shard drop"))
 
 	s.metrics.DeleteShardLabels(s.index.Config.ClassName.String(), s.name)
+	s.index.Config.ObjectTTLMetrics.DeleteShard(s.index.Config.ClassName.String(), s.name)
 	s.metrics.baseMetrics.StartUnloadingShard()
 	s.replicationMap.clear()
 
@@ -62,6 +63,9 @@ func (s *Shard) drop() (err error) {
 		s.cycleCallbacks.flushCallbacksCtrl,
 		s.cycleCallbacks.vectorCombinedCallbacksCtrl,
 		s.cycleCallbacks.geoPropsCombinedCallbacksCtrl,
+		s.cycleCallbacks.objectTTLCallbacksCtrl,
+		s.cycleCallbacks.changeLogCallbacksCtrl,
+		s.cycleCallbacks.usageCallbacksCtrl,
 	).Unregister(ctx); err != nil {
 		return err
 	}
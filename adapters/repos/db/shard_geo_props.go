@@ -35,6 +35,8 @@ func (s *Shard) initGeoProp(prop *models.Property) error {
 		CoordinatesForID:   s.makeCoordinatesForID(prop.Name),
 		DisablePersistence: false,
 		Logger:             s.index.logger,
+		Encryption:         s.encryption,
+		LogArchiver:        s.index.Config.LogArchiver,
 	},
 		s.cycleCallbacks.geoPropsCommitLoggerCallbacks,
 		s.cycleCallbacks.geoPropsTombstoneCleanupCallbacks,
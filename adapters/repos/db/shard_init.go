@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
 	"github.com/weaviate/weaviate/adapters/repos/db/queue"
+	"github.com/weaviate/weaviate/entities/encryption"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	entsentry "github.com/weaviate/weaviate/entities/sentry"
@@ -113,6 +114,10 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		return nil, err
 	}
 
+	if s.encryption, err = s.encryptionKeys(ctx, exists); err != nil {
+		return nil, fmt.Errorf("init shard's %q encryption keys: %w", s.ID(), err)
+	}
+
 	// init the store itself synchronously
 	if err := s.initLSMStore(); err != nil {
 		return nil, fmt.Errorf("init shard's %q store: %w", s.ID(), err)
@@ -154,6 +159,36 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 	return s, nil
 }
 
+// encryptionKeys returns the keys the shard's files are encrypted with. If a
+// key management module is enabled, every tenant of a multi-tenant class has
+// its own key, so its shard can only be loaded while that key exists. Keys
+// are only created for new shards. A missing key of an existing shard means
+// the key was deleted, unless the shard was created before the module was
+// enabled, which is only assumed if CreateMissingTenantKeys is set.
+func (s *Shard) encryptionKeys(ctx context.Context, exists bool) (encryption.KeyProvider, error) {
+	tenantKeys := s.index.Config.TenantKeys
+	if tenantKeys == nil || !s.index.partitioningEnabled {
+		return s.index.Config.Encryption, nil
+	}
+
+	className := s.index.Config.ClassName.String()
+	if exists {
+		keys, err := tenantKeys.TenantKeys(ctx, className, s.name)
+		if err == nil || !errors.Is(err, encryption.ErrKeyNotFound) {
+			return keys, err
+		}
+		if !s.index.Config.CreateMissingTenantKeys {
+			return nil, fmt.Errorf("tenant %q of class %q: %w", s.name, className, err)
+		}
+		s.index.logger.WithFields(logrus.Fields{
+			"action": "init_shard_encryption_keys",
+			"shard":  s.name,
+			"index":  s.index.ID(),
+		}).Warn("tenant has no encryption key, creating a new one for its new files")
+	}
+	return tenantKeys.CreateTenantKeys(ctx, className, s.name)
+}
+
 // cleanupPartialInit is called when the shard was only partially initialized.
 // Internally it just uses [Shutdown], but also adds some logging.
 func (s *Shard) cleanupPartialInit(ctx context.Context) {
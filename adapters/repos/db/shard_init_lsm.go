@@ -91,6 +91,12 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 		return fmt.Errorf("init shard %q: %w", s.ID(), err)
 	}
 
+	if s.index.Config.ChangeDataCaptureEnabled {
+		if err := s.initChangeLog(ctx); err != nil {
+			return fmt.Errorf("init shard %q: %w", s.ID(), err)
+		}
+	}
+
 	// Object bucket must be available, initAsyncReplication depends on it
 	if s.index.asyncReplicationEnabled() {
 		s.asyncReplicationRWMux.Lock()
@@ -134,6 +140,8 @@ func (s *Shard) initLSMStore() error {
 		return fmt.Errorf("init lsmkv store at %s: %w", s.pathLSM(), err)
 	}
 
+	store.SetEncryption(s.encryption)
+	store.SetLogArchiver(s.index.Config.LogArchiver)
 	s.store = store
 
 	return nil
@@ -150,6 +158,7 @@ func (s *Shard) initObjectBucket(ctx context.Context) error {
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		lsmkv.WithSegmentsChecksumValidationEnabled(s.index.Config.LSMEnableSegmentsChecksumValidation),
+		lsmkv.WithBlockCompression(s.index.Config.LSMObjectsBlockCompression),
 		s.segmentCleanupConfig(),
 	}
 
@@ -17,17 +17,21 @@ import (
 	"path/filepath"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ivf"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	ivfent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
 	"go.etcd.io/bbolt"
 )
 
@@ -110,6 +114,8 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 						hnsw.WithCommitlogThresholdForCombining(s.index.Config.HNSWMaxLogSize),
 						// consistent with previous logic where the individual limit is 1/5 of the combined limit
 						hnsw.WithCommitlogThreshold(s.index.Config.HNSWMaxLogSize/5),
+						hnsw.WithCommitlogEncryption(s.encryption),
+						hnsw.WithCommitlogArchiver(s.index.Config.LogArchiver),
 					)
 				},
 				AllocChecker:           s.index.allocChecker,
@@ -117,6 +123,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 				FlatSearchConcurrency:  s.index.Config.HNSWFlatSearchConcurrency,
 				AcornFilterRatio:       s.index.Config.HNSWAcornFilterRatio,
 				VisitedListPoolMaxSize: s.index.Config.VisitedListPoolMaxSize,
+				Encryption:             s.encryption,
 			}, hnswUserConfig, s.cycleCallbacks.vectorTombstoneCleanupCallbacks, s.store)
 			if err != nil {
 				return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
@@ -183,18 +190,70 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			TempVectorForIDThunk: hnsw.NewTempVectorForIDThunk(targetVector, s.readVectorByIndexIDIntoSlice),
 			MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
 				return hnsw.NewCommitLogger(s.path(), vecIdxID,
-					s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks)
+					s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
+					hnsw.WithCommitlogEncryption(s.encryption),
+					hnsw.WithCommitlogArchiver(s.index.Config.LogArchiver))
 			},
 			TombstoneCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
 			SharedDB:           sharedDB,
+			Encryption:         s.encryption,
 		}, dynamicUserConfig, s.store)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
 		}
 		vectorIndex = vi
+	case vectorindex.VectorIndexTypeDISKANN:
+		diskannUserConfig, ok := vectorIndexUserConfig.(diskannent.UserConfig)
+		if !ok {
+			return nil, errors.Errorf("diskann vector index: config is not diskann.UserConfig: %T",
+				vectorIndexUserConfig)
+		}
+		s.index.cycleCallbacks.vectorCommitLoggerCycle.Start()
+		s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
+
+		vecIdxID := s.vectorIndexID(targetVector)
+
+		vi, err := diskann.New(diskann.Config{
+			ID:               vecIdxID,
+			TargetVector:     targetVector,
+			RootPath:         s.path(),
+			ClassName:        s.index.Config.ClassName.String(),
+			ShardName:        s.name,
+			Logger:           s.index.logger,
+			DistanceProvider: distProv,
+			AllocChecker:     s.index.allocChecker,
+		}, diskannUserConfig, s.cycleCallbacks.vectorTombstoneCleanupCallbacks, s.store)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: diskann index", s.ID())
+		}
+		vectorIndex = vi
+	case vectorindex.VectorIndexTypeIVF:
+		ivfUserConfig, ok := vectorIndexUserConfig.(ivfent.UserConfig)
+		if !ok {
+			return nil, errors.Errorf("ivf vector index: config is not ivf.UserConfig: %T",
+				vectorIndexUserConfig)
+		}
+		s.index.cycleCallbacks.vectorCommitLoggerCycle.Start()
+
+		vecIdxID := s.vectorIndexID(targetVector)
+
+		vi, err := ivf.New(ivf.Config{
+			ID:               vecIdxID,
+			TargetVector:     targetVector,
+			RootPath:         s.path(),
+			ClassName:        s.index.Config.ClassName.String(),
+			ShardName:        s.name,
+			Logger:           s.index.logger,
+			DistanceProvider: distProv,
+		}, ivfUserConfig, s.store)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: ivf index", s.ID())
+		}
+		vectorIndex = vi
 	default:
-		return nil, fmt.Errorf("unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\"]",
-			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC)
+		return nil, fmt.Errorf("unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\", \"%s\", \"%s\"]",
+			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT,
+			vectorindex.VectorIndexTypeDYNAMIC, vectorindex.VectorIndexTypeDISKANN, vectorindex.VectorIndexTypeIVF)
 	}
 	defer vectorIndex.PostStartup()
 	return vectorIndex, nil
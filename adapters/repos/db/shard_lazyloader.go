@@ -32,6 +32,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/changelog"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
@@ -523,6 +524,13 @@ func (l *LazyLoadShard) isReadOnly() error {
 	return l.shard.isReadOnly()
 }
 
+func (l *LazyLoadShard) usage() (objectCount, diskBytes int64, measured bool) {
+	if !l.isLoaded() {
+		return 0, 0, false
+	}
+	return l.shard.usage()
+}
+
 func (l *LazyLoadShard) preparePutObject(ctx context.Context, shardID string, object *storobj.Object) replica.SimpleResponse {
 	l.mustLoadCtx(ctx)
 	return l.shard.preparePutObject(ctx, shardID, object)
@@ -631,6 +639,11 @@ func (l *LazyLoadShard) mayUpsertObjectHashTree(object *storobj.Object, idBytes
 	return l.shard.mayUpsertObjectHashTree(object, idBytes, status)
 }
 
+func (l *LazyLoadShard) readChangeLog(after uint64, limit int) ([]changelog.Record, <-chan struct{}, error) {
+	l.mustLoad()
+	return l.shard.readChangeLog(after, limit)
+}
+
 func (l *LazyLoadShard) mutableMergeObjectLSM(merge objects.MergeDocument, idBytes []byte) (mutableMergeResult, error) {
 	l.mustLoad()
 	return l.shard.mutableMergeObjectLSM(merge, idBytes)
@@ -0,0 +1,248 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// objectTTLDeleteBatchSize limits how many expired objects are looked up and
+// deleted at once, so that a sweep over a large backlog can be aborted in
+// between.
+const objectTTLDeleteBatchSize = 1000
+
+// expireObjects is registered as the shard's object ttl cycle callback. It
+// deletes all objects whose configured timestamp is older than the
+// time-to-live of the class.
+//
+// Every replica of a shard runs its own sweep and deletes through the local
+// batch delete path, bypassing the replicator. Since expiry only depends on
+// the (replicated) object timestamps, replicas converge on the same state.
+func (s *Shard) expireObjects(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	class := s.index.getSchema.ReadOnlyClass(s.index.Config.ClassName.String())
+	if !schema.ObjectTTLEnabled(class) {
+		return false
+	}
+
+	if status := s.GetStatus(); status != storagestate.StatusReady && status != storagestate.StatusIndexing {
+		return false
+	}
+
+	release, err := s.preventShutdown()
+	if err != nil {
+		return false
+	}
+	defer release()
+
+	logger := s.index.logger.WithFields(logrus.Fields{
+		"action": "object_ttl_sweep",
+		"class":  class.Class,
+		"shard":  s.name,
+	})
+
+	ctx := s.index.closingCtx
+	before := time.Now()
+
+	expiredDocIDs, err := s.findAllowList(ctx, objectTTLFilter(class, before))
+	if err != nil {
+		logger.WithError(err).Error("failed to find expired objects")
+		return false
+	}
+	defer expiredDocIDs.Close()
+	if expiredDocIDs.IsEmpty() {
+		return false
+	}
+
+	// the uuids of the expired objects are resolved and deleted page by page
+	// rather than all at once. Each object is checked again when deleted, it
+	// may have been updated since it was found.
+	stillExpired := objectTTLExpired(class, before)
+	it := expiredDocIDs.Iterator()
+	uuids := make([]strfmt.UUID, 0, objectTTLDeleteBatchSize)
+	expired := 0
+	for {
+		if shouldAbort() || ctx.Err() != nil {
+			break
+		}
+
+		uuids = s.nextExpiredUUIDs(it, uuids[:0])
+		if len(uuids) == 0 {
+			break
+		}
+
+		n, err := s.deleteExpiredObjects(ctx, uuids, stillExpired, before)
+		expired += n
+		if err != nil {
+			logger.WithError(err).Error("failed to delete expired objects")
+			break
+		}
+	}
+
+	s.index.Config.ObjectTTLMetrics.Expired(s.index.Config.ClassName.String(), s.name, expired, before)
+	logger.WithFields(logrus.Fields{
+		"expired": expired,
+		"took":    time.Since(before),
+	}).Debug("deleted expired objects")
+
+	return expired > 0
+}
+
+// nextExpiredUUIDs appends the uuids of the next page of docIDs of it to
+// uuids. DocIDs whose object is gone in the meantime are skipped.
+func (s *Shard) nextExpiredUUIDs(it helpers.AllowListIterator, uuids []strfmt.UUID) []strfmt.UUID {
+	for len(uuids) < cap(uuids) {
+		docID, ok := it.Next()
+		if !ok {
+			break
+		}
+		uuid, err := s.uuidFromDocID(docID)
+		if err != nil {
+			continue
+		}
+		uuids = append(uuids, uuid)
+	}
+	return uuids
+}
+
+// deleteExpiredObjects deletes the objects of uuids for which expired still
+// holds, checked while holding the lock of the object
+func (s *Shard) deleteExpiredObjects(ctx context.Context, uuids []strfmt.UUID,
+	expired func(obj *storobj.Object) bool, deletionTime time.Time,
+) (int, error) {
+	cond := func(existing []byte) (bool, error) {
+		obj, err := storobj.FromBinary(existing)
+		if err != nil {
+			return false, fmt.Errorf("unmarshal object: %w", err)
+		}
+		return expired(obj), nil
+	}
+
+	deleted := 0
+	for _, id := range uuids {
+		ok, err := s.batchDeleteObjectIf(ctx, id, deletionTime, cond)
+		if err != nil {
+			return deleted, err
+		}
+		if ok {
+			deleted++
+		}
+	}
+
+	// like the batch delete path, flush the buffered WALs once per page
+	if err := s.store.WriteWALs(); err != nil {
+		return deleted, fmt.Errorf("flush all buffered WALs: %w", err)
+	}
+	err := s.ForEachVectorQueue(func(targetVector string, queue *VectorIndexQueue) error {
+		if err := queue.Flush(); err != nil {
+			return fmt.Errorf("flush vector index queue of vector %q: %w", targetVector, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return deleted, err
+	}
+	if err := s.GetPropertyLengthTracker().Flush(); err != nil {
+		return deleted, fmt.Errorf("flush prop length tracker: %w", err)
+	}
+	return deleted, nil
+}
+
+// objectTTLExpired returns whether an object matched by objectTTLFilter is
+// still expired at now. Objects without the timestamp never expire.
+func objectTTLExpired(class *models.Class, now time.Time) func(obj *storobj.Object) bool {
+	deleteOn := schema.ObjectTTLDeleteOn(class)
+	cutoff := now.Add(-schema.ObjectTTL(class))
+
+	switch deleteOn {
+	case filters.InternalPropCreationTimeUnix:
+		return func(obj *storobj.Object) bool {
+			return obj.CreationTimeUnix() < cutoff.UnixMilli()
+		}
+	case filters.InternalPropLastUpdateTimeUnix:
+		return func(obj *storobj.Object) bool {
+			return obj.LastUpdateTimeUnix() < cutoff.UnixMilli()
+		}
+	default:
+		return func(obj *storobj.Object) bool {
+			props, ok := obj.Properties().(map[string]interface{})
+			if !ok {
+				return false
+			}
+			for name, value := range props {
+				if !strings.EqualFold(name, deleteOn) {
+					continue
+				}
+				switch v := value.(type) {
+				case time.Time:
+					return v.Before(cutoff)
+				case string:
+					t, err := time.Parse(time.RFC3339Nano, v)
+					return err == nil && t.Before(cutoff)
+				}
+			}
+			return false
+		}
+	}
+}
+
+// objectTTLFilter matches all objects of the class whose configured
+// timestamp lies further in the past than the class' time-to-live.
+func objectTTLFilter(class *models.Class, now time.Time) *filters.LocalFilter {
+	deleteOn := schema.ObjectTTLDeleteOn(class)
+	cutoff := now.Add(-schema.ObjectTTL(class))
+
+	var value *filters.Value
+	switch deleteOn {
+	case filters.InternalPropCreationTimeUnix, filters.InternalPropLastUpdateTimeUnix:
+		// internal timestamps are indexed as unix milliseconds
+		value = &filters.Value{
+			Value: strconv.FormatInt(cutoff.UnixMilli(), 10),
+			Type:  schema.DataTypeText,
+		}
+	default:
+		for _, prop := range class.Properties {
+			if strings.EqualFold(prop.Name, deleteOn) {
+				deleteOn = prop.Name
+				break
+			}
+		}
+		value = &filters.Value{
+			Value: cutoff.UTC().Format(time.RFC3339Nano),
+			Type:  schema.DataTypeDate,
+		}
+	}
+
+	return &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorLessThan,
+			On: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: schema.PropertyName(deleteOn),
+			},
+			Value: value,
+		},
+	}
+}
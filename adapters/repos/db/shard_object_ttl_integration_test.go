@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_ExpireObjects(t *testing.T) {
+	ctx := context.Background()
+	class := &models.Class{
+		Class:               "TTLClass",
+		InvertedIndexConfig: &models.InvertedIndexConfig{IndexTimestamps: true},
+		ObjectTTLConfig:     &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 3600},
+	}
+	shd, _ := testShardWithSettings(t, ctx, class, enthnsw.UserConfig{Skip: true}, false, false,
+		func(idx *Index) { idx.Config.DisableLazyLoadShards = true })
+	shard := shd.(*Shard)
+
+	expiredAt := time.Now().Add(-2 * time.Hour).UnixMilli()
+	const count = 2*objectTTLDeleteBatchSize + 10
+	objs := make([]*storobj.Object, count)
+	for i := range objs {
+		objs[i] = testObject(class.Class)
+		objs[i].Object.CreationTimeUnix = expiredAt
+		objs[i].Object.LastUpdateTimeUnix = expiredAt
+	}
+	for _, err := range shard.PutObjectBatch(ctx, objs) {
+		require.NoError(t, err)
+	}
+	fresh := testObject(class.Class)
+	fresh.Object.CreationTimeUnix = time.Now().UnixMilli()
+	fresh.Object.LastUpdateTimeUnix = fresh.Object.CreationTimeUnix
+	require.NoError(t, shard.PutObject(ctx, fresh))
+	require.Equal(t, count+1, shard.ObjectCount())
+
+	t.Run("aborted between pages", func(t *testing.T) {
+		pages := 0
+		shouldAbort := func() bool {
+			pages++
+			return pages > 1
+		}
+		assert.True(t, shard.expireObjects(shouldAbort))
+		assert.Equal(t, count+1-objectTTLDeleteBatchSize, shard.ObjectCount())
+	})
+
+	t.Run("remaining pages", func(t *testing.T) {
+		assert.True(t, shard.expireObjects(func() bool { return false }))
+		assert.Equal(t, 1, shard.ObjectCount())
+
+		exists, err := shard.Exists(ctx, fresh.ID())
+		require.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("objects no longer expired are kept", func(t *testing.T) {
+		// e.g. updated after they were found
+		now := time.Now()
+		n, err := shard.deleteExpiredObjects(ctx, []strfmt.UUID{fresh.ID()}, objectTTLExpired(class, now), now)
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+		assert.Equal(t, 1, shard.ObjectCount())
+	})
+
+	t.Run("nothing left to expire", func(t *testing.T) {
+		assert.False(t, shard.expireObjects(func() bool { return false }))
+	})
+}
@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// ObjectTTLMetrics reports the work done by the per-shard object expiry
+// sweeps. A nil *ObjectTTLMetrics is valid and reports nothing.
+type ObjectTTLMetrics struct {
+	grouped bool
+
+	expired        *prometheus.CounterVec
+	sweepDurations *prometheus.SummaryVec
+}
+
+func NewObjectTTLMetrics(reg prometheus.Registerer, grouped bool) *ObjectTTLMetrics {
+	r := promauto.With(reg)
+
+	return &ObjectTTLMetrics{
+		grouped: grouped,
+
+		expired: r.NewCounterVec(prometheus.CounterOpts{
+			Name: "objects_ttl_expired_total",
+			Help: "Number of objects deleted because their time-to-live expired",
+		}, []string{"class_name", "shard_name"}),
+		sweepDurations: r.NewSummaryVec(prometheus.SummaryOpts{
+			Name: "objects_ttl_sweep_durations_seconds",
+			Help: "Duration of an object expiry sweep that deleted at least one object",
+		}, []string{"class_name", "shard_name"}),
+	}
+}
+
+func (m *ObjectTTLMetrics) Expired(className, shardName string, count int, start time.Time) {
+	if m == nil || count == 0 {
+		return
+	}
+
+	if m.grouped {
+		className = "n/a"
+		shardName = "n/a"
+	}
+
+	m.expired.WithLabelValues(className, shardName).Add(float64(count))
+	m.sweepDurations.WithLabelValues(className, shardName).Observe(time.Since(start).Seconds())
+}
+
+func (m *ObjectTTLMetrics) DeleteShard(className, shardName string) {
+	if m == nil || m.grouped {
+		// never delete the shared label, only individual ones
+		return
+	}
+
+	labels := prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	}
+	m.expired.DeletePartialMatch(labels)
+	m.sweepDurations.DeletePartialMatch(labels)
+}
@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestObjectTTLExpired(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	object := func(created, updated time.Time, props map[string]interface{}) *storobj.Object {
+		return storobj.FromObject(&models.Object{
+			Class:              "TTLClass",
+			CreationTimeUnix:   created.UnixMilli(),
+			LastUpdateTimeUnix: updated.UnixMilli(),
+			Properties:         props,
+		}, nil, nil, nil)
+	}
+	old, recent := now.Add(-2*time.Hour), now.Add(-time.Minute)
+
+	t.Run("creation time", func(t *testing.T) {
+		expired := objectTTLExpired(&models.Class{
+			ObjectTTLConfig: &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 3600},
+		}, now)
+		assert.True(t, expired(object(old, recent, nil)))
+		assert.False(t, expired(object(recent, recent, nil)))
+	})
+
+	t.Run("last update time", func(t *testing.T) {
+		expired := objectTTLExpired(&models.Class{
+			ObjectTTLConfig: &models.ObjectTTLConfig{
+				Enabled: true, DefaultTTL: 3600, DeleteOn: filters.InternalPropLastUpdateTimeUnix,
+			},
+		}, now)
+		assert.True(t, expired(object(old, old, nil)))
+		assert.False(t, expired(object(old, recent, nil)))
+	})
+
+	t.Run("date property", func(t *testing.T) {
+		expired := objectTTLExpired(&models.Class{
+			Properties: []*models.Property{{
+				Name: "expiresAt", DataType: schema.DataTypeDate.PropString(),
+			}},
+			ObjectTTLConfig: &models.ObjectTTLConfig{
+				Enabled: true, DefaultTTL: 3600, DeleteOn: "ExpiresAt",
+			},
+		}, now)
+		assert.True(t, expired(object(now, now, map[string]interface{}{"expiresAt": old.Format(time.RFC3339Nano)})))
+		assert.True(t, expired(object(now, now, map[string]interface{}{"expiresAt": old})))
+		assert.False(t, expired(object(old, old, map[string]interface{}{"expiresAt": recent.Format(time.RFC3339Nano)})))
+		assert.False(t, expired(object(old, old, nil)))
+	})
+}
+
+func TestObjectTTLFilter(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("creation time by default", func(t *testing.T) {
+		class := &models.Class{
+			Class:           "TTLClass",
+			ObjectTTLConfig: &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 3600},
+		}
+
+		filter := objectTTLFilter(class, now)
+		require.NotNil(t, filter.Root)
+		assert.Equal(t, filters.OperatorLessThan, filter.Root.Operator)
+		assert.Equal(t, schema.PropertyName(filters.InternalPropCreationTimeUnix), filter.Root.On.Property)
+		assert.Equal(t, schema.DataTypeText, filter.Root.Value.Type)
+		assert.Equal(t, strconv.FormatInt(now.Add(-time.Hour).UnixMilli(), 10), filter.Root.Value.Value)
+	})
+
+	t.Run("last update time", func(t *testing.T) {
+		class := &models.Class{
+			Class: "TTLClass",
+			ObjectTTLConfig: &models.ObjectTTLConfig{
+				Enabled: true, DefaultTTL: 60, DeleteOn: filters.InternalPropLastUpdateTimeUnix,
+			},
+		}
+
+		filter := objectTTLFilter(class, now)
+		assert.Equal(t, schema.PropertyName(filters.InternalPropLastUpdateTimeUnix), filter.Root.On.Property)
+		assert.Equal(t, strconv.FormatInt(now.Add(-time.Minute).UnixMilli(), 10), filter.Root.Value.Value)
+	})
+
+	t.Run("date property", func(t *testing.T) {
+		class := &models.Class{
+			Class: "TTLClass",
+			Properties: []*models.Property{{
+				Name: "expiresAt", DataType: schema.DataTypeDate.PropString(),
+			}},
+			ObjectTTLConfig: &models.ObjectTTLConfig{
+				Enabled: true, DefaultTTL: 60, DeleteOn: "ExpiresAt",
+			},
+		}
+
+		filter := objectTTLFilter(class, now)
+		assert.Equal(t, schema.PropertyName("expiresAt"), filter.Root.On.Property)
+		assert.Equal(t, schema.DataTypeDate, filter.Root.Value.Type)
+		assert.Equal(t, "2024-05-01T11:59:00Z", filter.Root.Value.Value)
+	})
+}
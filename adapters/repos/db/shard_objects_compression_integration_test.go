@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/additional"
+)
+
+func TestShard_ObjectsBlockCompression(t *testing.T) {
+	ctx := context.Background()
+	shd, _ := testShard(t, ctx, "CompressedObjectsClass", func(idx *Index) {
+		idx.Config.LSMObjectsBlockCompression = "zstd"
+		idx.Config.DisableLazyLoadShards = true
+	})
+	shard := shd.(*Shard)
+
+	obj := testObject("CompressedObjectsClass")
+	require.NoError(t, shard.PutObject(ctx, obj))
+
+	bucket := shard.Store().Bucket(helpers.ObjectsBucketLSM)
+	require.NoError(t, bucket.FlushAndSwitch())
+
+	segments, err := filepath.Glob(filepath.Join(bucket.GetDir(), "*.db"))
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+
+	f, err := os.Open(segments[0])
+	require.NoError(t, err)
+	defer f.Close()
+	header, err := segmentindex.ParseHeader(f)
+	require.NoError(t, err)
+	require.Equal(t, segmentindex.CompressionZstd, header.Compression)
+
+	stored, err := shard.ObjectByID(ctx, obj.ID(), nil, additional.Properties{})
+	require.NoError(t, err)
+	require.NotNil(t, stored)
+	require.Equal(t, obj.ID(), stored.ID())
+}
@@ -0,0 +1,260 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// shardUsageRefreshInterval bounds how often the usage of a shard is
+// measured. The size on disk requires a walk over the files of the shard and
+// is measured in the background by the usage cycle, so a tenant quota is
+// exceeded by at most the bytes written within one interval. The object
+// count is read from the objects bucket, objects admitted in between are
+// tracked as reservations.
+const shardUsageRefreshInterval = 5 * time.Second
+
+// shardUsageReportInterval is how often the usage of shards without a disk
+// quota is measured, it is only reported to users
+const shardUsageReportInterval = time.Minute
+
+type shardUsage struct {
+	sync.Mutex
+	// objectCount of the objects bucket when it was last counted, plus the
+	// new objects written since
+	objectCount int64
+	countedAt   time.Time
+	// reserved counts the new objects admitted by the quota whose writes
+	// have not completed yet. Writes in progress while counting may be
+	// counted twice until the next count, which errs on the safe side.
+	reserved   int64
+	diskBytes  int64
+	measuredAt time.Time
+}
+
+// usage returns the number of objects and the size on disk of the shard as
+// measured last by the usage cycle. It never measures itself, measured is
+// false if the shard was not measured yet.
+func (s *Shard) usage() (objectCount, diskBytes int64, measured bool) {
+	u := &s.quotaUsage
+	u.Lock()
+	defer u.Unlock()
+	return u.objectCount, u.diskBytes, !u.measuredAt.IsZero()
+}
+
+// measureDiskUsage walks the files of the shard and updates its size on
+// disk, without holding quotaUsage while walking
+func (s *Shard) measureDiskUsage() {
+	diskBytes := dirSize(s.path())
+
+	u := &s.quotaUsage
+	u.Lock()
+	defer u.Unlock()
+	u.diskBytes = diskBytes
+	u.measuredAt = time.Now()
+	s.countObjects()
+}
+
+// measureUsageCycle is registered as the shard's usage cycle callback. It
+// measures the size on disk of tenants with a disk quota on every cycle, all
+// other shards only every shardUsageReportInterval, as their usage is only
+// reported.
+func (s *Shard) measureUsageCycle(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	if quota := s.tenantQuota(); quota == nil || quota.MaxDiskBytes <= 0 {
+		u := &s.quotaUsage
+		u.Lock()
+		measured := time.Since(u.measuredAt) < shardUsageReportInterval
+		u.Unlock()
+		if measured {
+			return false
+		}
+	}
+
+	release, err := s.preventShutdown()
+	if err != nil {
+		return false
+	}
+	defer release()
+
+	s.measureDiskUsage()
+	return true
+}
+
+// countObjects updates the object count if it is outdated, quotaUsage must
+// be locked
+func (s *Shard) countObjects() {
+	u := &s.quotaUsage
+	if time.Since(u.countedAt) < shardUsageRefreshInterval {
+		return
+	}
+	u.objectCount = int64(s.ObjectCount())
+	u.countedAt = time.Now()
+}
+
+func (s *Shard) tenantQuota() *models.TenantQuota {
+	if !s.index.partitioningEnabled {
+		return nil
+	}
+	return schema.TenantQuota(s.index.getSchema.ReadOnlyClass(s.index.Config.ClassName.String()))
+}
+
+// checkTenantQuota returns an error wrapping
+// enterrors.ErrTenantQuotaExceeded if the tenant's size on disk reached the
+// limit of the tenant quota of the class, in which case all writes but
+// deletes are rejected.
+func (s *Shard) checkTenantQuota() error {
+	quota := s.tenantQuota()
+	if quota == nil {
+		return nil
+	}
+
+	u := &s.quotaUsage
+	u.Lock()
+	defer u.Unlock()
+	return s.checkTenantDiskQuota(quota)
+}
+
+// checkTenantDiskQuota checks the size on disk measured last, quotaUsage
+// must be locked
+func (s *Shard) checkTenantDiskQuota(quota *models.TenantQuota) error {
+	u := &s.quotaUsage
+	if quota.MaxDiskBytes > 0 && u.diskBytes >= quota.MaxDiskBytes {
+		return fmt.Errorf("%w: tenant %q uses %d bytes on disk, the limit is %d",
+			enterrors.ErrTenantQuotaExceeded, s.name, u.diskBytes, quota.MaxDiskBytes)
+	}
+	return nil
+}
+
+// reserveTenantQuota checks the tenant quota like checkTenantQuota and
+// additionally reserves the new objects against the object count limit. It
+// returns an error wrapping enterrors.ErrTenantQuotaExceeded if the objects
+// would exceed the limit. The returned reservation must be released once the
+// objects are written.
+func (s *Shard) reserveTenantQuota(objects []*storobj.Object) (*quotaReservation, error) {
+	quota := s.tenantQuota()
+	if quota == nil {
+		return nil, nil
+	}
+
+	u := &s.quotaUsage
+	u.Lock()
+	defer u.Unlock()
+
+	if err := s.checkTenantDiskQuota(quota); err != nil {
+		return nil, err
+	}
+	if quota.MaxObjectCount <= 0 || len(objects) == 0 {
+		return nil, nil
+	}
+
+	s.countObjects()
+	added := s.countNewObjects(objects)
+	if added > 0 && u.objectCount+u.reserved+added > quota.MaxObjectCount {
+		return nil, fmt.Errorf("%w: tenant %q has %d objects, adding %d would exceed the limit of %d",
+			enterrors.ErrTenantQuotaExceeded, s.name, u.objectCount+u.reserved, added, quota.MaxObjectCount)
+	}
+	u.reserved += added
+	return &quotaReservation{usage: u, count: added}, nil
+}
+
+// quotaReservation of new objects admitted by the tenant quota. A nil
+// reservation is valid and reserves nothing.
+type quotaReservation struct {
+	usage    *shardUsage
+	count    int64
+	released bool
+}
+
+// release the reservation once the write completed, of which at most
+// written objects were stored. It is safe to call more than once, only the
+// first call counts.
+func (r *quotaReservation) release(written int) {
+	if r == nil {
+		return
+	}
+	u := r.usage
+	u.Lock()
+	defer u.Unlock()
+
+	if r.released {
+		return
+	}
+	r.released = true
+	u.reserved -= r.count
+	// which of the objects were new is not known if some failed, so the
+	// failed ones are assumed to be updates
+	u.objectCount += min(r.count, int64(written))
+}
+
+// countNewObjects returns how many of the objects do not exist yet, updates
+// of existing objects do not count towards the quota
+func (s *Shard) countNewObjects(objects []*storobj.Object) int64 {
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	var added int64
+	for _, obj := range objects {
+		id, err := uuid.MustParse(obj.ID().String()).MarshalBinary()
+		if err != nil {
+			continue
+		}
+		if existing, err := bucket.Get(id); err == nil && existing == nil {
+			added++
+		}
+	}
+	return added
+}
+
+// countSucceeded returns the number of nil errors
+func countSucceeded(errs []error) int {
+	n := 0
+	for _, err := range errs {
+		if err == nil {
+			n++
+		}
+	}
+	return n
+}
+
+func errorsForAll(err error, n int) []error {
+	errs := make([]error, n)
+	for i := range errs {
+		errs[i] = err
+	}
+	return errs
+}
+
+// dirSize returns the size of all files below path. Files removed during the
+// walk, e.g. by compactions, are skipped.
+func dirSize(path string) int64 {
+	var size int64
+	filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		if info, err := d.Info(); err == nil {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size
+}
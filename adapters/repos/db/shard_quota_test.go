@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_TenantQuota(t *testing.T) {
+	ctx := testCtx()
+	class := &models.Class{
+		Class: "QuotaClass",
+		MultiTenancyConfig: &models.MultiTenancyConfig{
+			Enabled:     true,
+			TenantQuota: &models.TenantQuota{MaxObjectCount: 3},
+		},
+	}
+	opts := func(idx *Index) {
+		idx.partitioningEnabled = true
+		idx.Config.DisableLazyLoadShards = true
+	}
+	shd, _ := testShardWithSettings(t, ctx, class, enthnsw.UserConfig{Skip: true}, false, false, opts)
+	s := shd.(*Shard)
+
+	first := testObject(class.Class)
+	require.Nil(t, shd.PutObject(ctx, first))
+
+	t.Run("batch exceeding the object count is rejected", func(t *testing.T) {
+		batch := createRandomObjects(getRandomSeed(), class.Class, 3, 0)
+		for _, err := range shd.PutObjectBatch(ctx, batch) {
+			assert.True(t, enterrors.IsTenantQuotaExceeded(err), "expected quota error, got %v", err)
+		}
+	})
+
+	t.Run("objects up to the limit are accepted", func(t *testing.T) {
+		batch := createRandomObjects(getRandomSeed(), class.Class, 2, 0)
+		for _, err := range shd.PutObjectBatch(ctx, batch) {
+			assert.Nil(t, err)
+		}
+		err := shd.PutObject(ctx, testObject(class.Class))
+		assert.True(t, enterrors.IsTenantQuotaExceeded(err), "expected quota error, got %v", err)
+	})
+
+	t.Run("updates of existing objects are accepted", func(t *testing.T) {
+		require.Nil(t, shd.PutObject(ctx, first))
+	})
+
+	t.Run("usage is measured in the background", func(t *testing.T) {
+		_, _, measured := s.usage()
+		require.False(t, measured)
+
+		require.True(t, s.measureUsageCycle(func() bool { return false }))
+		objectCount, diskBytes, measured := s.usage()
+		require.True(t, measured)
+		assert.Equal(t, int64(3), objectCount)
+		assert.Greater(t, diskBytes, int64(0))
+
+		// without a disk quota the usage is measured less often
+		assert.False(t, s.measureUsageCycle(func() bool { return false }))
+	})
+}
+
+func TestShard_TenantQuotaReservations(t *testing.T) {
+	ctx := testCtx()
+	class := &models.Class{
+		Class: "QuotaReservationClass",
+		MultiTenancyConfig: &models.MultiTenancyConfig{
+			Enabled:     true,
+			TenantQuota: &models.TenantQuota{MaxObjectCount: 3},
+		},
+	}
+	opts := func(idx *Index) {
+		idx.partitioningEnabled = true
+		idx.Config.DisableLazyLoadShards = true
+	}
+	shd, _ := testShardWithSettings(t, ctx, class, enthnsw.UserConfig{Skip: true}, false, false, opts)
+	s := shd.(*Shard)
+	recount := func() {
+		s.quotaUsage.Lock()
+		s.quotaUsage.countedAt = time.Time{}
+		s.quotaUsage.Unlock()
+	}
+
+	t.Run("reservations are kept when counting", func(t *testing.T) {
+		reservation, err := s.reserveTenantQuota(createRandomObjects(getRandomSeed(), class.Class, 2, 0))
+		require.Nil(t, err)
+		recount()
+		_, err = s.reserveTenantQuota(createRandomObjects(getRandomSeed(), class.Class, 2, 0))
+		assert.True(t, enterrors.IsTenantQuotaExceeded(err), "expected quota error, got %v", err)
+
+		reservation.release(0)
+		reservation.release(2) // no-op
+		reservation, err = s.reserveTenantQuota(createRandomObjects(getRandomSeed(), class.Class, 3, 0))
+		require.Nil(t, err)
+		reservation.release(0)
+	})
+
+	t.Run("written objects are counted until the next count", func(t *testing.T) {
+		reservation, err := s.reserveTenantQuota(createRandomObjects(getRandomSeed(), class.Class, 2, 0))
+		require.Nil(t, err)
+		reservation.release(1)
+		_, err = s.reserveTenantQuota(createRandomObjects(getRandomSeed(), class.Class, 3, 0))
+		assert.True(t, enterrors.IsTenantQuotaExceeded(err), "expected quota error, got %v", err)
+
+		// nothing was actually written
+		recount()
+		reservation, err = s.reserveTenantQuota(createRandomObjects(getRandomSeed(), class.Class, 3, 0))
+		require.Nil(t, err)
+		reservation.release(0)
+	})
+
+	t.Run("failed writes release their reservation", func(t *testing.T) {
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		batch := createRandomObjects(getRandomSeed(), class.Class, 3, 0)
+		for _, err := range shd.PutObjectBatch(canceled, batch) {
+			require.NotNil(t, err)
+		}
+		require.Nil(t, shd.PutObject(ctx, testObject(class.Class)))
+	})
+
+	t.Run("aborted replication releases its reservation", func(t *testing.T) {
+		batch := createRandomObjects(getRandomSeed(), class.Class, 2, 0)
+		resp := s.preparePutObjects(ctx, "request", batch)
+		require.Empty(t, resp.Errors)
+		resp = s.preparePutObjects(ctx, "other-request", batch)
+		require.Len(t, resp.Errors, 1)
+		assert.Contains(t, resp.Errors[0].Msg, "quota")
+
+		s.abortReplication(ctx, "request")
+		resp = s.preparePutObjects(ctx, "other-request", batch)
+		require.Empty(t, resp.Errors)
+		s.commitReplication(ctx, "other-request", &shardTransfer{})
+
+		objectCount, _, _ := s.usage()
+		assert.Equal(t, int64(3), objectCount)
+		err := shd.PutObject(ctx, testObject(class.Class))
+		assert.True(t, enterrors.IsTenantQuotaExceeded(err), "expected quota error, got %v", err)
+	})
+
+	t.Run("disk usage is measured in the background", func(t *testing.T) {
+		require.Nil(t, s.checkTenantQuota())
+		// measured only with a disk quota
+		class.MultiTenancyConfig.TenantQuota = &models.TenantQuota{MaxDiskBytes: 1}
+		s.quotaUsage.Lock()
+		s.quotaUsage.diskBytes = 0
+		s.quotaUsage.Unlock()
+
+		require.True(t, s.measureUsageCycle(func() bool { return false }))
+		err := s.checkTenantQuota()
+		assert.True(t, enterrors.IsTenantQuotaExceeded(err), "expected quota error, got %v", err)
+	})
+}
+
+func TestDirSize(t *testing.T) {
+	dir := t.TempDir()
+	require.Nil(t, os.MkdirAll(filepath.Join(dir, "sub"), 0o755))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "a"), make([]byte, 10), 0o644))
+	require.Nil(t, os.WriteFile(filepath.Join(dir, "sub", "b"), make([]byte, 32), 0o644))
+
+	assert.Equal(t, int64(42), dirSize(dir))
+	assert.Equal(t, int64(0), dirSize(filepath.Join(dir, "missing")))
+}
@@ -39,6 +39,9 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 	entsentry "github.com/weaviate/weaviate/entities/sentry"
 	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (s *Shard) ObjectByIDErrDeleted(ctx context.Context, id strfmt.UUID, props search.SelectProperties, additional additional.Properties) (*storobj.Object, error) {
@@ -262,11 +265,24 @@ func (s *Shard) readMultiVectorByIndexIDIntoSlice(ctx context.Context, indexID u
 	return storobj.MultiVectorFromBinary(bytes, container.Slice, targetVector)
 }
 
+// startSearchSpan starts the span of a search in this shard. Details
+// annotated for the slow query log are added to the span as well.
+func (s *Shard) startSearchSpan(ctx context.Context, name string, limit int,
+	attrs ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	return tracing.Start(ctx, name, append([]attribute.KeyValue{
+		attribute.String("class", s.index.Config.ClassName.String()),
+		attribute.String("shard", s.ID()),
+		attribute.Int("limit", limit),
+	}, attrs...)...)
+}
+
 func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	additional additional.Properties, properties []string,
-) ([]*storobj.Object, []float32, error) {
-	var err error
+) (_ []*storobj.Object, _ []float32, err error) {
+	ctx, span := s.startSearchSpan(ctx, "shard.ObjectSearch", limit)
+	defer func() { tracing.End(span, err) }()
 
 	// Report slow queries if this method takes longer than expected
 	startTime := time.Now()
@@ -371,7 +387,11 @@ func (s *Shard) VectorDistanceForQuery(ctx context.Context, docId uint64, search
 	return distances, nil
 }
 
-func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.Vector, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) ([]*storobj.Object, []float32, error) {
+func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.Vector, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) (_ []*storobj.Object, _ []float32, err error) {
+	ctx, span := s.startSearchSpan(ctx, "shard.ObjectVectorSearch", limit,
+		attribute.StringSlice("target_vectors", targetVectors))
+	defer func() { tracing.End(span, err) }()
+
 	startTime := time.Now()
 
 	defer func() {
@@ -684,20 +704,44 @@ func (s *Shard) batchDeleteObject(ctx context.Context, id strfmt.UUID, deletionT
 		return nil
 	}
 
-	// we need the doc ID so we can clean up inverted indices currently
-	// pointing to this object
-	docID, updateTime, err := storobj.DocIDAndTimeFromBinary(existing)
+	if err = s.deleteObjectFromBucket(bucket, idBytes, deletionTime); err != nil {
+		return errors.Wrap(err, "delete object from bucket")
+	}
+
+	return s.cleanupOnBatchDelete(idBytes, existing)
+}
+
+// batchDeleteObjectIf deletes the object like batchDeleteObject if cond
+// holds for it, see deleteObjectFromBucketIf. It returns whether the object
+// was deleted.
+func (s *Shard) batchDeleteObjectIf(ctx context.Context, id strfmt.UUID, deletionTime time.Time,
+	cond func(existing []byte) (bool, error),
+) (bool, error) {
+	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
-		return errors.Wrap(err, "get existing doc id from object binary")
+		return false, err
 	}
 
-	if deletionTime.IsZero() {
-		err = bucket.Delete(idBytes)
-	} else {
-		err = bucket.DeleteWith(idBytes, deletionTime)
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	existing, err := s.deleteObjectFromBucketIf(bucket, idBytes, deletionTime, cond)
+	if err != nil {
+		return false, errors.Wrap(err, "delete object from bucket")
 	}
+	if existing == nil {
+		return false, nil
+	}
+
+	return true, s.cleanupOnBatchDelete(idBytes, existing)
+}
+
+// cleanupOnBatchDelete removes the object deleted from the objects bucket
+// from the inverted and vector indexes and the hashtree
+func (s *Shard) cleanupOnBatchDelete(idBytes, existing []byte) error {
+	// we need the doc ID so we can clean up inverted indices currently
+	// pointing to this object
+	docID, updateTime, err := storobj.DocIDAndTimeFromBinary(existing)
 	if err != nil {
-		return errors.Wrap(err, "delete object from bucket")
+		return errors.Wrap(err, "get existing doc id from object binary")
 	}
 
 	err = s.cleanupInvertedIndexOnDelete(existing, docID)
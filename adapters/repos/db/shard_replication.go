@@ -30,13 +30,22 @@ type replicaTask func(context.Context) interface{}
 type pendingReplicaTasks struct {
 	sync.Mutex
 	Tasks map[string]replicaTask
+	// reservations of the tenant quota held by the tasks, released once a
+	// task is deleted, i.e. committed or aborted
+	reservations map[string]*quotaReservation
 }
 
 func (p *pendingReplicaTasks) clear() {
 	p.Lock()
 	// TODO: can we postpone deletion until all pending replications are done
 	p.Tasks = nil
+	reservations := p.reservations
+	p.reservations = nil
 	p.Unlock()
+
+	for _, r := range reservations {
+		r.release(0)
+	}
 }
 
 func (p *pendingReplicaTasks) get(requestID string) (replicaTask, bool) {
@@ -52,10 +61,29 @@ func (p *pendingReplicaTasks) set(requestID string, task replicaTask) {
 	p.Unlock()
 }
 
+// setReserved sets a task holding a reservation of the tenant quota, which
+// the task should release once done. Otherwise it is released without any
+// objects written when the task is deleted.
+func (p *pendingReplicaTasks) setReserved(requestID string, task replicaTask, reservation *quotaReservation) {
+	p.Lock()
+	p.Tasks[requestID] = task
+	if reservation != nil {
+		if p.reservations == nil {
+			p.reservations = map[string]*quotaReservation{}
+		}
+		p.reservations[requestID] = reservation
+	}
+	p.Unlock()
+}
+
 func (p *pendingReplicaTasks) delete(requestID string) {
 	p.Lock()
 	delete(p.Tasks, requestID)
+	reservation := p.reservations[requestID]
+	delete(p.reservations, requestID)
 	p.Unlock()
+
+	reservation.release(0)
 }
 
 func (s *Shard) commitReplication(ctx context.Context, requestID string, backupReadLock *shardTransfer) interface{} {
@@ -82,16 +110,24 @@ func (s *Shard) preparePutObject(ctx context.Context, requestID string, object *
 			Code: replica.StatusPreconditionFailed, Msg: err.Error(),
 		}}}
 	}
+	reservation, err := s.reserveTenantQuota([]*storobj.Object{object})
+	if err != nil {
+		return replica.SimpleResponse{Errors: []replica.Error{{
+			Code: replica.StatusTenantQuotaExceeded, Msg: err.Error(),
+		}}}
+	}
 	task := func(ctx context.Context) interface{} {
 		resp := replica.SimpleResponse{}
 		if err := s.putOne(ctx, uuid, object); err != nil {
 			resp.Errors = []replica.Error{
 				{Code: replica.StatusConflict, Msg: err.Error()},
 			}
+			return resp
 		}
+		reservation.release(1)
 		return resp
 	}
-	s.replicationMap.set(requestID, task)
+	s.replicationMap.setReserved(requestID, task, reservation)
 	return replica.SimpleResponse{}
 }
 
@@ -102,6 +138,11 @@ func (s *Shard) prepareMergeObject(ctx context.Context, requestID string, doc *o
 			{Code: replica.StatusPreconditionFailed, Msg: err.Error()},
 		}}
 	}
+	if err := s.checkTenantQuota(); err != nil {
+		return replica.SimpleResponse{Errors: []replica.Error{{
+			Code: replica.StatusTenantQuotaExceeded, Msg: err.Error(),
+		}}}
+	}
 	task := func(ctx context.Context) interface{} {
 		resp := replica.SimpleResponse{}
 		if err := s.merge(ctx, uuid, *doc); err != nil {
@@ -144,8 +185,15 @@ func (s *Shard) prepareDeleteObject(ctx context.Context, requestID string, uuid
 }
 
 func (s *Shard) preparePutObjects(ctx context.Context, requestID string, objects []*storobj.Object) replica.SimpleResponse {
+	reservation, err := s.reserveTenantQuota(objects)
+	if err != nil {
+		return replica.SimpleResponse{Errors: []replica.Error{{
+			Code: replica.StatusTenantQuotaExceeded, Msg: err.Error(),
+		}}}
+	}
 	task := func(ctx context.Context) interface{} {
 		rawErrs := s.putBatch(ctx, objects)
+		reservation.release(countSucceeded(rawErrs))
 		resp := replica.SimpleResponse{Errors: make([]replica.Error, len(rawErrs))}
 		for i, err := range rawErrs {
 			if err != nil {
@@ -154,7 +202,7 @@ func (s *Shard) preparePutObjects(ctx context.Context, requestID string, objects
 		}
 		return resp
 	}
-	s.replicationMap.set(requestID, task)
+	s.replicationMap.setReserved(requestID, task, reservation)
 	return replica.SimpleResponse{}
 }
 
@@ -181,6 +229,11 @@ func (s *Shard) prepareDeleteObjects(ctx context.Context, requestID string,
 }
 
 func (s *Shard) prepareAddReferences(ctx context.Context, requestID string, refs []objects.BatchReference) replica.SimpleResponse {
+	if err := s.checkTenantQuota(); err != nil {
+		return replica.SimpleResponse{Errors: []replica.Error{{
+			Code: replica.StatusTenantQuotaExceeded, Msg: err.Error(),
+		}}}
+	}
 	task := func(ctx context.Context) interface{} {
 		rawErrs := newReferencesBatcher(s).References(ctx, refs)
 		resp := replica.SimpleResponse{Errors: make([]replica.Error, len(rawErrs))}
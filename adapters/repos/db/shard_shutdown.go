@@ -62,6 +62,9 @@ func (s *Shard) Shutdown(ctx context.Context) (err error) {
 		s.cycleCallbacks.flushCallbacksCtrl,
 		s.cycleCallbacks.vectorCombinedCallbacksCtrl,
 		s.cycleCallbacks.geoPropsCombinedCallbacksCtrl,
+		s.cycleCallbacks.objectTTLCallbacksCtrl,
+		s.cycleCallbacks.changeLogCallbacksCtrl,
+		s.cycleCallbacks.usageCallbacksCtrl,
 	).Unregister(ctx)
 	ec.Add(err)
 
@@ -21,6 +21,7 @@ import (
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/filters"
@@ -143,10 +144,7 @@ func (b *deleteObjectsBatcher) setErrorAtIndex(err error, index int) {
 }
 
 func (s *Shard) findDocIDs(ctx context.Context, filters *filters.LocalFilter) ([]uint64, error) {
-	allowList, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
-		nil, s.index.classSearcher, s.index.stopwords, s.versioner.version, s.isFallbackToSearchable,
-		s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
-		DocIDs(ctx, filters, additional.Properties{}, s.index.Config.ClassName)
+	allowList, err := s.findAllowList(ctx, filters)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +152,14 @@ func (s *Shard) findDocIDs(ctx context.Context, filters *filters.LocalFilter) ([
 	return allowList.Slice(), nil
 }
 
+// findAllowList returns the docIDs matching filters, the caller must close it
+func (s *Shard) findAllowList(ctx context.Context, filters *filters.LocalFilter) (helpers.AllowList, error) {
+	return inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
+		nil, s.index.classSearcher, s.index.stopwords, s.versioner.version, s.isFallbackToSearchable,
+		s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
+		DocIDs(ctx, filters, additional.Properties{}, s.index.Config.ClassName)
+}
+
 func (s *Shard) FindUUIDs(ctx context.Context, filters *filters.LocalFilter) ([]strfmt.UUID, error) {
 	docs, err := s.findDocIDs(ctx, filters)
 	if err != nil {
@@ -38,8 +38,14 @@ func (s *Shard) PutObjectBatch(ctx context.Context,
 	if err := s.isReadOnly(); err != nil {
 		return []error{err}
 	}
+	reservation, err := s.reserveTenantQuota(objects)
+	if err != nil {
+		return errorsForAll(err, len(objects))
+	}
 
-	return s.putBatch(ctx, objects)
+	errs := s.putBatch(ctx, objects)
+	reservation.release(countSucceeded(errs))
+	return errs
 }
 
 // asyncEnabled is a quick and dirty way to create a feature flag for async
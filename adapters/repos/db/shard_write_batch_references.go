@@ -32,6 +32,9 @@ func (s *Shard) AddReferencesBatch(ctx context.Context, refs objects.BatchRefere
 	if err := s.isReadOnly(); err != nil {
 		return []error{err}
 	}
+	if err := s.checkTenantQuota(); err != nil {
+		return errorsForAll(err, len(refs))
+	}
 
 	return newReferencesBatcher(s).References(ctx, refs)
 }
@@ -52,12 +52,7 @@ func (s *Shard) DeleteObject(ctx context.Context, id strfmt.UUID, deletionTime t
 		return fmt.Errorf("get existing doc id from object binary: %w", err)
 	}
 
-	if deletionTime.IsZero() {
-		err = bucket.Delete(idBytes)
-	} else {
-		err = bucket.DeleteWith(idBytes, deletionTime)
-	}
-	if err != nil {
+	if err = s.deleteObjectFromBucket(bucket, idBytes, deletionTime); err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
 
@@ -128,12 +123,7 @@ func (s *Shard) deleteOne(ctx context.Context, bucket *lsmkv.Bucket, obj, idByte
 
 	var err error
 
-	if deletionTime.IsZero() {
-		err = bucket.Delete(idBytes)
-	} else {
-		err = bucket.DeleteWith(idBytes, deletionTime)
-	}
-	if err != nil {
+	if err = s.deleteObjectFromBucket(bucket, idBytes, deletionTime); err != nil {
 		return fmt.Errorf("delete object from bucket: %w", err)
 	}
 
@@ -79,6 +79,10 @@ func (s *Shard) AnalyzeObject(object *storobj.Object) ([]inverted.Property, []in
 		schemaMap[filters.InternalPropLastUpdateTimeUnix] = object.Object.LastUpdateTimeUnix
 	}
 
-	props, err := inverted.NewAnalyzer(s.isFallbackToSearchable).Object(schemaMap, c.Properties, object.ID())
+	analyzer := inverted.NewAnalyzer(s.isFallbackToSearchable)
+	if s.index.invertedIndexConfig.IndexTermPositions {
+		analyzer.WithTermPositions()
+	}
+	props, err := analyzer.Object(schemaMap, c.Properties, object.ID())
 	return props, nilProps, err
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/entities/schema"
 )
 
 func (s *Shard) extendInvertedIndicesLSM(props []inverted.Property, nilProps []inverted.NilProperty,
@@ -102,9 +103,13 @@ func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property
 			// which is not standard for BM25
 			propLen = float32(len(property.Items))
 		}
+		withPositions := storesTermPositions(bucketValue, s.index.invertedIndexConfig)
 		for _, item := range property.Items {
 			key := item.Data
 			pair := s.pairPropertyWithFrequency(docID, item.TermFrequency, propLen)
+			if withPositions {
+				pair = withTermPositions(pair, item)
+			}
 			if err := s.addToPropertyMapBucket(bucketValue, pair, key); err != nil {
 				return errors.Wrapf(err, "failed adding to prop '%s' value bucket", property.Name)
 			}
@@ -164,6 +169,21 @@ func (s *Shard) addToPropertyNullIndex(propName string, docID uint64, isNull boo
 	return nil
 }
 
+// storesTermPositions returns whether the postings of a searchable bucket
+// carry term positions, which are only stored by the inverted strategy
+func storesTermPositions(bucket *lsmkv.Bucket, config schema.InvertedIndexConfig) bool {
+	return config.IndexTermPositions && bucket.Strategy() == lsmkv.StrategyInverted
+}
+
+// withTermPositions appends the term positions of the item to the value of
+// its posting
+func withTermPositions(pair lsmkv.MapPair, item inverted.Countable) lsmkv.MapPair {
+	value := make([]byte, 0, len(pair.Value)+len(item.Positions)*2)
+	value = append(value, pair.Value...)
+	pair.Value = append(value, inverted.EncodePositions(item.Positions)...)
+	return pair
+}
+
 func (s *Shard) pairPropertyWithFrequency(docID uint64, freq, propLen float32) lsmkv.MapPair {
 	// 8 bytes for doc id, 4 bytes for frequency, 4 bytes for prop term length
 	buf := make([]byte, 16)
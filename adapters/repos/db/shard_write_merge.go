@@ -30,6 +30,9 @@ func (s *Shard) MergeObject(ctx context.Context, merge objects.MergeDocument) er
 	if err := s.isReadOnly(); err != nil {
 		return err
 	}
+	if err := s.checkTenantQuota(); err != nil {
+		return err
+	}
 
 	for targetVector, vector := range merge.Vectors {
 		// validation needs to happen before any changes are done. Otherwise, insertion is aborted somewhere in-between.
@@ -168,6 +171,8 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 			return errors.Wrap(err, "upsert object data")
 		}
 
+		s.trackWrite(idBytes)
+		s.mayAppendChangeLogUpsert(obj, objBytes)
 		return nil
 	}(); err != nil {
 		return nil, objectInsertStatus{}, err
@@ -247,6 +252,9 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 		return out, errors.Wrap(err, "upsert object data")
 	}
 
+	s.trackWrite(idBytes)
+	s.mayAppendChangeLogUpsert(obj, objBytes)
+
 	// do not updated inverted index, since this requires delta analysis, which
 	// must be done by the caller!
 
@@ -39,7 +39,16 @@ func (s *Shard) PutObject(ctx context.Context, object *storobj.Object) error {
 	if err != nil {
 		return err
 	}
-	return s.putOne(ctx, uid, object)
+	reservation, err := s.reserveTenantQuota([]*storobj.Object{object})
+	if err != nil {
+		return err
+	}
+	if err := s.putOne(ctx, uid, object); err != nil {
+		reservation.release(0)
+		return err
+	}
+	reservation.release(1)
+	return nil
 }
 
 func (s *Shard) putOne(ctx context.Context, uuid []byte, object *storobj.Object) error {
@@ -279,6 +288,9 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		}
 		s.metrics.PutObjectUpsertObject(before)
 
+		s.trackWrite(idBytes)
+		s.mayAppendChangeLogUpsert(obj, objBinary)
+
 		return nil
 	}(); err != nil {
 		return objectInsertStatus{}, err
@@ -0,0 +1,343 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/encryption"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	schemaUC "github.com/weaviate/weaviate/usecases/schema"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestTenantEncryptionKeys(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	tenantKeys := newFakeTenantKeys()
+
+	class := &models.Class{
+		Class:               "EncryptedTenants",
+		Properties:          []*models.Property{{Name: "text", DataType: schema.DataTypeText.PropString()}},
+		InvertedIndexConfig: invertedConfig(),
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+	}
+	tenants := []string{"tenant1", "tenant2"}
+	shardState := &sharding.State{
+		Physical:            map[string]sharding.Physical{},
+		PartitioningEnabled: true,
+	}
+	for _, tenant := range tenants {
+		shardState.Physical[tenant] = sharding.Physical{
+			Name:           tenant,
+			BelongsToNodes: []string{"node1"},
+			Status:         models.TenantActivityStatusHOT,
+		}
+	}
+	shardState.SetLocalName("node1")
+
+	newDB := func(t *testing.T) *DB {
+		repo, err := New(logger, Config{
+			MemtablesFlushDirtyAfter:  60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10,
+			MaxImportGoroutinesFactor: 1,
+			TenantKeys:                tenantKeys,
+		}, &fakeRemoteClient{}, &fakeNodeResolver{},
+			&fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(&fakeSchemaGetter{
+			schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+			shardState: shardState,
+		})
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	t.Run("tenants get their own keys", func(t *testing.T) {
+		repo := newDB(t)
+		defer repo.Shutdown(ctx)
+
+		migrator := NewMigrator(repo, logger)
+		var creates []*schemaUC.CreateTenantPayload
+		for _, tenant := range tenants {
+			creates = append(creates, &schemaUC.CreateTenantPayload{
+				Name: tenant, Status: models.TenantActivityStatusHOT,
+			})
+		}
+		require.Nil(t, migrator.NewTenants(ctx, class, creates))
+
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		for _, tenant := range tenants {
+			require.Nil(t, idx.LoadLocalShard(ctx, tenant))
+			shard, release, err := idx.GetShard(ctx, tenant)
+			require.Nil(t, err)
+
+			obj := &models.Object{
+				ID:         strfmt.UUID(uuid.NewString()),
+				Class:      class.Class,
+				Tenant:     tenant,
+				Properties: map[string]interface{}{"text": "secret of " + tenant},
+			}
+			require.Nil(t, shard.PutObject(ctx, storobj.FromObject(obj, []float32{1, 2, 3}, nil, nil)))
+			require.Nil(t, shard.Store().FlushMemtables(ctx))
+			release()
+		}
+
+		keyIDs := map[string]string{}
+		for _, tenant := range tenants {
+			ids := fileKeyIDs(t, shardPath(idx.path(), tenant))
+			require.Len(t, ids, 1, tenant)
+			for id := range ids {
+				keyIDs[tenant] = id
+			}
+			assert.Equal(t, tenantKeys.keyID(class.Class, tenant), keyIDs[tenant])
+		}
+		assert.NotEqual(t, keyIDs["tenant1"], keyIDs["tenant2"])
+	})
+
+	t.Run("tenant can only be loaded with its key", func(t *testing.T) {
+		require.Nil(t, tenantKeys.DeleteTenantKeys(ctx, class.Class, []string{"tenant1"}))
+
+		repo := newDB(t)
+		defer repo.Shutdown(ctx)
+
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		assert.ErrorContains(t, idx.LoadLocalShard(ctx, "tenant1"), encryption.ErrKeyNotFound.Error())
+		assert.Nil(t, idx.LoadLocalShard(ctx, "tenant2"))
+
+		// the key of an existing tenant is not silently replaced
+		_, err := tenantKeys.TenantKeys(ctx, class.Class, "tenant1")
+		assert.ErrorIs(t, err, encryption.ErrKeyNotFound)
+	})
+
+	t.Run("deleting tenants deletes their keys", func(t *testing.T) {
+		repo := newDB(t)
+		defer repo.Shutdown(ctx)
+
+		migrator := NewMigrator(repo, logger)
+		require.Nil(t, migrator.DeleteTenants(ctx, class.Class,
+			[]*models.Tenant{{Name: "tenant2"}}))
+
+		_, err := tenantKeys.TenantKeys(ctx, class.Class, "tenant2")
+		assert.ErrorIs(t, err, encryption.ErrKeyNotFound)
+	})
+}
+
+func TestTenantEncryptionKeysMigration(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	tenantKeys := newFakeTenantKeys()
+
+	class := &models.Class{
+		Class:               "MigratedTenants",
+		Properties:          []*models.Property{{Name: "text", DataType: schema.DataTypeText.PropString()}},
+		InvertedIndexConfig: invertedConfig(),
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+	}
+	shardState := &sharding.State{
+		Physical: map[string]sharding.Physical{"tenant1": {
+			Name:           "tenant1",
+			BelongsToNodes: []string{"node1"},
+			Status:         models.TenantActivityStatusHOT,
+		}},
+		PartitioningEnabled: true,
+	}
+	shardState.SetLocalName("node1")
+	obj := &models.Object{
+		ID:         strfmt.UUID(uuid.NewString()),
+		Class:      class.Class,
+		Tenant:     "tenant1",
+		Properties: map[string]interface{}{"text": "created before encryption"},
+	}
+
+	newDB := func(t *testing.T, keys *fakeTenantKeys, createMissing bool) *DB {
+		config := Config{
+			MemtablesFlushDirtyAfter:  60,
+			RootPath:                  dirName,
+			QueryMaximumResults:       10,
+			MaxImportGoroutinesFactor: 1,
+			CreateMissingTenantKeys:   createMissing,
+		}
+		if keys != nil {
+			config.TenantKeys = keys
+		}
+		repo, err := New(logger, config, &fakeRemoteClient{}, &fakeNodeResolver{},
+			&fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, nil)
+		require.Nil(t, err)
+		repo.SetSchemaGetter(&fakeSchemaGetter{
+			schema:     schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}},
+			shardState: shardState,
+		})
+		require.Nil(t, repo.WaitForStartup(testCtx()))
+		return repo
+	}
+
+	t.Run("tenant is created without encryption", func(t *testing.T) {
+		repo := newDB(t, nil, false)
+		defer repo.Shutdown(ctx)
+
+		require.Nil(t, NewMigrator(repo, logger).NewTenants(ctx, class,
+			[]*schemaUC.CreateTenantPayload{{Name: "tenant1", Status: models.TenantActivityStatusHOT}}))
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		shard, release, err := idx.GetShard(ctx, "tenant1")
+		require.Nil(t, err)
+		defer release()
+		require.Nil(t, shard.PutObject(ctx, storobj.FromObject(obj, []float32{1, 2, 3}, nil, nil)))
+		require.Nil(t, shard.Store().FlushMemtables(ctx))
+	})
+
+	t.Run("missing key is not created without the migration flag", func(t *testing.T) {
+		repo := newDB(t, tenantKeys, false)
+		defer repo.Shutdown(ctx)
+
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		assert.ErrorContains(t, idx.LoadLocalShard(ctx, "tenant1"), encryption.ErrKeyNotFound.Error())
+		_, err := tenantKeys.TenantKeys(ctx, class.Class, "tenant1")
+		assert.ErrorIs(t, err, encryption.ErrKeyNotFound)
+	})
+
+	t.Run("missing key is created with the migration flag", func(t *testing.T) {
+		repo := newDB(t, tenantKeys, true)
+		defer repo.Shutdown(ctx)
+
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		require.Nil(t, idx.LoadLocalShard(ctx, "tenant1"))
+		_, err := tenantKeys.TenantKeys(ctx, class.Class, "tenant1")
+		assert.Nil(t, err)
+
+		shard, release, err := idx.GetShard(ctx, "tenant1")
+		require.Nil(t, err)
+		defer release()
+		stored, err := shard.ObjectByID(ctx, obj.ID, nil, additional.Properties{})
+		require.Nil(t, err)
+		require.NotNil(t, stored)
+	})
+}
+
+// fileKeyIDs returns the keys used by all encrypted files below dir
+func fileKeyIDs(t *testing.T, dir string) map[string]struct{} {
+	ids := map[string]struct{}{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		id, err := encryption.KeyID(path)
+		if err != nil {
+			return err
+		}
+		if id != "" {
+			ids[id] = struct{}{}
+		}
+		return nil
+	})
+	require.Nil(t, err)
+	return ids
+}
+
+type fakeTenantKeys struct {
+	sync.Mutex
+	keys map[string]encryption.Key // by class/tenant
+}
+
+func newFakeTenantKeys() *fakeTenantKeys {
+	return &fakeTenantKeys{keys: map[string]encryption.Key{}}
+}
+
+func (f *fakeTenantKeys) keyID(class, tenant string) string {
+	f.Lock()
+	defer f.Unlock()
+	return f.keys[class+"/"+tenant].ID
+}
+
+func (f *fakeTenantKeys) TenantKeys(ctx context.Context, class, tenant string) (encryption.KeyProvider, error) {
+	f.Lock()
+	defer f.Unlock()
+	key, ok := f.keys[class+"/"+tenant]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s/%s", encryption.ErrKeyNotFound, class, tenant)
+	}
+	return singleKey(key), nil
+}
+
+func (f *fakeTenantKeys) CreateTenantKeys(ctx context.Context, class, tenant string) (encryption.KeyProvider, error) {
+	f.Lock()
+	defer f.Unlock()
+	key, ok := f.keys[class+"/"+tenant]
+	if !ok {
+		var err error
+		if key, err = encryption.GenerateKey(uuid.NewString()); err != nil {
+			return nil, err
+		}
+		f.keys[class+"/"+tenant] = key
+	}
+	return singleKey(key), nil
+}
+
+func (f *fakeTenantKeys) DeleteTenantKeys(ctx context.Context, class string, tenants []string) error {
+	f.Lock()
+	defer f.Unlock()
+	for _, tenant := range tenants {
+		delete(f.keys, class+"/"+tenant)
+	}
+	return nil
+}
+
+func (f *fakeTenantKeys) DeleteClassKeys(ctx context.Context, class string) error {
+	f.Lock()
+	defer f.Unlock()
+	for k := range f.keys {
+		if strings.HasPrefix(k, class+"/") {
+			delete(f.keys, k)
+		}
+	}
+	return nil
+}
+
+type singleKey encryption.Key
+
+func (k singleKey) CurrentKey() (encryption.Key, error) {
+	return encryption.Key(k), nil
+}
+
+func (k singleKey) Key(id string) (encryption.Key, error) {
+	if id != k.ID {
+		return encryption.Key{}, fmt.Errorf("%w: %q", encryption.ErrKeyNotFound, id)
+	}
+	return encryption.Key(k), nil
+}
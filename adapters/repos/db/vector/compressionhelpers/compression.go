@@ -39,6 +39,7 @@ type ReturnDistancerFn func()
 type CommitLogger interface {
 	AddPQCompression(PQData) error
 	AddSQCompression(SQData) error
+	AddRQCompression(RQData) error
 }
 
 type CompressionStats interface {
@@ -631,6 +632,92 @@ func RestoreHNSWSQMultiCompressor(
 	return sqVectorsCompressor, nil
 }
 
+func NewHNSWRQCompressor(
+	distance distancer.Provider,
+	dimensions int,
+	bits int,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	quantizer, err := NewRotationalQuantizer(dimensions, bits, distance)
+	if err != nil {
+		return nil, err
+	}
+	return newRQCompressor(quantizer, vectorCacheMaxObjects, logger, store, allocChecker, false), nil
+}
+
+func RestoreHNSWRQCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data RQData,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	quantizer, err := RestoreRotationalQuantizer(int(data.Dimensions), int(data.Bits), data.Seed, distance)
+	if err != nil {
+		return nil, err
+	}
+	return newRQCompressor(quantizer, vectorCacheMaxObjects, logger, store, allocChecker, false), nil
+}
+
+func NewHNSWRQMultiCompressor(
+	distance distancer.Provider,
+	dimensions int,
+	bits int,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	quantizer, err := NewRotationalQuantizer(dimensions, bits, distance)
+	if err != nil {
+		return nil, err
+	}
+	return newRQCompressor(quantizer, vectorCacheMaxObjects, logger, store, allocChecker, true), nil
+}
+
+func RestoreHNSWRQMultiCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data RQData,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	quantizer, err := RestoreRotationalQuantizer(int(data.Dimensions), int(data.Bits), data.Seed, distance)
+	if err != nil {
+		return nil, err
+	}
+	return newRQCompressor(quantizer, vectorCacheMaxObjects, logger, store, allocChecker, true), nil
+}
+
+func newRQCompressor(quantizer *RotationalQuantizer, vectorCacheMaxObjects int,
+	logger logrus.FieldLogger, store *lsmkv.Store, allocChecker memwatch.AllocChecker,
+	multi bool,
+) VectorCompressor {
+	rqVectorsCompressor := &quantizedVectorsCompressor[byte]{
+		quantizer:       quantizer,
+		compressedStore: store,
+		storeId:         binary.BigEndian.PutUint64,
+		loadId:          binary.BigEndian.Uint64,
+		logger:          logger,
+	}
+	rqVectorsCompressor.initCompressedStore()
+	if multi {
+		rqVectorsCompressor.cache = cache.NewShardedMultiByteLockCache(
+			rqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, logger,
+			0, allocChecker)
+	} else {
+		rqVectorsCompressor.cache = cache.NewShardedByteLockCache(
+			rqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
+			0, allocChecker)
+	}
+	return rqVectorsCompressor
+}
+
 type quantizedCompressorDistancer[T byte | uint64] struct {
 	compressor *quantizedVectorsCompressor[T]
 	distancer  quantizerDistancer[T]
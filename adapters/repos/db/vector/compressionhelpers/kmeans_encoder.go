@@ -26,6 +26,7 @@ type KMeansEncoder struct {
 	s        int                // Segment where it operates.
 	centers  [][]float32        // k-means centroids used for encoding data.
 	distance distancer.Provider // Distance measure used to encode to nearest center.
+	seed     uint64             // Seed of the k-means fitting, random if zero.
 }
 
 func NewKMeansEncoder(k int, dimensions int, segment int) *KMeansEncoder {
@@ -47,6 +48,9 @@ func NewKMeansEncoderWithCenters(k int, dimensions int, segment int, centers [][
 // Assumes that data contains only non-nil vectors.
 func (m *KMeansEncoder) Fit(data [][]float32) error {
 	km := kmeans.New(m.k, m.d, m.s)
+	if m.seed != 0 {
+		km.Seed = m.seed
+	}
 	km.DeltaThreshold = 0.01
 	km.IterationThreshold = 10
 	// Experiments on ANN datasets reveal that random initialization is ~20%
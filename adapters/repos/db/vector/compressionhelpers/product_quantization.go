@@ -165,6 +165,8 @@ type ProductQuantizer struct {
 	trainingLimit       int
 	globalDistances     []float32
 	logger              logrus.FieldLogger
+	// seed makes fitting kmeans encoders deterministic if it is not zero
+	seed uint64
 }
 
 type PQData struct {
@@ -363,6 +365,12 @@ func (d *PQDistancer) DistanceToFloat(x []float32) (float32, error) {
 	return d.pq.DistanceBetweenCompressedVectors(d.compressed, xComp)
 }
 
+// SetSeed seeds fitting kmeans encoders, so that fitting the same data results
+// in the same encoders.
+func (pq *ProductQuantizer) SetSeed(seed uint64) {
+	pq.seed = seed
+}
+
 func (pq *ProductQuantizer) Fit(data [][]float32) error {
 	if pq.trainingLimit > 0 && len(data) > pq.trainingLimit {
 		data = data[:pq.trainingLimit]
@@ -391,11 +399,15 @@ func (pq *ProductQuantizer) Fit(data [][]float32) error {
 				return
 			}
 			mutex.Unlock()
-			pq.kms[i] = NewKMeansEncoder(
+			encoder := NewKMeansEncoder(
 				pq.ks,
 				pq.ds,
 				int(i),
 			)
+			if pq.seed != 0 {
+				encoder.seed = pq.seed + i
+			}
+			pq.kms[i] = encoder
 			err := pq.kms[i].Fit(data)
 			mutex.Lock()
 			if errorResult == nil && err != nil {
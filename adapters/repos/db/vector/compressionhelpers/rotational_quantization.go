@@ -0,0 +1,466 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"math/rand"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+const (
+	// the rotation is applied to blocks of rqBlockSize dimensions, vectors are
+	// padded with zeros to a multiple of it
+	rqBlockSize = 64
+	// rounds of permutations, sign flips and block-wise Hadamard transforms,
+	// which spread every input dimension across all output dimensions
+	rqRounds = 3
+	// rqFooterSize is the size of the per-vector correction factors stored
+	// after the codes: lower, step, code sum, squared norm, scale and error
+	rqFooterSize = 6 * 4
+	// rqErrorEpsilon controls the confidence of the error bounds in standard
+	// deviations of the approximately normally distributed estimation error
+	rqErrorEpsilon = 3.0
+)
+
+// RotationalQuantizer compresses vectors by applying a random rotation and
+// quantizing every rotated dimension to a few bits. The rotation spreads the
+// information of all dimensions evenly, so a single scalar range per vector
+// suffices. Like RaBitQ, the estimates of the inner products are corrected
+// by how well the quantized vector aligns with the original one, which makes
+// them unbiased and allows to give a bound on their error.
+//
+// Unlike PQ and SQ, the quantizer does not depend on the data, it is fully
+// described by the dimensions, the bits per dimension and the seed of the
+// rotation.
+type RotationalQuantizer struct {
+	distancer  distancer.Provider
+	dimensions int
+	bits       int
+	seed       uint64
+	rotation   *fastRotation
+}
+
+type RQData struct {
+	Dimensions uint16
+	Bits       uint8
+	Seed       uint64
+}
+
+// NewRotationalQuantizer creates a quantizer with a random rotation.
+func NewRotationalQuantizer(dimensions, bits int, distance distancer.Provider) (*RotationalQuantizer, error) {
+	return RestoreRotationalQuantizer(dimensions, bits, rand.Uint64(), distance)
+}
+
+// RestoreRotationalQuantizer recreates the quantizer with the rotation of the
+// given seed.
+func RestoreRotationalQuantizer(dimensions, bits int, seed uint64, distance distancer.Provider) (*RotationalQuantizer, error) {
+	if dimensions <= 0 {
+		return nil, errors.Errorf("invalid dimensions %d for rotational quantization", dimensions)
+	}
+	switch bits {
+	case 1, 2, 4, 8:
+	default:
+		return nil, errors.Errorf("invalid bits %d for rotational quantization, must be 1, 2, 4 or 8", bits)
+	}
+	switch distance.Type() {
+	case "l2-squared", "dot", "cosine-dot":
+	default:
+		return nil, errors.Errorf("distance %s is not supported by rotational quantization", distance.Type())
+	}
+
+	return &RotationalQuantizer{
+		distancer:  distance,
+		dimensions: dimensions,
+		bits:       bits,
+		seed:       seed,
+		rotation:   newFastRotation(dimensions, int64(seed)),
+	}, nil
+}
+
+// fastRotation is a random orthogonal transformation which is much cheaper
+// to apply and to store than a dense random rotation matrix. Each round
+// permutes the dimensions, flips their signs randomly and mixes each block
+// with a normalized Walsh-Hadamard transform.
+type fastRotation struct {
+	outputDims   int
+	permutations [][]int
+	signs        [][]float32
+}
+
+func newFastRotation(dimensions int, seed int64) *fastRotation {
+	outputDims := (dimensions + rqBlockSize - 1) / rqBlockSize * rqBlockSize
+	r := rand.New(rand.NewSource(seed))
+
+	rotation := &fastRotation{
+		outputDims:   outputDims,
+		permutations: make([][]int, rqRounds),
+		signs:        make([][]float32, rqRounds),
+	}
+	for round := 0; round < rqRounds; round++ {
+		rotation.permutations[round] = r.Perm(outputDims)
+		rotation.signs[round] = make([]float32, outputDims)
+		for i := range rotation.signs[round] {
+			rotation.signs[round][i] = 1
+			if r.Intn(2) == 0 {
+				rotation.signs[round][i] = -1
+			}
+		}
+	}
+	return rotation
+}
+
+func (f *fastRotation) rotate(x []float32) []float32 {
+	out := make([]float32, f.outputDims)
+	copy(out, x)
+	tmp := make([]float32, f.outputDims)
+	for round := 0; round < rqRounds; round++ {
+		signs := f.signs[round]
+		for i, j := range f.permutations[round] {
+			tmp[i] = out[j] * signs[i]
+		}
+		out, tmp = tmp, out
+		for start := 0; start < f.outputDims; start += rqBlockSize {
+			walshHadamard(out[start : start+rqBlockSize])
+		}
+	}
+	return out
+}
+
+// walshHadamard applies the normalized, hence orthogonal, Walsh-Hadamard
+// transform in place. The length of x must be a power of two.
+func walshHadamard(x []float32) {
+	for h := 1; h < len(x); h *= 2 {
+		for i := 0; i < len(x); i += 2 * h {
+			for j := i; j < i+h; j++ {
+				a, b := x[j], x[j+h]
+				x[j], x[j+h] = a+b, a-b
+			}
+		}
+	}
+	norm := float32(1 / math.Sqrt(float64(len(x))))
+	for i := range x {
+		x[i] *= norm
+	}
+}
+
+func (rq *RotationalQuantizer) codesSize() int {
+	return rq.rotation.outputDims * rq.bits / 8
+}
+
+// Encode quantizes the rotated vector with a symmetric range, a single bit
+// per dimension stores the sign scaled by the mean magnitude instead, which
+// minimizes the reconstruction error. The codes are followed by the
+// correction factors of the vector.
+func (rq *RotationalQuantizer) Encode(vec []float32) []byte {
+	rotated := rq.rotation.rotate(vec)
+	levels := uint32(1)<<rq.bits - 1
+
+	var maxAbs, sumAbs, normSq float64
+	for _, v := range rotated {
+		abs := math.Abs(float64(v))
+		sumAbs += abs
+		normSq += float64(v) * float64(v)
+		if abs > maxAbs {
+			maxAbs = abs
+		}
+	}
+
+	var lower, step float64
+	if rq.bits == 1 {
+		alpha := sumAbs / float64(len(rotated))
+		lower, step = -alpha, 2*alpha
+	} else {
+		lower, step = -maxAbs, 2*maxAbs/float64(levels)
+	}
+
+	out := make([]byte, rq.codesSize()+rqFooterSize)
+	var codeSum, dotRecon, normSqRecon float64
+	for i, v := range rotated {
+		code := uint32(0)
+		if step > 0 {
+			c := math.Round((float64(v) - lower) / step)
+			code = uint32(math.Max(0, math.Min(float64(levels), c)))
+		}
+		rq.putCode(out, i, code)
+
+		recon := lower + step*float64(code)
+		codeSum += float64(code)
+		dotRecon += recon * float64(v)
+		normSqRecon += recon * recon
+	}
+
+	// the RaBitQ estimator <x,q> ~ <x',q> * |x|^2 / <x',x> with x' being the
+	// reconstruction, its error shrinks with the alignment of x and x'
+	scale, errFactor := 1.0, 0.0
+	if dotRecon > 0 && normSqRecon > 0 {
+		scale = normSq / dotRecon
+		cos := dotRecon / math.Sqrt(normSqRecon*normSq)
+		if cos < 1 {
+			errFactor = math.Sqrt(normSq) * math.Sqrt((1-cos*cos)/(cos*cos)) /
+				math.Sqrt(float64(len(rotated)-1))
+		}
+	}
+
+	footer := out[rq.codesSize():]
+	for i, f := range []float64{lower, step, codeSum, normSq, scale, errFactor} {
+		binary.LittleEndian.PutUint32(footer[i*4:], math.Float32bits(float32(f)))
+	}
+	return out
+}
+
+func (rq *RotationalQuantizer) putCode(out []byte, i int, code uint32) {
+	switch rq.bits {
+	case 8:
+		out[i] = byte(code)
+	default:
+		pos := i * rq.bits
+		out[pos/8] |= byte(code) << (pos % 8)
+	}
+}
+
+func (rq *RotationalQuantizer) code(codes []byte, i int) uint32 {
+	switch rq.bits {
+	case 8:
+		return uint32(codes[i])
+	default:
+		pos := i * rq.bits
+		return uint32(codes[pos/8]>>(pos%8)) & (1<<rq.bits - 1)
+	}
+}
+
+// rqCode gives access to the correction factors of an encoded vector.
+type rqCode []byte
+
+func (c rqCode) factor(i int) float32 {
+	return math.Float32frombits(binary.LittleEndian.Uint32(c[len(c)-rqFooterSize+i*4:]))
+}
+
+func (c rqCode) lower() float32     { return c.factor(0) }
+func (c rqCode) step() float32      { return c.factor(1) }
+func (c rqCode) codeSum() float32   { return c.factor(2) }
+func (c rqCode) normSq() float32    { return c.factor(3) }
+func (c rqCode) scale() float32     { return c.factor(4) }
+func (c rqCode) errFactor() float32 { return c.factor(5) }
+
+func (rq *RotationalQuantizer) validCode(x []byte) error {
+	if len(x) != rq.codesSize()+rqFooterSize {
+		return errors.Errorf("rotational quantization code of length %d does not match %d",
+			len(x), rq.codesSize()+rqFooterSize)
+	}
+	return nil
+}
+
+// dotCodes is the inner product of the integer codes of two vectors.
+func (rq *RotationalQuantizer) dotCodes(x, y []byte) float32 {
+	x, y = x[:rq.codesSize()], y[:rq.codesSize()]
+	switch rq.bits {
+	case 8:
+		return float32(dotByteImpl(x, y))
+	case 1:
+		var sum int
+		for i := 0; i+8 <= len(x); i += 8 {
+			sum += bits.OnesCount64(binary.LittleEndian.Uint64(x[i:]) & binary.LittleEndian.Uint64(y[i:]))
+		}
+		return float32(sum)
+	default:
+		var sum uint32
+		for i := 0; i < rq.rotation.outputDims; i++ {
+			sum += rq.code(x, i) * rq.code(y, i)
+		}
+		return float32(sum)
+	}
+}
+
+// dotQuery is the inner product of the rotated query with the integer codes
+// of a vector.
+func (rq *RotationalQuantizer) dotQuery(query []float32, codes []byte) float32 {
+	var sum float32
+	switch rq.bits {
+	case 8:
+		for i, q := range query {
+			sum += q * float32(codes[i])
+		}
+	case 1:
+		for i, b := range codes[:rq.codesSize()] {
+			for b != 0 {
+				sum += query[i*8+bits.TrailingZeros8(b)]
+				b &= b - 1
+			}
+		}
+	default:
+		for i, q := range query {
+			sum += q * float32(rq.code(codes, i))
+		}
+	}
+	return sum
+}
+
+// estimateDot estimates the inner product of the vectors behind two codes.
+func (rq *RotationalQuantizer) estimateDot(x, y rqCode) float32 {
+	dims := float32(rq.rotation.outputDims)
+	dot := dims*x.lower()*y.lower() +
+		x.lower()*y.step()*y.codeSum() +
+		y.lower()*x.step()*x.codeSum() +
+		x.step()*y.step()*rq.dotCodes(x, y)
+	return dot * x.scale() * y.scale()
+}
+
+func (rq *RotationalQuantizer) distanceFromDot(dot, normSqX, normSqY float32) (float32, error) {
+	switch rq.distancer.Type() {
+	case "l2-squared":
+		return normSqX + normSqY - 2*dot, nil
+	case "dot":
+		return -dot, nil
+	case "cosine-dot":
+		return 1 - dot, nil
+	}
+	return 0, errors.Errorf("distance %s is not supported by rotational quantization", rq.distancer.Type())
+}
+
+func (rq *RotationalQuantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	if len(x) != len(y) {
+		return 0, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(x), len(y))
+	}
+	if err := rq.validCode(x); err != nil {
+		return 0, err
+	}
+	cx, cy := rqCode(x), rqCode(y)
+	return rq.distanceFromDot(rq.estimateDot(cx, cy), cx.normSq(), cy.normSq())
+}
+
+type RQDistancer struct {
+	x       []float32
+	rq      *RotationalQuantizer
+	rotated []float32
+	sum     float32
+	normSq  float32
+	// compressed is set instead of the query if the distancer was created
+	// from an encoded vector
+	compressed []byte
+}
+
+func (rq *RotationalQuantizer) NewDistancer(a []float32) *RQDistancer {
+	rotated := rq.rotation.rotate(a)
+	var sum, normSq float32
+	for _, v := range rotated {
+		sum += v
+		normSq += v * v
+	}
+	return &RQDistancer{
+		x:       a,
+		rq:      rq,
+		rotated: rotated,
+		sum:     sum,
+		normSq:  normSq,
+	}
+}
+
+// Distance estimates the distance between the query and the encoded vector.
+func (d *RQDistancer) Distance(x []byte) (float32, error) {
+	dist, _, err := d.DistanceWithBound(x)
+	return dist, err
+}
+
+// DistanceWithBound estimates the distance between the query and the encoded
+// vector together with a bound of the estimation error. The exact distance
+// lies within [dist-bound, dist+bound] with high probability.
+func (d *RQDistancer) DistanceWithBound(x []byte) (float32, float32, error) {
+	if d.compressed != nil {
+		dist, err := d.rq.DistanceBetweenCompressedVectors(d.compressed, x)
+		return dist, 0, err
+	}
+	if err := d.rq.validCode(x); err != nil {
+		return 0, 0, err
+	}
+	code := rqCode(x)
+
+	dot := code.scale() * (code.lower()*d.sum + code.step()*d.rq.dotQuery(d.rotated, x))
+	dist, err := d.rq.distanceFromDot(dot, d.normSq, code.normSq())
+	if err != nil {
+		return 0, 0, err
+	}
+
+	bound := rqErrorEpsilon * float32(math.Sqrt(float64(d.normSq))) * code.errFactor()
+	if d.rq.distancer.Type() == "l2-squared" {
+		bound *= 2
+	}
+	return dist, bound, nil
+}
+
+func (d *RQDistancer) DistanceToFloat(x []float32) (float32, error) {
+	if len(d.x) > 0 {
+		return d.rq.distancer.SingleDist(d.x, x)
+	}
+	return d.rq.DistanceBetweenCompressedVectors(d.compressed, d.rq.Encode(x))
+}
+
+func (rq *RotationalQuantizer) NewQuantizerDistancer(a []float32) quantizerDistancer[byte] {
+	return rq.NewDistancer(a)
+}
+
+func (rq *RotationalQuantizer) NewCompressedQuantizerDistancer(a []byte) quantizerDistancer[byte] {
+	return &RQDistancer{
+		rq:         rq,
+		compressed: a,
+	}
+}
+
+func (rq *RotationalQuantizer) ReturnQuantizerDistancer(distancer quantizerDistancer[byte]) {}
+
+func (rq *RotationalQuantizer) CompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (rq *RotationalQuantizer) FromCompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (rq *RotationalQuantizer) FromCompressedBytesWithSubsliceBuffer(compressed []byte, buffer *[]byte) []byte {
+	if len(*buffer) < len(compressed) {
+		*buffer = make([]byte, len(compressed)*1000)
+	}
+
+	// take from end so we can address the start of the buffer
+	out := (*buffer)[len(*buffer)-len(compressed):]
+	copy(out, compressed)
+	*buffer = (*buffer)[:len(*buffer)-len(compressed)]
+
+	return out
+}
+
+func (rq *RotationalQuantizer) PersistCompression(logger CommitLogger) {
+	logger.AddRQCompression(RQData{
+		Dimensions: uint16(rq.dimensions),
+		Bits:       uint8(rq.bits),
+		Seed:       rq.seed,
+	})
+}
+
+type RQStats struct {
+	Bits int `json:"bits"`
+}
+
+func (s RQStats) CompressionType() string {
+	return "rq"
+}
+
+func (rq *RotationalQuantizer) Stats() CompressionStats {
+	return RQStats{
+		Bits: rq.bits,
+	}
+}
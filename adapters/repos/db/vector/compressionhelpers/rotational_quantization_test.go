@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	testinghelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func Test_RQInvalidSettings(t *testing.T) {
+	_, err := compressionhelpers.NewRotationalQuantizer(128, 3, distancer.NewL2SquaredProvider())
+	assert.ErrorContains(t, err, "invalid bits 3")
+
+	_, err = compressionhelpers.NewRotationalQuantizer(128, 8, distancer.NewManhattanProvider())
+	assert.ErrorContains(t, err, "distance manhattan is not supported")
+}
+
+func Test_RQRestoreProducesSameCodes(t *testing.T) {
+	data, _ := testinghelpers.RandomVecs(10, 0, 100)
+	rq, err := compressionhelpers.NewRotationalQuantizer(100, 4, distancer.NewL2SquaredProvider())
+	require.Nil(t, err)
+
+	var restored *compressionhelpers.RotationalQuantizer
+	rq.PersistCompression(&rqRecorder{fn: func(data compressionhelpers.RQData) {
+		assert.Equal(t, uint16(100), data.Dimensions)
+		assert.Equal(t, uint8(4), data.Bits)
+		restored, err = compressionhelpers.RestoreRotationalQuantizer(
+			int(data.Dimensions), int(data.Bits), data.Seed, distancer.NewL2SquaredProvider())
+		require.Nil(t, err)
+	}})
+	require.NotNil(t, restored)
+
+	for _, vec := range data {
+		assert.Equal(t, rq.Encode(vec), restored.Encode(vec))
+	}
+}
+
+func Test_RQDistanceEstimates(t *testing.T) {
+	distancers := []distancer.Provider{
+		distancer.NewL2SquaredProvider(),
+		distancer.NewCosineDistanceProvider(),
+		distancer.NewDotProductProvider(),
+	}
+	dims := 300
+	data, queries := testinghelpers.RandomVecs(200, 10, dims)
+	testinghelpers.Normalize(data)
+	testinghelpers.Normalize(queries)
+
+	for _, bits := range []int{1, 2, 4, 8} {
+		for _, dp := range distancers {
+			t.Run(fmt.Sprintf("%d bits %s", bits, dp.Type()), func(t *testing.T) {
+				rq, err := compressionhelpers.NewRotationalQuantizer(dims, bits, dp)
+				require.Nil(t, err)
+
+				codes := make([][]byte, len(data))
+				for i := range data {
+					codes[i] = rq.Encode(data[i])
+				}
+
+				var withinBound, total int
+				var maxErr float64
+				for _, query := range queries {
+					d := rq.NewDistancer(query)
+					for i, code := range codes {
+						expected, _ := dp.SingleDist(query, data[i])
+						dist, bound, err := d.DistanceWithBound(code)
+						require.Nil(t, err)
+
+						diff := math.Abs(float64(dist - expected))
+						maxErr = math.Max(maxErr, diff)
+						if diff <= float64(bound) {
+							withinBound++
+						}
+						total++
+					}
+				}
+				// the bounds hold with high probability
+				assert.Greater(t, float64(withinBound)/float64(total), 0.99)
+				// more bits give tighter estimates
+				assert.Less(t, maxErr, 0.5/math.Sqrt(float64(bits)))
+
+				compressed, err := rq.DistanceBetweenCompressedVectors(codes[0], codes[1])
+				require.Nil(t, err)
+				expected, _ := dp.SingleDist(data[0], data[1])
+				assert.InDelta(t, expected, compressed, 0.5/math.Sqrt(float64(bits)))
+			})
+		}
+	}
+}
+
+func Test_RQRecall(t *testing.T) {
+	dp := distancer.NewCosineDistanceProvider()
+	k := 10
+	dims := 256
+	data, queries := testinghelpers.RandomVecs(1000, 20, dims)
+	testinghelpers.Normalize(data)
+	testinghelpers.Normalize(queries)
+
+	for _, tc := range []struct {
+		bits      int
+		rescore   int
+		minRecall float32
+	}{
+		{bits: 1, rescore: 100, minRecall: 0.8},
+		{bits: 8, rescore: 10, minRecall: 0.9},
+	} {
+		t.Run(fmt.Sprintf("%d bits", tc.bits), func(t *testing.T) {
+			rq, err := compressionhelpers.NewRotationalQuantizer(dims, tc.bits, dp)
+			require.Nil(t, err)
+			codes := make([][]byte, len(data))
+			for i := range data {
+				codes[i] = rq.Encode(data[i])
+			}
+
+			var relevant uint64
+			for _, query := range queries {
+				truth, _ := testinghelpers.BruteForce(logrus.New(), data, query, k, distancerWrapper(dp))
+
+				heap := priorityqueue.NewMax[any](tc.rescore)
+				d := rq.NewDistancer(query)
+				for i, code := range codes {
+					dist, err := d.Distance(code)
+					require.Nil(t, err)
+					if heap.Len() < tc.rescore || heap.Top().Dist > dist {
+						if heap.Len() == tc.rescore {
+							heap.Pop()
+						}
+						heap.Insert(uint64(i), dist)
+					}
+				}
+
+				rescored := priorityqueue.NewMax[any](k)
+				for heap.Len() > 0 {
+					id := heap.Pop().ID
+					dist, _ := dp.SingleDist(query, data[id])
+					if rescored.Len() < k || rescored.Top().Dist > dist {
+						if rescored.Len() == k {
+							rescored.Pop()
+						}
+						rescored.Insert(id, dist)
+					}
+				}
+				results := make([]uint64, 0, k)
+				for rescored.Len() > 0 {
+					results = append(results, rescored.Pop().ID)
+				}
+				relevant += matchesInLists(truth, results)
+			}
+			recall := float32(relevant) / float32(k*len(queries))
+			assert.GreaterOrEqual(t, recall, tc.minRecall)
+		})
+	}
+}
+
+type rqRecorder struct {
+	fn func(compressionhelpers.RQData)
+}
+
+func (r *rqRecorder) AddPQCompression(compressionhelpers.PQData) error { return nil }
+
+func (r *rqRecorder) AddSQCompression(compressionhelpers.SQData) error { return nil }
+
+func (r *rqRecorder) AddRQCompression(data compressionhelpers.RQData) error {
+	r.fn(data)
+	return nil
+}
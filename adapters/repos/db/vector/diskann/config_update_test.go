@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/schema/config"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+)
+
+func TestDiskANNUserConfigUpdates(t *testing.T) {
+	type test struct {
+		name          string
+		initial       config.VectorIndexConfig
+		update        config.VectorIndexConfig
+		expectedError error
+	}
+
+	tests := []test{
+		{
+			name:    "attempting to change maxDegree",
+			initial: ent.UserConfig{MaxDegree: 64},
+			update:  ent.UserConfig{MaxDegree: 32},
+			expectedError: errors.Errorf(
+				"maxDegree is immutable: " +
+					"attempted change from \"64\" to \"32\""),
+		},
+		{
+			name:    "attempting to change alpha",
+			initial: ent.UserConfig{Alpha: 1.2},
+			update:  ent.UserConfig{Alpha: 1.5},
+			expectedError: errors.Errorf(
+				"alpha is immutable: " +
+					"attempted change from \"1.2\" to \"1.5\""),
+		},
+		{
+			name:    "attempting to change distance",
+			initial: ent.UserConfig{Distance: "cosine"},
+			update:  ent.UserConfig{Distance: "l2-squared"},
+			expectedError: errors.Errorf(
+				"distance is immutable: " +
+					"attempted change from \"cosine\" to \"l2-squared\""),
+		},
+		{
+			name:          "changing search parameters",
+			initial:       ent.UserConfig{SearchListSize: 100, BeamWidth: 4, FlatSearchCutoff: 10},
+			update:        ent.UserConfig{SearchListSize: 200, BeamWidth: 8, FlatSearchCutoff: 20},
+			expectedError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateUserConfigUpdate(test.initial, test.update)
+			if test.expectedError == nil {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err, "update validation must error")
+				assert.Equal(t, test.expectedError.Error(), err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/binary"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+// Delete marks the nodes as deleted. They are skipped by searches right away,
+// but only removed from the graph by the next tombstone cleanup, which
+// repairs the edges pointing to them.
+func (index *diskann) Delete(ids ...uint64) error {
+	bucket := index.store.Bucket(index.getTombstoneBucketName())
+	for _, id := range ids {
+		if !index.hasCode(id) {
+			continue
+		}
+
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, id)
+		if err := bucket.Put(key, []byte{1}); err != nil {
+			return errors.Wrapf(err, "add tombstone for node %d", id)
+		}
+
+		index.tombstoneLock.Lock()
+		index.tombstones[id] = struct{}{}
+		index.tombstoneLock.Unlock()
+	}
+	return nil
+}
+
+func (index *diskann) hasTombstone(id uint64) bool {
+	index.tombstoneLock.RLock()
+	defer index.tombstoneLock.RUnlock()
+	_, ok := index.tombstones[id]
+	return ok
+}
+
+func (index *diskann) removeTombstone(id uint64) error {
+	index.tombstoneLock.Lock()
+	defer index.tombstoneLock.Unlock()
+
+	if _, ok := index.tombstones[id]; !ok {
+		return nil
+	}
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	if err := index.store.Bucket(index.getTombstoneBucketName()).Delete(key); err != nil {
+		return errors.Wrapf(err, "remove tombstone of node %d", id)
+	}
+	delete(index.tombstones, id)
+	return nil
+}
+
+func (index *diskann) loadTombstones() error {
+	cursor := index.store.Bucket(index.getTombstoneBucketName()).Cursor()
+	defer cursor.Close()
+
+	index.tombstoneLock.Lock()
+	defer index.tombstoneLock.Unlock()
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		index.tombstones[binary.BigEndian.Uint64(key)] = struct{}{}
+	}
+	return nil
+}
+
+func (index *diskann) tombstoneCleanup(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	executed, err := index.cleanUpTombstones(shouldAbort)
+	if err != nil {
+		index.logger.WithField("action", "diskann_tombstone_cleanup").
+			WithError(err).Error("tombstone cleanup errored")
+	}
+	return executed
+}
+
+// cleanUpTombstones removes deleted nodes from the graph. Every node with an
+// edge to a deleted node gets the neighbors of the deleted node as additional
+// candidates and is pruned again, so that the graph stays navigable. If the
+// cleanup is aborted, the deleted nodes stay in the graph and are picked up
+// by the next cycle.
+func (index *diskann) cleanUpTombstones(shouldAbort cyclemanager.ShouldAbortCallback) (bool, error) {
+	index.graphLock.Lock()
+	defer index.graphLock.Unlock()
+
+	index.tombstoneLock.RLock()
+	deleted := make(map[uint64]struct{}, len(index.tombstones))
+	for id := range index.tombstones {
+		deleted[id] = struct{}{}
+	}
+	index.tombstoneLock.RUnlock()
+
+	if len(deleted) == 0 {
+		return false, nil
+	}
+
+	before := time.Now()
+	deletedNeighbors := make(map[uint64][]uint64, len(deleted))
+	for id := range deleted {
+		n, err := index.readNode(id)
+		if err != nil {
+			return true, err
+		}
+		if n == nil {
+			continue
+		}
+		for _, neighbor := range n.neighbors {
+			if _, ok := deleted[neighbor]; !ok {
+				deletedNeighbors[id] = append(deletedNeighbors[id], neighbor)
+			}
+		}
+	}
+
+	affected, aborted, err := index.findAffectedNodes(deleted, shouldAbort)
+	if err != nil || aborted {
+		return true, err
+	}
+
+	for _, id := range affected {
+		if shouldAbort() {
+			return true, nil
+		}
+		if err := index.repairNode(id, deleted, deletedNeighbors); err != nil {
+			return true, err
+		}
+	}
+
+	if err := index.reassignEntryPoint(deleted, deletedNeighbors); err != nil {
+		return true, err
+	}
+
+	for id := range deleted {
+		if err := index.deleteNode(id); err != nil {
+			return true, errors.Wrapf(err, "delete node %d", id)
+		}
+		if err := index.deleteCode(id); err != nil {
+			return true, errors.Wrapf(err, "delete compressed vector %d", id)
+		}
+		if err := index.removeTombstone(id); err != nil {
+			return true, err
+		}
+		atomic.AddUint64(&index.count, ^uint64(0))
+	}
+
+	index.logger.WithFields(logrus.Fields{
+		"action":   "diskann_tombstone_cleanup",
+		"deleted":  len(deleted),
+		"repaired": len(affected),
+		"took":     time.Since(before),
+		"index_id": index.id,
+	}).Debug("removed deleted nodes from graph")
+
+	return true, nil
+}
+
+// findAffectedNodes scans the graph for nodes with edges to deleted nodes.
+func (index *diskann) findAffectedNodes(deleted map[uint64]struct{},
+	shouldAbort cyclemanager.ShouldAbortCallback,
+) ([]uint64, bool, error) {
+	cursor := index.store.Bucket(index.getGraphBucketName()).Cursor()
+	defer cursor.Close()
+
+	var affected []uint64
+	i := 0
+	for key, v := cursor.First(); key != nil; key, v = cursor.Next() {
+		if i%1000 == 0 && shouldAbort() {
+			return nil, true, nil
+		}
+		i++
+
+		id := binary.BigEndian.Uint64(key)
+		if _, ok := deleted[id]; ok {
+			continue
+		}
+		neighbors, err := decodeNeighbors(v)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "decode node %d", id)
+		}
+		for _, neighbor := range neighbors {
+			if _, ok := deleted[neighbor]; ok {
+				affected = append(affected, id)
+				break
+			}
+		}
+	}
+	return affected, false, nil
+}
+
+func (index *diskann) repairNode(id uint64, deleted map[uint64]struct{},
+	deletedNeighbors map[uint64][]uint64,
+) error {
+	n, err := index.readNode(id)
+	if err != nil || n == nil {
+		return err
+	}
+
+	candidateIDs := make(map[uint64]struct{}, len(n.neighbors))
+	for _, neighbor := range n.neighbors {
+		if _, ok := deleted[neighbor]; !ok {
+			candidateIDs[neighbor] = struct{}{}
+			continue
+		}
+		for _, next := range deletedNeighbors[neighbor] {
+			candidateIDs[next] = struct{}{}
+		}
+	}
+	delete(candidateIDs, id)
+
+	ids := make([]uint64, 0, len(candidateIDs))
+	for candidate := range candidateIDs {
+		ids = append(ids, candidate)
+	}
+	nodes, err := index.readNodes(ids)
+	if err != nil {
+		return err
+	}
+
+	candidates := make([]scoredNode, 0, len(nodes))
+	for _, candidate := range nodes {
+		if candidate == nil {
+			continue
+		}
+		dist, err := index.distancerProvider.SingleDist(n.vector, candidate.vector)
+		if err != nil {
+			return errors.Wrapf(err, "repair node %d", id)
+		}
+		candidates = append(candidates, scoredNode{node: candidate, dist: dist})
+	}
+
+	neighbors, err := index.robustPrune(candidates)
+	if err != nil {
+		return err
+	}
+	return index.writeNode(id, neighbors, n.vector)
+}
+
+// reassignEntryPoint moves the entry point to a remaining node if it is about
+// to be removed.
+func (index *diskann) reassignEntryPoint(deleted map[uint64]struct{},
+	deletedNeighbors map[uint64][]uint64,
+) error {
+	index.entryPointLock.Lock()
+	defer index.entryPointLock.Unlock()
+
+	if _, ok := deleted[index.entryPoint]; !index.hasEntryPoint || !ok {
+		return nil
+	}
+
+	if neighbors := deletedNeighbors[index.entryPoint]; len(neighbors) > 0 {
+		return index.updateEntryPoint(neighbors[0], true)
+	}
+
+	cursor := index.store.Bucket(index.getGraphBucketName()).Cursor()
+	defer cursor.Close()
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		id := binary.BigEndian.Uint64(key)
+		if _, ok := deleted[id]; !ok {
+			return index.updateEntryPoint(id, true)
+		}
+	}
+
+	// the graph is empty
+	return index.updateEntryPoint(0, false)
+}
+
+func (index *diskann) updateEntryPoint(id uint64, ok bool) error {
+	if err := index.setEntryPoint(id, ok); err != nil {
+		return err
+	}
+	index.entryPoint, index.hasEntryPoint = id, ok
+	return nil
+}
@@ -0,0 +1,365 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	entlsmkv "github.com/weaviate/weaviate/entities/lsmkv"
+)
+
+// graphSlackFactor allows nodes to temporarily exceed their maximum degree
+// when receiving reverse edges, so that the comparably expensive pruning
+// (which reads all neighbors from disk) only happens every now and then.
+const graphSlackFactor = 1.3
+
+// node is a single vertex of the graph as it is stored on disk. The neighbors
+// and the full vector are kept in the same record, so that expanding a node
+// during a search only needs a single read.
+type node struct {
+	id        uint64
+	neighbors []uint64
+	vector    []float32
+}
+
+// scoredNode is a node together with its distance to the node or query it
+// was found for.
+type scoredNode struct {
+	*node
+	dist float32
+}
+
+// encodeNode creates the on-disk record of a node:
+// [uint16 degree][degree * uint64 neighbors][dims * float32 vector]
+func encodeNode(neighbors []uint64, vector []float32) []byte {
+	out := make([]byte, 2+len(neighbors)*8+len(vector)*4)
+	binary.LittleEndian.PutUint16(out, uint16(len(neighbors)))
+	pos := 2
+	for _, id := range neighbors {
+		binary.LittleEndian.PutUint64(out[pos:], id)
+		pos += 8
+	}
+	for _, v := range vector {
+		binary.LittleEndian.PutUint32(out[pos:], math.Float32bits(v))
+		pos += 4
+	}
+	return out
+}
+
+func decodeNeighbors(data []byte) ([]uint64, error) {
+	if len(data) < 2 {
+		return nil, fmt.Errorf("node record of length %d is too short", len(data))
+	}
+	degree := int(binary.LittleEndian.Uint16(data))
+	if len(data) < 2+degree*8 {
+		return nil, fmt.Errorf("node record of length %d cannot hold %d neighbors",
+			len(data), degree)
+	}
+	neighbors := make([]uint64, degree)
+	for i := range neighbors {
+		neighbors[i] = binary.LittleEndian.Uint64(data[2+i*8:])
+	}
+	return neighbors, nil
+}
+
+func decodeNode(id uint64, data []byte) (*node, error) {
+	neighbors, err := decodeNeighbors(data)
+	if err != nil {
+		return nil, err
+	}
+	vecBytes := data[2+len(neighbors)*8:]
+	if len(vecBytes)%4 != 0 {
+		return nil, fmt.Errorf("node record has a vector of %d bytes", len(vecBytes))
+	}
+	vector := make([]float32, len(vecBytes)/4)
+	for i := range vector {
+		vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(vecBytes[i*4:]))
+	}
+	return &node{id: id, neighbors: neighbors, vector: vector}, nil
+}
+
+// readNode returns nil if the node does not exist (anymore).
+func (index *diskann) readNode(id uint64) (*node, error) {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	data, err := index.store.Bucket(index.getGraphBucketName()).Get(key)
+	if err != nil {
+		if errors.Is(err, entlsmkv.NotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "read node %d", id)
+	}
+	if data == nil {
+		return nil, nil
+	}
+	return decodeNode(id, data)
+}
+
+// readNodes reads the given nodes concurrently, missing nodes are nil.
+func (index *diskann) readNodes(ids []uint64) ([]*node, error) {
+	nodes := make([]*node, len(ids))
+	if len(ids) == 1 {
+		n, err := index.readNode(ids[0])
+		nodes[0] = n
+		return nodes, err
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(index.logger)
+	for i := range ids {
+		i := i
+		eg.Go(func() error {
+			n, err := index.readNode(ids[i])
+			nodes[i] = n
+			return err
+		})
+	}
+	return nodes, eg.Wait()
+}
+
+func (index *diskann) writeNode(id uint64, neighbors []uint64, vector []float32) error {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	if err := index.store.Bucket(index.getGraphBucketName()).Put(key,
+		encodeNode(neighbors, vector)); err != nil {
+		return errors.Wrapf(err, "write node %d", id)
+	}
+	return nil
+}
+
+func (index *diskann) deleteNode(id uint64) error {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return index.store.Bucket(index.getGraphBucketName()).Delete(key)
+}
+
+// candidate is an entry of the search list, ordered by compressed distance.
+type candidate struct {
+	id       uint64
+	dist     float32
+	expanded bool
+}
+
+// searchList is the bounded and sorted candidate list of the greedy search.
+type searchList struct {
+	items []candidate
+	size  int
+}
+
+func newSearchList(size int) *searchList {
+	return &searchList{items: make([]candidate, 0, size), size: size}
+}
+
+func (l *searchList) insert(id uint64, dist float32) {
+	if len(l.items) == l.size && dist >= l.items[len(l.items)-1].dist {
+		return
+	}
+	pos := sort.Search(len(l.items), func(i int) bool {
+		return l.items[i].dist > dist
+	})
+	if len(l.items) < l.size {
+		l.items = append(l.items, candidate{})
+	}
+	// shifts the tail, the last element falls off if the list is full
+	copy(l.items[pos+1:], l.items[pos:])
+	l.items[pos] = candidate{id: id, dist: dist}
+}
+
+// next marks up to n of the closest unexpanded candidates as expanded and
+// appends their ids to buf.
+func (l *searchList) next(n int, buf []uint64) []uint64 {
+	for i := range l.items {
+		if len(buf) == n {
+			break
+		}
+		if !l.items[i].expanded {
+			l.items[i].expanded = true
+			buf = append(buf, l.items[i].id)
+		}
+	}
+	return buf
+}
+
+// greedySearch runs a beam search starting at the entry point. Candidates are
+// ordered by the distance of their compressed vectors held in memory, whereas
+// expanding a candidate reads its neighbors and full vector from disk. visit
+// is called with every expanded node and its exact distance to the query.
+func (index *diskann) greedySearch(ctx context.Context, query []float32, listSize, beamWidth int,
+	visit func(n *node, dist float32),
+) error {
+	entryPoint, ok := index.getEntryPoint()
+	if !ok {
+		return nil
+	}
+
+	queryCode := index.bq.Encode(query)
+	distancer := index.distancerProvider.New(query)
+	list := newSearchList(listSize)
+	visited := map[uint64]struct{}{entryPoint: {}}
+
+	dist, ok, err := index.compressedDistance(ctx, queryCode, entryPoint)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		// the entry point was removed concurrently, it is expanded regardless
+		dist = 0
+	}
+	list.insert(entryPoint, dist)
+
+	ids := make([]uint64, 0, beamWidth)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		ids = list.next(beamWidth, ids[:0])
+		if len(ids) == 0 {
+			return nil
+		}
+
+		nodes, err := index.readNodes(ids)
+		if err != nil {
+			return err
+		}
+
+		for _, n := range nodes {
+			if n == nil {
+				continue
+			}
+
+			dist, err := distancer.Distance(n.vector)
+			if err != nil {
+				return errors.Wrapf(err, "distance to node %d", n.id)
+			}
+			visit(n, dist)
+
+			for _, neighbor := range n.neighbors {
+				if _, seen := visited[neighbor]; seen {
+					continue
+				}
+				visited[neighbor] = struct{}{}
+
+				dist, ok, err := index.compressedDistance(ctx, queryCode, neighbor)
+				if err != nil {
+					return err
+				}
+				if ok {
+					list.insert(neighbor, dist)
+				}
+			}
+		}
+	}
+}
+
+// robustPrune selects at most maxDegree neighbors out of the candidates,
+// which hold their distance to the node being pruned. A candidate is skipped
+// if an already selected neighbor is considerably closer to it than the node
+// itself, where alpha controls how many long-range edges are kept.
+func (index *diskann) robustPrune(candidates []scoredNode) ([]uint64, error) {
+	sort.Slice(candidates, func(a, b int) bool {
+		return candidates[a].dist < candidates[b].dist
+	})
+
+	selected := make([]uint64, 0, index.maxDegree)
+	pruned := make([]bool, len(candidates))
+	for i := range candidates {
+		if pruned[i] {
+			continue
+		}
+		if i > 0 && candidates[i].id == candidates[i-1].id {
+			continue
+		}
+
+		selected = append(selected, candidates[i].id)
+		if len(selected) == index.maxDegree {
+			break
+		}
+
+		for j := i + 1; j < len(candidates); j++ {
+			if pruned[j] {
+				continue
+			}
+			dist, err := index.distancerProvider.SingleDist(candidates[i].vector, candidates[j].vector)
+			if err != nil {
+				return nil, errors.Wrap(err, "prune")
+			}
+			if occluded(index.alpha, dist, candidates[j].dist) {
+				pruned[j] = true
+			}
+		}
+	}
+
+	return selected, nil
+}
+
+// occluded reports whether a candidate is pruned because it is much closer to
+// a selected neighbor than to the node being pruned. Dot product distances
+// can be negative, where scaling by alpha would have the opposite effect.
+func occluded(alpha, distToSelected, distToNode float32) bool {
+	if distToSelected >= 0 {
+		return alpha*distToSelected <= distToNode
+	}
+	return distToSelected/alpha <= distToNode
+}
+
+// addReverseEdge adds an edge from an existing neighbor to a new node. Nodes
+// exceeding their degree (including slack) are pruned back to maxDegree.
+func (index *diskann) addReverseEdge(id uint64, added *node) error {
+	index.nodeLocks.Lock(id)
+	defer index.nodeLocks.Unlock(id)
+
+	n, err := index.readNode(id)
+	if err != nil || n == nil {
+		return err
+	}
+	for _, neighbor := range n.neighbors {
+		if neighbor == added.id {
+			return nil
+		}
+	}
+
+	if len(n.neighbors) < index.maxSlackDegree() {
+		return index.writeNode(id, append(n.neighbors, added.id), n.vector)
+	}
+
+	neighbors, err := index.readNodes(n.neighbors)
+	if err != nil {
+		return err
+	}
+	candidates := make([]scoredNode, 0, len(neighbors)+1)
+	for _, neighbor := range append(neighbors, added) {
+		if neighbor == nil {
+			continue
+		}
+		dist, err := index.distancerProvider.SingleDist(n.vector, neighbor.vector)
+		if err != nil {
+			return errors.Wrap(err, "add reverse edge")
+		}
+		candidates = append(candidates, scoredNode{node: neighbor, dist: dist})
+	}
+
+	pruned, err := index.robustPrune(candidates)
+	if err != nil {
+		return err
+	}
+	return index.writeNode(id, pruned, n.vector)
+}
+
+func (index *diskann) maxSlackDegree() int {
+	return int(math.Ceil(float64(index.maxDegree) * graphSlackFactor))
+}
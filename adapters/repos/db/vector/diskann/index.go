@@ -0,0 +1,585 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package diskann contains a disk-resident vector index based on the Vamana
+// graph of DiskANN. The graph and the full vectors are stored on disk in the
+// LSM store of the shard, while only binary quantized vectors are kept in
+// memory to decide which nodes to read next during a search.
+package diskann
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	entlsmkv "github.com/weaviate/weaviate/entities/lsmkv"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	graphBucketPrefix      = "diskann_graph"
+	compressedBucketPrefix = "diskann_compressed"
+	tombstoneBucketPrefix  = "diskann_tombstones"
+	defaultCachePageSize   = 32
+)
+
+type diskann struct {
+	id                string
+	targetVector      string
+	rootPath          string
+	className         string
+	shardName         string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	store             *lsmkv.Store
+
+	metadata     *bolt.DB
+	metadataLock *sync.RWMutex
+
+	dims                int32
+	trackDimensionsOnce sync.Once
+
+	entryPointLock sync.RWMutex
+	entryPoint     uint64
+	hasEntryPoint  bool
+
+	// immutable graph parameters
+	maxDegree     int
+	buildListSize int
+	alpha         float32
+
+	// mutable search parameters, read atomically
+	searchListSize   int64
+	beamWidth        int64
+	flatSearchCutoff int64
+
+	bq    compressionhelpers.BinaryQuantizer
+	codes cache.Cache[uint64]
+
+	nodeLocks *common.ShardedRWLocks
+	// graphLock is held for reading by all modifications of the graph and
+	// exclusively by the tombstone cleanup, which rewrites the graph
+	graphLock sync.RWMutex
+
+	tombstoneLock sync.RWMutex
+	tombstones    map[uint64]struct{}
+
+	count                        uint64
+	tombstoneCleanupCallbackCtrl cyclemanager.CycleCallbackCtrl
+}
+
+func New(cfg Config, uc ent.UserConfig, tombstoneCallbacks cyclemanager.CycleCallbackGroup,
+	store *lsmkv.Store,
+) (*diskann, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &diskann{
+		id:                cfg.ID,
+		targetVector:      cfg.TargetVector,
+		rootPath:          cfg.RootPath,
+		className:         cfg.ClassName,
+		shardName:         cfg.ShardName,
+		logger:            logger,
+		distancerProvider: cfg.DistanceProvider,
+		store:             store,
+		metadataLock:      &sync.RWMutex{},
+		maxDegree:         uc.MaxDegree,
+		buildListSize:     uc.BuildListSize,
+		alpha:             float32(uc.Alpha),
+		searchListSize:    int64(uc.SearchListSize),
+		beamWidth:         int64(uc.BeamWidth),
+		flatSearchCutoff:  int64(uc.FlatSearchCutoff),
+		bq:                compressionhelpers.NewBinaryQuantizer(nil),
+		nodeLocks:         common.NewDefaultShardedRWLocks(),
+		tombstones:        map[uint64]struct{}{},
+	}
+
+	if err := index.initBuckets(context.Background()); err != nil {
+		return nil, fmt.Errorf("init diskann index buckets: %w", err)
+	}
+
+	index.codes = cache.NewShardedUInt64LockCache(index.getCode, uc.VectorCacheMaxObjects,
+		defaultCachePageSize, logger, 0, cfg.AllocChecker)
+
+	if err := index.initMetadata(); err != nil {
+		return nil, err
+	}
+
+	if err := index.loadTombstones(); err != nil {
+		return nil, err
+	}
+
+	id := strings.Join([]string{
+		"diskann", "tombstone_cleanup",
+		index.className, index.shardName, index.id,
+	}, "/")
+	index.tombstoneCleanupCallbackCtrl = tombstoneCallbacks.Register(id, index.tombstoneCleanup)
+
+	return index, nil
+}
+
+func (index *diskann) bucketName(prefix string) string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", prefix, index.targetVector)
+	}
+	return prefix
+}
+
+func (index *diskann) getGraphBucketName() string {
+	return index.bucketName(graphBucketPrefix)
+}
+
+func (index *diskann) getCompressedBucketName() string {
+	return index.bucketName(compressedBucketPrefix)
+}
+
+func (index *diskann) getTombstoneBucketName() string {
+	return index.bucketName(tombstoneBucketPrefix)
+}
+
+func (index *diskann) initBuckets(ctx context.Context) error {
+	if err := index.store.CreateOrLoadBucket(ctx, index.getGraphBucketName(),
+		lsmkv.WithUseBloomFilter(false),
+		lsmkv.WithCalcCountNetAdditions(false),
+	); err != nil {
+		return fmt.Errorf("create or load diskann graph bucket: %w", err)
+	}
+	if err := index.store.CreateOrLoadBucket(ctx, index.getCompressedBucketName(),
+		lsmkv.WithUseBloomFilter(false),
+		lsmkv.WithCalcCountNetAdditions(false),
+		lsmkv.WithPread(false),
+	); err != nil {
+		return fmt.Errorf("create or load diskann compressed vectors bucket: %w", err)
+	}
+	if err := index.store.CreateOrLoadBucket(ctx, index.getTombstoneBucketName()); err != nil {
+		return fmt.Errorf("create or load diskann tombstones bucket: %w", err)
+	}
+	return nil
+}
+
+func (index *diskann) getCode(ctx context.Context, id uint64) ([]uint64, error) {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	data, err := index.store.Bucket(index.getCompressedBucketName()).Get(key)
+	if err != nil && !errors.Is(err, entlsmkv.NotFound) {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return index.bq.FromCompressedBytes(data), nil
+}
+
+func (index *diskann) storeCode(id uint64, code []uint64) error {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	if err := index.store.Bucket(index.getCompressedBucketName()).Put(key,
+		index.bq.CompressedBytes(code)); err != nil {
+		return errors.Wrapf(err, "store compressed vector %d", id)
+	}
+	index.codes.Grow(id)
+	index.codes.Preload(id, code)
+	return nil
+}
+
+func (index *diskann) deleteCode(id uint64) error {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	index.codes.Delete(context.Background(), id)
+	return index.store.Bucket(index.getCompressedBucketName()).Delete(key)
+}
+
+// getCachedCode reads through the cache, which only covers ids it has been
+// grown to, e.g. not before PostStartup loaded all compressed vectors.
+func (index *diskann) getCachedCode(ctx context.Context, id uint64) ([]uint64, error) {
+	if id >= uint64(index.codes.Len()) {
+		return index.getCode(ctx, id)
+	}
+	return index.codes.Get(ctx, id)
+}
+
+func (index *diskann) hasCode(id uint64) bool {
+	code, err := index.getCachedCode(context.Background(), id)
+	return err == nil && len(code) > 0
+}
+
+// compressedDistance returns false if the node has no compressed vector,
+// i.e. it does not exist (anymore).
+func (index *diskann) compressedDistance(ctx context.Context, queryCode []uint64,
+	id uint64,
+) (float32, bool, error) {
+	code, err := index.getCachedCode(ctx, id)
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "get compressed vector %d", id)
+	}
+	if len(code) == 0 {
+		return 0, false, nil
+	}
+	dist, err := index.bq.DistanceBetweenCompressedVectors(code, queryCode)
+	if err != nil {
+		return 0, false, err
+	}
+	return dist, true, nil
+}
+
+func (index *diskann) getEntryPoint() (uint64, bool) {
+	index.entryPointLock.RLock()
+	defer index.entryPointLock.RUnlock()
+	return index.entryPoint, index.hasEntryPoint
+}
+
+func (index *diskann) normalized(vector []float32) []float32 {
+	if index.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+func (index *diskann) trackDimensions(vector []float32) error {
+	index.trackDimensionsOnce.Do(func() {
+		size := int32(len(vector))
+		atomic.StoreInt32(&index.dims, size)
+		if err := index.setDimensions(size); err != nil {
+			index.logger.WithError(err).Error("could not set dimensions")
+		}
+	})
+	if dims := atomic.LoadInt32(&index.dims); len(vector) != int(dims) {
+		return errors.Errorf("insert called with a vector of the wrong size: "+
+			"expected %d dimensions, got %d", dims, len(vector))
+	}
+	return nil
+}
+
+func (index *diskann) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(ids) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+	if len(ids) == 0 {
+		return errors.Errorf("insertBatch called with empty lists")
+	}
+	for i := range ids {
+		if err := index.Add(ctx, ids[i], vectors[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (index *diskann) Add(ctx context.Context, id uint64, vector []float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := index.trackDimensions(vector); err != nil {
+		return err
+	}
+	vector = index.normalized(vector)
+
+	index.graphLock.RLock()
+	defer index.graphLock.RUnlock()
+
+	// re-adding a deleted node replaces it
+	if err := index.removeTombstone(id); err != nil {
+		return err
+	}
+	if !index.hasCode(id) {
+		defer atomic.AddUint64(&index.count, 1)
+	}
+	if err := index.storeCode(id, index.bq.Encode(vector)); err != nil {
+		return err
+	}
+
+	if added, err := index.addInitialNode(id, vector); added || err != nil {
+		return err
+	}
+
+	var candidates []scoredNode
+	if err := index.greedySearch(ctx, vector, index.buildListSize, int(atomic.LoadInt64(&index.beamWidth)),
+		func(n *node, dist float32) {
+			if n.id != id {
+				candidates = append(candidates, scoredNode{node: n, dist: dist})
+			}
+		}); err != nil {
+		return errors.Wrapf(err, "search neighbors of node %d", id)
+	}
+
+	neighbors, err := index.robustPrune(candidates)
+	if err != nil {
+		return err
+	}
+
+	index.nodeLocks.Lock(id)
+	err = index.writeNode(id, neighbors, vector)
+	index.nodeLocks.Unlock(id)
+	if err != nil {
+		return err
+	}
+
+	added := &node{id: id, vector: vector}
+	for _, neighbor := range neighbors {
+		if err := index.addReverseEdge(neighbor, added); err != nil {
+			return errors.Wrapf(err, "add reverse edge to node %d", id)
+		}
+	}
+	return nil
+}
+
+// addInitialNode makes the very first node the entry point of the graph.
+func (index *diskann) addInitialNode(id uint64, vector []float32) (bool, error) {
+	index.entryPointLock.Lock()
+	defer index.entryPointLock.Unlock()
+
+	if index.hasEntryPoint {
+		return false, nil
+	}
+	if err := index.writeNode(id, nil, vector); err != nil {
+		return false, err
+	}
+	if err := index.setEntryPoint(id, true); err != nil {
+		return false, err
+	}
+	index.entryPoint, index.hasEntryPoint = id, true
+	return true, nil
+}
+
+func (index *diskann) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	return errors.Errorf("AddMulti is not supported for diskann index")
+}
+
+func (index *diskann) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	return errors.Errorf("AddMultiBatch is not supported for diskann index")
+}
+
+func (index *diskann) DeleteMulti(ids ...uint64) error {
+	return errors.Errorf("DeleteMulti is not supported for diskann index")
+}
+
+func (index *diskann) Compressed() bool {
+	// only compressed vectors are kept in memory
+	return true
+}
+
+func (index *diskann) Multivector() bool {
+	return false
+}
+
+func (index *diskann) Drop(ctx context.Context) error {
+	if err := index.tombstoneCleanupCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "diskann drop")
+	}
+	index.codes.Drop()
+	if err := index.removeMetadataFile(); err != nil {
+		return err
+	}
+	// Shard::drop will take care of handling store's buckets
+	return nil
+}
+
+func (index *diskann) Flush() error {
+	// nothing to do here
+	// Shard will take care of handling store's buckets
+	return nil
+}
+
+func (index *diskann) Shutdown(ctx context.Context) error {
+	if err := index.tombstoneCleanupCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "diskann shutdown")
+	}
+	index.codes.Drop()
+	// Shard::shutdown will take care of handling store's buckets
+	return nil
+}
+
+func (index *diskann) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+// ListFiles returns the metadata file of the index. The graph, compressed
+// vectors and tombstones live in buckets of the shard's store, which are
+// part of backups anyway.
+func (index *diskann) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	var files []string
+
+	fullPath := filepath.Join(index.rootPath, index.getMetadataFile())
+	if _, err := os.Stat(fullPath); err == nil {
+		relPath, err := filepath.Rel(basePath, fullPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get relative path: %w", err)
+		}
+		// If the file doesn't exist, we simply don't add it to the list
+		files = append(files, relPath)
+	}
+
+	return files, nil
+}
+
+func (index *diskann) GetKeys(id uint64) (uint64, uint64, error) {
+	return 0, 0, errors.Errorf("GetKeys is not supported for diskann index")
+}
+
+func (index *diskann) ValidateBeforeInsert(vector []float32) error {
+	dims := int(atomic.LoadInt32(&index.dims))
+	if dims != 0 && dims != len(vector) {
+		return errors.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+	return nil
+}
+
+func (index *diskann) ValidateMultiBeforeInsert(vector [][]float32) error {
+	return errors.Errorf("multi vectors are not supported for diskann index")
+}
+
+// PostStartup loads all compressed vectors into memory.
+func (index *diskann) PostStartup() {
+	before := time.Now()
+	bucket := index.store.Bucket(index.getCompressedBucketName())
+	// we expect to be IO-bound, so more goroutines than CPUs is fine
+	it := compressionhelpers.NewParallelIterator[uint64](bucket, 2*runtime.GOMAXPROCS(0),
+		binary.BigEndian.Uint64, index.bq.FromCompressedBytesWithSubsliceBuffer, index.logger)
+	channel := it.IterateAll()
+	if channel == nil {
+		return // nothing to do
+	}
+
+	vecs := make([]compressionhelpers.VecAndID[uint64], 0, 10_000)
+	for v := range channel {
+		vecs = append(vecs, v...)
+	}
+
+	maxID := uint64(0)
+	for i := range vecs {
+		if vecs[i].Id > maxID {
+			maxID = vecs[i].Id
+		}
+	}
+
+	// Grow cache just once
+	index.codes.LockAll()
+	index.codes.SetSizeAndGrowNoLock(maxID)
+	for _, vec := range vecs {
+		index.codes.PreloadNoLock(vec.Id, vec.Vec)
+	}
+	index.codes.UnlockAll()
+	atomic.StoreUint64(&index.count, uint64(len(vecs)))
+
+	took := time.Since(before)
+	index.logger.WithFields(logrus.Fields{
+		"action":   "preload_diskann_compressed_vectors",
+		"count":    len(vecs),
+		"took":     took,
+		"index_id": index.id,
+	}).Debugf("pre-loaded %d vectors in %s", len(vecs), took)
+}
+
+func (index *diskann) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	return index.distancerProvider.SingleDist(x, y)
+}
+
+func (index *diskann) ContainsDoc(id uint64) bool {
+	return !index.hasTombstone(id) && index.hasCode(id)
+}
+
+func (index *diskann) Iterate(fn func(docID uint64) bool) {
+	cursor := index.store.Bucket(index.getCompressedBucketName()).Cursor()
+	defer cursor.Close()
+
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		id := binary.BigEndian.Uint64(key)
+		if index.hasTombstone(id) {
+			continue
+		}
+		if !fn(id) {
+			break
+		}
+	}
+}
+
+func (index *diskann) DistancerProvider() distancer.Provider {
+	return index.distancerProvider
+}
+
+func (index *diskann) AlreadyIndexed() uint64 {
+	return atomic.LoadUint64(&index.count)
+}
+
+func (index *diskann) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	queryVector = index.normalized(queryVector)
+	distancer := index.distancerProvider.New(queryVector)
+	distFunc := func(id uint64) (float32, error) {
+		n, err := index.readNode(id)
+		if err != nil {
+			return 0, err
+		}
+		if n == nil {
+			return 0, fmt.Errorf("node %d is not in the vector index", id)
+		}
+		return distancer.Distance(n.vector)
+	}
+	return common.QueryVectorDistancer{DistanceFunc: distFunc}
+}
+
+func (index *diskann) QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVectorDistancer {
+	return common.QueryVectorDistancer{}
+}
+
+func (index *diskann) Stats() (common.IndexStats, error) {
+	index.tombstoneLock.RLock()
+	tombstones := len(index.tombstones)
+	index.tombstoneLock.RUnlock()
+
+	return &DiskANNStats{
+		Dimensions: int(atomic.LoadInt32(&index.dims)),
+		Nodes:      index.codes.CountVectors(),
+		Tombstones: tombstones,
+	}, nil
+}
+
+type DiskANNStats struct {
+	Dimensions int   `json:"dimensions"`
+	Nodes      int64 `json:"nodes"`
+	Tombstones int   `json:"tombstones"`
+}
+
+func (s *DiskANNStats) IndexType() common.IndexType {
+	return common.IndexTypeDiskANN
+}
@@ -0,0 +1,216 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+)
+
+// clusteredVectors resembles embeddings more closely than uniformly random
+// vectors, which are a worst case for the binary quantization guiding the
+// search.
+func clusteredVectors(r *rand.Rand, centers [][]float32, size int) [][]float32 {
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		center := centers[r.Intn(len(centers))]
+		vectors[i] = make([]float32, len(center))
+		for j := range vectors[i] {
+			vectors[i][j] = center[j] + float32(r.NormFloat64())*0.3
+		}
+	}
+	return vectors
+}
+
+func randomCenters(r *rand.Rand, size, dims int) [][]float32 {
+	centers := make([][]float32, size)
+	for i := range centers {
+		centers[i] = make([]float32, dims)
+		for j := range centers[i] {
+			centers[i][j] = r.Float32()*2 - 1
+		}
+	}
+	return centers
+}
+
+func newTestStore(t *testing.T, dir string) *lsmkv.Store {
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dir, dir, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	return store
+}
+
+func newTestIndex(t *testing.T, dir string, store *lsmkv.Store) *diskann {
+	logger, _ := test.NewNullLogger()
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxDegree = 16
+	uc.BuildListSize = 32
+	uc.SearchListSize = 100
+	uc.FlatSearchCutoff = 50
+
+	index, err := New(Config{
+		ID:               "diskann-test",
+		RootPath:         dir,
+		ClassName:        "Class",
+		ShardName:        "shard",
+		Logger:           logger,
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+	}, uc, cyclemanager.NewCallbackGroupNoop(), store)
+	require.Nil(t, err)
+	return index
+}
+
+func recall(t *testing.T, index *diskann, vectors, queries [][]float32, k int,
+	allow helpers.AllowList,
+) float32 {
+	var hits int
+	for _, query := range queries {
+		results, _, err := index.SearchByVector(context.Background(), query, k, allow)
+		require.Nil(t, err)
+
+		expected := bruteForce(vectors, query, k, allow)
+		hits += int(testinghelpers.MatchesInLists(expected, results))
+	}
+	return float32(hits) / float32(k*len(queries))
+}
+
+// bruteForce considers nil vectors as deleted.
+func bruteForce(vectors [][]float32, query []float32, k int, allow helpers.AllowList) []uint64 {
+	heap := make([]struct {
+		id   uint64
+		dist float32
+	}, 0, len(vectors))
+	for i, vec := range vectors {
+		if vec == nil || (allow != nil && !allow.Contains(uint64(i))) {
+			continue
+		}
+		dist, _ := distancer.NewL2SquaredProvider().SingleDist(query, vec)
+		heap = append(heap, struct {
+			id   uint64
+			dist float32
+		}{uint64(i), dist})
+	}
+	for i := 0; i < k && i < len(heap); i++ {
+		for j := i + 1; j < len(heap); j++ {
+			if heap[j].dist < heap[i].dist {
+				heap[i], heap[j] = heap[j], heap[i]
+			}
+		}
+	}
+	ids := make([]uint64, 0, k)
+	for i := 0; i < k && i < len(heap); i++ {
+		ids = append(ids, heap[i].id)
+	}
+	return ids
+}
+
+func TestDiskANN(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(42))
+	centers := randomCenters(r, 20, 128)
+	vectors := clusteredVectors(r, centers, 1000)
+	queries := clusteredVectors(r, centers, 20)
+	k := 10
+
+	dir := t.TempDir()
+	store := newTestStore(t, dir)
+	index := newTestIndex(t, dir, store)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(i), vec))
+	}
+
+	t.Run("recall", func(t *testing.T) {
+		assert.Greater(t, recall(t, index, vectors, queries, k, nil), float32(0.9))
+		assert.Equal(t, uint64(len(vectors)), index.AlreadyIndexed())
+	})
+
+	t.Run("exact distances", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector(ctx, vectors[5], 1, nil)
+		require.Nil(t, err)
+		require.Len(t, ids, 1)
+		assert.Equal(t, uint64(5), ids[0])
+		assert.Equal(t, float32(0), dists[0])
+
+		queryDistancer := index.QueryVectorDistancer(vectors[5])
+		dist, err := queryDistancer.DistanceToNode(7)
+		require.Nil(t, err)
+		expected, _ := distancer.NewL2SquaredProvider().SingleDist(vectors[5], vectors[7])
+		assert.Equal(t, expected, dist)
+	})
+
+	t.Run("filtered search", func(t *testing.T) {
+		// below the flat search cutoff
+		small := helpers.NewAllowList(1, 3, 5, 7, 9, 11)
+		assert.Equal(t, float32(1), recall(t, index, vectors, queries, 3, small))
+
+		var ids []uint64
+		for i := 0; i < len(vectors); i += 2 {
+			ids = append(ids, uint64(i))
+		}
+		large := helpers.NewAllowList(ids...)
+		assert.Greater(t, recall(t, index, vectors, queries, k, large), float32(0.8))
+	})
+
+	t.Run("delete and clean up tombstones", func(t *testing.T) {
+		for i := 0; i < len(vectors); i += 3 {
+			require.Nil(t, index.Delete(uint64(i)))
+			assert.False(t, index.ContainsDoc(uint64(i)))
+			vectors[i] = nil
+		}
+		assert.Greater(t, recall(t, index, vectors, queries, k, nil), float32(0.85))
+
+		executed, err := index.cleanUpTombstones(func() bool { return false })
+		require.Nil(t, err)
+		assert.True(t, executed)
+		assert.Empty(t, index.tombstones)
+		assert.Greater(t, recall(t, index, vectors, queries, k, nil), float32(0.85))
+		assert.Equal(t, uint64(len(vectors)-334), index.AlreadyIndexed())
+
+		n, err := index.readNode(0)
+		require.Nil(t, err)
+		assert.Nil(t, n)
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(ctx))
+		require.Nil(t, store.Shutdown(ctx))
+
+		store = newTestStore(t, dir)
+		index = newTestIndex(t, dir, store)
+		index.PostStartup()
+
+		assert.Equal(t, uint64(len(vectors)-334), index.AlreadyIndexed())
+		assert.Greater(t, recall(t, index, vectors, queries, k, nil), float32(0.85))
+
+		files, err := index.ListFiles(ctx, dir)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"diskann_meta.db"}, files)
+	})
+
+	require.Nil(t, index.Shutdown(ctx))
+	require.Nil(t, store.Shutdown(ctx))
+}
@@ -0,0 +1,169 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	metadataPrefix       = "diskann_meta"
+	vectorMetadataBucket = "vector"
+	dimensionsKey        = "dimensions"
+	entryPointKey        = "entrypoint"
+)
+
+func (index *diskann) getMetadataFile() string {
+	if index.targetVector != "" {
+		// This may be redundant as target vector is already validated in the schema
+		cleanTarget := filepath.Clean(index.targetVector)
+		cleanTarget = filepath.Base(cleanTarget)
+		return fmt.Sprintf("%s_%s.db", metadataPrefix, cleanTarget)
+	}
+	return fmt.Sprintf("%s.db", metadataPrefix)
+}
+
+func (index *diskann) removeMetadataFile() error {
+	path := filepath.Join(index.rootPath, index.getMetadataFile())
+	index.closeMetadata()
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "remove metadata file %q", path)
+	}
+	return nil
+}
+
+func (index *diskann) closeMetadata() {
+	index.metadataLock.Lock()
+	defer index.metadataLock.Unlock()
+
+	if index.metadata != nil {
+		index.metadata.Close()
+		index.metadata = nil
+	}
+}
+
+func (index *diskann) openMetadata() error {
+	index.metadataLock.Lock()
+	defer index.metadataLock.Unlock()
+
+	if index.metadata != nil {
+		return nil // Already open
+	}
+
+	path := filepath.Join(index.rootPath, index.getMetadataFile())
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open %q", path)
+	}
+
+	index.metadata = db
+	return nil
+}
+
+// initMetadata restores the dimensions and the entry point of the graph.
+func (index *diskann) initMetadata() error {
+	err := index.openMetadata()
+	if err != nil {
+		return err
+	}
+	defer index.closeMetadata()
+
+	var dims, entryPoint []byte
+	err = index.metadata.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(vectorMetadataBucket))
+		if err != nil {
+			return errors.Wrap(err, "create bucket")
+		}
+		if b == nil {
+			return errors.New("failed to create or get bucket")
+		}
+		dims = copyBytes(b.Get([]byte(dimensionsKey)))
+		entryPoint = copyBytes(b.Get([]byte(entryPointKey)))
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "init metadata bucket")
+	}
+
+	if len(dims) == 4 {
+		index.trackDimensionsOnce.Do(func() {
+			atomic.StoreInt32(&index.dims, int32(binary.LittleEndian.Uint32(dims)))
+		})
+	}
+	if len(entryPoint) == 8 {
+		index.entryPoint = binary.LittleEndian.Uint64(entryPoint)
+		index.hasEntryPoint = true
+	}
+
+	return nil
+}
+
+// copyBytes copies values read from bolt, which are only valid during the
+// transaction.
+func copyBytes(in []byte) []byte {
+	if in == nil {
+		return nil
+	}
+	out := make([]byte, len(in))
+	copy(out, in)
+	return out
+}
+
+func (index *diskann) setDimensions(dimensions int32) error {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(dimensions))
+	if err := index.putMetadata(dimensionsKey, buf); err != nil {
+		return errors.Wrap(err, "set dimensions")
+	}
+	return nil
+}
+
+// setEntryPoint persists the entry point of the graph, ok is false if the
+// graph became empty.
+func (index *diskann) setEntryPoint(id uint64, ok bool) error {
+	var buf []byte
+	if ok {
+		buf = make([]byte, 8)
+		binary.LittleEndian.PutUint64(buf, id)
+	}
+	if err := index.putMetadata(entryPointKey, buf); err != nil {
+		return errors.Wrap(err, "set entry point")
+	}
+	return nil
+}
+
+func (index *diskann) putMetadata(key string, value []byte) error {
+	err := index.openMetadata()
+	if err != nil {
+		return err
+	}
+	defer index.closeMetadata()
+
+	return index.metadata.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(vectorMetadataBucket))
+		if b == nil {
+			return errors.New("failed to get bucket")
+		}
+		if value == nil {
+			return b.Delete([]byte(key))
+		}
+		return b.Put([]byte(key), value)
+	})
+}
@@ -0,0 +1,220 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+// flatSearchBatchSize is the number of nodes read concurrently from disk when
+// searching a small allow list exhaustively.
+const flatSearchBatchSize = 64
+
+func (index *diskann) SearchByVector(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	vector = index.normalized(vector)
+	if allow != nil && allow.Len() < int(atomic.LoadInt64(&index.flatSearchCutoff)) {
+		helpers.AnnotateSlowQueryLog(ctx, "diskann_flat_search", true)
+		return index.flatSearch(ctx, vector, k, allow)
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "diskann_flat_search", false)
+
+	listSize := int(atomic.LoadInt64(&index.searchListSize))
+	if listSize < k {
+		listSize = k
+	}
+
+	heap := priorityqueue.NewMax[any](k)
+	err := index.greedySearch(ctx, vector, listSize, int(atomic.LoadInt64(&index.beamWidth)),
+		func(n *node, dist float32) {
+			if index.hasTombstone(n.id) || (allow != nil && !allow.Contains(n.id)) {
+				return
+			}
+			insertToHeap(heap, k, n.id, dist)
+		})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "greedy search")
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
+
+// flatSearch computes the exact distance to every allowed node. It is used if
+// the allow list is small, as the graph search would have to visit a large
+// part of the graph to find enough allowed nodes.
+func (index *diskann) flatSearch(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	heap := priorityqueue.NewMax[any](k)
+	distancer := index.distancerProvider.New(vector)
+
+	batch := make([]uint64, 0, flatSearchBatchSize)
+	flush := func() error {
+		nodes, err := index.readNodes(batch)
+		if err != nil {
+			return err
+		}
+		for _, n := range nodes {
+			if n == nil || index.hasTombstone(n.id) {
+				continue
+			}
+			dist, err := distancer.Distance(n.vector)
+			if err != nil {
+				return errors.Wrapf(err, "distance to node %d", n.id)
+			}
+			insertToHeap(heap, k, n.id, dist)
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	it := allow.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		batch = append(batch, id)
+		if len(batch) == flatSearchBatchSize {
+			if err := flush(); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+	if len(batch) > 0 {
+		if err := flush(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
+
+func insertToHeap(heap *priorityqueue.Queue[any], limit int, id uint64, distance float32) {
+	if heap.Len() < limit {
+		heap.Insert(id, distance)
+	} else if heap.Top().Dist > distance {
+		heap.Pop()
+		heap.Insert(id, distance)
+	}
+}
+
+func extractHeap(heap *priorityqueue.Queue[any]) ([]uint64, []float32) {
+	len := heap.Len()
+
+	ids := make([]uint64, len)
+	dists := make([]float32, len)
+	for i := len - 1; i >= 0; i-- {
+		item := heap.Pop()
+		ids[i] = item.ID
+		dists[i] = item.Dist
+	}
+	return ids, dists
+}
+
+func (index *diskann) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByMultiVector is not supported for diskann index")
+}
+
+func (index *diskann) SearchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	var (
+		searchParams = newSearchByDistParams(maxLimit)
+
+		resultIDs  []uint64
+		resultDist []float32
+	)
+
+	recursiveSearch := func() (bool, error) {
+		totalLimit := searchParams.TotalLimit()
+		ids, dist, err := index.SearchByVector(ctx, vector, totalLimit, allow)
+		if err != nil {
+			return false, errors.Wrap(err, "vector search")
+		}
+
+		// if there is less results than given limit search can be stopped
+		shouldContinue := !(len(ids) < totalLimit)
+
+		// ensures the indexes aren't out of range
+		offsetCap := searchParams.OffsetCapacity(ids)
+		totalLimitCap := searchParams.TotalLimitCapacity(ids)
+
+		if offsetCap == totalLimitCap {
+			return false, nil
+		}
+
+		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
+		for i := range ids {
+			if aboveThresh := dist[i] <= targetDistance; aboveThresh ||
+				floatcomp.InDelta(float64(dist[i]), float64(targetDistance), 1e-6) {
+				resultIDs = append(resultIDs, ids[i])
+				resultDist = append(resultDist, dist[i])
+			} else {
+				// as soon as we encounter a certainty which
+				// is below threshold, we can stop searching
+				shouldContinue = false
+				break
+			}
+		}
+
+		return shouldContinue, nil
+	}
+
+	shouldContinue, err := recursiveSearch()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for shouldContinue {
+		searchParams.Iterate()
+		if searchParams.MaxLimitReached() {
+			index.logger.
+				WithField("action", "unlimited_vector_search").
+				Warnf("maximum search limit of %d results has been reached",
+					searchParams.MaximumSearchLimit())
+			break
+		}
+
+		shouldContinue, err = recursiveSearch()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return resultIDs, resultDist, nil
+}
+
+func (index *diskann) SearchByMultiVectorDistance(ctx context.Context, vector [][]float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByMultiVectorDistance is not supported for diskann index")
+}
+
+func newSearchByDistParams(maxLimit int64) *common.SearchByDistParams {
+	initialOffset := 0
+	initialLimit := common.DefaultSearchByDistInitialLimit
+
+	return common.NewSearchByDistParams(initialOffset, initialLimit, initialOffset+initialLimit, maxLimit)
+}
@@ -18,7 +18,9 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ivf"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	schemaconfig "github.com/weaviate/weaviate/entities/schema/config"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
@@ -40,6 +42,7 @@ type Config struct {
 	MakeCommitLoggerThunk hnsw.MakeCommitLogger
 	TombstoneCallbacks    cyclemanager.CycleCallbackGroup
 	SharedDB              *bolt.DB
+	Encryption            encryption.KeyProvider
 }
 
 func (c Config) Validate() error {
@@ -72,6 +75,10 @@ func ValidateUserConfigUpdate(initial, updated schemaconfig.VectorIndexConfig) e
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "upgradeTo",
+			accessor: func(c ent.UserConfig) interface{} { return c.UpgradeTo },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -85,6 +92,9 @@ func ValidateUserConfigUpdate(initial, updated schemaconfig.VectorIndexConfig) e
 	if err := hnsw.ValidateUserConfigUpdate(initialParsed.HnswUC, updatedParsed.HnswUC); err != nil {
 		return err
 	}
+	if err := ivf.ValidateUserConfigUpdate(initialParsed.IvfUC, updatedParsed.IvfUC); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -33,12 +33,15 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ivf"
 	entcfg "github.com/weaviate/weaviate/entities/config"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/encryption"
 	werrors "github.com/weaviate/weaviate/entities/errors"
 	schemaconfig "github.com/weaviate/weaviate/entities/schema/config"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	ivfent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 )
 
@@ -109,11 +112,14 @@ type dynamic struct {
 	tempVectorForIDThunk  common.TempVectorForID[float32]
 	distanceProvider      distancer.Provider
 	makeCommitLoggerThunk hnsw.MakeCommitLogger
+	encryption            encryption.KeyProvider
 	threshold             uint64
 	index                 VectorIndex
 	upgraded              atomic.Bool
 	tombstoneCallbacks    cyclemanager.CycleCallbackGroup
 	hnswUC                hnswent.UserConfig
+	ivfUC                 ivfent.UserConfig
+	upgradeTo             string
 	db                    *bbolt.DB
 }
 
@@ -152,10 +158,13 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 		tempVectorForIDThunk:  cfg.TempVectorForIDThunk,
 		distanceProvider:      cfg.DistanceProvider,
 		makeCommitLoggerThunk: cfg.MakeCommitLoggerThunk,
+		encryption:            cfg.Encryption,
 		store:                 store,
 		threshold:             uc.Threshold,
 		tombstoneCallbacks:    cfg.TombstoneCallbacks,
 		hnswUC:                uc.HnswUC,
+		ivfUC:                 uc.IvfUC,
+		upgradeTo:             uc.UpgradeTo,
 		db:                    cfg.SharedDB,
 	}
 
@@ -186,27 +195,11 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 
 	if upgraded {
 		index.upgraded.Store(true)
-		hnsw, err := hnsw.New(
-			hnsw.Config{
-				Logger:                index.logger,
-				RootPath:              index.rootPath,
-				ID:                    index.id,
-				ShardName:             index.shardName,
-				ClassName:             index.className,
-				PrometheusMetrics:     index.prometheusMetrics,
-				VectorForIDThunk:      index.vectorForIDThunk,
-				TempVectorForIDThunk:  index.tempVectorForIDThunk,
-				DistanceProvider:      index.distanceProvider,
-				MakeCommitLoggerThunk: index.makeCommitLoggerThunk,
-			},
-			index.hnswUC,
-			index.tombstoneCallbacks,
-			index.store,
-		)
+		upgradedIndex, err := index.newUpgradedIndex()
 		if err != nil {
 			return nil, err
 		}
-		index.index = hnsw
+		index.index = upgradedIndex
 	} else {
 		flat, err := flat.New(flatConfig, uc.FlatUC, store)
 		if err != nil {
@@ -321,9 +314,14 @@ func (dynamic *dynamic) UpdateUserConfig(updated schemaconfig.VectorIndexConfig,
 	if dynamic.upgraded.Load() {
 		dynamic.RLock()
 		defer dynamic.RUnlock()
-		dynamic.index.UpdateUserConfig(parsed.HnswUC, callback)
+		if dynamic.upgradeTo == ent.UpgradeToIVF {
+			dynamic.index.UpdateUserConfig(parsed.IvfUC, callback)
+		} else {
+			dynamic.index.UpdateUserConfig(parsed.HnswUC, callback)
+		}
 	} else {
 		dynamic.hnswUC = parsed.HnswUC
+		dynamic.ivfUC = parsed.IvfUC
 		dynamic.RLock()
 		defer dynamic.RUnlock()
 		dynamic.index.UpdateUserConfig(parsed.FlatUC, callback)
@@ -457,28 +455,19 @@ func (dynamic *dynamic) Upgrade(callback func()) error {
 		return dynamic.index.(upgradableIndexer).Upgrade(callback)
 	}
 
-	index, err := hnsw.New(
-		hnsw.Config{
-			Logger:                dynamic.logger,
-			RootPath:              dynamic.rootPath,
-			ID:                    dynamic.id,
-			ShardName:             dynamic.shardName,
-			ClassName:             dynamic.className,
-			PrometheusMetrics:     dynamic.prometheusMetrics,
-			VectorForIDThunk:      dynamic.vectorForIDThunk,
-			TempVectorForIDThunk:  dynamic.tempVectorForIDThunk,
-			DistanceProvider:      dynamic.distanceProvider,
-			MakeCommitLoggerThunk: dynamic.makeCommitLoggerThunk,
-		},
-		dynamic.hnswUC,
-		dynamic.tombstoneCallbacks,
-		dynamic.store,
-	)
+	index, err := dynamic.newUpgradedIndex()
 	if err != nil {
 		callback()
 		return err
 	}
 
+	if dynamic.upgradeTo == ent.UpgradeToIVF {
+		// the ivf index shares the vectors bucket with the flat index, so the
+		// vectors only need to be counted and trained on instead of copied
+		index.PostStartup()
+		return dynamic.markUpgraded(index, callback)
+	}
+
 	bucket := dynamic.store.Bucket(dynamic.getBucketName())
 
 	g := werrors.NewErrorGroupWrapper(dynamic.logger)
@@ -527,7 +516,11 @@ func (dynamic *dynamic) Upgrade(callback func()) error {
 		return errors.Wrap(err, "upgrade")
 	}
 
-	err = dynamic.db.Update(func(tx *bbolt.Tx) error {
+	return dynamic.markUpgraded(index, callback)
+}
+
+func (dynamic *dynamic) markUpgraded(index VectorIndex, callback func()) error {
+	err := dynamic.db.Update(func(tx *bbolt.Tx) error {
 		b := tx.Bucket(dynamicBucket)
 		return b.Put(dynamic.dbKey(), []byte{1})
 	})
@@ -541,6 +534,45 @@ func (dynamic *dynamic) Upgrade(callback func()) error {
 	return nil
 }
 
+// newUpgradedIndex creates the index the dynamic index is upgraded to
+// according to the upgradeTo setting.
+func (dynamic *dynamic) newUpgradedIndex() (VectorIndex, error) {
+	if dynamic.upgradeTo == ent.UpgradeToIVF {
+		return ivf.New(
+			ivf.Config{
+				ID:               dynamic.id,
+				TargetVector:     dynamic.targetVector,
+				RootPath:         dynamic.rootPath,
+				ClassName:        dynamic.className,
+				ShardName:        dynamic.shardName,
+				Logger:           dynamic.logger,
+				DistanceProvider: dynamic.distanceProvider,
+			},
+			dynamic.ivfUC,
+			dynamic.store,
+		)
+	}
+
+	return hnsw.New(
+		hnsw.Config{
+			Logger:                dynamic.logger,
+			RootPath:              dynamic.rootPath,
+			ID:                    dynamic.id,
+			ShardName:             dynamic.shardName,
+			ClassName:             dynamic.className,
+			PrometheusMetrics:     dynamic.prometheusMetrics,
+			VectorForIDThunk:      dynamic.vectorForIDThunk,
+			TempVectorForIDThunk:  dynamic.tempVectorForIDThunk,
+			DistanceProvider:      dynamic.distanceProvider,
+			MakeCommitLoggerThunk: dynamic.makeCommitLoggerThunk,
+			Encryption:            dynamic.encryption,
+		},
+		dynamic.hnswUC,
+		dynamic.tombstoneCallbacks,
+		dynamic.store,
+	)
+}
+
 func (dynamic *dynamic) Iterate(fn func(id uint64) bool) {
 	dynamic.index.Iterate(fn)
 }
@@ -33,6 +33,7 @@ import (
 	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	ivfent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
 	"go.etcd.io/bbolt"
 )
 
@@ -119,6 +120,90 @@ func TestDynamic(t *testing.T) {
 	assert.True(t, latency1 > latency2)
 }
 
+func TestDynamicUpgradeToIVF(t *testing.T) {
+	ctx := context.Background()
+	currentIndexing := os.Getenv("ASYNC_INDEXING")
+	os.Setenv("ASYNC_INDEXING", "true")
+	defer os.Setenv("ASYNC_INDEXING", currentIndexing)
+	dimensions := 20
+	vectors_size := 2_000
+	queries_size := 10
+	k := 10
+
+	db, err := bbolt.Open(filepath.Join(t.TempDir(), "index.db"), 0o666, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		db.Close()
+	})
+
+	vectors, queries := testinghelpers.RandomVecs(vectors_size, queries_size, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+	truths := make([][]uint64, queries_size)
+	compressionhelpers.Concurrently(logger, uint64(len(queries)), func(i uint64) {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k, testinghelpers.DistanceWrapper(distancer))
+	})
+	fuc := flatent.UserConfig{}
+	fuc.SetDefaults()
+	ivfuc := ivfent.NewDefaultUserConfig()
+	ivfuc.NLists = 16
+	ivfuc.NProbe = 8
+	ivfuc.TrainingThreshold = 1_000
+	ivfuc.RescoreLimit = 200
+	ivfuc.PQ.Segments = 10
+
+	config := Config{
+		RootPath:              t.TempDir(),
+		ID:                    "ivf-upgrade-test",
+		MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+		DistanceProvider:      distancer,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+		TombstoneCallbacks:   cyclemanager.NewCallbackGroupNoop(),
+		SharedDB:             db,
+	}
+	uc := ent.UserConfig{
+		Threshold: uint64(vectors_size),
+		Distance:  distancer.Type(),
+		UpgradeTo: ent.UpgradeToIVF,
+		HnswUC:    hnswent.NewDefaultUserConfig(),
+		FlatUC:    fuc,
+		IvfUC:     ivfuc,
+	}
+	store := testinghelpers.NewDummyStore(t)
+	dynamic, err := New(config, uc, store)
+	require.NoError(t, err)
+
+	compressionhelpers.Concurrently(logger, uint64(vectors_size), func(i uint64) {
+		require.NoError(t, dynamic.Add(ctx, i, vectors[i]))
+	})
+	assert.False(t, dynamic.Upgraded())
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	require.NoError(t, dynamic.Upgrade(wg.Done))
+	wg.Wait()
+
+	// the ivf index trains on the vectors imported by the flat index
+	assert.True(t, dynamic.Upgraded())
+	assert.True(t, dynamic.Compressed())
+	shouldUpgrade, _ := dynamic.ShouldUpgrade()
+	assert.False(t, shouldUpgrade)
+	recall, _ := testinghelpers.RecallAndLatency(ctx, queries, k, dynamic, truths)
+	assert.Greater(t, recall, float32(0.7))
+
+	t.Run("the upgrade survives a restart", func(t *testing.T) {
+		restored, err := New(config, uc, store)
+		require.NoError(t, err)
+		restored.PostStartup()
+
+		assert.True(t, restored.Upgraded())
+		recall, _ := testinghelpers.RecallAndLatency(ctx, queries, k, restored, truths)
+		assert.Greater(t, recall, float32(0.7))
+	})
+}
+
 func TestDynamicReturnsErrorIfNoAsync(t *testing.T) {
 	currentIndexing := os.Getenv("ASYNC_INDEXING")
 	os.Unsetenv("ASYNC_INDEXING")
@@ -20,6 +20,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -47,6 +48,7 @@ const (
 	compressionBQ        = "bq"
 	compressionPQ        = "pq"
 	compressionSQ        = "sq"
+	compressionRQ        = "rq"
 	compressionNone      = "none"
 	defaultCachePageSize = 32
 )
@@ -64,6 +66,8 @@ type flat struct {
 	trackDimensionsOnce sync.Once
 	rescore             int64
 	bq                  compressionhelpers.BinaryQuantizer
+	rq                  *compressionhelpers.RotationalQuantizer
+	rqBits              int
 
 	pqResults *common.PqMaxPool
 	pool      *pools
@@ -98,6 +102,7 @@ func New(cfg Config, uc flatent.UserConfig, store *lsmkv.Store) (*flat, error) {
 		rescore:              extractCompressionRescore(uc),
 		pqResults:            common.NewPqMaxPool(100),
 		compression:          extractCompression(uc),
+		rqBits:               uc.RQ.Bits,
 		pool:                 newPools(),
 		store:                store,
 		concurrentCacheReads: runtime.GOMAXPROCS(0) * 2,
@@ -145,6 +150,10 @@ func extractCompression(uc flatent.UserConfig) string {
 		return compressionSQ
 	}
 
+	if uc.RQ.Enabled {
+		return compressionRQ
+	}
+
 	return compressionNone
 }
 
@@ -157,6 +166,8 @@ func extractCompressionRescore(uc flatent.UserConfig) int64 {
 		return int64(uc.BQ.RescoreLimit)
 	case compressionSQ:
 		return int64(uc.SQ.RescoreLimit)
+	case compressionRQ:
+		return int64(uc.RQ.RescoreLimit)
 	default:
 		return 0
 	}
@@ -180,6 +191,16 @@ func (index *flat) isBQ() bool {
 	return index.compression == compressionBQ
 }
 
+func (index *flat) isRQ() bool {
+	return index.compression == compressionRQ
+}
+
+// hasCompressedBucket is true for all compression methods which store their
+// encodings next to the uncompressed vectors.
+func (index *flat) hasCompressedBucket() bool {
+	return index.isBQ() || index.isRQ()
+}
+
 func (index *flat) isBQCached() bool {
 	return index.bqCache != nil
 }
@@ -229,7 +250,7 @@ func (index *flat) initBuckets(ctx context.Context) error {
 	); err != nil {
 		return fmt.Errorf("create or load flat vectors bucket: %w", err)
 	}
-	if index.isBQ() {
+	if index.hasCompressedBucket() {
 		if err := index.store.CreateOrLoadBucket(ctx, index.getCompressedBucketName(),
 			lsmkv.WithForceCompaction(forceCompaction),
 			lsmkv.WithUseBloomFilter(false),
@@ -321,6 +342,11 @@ func (index *flat) Add(ctx context.Context, id uint64, vector []float32) error {
 		if index.isBQ() {
 			index.bq = compressionhelpers.NewBinaryQuantizer(nil)
 		}
+		if index.isRQ() {
+			if err := index.initRQ(size); err != nil {
+				index.logger.WithError(err).Error("could not init rotational quantizer")
+			}
+		}
 	})
 	if len(vector) != int(index.dims) {
 		return errors.Errorf("insert called with a vector of the wrong size")
@@ -338,6 +364,12 @@ func (index *flat) Add(ctx context.Context, id uint64, vector []float32) error {
 		slice = make([]byte, len(vectorBQ)*8)
 		index.storeCompressedVector(id, byteSliceFromUint64Slice(vectorBQ, slice))
 	}
+	if index.isRQ() {
+		if index.rq == nil {
+			return errors.Errorf("rotational quantizer is not initialized")
+		}
+		index.storeCompressedVector(id, index.rq.Encode(vector))
+	}
 	newCount := atomic.LoadUint64(&index.count)
 	atomic.StoreUint64(&index.count, newCount+1)
 	return nil
@@ -363,7 +395,7 @@ func (index *flat) Delete(ids ...uint64) error {
 			return err
 		}
 
-		if index.isBQ() {
+		if index.hasCompressedBucket() {
 			if err := index.store.Bucket(index.getCompressedBucketName()).Delete(idBytes); err != nil {
 				return err
 			}
@@ -390,6 +422,8 @@ func (index *flat) SearchByVector(ctx context.Context, vector []float32, k int,
 	switch index.compression {
 	case compressionBQ:
 		return index.searchByVectorBQ(ctx, vector, k, allow)
+	case compressionRQ:
+		return index.searchByVectorRQ(ctx, vector, k, allow)
 	case compressionPQ:
 		// use uncompressed for now
 		fallthrough
@@ -452,7 +486,6 @@ func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int
 		}
 	}
 
-	distanceCalc := index.createDistanceCalc(vector)
 	idsSlice := index.pool.uint64SlicePool.Get(heap.Len())
 	defer index.pool.uint64SlicePool.Put(idsSlice)
 
@@ -460,15 +493,32 @@ func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int
 		idsSlice.slice[i] = heap.Pop().ID
 	}
 
+	distancesUncompressedVectors, err := index.rescoreCandidates(idsSlice.slice,
+		index.createDistanceCalc(vector))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i, id := range idsSlice.slice {
+		index.insertToHeap(heap, k, id, distancesUncompressedVectors[i])
+	}
+
+	ids, dists := index.extractHeap(heap)
+	return ids, dists, nil
+}
+
+// rescoreCandidates calculates the distances of the given candidates based on
+// their uncompressed vectors.
+func (index *flat) rescoreCandidates(ids []uint64, distanceCalc distanceCalc) ([]float32, error) {
 	// we expect to be mostly IO-bound, so more goroutines than CPUs is fine
-	distancesUncompressedVectors := make([]float32, len(idsSlice.slice))
+	distancesUncompressedVectors := make([]float32, len(ids))
 
 	eg := enterrors.NewErrorGroupWrapper(index.logger)
 	for workerID := 0; workerID < index.concurrentCacheReads; workerID++ {
 		workerID := workerID
 		eg.Go(func() error {
-			for idPos := workerID; idPos < len(idsSlice.slice); idPos += index.concurrentCacheReads {
-				id := idsSlice.slice[idPos]
+			for idPos := workerID; idPos < len(ids); idPos += index.concurrentCacheReads {
+				id := ids[idPos]
 				candidateAsBytes, err := index.vectorById(id)
 				if err != nil {
 					return err
@@ -489,15 +539,81 @@ func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int
 	}
 
 	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return distancesUncompressedVectors, nil
+}
+
+// searchByVectorRQ scans the rotationally quantized vectors to collect the
+// candidates with the smallest estimated distances. As every estimate comes
+// with a bound of its error, candidates which cannot make it into the top k
+// with high probability are skipped instead of being rescored.
+func (index *flat) searchByVectorRQ(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	if index.rq == nil {
+		// nothing has been imported yet
+		return nil, nil, nil
+	}
+
+	rescore := index.searchTimeRescore(k)
+	heap := index.pqResults.GetMax(rescore)
+	defer index.pqResults.Put(heap)
+
+	vector = index.normalized(vector)
+	distancer := index.rq.NewDistancer(vector)
+
+	if err := index.iterateVectors(allow, index.store.Bucket(index.getCompressedBucketName()).Cursor,
+		func(id uint64, vecAsBytes []byte) error {
+			distance, bound, err := distancer.DistanceWithBound(vecAsBytes)
+			if err != nil {
+				return err
+			}
+			if heap.Len() < rescore {
+				heap.InsertWithValue(id, distance, bound)
+			} else if heap.Top().Dist > distance {
+				heap.Pop()
+				heap.InsertWithValue(id, distance, bound)
+			}
+			return nil
+		},
+	); err != nil {
 		return nil, nil, err
 	}
 
-	for i, id := range idsSlice.slice {
+	candidates := make([]priorityqueue.Item[any], heap.Len())
+	upperBounds := make([]float32, heap.Len())
+	for i := len(candidates) - 1; i >= 0; i-- {
+		candidates[i] = heap.Pop()
+		upperBounds[i] = candidates[i].Dist + candidates[i].Value.(float32)
+	}
+
+	// the k-th smallest upper bound is an upper bound of the k-th result
+	threshold := float32(math.MaxFloat32)
+	if len(upperBounds) >= k {
+		sort.Slice(upperBounds, func(a, b int) bool { return upperBounds[a] < upperBounds[b] })
+		threshold = upperBounds[k-1]
+	}
+
+	idsSlice := index.pool.uint64SlicePool.Get(len(candidates))
+	defer index.pool.uint64SlicePool.Put(idsSlice)
+
+	ids := idsSlice.slice[:0]
+	for _, c := range candidates {
+		if c.Dist-c.Value.(float32) <= threshold {
+			ids = append(ids, c.ID)
+		}
+	}
+
+	distancesUncompressedVectors, err := index.rescoreCandidates(ids, index.createDistanceCalc(vector))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i, id := range ids {
 		index.insertToHeap(heap, k, id, distancesUncompressedVectors[i])
 	}
 
-	ids, dists := index.extractHeap(heap)
-	return ids, dists, nil
+	resultIDs, dists := index.extractHeap(heap)
+	return resultIDs, dists, nil
 }
 
 func (index *flat) createDistanceCalcBQ(vectorBQ []uint64) distanceCalc {
@@ -523,6 +639,21 @@ func (index *flat) vectorById(id uint64) ([]byte, error) {
 func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	allow helpers.AllowList, limit int, cursorFn func() *lsmkv.CursorReplace,
 	distanceCalc distanceCalc,
+) error {
+	return index.iterateVectors(allow, cursorFn, func(id uint64, v []byte) error {
+		distance, err := distanceCalc(v)
+		if err != nil {
+			return err
+		}
+		index.insertToHeap(heap, limit, id, distance)
+		return nil
+	})
+}
+
+// iterateVectors calls fn with all vectors of the cursor which are part of
+// the allow list
+func (index *flat) iterateVectors(allow helpers.AllowList,
+	cursorFn func() *lsmkv.CursorReplace, fn func(id uint64, v []byte) error,
 ) error {
 	var key []byte
 	var v []byte
@@ -553,11 +684,9 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	for ; key != nil && (allow == nil || id <= allowMax); key, v = cursor.Next() {
 		id = binary.BigEndian.Uint64(key)
 		if allow == nil || allow.Contains(id) {
-			distance, err := distanceCalc(v)
-			if err != nil {
+			if err := fn(id, v); err != nil {
 				return err
 			}
-			index.insertToHeap(heap, limit, id, distance)
 		}
 	}
 	return nil
@@ -864,7 +993,7 @@ func (index *flat) ContainsDoc(id uint64) bool {
 	// logic modeled after SearchByVector which indicates that the PQ bucket is
 	// the same as the uncompressed bucket "for now"
 	switch index.compression {
-	case compressionBQ:
+	case compressionBQ, compressionRQ:
 		bucketName = index.getCompressedBucketName()
 	case compressionPQ:
 		// use uncompressed for now
@@ -889,7 +1018,7 @@ func (index *flat) Iterate(fn func(docID uint64) bool) {
 	// logic modeled after SearchByVector which indicates that the PQ bucket is
 	// the same as the uncompressed bucket "for now"
 	switch index.compression {
-	case compressionBQ:
+	case compressionBQ, compressionRQ:
 		bucketName = index.getCompressedBucketName()
 	case compressionPQ:
 		// use uncompressed for now
@@ -967,6 +1096,14 @@ func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) e
 			name:     "bq",
 			accessor: func(c flatent.UserConfig) interface{} { return c.BQ.Enabled },
 		},
+		{
+			name:     "rq",
+			accessor: func(c flatent.UserConfig) interface{} { return c.RQ.Enabled },
+		},
+		{
+			name:     "rq.bits",
+			accessor: func(c flatent.UserConfig) interface{} { return c.RQ.Bits },
+		},
 		// as of v1.25.2, updating the BQ cache setting is now possible.
 		// Note that the change does not take effect until the tenant is
 		// reloaded, either from a complete restart or from
@@ -1016,6 +1153,21 @@ func (index *flat) QueryVectorDistancer(queryVector []float32) common.QueryVecto
 				return index.bq.DistanceBetweenCompressedVectors(vec, queryVecEncode)
 			}
 		}
+	case compressionRQ:
+		if index.rq == nil {
+			distFunc = defaultDistFunc
+		} else {
+			distancer := index.rq.NewDistancer(queryVector)
+			distFunc = func(nodeID uint64) (float32, error) {
+				idBytes := make([]byte, 8)
+				binary.BigEndian.PutUint64(idBytes, nodeID)
+				vec, err := index.store.Bucket(index.getCompressedBucketName()).Get(idBytes)
+				if err != nil {
+					return 0, err
+				}
+				return distancer.Distance(vec)
+			}
+		}
 	case compressionPQ:
 		// use uncompressed for now
 		fallthrough
@@ -72,6 +72,9 @@ func run(ctx context.Context, dirName string, logger *logrus.Logger, compression
 	bq := flatent.CompressionUserConfig{
 		Enabled: false,
 	}
+	rq := flatent.RQUserConfig{
+		Enabled: false,
+	}
 	switch compression {
 	case compressionPQ:
 		pq.Enabled = true
@@ -81,6 +84,10 @@ func run(ctx context.Context, dirName string, logger *logrus.Logger, compression
 		bq.Enabled = true
 		bq.RescoreLimit = 100 * k
 		bq.Cache = vectorCache
+	case compressionRQ:
+		rq.Enabled = true
+		rq.RescoreLimit = 10 * k
+		rq.Bits = 8
 	}
 	index, err := New(Config{
 		ID:               runId,
@@ -89,6 +96,7 @@ func run(ctx context.Context, dirName string, logger *logrus.Logger, compression
 	}, flatent.UserConfig{
 		PQ: pq,
 		BQ: bq,
+		RQ: rq,
 	}, store)
 	if err != nil {
 		return 0, 0, err
@@ -185,17 +193,20 @@ func Test_NoRaceFlatIndex(t *testing.T) {
 	}
 
 	extraVectorsForDelete, _ := testinghelpers.RandomVecs(5_000, 0, dimensions)
-	for _, compression := range []string{compressionNone, compressionBQ} {
+	for _, compression := range []string{compressionNone, compressionBQ, compressionRQ} {
 		t.Run("compression: "+compression, func(t *testing.T) {
 			for _, cache := range []bool{false, true} {
 				t.Run("cache: "+strconv.FormatBool(cache), func(t *testing.T) {
-					if compression == compressionNone && cache == true {
+					if (compression == compressionNone || compression == compressionRQ) && cache == true {
 						return
 					}
 					targetRecall := float32(0.99)
 					if compression == compressionBQ {
 						targetRecall = 0.8
 					}
+					if compression == compressionRQ {
+						targetRecall = 0.95
+					}
 					t.Run("recall", func(t *testing.T) {
 						recall, latency, err := run(ctx, dirName, logger, compression, cache, vectors, queries, k, truths, nil, nil, distancer, 0)
 						require.Nil(t, err)
@@ -217,10 +228,13 @@ func Test_NoRaceFlatIndex(t *testing.T) {
 			}
 		})
 	}
-	for _, compression := range []string{compressionNone, compressionBQ} {
+	for _, compression := range []string{compressionNone, compressionBQ, compressionRQ} {
 		t.Run("compression: "+compression, func(t *testing.T) {
 			for _, cache := range []bool{false, true} {
 				t.Run("cache: "+strconv.FormatBool(cache), func(t *testing.T) {
+					if compression == compressionRQ && cache == true {
+						return
+					}
 					from := 0
 					to := 3_000
 					for i := range queries {
@@ -235,6 +249,9 @@ func Test_NoRaceFlatIndex(t *testing.T) {
 					if compression == compressionBQ {
 						targetRecall = 0.8
 					}
+					if compression == compressionRQ {
+						targetRecall = 0.95
+					}
 
 					t.Run("recall on filtered", func(t *testing.T) {
 						recall, latency, err := run(ctx, dirName, logger, compression, cache, vectors, queries, k, truths, nil, allowIds, distancer, 0)
@@ -321,6 +338,56 @@ func TestFlat_QueryVectorDistancer(t *testing.T) {
 	}
 }
 
+func TestFlat_RQRestart(t *testing.T) {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	store, err := lsmkv.New(dirName, dirName, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer store.Shutdown(ctx)
+
+	vectors, queries := testinghelpers.RandomVecs(1000, 10, 64)
+	uc := flatent.NewDefaultUserConfig()
+	uc.Distance = "l2-squared"
+	uc.RQ = flatent.RQUserConfig{Enabled: true, Bits: 4, RescoreLimit: 20}
+	cfg := Config{
+		ID:               "rq-restart",
+		RootPath:         t.TempDir(),
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+	}
+
+	index, err := New(cfg, uc, store)
+	require.Nil(t, err)
+	for id, vec := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(id), vec))
+	}
+
+	before := make([][]uint64, len(queries))
+	for i, query := range queries {
+		before[i], _, err = index.SearchByVector(ctx, query, 10, nil)
+		require.Nil(t, err)
+		require.Len(t, before[i], 10)
+	}
+	require.Nil(t, index.Shutdown(ctx))
+
+	// the codes were created with the rotation of the first instance, so the
+	// restarted index must pick up the same seed
+	restarted, err := New(cfg, uc, store)
+	require.Nil(t, err)
+	defer restarted.Shutdown(ctx)
+	require.NotNil(t, restarted.rq)
+
+	for i, query := range queries {
+		after, _, err := restarted.SearchByVector(ctx, query, 10, nil)
+		require.Nil(t, err)
+		assert.Equal(t, before[i], after)
+	}
+}
+
 func TestConcurrentReads(t *testing.T) {
 	ctx := context.Background()
 	dirName := t.TempDir()
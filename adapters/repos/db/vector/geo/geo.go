@@ -21,7 +21,9 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/logarchive"
 	"github.com/weaviate/weaviate/entities/models"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
@@ -55,6 +57,8 @@ type Config struct {
 	DisablePersistence bool
 	RootPath           string
 	Logger             logrus.FieldLogger
+	Encryption         encryption.KeyProvider
+	LogArchiver        logarchive.Archiver
 }
 
 func NewIndex(config Config,
@@ -66,6 +70,7 @@ func NewIndex(config Config,
 		RootPath:              config.RootPath,
 		MakeCommitLoggerThunk: makeCommitLoggerFromConfig(config, commitLogMaintenanceCallbacks),
 		DistanceProvider:      distancer.NewGeoProvider(),
+		Encryption:            config.Encryption,
 	}, hnswent.UserConfig{
 		MaxConnections:         64,
 		EFConstruction:         128,
@@ -101,7 +106,9 @@ func makeCommitLoggerFromConfig(config Config, maintenanceCallbacks cyclemanager
 	makeCL := hnsw.MakeNoopCommitLogger
 	if !config.DisablePersistence {
 		makeCL = func() (hnsw.CommitLogger, error) {
-			return hnsw.NewCommitLogger(config.RootPath, config.ID, config.Logger, maintenanceCallbacks)
+			return hnsw.NewCommitLogger(config.RootPath, config.ID, config.Logger, maintenanceCallbacks,
+				hnsw.WithCommitlogEncryption(config.Encryption),
+				hnsw.WithCommitlogArchiver(config.LogArchiver))
 		}
 	}
 	return makeCL
@@ -13,7 +13,6 @@ package hnsw
 
 import (
 	"io"
-	"os"
 	"unicode/utf8"
 )
 
@@ -21,23 +20,23 @@ const (
 	defaultBufSize = 4096
 )
 
-// bufWriter implements buffering for an *os.File object.
+// bufWriter implements buffering for an io.Writer object.
 // If an error occurs writing to a bufWriter, no more data will be
 // accepted and all subsequent writes, and Flush, will return the error.
 // After all data has been written, the client should call the
 // Flush method to guarantee all data has been forwarded to
-// the underlying *os.File.
+// the underlying io.Writer.
 type bufWriter struct {
 	err error
 	buf []byte
 	n   int
-	wr  *os.File
+	wr  io.Writer
 }
 
 // NewWriterSize returns a new Writer whose buffer has at least the specified
-// size. If the argument *os.File is already a Writer with large enough
+// size. If the argument io.Writer is already a Writer with large enough
 // size, it returns the underlying Writer.
-func NewWriterSize(w *os.File, size int) *bufWriter {
+func NewWriterSize(w io.Writer, size int) *bufWriter {
 	if size <= 0 {
 		size = defaultBufSize
 	}
@@ -48,7 +47,7 @@ func NewWriterSize(w *os.File, size int) *bufWriter {
 }
 
 // NewWriter returns a new Writer whose buffer has the default size.
-func NewWriter(w *os.File) *bufWriter {
+func NewWriter(w io.Writer) *bufWriter {
 	return NewWriterSize(w, defaultBufSize)
 }
 
@@ -57,13 +56,13 @@ func (b *bufWriter) Size() int { return len(b.buf) }
 
 // Reset discards any unflushed buffered data, clears any error, and
 // resets b to write its output to w.
-func (b *bufWriter) Reset(w *os.File) {
+func (b *bufWriter) Reset(w io.Writer) {
 	b.err = nil
 	b.n = 0
 	b.wr = w
 }
 
-// Flush writes any buffered data to the underlying *os.File.
+// Flush writes any buffered data to the underlying io.Writer.
 func (b *bufWriter) Flush() error {
 	if b.err != nil {
 		return b.err
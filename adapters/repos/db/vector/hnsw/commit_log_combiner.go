@@ -18,6 +18,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/encryption"
 )
 
 type CommitLogCombiner struct {
@@ -25,16 +26,18 @@ type CommitLogCombiner struct {
 	id        string
 	threshold int64
 	logger    logrus.FieldLogger
+	keys      encryption.KeyProvider
 }
 
 func NewCommitLogCombiner(rootPath, id string, threshold int64,
-	logger logrus.FieldLogger,
+	logger logrus.FieldLogger, keys encryption.KeyProvider,
 ) *CommitLogCombiner {
 	return &CommitLogCombiner{
 		rootPath:  rootPath,
 		id:        id,
 		threshold: threshold,
 		logger:    logger,
+		keys:      keys,
 	}
 }
 
@@ -160,13 +163,31 @@ func (c *CommitLogCombiner) mergeFiles(outName, first, second string) error {
 	}
 	defer source2.Close()
 
-	_, err = io.Copy(out, source1)
+	// encrypted logs can't simply be concatenated, as every one of them has
+	// its own header and data key. Instead they are decrypted and written to
+	// the target as a single stream. Plaintext sources pass through unchanged.
+	var target io.Writer = out
+	if c.keys != nil {
+		if target, err = encryption.NewStreamWriter(out, c.keys); err != nil {
+			return errors.Wrapf(err, "encrypt target file %q", outName)
+		}
+	}
+
+	r1, err := encryption.NewReader(source1, c.keys)
+	if err != nil {
+		return errors.Wrapf(err, "open first source file %q", first)
+	}
+	_, err = io.Copy(target, r1)
 	if err != nil {
 		return errors.Wrapf(err, "copy first source (%q) into target (%q)", first,
 			outName)
 	}
 
-	_, err = io.Copy(out, source2)
+	r2, err := encryption.NewReader(source2, c.keys)
+	if err != nil {
+		return errors.Wrapf(err, "open second source file %q", second)
+	}
+	_, err = io.Copy(target, r2)
 	if err != nil {
 		return errors.Wrapf(err, "copy second source (%q) into target (%q)", second,
 			outName)
@@ -49,7 +49,7 @@ func Test_CommitlogCombiner(t *testing.T) {
 	})
 
 	t.Run("run combiner", func(t *testing.T) {
-		_, err := NewCommitLogCombiner(rootPath, id, threshold, logger).Do()
+		_, err := NewCommitLogCombiner(rootPath, id, threshold, logger, nil).Do()
 		require.Nil(t, err)
 	})
 
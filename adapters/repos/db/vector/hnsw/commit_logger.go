@@ -27,7 +27,9 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/commitlog"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
+	"github.com/weaviate/weaviate/entities/logarchive"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 )
 
@@ -66,12 +68,27 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 		return nil, err
 	}
 
+	commitLogger, err := l.newLogger(fd)
+	if errors.Is(err, encryption.ErrNotEncrypted) {
+		// the latest log was written before encryption was enabled, continue
+		// in a new one rather than appending encrypted frames to plaintext
+		fd.Close()
+		fd, err = createCommitFileAfter(rootPath, name, filepath.Base(fd.Name()))
+		if err != nil {
+			return nil, err
+		}
+		commitLogger, err = l.newLogger(fd)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "init commit log")
+	}
+
 	id := func(elems ...string) string {
 		elems = append([]string{"commit_logger"}, elems...)
 		elems = append(elems, l.id)
 		return strings.Join(elems, "/")
 	}
-	l.commitLogger = commitlog.NewLoggerWithFile(fd)
+	l.commitLogger = commitLogger
 	l.switchLogsCallbackCtrl = maintenanceCallbacks.Register(id("switch_logs"), l.startSwitchLogs)
 	l.condenseLogsCallbackCtrl = maintenanceCallbacks.Register(id("condense_logs"), l.startCombineAndCondenseLogs)
 
@@ -96,7 +113,7 @@ func getLatestCommitFileOrCreate(rootPath, name string) (*os.File, error) {
 	}
 
 	fd, err := os.OpenFile(commitLogFileName(rootPath, name, fileName),
-		os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+		os.O_RDWR|os.O_APPEND|os.O_CREATE, 0o666)
 	if err != nil {
 		return nil, errors.Wrap(err, "create commit log file")
 	}
@@ -104,6 +121,38 @@ func getLatestCommitFileOrCreate(rootPath, name string) (*os.File, error) {
 	return fd, nil
 }
 
+// createCommitFileAfter creates a new commit log which is guaranteed to be
+// ordered after the given one.
+func createCommitFileAfter(rootPath, name, previous string) (*os.File, error) {
+	ts, err := asTimeStamp(previous)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := fmt.Sprintf("%d", max(time.Now().Unix(), ts+1))
+	fd, err := os.OpenFile(commitLogFileName(rootPath, name, fileName),
+		os.O_RDWR|os.O_APPEND|os.O_CREATE, 0o666)
+	if err != nil {
+		return nil, errors.Wrap(err, "create commit log file")
+	}
+
+	return fd, nil
+}
+
+// newLogger appends to fd, encrypting the log if a key provider is
+// configured.
+func (l *hnswCommitLogger) newLogger(fd *os.File) (*commitlog.Logger, error) {
+	if l.encryption == nil {
+		return commitlog.NewLoggerWithFile(fd), nil
+	}
+
+	w, err := encryption.NewStreamWriter(fd, l.encryption)
+	if err != nil {
+		return nil, err
+	}
+	return commitlog.NewLoggerWithWriter(fd, w), nil
+}
+
 // getCommitFileNames in order, from old to new
 func getCommitFileNames(rootPath, name string) ([]string, error) {
 	dir := commitLogDirectory(rootPath, name)
@@ -260,6 +309,13 @@ type hnswCommitLogger struct {
 	condenseLogsCallbackCtrl cyclemanager.CycleCallbackCtrl
 
 	allocChecker memwatch.AllocChecker
+	encryption   encryption.KeyProvider
+	archiver     logarchive.Archiver
+
+	// switched logs whose retirement to the archiver failed, they must not be
+	// condensed before a retry succeeded
+	unarchivedLock sync.Mutex
+	unarchived     map[string]struct{}
 }
 
 type HnswCommitType uint8 // 256 options, plenty of room for future extensions
@@ -278,6 +334,7 @@ const (
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
 	AddSQ
+	AddRQ
 )
 
 func (t HnswCommitType) String() string {
@@ -308,6 +365,8 @@ func (t HnswCommitType) String() string {
 		return "AddProductQuantizer"
 	case AddSQ:
 		return "AddScalarQuantizer"
+	case AddRQ:
+		return "AddRotationalQuantizer"
 	}
 	return "unknown commit type"
 }
@@ -330,6 +389,13 @@ func (l *hnswCommitLogger) AddSQCompression(data compressionhelpers.SQData) erro
 	return l.commitLogger.AddSQCompression(data)
 }
 
+func (l *hnswCommitLogger) AddRQCompression(data compressionhelpers.RQData) error {
+	l.Lock()
+	defer l.Unlock()
+
+	return l.commitLogger.AddRQCompression(data)
+}
+
 // AddNode adds an empty node
 func (l *hnswCommitLogger) AddNode(node *vertex) error {
 	l.Lock()
@@ -494,12 +560,32 @@ func (l *hnswCommitLogger) switchCommitLogs(force bool) (bool, error) {
 	}
 
 	fd, err := os.OpenFile(commitLogFileName(l.rootPath, l.id, fileName),
-		os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+		os.O_RDWR|os.O_APPEND|os.O_CREATE, 0o666)
 	if err != nil {
 		return true, errors.Wrap(err, "create commit log file")
 	}
 
-	l.commitLogger = commitlog.NewLoggerWithFile(fd)
+	l.commitLogger, err = l.newLogger(fd)
+	if err != nil {
+		fd.Close()
+		return true, errors.Wrap(err, "init commit log")
+	}
+
+	if oldPath := commitLogFileName(l.rootPath, l.id, oldFileName); l.archiver != nil && oldPath != fd.Name() {
+		// the old log is complete, archive it before it can be condensed
+		if err := l.archiver.Retire(oldPath); err != nil {
+			l.unarchivedLock.Lock()
+			if l.unarchived == nil {
+				l.unarchived = map[string]struct{}{}
+			}
+			l.unarchived[oldPath] = struct{}{}
+			l.unarchivedLock.Unlock()
+			l.logger.WithError(err).
+				WithField("action", "hnsw_commit_log_archive").
+				WithField("path", oldPath).
+				Error("archiving switched commit log failed, holding back condensing")
+		}
+	}
 
 	return true, nil
 }
@@ -526,6 +612,11 @@ func (l *hnswCommitLogger) condenseOldLogs() (bool, error) {
 			continue
 		}
 
+		if err := l.retryArchive(candidate); err != nil {
+			// the log must be shipped as is, condensing it would lose entries
+			return false, err
+		}
+
 		if l.allocChecker != nil {
 			// allocChecker is optional, so we can only check this if it's actually set
 			s, err := os.Stat(candidate)
@@ -555,13 +646,29 @@ func (l *hnswCommitLogger) condenseOldLogs() (bool, error) {
 	return false, nil
 }
 
+// retryArchive retires the log to the archiver again if this failed when it
+// was switched.
+func (l *hnswCommitLogger) retryArchive(fileName string) error {
+	l.unarchivedLock.Lock()
+	defer l.unarchivedLock.Unlock()
+
+	if _, ok := l.unarchived[fileName]; !ok {
+		return nil
+	}
+	if err := l.archiver.Retire(fileName); err != nil {
+		return errors.Wrapf(err, "archive commit log %q before condensing", fileName)
+	}
+	delete(l.unarchived, fileName)
+	return nil
+}
+
 func (l *hnswCommitLogger) combineLogs() (bool, error) {
 	// maxSize is the desired final size, since we assume a lot of redundancy we
 	// can set the combining threshold higher than the final threshold under the
 	// assumption that the combined file will be considerably smaller than the
 	// sum of both input files
 	threshold := int64(float64(l.maxSizeCombining) * 1.75)
-	return NewCommitLogCombiner(l.rootPath, l.id, threshold, l.logger).Do()
+	return NewCommitLogCombiner(l.rootPath, l.id, threshold, l.logger, l.encryption).Do()
 }
 
 func (l *hnswCommitLogger) Drop(ctx context.Context) error {
@@ -11,7 +11,11 @@
 
 package hnsw
 
-import "github.com/weaviate/weaviate/usecases/memwatch"
+import (
+	"github.com/weaviate/weaviate/entities/encryption"
+	"github.com/weaviate/weaviate/entities/logarchive"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
 
 type CommitlogOption func(l *hnswCommitLogger) error
 
@@ -36,6 +40,27 @@ func WithAllocChecker(mm memwatch.AllocChecker) CommitlogOption {
 	}
 }
 
+// WithCommitlogEncryption encrypts new commit logs with the current key of the
+// given provider.
+func WithCommitlogEncryption(keys encryption.KeyProvider) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		l.encryption = keys
+		if c, ok := l.condensor.(*MemoryCondensor); ok {
+			c.encryption = keys
+		}
+		return nil
+	}
+}
+
+// WithCommitlogArchiver hands commit logs to the given archiver once they
+// have been switched and are no longer written to.
+func WithCommitlogArchiver(a logarchive.Archiver) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		l.archiver = a
+		return nil
+	}
+}
+
 func WithCondensor(condensor Condensor) CommitlogOption {
 	return func(l *hnswCommitLogger) error {
 		l.condensor = condensor
@@ -137,6 +137,50 @@ func TestCondenseLoop_WithAllocChecker_OOM(t *testing.T) {
 	}, 2*time.Second, 50*time.Millisecond, "files should not change")
 }
 
+func TestCondenseHeldBackUntilArchived(t *testing.T) {
+	scratchDir := t.TempDir()
+	commitLogDir := fmt.Sprintf("%s/main.hnsw.commitlog.d", scratchDir)
+	require.Nil(t, os.MkdirAll(commitLogDir, os.ModePerm))
+	_, err := os.Create(fmt.Sprintf("%s/1000", commitLogDir))
+	require.Nil(t, err)
+
+	logger, _ := test.NewNullLogger()
+	archiver := &fakeArchiver{failures: 2}
+	cl, err := NewCommitLogger(scratchDir, "main", logger, cyclemanager.NewCallbackGroupNoop(),
+		WithCondensor(&fakeCondensor{}), WithCommitlogArchiver(archiver))
+	require.Nil(t, err)
+	defer cl.Shutdown(context.Background())
+
+	require.Nil(t, cl.SwitchCommitLogs(true))
+
+	// the retirement on switch and the first retry fail
+	_, err = cl.condenseOldLogs()
+	require.NotNil(t, err)
+	_, err = os.Stat(fmt.Sprintf("%s/1000", commitLogDir))
+	require.Nil(t, err, "log must not be condensed before it was archived")
+
+	executed, err := cl.condenseOldLogs()
+	require.Nil(t, err)
+	assert.True(t, executed)
+	_, err = os.Stat(fmt.Sprintf("%s/1000.condensed", commitLogDir))
+	require.Nil(t, err)
+	assert.Equal(t, []string{fmt.Sprintf("%s/1000", commitLogDir)}, archiver.retired)
+}
+
+type fakeArchiver struct {
+	failures int
+	retired  []string
+}
+
+func (f *fakeArchiver) Retire(path string) error {
+	if f.failures > 0 {
+		f.failures--
+		return fmt.Errorf("archive %q unavailable", path)
+	}
+	f.retired = append(f.retired, path)
+	return nil
+}
+
 type fakeCondensor struct{}
 
 func (f fakeCondensor) Do(fileName string) error {
@@ -13,6 +13,7 @@ package commitlog
 
 import (
 	"encoding/binary"
+	"io"
 	"math"
 	"os"
 
@@ -43,6 +44,7 @@ const (
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
 	AddSQ
+	AddRQ
 )
 
 func NewLogger(fileName string) *Logger {
@@ -58,6 +60,12 @@ func NewLoggerWithFile(file *os.File) *Logger {
 	return &Logger{file: file, bufw: NewWriterSize(file, 32*1024)}
 }
 
+// NewLoggerWithWriter writes through w, e.g. an encrypting writer, the file
+// is still used to determine the size and name of the log.
+func NewLoggerWithWriter(file *os.File, w io.Writer) *Logger {
+	return &Logger{file: file, bufw: NewWriterSize(w, 32*1024)}
+}
+
 func (l *Logger) SetEntryPointWithMaxLayer(id uint64, level int) error {
 	toWrite := make([]byte, 11)
 	toWrite[0] = byte(SetEntryPointMaxLevel)
@@ -107,6 +115,16 @@ func (l *Logger) AddSQCompression(data compressionhelpers.SQData) error {
 	return err
 }
 
+func (l *Logger) AddRQCompression(data compressionhelpers.RQData) error {
+	toWrite := make([]byte, 12)
+	toWrite[0] = byte(AddRQ)
+	binary.LittleEndian.PutUint16(toWrite[1:], data.Dimensions)
+	toWrite[3] = data.Bits
+	binary.LittleEndian.PutUint64(toWrite[4:], data.Seed)
+	_, err := l.bufw.Write(toWrite)
+	return err
+}
+
 func (l *Logger) AddLinkAtLevel(id uint64, level int, target uint64) error {
 	toWrite := make([]byte, 19)
 	toWrite[0] = byte(AddLinkAtLevel)
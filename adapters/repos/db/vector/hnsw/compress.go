@@ -23,7 +23,7 @@ import (
 )
 
 func (h *hnsw) compress(cfg ent.UserConfig) error {
-	if !cfg.PQ.Enabled && !cfg.BQ.Enabled && !cfg.SQ.Enabled {
+	if !cfg.PQ.Enabled && !cfg.BQ.Enabled && !cfg.SQ.Enabled && !cfg.RQ.Enabled {
 		return nil
 	}
 	h.compressActionLock.Lock()
@@ -105,6 +105,27 @@ func (h *hnsw) compress(cfg ent.UserConfig) error {
 			}
 		}
 		h.compressor.PersistCompression(h.commitLog)
+	} else if cfg.RQ.Enabled {
+		// the rotation is random and does not need to be fitted, only the
+		// dimensions have to be known
+		if h.isEmpty() {
+			return errors.New("compress command cannot be executed before inserting some data")
+		}
+		var err error
+		if !h.multivector.Load() {
+			h.compressor, err = compressionhelpers.NewHNSWRQCompressor(
+				h.distancerProvider, int(h.dims), cfg.RQ.Bits, 1e12, h.logger, h.store,
+				h.allocChecker)
+		} else {
+			h.compressor, err = compressionhelpers.NewHNSWRQMultiCompressor(
+				h.distancerProvider, int(h.dims), cfg.RQ.Bits, 1e12, h.logger, h.store,
+				h.allocChecker)
+		}
+		if err != nil {
+			h.rqConfig.Enabled = false
+			return fmt.Errorf("compressing vectors: %w", err)
+		}
+		h.compressor.PersistCompression(h.commitLog)
 	} else {
 		var err error
 		if !h.multivector.Load() {
@@ -14,10 +14,12 @@ package hnsw
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus/hooks/test"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
@@ -88,3 +90,96 @@ func Test_NoRaceCompressReturnsErrorWhenNotEnoughData(t *testing.T) {
 	err := index.compress(uc)
 	assert.NotNil(t, err)
 }
+
+func Test_NoRaceCompressWithRQ(t *testing.T) {
+	ctx := context.Background()
+	dimensions := 96
+	k := 10
+	vectors, queries := testinghelpers.RandomVecs(2000, 20, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+	logger, _ := test.NewNullLogger()
+	store := testinghelpers.NewDummyStore(t)
+	rootPath := t.TempDir()
+	indexID := "rq-compression"
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 32
+	uc.EFConstruction = 64
+	uc.EF = 64
+	uc.RQ = ent.RQConfig{
+		Enabled:      true,
+		Bits:         8,
+		RescoreLimit: 20,
+	}
+
+	newIndex := func() *hnsw {
+		index, err := New(Config{
+			RootPath: rootPath,
+			ID:       indexID,
+			Logger:   logger,
+			MakeCommitLoggerThunk: func() (CommitLogger, error) {
+				return NewCommitLogger(rootPath, indexID, logger, cyclemanager.NewCallbackGroupNoop())
+			},
+			DistanceProvider: distancer,
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				if int(id) >= len(vectors) {
+					return nil, storobj.NewErrNotFoundf(id, "out of range")
+				}
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+				copy(container.Slice, vectors[int(id)])
+				return container.Slice, nil
+			},
+		}, uc, cyclemanager.NewCallbackGroupNoop(), store)
+		require.Nil(t, err)
+		index.PostStartup()
+		return index
+	}
+
+	truths := make([][]uint64, len(queries))
+	for i := range queries {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k,
+			func(x, y []float32) float32 {
+				dist, _ := distancer.SingleDist(x, y)
+				return dist
+			})
+	}
+
+	recall := func(index *hnsw) float32 {
+		var relevant uint64
+		for i := range queries {
+			results, _, err := index.SearchByVector(ctx, queries[i], k, nil)
+			require.Nil(t, err)
+			relevant += testinghelpers.MatchesInLists(truths[i], results)
+		}
+		return float32(relevant) / float32(k*len(queries))
+	}
+
+	index := newIndex()
+	require.Nil(t, compressionhelpers.ConcurrentlyWithError(logger, uint64(len(vectors)), func(id uint64) error {
+		return index.Add(ctx, id, vectors[id])
+	}))
+
+	t.Run("compress", func(t *testing.T) {
+		shouldUpgrade, at := index.ShouldUpgrade()
+		require.True(t, shouldUpgrade)
+		require.Equal(t, 0, at)
+
+		require.Nil(t, index.compress(uc))
+		require.True(t, index.Compressed())
+		assert.Greater(t, recall(index), float32(0.9))
+	})
+
+	t.Run("restore from the commit log", func(t *testing.T) {
+		require.Nil(t, index.Flush())
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		require.Nil(t, index.Shutdown(ctx))
+
+		index = newIndex()
+		defer index.Shutdown(context.Background())
+		require.True(t, index.Compressed())
+		assert.Greater(t, recall(index), float32(0.9))
+	})
+}
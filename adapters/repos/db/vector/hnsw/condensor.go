@@ -15,12 +15,14 @@ import (
 	"bufio"
 	"encoding/binary"
 	"fmt"
+	"io"
 	"math"
 	"os"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 )
 
@@ -28,6 +30,7 @@ type MemoryCondensor struct {
 	newLogFile *os.File
 	newLog     *bufWriter
 	logger     logrus.FieldLogger
+	encryption encryption.KeyProvider
 }
 
 func (c *MemoryCondensor) Do(fileName string) error {
@@ -39,7 +42,11 @@ func (c *MemoryCondensor) Do(fileName string) error {
 		return errors.Wrap(err, "open commit log to be condensed")
 	}
 	defer fd.Close()
-	fdBuf := bufio.NewReaderSize(fd, 256*1024)
+	r, err := encryption.NewReader(fd, c.encryption)
+	if err != nil {
+		return errors.Wrap(err, "open commit log to be condensed")
+	}
+	fdBuf := bufio.NewReaderSize(r, 256*1024)
 
 	res, _, err := NewDeserializer(c.logger).Do(fdBuf, nil, true)
 	if err != nil {
@@ -47,14 +54,20 @@ func (c *MemoryCondensor) Do(fileName string) error {
 	}
 
 	newLogFile, err := os.OpenFile(fmt.Sprintf("%s.condensed", fileName),
-		os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+		os.O_RDWR|os.O_APPEND|os.O_CREATE, 0o666)
 	if err != nil {
 		return errors.Wrap(err, "open new commit log file for writing")
 	}
 
 	c.newLogFile = newLogFile
 
-	c.newLog = NewWriterSize(c.newLogFile, 1*1024*1024)
+	var w io.Writer = c.newLogFile
+	if c.encryption != nil {
+		if w, err = encryption.NewStreamWriter(c.newLogFile, c.encryption); err != nil {
+			return errors.Wrap(err, "encrypt new commit log file")
+		}
+	}
+	c.newLog = NewWriterSize(w, 1*1024*1024)
 
 	if res.Compressed {
 		if res.CompressionPQData != nil {
@@ -65,6 +78,10 @@ func (c *MemoryCondensor) Do(fileName string) error {
 			if err := c.AddSQCompression(*res.CompressionSQData); err != nil {
 				return fmt.Errorf("write sq data: %w", err)
 			}
+		} else if res.CompressionRQData != nil {
+			if err := c.AddRQCompression(*res.CompressionRQData); err != nil {
+				return fmt.Errorf("write rq data: %w", err)
+			}
 		} else {
 			return errors.Wrap(err, "unavailable compression data")
 		}
@@ -317,6 +334,16 @@ func (c *MemoryCondensor) AddSQCompression(data compressionhelpers.SQData) error
 	return err
 }
 
+func (c *MemoryCondensor) AddRQCompression(data compressionhelpers.RQData) error {
+	toWrite := make([]byte, 12)
+	toWrite[0] = byte(AddRQ)
+	binary.LittleEndian.PutUint16(toWrite[1:], data.Dimensions)
+	toWrite[3] = data.Bits
+	binary.LittleEndian.PutUint64(toWrite[4:], data.Seed)
+	_, err := c.newLog.Write(toWrite)
+	return err
+}
+
 func NewMemoryCondensor(logger logrus.FieldLogger) *MemoryCondensor {
 	return &MemoryCondensor{logger: logger}
 }
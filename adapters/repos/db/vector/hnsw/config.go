@@ -17,6 +17,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -38,6 +39,7 @@ type Config struct {
 	DistanceProvider          distancer.Provider
 	PrometheusMetrics         *monitoring.PrometheusMetrics
 	AllocChecker              memwatch.AllocChecker
+	Encryption                encryption.KeyProvider
 	WaitForCachePrefill       bool
 	FlatSearchConcurrency     int
 	AcornFilterRatio          float64
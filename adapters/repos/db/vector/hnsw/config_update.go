@@ -60,6 +60,11 @@ func ValidateUserConfigUpdate(initial, updated config.VectorIndexConfig) error {
 			name:     "multivector enabled",
 			accessor: func(c ent.UserConfig) interface{} { return c.Multivector.Enabled },
 		},
+		{
+			// the encodings depend on all muvera parameters
+			name:     "multivector muvera",
+			accessor: func(c ent.UserConfig) interface{} { return c.Multivector.Muvera },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -106,7 +111,7 @@ func (h *hnsw) UpdateUserConfig(updated config.VectorIndexConfig, callback func(
 
 	h.acornSearch.Store(parsed.FilterStrategy == ent.FilterStrategyAcorn)
 
-	if !parsed.PQ.Enabled && !parsed.BQ.Enabled && !parsed.SQ.Enabled {
+	if !parsed.PQ.Enabled && !parsed.BQ.Enabled && !parsed.SQ.Enabled && !parsed.RQ.Enabled {
 		callback()
 		return nil
 	}
@@ -114,6 +119,7 @@ func (h *hnsw) UpdateUserConfig(updated config.VectorIndexConfig, callback func(
 	h.pqConfig = parsed.PQ
 	h.sqConfig = parsed.SQ
 	h.bqConfig = parsed.BQ
+	h.rqConfig = parsed.RQ
 	if asyncEnabled() {
 		callback()
 		return nil
@@ -142,6 +148,11 @@ func (h *hnsw) Upgrade(callback func()) error {
 		return err
 	}
 
+	if err := ent.ValidateRQConfig(h.rqConfig); err != nil {
+		callback()
+		return err
+	}
+
 	enterrors.GoWrapper(func() { h.compressThenCallback(callback) }, h.logger)
 
 	return nil
@@ -154,6 +165,7 @@ func (h *hnsw) compressThenCallback(callback func()) {
 		PQ: h.pqConfig,
 		BQ: h.bqConfig,
 		SQ: h.sqConfig,
+		RQ: h.rqConfig,
 	}
 	if err := h.compress(uc); err != nil {
 		h.logger.Error(err)
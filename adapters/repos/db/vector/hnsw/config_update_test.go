@@ -75,6 +75,20 @@ func TestUserConfigUpdates(t *testing.T) {
 					"multivector enabled is immutable: " +
 						"attempted change from \"false\" to \"true\""),
 			},
+			{
+				name: "attempting to change muvera",
+				initial: ent.UserConfig{Multivector: ent.MultivectorConfig{
+					Enabled: true,
+					Muvera:  ent.MuveraConfig{Enabled: true, KSim: 4, DProjections: 16, Repetitions: 10},
+				}},
+				update: ent.UserConfig{Multivector: ent.MultivectorConfig{
+					Enabled: true,
+					Muvera:  ent.MuveraConfig{Enabled: true, KSim: 4, DProjections: 16, Repetitions: 20},
+				}},
+				expectedError: errors.Errorf(
+					"multivector muvera is immutable: " +
+						"attempted change from \"{true 4 16 10}\" to \"{true 4 16 20}\""),
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
@@ -97,6 +97,11 @@ func (h *hnsw) Delete(ids ...uint64) error {
 }
 
 func (h *hnsw) DeleteMulti(docIDs ...uint64) error {
+	if h.muvera != nil {
+		// there is a single node per document
+		return h.Delete(docIDs...)
+	}
+
 	before := time.Now()
 	defer h.metrics.TrackDelete(before, "total")
 
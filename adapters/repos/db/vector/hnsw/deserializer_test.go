@@ -115,6 +115,7 @@ func TestDeserializer2ReadCommitType(t *testing.T) {
 		DeleteNode,
 		ResetIndex,
 		AddPQ,
+		AddRQ,
 	}
 	for _, commitType := range commitTypes {
 		b := make([]byte, 1)
@@ -501,3 +502,48 @@ func TestDeserializerTotalReadPQ(t *testing.T) {
 		t.Logf("deserializeSize: %v\n", deserializeSize)
 	})
 }
+
+func TestDeserializerReadRQ(t *testing.T) {
+	rootPath := t.TempDir()
+	ctx := context.Background()
+
+	logger, _ := test.NewNullLogger()
+	commitLogger, err := NewCommitLogger(rootPath, "tmpLogger", logger,
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+
+	rqData := compressionhelpers.RQData{
+		Dimensions: 768,
+		Bits:       4,
+		Seed:       0xdeadbeefcafe,
+	}
+
+	t.Run("add rq data to the log", func(t *testing.T) {
+		require.Nil(t, commitLogger.AddRQCompression(rqData))
+		require.Nil(t, commitLogger.Flush())
+		require.Nil(t, commitLogger.Shutdown(ctx))
+	})
+
+	t.Run("deserialize the log", func(t *testing.T) {
+		nullLogger, _ := test.NewNullLogger()
+		commitLoggerPath := rootPath + "/tmpLogger.hnsw.commitlog.d"
+
+		fileName, found, err := getCurrentCommitLogFileName(commitLoggerPath)
+		require.Nil(t, err)
+		require.True(t, found)
+
+		fd, err := os.Open(commitLoggerPath + "/" + fileName)
+		require.Nil(t, err)
+
+		defer fd.Close()
+		fdBuf := bufio.NewReaderSize(fd, 256*1024)
+
+		res, deserializeSize, err := NewDeserializer(nullLogger).Do(fdBuf, nil, true)
+		require.Nil(t, err)
+
+		require.Equal(t, 12, deserializeSize)
+		require.True(t, res.Compressed)
+		require.NotNil(t, res.CompressionRQData)
+		require.Equal(t, rqData, *res.CompressionRQData)
+	})
+}
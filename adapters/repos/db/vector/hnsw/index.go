@@ -30,7 +30,9 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/encryption"
 	"github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/storobj"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
@@ -167,6 +169,7 @@ type hnsw struct {
 	pqConfig   ent.PQConfig
 	bqConfig   ent.BQConfig
 	sqConfig   ent.SQConfig
+	rqConfig   ent.RQConfig
 	// rescoring compressed vectors is disk-bound. On cold starts, we cannot
 	// rescore sequentially, as that would take very long. This setting allows us
 	// to define the rescoring concurrency.
@@ -180,6 +183,7 @@ type hnsw struct {
 	store              *lsmkv.Store
 
 	allocChecker            memwatch.AllocChecker
+	encryption              encryption.KeyProvider
 	tombstoneCleanupRunning atomic.Bool
 
 	visitedListPoolMaxSize int
@@ -189,6 +193,11 @@ type hnsw struct {
 	docIDVectors map[uint64][]uint64
 	vecIDcounter uint64
 	maxDocID     uint64
+
+	// only used for multivector mode with muvera encodings, in which case
+	// the graph contains a single node per document and multivector is false
+	muvera          *multivector.MuveraEncoder
+	muveraNormalize bool
 }
 
 type CommitLogger interface {
@@ -210,6 +219,7 @@ type CommitLogger interface {
 	SwitchCommitLogs(bool) error
 	AddPQCompression(compressionhelpers.PQData) error
 	AddSQCompression(compressionhelpers.SQData) error
+	AddRQCompression(compressionhelpers.RQData) error
 }
 
 type BufferedLinksLogger interface {
@@ -240,10 +250,28 @@ func New(cfg Config, uc ent.UserConfig,
 	}
 
 	normalizeOnRead := cfg.DistanceProvider.Type() == "cosine-dot"
+	multivectorGraph := uc.Multivector.Enabled
+
+	var muvera *multivector.MuveraEncoder
+	muveraNormalize := false
+	if uc.Multivector.Enabled && uc.Multivector.Muvera.Enabled {
+		// The graph is built from the fixed dimensional encodings of the
+		// documents, which are compared by their inner product. The tokens are
+		// normalized before encoding, while the encodings of both the documents
+		// and the queries are left unnormalized, as their inner product
+		// approximates the late interaction score.
+		muvera = multivector.NewMuveraEncoder(uc.Multivector.Muvera)
+		muveraNormalize = normalizeOnRead
+		cfg.VectorForIDThunk = muveraVectorForID(muvera, muveraNormalize, cfg.MultiVectorForIDThunk)
+		cfg.TempVectorForIDThunk = muveraTempVectorForID(muvera, muveraNormalize, cfg.TempMultiVectorForIDThunk)
+		cfg.DistanceProvider = distancer.NewDotProductProvider()
+		normalizeOnRead = false
+		multivectorGraph = false
+	}
 
 	var vectorCache cache.Cache[float32]
 
-	if uc.Multivector.Enabled {
+	if multivectorGraph {
 		vectorCache = cache.NewShardedMultiFloat32LockCache(cfg.MultiVectorForIDThunk, uc.VectorCacheMaxObjects,
 			cfg.Logger, normalizeOnRead, cache.DefaultDeletionInterval, cfg.AllocChecker)
 	} else {
@@ -300,22 +328,27 @@ func New(cfg Config, uc ent.UserConfig,
 		pqConfig:                  uc.PQ,
 		bqConfig:                  uc.BQ,
 		sqConfig:                  uc.SQ,
+		rqConfig:                  uc.RQ,
 		rescoreConcurrency:        2 * runtime.GOMAXPROCS(0), // our default for IO-bound activties
 		shardedNodeLocks:          common.NewDefaultShardedRWLocks(),
 
 		store:                  store,
 		allocChecker:           cfg.AllocChecker,
+		encryption:             cfg.Encryption,
 		visitedListPoolMaxSize: cfg.VisitedListPoolMaxSize,
 
 		docIDVectors: make(map[uint64][]uint64),
+
+		muvera:          muvera,
+		muveraNormalize: muveraNormalize,
 	}
 	index.acornSearch.Store(uc.FilterStrategy == ent.FilterStrategyAcorn)
 
-	index.multivector.Store(uc.Multivector.Enabled)
+	index.multivector.Store(multivectorGraph)
 
 	if uc.BQ.Enabled {
 		var err error
-		if !uc.Multivector.Enabled {
+		if !multivectorGraph {
 			index.compressor, err = compressionhelpers.NewBQCompressor(
 				index.distancerProvider, uc.VectorCacheMaxObjects, cfg.Logger, store,
 				cfg.AllocChecker)
@@ -334,6 +367,9 @@ func New(cfg Config, uc ent.UserConfig,
 
 	if uc.Multivector.Enabled {
 		index.multiDistancerProvider = distancer.NewDotProductProvider()
+	}
+
+	if multivectorGraph {
 		err := index.store.CreateOrLoadBucket(context.Background(), cfg.ID+"_mv_mappings", lsmkv.WithStrategy(lsmkv.StrategyReplace))
 		if err != nil {
 			return nil, errors.Wrapf(err, "Create or load bucket (multivector store)")
@@ -685,7 +721,7 @@ func (h *hnsw) DistanceBetweenVectors(x, y []float32) (float32, error) {
 }
 
 func (h *hnsw) ContainsDoc(docID uint64) bool {
-	if h.Multivector() {
+	if h.multivector.Load() {
 		h.RLock()
 		vecIds, exists := h.docIDVectors[docID]
 		h.RUnlock()
@@ -702,7 +738,7 @@ func (h *hnsw) ContainsDoc(docID uint64) bool {
 }
 
 func (h *hnsw) Iterate(fn func(docID uint64) bool) {
-	if h.Multivector() {
+	if h.multivector.Load() {
 		h.iterateMulti(fn)
 		return
 	}
@@ -771,6 +807,10 @@ func (h *hnsw) DistancerProvider() distancer.Provider {
 }
 
 func (h *hnsw) ShouldUpgrade() (bool, int) {
+	if h.rqConfig.Enabled {
+		// nothing to train, compress as soon as the dimensions are known
+		return true, 0
+	}
 	if h.sqConfig.Enabled {
 		return h.sqConfig.Enabled, h.sqConfig.TrainingLimit
 	}
@@ -779,6 +819,9 @@ func (h *hnsw) ShouldUpgrade() (bool, int) {
 
 func (h *hnsw) ShouldCompressFromConfig(config config.VectorIndexConfig) (bool, int) {
 	hnswConfig := config.(ent.UserConfig)
+	if hnswConfig.RQ.Enabled {
+		return true, 0
+	}
 	if hnswConfig.SQ.Enabled {
 		return hnswConfig.SQ.Enabled, hnswConfig.SQ.TrainingLimit
 	}
@@ -790,7 +833,7 @@ func (h *hnsw) Compressed() bool {
 }
 
 func (h *hnsw) Multivector() bool {
-	return h.multivector.Load()
+	return h.multivector.Load() || h.muvera != nil
 }
 
 func (h *hnsw) Upgraded() bool {
@@ -816,7 +859,7 @@ func (h *hnsw) normalizeVec(vec []float32) []float32 {
 }
 
 func (h *hnsw) normalizeVecs(vecs [][]float32) [][]float32 {
-	if h.distancerProvider.Type() == "cosine-dot" {
+	if h.distancerProvider.Type() == "cosine-dot" || h.muveraNormalize {
 		normalized := make([][]float32, len(vecs))
 		for i, vec := range vecs {
 			normalized[i] = distancer.Normalize(vec)
@@ -0,0 +1,171 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package hnsw
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/encryption"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestStartupWithEncryptedCommitLogs(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	id := "encrypted_test"
+	logger, _ := test.NewNullLogger()
+
+	k1, k2 := randomKey(t), randomKey(t)
+	keyfile := filepath.Join(t.TempDir(), "keys.json")
+	writeTestKeyfile(t, keyfile, "k1", map[string]string{"k1": k1})
+	keys, err := encryption.NewKeyfileProvider(keyfile)
+	require.Nil(t, err)
+
+	original, err := NewCommitLogger(rootPath, id, logger,
+		cyclemanager.NewCallbackGroupNoop(), WithCommitlogEncryption(keys))
+	require.Nil(t, err)
+
+	vectors, queries := testinghelpers.RandomVecs(300, 10, 16)
+	newIndex := func(t *testing.T, makeCL MakeCommitLogger, keys encryption.KeyProvider) (*hnsw, error) {
+		return New(Config{
+			MakeCommitLoggerThunk: makeCL,
+			ID:                    id,
+			RootPath:              rootPath,
+			DistanceProvider:      distancer.NewL2SquaredProvider(),
+			Logger:                logger,
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+			Encryption: keys,
+		}, hnswent.UserConfig{
+			MaxConnections:         16,
+			EFConstruction:         64,
+			CleanupIntervalSeconds: 0,
+		}, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	}
+
+	index, err := newIndex(t, func() (CommitLogger, error) { return original, nil }, keys)
+	require.Nil(t, err)
+
+	// commit log names are unix timestamps, wait so that every switch starts a
+	// new file
+	switchLogs := func(t *testing.T) {
+		time.Sleep(1100 * time.Millisecond)
+		require.Nil(t, original.SwitchCommitLogs(true))
+	}
+
+	t.Run("write logs with two different keys", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			require.Nil(t, index.Add(ctx, uint64(i), vectors[i]))
+		}
+		require.Nil(t, index.Flush())
+		switchLogs(t)
+
+		writeTestKeyfile(t, keyfile, "k2", map[string]string{"k1": k1, "k2": k2})
+		for i := 100; i < 200; i++ {
+			require.Nil(t, index.Add(ctx, uint64(i), vectors[i]))
+		}
+		require.Nil(t, index.Delete(3, 7))
+		require.Nil(t, index.Flush())
+		switchLogs(t)
+
+		for i := 200; i < len(vectors); i++ {
+			require.Nil(t, index.Add(ctx, uint64(i), vectors[i]))
+		}
+		require.Nil(t, index.Flush())
+	})
+
+	t.Run("condense and combine", func(t *testing.T) {
+		for {
+			condensed, err := original.condenseOldLogs()
+			require.Nil(t, err)
+			if !condensed {
+				break
+			}
+		}
+		_, err := original.combineLogs()
+		require.Nil(t, err)
+	})
+
+	t.Run("all logs are encrypted", func(t *testing.T) {
+		dir := commitLogDirectory(rootPath, id)
+		files, err := os.ReadDir(dir)
+		require.Nil(t, err)
+		require.NotEmpty(t, files)
+
+		for _, f := range files {
+			keyID, err := encryption.KeyID(filepath.Join(dir, f.Name()))
+			require.Nil(t, err)
+			// condensing and combining re-encrypt with the rotated key
+			assert.Equal(t, "k2", keyID, f.Name())
+		}
+	})
+
+	expected := make([][]uint64, len(queries))
+	for i, query := range queries {
+		expected[i], _, err = index.SearchByVector(ctx, query, 10, nil)
+		require.Nil(t, err)
+	}
+	require.Nil(t, original.Shutdown(ctx))
+	index = nil
+
+	t.Run("cannot start without the keys", func(t *testing.T) {
+		_, err := newIndex(t, MakeNoopCommitLogger, nil)
+		require.NotNil(t, err)
+	})
+
+	t.Run("restart from the encrypted logs", func(t *testing.T) {
+		restarted, err := newIndex(t, MakeNoopCommitLogger, keys)
+		require.Nil(t, err)
+		restarted.PostStartup()
+
+		for i, query := range queries {
+			res, _, err := restarted.SearchByVector(ctx, query, 10, nil)
+			require.Nil(t, err)
+			assert.Equal(t, expected[i], res)
+		}
+	})
+}
+
+func randomKey(t *testing.T) string {
+	key := make([]byte, encryption.KeySize)
+	_, err := rand.Read(key)
+	require.Nil(t, err)
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func writeTestKeyfile(t *testing.T, path, current string, keys map[string]string) {
+	entries := ""
+	for id, key := range keys {
+		if entries != "" {
+			entries += ","
+		}
+		entries += fmt.Sprintf(`{"id":%q,"key":%q}`, id, key)
+	}
+	contents := fmt.Sprintf(`{"current":%q,"keys":[%s]}`, current, entries)
+	require.Nil(t, os.WriteFile(path, []byte(contents), 0o600))
+}
@@ -43,6 +43,10 @@ func (h *hnsw) ValidateBeforeInsert(vector []float32) error {
 
 func (h *hnsw) ValidateMultiBeforeInsert(vector [][]float32) error {
 	dims := int(atomic.LoadInt32(&h.dims))
+	if h.muvera != nil {
+		// the index dimensions are the ones of the encodings
+		dims = h.muvera.InputDimensions()
+	}
 
 	// no vectors exist
 	if dims == 0 {
@@ -155,7 +159,7 @@ func (h *hnsw) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][
 	if err := ctx.Err(); err != nil {
 		return err
 	}
-	if !h.multivector.Load() {
+	if !h.Multivector() {
 		return errors.Errorf("addMultiBatch called on non-multivector index")
 	}
 	if len(docIDs) != len(vectors) {
@@ -164,6 +168,9 @@ func (h *hnsw) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][
 	if len(docIDs) == 0 {
 		return errors.Errorf("addMultiBatch called with empty lists")
 	}
+	if h.muvera != nil {
+		return h.addMuveraBatch(ctx, docIDs, vectors)
+	}
 
 	var err error
 	h.trackDimensionsOnce.Do(func() {
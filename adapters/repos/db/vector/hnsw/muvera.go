@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
+)
+
+// muveraVectorForID turns the multi vector thunk of the shard into a thunk
+// returning the document encodings the graph is built from.
+func muveraVectorForID(encoder *multivector.MuveraEncoder, normalize bool,
+	multiVectorForID common.VectorForID[[]float32],
+) common.VectorForID[float32] {
+	return func(ctx context.Context, id uint64) ([]float32, error) {
+		vecs, err := multiVectorForID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		return encoder.EncodeDoc(normalizeMuveraTokens(vecs, normalize))
+	}
+}
+
+func muveraTempVectorForID(encoder *multivector.MuveraEncoder, normalize bool,
+	tempMultiVectorForID common.TempVectorForID[[]float32],
+) common.TempVectorForID[float32] {
+	return func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+		vecs, err := tempMultiVectorForID(ctx, id, container)
+		if err != nil {
+			return nil, err
+		}
+		return encoder.EncodeDoc(normalizeMuveraTokens(vecs, normalize))
+	}
+}
+
+func normalizeMuveraTokens(vecs [][]float32, normalize bool) [][]float32 {
+	if !normalize {
+		return vecs
+	}
+	normalized := make([][]float32, len(vecs))
+	for i, vec := range vecs {
+		normalized[i] = distancer.Normalize(vec)
+	}
+	return normalized
+}
+
+func (h *hnsw) addMuveraBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	encodings := make([][]float32, len(vectors))
+	for i, vecs := range vectors {
+		encoding, err := h.muvera.EncodeDoc(h.normalizeVecs(vecs))
+		if err != nil {
+			return errors.Wrapf(err, "encode multi vector of doc %d", docIDs[i])
+		}
+		encodings[i] = encoding
+	}
+	return h.AddBatch(ctx, docIDs, encodings)
+}
+
+// searchByMuvera collects candidates using the encoding of the query and
+// rescores them with the exact late interaction score of the multi vectors.
+func (h *hnsw) searchByMuvera(ctx context.Context, vectors [][]float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	query, err := h.muvera.EncodeQuery(vectors)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "encode query multi vector")
+	}
+
+	candidates := h.searchTimeEF(k)
+	var ids []uint64
+	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
+	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
+		ids, _, err = h.flatSearch(ctx, query, candidates, candidates, allowList)
+	} else {
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
+		ids, _, err = h.knnSearchByVector(ctx, query, candidates, candidates, allowList)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	candidateSet := make(map[uint64]struct{}, len(ids))
+	for _, id := range ids {
+		candidateSet[id] = struct{}{}
+	}
+	return h.computeLateInteraction(vectors, k, candidateSet)
+}
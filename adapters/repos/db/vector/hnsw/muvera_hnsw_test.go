@@ -0,0 +1,163 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package hnsw
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func muveraTestConfig() Config {
+	return Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "recallbenchmark",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewCosineDistanceProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return []float32{0}, errors.New("can not use VectorForIDThunk with multivector")
+		},
+		MultiVectorForIDThunk: func(ctx context.Context, id uint64) ([][]float32, error) {
+			return multiVectors[id], nil
+		},
+		TempMultiVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([][]float32, error) {
+			return multiVectors[id], nil
+		},
+	}
+}
+
+func muveraTestUserConfig() ent.UserConfig {
+	return ent.UserConfig{
+		VectorCacheMaxObjects: 1e12,
+		MaxConnections:        8,
+		EFConstruction:        64,
+		EF:                    64,
+		Multivector: ent.MultivectorConfig{
+			Enabled: true,
+			Muvera: ent.MuveraConfig{
+				Enabled:      true,
+				KSim:         ent.DefaultMuveraKSim,
+				DProjections: ent.DefaultMuveraDProjections,
+				Repetitions:  ent.DefaultMuveraRepetitions,
+			},
+		},
+	}
+}
+
+func TestMuveraHnsw(t *testing.T) {
+	ctx := context.Background()
+	k := 10
+
+	index, err := New(muveraTestConfig(), muveraTestUserConfig(),
+		cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+
+	t.Run("importing into hnsw", func(t *testing.T) {
+		for i, vec := range multiVectors {
+			require.Nil(t, index.ValidateMultiBeforeInsert(vec))
+			require.Nil(t, index.AddMulti(ctx, uint64(i), vec))
+		}
+
+		assert.True(t, index.Multivector())
+		assert.False(t, index.multivector.Load())
+		assert.Equal(t, index.muvera.Dimensions(), int(index.dims))
+		assert.Equal(t, 3, index.muvera.InputDimensions())
+		assert.Empty(t, index.docIDVectors)
+	})
+
+	t.Run("encodings are not normalized on read", func(t *testing.T) {
+		cached, err := index.cache.Get(ctx, 0)
+		require.Nil(t, err)
+
+		// evict the encoding so that it is read through the thunk again
+		index.cache.Delete(ctx, 0)
+		loaded, err := index.cache.Get(ctx, 0)
+		require.Nil(t, err)
+
+		expected, err := index.muvera.EncodeDoc(normalizeMuveraTokens(multiVectors[0], true))
+		require.Nil(t, err)
+		assert.Equal(t, expected, cached)
+		assert.Equal(t, expected, loaded)
+	})
+
+	t.Run("validating dimensions of the tokens", func(t *testing.T) {
+		err := index.ValidateMultiBeforeInsert([][]float32{{0.1, 0.2}})
+		require.NotNil(t, err)
+	})
+
+	t.Run("candidates are rescored with the late interaction score", func(t *testing.T) {
+		for i, query := range multiQueries {
+			ids, _, err := index.SearchByMultiVector(ctx, query, k, nil)
+			require.Nil(t, err)
+			require.Equal(t, expectedResults[i], ids)
+		}
+	})
+
+	t.Run("query distancer", func(t *testing.T) {
+		_, dists, err := index.SearchByMultiVector(ctx, multiQueries[0], 1, nil)
+		require.Nil(t, err)
+
+		distancer := index.QueryMultiVectorDistancer(multiQueries[0])
+		dist, err := distancer.DistanceFunc(expectedResults[0][0])
+		require.Nil(t, err)
+		assert.InDelta(t, dists[0], dist, 1e-6)
+
+		_, err = distancer.DistanceFunc(100)
+		require.NotNil(t, err)
+	})
+
+	t.Run("delete a document", func(t *testing.T) {
+		require.Nil(t, index.DeleteMulti(1))
+		assert.False(t, index.ContainsDoc(1))
+
+		newExpectedResults := [][]uint64{
+			{0, 2},
+			{0, 2},
+		}
+		for i, query := range multiQueries {
+			ids, _, err := index.SearchByMultiVector(ctx, query, k, nil)
+			require.Nil(t, err)
+			require.Equal(t, newExpectedResults[i], ids)
+		}
+	})
+}
+
+func TestMuveraBQHnsw(t *testing.T) {
+	ctx := context.Background()
+	uc := muveraTestUserConfig()
+	uc.BQ = ent.BQConfig{Enabled: true}
+
+	index, err := New(muveraTestConfig(), uc,
+		cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+
+	for i, vec := range multiVectors {
+		require.Nil(t, index.AddMulti(ctx, uint64(i), vec))
+	}
+
+	for i, query := range multiQueries {
+		ids, _, err := index.SearchByMultiVector(ctx, query, 10, nil)
+		require.Nil(t, err)
+		require.Equal(t, expectedResults[i], ids)
+	}
+}
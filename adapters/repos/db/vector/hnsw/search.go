@@ -92,7 +92,7 @@ func (h *hnsw) SearchByVector(ctx context.Context, vector []float32,
 }
 
 func (h *hnsw) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
-	if !h.multivector.Load() {
+	if !h.Multivector() {
 		return nil, nil, errors.New("multivector search is not enabled")
 	}
 
@@ -100,6 +100,9 @@ func (h *hnsw) SearchByMultiVector(ctx context.Context, vectors [][]float32, k i
 	defer h.compressActionLock.RUnlock()
 
 	vectors = h.normalizeVecs(vectors)
+	if h.muvera != nil {
+		return h.searchByMuvera(ctx, vectors, k, allowList)
+	}
 	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
 	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
 		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
@@ -734,7 +737,7 @@ func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int
 	}
 
 	beforeRescore := time.Now()
-	if h.shouldRescore() && !h.multivector.Load() {
+	if h.shouldRescore() && !h.Multivector() {
 		if err := h.rescore(ctx, res, k, compressorDistancer); err != nil {
 			helpers.AnnotateSlowQueryLog(ctx, "context_error", "knn_search_rescore")
 			took := time.Since(beforeRescore)
@@ -819,7 +822,7 @@ func (h *hnsw) computeScore(searchVecs [][]float32, docID uint64) (float32, erro
 	vecIDs := h.docIDVectors[docID]
 	h.RUnlock()
 	var docVecs [][]float32
-	if h.compressed.Load() {
+	if h.compressed.Load() || h.muvera != nil {
 		slice := h.pools.tempVectors.Get(int(h.dims))
 		var err error
 		docVecs, err = h.TempMultiVectorForIDThunk(context.Background(), docID, slice)
@@ -888,6 +891,12 @@ func (h *hnsw) QueryVectorDistancer(queryVector []float32) common.QueryVectorDis
 func (h *hnsw) QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVectorDistancer {
 	queryVector = h.normalizeVecs(queryVector)
 	f := func(docID uint64) (float32, error) {
+		if h.muvera != nil {
+			if !h.ContainsDoc(docID) {
+				return -1, fmt.Errorf("docID %v is not in the vector index", docID)
+			}
+			return h.computeScore(queryVector, docID)
+		}
 		h.RLock()
 		_, ok := h.docIDVectors[docID]
 		h.RUnlock()
@@ -905,6 +914,11 @@ func (h *hnsw) rescore(ctx context.Context, res *priorityqueue.Queue[any], k int
 			res.Pop()
 		}
 	}
+	if h.rqConfig.Enabled && h.rqConfig.RescoreLimit >= k {
+		for res.Len() > h.rqConfig.RescoreLimit {
+			res.Pop()
+		}
+	}
 	ids := make([]uint64, res.Len())
 	i := len(ids) - 1
 	for res.Len() > 0 {
@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/diskio"
+	"github.com/weaviate/weaviate/entities/encryption"
 )
 
 func (h *hnsw) init(cfg Config) error {
@@ -86,7 +87,12 @@ func (h *hnsw) restoreFromDisk() error {
 
 		defer fd.Close()
 
-		metered := diskio.NewMeteredReader(fd,
+		r, err := encryption.NewReader(fd, h.encryption)
+		if err != nil {
+			return errors.Wrapf(err, "open commit log %q for reading", fileName)
+		}
+
+		metered := diskio.NewMeteredReader(r,
 			h.metrics.TrackStartupReadCommitlogDiskIO)
 		fdBuf := bufio.NewReaderSize(metered, 256*1024)
 
@@ -105,7 +111,7 @@ func (h *hnsw) restoreFromDisk() error {
 					Error("write-ahead-log ended abruptly, some elements may not have been recovered")
 
 				// we need to truncate the file to its valid length!
-				if err := os.Truncate(fileName, int64(valid)); err != nil {
+				if err := encryption.Truncate(fileName, int64(valid), h.encryption); err != nil {
 					return errors.Wrapf(err, "truncate corrupt commit log %q", fileName)
 				}
 			} else {
@@ -207,6 +213,31 @@ func (h *hnsw) restoreFromDisk() error {
 			if err != nil {
 				return errors.Wrap(err, "Restoring compressed data.")
 			}
+		} else if state.CompressionRQData != nil {
+			data := state.CompressionRQData
+			h.dims = int32(data.Dimensions)
+			if !h.multivector.Load() {
+				h.compressor, err = compressionhelpers.RestoreHNSWRQCompressor(
+					h.distancerProvider,
+					1e12,
+					h.logger,
+					*data,
+					h.store,
+					h.allocChecker,
+				)
+			} else {
+				h.compressor, err = compressionhelpers.RestoreHNSWRQMultiCompressor(
+					h.distancerProvider,
+					1e12,
+					h.logger,
+					*data,
+					h.store,
+					h.allocChecker,
+				)
+			}
+			if err != nil {
+				return errors.Wrap(err, "Restoring compressed data.")
+			}
 		} else {
 			return errors.New("unsupported type while loading compression data")
 		}
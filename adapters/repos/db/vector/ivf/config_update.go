@@ -0,0 +1,100 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+)
+
+func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) error {
+	initialParsed, ok := initial.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	// the lists and their compressed vectors depend on the training
+	// parameters, changing them would require to retrain the index
+	immutableFields := []immutableParameter{
+		{
+			name:     "distance",
+			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
+		},
+		{
+			name:     "nlists",
+			accessor: func(c ent.UserConfig) interface{} { return c.NLists },
+		},
+		{
+			name:     "trainingThreshold",
+			accessor: func(c ent.UserConfig) interface{} { return c.TrainingThreshold },
+		},
+		{
+			name:     "pq",
+			accessor: func(c ent.UserConfig) interface{} { return c.PQ },
+		},
+		{
+			name:     "bq",
+			accessor: func(c ent.UserConfig) interface{} { return c.BQ },
+		},
+	}
+
+	for _, u := range immutableFields {
+		if err := validateImmutableField(u, initialParsed, updatedParsed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type immutableParameter struct {
+	accessor func(c ent.UserConfig) interface{}
+	name     string
+}
+
+func validateImmutableField(u immutableParameter,
+	previous, next ent.UserConfig,
+) error {
+	oldField := u.accessor(previous)
+	newField := u.accessor(next)
+	if oldField != newField {
+		return errors.Errorf("%s is immutable: attempted change from \"%v\" to \"%v\"",
+			u.name, oldField, newField)
+	}
+
+	return nil
+}
+
+func (index *ivf) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	defer callback()
+
+	parsed, ok := updated.(ent.UserConfig)
+	if !ok {
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	// Store atomically as a lock here would be very expensive, these values are
+	// read on every single user-facing search, which can be highly concurrent
+	atomic.StoreInt64(&index.nProbe, int64(parsed.NProbe))
+	atomic.StoreInt64(&index.rescoreLimit, int64(parsed.RescoreLimit))
+	atomic.StoreInt64(&index.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+	return nil
+}
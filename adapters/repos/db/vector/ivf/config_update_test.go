@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/schema/config"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+)
+
+func TestIVFUserConfigUpdates(t *testing.T) {
+	type test struct {
+		name          string
+		initial       config.VectorIndexConfig
+		update        config.VectorIndexConfig
+		expectedError error
+	}
+
+	tests := []test{
+		{
+			name:    "attempting to change nlists",
+			initial: ent.UserConfig{NLists: 256},
+			update:  ent.UserConfig{NLists: 512},
+			expectedError: errors.Errorf(
+				"nlists is immutable: " +
+					"attempted change from \"256\" to \"512\""),
+		},
+		{
+			name:    "attempting to switch from pq to bq",
+			initial: ent.UserConfig{PQ: ent.PQConfig{Enabled: true}},
+			update:  ent.UserConfig{BQ: ent.BQConfig{Enabled: true}},
+			expectedError: errors.Errorf(
+				"pq is immutable: " +
+					"attempted change from \"{true 0 0}\" to \"{false 0 0}\""),
+		},
+		{
+			name:    "attempting to change distance",
+			initial: ent.UserConfig{Distance: "cosine"},
+			update:  ent.UserConfig{Distance: "l2-squared"},
+			expectedError: errors.Errorf(
+				"distance is immutable: " +
+					"attempted change from \"cosine\" to \"l2-squared\""),
+		},
+		{
+			name:          "changing search parameters",
+			initial:       ent.UserConfig{NProbe: 16, RescoreLimit: 100, FlatSearchCutoff: 10},
+			update:        ent.UserConfig{NProbe: 32, RescoreLimit: 200, FlatSearchCutoff: 20},
+			expectedError: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := ValidateUserConfigUpdate(test.initial, test.update)
+			if test.expectedError == nil {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err, "update validation must error")
+				assert.Equal(t, test.expectedError.Error(), err.Error())
+			}
+		})
+	}
+}
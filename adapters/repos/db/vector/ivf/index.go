@@ -0,0 +1,569 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package ivf contains an inverted file vector index. Vectors are clustered
+// around k-means centroids into inverted lists of compressed vectors held in
+// memory, the uncompressed vectors are kept on disk for rescoring. Until
+// enough vectors have been imported to train the index, it searches
+// exhaustively like the flat index.
+package ivf
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	entlsmkv "github.com/weaviate/weaviate/entities/lsmkv"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+	bolt "go.etcd.io/bbolt"
+)
+
+const codesBucketPrefix = "ivf_codes"
+
+type ivf struct {
+	id                string
+	targetVector      string
+	rootPath          string
+	className         string
+	shardName         string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	store             *lsmkv.Store
+
+	metadata     *bolt.DB
+	metadataLock *sync.RWMutex
+
+	dims                int32
+	trackDimensionsOnce sync.Once
+
+	// immutable training parameters, the search parameters of the config are
+	// ignored in favor of the atomics below
+	trainingConfig ent.UserConfig
+
+	// mutable search parameters, read atomically
+	nProbe           int64
+	rescoreLimit     int64
+	flatSearchCutoff int64
+
+	// trainLock is held for reading while vectors are assigned to lists and
+	// exclusively while the vectors are assigned to the trained lists
+	trainLock sync.RWMutex
+	trained   atomic.Bool
+	centroids [][]float32
+	quantizer quantizer
+	lists     *invertedLists
+
+	// training is set while a background training is running, shutdown stops
+	// it and trainingWg allows waiting for it to complete
+	training       atomic.Bool
+	trainingWg     sync.WaitGroup
+	shutdownCtx    context.Context
+	shutdownCancel context.CancelFunc
+	seed           uint64
+
+	count uint64
+}
+
+func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*ivf, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	seed := cfg.Seed
+	if seed == 0 {
+		seed = rand.Uint64()
+	}
+
+	index := &ivf{
+		id:                cfg.ID,
+		targetVector:      cfg.TargetVector,
+		rootPath:          cfg.RootPath,
+		className:         cfg.ClassName,
+		shardName:         cfg.ShardName,
+		logger:            logger,
+		distancerProvider: cfg.DistanceProvider,
+		store:             store,
+		metadataLock:      &sync.RWMutex{},
+		trainingConfig:    uc,
+		nProbe:            int64(uc.NProbe),
+		rescoreLimit:      int64(uc.RescoreLimit),
+		flatSearchCutoff:  int64(uc.FlatSearchCutoff),
+		seed:              seed,
+	}
+	index.shutdownCtx, index.shutdownCancel = context.WithCancel(context.Background())
+
+	if err := index.initBuckets(context.Background()); err != nil {
+		return nil, fmt.Errorf("init ivf index buckets: %w", err)
+	}
+
+	if err := index.initMetadata(); err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// getBucketName returns the bucket of the uncompressed vectors, which is the
+// same as the one of the flat index. This allows the dynamic index to upgrade
+// from flat to ivf without copying the vectors.
+func (index *ivf) getBucketName() string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorsBucketLSM, index.targetVector)
+	}
+	return helpers.VectorsBucketLSM
+}
+
+func (index *ivf) getCodesBucketName() string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", codesBucketPrefix, index.targetVector)
+	}
+	return codesBucketPrefix
+}
+
+func (index *ivf) initBuckets(ctx context.Context) error {
+	if err := index.store.CreateOrLoadBucket(ctx, index.getBucketName(),
+		lsmkv.WithUseBloomFilter(false),
+		lsmkv.WithCalcCountNetAdditions(false),
+		lsmkv.WithPread(false),
+	); err != nil {
+		return fmt.Errorf("create or load ivf vectors bucket: %w", err)
+	}
+	if err := index.store.CreateOrLoadBucket(ctx, index.getCodesBucketName(),
+		lsmkv.WithUseBloomFilter(false),
+		lsmkv.WithCalcCountNetAdditions(false),
+		lsmkv.WithPread(false),
+	); err != nil {
+		return fmt.Errorf("create or load ivf codes bucket: %w", err)
+	}
+	return nil
+}
+
+func (index *ivf) normalized(vector []float32) []float32 {
+	if index.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+func (index *ivf) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(ids) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+	if len(ids) == 0 {
+		return errors.Errorf("insertBatch called with empty lists")
+	}
+	for i := range ids {
+		if err := index.Add(ctx, ids[i], vectors[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (index *ivf) Add(ctx context.Context, id uint64, vector []float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	index.trackDimensionsOnce.Do(func() {
+		size := int32(len(vector))
+		atomic.StoreInt32(&index.dims, size)
+		if err := index.setDimensions(size); err != nil {
+			index.logger.WithError(err).Error("could not set dimensions")
+		}
+	})
+	if len(vector) != int(atomic.LoadInt32(&index.dims)) {
+		return errors.Errorf("insert called with a vector of the wrong size")
+	}
+	vector = index.normalized(vector)
+
+	// re-adding a vector replaces it, it is only counted once
+	existing, err := index.vectorByID(id)
+	if err != nil {
+		return err
+	}
+	if err := index.storeVector(id, vector); err != nil {
+		return err
+	}
+
+	index.trainLock.RLock()
+	trained := index.trained.Load()
+	if trained {
+		err = index.assign(id, vector)
+	}
+	index.trainLock.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	count := atomic.LoadUint64(&index.count)
+	if existing == nil {
+		count = atomic.AddUint64(&index.count, 1)
+	}
+	if !trained && count >= uint64(index.trainingConfig.TrainingThreshold) {
+		index.trainInBackground()
+	}
+	return nil
+}
+
+func (index *ivf) storeVector(id uint64, vector []float32) error {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	value := make([]byte, len(vector)*4)
+	for i := range vector {
+		binary.LittleEndian.PutUint32(value[i*4:], math.Float32bits(vector[i]))
+	}
+	return index.store.Bucket(index.getBucketName()).Put(key, value)
+}
+
+// vectorByID returns nil if the vector does not exist.
+func (index *ivf) vectorByID(id uint64) ([]float32, error) {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	value, err := index.store.Bucket(index.getBucketName()).Get(key)
+	if err != nil && !errors.Is(err, entlsmkv.NotFound) {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+	return float32SliceFromByteSlice(value, make([]float32, len(value)/4)), nil
+}
+
+func float32SliceFromByteSlice(vector []byte, slice []float32) []float32 {
+	for i := range slice {
+		slice[i] = math.Float32frombits(binary.LittleEndian.Uint32(vector[i*4:]))
+	}
+	return slice
+}
+
+// assign adds the vector to the list of its closest centroid. The caller
+// must hold the trainLock.
+func (index *ivf) assign(id uint64, vector []float32) error {
+	list, err := index.nearestCentroid(vector)
+	if err != nil {
+		return err
+	}
+	code := index.quantizer.encode(vector)
+
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	value := make([]byte, 4+len(code))
+	binary.LittleEndian.PutUint32(value, list)
+	copy(value[4:], code)
+	if err := index.store.Bucket(index.getCodesBucketName()).Put(key, value); err != nil {
+		return errors.Wrapf(err, "store code of vector %d", id)
+	}
+
+	index.lists.add(list, id, code)
+	return nil
+}
+
+func (index *ivf) nearestCentroid(vector []float32) (uint32, error) {
+	var (
+		nearest uint32
+		minDist = float32(math.MaxFloat32)
+	)
+	for i, centroid := range index.centroids {
+		dist, err := index.distancerProvider.SingleDist(vector, centroid)
+		if err != nil {
+			return 0, errors.Wrap(err, "distance to centroid")
+		}
+		if dist < minDist {
+			minDist, nearest = dist, uint32(i)
+		}
+	}
+	return nearest, nil
+}
+
+func (index *ivf) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	return errors.Errorf("AddMulti is not supported for ivf index")
+}
+
+func (index *ivf) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	return errors.Errorf("AddMultiBatch is not supported for ivf index")
+}
+
+func (index *ivf) Delete(ids ...uint64) error {
+	index.trainLock.RLock()
+	defer index.trainLock.RUnlock()
+
+	for _, id := range ids {
+		key := make([]byte, 8)
+		binary.BigEndian.PutUint64(key, id)
+
+		existing, err := index.vectorByID(id)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			continue
+		}
+		if err := index.store.Bucket(index.getBucketName()).Delete(key); err != nil {
+			return err
+		}
+		atomic.AddUint64(&index.count, ^uint64(0))
+		if !index.trained.Load() {
+			continue
+		}
+		if err := index.store.Bucket(index.getCodesBucketName()).Delete(key); err != nil {
+			return err
+		}
+		index.lists.remove(id)
+	}
+	return nil
+}
+
+func (index *ivf) DeleteMulti(ids ...uint64) error {
+	return errors.Errorf("DeleteMulti is not supported for ivf index")
+}
+
+func (index *ivf) Compressed() bool {
+	return index.trained.Load()
+}
+
+// ShouldUpgrade, Upgraded and Upgrade allow wrapping the index in a dynamic
+// index. The training is triggered by the imports, so there is nothing to
+// upgrade afterwards.
+func (index *ivf) ShouldUpgrade() (bool, int) {
+	return false, 0
+}
+
+func (index *ivf) Upgraded() bool {
+	return index.trained.Load()
+}
+
+func (index *ivf) Upgrade(callback func()) error {
+	callback()
+	return nil
+}
+
+func (index *ivf) Multivector() bool {
+	return false
+}
+
+func (index *ivf) Drop(ctx context.Context) error {
+	index.stopTraining()
+	if err := index.removeMetadataFile(); err != nil {
+		return err
+	}
+	// Shard::drop will take care of handling store's buckets
+	return nil
+}
+
+func (index *ivf) Flush() error {
+	// nothing to do here
+	// Shard will take care of handling store's buckets
+	return nil
+}
+
+func (index *ivf) Shutdown(ctx context.Context) error {
+	index.stopTraining()
+	// Shard::shutdown will take care of handling store's buckets
+	return nil
+}
+
+func (index *ivf) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+// ListFiles returns the metadata file holding the centroids and quantizer.
+// The vectors and codes live in buckets of the shard's store, which are part
+// of backups anyway.
+func (index *ivf) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	var files []string
+
+	fullPath := filepath.Join(index.rootPath, index.getMetadataFile())
+	if _, err := os.Stat(fullPath); err == nil {
+		relPath, err := filepath.Rel(basePath, fullPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get relative path: %w", err)
+		}
+		// If the file doesn't exist, we simply don't add it to the list
+		files = append(files, relPath)
+	}
+
+	return files, nil
+}
+
+func (index *ivf) GetKeys(id uint64) (uint64, uint64, error) {
+	return 0, 0, errors.Errorf("GetKeys is not supported for ivf index")
+}
+
+func (index *ivf) ValidateBeforeInsert(vector []float32) error {
+	dims := int(atomic.LoadInt32(&index.dims))
+	if dims != 0 && dims != len(vector) {
+		return errors.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+	return nil
+}
+
+func (index *ivf) ValidateMultiBeforeInsert(vector [][]float32) error {
+	return errors.Errorf("multi vectors are not supported for ivf index")
+}
+
+// PostStartup loads the inverted lists of a trained index into memory, or
+// counts the vectors imported so far otherwise.
+func (index *ivf) PostStartup() {
+	if index.trained.Load() {
+		index.loadLists()
+		return
+	}
+
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	count := uint64(0)
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		count++
+	}
+	cursor.Close()
+	atomic.StoreUint64(&index.count, count)
+
+	// the vectors were either imported by a flat index before a dynamic upgrade
+	// or the training was interrupted by a restart
+	if count >= uint64(index.trainingConfig.TrainingThreshold) {
+		if err := index.train(index.shutdownCtx); err != nil {
+			index.logger.WithField("action", "ivf_post_startup").
+				WithError(err).Error("could not train ivf index")
+		}
+	}
+}
+
+func (index *ivf) loadLists() {
+	index.trainLock.Lock()
+	defer index.trainLock.Unlock()
+
+	cursor := index.store.Bucket(index.getCodesBucketName()).Cursor()
+	defer cursor.Close()
+	for key, value := cursor.First(); key != nil; key, value = cursor.Next() {
+		if len(value) != 4+index.lists.codeSize {
+			index.logger.WithField("action", "ivf_post_startup").
+				Warnf("skipping code of unexpected length %d", len(value))
+			continue
+		}
+		list := binary.LittleEndian.Uint32(value)
+		if int(list) >= len(index.lists.lists) {
+			continue
+		}
+		code := make([]byte, len(value)-4)
+		copy(code, value[4:])
+		index.lists.add(list, binary.BigEndian.Uint64(key), code)
+	}
+	atomic.StoreUint64(&index.count, uint64(index.lists.count()))
+
+	index.logger.WithFields(logrus.Fields{
+		"action":   "ivf_post_startup",
+		"count":    index.lists.count(),
+		"index_id": index.id,
+	}).Debug("loaded inverted lists")
+}
+
+func (index *ivf) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	return index.distancerProvider.SingleDist(x, y)
+}
+
+func (index *ivf) ContainsDoc(id uint64) bool {
+	vec, err := index.vectorByID(id)
+	return err == nil && vec != nil
+}
+
+func (index *ivf) Iterate(fn func(docID uint64) bool) {
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	defer cursor.Close()
+
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		if !fn(binary.BigEndian.Uint64(key)) {
+			break
+		}
+	}
+}
+
+func (index *ivf) DistancerProvider() distancer.Provider {
+	return index.distancerProvider
+}
+
+func (index *ivf) AlreadyIndexed() uint64 {
+	return atomic.LoadUint64(&index.count)
+}
+
+func (index *ivf) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	queryVector = index.normalized(queryVector)
+	distFunc := func(id uint64) (float32, error) {
+		vec, err := index.vectorByID(id)
+		if err != nil {
+			return 0, err
+		}
+		if vec == nil {
+			return 0, fmt.Errorf("vector %d is not in the vector index", id)
+		}
+		return index.distancerProvider.SingleDist(queryVector, vec)
+	}
+	return common.QueryVectorDistancer{DistanceFunc: distFunc}
+}
+
+func (index *ivf) QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVectorDistancer {
+	return common.QueryVectorDistancer{}
+}
+
+func (index *ivf) Stats() (common.IndexStats, error) {
+	index.trainLock.RLock()
+	defer index.trainLock.RUnlock()
+
+	stats := &IVFStats{
+		Dimensions: int(atomic.LoadInt32(&index.dims)),
+		Trained:    index.trained.Load(),
+		Vectors:    int(atomic.LoadUint64(&index.count)),
+	}
+	if stats.Trained {
+		stats.Lists = len(index.lists.lists)
+		stats.Vectors = index.lists.count()
+		stats.Compression = index.quantizer.kind()
+	}
+	return stats, nil
+}
+
+type IVFStats struct {
+	Dimensions  int    `json:"dimensions"`
+	Trained     bool   `json:"trained"`
+	Lists       int    `json:"lists"`
+	Vectors     int    `json:"vectors"`
+	Compression string `json:"compression,omitempty"`
+}
+
+func (s *IVFStats) IndexType() common.IndexType {
+	return common.IndexTypeIVF
+}
@@ -0,0 +1,345 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"context"
+	"math/rand"
+	randv2 "math/rand/v2"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+)
+
+// clusteredVectors resembles embeddings more closely than uniformly random
+// vectors, which would not benefit from being clustered into lists.
+func clusteredVectors(r *rand.Rand, centers [][]float32, size int) [][]float32 {
+	vectors := make([][]float32, size)
+	for i := range vectors {
+		center := centers[r.Intn(len(centers))]
+		vectors[i] = make([]float32, len(center))
+		for j := range vectors[i] {
+			vectors[i][j] = center[j] + float32(r.NormFloat64())*0.3
+		}
+	}
+	return vectors
+}
+
+func randomCenters(r *rand.Rand, size, dims int) [][]float32 {
+	centers := make([][]float32, size)
+	for i := range centers {
+		centers[i] = make([]float32, dims)
+		for j := range centers[i] {
+			centers[i][j] = r.Float32()*2 - 1
+		}
+	}
+	return centers
+}
+
+func newTestStore(t *testing.T, dir string) *lsmkv.Store {
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dir, dir, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	return store
+}
+
+func newTestUserConfig() ent.UserConfig {
+	uc := ent.NewDefaultUserConfig()
+	uc.NLists = 16
+	uc.NProbe = 4
+	uc.TrainingThreshold = 500
+	uc.RescoreLimit = 100
+	uc.FlatSearchCutoff = 50
+	uc.PQ.Segments = 16
+	uc.PQ.Centroids = 64
+	return uc
+}
+
+func newTestIndex(t *testing.T, dir string, store *lsmkv.Store, uc ent.UserConfig) *ivf {
+	logger, _ := test.NewNullLogger()
+	index, err := New(Config{
+		ID:               "ivf-test",
+		RootPath:         dir,
+		ClassName:        "Class",
+		ShardName:        "shard",
+		Logger:           logger,
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+		Seed:             42,
+	}, uc, store)
+	require.Nil(t, err)
+	return index
+}
+
+func recall(t *testing.T, index *ivf, vectors, queries [][]float32, k int,
+	allow helpers.AllowList,
+) float32 {
+	var hits int
+	for _, query := range queries {
+		results, _, err := index.SearchByVector(context.Background(), query, k, allow)
+		require.Nil(t, err)
+
+		expected := bruteForce(vectors, query, k, allow)
+		hits += int(testinghelpers.MatchesInLists(expected, results))
+	}
+	return float32(hits) / float32(k*len(queries))
+}
+
+// bruteForce considers nil vectors as deleted.
+func bruteForce(vectors [][]float32, query []float32, k int, allow helpers.AllowList) []uint64 {
+	heap := make([]struct {
+		id   uint64
+		dist float32
+	}, 0, len(vectors))
+	for i, vec := range vectors {
+		if vec == nil || (allow != nil && !allow.Contains(uint64(i))) {
+			continue
+		}
+		dist, _ := distancer.NewL2SquaredProvider().SingleDist(query, vec)
+		heap = append(heap, struct {
+			id   uint64
+			dist float32
+		}{uint64(i), dist})
+	}
+	for i := 0; i < k && i < len(heap); i++ {
+		for j := i + 1; j < len(heap); j++ {
+			if heap[j].dist < heap[i].dist {
+				heap[i], heap[j] = heap[j], heap[i]
+			}
+		}
+	}
+	ids := make([]uint64, 0, k)
+	for i := 0; i < k && i < len(heap); i++ {
+		ids = append(ids, heap[i].id)
+	}
+	return ids
+}
+
+func TestIVF(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(42))
+	centers := randomCenters(r, 20, 64)
+	vectors := clusteredVectors(r, centers, 2000)
+	queries := clusteredVectors(r, centers, 20)
+	k := 10
+
+	dir := t.TempDir()
+	store := newTestStore(t, dir)
+	uc := newTestUserConfig()
+	index := newTestIndex(t, dir, store, uc)
+
+	t.Run("exact search before training", func(t *testing.T) {
+		for i := 0; i < uc.TrainingThreshold-1; i++ {
+			require.Nil(t, index.Add(ctx, uint64(i), vectors[i]))
+		}
+		assert.False(t, index.Compressed())
+		assert.Equal(t, float32(1), recall(t, index, vectors[:uc.TrainingThreshold-1], queries, k, nil))
+	})
+
+	t.Run("training on reaching the threshold", func(t *testing.T) {
+		require.Nil(t, index.Add(ctx, uint64(uc.TrainingThreshold-1), vectors[uc.TrainingThreshold-1]))
+		// the training runs in the background, waiting for it keeps the
+		// training sample deterministic
+		index.trainingWg.Wait()
+		assert.True(t, index.Compressed())
+		for i := uc.TrainingThreshold; i < len(vectors); i++ {
+			require.Nil(t, index.Add(ctx, uint64(i), vectors[i]))
+		}
+		assert.Equal(t, uint64(len(vectors)), index.AlreadyIndexed())
+		assert.Len(t, index.centroids, uc.NLists)
+		assert.Equal(t, len(vectors), index.lists.count())
+		assert.Greater(t, recall(t, index, vectors, queries, k, nil), float32(0.9))
+	})
+
+	t.Run("exact distances", func(t *testing.T) {
+		ids, dists, err := index.SearchByVector(ctx, vectors[5], 1, nil)
+		require.Nil(t, err)
+		require.Len(t, ids, 1)
+		assert.Equal(t, uint64(5), ids[0])
+		assert.Equal(t, float32(0), dists[0])
+	})
+
+	t.Run("probing more lists", func(t *testing.T) {
+		updated := uc
+		updated.NProbe = uc.NLists
+		updated.RescoreLimit = 200
+		require.Nil(t, index.UpdateUserConfig(updated, func() {}))
+		assert.Greater(t, recall(t, index, vectors, queries, k, nil), float32(0.95))
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+	})
+
+	t.Run("filtered search", func(t *testing.T) {
+		// below the flat search cutoff
+		small := helpers.NewAllowList(1, 3, 5, 7, 9, 11)
+		assert.Equal(t, float32(1), recall(t, index, vectors, queries, 3, small))
+
+		var ids []uint64
+		for i := 0; i < len(vectors); i += 10 {
+			ids = append(ids, uint64(i))
+		}
+		large := helpers.NewAllowList(ids...)
+		for _, query := range queries {
+			results, _, err := index.SearchByVector(ctx, query, k, large)
+			require.Nil(t, err)
+			// the probing continues until enough allowed candidates are found
+			assert.Len(t, results, k)
+			for _, id := range results {
+				assert.True(t, large.Contains(id))
+			}
+		}
+		assert.Greater(t, recall(t, index, vectors, queries, k, large), float32(0.8))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		for i := 0; i < len(vectors); i += 3 {
+			require.Nil(t, index.Delete(uint64(i)))
+			assert.False(t, index.ContainsDoc(uint64(i)))
+			vectors[i] = nil
+		}
+		assert.Equal(t, len(vectors)-667, index.lists.count())
+		assert.Greater(t, recall(t, index, vectors, queries, k, nil), float32(0.9))
+	})
+
+	t.Run("restart", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(ctx))
+		require.Nil(t, store.Shutdown(ctx))
+
+		store = newTestStore(t, dir)
+		index = newTestIndex(t, dir, store, uc)
+		index.PostStartup()
+
+		assert.True(t, index.Compressed())
+		assert.Equal(t, uint64(len(vectors)-667), index.AlreadyIndexed())
+		assert.Greater(t, recall(t, index, vectors, queries, k, nil), float32(0.9))
+
+		files, err := index.ListFiles(ctx, dir)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"ivf_meta.db"}, files)
+	})
+
+	require.Nil(t, index.Shutdown(ctx))
+	require.Nil(t, store.Shutdown(ctx))
+}
+
+func TestIVFWithBQ(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(7))
+	centers := randomCenters(r, 20, 64)
+	vectors := clusteredVectors(r, centers, 1000)
+	queries := clusteredVectors(r, centers, 20)
+	k := 10
+
+	dir := t.TempDir()
+	store := newTestStore(t, dir)
+	uc := newTestUserConfig()
+	uc.PQ.Enabled = false
+	uc.BQ.Enabled = true
+	uc.RescoreLimit = 100
+	index := newTestIndex(t, dir, store, uc)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(i), vec))
+		if i == uc.TrainingThreshold-1 {
+			index.trainingWg.Wait()
+		}
+	}
+	assert.True(t, index.Compressed())
+	assert.Greater(t, recall(t, index, vectors, queries, k, nil), float32(0.8))
+
+	require.Nil(t, store.Shutdown(ctx))
+	store = newTestStore(t, dir)
+	index = newTestIndex(t, dir, store, uc)
+	index.PostStartup()
+	assert.Greater(t, recall(t, index, vectors, queries, k, nil), float32(0.8))
+
+	require.Nil(t, store.Shutdown(ctx))
+}
+
+func TestIVFCount(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(3))
+	vectors := clusteredVectors(r, randomCenters(r, 4, 16), 100)
+
+	dir := t.TempDir()
+	store := newTestStore(t, dir)
+	uc := newTestUserConfig()
+	uc.PQ.Segments = 4
+	index := newTestIndex(t, dir, store, uc)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(i), vec))
+	}
+	assert.Equal(t, uint64(100), index.AlreadyIndexed())
+
+	// updating a vector does not count it twice
+	for i := 0; i < 10; i++ {
+		require.Nil(t, index.Add(ctx, uint64(i), vectors[99-i]))
+	}
+	assert.Equal(t, uint64(100), index.AlreadyIndexed())
+
+	require.Nil(t, index.Delete(0, 1, 2))
+	assert.Equal(t, uint64(97), index.AlreadyIndexed())
+	// deleting vectors which do not exist does not change the count
+	require.Nil(t, index.Delete(0, 1000))
+	assert.Equal(t, uint64(97), index.AlreadyIndexed())
+
+	require.Nil(t, index.Shutdown(ctx))
+	require.Nil(t, store.Shutdown(ctx))
+}
+
+func TestIVFSampleVectors(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(5))
+	vectors := clusteredVectors(r, randomCenters(r, 4, 8), 1000)
+
+	dir := t.TempDir()
+	store := newTestStore(t, dir)
+	uc := newTestUserConfig()
+	uc.TrainingThreshold = 10_000
+	index := newTestIndex(t, dir, store, uc)
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(i), vec))
+	}
+
+	sample, err := index.sampleVectors(100, randv2.New(randv2.NewPCG(1, 2)))
+	require.Nil(t, err)
+	require.Len(t, sample, 100)
+
+	// the sample is drawn from all vectors, not just the ones with the lowest ids
+	fromTail := 0
+	for _, vec := range sample {
+		for _, tail := range vectors[500:] {
+			if vec[0] == tail[0] && vec[1] == tail[1] {
+				fromTail++
+				break
+			}
+		}
+	}
+	assert.Greater(t, fromTail, 25)
+
+	again, err := index.sampleVectors(100, randv2.New(randv2.NewPCG(1, 2)))
+	require.Nil(t, err)
+	assert.Equal(t, sample, again)
+
+	require.Nil(t, index.Shutdown(ctx))
+	require.Nil(t, store.Shutdown(ctx))
+}
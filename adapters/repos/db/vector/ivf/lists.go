@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"sync"
+)
+
+// invertedList holds the compressed vectors assigned to a single centroid.
+// The codes are stored back to back to keep the memory overhead per vector
+// low and scans cache friendly.
+type invertedList struct {
+	sync.RWMutex
+	ids   []uint64
+	codes []byte
+}
+
+func (l *invertedList) add(id uint64, code []byte) int {
+	l.Lock()
+	defer l.Unlock()
+	l.ids = append(l.ids, id)
+	l.codes = append(l.codes, code...)
+	return len(l.ids) - 1
+}
+
+// remove deletes the entry at pos by moving the last entry into its place.
+// It returns the id of the moved entry, moved is false if pos was the last.
+func (l *invertedList) remove(pos, codeSize int) (movedID uint64, moved bool) {
+	l.Lock()
+	defer l.Unlock()
+
+	last := len(l.ids) - 1
+	if pos != last {
+		l.ids[pos] = l.ids[last]
+		copy(l.codes[pos*codeSize:(pos+1)*codeSize], l.codes[last*codeSize:])
+		movedID, moved = l.ids[pos], true
+	}
+	l.ids = l.ids[:last]
+	l.codes = l.codes[:last*codeSize]
+	return movedID, moved
+}
+
+func (l *invertedList) len() int {
+	l.RLock()
+	defer l.RUnlock()
+	return len(l.ids)
+}
+
+type position struct {
+	list   uint32
+	offset uint32
+}
+
+// invertedLists keeps track of the position of every vector, so that it can
+// be removed from its list again. Locks are always acquired in the order
+// positionsLock before the lock of a list.
+type invertedLists struct {
+	lists    []*invertedList
+	codeSize int
+
+	positionsLock sync.Mutex
+	positions     map[uint64]position
+}
+
+func newInvertedLists(count, codeSize int) *invertedLists {
+	lists := make([]*invertedList, count)
+	for i := range lists {
+		lists[i] = &invertedList{}
+	}
+	return &invertedLists{
+		lists:     lists,
+		codeSize:  codeSize,
+		positions: map[uint64]position{},
+	}
+}
+
+// add inserts or replaces the code of the vector with the given id.
+func (l *invertedLists) add(list uint32, id uint64, code []byte) {
+	l.positionsLock.Lock()
+	defer l.positionsLock.Unlock()
+
+	l.removeNoLock(id)
+	offset := l.lists[list].add(id, code)
+	l.positions[id] = position{list: list, offset: uint32(offset)}
+}
+
+func (l *invertedLists) remove(id uint64) {
+	l.positionsLock.Lock()
+	defer l.positionsLock.Unlock()
+	l.removeNoLock(id)
+}
+
+func (l *invertedLists) removeNoLock(id uint64) {
+	pos, ok := l.positions[id]
+	if !ok {
+		return
+	}
+	delete(l.positions, id)
+	if movedID, moved := l.lists[pos.list].remove(int(pos.offset), l.codeSize); moved {
+		l.positions[movedID] = pos
+	}
+}
+
+func (l *invertedLists) contains(id uint64) bool {
+	l.positionsLock.Lock()
+	defer l.positionsLock.Unlock()
+	_, ok := l.positions[id]
+	return ok
+}
+
+func (l *invertedLists) count() int {
+	l.positionsLock.Lock()
+	defer l.positionsLock.Unlock()
+	return len(l.positions)
+}
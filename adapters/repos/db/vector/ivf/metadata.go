@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	metadataPrefix       = "ivf_meta"
+	vectorMetadataBucket = "vector"
+	dimensionsKey        = "dimensions"
+	centroidsKey         = "centroids"
+	quantizerKindKey     = "quantizer_kind"
+	quantizerKey         = "quantizer"
+)
+
+func (index *ivf) getMetadataFile() string {
+	if index.targetVector != "" {
+		// This may be redundant as target vector is already validated in the schema
+		cleanTarget := filepath.Clean(index.targetVector)
+		cleanTarget = filepath.Base(cleanTarget)
+		return fmt.Sprintf("%s_%s.db", metadataPrefix, cleanTarget)
+	}
+	return fmt.Sprintf("%s.db", metadataPrefix)
+}
+
+func (index *ivf) removeMetadataFile() error {
+	path := filepath.Join(index.rootPath, index.getMetadataFile())
+	index.closeMetadata()
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "remove metadata file %q", path)
+	}
+	return nil
+}
+
+func (index *ivf) closeMetadata() {
+	index.metadataLock.Lock()
+	defer index.metadataLock.Unlock()
+
+	if index.metadata != nil {
+		index.metadata.Close()
+		index.metadata = nil
+	}
+}
+
+func (index *ivf) openMetadata() error {
+	index.metadataLock.Lock()
+	defer index.metadataLock.Unlock()
+
+	if index.metadata != nil {
+		return nil // Already open
+	}
+
+	path := filepath.Join(index.rootPath, index.getMetadataFile())
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open %q", path)
+	}
+
+	index.metadata = db
+	return nil
+}
+
+// initMetadata restores the dimensions and, if the index has been trained,
+// the centroids and the quantizer.
+func (index *ivf) initMetadata() error {
+	err := index.openMetadata()
+	if err != nil {
+		return err
+	}
+	defer index.closeMetadata()
+
+	var dims, centroids, quantizerKind, quantizerData []byte
+	err = index.metadata.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(vectorMetadataBucket))
+		if err != nil {
+			return errors.Wrap(err, "create bucket")
+		}
+		if b == nil {
+			return errors.New("failed to create or get bucket")
+		}
+		dims = copyBytes(b.Get([]byte(dimensionsKey)))
+		centroids = copyBytes(b.Get([]byte(centroidsKey)))
+		quantizerKind = copyBytes(b.Get([]byte(quantizerKindKey)))
+		quantizerData = copyBytes(b.Get([]byte(quantizerKey)))
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "init metadata bucket")
+	}
+
+	if len(dims) == 4 {
+		index.trackDimensionsOnce.Do(func() {
+			atomic.StoreInt32(&index.dims, int32(binary.LittleEndian.Uint32(dims)))
+		})
+	}
+
+	if centroids == nil {
+		return nil
+	}
+	index.centroids, err = unmarshalCentroids(centroids)
+	if err != nil {
+		return errors.Wrap(err, "restore centroids")
+	}
+	index.quantizer, err = unmarshalQuantizer(string(quantizerKind), quantizerData,
+		index.distancerProvider, index.logger)
+	if err != nil {
+		return errors.Wrap(err, "restore quantizer")
+	}
+	index.lists = newInvertedLists(len(index.centroids), index.quantizer.codeSize())
+	index.trained.Store(true)
+	return nil
+}
+
+// copyBytes copies values read from bolt, which are only valid during the
+// transaction.
+func copyBytes(in []byte) []byte {
+	if in == nil {
+		return nil
+	}
+	out := make([]byte, len(in))
+	copy(out, in)
+	return out
+}
+
+func (index *ivf) setDimensions(dimensions int32) error {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(dimensions))
+	if err := index.putMetadata(map[string][]byte{dimensionsKey: buf}); err != nil {
+		return errors.Wrap(err, "set dimensions")
+	}
+	return nil
+}
+
+// setTrained persists the state of a trained index in a single transaction.
+func (index *ivf) setTrained(centroids [][]float32, q quantizer) error {
+	if err := index.putMetadata(map[string][]byte{
+		centroidsKey:     marshalCentroids(centroids),
+		quantizerKindKey: []byte(q.kind()),
+		quantizerKey:     q.marshal(),
+	}); err != nil {
+		return errors.Wrap(err, "persist trained index")
+	}
+	return nil
+}
+
+func (index *ivf) putMetadata(values map[string][]byte) error {
+	err := index.openMetadata()
+	if err != nil {
+		return err
+	}
+	defer index.closeMetadata()
+
+	return index.metadata.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(vectorMetadataBucket))
+		if b == nil {
+			return errors.New("failed to get bucket")
+		}
+		for key, value := range values {
+			if err := b.Put([]byte(key), value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// marshalCentroids encodes the centroids as
+// [uint32 count][uint32 dims][count * dims float32]
+func marshalCentroids(centroids [][]float32) []byte {
+	dims := 0
+	if len(centroids) > 0 {
+		dims = len(centroids[0])
+	}
+	out := make([]byte, 8+len(centroids)*dims*4)
+	binary.LittleEndian.PutUint32(out, uint32(len(centroids)))
+	binary.LittleEndian.PutUint32(out[4:], uint32(dims))
+	pos := 8
+	for _, centroid := range centroids {
+		for _, v := range centroid {
+			binary.LittleEndian.PutUint32(out[pos:], math.Float32bits(v))
+			pos += 4
+		}
+	}
+	return out
+}
+
+func unmarshalCentroids(data []byte) ([][]float32, error) {
+	if len(data) < 8 {
+		return nil, fmt.Errorf("invalid centroids of length %d", len(data))
+	}
+	count := int(binary.LittleEndian.Uint32(data))
+	dims := int(binary.LittleEndian.Uint32(data[4:]))
+	if count == 0 || len(data) != 8+count*dims*4 {
+		return nil, fmt.Errorf("invalid centroids of length %d", len(data))
+	}
+
+	centroids := make([][]float32, count)
+	pos := 8
+	for i := range centroids {
+		centroids[i] = make([]float32, dims)
+		for j := range centroids[i] {
+			centroids[i][j] = math.Float32frombits(binary.LittleEndian.Uint32(data[pos:]))
+			pos += 4
+		}
+	}
+	return centroids, nil
+}
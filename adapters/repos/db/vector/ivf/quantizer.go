@@ -0,0 +1,237 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+)
+
+const (
+	quantizerPQ = "pq"
+	quantizerBQ = "bq"
+)
+
+// quantizer compresses the vectors held in memory by the inverted lists. All
+// codes of a quantizer have the same length.
+type quantizer interface {
+	// fit trains the quantizer on a sample of the vectors
+	fit(data [][]float32) error
+	encode(vec []float32) []byte
+	codeSize() int
+	// distancer returns the distance between the query and a code, release
+	// must be called once the distancer is no longer used
+	distancer(query []float32) (dist func(code []byte) (float32, error), release func())
+	// marshal returns the state restored by unmarshalQuantizer
+	marshal() []byte
+	kind() string
+}
+
+// newQuantizer creates the quantizer configured by uc, seed makes fitting it
+// deterministic.
+func newQuantizer(uc ent.UserConfig, provider distancer.Provider, dims int,
+	seed uint64, logger logrus.FieldLogger,
+) (quantizer, error) {
+	if uc.BQ.Enabled {
+		return newBQQuantizer(dims), nil
+	}
+
+	segments := uc.PQ.Segments
+	if segments <= 0 {
+		segments = common.CalculateOptimalSegments(dims)
+	}
+	pq, err := compressionhelpers.NewProductQuantizer(pqConfig(segments, uc.PQ.Centroids),
+		provider, dims, logger)
+	if err != nil {
+		return nil, err
+	}
+	pq.SetSeed(seed)
+	return &pqQuantizer{pq: pq, segments: segments, centroids: uc.PQ.Centroids, dims: dims}, nil
+}
+
+func pqConfig(segments, centroids int) hnswent.PQConfig {
+	return hnswent.PQConfig{
+		Enabled:   true,
+		Segments:  segments,
+		Centroids: centroids,
+		Encoder: hnswent.PQEncoder{
+			// only kmeans encoders can be persisted by the ivf index
+			Type:         hnswent.PQEncoderTypeKMeans,
+			Distribution: hnswent.PQEncoderDistributionLogNormal,
+		},
+	}
+}
+
+func unmarshalQuantizer(kind string, data []byte, provider distancer.Provider,
+	logger logrus.FieldLogger,
+) (quantizer, error) {
+	switch kind {
+	case quantizerBQ:
+		if len(data) != 4 {
+			return nil, fmt.Errorf("invalid bq quantizer data of length %d", len(data))
+		}
+		return newBQQuantizer(int(binary.LittleEndian.Uint32(data))), nil
+	case quantizerPQ:
+		return unmarshalPQQuantizer(data, provider, logger)
+	default:
+		return nil, fmt.Errorf("unknown quantizer %q", kind)
+	}
+}
+
+type pqQuantizer struct {
+	pq        *compressionhelpers.ProductQuantizer
+	segments  int
+	centroids int
+	dims      int
+}
+
+func (q *pqQuantizer) fit(data [][]float32) error {
+	return q.pq.Fit(data)
+}
+
+func (q *pqQuantizer) encode(vec []float32) []byte {
+	return q.pq.Encode(vec)
+}
+
+func (q *pqQuantizer) codeSize() int {
+	return q.segments
+}
+
+func (q *pqQuantizer) distancer(query []float32) (func(code []byte) (float32, error), func()) {
+	d := q.pq.NewDistancer(query)
+	return d.Distance, func() { q.pq.ReturnDistancer(d) }
+}
+
+func (q *pqQuantizer) kind() string {
+	return quantizerPQ
+}
+
+// marshal encodes the segment centers as
+// [uint32 segments][uint32 centroids][uint32 dims][segments * centroids * dims/segments float32]
+func (q *pqQuantizer) marshal() []byte {
+	out := make([]byte, 12, 12+q.centroids*q.dims*4)
+	binary.LittleEndian.PutUint32(out, uint32(q.segments))
+	binary.LittleEndian.PutUint32(out[4:], uint32(q.centroids))
+	binary.LittleEndian.PutUint32(out[8:], uint32(q.dims))
+	recorder := &pqRecorder{}
+	q.pq.PersistCompression(recorder)
+	for _, encoder := range recorder.data.Encoders {
+		out = append(out, encoder.ExposeDataForRestore()...)
+	}
+	return out
+}
+
+// pqRecorder captures the state the product quantizer would write to the
+// hnsw commit log.
+type pqRecorder struct {
+	data compressionhelpers.PQData
+}
+
+func (r *pqRecorder) AddPQCompression(data compressionhelpers.PQData) error {
+	r.data = data
+	return nil
+}
+
+func (r *pqRecorder) AddSQCompression(compressionhelpers.SQData) error {
+	return fmt.Errorf("sq is not supported by the ivf index")
+}
+
+func (r *pqRecorder) AddRQCompression(compressionhelpers.RQData) error {
+	return fmt.Errorf("rq is not supported by the ivf index")
+}
+
+func unmarshalPQQuantizer(data []byte, provider distancer.Provider,
+	logger logrus.FieldLogger,
+) (quantizer, error) {
+	if len(data) < 12 {
+		return nil, fmt.Errorf("invalid pq quantizer data of length %d", len(data))
+	}
+	segments := int(binary.LittleEndian.Uint32(data))
+	centroids := int(binary.LittleEndian.Uint32(data[4:]))
+	dims := int(binary.LittleEndian.Uint32(data[8:]))
+	if segments == 0 || dims%segments != 0 || len(data) != 12+centroids*dims*4 {
+		return nil, fmt.Errorf("invalid pq quantizer data of length %d", len(data))
+	}
+
+	ds := dims / segments
+	pos := 12
+	encoders := make([]compressionhelpers.PQEncoder, segments)
+	for i := range encoders {
+		centers := make([][]float32, centroids)
+		for c := range centers {
+			centers[c] = make([]float32, ds)
+			for j := range centers[c] {
+				centers[c][j] = math.Float32frombits(binary.LittleEndian.Uint32(data[pos:]))
+				pos += 4
+			}
+		}
+		encoders[i] = compressionhelpers.NewKMeansEncoderWithCenters(centroids, ds, i, centers)
+	}
+
+	pq, err := compressionhelpers.NewProductQuantizerWithEncoders(pqConfig(segments, centroids),
+		provider, dims, encoders, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &pqQuantizer{pq: pq, segments: segments, centroids: centroids, dims: dims}, nil
+}
+
+type bqQuantizer struct {
+	bq   compressionhelpers.BinaryQuantizer
+	dims int
+}
+
+func newBQQuantizer(dims int) *bqQuantizer {
+	return &bqQuantizer{bq: compressionhelpers.NewBinaryQuantizer(nil), dims: dims}
+}
+
+func (q *bqQuantizer) fit(data [][]float32) error {
+	return nil
+}
+
+func (q *bqQuantizer) encode(vec []float32) []byte {
+	return q.bq.CompressedBytes(q.bq.Encode(vec))
+}
+
+func (q *bqQuantizer) codeSize() int {
+	return (q.dims + 63) / 64 * 8
+}
+
+func (q *bqQuantizer) distancer(query []float32) (func(code []byte) (float32, error), func()) {
+	encoded := q.bq.Encode(query)
+	buf := make([]uint64, len(encoded))
+	return func(code []byte) (float32, error) {
+		for i := range buf {
+			buf[i] = binary.LittleEndian.Uint64(code[i*8:])
+		}
+		return q.bq.DistanceBetweenCompressedVectors(encoded, buf)
+	}, func() {}
+}
+
+func (q *bqQuantizer) kind() string {
+	return quantizerBQ
+}
+
+func (q *bqQuantizer) marshal() []byte {
+	out := make([]byte, 4)
+	binary.LittleEndian.PutUint32(out, uint32(q.dims))
+	return out
+}
@@ -0,0 +1,291 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+func (index *ivf) SearchByVector(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	vector = index.normalized(vector)
+
+	index.trainLock.RLock()
+	defer index.trainLock.RUnlock()
+
+	smallAllowList := allow != nil && allow.Len() < int(atomic.LoadInt64(&index.flatSearchCutoff))
+	if !index.trained.Load() || smallAllowList {
+		helpers.AnnotateSlowQueryLog(ctx, "ivf_flat_search", true)
+		return index.exactSearch(ctx, vector, k, allow, smallAllowList)
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "ivf_flat_search", false)
+	return index.searchLists(ctx, vector, k, allow)
+}
+
+// exactSearch computes the distance to every (allowed) uncompressed vector.
+// Small allow lists are looked up by id, otherwise the bucket is scanned.
+func (index *ivf) exactSearch(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList, byID bool,
+) ([]uint64, []float32, error) {
+	heap := priorityqueue.NewMax[any](k)
+
+	if byID {
+		it := allow.Iterator()
+		for id, ok := it.Next(); ok; id, ok = it.Next() {
+			if err := ctx.Err(); err != nil {
+				return nil, nil, err
+			}
+			vec, err := index.vectorByID(id)
+			if err != nil {
+				return nil, nil, err
+			}
+			if vec == nil {
+				continue
+			}
+			dist, err := index.distancerProvider.SingleDist(vector, vec)
+			if err != nil {
+				return nil, nil, err
+			}
+			insertToHeap(heap, k, id, dist)
+		}
+		ids, dists := extractHeap(heap)
+		return ids, dists, nil
+	}
+
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	defer cursor.Close()
+
+	vec := make([]float32, atomic.LoadInt32(&index.dims))
+	for key, value := cursor.First(); key != nil; key, value = cursor.Next() {
+		id := binary.BigEndian.Uint64(key)
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+		if len(value) != len(vec)*4 {
+			continue
+		}
+		dist, err := index.distancerProvider.SingleDist(vector, float32SliceFromByteSlice(value, vec))
+		if err != nil {
+			return nil, nil, err
+		}
+		insertToHeap(heap, k, id, dist)
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
+
+// searchLists scans the compressed vectors of the nprobe lists closest to the
+// query and rescores the best candidates with the uncompressed vectors. If a
+// filter leaves fewer than k candidates, further lists are probed.
+func (index *ivf) searchLists(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	lists, err := index.rankLists(vector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	limit := int(atomic.LoadInt64(&index.rescoreLimit))
+	if limit < k {
+		limit = k
+	}
+	nProbe := int(atomic.LoadInt64(&index.nProbe))
+	codeSize := index.lists.codeSize
+
+	distance, release := index.quantizer.distancer(vector)
+	defer release()
+
+	candidates := priorityqueue.NewMax[any](limit)
+	for i, listID := range lists {
+		if i >= nProbe && candidates.Len() >= k {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+
+		list := index.lists.lists[listID]
+		list.RLock()
+		for j, id := range list.ids {
+			if allow != nil && !allow.Contains(id) {
+				continue
+			}
+			dist, err := distance(list.codes[j*codeSize : (j+1)*codeSize])
+			if err != nil {
+				list.RUnlock()
+				return nil, nil, errors.Wrap(err, "compressed distance")
+			}
+			insertToHeap(candidates, limit, id, dist)
+		}
+		list.RUnlock()
+	}
+
+	heap := priorityqueue.NewMax[any](k)
+	for candidates.Len() > 0 {
+		id := candidates.Pop().ID
+		vec, err := index.vectorByID(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if vec == nil {
+			// deleted concurrently
+			continue
+		}
+		dist, err := index.distancerProvider.SingleDist(vector, vec)
+		if err != nil {
+			return nil, nil, err
+		}
+		insertToHeap(heap, k, id, dist)
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
+
+// rankLists orders the lists by the distance of their centroid to the query.
+func (index *ivf) rankLists(vector []float32) ([]uint32, error) {
+	lists := make([]uint32, len(index.centroids))
+	dists := make([]float32, len(index.centroids))
+	for i, centroid := range index.centroids {
+		dist, err := index.distancerProvider.SingleDist(vector, centroid)
+		if err != nil {
+			return nil, errors.Wrap(err, "distance to centroid")
+		}
+		lists[i], dists[i] = uint32(i), dist
+	}
+	sort.Slice(lists, func(a, b int) bool {
+		return dists[lists[a]] < dists[lists[b]]
+	})
+	return lists, nil
+}
+
+func insertToHeap(heap *priorityqueue.Queue[any], limit int, id uint64, distance float32) {
+	if heap.Len() < limit {
+		heap.Insert(id, distance)
+	} else if heap.Top().Dist > distance {
+		heap.Pop()
+		heap.Insert(id, distance)
+	}
+}
+
+func extractHeap(heap *priorityqueue.Queue[any]) ([]uint64, []float32) {
+	len := heap.Len()
+
+	ids := make([]uint64, len)
+	dists := make([]float32, len)
+	for i := len - 1; i >= 0; i-- {
+		item := heap.Pop()
+		ids[i] = item.ID
+		dists[i] = item.Dist
+	}
+	return ids, dists
+}
+
+func (index *ivf) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByMultiVector is not supported for ivf index")
+}
+
+func (index *ivf) SearchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	var (
+		searchParams = newSearchByDistParams(maxLimit)
+
+		resultIDs  []uint64
+		resultDist []float32
+	)
+
+	recursiveSearch := func() (bool, error) {
+		totalLimit := searchParams.TotalLimit()
+		ids, dist, err := index.SearchByVector(ctx, vector, totalLimit, allow)
+		if err != nil {
+			return false, errors.Wrap(err, "vector search")
+		}
+
+		// if there is less results than given limit search can be stopped
+		shouldContinue := !(len(ids) < totalLimit)
+
+		// ensures the indexes aren't out of range
+		offsetCap := searchParams.OffsetCapacity(ids)
+		totalLimitCap := searchParams.TotalLimitCapacity(ids)
+
+		if offsetCap == totalLimitCap {
+			return false, nil
+		}
+
+		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
+		for i := range ids {
+			if aboveThresh := dist[i] <= targetDistance; aboveThresh ||
+				floatcomp.InDelta(float64(dist[i]), float64(targetDistance), 1e-6) {
+				resultIDs = append(resultIDs, ids[i])
+				resultDist = append(resultDist, dist[i])
+			} else {
+				// as soon as we encounter a certainty which
+				// is below threshold, we can stop searching
+				shouldContinue = false
+				break
+			}
+		}
+
+		return shouldContinue, nil
+	}
+
+	shouldContinue, err := recursiveSearch()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for shouldContinue {
+		searchParams.Iterate()
+		if searchParams.MaxLimitReached() {
+			index.logger.
+				WithField("action", "unlimited_vector_search").
+				Warnf("maximum search limit of %d results has been reached",
+					searchParams.MaximumSearchLimit())
+			break
+		}
+
+		shouldContinue, err = recursiveSearch()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return resultIDs, resultDist, nil
+}
+
+func (index *ivf) SearchByMultiVectorDistance(ctx context.Context, vector [][]float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByMultiVectorDistance is not supported for ivf index")
+}
+
+func newSearchByDistParams(maxLimit int64) *common.SearchByDistParams {
+	initialOffset := 0
+	initialLimit := common.DefaultSearchByDistInitialLimit
+
+	return common.NewSearchByDistParams(initialOffset, initialLimit, initialOffset+initialLimit, maxLimit)
+}
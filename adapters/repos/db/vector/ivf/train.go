@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"context"
+	"encoding/binary"
+	"math/rand/v2"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/kmeans"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// trainingSampleLimit caps the number of vectors the centroids and the
+// quantizer are trained on.
+const trainingSampleLimit = 100_000
+
+// trainInBackground starts training the index unless a training is running
+// already. Imports continue while the index is trained.
+func (index *ivf) trainInBackground() {
+	if index.shutdownCtx.Err() != nil || !index.training.CompareAndSwap(false, true) {
+		return
+	}
+	index.trainingWg.Add(1)
+	enterrors.GoWrapper(func() {
+		defer index.trainingWg.Done()
+		defer index.training.Store(false)
+
+		if err := index.train(index.shutdownCtx); err != nil {
+			index.logger.WithField("action", "ivf_train").
+				WithError(err).Error("could not train ivf index")
+		}
+	}, index.logger)
+}
+
+// stopTraining cancels a running training and waits for it to return
+func (index *ivf) stopTraining() {
+	index.shutdownCancel()
+	index.trainingWg.Wait()
+}
+
+// train clusters a sample of the vectors imported so far and assigns all of
+// them to the resulting lists. Imports are only blocked while the vectors are
+// assigned, vectors imported while the centroids are fitted are assigned as
+// well.
+func (index *ivf) train(ctx context.Context) error {
+	if index.trained.Load() {
+		return nil
+	}
+
+	before := time.Now()
+	rng := rand.New(rand.NewPCG(index.seed, index.seed))
+	sample, err := index.sampleVectors(trainingSampleLimit, rng)
+	if err != nil {
+		return errors.Wrap(err, "sample training vectors")
+	}
+	if len(sample) < index.minTrainingVectors() {
+		// vectors were deleted in the meantime, try again on a later import
+		return nil
+	}
+
+	dims := len(sample[0])
+	index.trackDimensionsOnce.Do(func() {
+		// the vectors were imported by a flat index before a dynamic upgrade
+		atomic.StoreInt32(&index.dims, int32(dims))
+		if err := index.setDimensions(int32(dims)); err != nil {
+			index.logger.WithError(err).Error("could not set dimensions")
+		}
+	})
+	km := kmeans.New(index.trainingConfig.NLists, dims, 0)
+	km.Seed = rng.Uint64()
+	if err := km.Fit(sample); err != nil {
+		return errors.Wrap(err, "fit centroids")
+	}
+
+	q, err := newQuantizer(index.trainingConfig, index.distancerProvider, dims, rng.Uint64(), index.logger)
+	if err != nil {
+		return errors.Wrap(err, "create quantizer")
+	}
+	if err := q.fit(sample); err != nil {
+		return errors.Wrap(err, "fit quantizer")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	index.trainLock.Lock()
+	defer index.trainLock.Unlock()
+
+	if index.trained.Load() {
+		return nil
+	}
+	index.centroids = km.Centers
+	index.quantizer = q
+	index.lists = newInvertedLists(len(km.Centers), q.codeSize())
+
+	if err := index.assignAll(ctx); err != nil {
+		return errors.Wrap(err, "assign vectors to lists")
+	}
+
+	// the codes are written before the centroids, an interrupted training is
+	// simply repeated after a restart
+	if err := index.setTrained(index.centroids, q); err != nil {
+		return err
+	}
+	index.trained.Store(true)
+
+	index.logger.WithFields(logrus.Fields{
+		"action":   "ivf_train",
+		"lists":    len(index.centroids),
+		"sample":   len(sample),
+		"vectors":  index.lists.count(),
+		"took":     time.Since(before),
+		"index_id": index.id,
+	}).Info("trained ivf index")
+	return nil
+}
+
+func (index *ivf) minTrainingVectors() int {
+	if index.trainingConfig.PQ.Enabled && index.trainingConfig.PQ.Centroids > index.trainingConfig.NLists {
+		return index.trainingConfig.PQ.Centroids
+	}
+	return index.trainingConfig.NLists
+}
+
+// sampleVectors draws a uniform sample of at most limit vectors using
+// reservoir sampling, so that the sample does not depend on the order of the
+// ids.
+func (index *ivf) sampleVectors(limit int, rng *rand.Rand) ([][]float32, error) {
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	defer cursor.Close()
+
+	var sample [][]float32
+	seen := 0
+	for key, value := cursor.First(); key != nil; key, value = cursor.Next() {
+		if len(value) == 0 {
+			continue
+		}
+		seen++
+		if len(sample) < limit {
+			sample = append(sample, float32SliceFromByteSlice(value, make([]float32, len(value)/4)))
+			continue
+		}
+		if i := rng.IntN(seen); i < limit {
+			sample[i] = float32SliceFromByteSlice(value, make([]float32, len(value)/4))
+		}
+	}
+	return sample, nil
+}
+
+func (index *ivf) assignAll(ctx context.Context) error {
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	defer cursor.Close()
+
+	vec := make([]float32, atomic.LoadInt32(&index.dims))
+	for key, value := cursor.First(); key != nil; key, value = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if len(value) != len(vec)*4 {
+			continue
+		}
+		if err := index.assign(binary.BigEndian.Uint64(key),
+			float32SliceFromByteSlice(value, vec)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
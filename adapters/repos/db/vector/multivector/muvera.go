@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package multivector contains encodings which turn multi vectors (e.g.
+// ColBERT token embeddings) into single vectors, so that they can be indexed
+// by the regular vector indexes.
+package multivector
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// muveraSeed makes the random partitions and projections deterministic, so
+// that encodings created before a restart remain comparable with the ones
+// created afterwards.
+const muveraSeed = 0x6d75766572
+
+// MuveraEncoder creates MUVERA fixed dimensional encodings (FDE) of multi
+// vectors, see https://arxiv.org/abs/2405.19504. The inner product of a
+// query and a document encoding approximates the late interaction (Chamfer)
+// similarity of the underlying multi vectors.
+//
+// Each repetition partitions the space into 2^ksim buckets using SimHash.
+// Documents average the vectors falling into each bucket, queries sum them.
+// Every bucket is projected to dprojections dimensions and all buckets of
+// all repetitions are concatenated.
+type MuveraEncoder struct {
+	ksim         int
+	dprojections int
+	repetitions  int
+
+	init sync.Once
+	dims atomic.Int32
+	// simhash holds repetitions * ksim gaussian vectors of the input dims
+	simhash [][]float32
+	// projections holds repetitions * dprojections random sign vectors of
+	// the input dims, scaled by 1/sqrt(dprojections)
+	projections [][]float32
+}
+
+func NewMuveraEncoder(cfg ent.MuveraConfig) *MuveraEncoder {
+	return &MuveraEncoder{
+		ksim:         cfg.KSim,
+		dprojections: cfg.DProjections,
+		repetitions:  cfg.Repetitions,
+	}
+}
+
+// Dimensions returns the number of dimensions of an encoding.
+func (e *MuveraEncoder) Dimensions() int {
+	return e.repetitions * e.buckets() * e.dprojections
+}
+
+// InputDimensions returns the dimensions of the vectors the encoder was
+// initialized with, 0 if nothing has been encoded yet.
+func (e *MuveraEncoder) InputDimensions() int {
+	return int(e.dims.Load())
+}
+
+func (e *MuveraEncoder) buckets() int {
+	return 1 << e.ksim
+}
+
+// initialize lazily creates the random matrices once the dimensions of the
+// multi vectors are known.
+func (e *MuveraEncoder) initialize(dims int) error {
+	e.init.Do(func() {
+		r := rand.New(rand.NewSource(muveraSeed))
+
+		e.simhash = make([][]float32, e.repetitions*e.ksim)
+		for i := range e.simhash {
+			e.simhash[i] = make([]float32, dims)
+			for j := range e.simhash[i] {
+				e.simhash[i][j] = float32(r.NormFloat64())
+			}
+		}
+
+		scale := float32(1 / math.Sqrt(float64(e.dprojections)))
+		e.projections = make([][]float32, e.repetitions*e.dprojections)
+		for i := range e.projections {
+			e.projections[i] = make([]float32, dims)
+			for j := range e.projections[i] {
+				if r.Intn(2) == 0 {
+					e.projections[i][j] = scale
+				} else {
+					e.projections[i][j] = -scale
+				}
+			}
+		}
+		e.dims.Store(int32(dims))
+	})
+
+	if initialized := e.InputDimensions(); initialized != dims {
+		return fmt.Errorf("muvera encoder was initialized for vectors with %d dimensions, got %d",
+			initialized, dims)
+	}
+	return nil
+}
+
+// EncodeDoc creates the encoding of a document's multi vector.
+func (e *MuveraEncoder) EncodeDoc(vecs [][]float32) ([]float32, error) {
+	return e.encode(vecs, true)
+}
+
+// EncodeQuery creates the encoding of a query's multi vector.
+func (e *MuveraEncoder) EncodeQuery(vecs [][]float32) ([]float32, error) {
+	return e.encode(vecs, false)
+}
+
+func (e *MuveraEncoder) encode(vecs [][]float32, isDoc bool) ([]float32, error) {
+	if len(vecs) == 0 {
+		return nil, fmt.Errorf("cannot encode empty multi vector")
+	}
+	for i := range vecs {
+		if len(vecs[i]) != len(vecs[0]) {
+			return nil, fmt.Errorf("multi vector consists of vectors with varying dimensions")
+		}
+	}
+	if err := e.initialize(len(vecs[0])); err != nil {
+		return nil, err
+	}
+
+	buckets := e.buckets()
+	out := make([]float32, e.Dimensions())
+	codes := make([]int, len(vecs))
+	counts := make([]int, buckets)
+	projected := make([][]float32, len(vecs))
+	for i := range projected {
+		projected[i] = make([]float32, e.dprojections)
+	}
+
+	for rep := 0; rep < e.repetitions; rep++ {
+		for i := range counts {
+			counts[i] = 0
+		}
+
+		for i, vec := range vecs {
+			codes[i] = e.bucket(rep, vec)
+			counts[codes[i]]++
+			e.project(rep, vec, projected[i])
+		}
+
+		repOut := out[rep*buckets*e.dprojections : (rep+1)*buckets*e.dprojections]
+		for i := range vecs {
+			bucketOut := repOut[codes[i]*e.dprojections : (codes[i]+1)*e.dprojections]
+			for j, v := range projected[i] {
+				bucketOut[j] += v
+			}
+		}
+
+		if !isDoc {
+			continue
+		}
+
+		for b := 0; b < buckets; b++ {
+			bucketOut := repOut[b*e.dprojections : (b+1)*e.dprojections]
+			if counts[b] > 0 {
+				inv := 1 / float32(counts[b])
+				for j := range bucketOut {
+					bucketOut[j] *= inv
+				}
+				continue
+			}
+
+			// empty buckets of documents are filled with the closest vector,
+			// otherwise query vectors in these buckets would not match at all
+			closest, minDist := 0, math.MaxInt
+			for i, code := range codes {
+				if dist := bits.OnesCount(uint(code ^ b)); dist < minDist {
+					closest, minDist = i, dist
+				}
+			}
+			copy(bucketOut, projected[closest])
+		}
+	}
+
+	return out, nil
+}
+
+// bucket returns the SimHash partition of the vector in the given repetition.
+func (e *MuveraEncoder) bucket(rep int, vec []float32) int {
+	code := 0
+	for k := 0; k < e.ksim; k++ {
+		if dot(e.simhash[rep*e.ksim+k], vec) > 0 {
+			code |= 1 << k
+		}
+	}
+	return code
+}
+
+func (e *MuveraEncoder) project(rep int, vec []float32, out []float32) {
+	for d := range out {
+		out[d] = dot(e.projections[rep*e.dprojections+d], vec)
+	}
+}
+
+func dot(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func testEncoder() *MuveraEncoder {
+	return NewMuveraEncoder(ent.MuveraConfig{
+		Enabled:      true,
+		KSim:         ent.DefaultMuveraKSim,
+		DProjections: ent.DefaultMuveraDProjections,
+		Repetitions:  ent.DefaultMuveraRepetitions,
+	})
+}
+
+func randomMultiVector(r *rand.Rand, tokens, dims int) [][]float32 {
+	vecs := make([][]float32, tokens)
+	for i := range vecs {
+		vecs[i] = make([]float32, dims)
+		var norm float32
+		for j := range vecs[i] {
+			vecs[i][j] = float32(r.NormFloat64())
+			norm += vecs[i][j] * vecs[i][j]
+		}
+		norm = float32(math.Sqrt(float64(norm)))
+		for j := range vecs[i] {
+			vecs[i][j] /= norm
+		}
+	}
+	return vecs
+}
+
+func chamfer(query, doc [][]float32) float32 {
+	var sim float32
+	for _, q := range query {
+		best := float32(-math.MaxFloat32)
+		for _, d := range doc {
+			if s := dot(q, d); s > best {
+				best = s
+			}
+		}
+		sim += best
+	}
+	return sim
+}
+
+func TestMuveraEncoder(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	dims := 32
+
+	t.Run("dimensions", func(t *testing.T) {
+		e := testEncoder()
+		assert.Equal(t, 10*16*16, e.Dimensions())
+		assert.Equal(t, 0, e.InputDimensions())
+
+		enc, err := e.EncodeDoc(randomMultiVector(r, 5, dims))
+		require.Nil(t, err)
+		assert.Len(t, enc, e.Dimensions())
+		assert.Equal(t, dims, e.InputDimensions())
+	})
+
+	t.Run("encodings are deterministic across encoders", func(t *testing.T) {
+		doc := randomMultiVector(r, 7, dims)
+		first, err := testEncoder().EncodeDoc(doc)
+		require.Nil(t, err)
+		second, err := testEncoder().EncodeDoc(doc)
+		require.Nil(t, err)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		e := testEncoder()
+		_, err := e.EncodeQuery(nil)
+		assert.NotNil(t, err)
+
+		_, err = e.EncodeQuery([][]float32{{1, 2, 3}, {1, 2}})
+		assert.NotNil(t, err)
+
+		_, err = e.EncodeDoc(randomMultiVector(r, 3, dims))
+		require.Nil(t, err)
+		_, err = e.EncodeDoc(randomMultiVector(r, 3, dims+1))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("documents fill empty buckets", func(t *testing.T) {
+		e := testEncoder()
+		enc, err := e.EncodeDoc(randomMultiVector(r, 1, dims))
+		require.Nil(t, err)
+
+		// a single token ends up in every bucket of every repetition
+		bucketSize := e.dprojections
+		for b := 1; b < len(enc)/bucketSize/e.repetitions; b++ {
+			assert.Equal(t, enc[:bucketSize], enc[b*bucketSize:(b+1)*bucketSize])
+		}
+	})
+
+	t.Run("inner product approximates the chamfer similarity", func(t *testing.T) {
+		e := testEncoder()
+		docs := make([][][]float32, 50)
+		encodings := make([][]float32, len(docs))
+		for i := range docs {
+			docs[i] = randomMultiVector(r, 20, dims)
+			enc, err := e.EncodeDoc(docs[i])
+			require.Nil(t, err)
+			encodings[i] = enc
+		}
+
+		// the query is a noisy copy of some tokens of one of the documents, so
+		// that document is the clear winner by chamfer similarity
+		query := make([][]float32, 8)
+		for i := range query {
+			query[i] = make([]float32, dims)
+			for j := range query[i] {
+				query[i][j] = docs[0][i][j] + 0.05*float32(r.NormFloat64())
+			}
+		}
+		queryEnc, err := e.EncodeQuery(query)
+		require.Nil(t, err)
+
+		byChamfer := make([]int, len(docs))
+		byEncoding := make([]int, len(docs))
+		for i := range docs {
+			byChamfer[i], byEncoding[i] = i, i
+		}
+		sort.Slice(byChamfer, func(a, b int) bool {
+			return chamfer(query, docs[byChamfer[a]]) > chamfer(query, docs[byChamfer[b]])
+		})
+		sort.Slice(byEncoding, func(a, b int) bool {
+			return dot(queryEnc, encodings[byEncoding[a]]) > dot(queryEnc, encodings[byEncoding[b]])
+		})
+
+		require.Equal(t, 0, byChamfer[0])
+		assert.Contains(t, byEncoding[:5], byChamfer[0])
+	})
+}
@@ -0,0 +1,184 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReplicationCancelSplitShardParams creates a new ReplicationCancelSplitShardParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReplicationCancelSplitShardParams() *ReplicationCancelSplitShardParams {
+	return &ReplicationCancelSplitShardParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReplicationCancelSplitShardParamsWithTimeout creates a new ReplicationCancelSplitShardParams object
+// with the ability to set a timeout on a request.
+func NewReplicationCancelSplitShardParamsWithTimeout(timeout time.Duration) *ReplicationCancelSplitShardParams {
+	return &ReplicationCancelSplitShardParams{
+		timeout: timeout,
+	}
+}
+
+// NewReplicationCancelSplitShardParamsWithContext creates a new ReplicationCancelSplitShardParams object
+// with the ability to set a context for a request.
+func NewReplicationCancelSplitShardParamsWithContext(ctx context.Context) *ReplicationCancelSplitShardParams {
+	return &ReplicationCancelSplitShardParams{
+		Context: ctx,
+	}
+}
+
+// NewReplicationCancelSplitShardParamsWithHTTPClient creates a new ReplicationCancelSplitShardParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReplicationCancelSplitShardParamsWithHTTPClient(client *http.Client) *ReplicationCancelSplitShardParams {
+	return &ReplicationCancelSplitShardParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReplicationCancelSplitShardParams contains all the parameters to send to the API endpoint
+
+	for the replication cancel split shard operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReplicationCancelSplitShardParams struct {
+
+	/* CollectionID.
+
+	   The collection name holding the shard.
+	*/
+	CollectionID string
+
+	/* ShardID.
+
+	   The shard id of the shard being split.
+	*/
+	ShardID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the replication cancel split shard params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationCancelSplitShardParams) WithDefaults() *ReplicationCancelSplitShardParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the replication cancel split shard params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationCancelSplitShardParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the replication cancel split shard params
+func (o *ReplicationCancelSplitShardParams) WithTimeout(timeout time.Duration) *ReplicationCancelSplitShardParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the replication cancel split shard params
+func (o *ReplicationCancelSplitShardParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the replication cancel split shard params
+func (o *ReplicationCancelSplitShardParams) WithContext(ctx context.Context) *ReplicationCancelSplitShardParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the replication cancel split shard params
+func (o *ReplicationCancelSplitShardParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the replication cancel split shard params
+func (o *ReplicationCancelSplitShardParams) WithHTTPClient(client *http.Client) *ReplicationCancelSplitShardParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the replication cancel split shard params
+func (o *ReplicationCancelSplitShardParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCollectionID adds the collectionID to the replication cancel split shard params
+func (o *ReplicationCancelSplitShardParams) WithCollectionID(collectionID string) *ReplicationCancelSplitShardParams {
+	o.SetCollectionID(collectionID)
+	return o
+}
+
+// SetCollectionID adds the collectionId to the replication cancel split shard params
+func (o *ReplicationCancelSplitShardParams) SetCollectionID(collectionID string) {
+	o.CollectionID = collectionID
+}
+
+// WithShardID adds the shardID to the replication cancel split shard params
+func (o *ReplicationCancelSplitShardParams) WithShardID(shardID string) *ReplicationCancelSplitShardParams {
+	o.SetShardID(shardID)
+	return o
+}
+
+// SetShardID adds the shardId to the replication cancel split shard params
+func (o *ReplicationCancelSplitShardParams) SetShardID(shardID string) {
+	o.ShardID = shardID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReplicationCancelSplitShardParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param collectionId
+	if err := r.SetPathParam("collectionId", o.CollectionID); err != nil {
+		return err
+	}
+
+	// path param shardId
+	if err := r.SetPathParam("shardId", o.ShardID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,460 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationCancelSplitShardReader is a Reader for the ReplicationCancelSplitShard structure.
+type ReplicationCancelSplitShardReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationCancelSplitShardReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewReplicationCancelSplitShardNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReplicationCancelSplitShardBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewReplicationCancelSplitShardUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationCancelSplitShardForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReplicationCancelSplitShardUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationCancelSplitShardInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationCancelSplitShardNoContent creates a ReplicationCancelSplitShardNoContent with default headers values
+func NewReplicationCancelSplitShardNoContent() *ReplicationCancelSplitShardNoContent {
+	return &ReplicationCancelSplitShardNoContent{}
+}
+
+/*
+ReplicationCancelSplitShardNoContent describes a response with status code 204, with default header values.
+
+Shard split canceled successfully
+*/
+type ReplicationCancelSplitShardNoContent struct {
+}
+
+// IsSuccess returns true when this replication cancel split shard no content response has a 2xx status code
+func (o *ReplicationCancelSplitShardNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication cancel split shard no content response has a 3xx status code
+func (o *ReplicationCancelSplitShardNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication cancel split shard no content response has a 4xx status code
+func (o *ReplicationCancelSplitShardNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication cancel split shard no content response has a 5xx status code
+func (o *ReplicationCancelSplitShardNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication cancel split shard no content response a status code equal to that given
+func (o *ReplicationCancelSplitShardNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the replication cancel split shard no content response
+func (o *ReplicationCancelSplitShardNoContent) Code() int {
+	return 204
+}
+
+func (o *ReplicationCancelSplitShardNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /replication/split/{collectionId}/{shardId}][%d] replicationCancelSplitShardNoContent ", 204)
+}
+
+func (o *ReplicationCancelSplitShardNoContent) String() string {
+	return fmt.Sprintf("[DELETE /replication/split/{collectionId}/{shardId}][%d] replicationCancelSplitShardNoContent ", 204)
+}
+
+func (o *ReplicationCancelSplitShardNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationCancelSplitShardBadRequest creates a ReplicationCancelSplitShardBadRequest with default headers values
+func NewReplicationCancelSplitShardBadRequest() *ReplicationCancelSplitShardBadRequest {
+	return &ReplicationCancelSplitShardBadRequest{}
+}
+
+/*
+ReplicationCancelSplitShardBadRequest describes a response with status code 400, with default header values.
+
+Malformed request.
+*/
+type ReplicationCancelSplitShardBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication cancel split shard bad request response has a 2xx status code
+func (o *ReplicationCancelSplitShardBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication cancel split shard bad request response has a 3xx status code
+func (o *ReplicationCancelSplitShardBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication cancel split shard bad request response has a 4xx status code
+func (o *ReplicationCancelSplitShardBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication cancel split shard bad request response has a 5xx status code
+func (o *ReplicationCancelSplitShardBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication cancel split shard bad request response a status code equal to that given
+func (o *ReplicationCancelSplitShardBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the replication cancel split shard bad request response
+func (o *ReplicationCancelSplitShardBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReplicationCancelSplitShardBadRequest) Error() string {
+	return fmt.Sprintf("[DELETE /replication/split/{collectionId}/{shardId}][%d] replicationCancelSplitShardBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ReplicationCancelSplitShardBadRequest) String() string {
+	return fmt.Sprintf("[DELETE /replication/split/{collectionId}/{shardId}][%d] replicationCancelSplitShardBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ReplicationCancelSplitShardBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationCancelSplitShardBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationCancelSplitShardUnauthorized creates a ReplicationCancelSplitShardUnauthorized with default headers values
+func NewReplicationCancelSplitShardUnauthorized() *ReplicationCancelSplitShardUnauthorized {
+	return &ReplicationCancelSplitShardUnauthorized{}
+}
+
+/*
+ReplicationCancelSplitShardUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationCancelSplitShardUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication cancel split shard unauthorized response has a 2xx status code
+func (o *ReplicationCancelSplitShardUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication cancel split shard unauthorized response has a 3xx status code
+func (o *ReplicationCancelSplitShardUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication cancel split shard unauthorized response has a 4xx status code
+func (o *ReplicationCancelSplitShardUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication cancel split shard unauthorized response has a 5xx status code
+func (o *ReplicationCancelSplitShardUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication cancel split shard unauthorized response a status code equal to that given
+func (o *ReplicationCancelSplitShardUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication cancel split shard unauthorized response
+func (o *ReplicationCancelSplitShardUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationCancelSplitShardUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /replication/split/{collectionId}/{shardId}][%d] replicationCancelSplitShardUnauthorized ", 401)
+}
+
+func (o *ReplicationCancelSplitShardUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /replication/split/{collectionId}/{shardId}][%d] replicationCancelSplitShardUnauthorized ", 401)
+}
+
+func (o *ReplicationCancelSplitShardUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationCancelSplitShardForbidden creates a ReplicationCancelSplitShardForbidden with default headers values
+func NewReplicationCancelSplitShardForbidden() *ReplicationCancelSplitShardForbidden {
+	return &ReplicationCancelSplitShardForbidden{}
+}
+
+/*
+ReplicationCancelSplitShardForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationCancelSplitShardForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication cancel split shard forbidden response has a 2xx status code
+func (o *ReplicationCancelSplitShardForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication cancel split shard forbidden response has a 3xx status code
+func (o *ReplicationCancelSplitShardForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication cancel split shard forbidden response has a 4xx status code
+func (o *ReplicationCancelSplitShardForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication cancel split shard forbidden response has a 5xx status code
+func (o *ReplicationCancelSplitShardForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication cancel split shard forbidden response a status code equal to that given
+func (o *ReplicationCancelSplitShardForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication cancel split shard forbidden response
+func (o *ReplicationCancelSplitShardForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationCancelSplitShardForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /replication/split/{collectionId}/{shardId}][%d] replicationCancelSplitShardForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationCancelSplitShardForbidden) String() string {
+	return fmt.Sprintf("[DELETE /replication/split/{collectionId}/{shardId}][%d] replicationCancelSplitShardForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationCancelSplitShardForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationCancelSplitShardForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationCancelSplitShardUnprocessableEntity creates a ReplicationCancelSplitShardUnprocessableEntity with default headers values
+func NewReplicationCancelSplitShardUnprocessableEntity() *ReplicationCancelSplitShardUnprocessableEntity {
+	return &ReplicationCancelSplitShardUnprocessableEntity{}
+}
+
+/*
+ReplicationCancelSplitShardUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type ReplicationCancelSplitShardUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication cancel split shard unprocessable entity response has a 2xx status code
+func (o *ReplicationCancelSplitShardUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication cancel split shard unprocessable entity response has a 3xx status code
+func (o *ReplicationCancelSplitShardUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication cancel split shard unprocessable entity response has a 4xx status code
+func (o *ReplicationCancelSplitShardUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication cancel split shard unprocessable entity response has a 5xx status code
+func (o *ReplicationCancelSplitShardUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication cancel split shard unprocessable entity response a status code equal to that given
+func (o *ReplicationCancelSplitShardUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the replication cancel split shard unprocessable entity response
+func (o *ReplicationCancelSplitShardUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReplicationCancelSplitShardUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[DELETE /replication/split/{collectionId}/{shardId}][%d] replicationCancelSplitShardUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationCancelSplitShardUnprocessableEntity) String() string {
+	return fmt.Sprintf("[DELETE /replication/split/{collectionId}/{shardId}][%d] replicationCancelSplitShardUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationCancelSplitShardUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationCancelSplitShardUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationCancelSplitShardInternalServerError creates a ReplicationCancelSplitShardInternalServerError with default headers values
+func NewReplicationCancelSplitShardInternalServerError() *ReplicationCancelSplitShardInternalServerError {
+	return &ReplicationCancelSplitShardInternalServerError{}
+}
+
+/*
+ReplicationCancelSplitShardInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationCancelSplitShardInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication cancel split shard internal server error response has a 2xx status code
+func (o *ReplicationCancelSplitShardInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication cancel split shard internal server error response has a 3xx status code
+func (o *ReplicationCancelSplitShardInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication cancel split shard internal server error response has a 4xx status code
+func (o *ReplicationCancelSplitShardInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication cancel split shard internal server error response has a 5xx status code
+func (o *ReplicationCancelSplitShardInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication cancel split shard internal server error response a status code equal to that given
+func (o *ReplicationCancelSplitShardInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication cancel split shard internal server error response
+func (o *ReplicationCancelSplitShardInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationCancelSplitShardInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /replication/split/{collectionId}/{shardId}][%d] replicationCancelSplitShardInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationCancelSplitShardInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /replication/split/{collectionId}/{shardId}][%d] replicationCancelSplitShardInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationCancelSplitShardInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationCancelSplitShardInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -43,8 +43,24 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	Replicate(params *ReplicateParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicateOK, error)
 
+	ReplicationCancelSplitShard(params *ReplicationCancelSplitShardParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationCancelSplitShardNoContent, error)
+
 	ReplicationDetails(params *ReplicationDetailsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationDetailsOK, error)
 
+	ReplicationRebalanceCancel(params *ReplicationRebalanceCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalanceCancelNoContent, error)
+
+	ReplicationRebalancePause(params *ReplicationRebalancePauseParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalancePauseOK, error)
+
+	ReplicationRebalancePlan(params *ReplicationRebalancePlanParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalancePlanOK, error)
+
+	ReplicationRebalanceResume(params *ReplicationRebalanceResumeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalanceResumeOK, error)
+
+	ReplicationRebalanceStart(params *ReplicationRebalanceStartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalanceStartOK, error)
+
+	ReplicationRebalanceStatus(params *ReplicationRebalanceStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalanceStatusOK, error)
+
+	ReplicationSplitShard(params *ReplicationSplitShardParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationSplitShardOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -87,6 +103,47 @@ func (a *Client) Replicate(params *ReplicateParams, authInfo runtime.ClientAuthI
 	panic(msg)
 }
 
+/*
+ReplicationCancelSplitShard cancels an ongoing split of a shard
+
+Aborts the split of a shard which has not completed yet. The shard keeps all its objects and the new shards are removed.
+*/
+func (a *Client) ReplicationCancelSplitShard(params *ReplicationCancelSplitShardParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationCancelSplitShardNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationCancelSplitShardParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replicationCancelSplitShard",
+		Method:             "DELETE",
+		PathPattern:        "/replication/split/{collectionId}/{shardId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationCancelSplitShardReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationCancelSplitShardNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replicationCancelSplitShard: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ReplicationDetails gets the details of a replication operation
 
@@ -128,6 +185,293 @@ func (a *Client) ReplicationDetails(params *ReplicationDetailsParams, authInfo r
 	panic(msg)
 }
 
+/*
+ReplicationRebalanceCancel cancels the ongoing shard rebalance
+
+Cancels the moves of the ongoing rebalance which have not started yet. Moves which are already replicating a shard complete.
+*/
+func (a *Client) ReplicationRebalanceCancel(params *ReplicationRebalanceCancelParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalanceCancelNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationRebalanceCancelParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replicationRebalanceCancel",
+		Method:             "DELETE",
+		PathPattern:        "/replication/rebalance",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationRebalanceCancelReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationRebalanceCancelNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replicationRebalanceCancel: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReplicationRebalancePause pauses the ongoing shard rebalance
+
+Stops starting new moves of the ongoing rebalance until it is resumed. Moves which are already replicating a shard complete.
+*/
+func (a *Client) ReplicationRebalancePause(params *ReplicationRebalancePauseParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalancePauseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationRebalancePauseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replicationRebalancePause",
+		Method:             "POST",
+		PathPattern:        "/replication/rebalance/pause",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationRebalancePauseReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationRebalancePauseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replicationRebalancePause: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReplicationRebalancePlan plans a shard rebalance without starting it
+
+Returns the moves a rebalance would carry out and the load of the nodes before and after them, without moving any shard.
+*/
+func (a *Client) ReplicationRebalancePlan(params *ReplicationRebalancePlanParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalancePlanOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationRebalancePlanParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replicationRebalancePlan",
+		Method:             "GET",
+		PathPattern:        "/replication/rebalance/plan",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationRebalancePlanReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationRebalancePlanOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replicationRebalancePlan: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReplicationRebalanceResume resumes a paused shard rebalance
+
+Continues starting the remaining moves of a paused rebalance.
+*/
+func (a *Client) ReplicationRebalanceResume(params *ReplicationRebalanceResumeParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalanceResumeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationRebalanceResumeParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replicationRebalanceResume",
+		Method:             "POST",
+		PathPattern:        "/replication/rebalance/resume",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationRebalanceResumeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationRebalanceResumeOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replicationRebalanceResume: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReplicationRebalanceStart starts rebalancing the shard replicas across the nodes
+
+Plans the moves which balance the number and the disk usage of shard replicas across the healthy nodes and carries them out in the background. A move replicates a shard to its target node and removes the replica from its source node afterwards. Only a limited number of moves run concurrently. The rebalance is coordinated by the node receiving the request and its state is kept in memory only. If that node restarts, the moves which did not start yet are dropped and the moves which are replicating complete without removing the replica from their source node.
+*/
+func (a *Client) ReplicationRebalanceStart(params *ReplicationRebalanceStartParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalanceStartOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationRebalanceStartParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replicationRebalanceStart",
+		Method:             "POST",
+		PathPattern:        "/replication/rebalance",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationRebalanceStartReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationRebalanceStartOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replicationRebalanceStart: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReplicationRebalanceStatus gets the state of the shard rebalance
+
+Returns the state and the moves of the last shard rebalance coordinated by the node receiving the request.
+*/
+func (a *Client) ReplicationRebalanceStatus(params *ReplicationRebalanceStatusParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationRebalanceStatusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationRebalanceStatusParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replicationRebalanceStatus",
+		Method:             "GET",
+		PathPattern:        "/replication/rebalance",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationRebalanceStatusReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationRebalanceStatusOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replicationRebalanceStatus: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+ReplicationSplitShard starts the async operation to split a shard into new shards
+
+Reassigns the virtual shards of a shard of a single-tenant collection to new shards. Every replica of the shard copies its data into the new shards in the background. Once all replicas are done, requests are routed to the new shards and the shard is removed.
+*/
+func (a *Client) ReplicationSplitShard(params *ReplicationSplitShardParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReplicationSplitShardOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReplicationSplitShardParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "replicationSplitShard",
+		Method:             "POST",
+		PathPattern:        "/replication/split",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &ReplicationSplitShardReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReplicationSplitShardOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for replicationSplitShard: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
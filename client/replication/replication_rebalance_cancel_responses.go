@@ -0,0 +1,386 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalanceCancelReader is a Reader for the ReplicationRebalanceCancel structure.
+type ReplicationRebalanceCancelReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationRebalanceCancelReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewReplicationRebalanceCancelNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewReplicationRebalanceCancelUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationRebalanceCancelForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReplicationRebalanceCancelUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationRebalanceCancelInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationRebalanceCancelNoContent creates a ReplicationRebalanceCancelNoContent with default headers values
+func NewReplicationRebalanceCancelNoContent() *ReplicationRebalanceCancelNoContent {
+	return &ReplicationRebalanceCancelNoContent{}
+}
+
+/*
+ReplicationRebalanceCancelNoContent describes a response with status code 204, with default header values.
+
+Rebalance canceled successfully
+*/
+type ReplicationRebalanceCancelNoContent struct {
+}
+
+// IsSuccess returns true when this replication rebalance cancel no content response has a 2xx status code
+func (o *ReplicationRebalanceCancelNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication rebalance cancel no content response has a 3xx status code
+func (o *ReplicationRebalanceCancelNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance cancel no content response has a 4xx status code
+func (o *ReplicationRebalanceCancelNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalance cancel no content response has a 5xx status code
+func (o *ReplicationRebalanceCancelNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance cancel no content response a status code equal to that given
+func (o *ReplicationRebalanceCancelNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the replication rebalance cancel no content response
+func (o *ReplicationRebalanceCancelNoContent) Code() int {
+	return 204
+}
+
+func (o *ReplicationRebalanceCancelNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /replication/rebalance][%d] replicationRebalanceCancelNoContent ", 204)
+}
+
+func (o *ReplicationRebalanceCancelNoContent) String() string {
+	return fmt.Sprintf("[DELETE /replication/rebalance][%d] replicationRebalanceCancelNoContent ", 204)
+}
+
+func (o *ReplicationRebalanceCancelNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationRebalanceCancelUnauthorized creates a ReplicationRebalanceCancelUnauthorized with default headers values
+func NewReplicationRebalanceCancelUnauthorized() *ReplicationRebalanceCancelUnauthorized {
+	return &ReplicationRebalanceCancelUnauthorized{}
+}
+
+/*
+ReplicationRebalanceCancelUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationRebalanceCancelUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication rebalance cancel unauthorized response has a 2xx status code
+func (o *ReplicationRebalanceCancelUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance cancel unauthorized response has a 3xx status code
+func (o *ReplicationRebalanceCancelUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance cancel unauthorized response has a 4xx status code
+func (o *ReplicationRebalanceCancelUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance cancel unauthorized response has a 5xx status code
+func (o *ReplicationRebalanceCancelUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance cancel unauthorized response a status code equal to that given
+func (o *ReplicationRebalanceCancelUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication rebalance cancel unauthorized response
+func (o *ReplicationRebalanceCancelUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationRebalanceCancelUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /replication/rebalance][%d] replicationRebalanceCancelUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalanceCancelUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /replication/rebalance][%d] replicationRebalanceCancelUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalanceCancelUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationRebalanceCancelForbidden creates a ReplicationRebalanceCancelForbidden with default headers values
+func NewReplicationRebalanceCancelForbidden() *ReplicationRebalanceCancelForbidden {
+	return &ReplicationRebalanceCancelForbidden{}
+}
+
+/*
+ReplicationRebalanceCancelForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationRebalanceCancelForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance cancel forbidden response has a 2xx status code
+func (o *ReplicationRebalanceCancelForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance cancel forbidden response has a 3xx status code
+func (o *ReplicationRebalanceCancelForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance cancel forbidden response has a 4xx status code
+func (o *ReplicationRebalanceCancelForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance cancel forbidden response has a 5xx status code
+func (o *ReplicationRebalanceCancelForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance cancel forbidden response a status code equal to that given
+func (o *ReplicationRebalanceCancelForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication rebalance cancel forbidden response
+func (o *ReplicationRebalanceCancelForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationRebalanceCancelForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /replication/rebalance][%d] replicationRebalanceCancelForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalanceCancelForbidden) String() string {
+	return fmt.Sprintf("[DELETE /replication/rebalance][%d] replicationRebalanceCancelForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalanceCancelForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceCancelForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalanceCancelUnprocessableEntity creates a ReplicationRebalanceCancelUnprocessableEntity with default headers values
+func NewReplicationRebalanceCancelUnprocessableEntity() *ReplicationRebalanceCancelUnprocessableEntity {
+	return &ReplicationRebalanceCancelUnprocessableEntity{}
+}
+
+/*
+ReplicationRebalanceCancelUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type ReplicationRebalanceCancelUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance cancel unprocessable entity response has a 2xx status code
+func (o *ReplicationRebalanceCancelUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance cancel unprocessable entity response has a 3xx status code
+func (o *ReplicationRebalanceCancelUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance cancel unprocessable entity response has a 4xx status code
+func (o *ReplicationRebalanceCancelUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance cancel unprocessable entity response has a 5xx status code
+func (o *ReplicationRebalanceCancelUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance cancel unprocessable entity response a status code equal to that given
+func (o *ReplicationRebalanceCancelUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the replication rebalance cancel unprocessable entity response
+func (o *ReplicationRebalanceCancelUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReplicationRebalanceCancelUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[DELETE /replication/rebalance][%d] replicationRebalanceCancelUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationRebalanceCancelUnprocessableEntity) String() string {
+	return fmt.Sprintf("[DELETE /replication/rebalance][%d] replicationRebalanceCancelUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationRebalanceCancelUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceCancelUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalanceCancelInternalServerError creates a ReplicationRebalanceCancelInternalServerError with default headers values
+func NewReplicationRebalanceCancelInternalServerError() *ReplicationRebalanceCancelInternalServerError {
+	return &ReplicationRebalanceCancelInternalServerError{}
+}
+
+/*
+ReplicationRebalanceCancelInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationRebalanceCancelInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance cancel internal server error response has a 2xx status code
+func (o *ReplicationRebalanceCancelInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance cancel internal server error response has a 3xx status code
+func (o *ReplicationRebalanceCancelInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance cancel internal server error response has a 4xx status code
+func (o *ReplicationRebalanceCancelInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalance cancel internal server error response has a 5xx status code
+func (o *ReplicationRebalanceCancelInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication rebalance cancel internal server error response a status code equal to that given
+func (o *ReplicationRebalanceCancelInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication rebalance cancel internal server error response
+func (o *ReplicationRebalanceCancelInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationRebalanceCancelInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /replication/rebalance][%d] replicationRebalanceCancelInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalanceCancelInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /replication/rebalance][%d] replicationRebalanceCancelInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalanceCancelInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceCancelInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReplicationRebalancePauseParams creates a new ReplicationRebalancePauseParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReplicationRebalancePauseParams() *ReplicationRebalancePauseParams {
+	return &ReplicationRebalancePauseParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReplicationRebalancePauseParamsWithTimeout creates a new ReplicationRebalancePauseParams object
+// with the ability to set a timeout on a request.
+func NewReplicationRebalancePauseParamsWithTimeout(timeout time.Duration) *ReplicationRebalancePauseParams {
+	return &ReplicationRebalancePauseParams{
+		timeout: timeout,
+	}
+}
+
+// NewReplicationRebalancePauseParamsWithContext creates a new ReplicationRebalancePauseParams object
+// with the ability to set a context for a request.
+func NewReplicationRebalancePauseParamsWithContext(ctx context.Context) *ReplicationRebalancePauseParams {
+	return &ReplicationRebalancePauseParams{
+		Context: ctx,
+	}
+}
+
+// NewReplicationRebalancePauseParamsWithHTTPClient creates a new ReplicationRebalancePauseParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReplicationRebalancePauseParamsWithHTTPClient(client *http.Client) *ReplicationRebalancePauseParams {
+	return &ReplicationRebalancePauseParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReplicationRebalancePauseParams contains all the parameters to send to the API endpoint
+
+	for the replication rebalance pause operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReplicationRebalancePauseParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the replication rebalance pause params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationRebalancePauseParams) WithDefaults() *ReplicationRebalancePauseParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the replication rebalance pause params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationRebalancePauseParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the replication rebalance pause params
+func (o *ReplicationRebalancePauseParams) WithTimeout(timeout time.Duration) *ReplicationRebalancePauseParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the replication rebalance pause params
+func (o *ReplicationRebalancePauseParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the replication rebalance pause params
+func (o *ReplicationRebalancePauseParams) WithContext(ctx context.Context) *ReplicationRebalancePauseParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the replication rebalance pause params
+func (o *ReplicationRebalancePauseParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the replication rebalance pause params
+func (o *ReplicationRebalancePauseParams) WithHTTPClient(client *http.Client) *ReplicationRebalancePauseParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the replication rebalance pause params
+func (o *ReplicationRebalancePauseParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReplicationRebalancePauseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
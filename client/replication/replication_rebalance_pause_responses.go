@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalancePauseReader is a Reader for the ReplicationRebalancePause structure.
+type ReplicationRebalancePauseReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationRebalancePauseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReplicationRebalancePauseOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewReplicationRebalancePauseUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationRebalancePauseForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReplicationRebalancePauseUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationRebalancePauseInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationRebalancePauseOK creates a ReplicationRebalancePauseOK with default headers values
+func NewReplicationRebalancePauseOK() *ReplicationRebalancePauseOK {
+	return &ReplicationRebalancePauseOK{}
+}
+
+/*
+ReplicationRebalancePauseOK describes a response with status code 200, with default header values.
+
+The state of the rebalance coordinated by this node
+*/
+type ReplicationRebalancePauseOK struct {
+	Payload *models.ReplicationRebalanceStatus
+}
+
+// IsSuccess returns true when this replication rebalance pause o k response has a 2xx status code
+func (o *ReplicationRebalancePauseOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication rebalance pause o k response has a 3xx status code
+func (o *ReplicationRebalancePauseOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance pause o k response has a 4xx status code
+func (o *ReplicationRebalancePauseOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalance pause o k response has a 5xx status code
+func (o *ReplicationRebalancePauseOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance pause o k response a status code equal to that given
+func (o *ReplicationRebalancePauseOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the replication rebalance pause o k response
+func (o *ReplicationRebalancePauseOK) Code() int {
+	return 200
+}
+
+func (o *ReplicationRebalancePauseOK) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance/pause][%d] replicationRebalancePauseOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalancePauseOK) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance/pause][%d] replicationRebalancePauseOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalancePauseOK) GetPayload() *models.ReplicationRebalanceStatus {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancePauseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReplicationRebalanceStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalancePauseUnauthorized creates a ReplicationRebalancePauseUnauthorized with default headers values
+func NewReplicationRebalancePauseUnauthorized() *ReplicationRebalancePauseUnauthorized {
+	return &ReplicationRebalancePauseUnauthorized{}
+}
+
+/*
+ReplicationRebalancePauseUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationRebalancePauseUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication rebalance pause unauthorized response has a 2xx status code
+func (o *ReplicationRebalancePauseUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance pause unauthorized response has a 3xx status code
+func (o *ReplicationRebalancePauseUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance pause unauthorized response has a 4xx status code
+func (o *ReplicationRebalancePauseUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance pause unauthorized response has a 5xx status code
+func (o *ReplicationRebalancePauseUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance pause unauthorized response a status code equal to that given
+func (o *ReplicationRebalancePauseUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication rebalance pause unauthorized response
+func (o *ReplicationRebalancePauseUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationRebalancePauseUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance/pause][%d] replicationRebalancePauseUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalancePauseUnauthorized) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance/pause][%d] replicationRebalancePauseUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalancePauseUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationRebalancePauseForbidden creates a ReplicationRebalancePauseForbidden with default headers values
+func NewReplicationRebalancePauseForbidden() *ReplicationRebalancePauseForbidden {
+	return &ReplicationRebalancePauseForbidden{}
+}
+
+/*
+ReplicationRebalancePauseForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationRebalancePauseForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance pause forbidden response has a 2xx status code
+func (o *ReplicationRebalancePauseForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance pause forbidden response has a 3xx status code
+func (o *ReplicationRebalancePauseForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance pause forbidden response has a 4xx status code
+func (o *ReplicationRebalancePauseForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance pause forbidden response has a 5xx status code
+func (o *ReplicationRebalancePauseForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance pause forbidden response a status code equal to that given
+func (o *ReplicationRebalancePauseForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication rebalance pause forbidden response
+func (o *ReplicationRebalancePauseForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationRebalancePauseForbidden) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance/pause][%d] replicationRebalancePauseForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalancePauseForbidden) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance/pause][%d] replicationRebalancePauseForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalancePauseForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancePauseForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalancePauseUnprocessableEntity creates a ReplicationRebalancePauseUnprocessableEntity with default headers values
+func NewReplicationRebalancePauseUnprocessableEntity() *ReplicationRebalancePauseUnprocessableEntity {
+	return &ReplicationRebalancePauseUnprocessableEntity{}
+}
+
+/*
+ReplicationRebalancePauseUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type ReplicationRebalancePauseUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance pause unprocessable entity response has a 2xx status code
+func (o *ReplicationRebalancePauseUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance pause unprocessable entity response has a 3xx status code
+func (o *ReplicationRebalancePauseUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance pause unprocessable entity response has a 4xx status code
+func (o *ReplicationRebalancePauseUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance pause unprocessable entity response has a 5xx status code
+func (o *ReplicationRebalancePauseUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance pause unprocessable entity response a status code equal to that given
+func (o *ReplicationRebalancePauseUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the replication rebalance pause unprocessable entity response
+func (o *ReplicationRebalancePauseUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReplicationRebalancePauseUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance/pause][%d] replicationRebalancePauseUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationRebalancePauseUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance/pause][%d] replicationRebalancePauseUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationRebalancePauseUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancePauseUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalancePauseInternalServerError creates a ReplicationRebalancePauseInternalServerError with default headers values
+func NewReplicationRebalancePauseInternalServerError() *ReplicationRebalancePauseInternalServerError {
+	return &ReplicationRebalancePauseInternalServerError{}
+}
+
+/*
+ReplicationRebalancePauseInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationRebalancePauseInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance pause internal server error response has a 2xx status code
+func (o *ReplicationRebalancePauseInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance pause internal server error response has a 3xx status code
+func (o *ReplicationRebalancePauseInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance pause internal server error response has a 4xx status code
+func (o *ReplicationRebalancePauseInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalance pause internal server error response has a 5xx status code
+func (o *ReplicationRebalancePauseInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication rebalance pause internal server error response a status code equal to that given
+func (o *ReplicationRebalancePauseInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication rebalance pause internal server error response
+func (o *ReplicationRebalancePauseInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationRebalancePauseInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance/pause][%d] replicationRebalancePauseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalancePauseInternalServerError) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance/pause][%d] replicationRebalancePauseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalancePauseInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancePauseInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,324 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalancePlanReader is a Reader for the ReplicationRebalancePlan structure.
+type ReplicationRebalancePlanReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationRebalancePlanReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReplicationRebalancePlanOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewReplicationRebalancePlanUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationRebalancePlanForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationRebalancePlanInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationRebalancePlanOK creates a ReplicationRebalancePlanOK with default headers values
+func NewReplicationRebalancePlanOK() *ReplicationRebalancePlanOK {
+	return &ReplicationRebalancePlanOK{}
+}
+
+/*
+ReplicationRebalancePlanOK describes a response with status code 200, with default header values.
+
+The planned moves
+*/
+type ReplicationRebalancePlanOK struct {
+	Payload *models.ReplicationRebalancePlan
+}
+
+// IsSuccess returns true when this replication rebalance plan o k response has a 2xx status code
+func (o *ReplicationRebalancePlanOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication rebalance plan o k response has a 3xx status code
+func (o *ReplicationRebalancePlanOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance plan o k response has a 4xx status code
+func (o *ReplicationRebalancePlanOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalance plan o k response has a 5xx status code
+func (o *ReplicationRebalancePlanOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance plan o k response a status code equal to that given
+func (o *ReplicationRebalancePlanOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the replication rebalance plan o k response
+func (o *ReplicationRebalancePlanOK) Code() int {
+	return 200
+}
+
+func (o *ReplicationRebalancePlanOK) Error() string {
+	return fmt.Sprintf("[GET /replication/rebalance/plan][%d] replicationRebalancePlanOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalancePlanOK) String() string {
+	return fmt.Sprintf("[GET /replication/rebalance/plan][%d] replicationRebalancePlanOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalancePlanOK) GetPayload() *models.ReplicationRebalancePlan {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancePlanOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReplicationRebalancePlan)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalancePlanUnauthorized creates a ReplicationRebalancePlanUnauthorized with default headers values
+func NewReplicationRebalancePlanUnauthorized() *ReplicationRebalancePlanUnauthorized {
+	return &ReplicationRebalancePlanUnauthorized{}
+}
+
+/*
+ReplicationRebalancePlanUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationRebalancePlanUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication rebalance plan unauthorized response has a 2xx status code
+func (o *ReplicationRebalancePlanUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance plan unauthorized response has a 3xx status code
+func (o *ReplicationRebalancePlanUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance plan unauthorized response has a 4xx status code
+func (o *ReplicationRebalancePlanUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance plan unauthorized response has a 5xx status code
+func (o *ReplicationRebalancePlanUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance plan unauthorized response a status code equal to that given
+func (o *ReplicationRebalancePlanUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication rebalance plan unauthorized response
+func (o *ReplicationRebalancePlanUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationRebalancePlanUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /replication/rebalance/plan][%d] replicationRebalancePlanUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalancePlanUnauthorized) String() string {
+	return fmt.Sprintf("[GET /replication/rebalance/plan][%d] replicationRebalancePlanUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalancePlanUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationRebalancePlanForbidden creates a ReplicationRebalancePlanForbidden with default headers values
+func NewReplicationRebalancePlanForbidden() *ReplicationRebalancePlanForbidden {
+	return &ReplicationRebalancePlanForbidden{}
+}
+
+/*
+ReplicationRebalancePlanForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationRebalancePlanForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance plan forbidden response has a 2xx status code
+func (o *ReplicationRebalancePlanForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance plan forbidden response has a 3xx status code
+func (o *ReplicationRebalancePlanForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance plan forbidden response has a 4xx status code
+func (o *ReplicationRebalancePlanForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance plan forbidden response has a 5xx status code
+func (o *ReplicationRebalancePlanForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance plan forbidden response a status code equal to that given
+func (o *ReplicationRebalancePlanForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication rebalance plan forbidden response
+func (o *ReplicationRebalancePlanForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationRebalancePlanForbidden) Error() string {
+	return fmt.Sprintf("[GET /replication/rebalance/plan][%d] replicationRebalancePlanForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalancePlanForbidden) String() string {
+	return fmt.Sprintf("[GET /replication/rebalance/plan][%d] replicationRebalancePlanForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalancePlanForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancePlanForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalancePlanInternalServerError creates a ReplicationRebalancePlanInternalServerError with default headers values
+func NewReplicationRebalancePlanInternalServerError() *ReplicationRebalancePlanInternalServerError {
+	return &ReplicationRebalancePlanInternalServerError{}
+}
+
+/*
+ReplicationRebalancePlanInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationRebalancePlanInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance plan internal server error response has a 2xx status code
+func (o *ReplicationRebalancePlanInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance plan internal server error response has a 3xx status code
+func (o *ReplicationRebalancePlanInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance plan internal server error response has a 4xx status code
+func (o *ReplicationRebalancePlanInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalance plan internal server error response has a 5xx status code
+func (o *ReplicationRebalancePlanInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication rebalance plan internal server error response a status code equal to that given
+func (o *ReplicationRebalancePlanInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication rebalance plan internal server error response
+func (o *ReplicationRebalancePlanInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationRebalancePlanInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /replication/rebalance/plan][%d] replicationRebalancePlanInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalancePlanInternalServerError) String() string {
+	return fmt.Sprintf("[GET /replication/rebalance/plan][%d] replicationRebalancePlanInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalancePlanInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalancePlanInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
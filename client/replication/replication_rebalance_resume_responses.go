@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalanceResumeReader is a Reader for the ReplicationRebalanceResume structure.
+type ReplicationRebalanceResumeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationRebalanceResumeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReplicationRebalanceResumeOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewReplicationRebalanceResumeUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationRebalanceResumeForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReplicationRebalanceResumeUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationRebalanceResumeInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationRebalanceResumeOK creates a ReplicationRebalanceResumeOK with default headers values
+func NewReplicationRebalanceResumeOK() *ReplicationRebalanceResumeOK {
+	return &ReplicationRebalanceResumeOK{}
+}
+
+/*
+ReplicationRebalanceResumeOK describes a response with status code 200, with default header values.
+
+The state of the rebalance coordinated by this node
+*/
+type ReplicationRebalanceResumeOK struct {
+	Payload *models.ReplicationRebalanceStatus
+}
+
+// IsSuccess returns true when this replication rebalance resume o k response has a 2xx status code
+func (o *ReplicationRebalanceResumeOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication rebalance resume o k response has a 3xx status code
+func (o *ReplicationRebalanceResumeOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance resume o k response has a 4xx status code
+func (o *ReplicationRebalanceResumeOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalance resume o k response has a 5xx status code
+func (o *ReplicationRebalanceResumeOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance resume o k response a status code equal to that given
+func (o *ReplicationRebalanceResumeOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the replication rebalance resume o k response
+func (o *ReplicationRebalanceResumeOK) Code() int {
+	return 200
+}
+
+func (o *ReplicationRebalanceResumeOK) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance/resume][%d] replicationRebalanceResumeOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalanceResumeOK) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance/resume][%d] replicationRebalanceResumeOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalanceResumeOK) GetPayload() *models.ReplicationRebalanceStatus {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceResumeOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReplicationRebalanceStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalanceResumeUnauthorized creates a ReplicationRebalanceResumeUnauthorized with default headers values
+func NewReplicationRebalanceResumeUnauthorized() *ReplicationRebalanceResumeUnauthorized {
+	return &ReplicationRebalanceResumeUnauthorized{}
+}
+
+/*
+ReplicationRebalanceResumeUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationRebalanceResumeUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication rebalance resume unauthorized response has a 2xx status code
+func (o *ReplicationRebalanceResumeUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance resume unauthorized response has a 3xx status code
+func (o *ReplicationRebalanceResumeUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance resume unauthorized response has a 4xx status code
+func (o *ReplicationRebalanceResumeUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance resume unauthorized response has a 5xx status code
+func (o *ReplicationRebalanceResumeUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance resume unauthorized response a status code equal to that given
+func (o *ReplicationRebalanceResumeUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication rebalance resume unauthorized response
+func (o *ReplicationRebalanceResumeUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationRebalanceResumeUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance/resume][%d] replicationRebalanceResumeUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalanceResumeUnauthorized) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance/resume][%d] replicationRebalanceResumeUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalanceResumeUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationRebalanceResumeForbidden creates a ReplicationRebalanceResumeForbidden with default headers values
+func NewReplicationRebalanceResumeForbidden() *ReplicationRebalanceResumeForbidden {
+	return &ReplicationRebalanceResumeForbidden{}
+}
+
+/*
+ReplicationRebalanceResumeForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationRebalanceResumeForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance resume forbidden response has a 2xx status code
+func (o *ReplicationRebalanceResumeForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance resume forbidden response has a 3xx status code
+func (o *ReplicationRebalanceResumeForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance resume forbidden response has a 4xx status code
+func (o *ReplicationRebalanceResumeForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance resume forbidden response has a 5xx status code
+func (o *ReplicationRebalanceResumeForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance resume forbidden response a status code equal to that given
+func (o *ReplicationRebalanceResumeForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication rebalance resume forbidden response
+func (o *ReplicationRebalanceResumeForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationRebalanceResumeForbidden) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance/resume][%d] replicationRebalanceResumeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalanceResumeForbidden) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance/resume][%d] replicationRebalanceResumeForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalanceResumeForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceResumeForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalanceResumeUnprocessableEntity creates a ReplicationRebalanceResumeUnprocessableEntity with default headers values
+func NewReplicationRebalanceResumeUnprocessableEntity() *ReplicationRebalanceResumeUnprocessableEntity {
+	return &ReplicationRebalanceResumeUnprocessableEntity{}
+}
+
+/*
+ReplicationRebalanceResumeUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type ReplicationRebalanceResumeUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance resume unprocessable entity response has a 2xx status code
+func (o *ReplicationRebalanceResumeUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance resume unprocessable entity response has a 3xx status code
+func (o *ReplicationRebalanceResumeUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance resume unprocessable entity response has a 4xx status code
+func (o *ReplicationRebalanceResumeUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance resume unprocessable entity response has a 5xx status code
+func (o *ReplicationRebalanceResumeUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance resume unprocessable entity response a status code equal to that given
+func (o *ReplicationRebalanceResumeUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the replication rebalance resume unprocessable entity response
+func (o *ReplicationRebalanceResumeUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReplicationRebalanceResumeUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance/resume][%d] replicationRebalanceResumeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationRebalanceResumeUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance/resume][%d] replicationRebalanceResumeUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationRebalanceResumeUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceResumeUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalanceResumeInternalServerError creates a ReplicationRebalanceResumeInternalServerError with default headers values
+func NewReplicationRebalanceResumeInternalServerError() *ReplicationRebalanceResumeInternalServerError {
+	return &ReplicationRebalanceResumeInternalServerError{}
+}
+
+/*
+ReplicationRebalanceResumeInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationRebalanceResumeInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance resume internal server error response has a 2xx status code
+func (o *ReplicationRebalanceResumeInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance resume internal server error response has a 3xx status code
+func (o *ReplicationRebalanceResumeInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance resume internal server error response has a 4xx status code
+func (o *ReplicationRebalanceResumeInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalance resume internal server error response has a 5xx status code
+func (o *ReplicationRebalanceResumeInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication rebalance resume internal server error response a status code equal to that given
+func (o *ReplicationRebalanceResumeInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication rebalance resume internal server error response
+func (o *ReplicationRebalanceResumeInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationRebalanceResumeInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance/resume][%d] replicationRebalanceResumeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalanceResumeInternalServerError) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance/resume][%d] replicationRebalanceResumeInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalanceResumeInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceResumeInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
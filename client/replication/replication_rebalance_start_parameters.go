@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewReplicationRebalanceStartParams creates a new ReplicationRebalanceStartParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReplicationRebalanceStartParams() *ReplicationRebalanceStartParams {
+	return &ReplicationRebalanceStartParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReplicationRebalanceStartParamsWithTimeout creates a new ReplicationRebalanceStartParams object
+// with the ability to set a timeout on a request.
+func NewReplicationRebalanceStartParamsWithTimeout(timeout time.Duration) *ReplicationRebalanceStartParams {
+	return &ReplicationRebalanceStartParams{
+		timeout: timeout,
+	}
+}
+
+// NewReplicationRebalanceStartParamsWithContext creates a new ReplicationRebalanceStartParams object
+// with the ability to set a context for a request.
+func NewReplicationRebalanceStartParamsWithContext(ctx context.Context) *ReplicationRebalanceStartParams {
+	return &ReplicationRebalanceStartParams{
+		Context: ctx,
+	}
+}
+
+// NewReplicationRebalanceStartParamsWithHTTPClient creates a new ReplicationRebalanceStartParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReplicationRebalanceStartParamsWithHTTPClient(client *http.Client) *ReplicationRebalanceStartParams {
+	return &ReplicationRebalanceStartParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReplicationRebalanceStartParams contains all the parameters to send to the API endpoint
+
+	for the replication rebalance start operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReplicationRebalanceStartParams struct {
+
+	// Body.
+	Body *models.ReplicationRebalanceRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the replication rebalance start params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationRebalanceStartParams) WithDefaults() *ReplicationRebalanceStartParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the replication rebalance start params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationRebalanceStartParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the replication rebalance start params
+func (o *ReplicationRebalanceStartParams) WithTimeout(timeout time.Duration) *ReplicationRebalanceStartParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the replication rebalance start params
+func (o *ReplicationRebalanceStartParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the replication rebalance start params
+func (o *ReplicationRebalanceStartParams) WithContext(ctx context.Context) *ReplicationRebalanceStartParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the replication rebalance start params
+func (o *ReplicationRebalanceStartParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the replication rebalance start params
+func (o *ReplicationRebalanceStartParams) WithHTTPClient(client *http.Client) *ReplicationRebalanceStartParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the replication rebalance start params
+func (o *ReplicationRebalanceStartParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the replication rebalance start params
+func (o *ReplicationRebalanceStartParams) WithBody(body *models.ReplicationRebalanceRequest) *ReplicationRebalanceStartParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the replication rebalance start params
+func (o *ReplicationRebalanceStartParams) SetBody(body *models.ReplicationRebalanceRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReplicationRebalanceStartParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalanceStartReader is a Reader for the ReplicationRebalanceStart structure.
+type ReplicationRebalanceStartReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationRebalanceStartReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReplicationRebalanceStartOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReplicationRebalanceStartBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewReplicationRebalanceStartUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationRebalanceStartForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReplicationRebalanceStartUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationRebalanceStartInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationRebalanceStartOK creates a ReplicationRebalanceStartOK with default headers values
+func NewReplicationRebalanceStartOK() *ReplicationRebalanceStartOK {
+	return &ReplicationRebalanceStartOK{}
+}
+
+/*
+ReplicationRebalanceStartOK describes a response with status code 200, with default header values.
+
+The state of the rebalance coordinated by this node
+*/
+type ReplicationRebalanceStartOK struct {
+	Payload *models.ReplicationRebalanceStatus
+}
+
+// IsSuccess returns true when this replication rebalance start o k response has a 2xx status code
+func (o *ReplicationRebalanceStartOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication rebalance start o k response has a 3xx status code
+func (o *ReplicationRebalanceStartOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance start o k response has a 4xx status code
+func (o *ReplicationRebalanceStartOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalance start o k response has a 5xx status code
+func (o *ReplicationRebalanceStartOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance start o k response a status code equal to that given
+func (o *ReplicationRebalanceStartOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the replication rebalance start o k response
+func (o *ReplicationRebalanceStartOK) Code() int {
+	return 200
+}
+
+func (o *ReplicationRebalanceStartOK) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance][%d] replicationRebalanceStartOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalanceStartOK) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance][%d] replicationRebalanceStartOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalanceStartOK) GetPayload() *models.ReplicationRebalanceStatus {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceStartOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReplicationRebalanceStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalanceStartBadRequest creates a ReplicationRebalanceStartBadRequest with default headers values
+func NewReplicationRebalanceStartBadRequest() *ReplicationRebalanceStartBadRequest {
+	return &ReplicationRebalanceStartBadRequest{}
+}
+
+/*
+ReplicationRebalanceStartBadRequest describes a response with status code 400, with default header values.
+
+Malformed request.
+*/
+type ReplicationRebalanceStartBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance start bad request response has a 2xx status code
+func (o *ReplicationRebalanceStartBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance start bad request response has a 3xx status code
+func (o *ReplicationRebalanceStartBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance start bad request response has a 4xx status code
+func (o *ReplicationRebalanceStartBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance start bad request response has a 5xx status code
+func (o *ReplicationRebalanceStartBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance start bad request response a status code equal to that given
+func (o *ReplicationRebalanceStartBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the replication rebalance start bad request response
+func (o *ReplicationRebalanceStartBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReplicationRebalanceStartBadRequest) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance][%d] replicationRebalanceStartBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ReplicationRebalanceStartBadRequest) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance][%d] replicationRebalanceStartBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ReplicationRebalanceStartBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceStartBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalanceStartUnauthorized creates a ReplicationRebalanceStartUnauthorized with default headers values
+func NewReplicationRebalanceStartUnauthorized() *ReplicationRebalanceStartUnauthorized {
+	return &ReplicationRebalanceStartUnauthorized{}
+}
+
+/*
+ReplicationRebalanceStartUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationRebalanceStartUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication rebalance start unauthorized response has a 2xx status code
+func (o *ReplicationRebalanceStartUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance start unauthorized response has a 3xx status code
+func (o *ReplicationRebalanceStartUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance start unauthorized response has a 4xx status code
+func (o *ReplicationRebalanceStartUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance start unauthorized response has a 5xx status code
+func (o *ReplicationRebalanceStartUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance start unauthorized response a status code equal to that given
+func (o *ReplicationRebalanceStartUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication rebalance start unauthorized response
+func (o *ReplicationRebalanceStartUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationRebalanceStartUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance][%d] replicationRebalanceStartUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalanceStartUnauthorized) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance][%d] replicationRebalanceStartUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalanceStartUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationRebalanceStartForbidden creates a ReplicationRebalanceStartForbidden with default headers values
+func NewReplicationRebalanceStartForbidden() *ReplicationRebalanceStartForbidden {
+	return &ReplicationRebalanceStartForbidden{}
+}
+
+/*
+ReplicationRebalanceStartForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationRebalanceStartForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance start forbidden response has a 2xx status code
+func (o *ReplicationRebalanceStartForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance start forbidden response has a 3xx status code
+func (o *ReplicationRebalanceStartForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance start forbidden response has a 4xx status code
+func (o *ReplicationRebalanceStartForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance start forbidden response has a 5xx status code
+func (o *ReplicationRebalanceStartForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance start forbidden response a status code equal to that given
+func (o *ReplicationRebalanceStartForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication rebalance start forbidden response
+func (o *ReplicationRebalanceStartForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationRebalanceStartForbidden) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance][%d] replicationRebalanceStartForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalanceStartForbidden) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance][%d] replicationRebalanceStartForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalanceStartForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceStartForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalanceStartUnprocessableEntity creates a ReplicationRebalanceStartUnprocessableEntity with default headers values
+func NewReplicationRebalanceStartUnprocessableEntity() *ReplicationRebalanceStartUnprocessableEntity {
+	return &ReplicationRebalanceStartUnprocessableEntity{}
+}
+
+/*
+ReplicationRebalanceStartUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type ReplicationRebalanceStartUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance start unprocessable entity response has a 2xx status code
+func (o *ReplicationRebalanceStartUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance start unprocessable entity response has a 3xx status code
+func (o *ReplicationRebalanceStartUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance start unprocessable entity response has a 4xx status code
+func (o *ReplicationRebalanceStartUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance start unprocessable entity response has a 5xx status code
+func (o *ReplicationRebalanceStartUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance start unprocessable entity response a status code equal to that given
+func (o *ReplicationRebalanceStartUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the replication rebalance start unprocessable entity response
+func (o *ReplicationRebalanceStartUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReplicationRebalanceStartUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance][%d] replicationRebalanceStartUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationRebalanceStartUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance][%d] replicationRebalanceStartUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationRebalanceStartUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceStartUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalanceStartInternalServerError creates a ReplicationRebalanceStartInternalServerError with default headers values
+func NewReplicationRebalanceStartInternalServerError() *ReplicationRebalanceStartInternalServerError {
+	return &ReplicationRebalanceStartInternalServerError{}
+}
+
+/*
+ReplicationRebalanceStartInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationRebalanceStartInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance start internal server error response has a 2xx status code
+func (o *ReplicationRebalanceStartInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance start internal server error response has a 3xx status code
+func (o *ReplicationRebalanceStartInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance start internal server error response has a 4xx status code
+func (o *ReplicationRebalanceStartInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalance start internal server error response has a 5xx status code
+func (o *ReplicationRebalanceStartInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication rebalance start internal server error response a status code equal to that given
+func (o *ReplicationRebalanceStartInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication rebalance start internal server error response
+func (o *ReplicationRebalanceStartInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationRebalanceStartInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /replication/rebalance][%d] replicationRebalanceStartInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalanceStartInternalServerError) String() string {
+	return fmt.Sprintf("[POST /replication/rebalance][%d] replicationRebalanceStartInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalanceStartInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceStartInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReplicationRebalanceStatusParams creates a new ReplicationRebalanceStatusParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReplicationRebalanceStatusParams() *ReplicationRebalanceStatusParams {
+	return &ReplicationRebalanceStatusParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReplicationRebalanceStatusParamsWithTimeout creates a new ReplicationRebalanceStatusParams object
+// with the ability to set a timeout on a request.
+func NewReplicationRebalanceStatusParamsWithTimeout(timeout time.Duration) *ReplicationRebalanceStatusParams {
+	return &ReplicationRebalanceStatusParams{
+		timeout: timeout,
+	}
+}
+
+// NewReplicationRebalanceStatusParamsWithContext creates a new ReplicationRebalanceStatusParams object
+// with the ability to set a context for a request.
+func NewReplicationRebalanceStatusParamsWithContext(ctx context.Context) *ReplicationRebalanceStatusParams {
+	return &ReplicationRebalanceStatusParams{
+		Context: ctx,
+	}
+}
+
+// NewReplicationRebalanceStatusParamsWithHTTPClient creates a new ReplicationRebalanceStatusParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReplicationRebalanceStatusParamsWithHTTPClient(client *http.Client) *ReplicationRebalanceStatusParams {
+	return &ReplicationRebalanceStatusParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReplicationRebalanceStatusParams contains all the parameters to send to the API endpoint
+
+	for the replication rebalance status operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReplicationRebalanceStatusParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the replication rebalance status params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationRebalanceStatusParams) WithDefaults() *ReplicationRebalanceStatusParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the replication rebalance status params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationRebalanceStatusParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the replication rebalance status params
+func (o *ReplicationRebalanceStatusParams) WithTimeout(timeout time.Duration) *ReplicationRebalanceStatusParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the replication rebalance status params
+func (o *ReplicationRebalanceStatusParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the replication rebalance status params
+func (o *ReplicationRebalanceStatusParams) WithContext(ctx context.Context) *ReplicationRebalanceStatusParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the replication rebalance status params
+func (o *ReplicationRebalanceStatusParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the replication rebalance status params
+func (o *ReplicationRebalanceStatusParams) WithHTTPClient(client *http.Client) *ReplicationRebalanceStatusParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the replication rebalance status params
+func (o *ReplicationRebalanceStatusParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReplicationRebalanceStatusParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
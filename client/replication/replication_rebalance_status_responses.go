@@ -0,0 +1,324 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationRebalanceStatusReader is a Reader for the ReplicationRebalanceStatus structure.
+type ReplicationRebalanceStatusReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationRebalanceStatusReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReplicationRebalanceStatusOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewReplicationRebalanceStatusUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationRebalanceStatusForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationRebalanceStatusInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationRebalanceStatusOK creates a ReplicationRebalanceStatusOK with default headers values
+func NewReplicationRebalanceStatusOK() *ReplicationRebalanceStatusOK {
+	return &ReplicationRebalanceStatusOK{}
+}
+
+/*
+ReplicationRebalanceStatusOK describes a response with status code 200, with default header values.
+
+The state of the rebalance coordinated by this node
+*/
+type ReplicationRebalanceStatusOK struct {
+	Payload *models.ReplicationRebalanceStatus
+}
+
+// IsSuccess returns true when this replication rebalance status o k response has a 2xx status code
+func (o *ReplicationRebalanceStatusOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication rebalance status o k response has a 3xx status code
+func (o *ReplicationRebalanceStatusOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance status o k response has a 4xx status code
+func (o *ReplicationRebalanceStatusOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalance status o k response has a 5xx status code
+func (o *ReplicationRebalanceStatusOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance status o k response a status code equal to that given
+func (o *ReplicationRebalanceStatusOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the replication rebalance status o k response
+func (o *ReplicationRebalanceStatusOK) Code() int {
+	return 200
+}
+
+func (o *ReplicationRebalanceStatusOK) Error() string {
+	return fmt.Sprintf("[GET /replication/rebalance][%d] replicationRebalanceStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalanceStatusOK) String() string {
+	return fmt.Sprintf("[GET /replication/rebalance][%d] replicationRebalanceStatusOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationRebalanceStatusOK) GetPayload() *models.ReplicationRebalanceStatus {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceStatusOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReplicationRebalanceStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalanceStatusUnauthorized creates a ReplicationRebalanceStatusUnauthorized with default headers values
+func NewReplicationRebalanceStatusUnauthorized() *ReplicationRebalanceStatusUnauthorized {
+	return &ReplicationRebalanceStatusUnauthorized{}
+}
+
+/*
+ReplicationRebalanceStatusUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationRebalanceStatusUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication rebalance status unauthorized response has a 2xx status code
+func (o *ReplicationRebalanceStatusUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance status unauthorized response has a 3xx status code
+func (o *ReplicationRebalanceStatusUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance status unauthorized response has a 4xx status code
+func (o *ReplicationRebalanceStatusUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance status unauthorized response has a 5xx status code
+func (o *ReplicationRebalanceStatusUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance status unauthorized response a status code equal to that given
+func (o *ReplicationRebalanceStatusUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication rebalance status unauthorized response
+func (o *ReplicationRebalanceStatusUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationRebalanceStatusUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /replication/rebalance][%d] replicationRebalanceStatusUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalanceStatusUnauthorized) String() string {
+	return fmt.Sprintf("[GET /replication/rebalance][%d] replicationRebalanceStatusUnauthorized ", 401)
+}
+
+func (o *ReplicationRebalanceStatusUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationRebalanceStatusForbidden creates a ReplicationRebalanceStatusForbidden with default headers values
+func NewReplicationRebalanceStatusForbidden() *ReplicationRebalanceStatusForbidden {
+	return &ReplicationRebalanceStatusForbidden{}
+}
+
+/*
+ReplicationRebalanceStatusForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationRebalanceStatusForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance status forbidden response has a 2xx status code
+func (o *ReplicationRebalanceStatusForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance status forbidden response has a 3xx status code
+func (o *ReplicationRebalanceStatusForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance status forbidden response has a 4xx status code
+func (o *ReplicationRebalanceStatusForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication rebalance status forbidden response has a 5xx status code
+func (o *ReplicationRebalanceStatusForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication rebalance status forbidden response a status code equal to that given
+func (o *ReplicationRebalanceStatusForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication rebalance status forbidden response
+func (o *ReplicationRebalanceStatusForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationRebalanceStatusForbidden) Error() string {
+	return fmt.Sprintf("[GET /replication/rebalance][%d] replicationRebalanceStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalanceStatusForbidden) String() string {
+	return fmt.Sprintf("[GET /replication/rebalance][%d] replicationRebalanceStatusForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationRebalanceStatusForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceStatusForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationRebalanceStatusInternalServerError creates a ReplicationRebalanceStatusInternalServerError with default headers values
+func NewReplicationRebalanceStatusInternalServerError() *ReplicationRebalanceStatusInternalServerError {
+	return &ReplicationRebalanceStatusInternalServerError{}
+}
+
+/*
+ReplicationRebalanceStatusInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationRebalanceStatusInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication rebalance status internal server error response has a 2xx status code
+func (o *ReplicationRebalanceStatusInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication rebalance status internal server error response has a 3xx status code
+func (o *ReplicationRebalanceStatusInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication rebalance status internal server error response has a 4xx status code
+func (o *ReplicationRebalanceStatusInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication rebalance status internal server error response has a 5xx status code
+func (o *ReplicationRebalanceStatusInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication rebalance status internal server error response a status code equal to that given
+func (o *ReplicationRebalanceStatusInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication rebalance status internal server error response
+func (o *ReplicationRebalanceStatusInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationRebalanceStatusInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /replication/rebalance][%d] replicationRebalanceStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalanceStatusInternalServerError) String() string {
+	return fmt.Sprintf("[GET /replication/rebalance][%d] replicationRebalanceStatusInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationRebalanceStatusInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationRebalanceStatusInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewReplicationSplitShardParams creates a new ReplicationSplitShardParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReplicationSplitShardParams() *ReplicationSplitShardParams {
+	return &ReplicationSplitShardParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReplicationSplitShardParamsWithTimeout creates a new ReplicationSplitShardParams object
+// with the ability to set a timeout on a request.
+func NewReplicationSplitShardParamsWithTimeout(timeout time.Duration) *ReplicationSplitShardParams {
+	return &ReplicationSplitShardParams{
+		timeout: timeout,
+	}
+}
+
+// NewReplicationSplitShardParamsWithContext creates a new ReplicationSplitShardParams object
+// with the ability to set a context for a request.
+func NewReplicationSplitShardParamsWithContext(ctx context.Context) *ReplicationSplitShardParams {
+	return &ReplicationSplitShardParams{
+		Context: ctx,
+	}
+}
+
+// NewReplicationSplitShardParamsWithHTTPClient creates a new ReplicationSplitShardParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReplicationSplitShardParamsWithHTTPClient(client *http.Client) *ReplicationSplitShardParams {
+	return &ReplicationSplitShardParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+ReplicationSplitShardParams contains all the parameters to send to the API endpoint
+
+	for the replication split shard operation.
+
+	Typically these are written to a http.Request.
+*/
+type ReplicationSplitShardParams struct {
+
+	// Body.
+	Body *models.ReplicationSplitShardRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the replication split shard params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationSplitShardParams) WithDefaults() *ReplicationSplitShardParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the replication split shard params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReplicationSplitShardParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the replication split shard params
+func (o *ReplicationSplitShardParams) WithTimeout(timeout time.Duration) *ReplicationSplitShardParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the replication split shard params
+func (o *ReplicationSplitShardParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the replication split shard params
+func (o *ReplicationSplitShardParams) WithContext(ctx context.Context) *ReplicationSplitShardParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the replication split shard params
+func (o *ReplicationSplitShardParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the replication split shard params
+func (o *ReplicationSplitShardParams) WithHTTPClient(client *http.Client) *ReplicationSplitShardParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the replication split shard params
+func (o *ReplicationSplitShardParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the replication split shard params
+func (o *ReplicationSplitShardParams) WithBody(body *models.ReplicationSplitShardRequest) *ReplicationSplitShardParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the replication split shard params
+func (o *ReplicationSplitShardParams) SetBody(body *models.ReplicationSplitShardRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReplicationSplitShardParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,472 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package replication
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ReplicationSplitShardReader is a Reader for the ReplicationSplitShard structure.
+type ReplicationSplitShardReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReplicationSplitShardReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReplicationSplitShardOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewReplicationSplitShardBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 401:
+		result := NewReplicationSplitShardUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewReplicationSplitShardForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewReplicationSplitShardUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewReplicationSplitShardInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewReplicationSplitShardOK creates a ReplicationSplitShardOK with default headers values
+func NewReplicationSplitShardOK() *ReplicationSplitShardOK {
+	return &ReplicationSplitShardOK{}
+}
+
+/*
+ReplicationSplitShardOK describes a response with status code 200, with default header values.
+
+Shard split registered successfully
+*/
+type ReplicationSplitShardOK struct {
+	Payload *models.ReplicationSplitShardResponse
+}
+
+// IsSuccess returns true when this replication split shard o k response has a 2xx status code
+func (o *ReplicationSplitShardOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this replication split shard o k response has a 3xx status code
+func (o *ReplicationSplitShardOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication split shard o k response has a 4xx status code
+func (o *ReplicationSplitShardOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication split shard o k response has a 5xx status code
+func (o *ReplicationSplitShardOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication split shard o k response a status code equal to that given
+func (o *ReplicationSplitShardOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the replication split shard o k response
+func (o *ReplicationSplitShardOK) Code() int {
+	return 200
+}
+
+func (o *ReplicationSplitShardOK) Error() string {
+	return fmt.Sprintf("[POST /replication/split][%d] replicationSplitShardOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationSplitShardOK) String() string {
+	return fmt.Sprintf("[POST /replication/split][%d] replicationSplitShardOK  %+v", 200, o.Payload)
+}
+
+func (o *ReplicationSplitShardOK) GetPayload() *models.ReplicationSplitShardResponse {
+	return o.Payload
+}
+
+func (o *ReplicationSplitShardOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ReplicationSplitShardResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationSplitShardBadRequest creates a ReplicationSplitShardBadRequest with default headers values
+func NewReplicationSplitShardBadRequest() *ReplicationSplitShardBadRequest {
+	return &ReplicationSplitShardBadRequest{}
+}
+
+/*
+ReplicationSplitShardBadRequest describes a response with status code 400, with default header values.
+
+Malformed request.
+*/
+type ReplicationSplitShardBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication split shard bad request response has a 2xx status code
+func (o *ReplicationSplitShardBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication split shard bad request response has a 3xx status code
+func (o *ReplicationSplitShardBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication split shard bad request response has a 4xx status code
+func (o *ReplicationSplitShardBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication split shard bad request response has a 5xx status code
+func (o *ReplicationSplitShardBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication split shard bad request response a status code equal to that given
+func (o *ReplicationSplitShardBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+// Code gets the status code for the replication split shard bad request response
+func (o *ReplicationSplitShardBadRequest) Code() int {
+	return 400
+}
+
+func (o *ReplicationSplitShardBadRequest) Error() string {
+	return fmt.Sprintf("[POST /replication/split][%d] replicationSplitShardBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ReplicationSplitShardBadRequest) String() string {
+	return fmt.Sprintf("[POST /replication/split][%d] replicationSplitShardBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ReplicationSplitShardBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationSplitShardBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationSplitShardUnauthorized creates a ReplicationSplitShardUnauthorized with default headers values
+func NewReplicationSplitShardUnauthorized() *ReplicationSplitShardUnauthorized {
+	return &ReplicationSplitShardUnauthorized{}
+}
+
+/*
+ReplicationSplitShardUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type ReplicationSplitShardUnauthorized struct {
+}
+
+// IsSuccess returns true when this replication split shard unauthorized response has a 2xx status code
+func (o *ReplicationSplitShardUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication split shard unauthorized response has a 3xx status code
+func (o *ReplicationSplitShardUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication split shard unauthorized response has a 4xx status code
+func (o *ReplicationSplitShardUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication split shard unauthorized response has a 5xx status code
+func (o *ReplicationSplitShardUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication split shard unauthorized response a status code equal to that given
+func (o *ReplicationSplitShardUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the replication split shard unauthorized response
+func (o *ReplicationSplitShardUnauthorized) Code() int {
+	return 401
+}
+
+func (o *ReplicationSplitShardUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /replication/split][%d] replicationSplitShardUnauthorized ", 401)
+}
+
+func (o *ReplicationSplitShardUnauthorized) String() string {
+	return fmt.Sprintf("[POST /replication/split][%d] replicationSplitShardUnauthorized ", 401)
+}
+
+func (o *ReplicationSplitShardUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewReplicationSplitShardForbidden creates a ReplicationSplitShardForbidden with default headers values
+func NewReplicationSplitShardForbidden() *ReplicationSplitShardForbidden {
+	return &ReplicationSplitShardForbidden{}
+}
+
+/*
+ReplicationSplitShardForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type ReplicationSplitShardForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication split shard forbidden response has a 2xx status code
+func (o *ReplicationSplitShardForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication split shard forbidden response has a 3xx status code
+func (o *ReplicationSplitShardForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication split shard forbidden response has a 4xx status code
+func (o *ReplicationSplitShardForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication split shard forbidden response has a 5xx status code
+func (o *ReplicationSplitShardForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication split shard forbidden response a status code equal to that given
+func (o *ReplicationSplitShardForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the replication split shard forbidden response
+func (o *ReplicationSplitShardForbidden) Code() int {
+	return 403
+}
+
+func (o *ReplicationSplitShardForbidden) Error() string {
+	return fmt.Sprintf("[POST /replication/split][%d] replicationSplitShardForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationSplitShardForbidden) String() string {
+	return fmt.Sprintf("[POST /replication/split][%d] replicationSplitShardForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ReplicationSplitShardForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationSplitShardForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationSplitShardUnprocessableEntity creates a ReplicationSplitShardUnprocessableEntity with default headers values
+func NewReplicationSplitShardUnprocessableEntity() *ReplicationSplitShardUnprocessableEntity {
+	return &ReplicationSplitShardUnprocessableEntity{}
+}
+
+/*
+ReplicationSplitShardUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous.
+*/
+type ReplicationSplitShardUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication split shard unprocessable entity response has a 2xx status code
+func (o *ReplicationSplitShardUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication split shard unprocessable entity response has a 3xx status code
+func (o *ReplicationSplitShardUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication split shard unprocessable entity response has a 4xx status code
+func (o *ReplicationSplitShardUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this replication split shard unprocessable entity response has a 5xx status code
+func (o *ReplicationSplitShardUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this replication split shard unprocessable entity response a status code equal to that given
+func (o *ReplicationSplitShardUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the replication split shard unprocessable entity response
+func (o *ReplicationSplitShardUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *ReplicationSplitShardUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /replication/split][%d] replicationSplitShardUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationSplitShardUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /replication/split][%d] replicationSplitShardUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *ReplicationSplitShardUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationSplitShardUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReplicationSplitShardInternalServerError creates a ReplicationSplitShardInternalServerError with default headers values
+func NewReplicationSplitShardInternalServerError() *ReplicationSplitShardInternalServerError {
+	return &ReplicationSplitShardInternalServerError{}
+}
+
+/*
+ReplicationSplitShardInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type ReplicationSplitShardInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this replication split shard internal server error response has a 2xx status code
+func (o *ReplicationSplitShardInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this replication split shard internal server error response has a 3xx status code
+func (o *ReplicationSplitShardInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this replication split shard internal server error response has a 4xx status code
+func (o *ReplicationSplitShardInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this replication split shard internal server error response has a 5xx status code
+func (o *ReplicationSplitShardInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this replication split shard internal server error response a status code equal to that given
+func (o *ReplicationSplitShardInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the replication split shard internal server error response
+func (o *ReplicationSplitShardInternalServerError) Code() int {
+	return 500
+}
+
+func (o *ReplicationSplitShardInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /replication/split][%d] replicationSplitShardInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationSplitShardInternalServerError) String() string {
+	return fmt.Sprintf("[POST /replication/split][%d] replicationSplitShardInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *ReplicationSplitShardInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ReplicationSplitShardInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
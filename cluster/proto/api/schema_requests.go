@@ -45,6 +45,17 @@ type AddReplicaToShardRequest struct {
 	SchemaVersion         uint64
 }
 
+type StartShardSplitRequest struct {
+	Class string
+	Split sharding.Split
+}
+
+// ShardSplitRequest marks a split of Shard as ready on Node, commits or
+// aborts it
+type ShardSplitRequest struct {
+	Class, Shard, Node string
+}
+
 type QueryReadOnlyClassesRequest struct {
 	Classes []string
 }
@@ -60,6 +60,15 @@ type ReplicationDeleteOpRequest struct {
 
 type ReplicationDeleteOpResponse struct{}
 
+// ReplicationDeleteReplicaRequest removes the replica of a shard held by Node
+type ReplicationDeleteReplicaRequest struct {
+	Version int
+
+	Node       string
+	Collection string
+	Shard      string
+}
+
 type ReplicationDetailsRequest struct {
 	Id uint64
 }
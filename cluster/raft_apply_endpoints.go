@@ -127,6 +127,33 @@ func (s *Raft) AddReplicaToShard(ctx context.Context, class, shard, replica stri
 	return s.Execute(ctx, command)
 }
 
+func (s *Raft) SetShardSplitReady(ctx context.Context, class, shard, node string) (uint64, error) {
+	if class == "" || shard == "" || node == "" {
+		return 0, fmt.Errorf("empty class or shard or node : %w", schema.ErrBadRequest)
+	}
+	return s.applyShardSplit(ctx, cmd.ApplyRequest_TYPE_SHARD_SPLIT_READY, cmd.ShardSplitRequest{Class: class, Shard: shard, Node: node})
+}
+
+func (s *Raft) CommitShardSplit(ctx context.Context, class, shard string) (uint64, error) {
+	if class == "" || shard == "" {
+		return 0, fmt.Errorf("empty class or shard : %w", schema.ErrBadRequest)
+	}
+	return s.applyShardSplit(ctx, cmd.ApplyRequest_TYPE_COMMIT_SHARD_SPLIT, cmd.ShardSplitRequest{Class: class, Shard: shard})
+}
+
+func (s *Raft) applyShardSplit(ctx context.Context, t cmd.ApplyRequest_Type, req cmd.ShardSplitRequest) (uint64, error) {
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return 0, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       t,
+		Class:      req.Class,
+		SubCommand: subCommand,
+	}
+	return s.Execute(ctx, command)
+}
+
 func (s *Raft) UpdateShardStatus(ctx context.Context, class, shard, status string) (uint64, error) {
 	if class == "" || shard == "" {
 		return 0, fmt.Errorf("empty class or shard : %w", schema.ErrBadRequest)
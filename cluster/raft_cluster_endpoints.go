@@ -26,6 +26,11 @@ func (s *Raft) LeaderWithID() (string, string) {
 	return string(addr), string(id)
 }
 
+// IsLeader returns whether this node is the leader of the cluster
+func (s *Raft) IsLeader() bool {
+	return s.store.IsLeader()
+}
+
 // StorageCandidates return the nodes in the raft configuration or memberlist storage nodes
 // based on the current configuration of the cluster if it does have  MetadataVoterOnly nodes.
 func (s *Raft) StorageCandidates() []string {
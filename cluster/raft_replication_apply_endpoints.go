@@ -52,8 +52,36 @@ func (s *Raft) ReplicationDisableReplica(node string, collection string, shard s
 	return fmt.Errorf("not implemented")
 }
 
+// ReplicationDeleteReplica removes the replica of a shard held by node from
+// the sharding state. The node drops its local copy once the change is
+// applied. The last replica of a shard can not be deleted.
 func (s *Raft) ReplicationDeleteReplica(node string, collection string, shard string) error {
-	return fmt.Errorf("not implemented")
+	state := s.SchemaReader().CopyShardingState(collection)
+	if state == nil {
+		return fmt.Errorf("%w: collection %s not found", replicationTypes.ErrInvalidRequest, collection)
+	}
+	if err := state.DeleteReplicaFromShard(shard, node); err != nil {
+		return fmt.Errorf("%w: %w", replicationTypes.ErrInvalidRequest, err)
+	}
+
+	subCommand, err := json.Marshal(&api.ReplicationDeleteReplicaRequest{
+		Version:    api.ReplicationCommandVersionV0,
+		Node:       node,
+		Collection: collection,
+		Shard:      shard,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &api.ApplyRequest{
+		Type:       api.ApplyRequest_TYPE_REPLICATION_REPLICA_DELETE,
+		Class:      collection,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(context.Background(), command); err != nil {
+		return err
+	}
+	return nil
 }
 
 func (s *Raft) ReplicationUpdateReplicaOpStatus(id uint64, state api.ShardReplicationState) error {
@@ -76,3 +104,57 @@ func (s *Raft) ReplicationUpdateReplicaOpStatus(id uint64, state api.ShardReplic
 	}
 	return nil
 }
+
+// ReplicationSplitShard starts splitting a shard of a single-tenant
+// collection into parts new shards and returns their names. The split is
+// carried out in the background by the replicas of the shard.
+func (s *Raft) ReplicationSplitShard(collection string, shard string, parts int) ([]string, error) {
+	state := s.SchemaReader().CopyShardingState(collection)
+	if state == nil {
+		return nil, fmt.Errorf("%w: collection %s not found", replicationTypes.ErrInvalidRequest, collection)
+	}
+	split, err := state.PlanSplit(shard, parts)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", replicationTypes.ErrInvalidRequest, err)
+	}
+
+	subCommand, err := json.Marshal(&api.StartShardSplitRequest{Class: collection, Split: split})
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+	command := &api.ApplyRequest{
+		Type:       api.ApplyRequest_TYPE_START_SHARD_SPLIT,
+		Class:      collection,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(context.Background(), command); err != nil {
+		return nil, err
+	}
+	return split.TargetNames(), nil
+}
+
+// ReplicationCancelSplitShard aborts an ongoing split of a shard, which keeps
+// all its objects
+func (s *Raft) ReplicationCancelSplitShard(collection string, shard string) error {
+	state := s.SchemaReader().CopyShardingState(collection)
+	if state == nil {
+		return fmt.Errorf("%w: collection %s not found", replicationTypes.ErrInvalidRequest, collection)
+	}
+	if _, ok := state.Splits[shard]; !ok {
+		return fmt.Errorf("%w: shard %s is not being split", replicationTypes.ErrInvalidRequest, shard)
+	}
+
+	subCommand, err := json.Marshal(&api.ShardSplitRequest{Class: collection, Shard: shard})
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &api.ApplyRequest{
+		Type:       api.ApplyRequest_TYPE_ABORT_SHARD_SPLIT,
+		Class:      collection,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(context.Background(), command); err != nil {
+		return err
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/weaviate/weaviate/cluster/replication/copier/types"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -91,3 +92,89 @@ func (c *Copier) CopyReplica(ctx context.Context, srcNodeId, collectionName, sha
 
 	return nil
 }
+
+// CopyShardSplit copies the local replica of a shard which is being split into
+// each of the split targets and loads them. The source node is expected to be
+// the local node, its changes are tracked from before the files are listed,
+// so that the targets can catch up with the writes the source receives while
+// being copied.
+func (c *Copier) CopyShardSplit(ctx context.Context, srcNodeId, collectionName, sourceShard string, targetShards []string) error {
+	sourceNodeHostname, ok := c.nodeSelector.NodeHostname(srcNodeId)
+	if !ok {
+		return fmt.Errorf("source node address not found in cluster membership for node %s", srcNodeId)
+	}
+	idx := c.indexGetter.GetIndex(schema.ClassName(collectionName))
+	if idx == nil {
+		return fmt.Errorf("could not find collection %s", collectionName)
+	}
+
+	if err := idx.PrepareShardSplit(ctx, sourceShard, targetShards); err != nil {
+		return err
+	}
+
+	err := c.copySplitFiles(ctx, sourceNodeHostname, collectionName, sourceShard, targetShards)
+	// the files of the source only need to stay immutable while being copied
+	if resumeErr := idx.ResumeShardMaintenance(ctx, sourceShard); resumeErr != nil && err == nil {
+		err = resumeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return idx.LoadShardSplit(ctx, sourceShard, targetShards)
+}
+
+// FinishShardSplit replaces the local replica of a shard with the targets of
+// its split after the split was committed. It can be retried until the
+// source is dropped.
+func (c *Copier) FinishShardSplit(ctx context.Context, collectionName, sourceShard string, targetShards []string) error {
+	idx := c.indexGetter.GetIndex(schema.ClassName(collectionName))
+	if idx == nil {
+		return fmt.Errorf("could not find collection %s", collectionName)
+	}
+	return idx.FinishShardSplit(ctx, sourceShard, targetShards)
+}
+
+func (c *Copier) copySplitFiles(ctx context.Context, sourceNodeHostname, collectionName, sourceShard string, targetShards []string) error {
+	relativeFilePaths, err := c.remoteIndex.PauseAndListFiles(ctx, sourceNodeHostname, collectionName, sourceShard)
+	if err != nil {
+		return err
+	}
+	for _, relativeFilePath := range relativeFilePaths {
+		// files of a shard are stored under <index>/<shard>/
+		parts := strings.SplitN(filepath.ToSlash(relativeFilePath), "/", 3)
+		if len(parts) != 3 || parts[1] != sourceShard {
+			return fmt.Errorf("unexpected path %q of a file of shard %s", relativeFilePath, sourceShard)
+		}
+
+		for _, targetShard := range targetShards {
+			targetPath := path.Join(parts[0], targetShard, parts[2])
+			if err := c.copyFile(ctx, sourceNodeHostname, collectionName, sourceShard, relativeFilePath, targetPath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Copier) copyFile(ctx context.Context, sourceNodeHostname, collectionName, shardName, relativeFilePath, targetPath string) error {
+	reader, err := c.remoteIndex.GetFile(ctx, sourceNodeHostname, collectionName, shardName, relativeFilePath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	finalPath := filepath.Join(c.rootDataPath, targetPath)
+	if err := os.MkdirAll(path.Dir(finalPath), os.ModePerm); err != nil {
+		return fmt.Errorf("create parent folder for %s: %w", targetPath, err)
+	}
+
+	f, err := os.Create(finalPath)
+	if err != nil {
+		return fmt.Errorf("open file %q for writing: %w", targetPath, err)
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, reader)
+	return err
+}
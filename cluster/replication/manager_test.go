@@ -164,6 +164,51 @@ func TestManager_Replicate(t *testing.T) {
 	}
 }
 
+func TestManager_ReplicateAfterCompletedOp(t *testing.T) {
+	parser := fakes.NewMockParser()
+	parser.On("ParseClass", mock.Anything).Return(nil)
+	schemaManager := schema.NewSchemaManager("test-node", nil, parser, prometheus.NewPedanticRegistry(), logrus.New())
+	manager := replication.NewManager(logrus.New(), schemaManager.NewSchemaReader(), nil, prometheus.NewPedanticRegistry())
+	require.NoError(t, schemaManager.AddClass(
+		buildApplyRequest("TestCollection", api.ApplyRequest_TYPE_ADD_CLASS, api.AddClassRequest{
+			Class: &models.Class{Class: "TestCollection", MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: false}},
+			State: &sharding.State{
+				Physical: map[string]sharding.Physical{"shard1": {BelongsToNodes: []string{"node1"}}},
+			},
+		}), "node1", true, false))
+
+	replicate := func(id uint64) error {
+		return manager.Replicate(id, buildApplyRequest("TestCollection", api.ApplyRequest_TYPE_REPLICATION_REPLICATE, api.ReplicationReplicateShardRequest{
+			SourceCollection: "TestCollection",
+			SourceShard:      "shard1",
+			SourceNode:       "node1",
+			TargetNode:       "node2",
+		}))
+	}
+	updateState := func(id uint64, state api.ShardReplicationState) error {
+		return manager.UpdateReplicateOpState(buildApplyRequest("TestCollection", api.ApplyRequest_TYPE_REPLICATION_REPLICATE_UPDATE_STATE, api.ReplicationUpdateOpStateRequest{
+			Id:    id,
+			State: state,
+		}))
+	}
+
+	require.NoError(t, replicate(1))
+	require.ErrorIs(t, replicate(2), replication.ErrShardAlreadyReplicating)
+
+	require.NoError(t, updateState(1, api.HYDRATING))
+	require.ErrorIs(t, replicate(2), replication.ErrShardAlreadyReplicating)
+
+	require.NoError(t, updateState(1, api.READY))
+	require.NoError(t, replicate(3))
+	state, ok := manager.GetReplicationFSM().GetReplicaOpState("node2", "TestCollection", "shard1")
+	require.True(t, ok)
+	assert.Equal(t, api.REGISTERED, state)
+
+	require.NoError(t, updateState(3, api.ABORTED))
+	require.NoError(t, replicate(4))
+	require.ErrorIs(t, updateState(3, api.READY), replication.ErrReplicationOpNotFound)
+}
+
 func TestManager_MetricsTracking(t *testing.T) {
 	const metricName = "weaviate_replication_operation_fsm_ops_by_state"
 	t.Run("one replication operation with two state transitions", func(t *testing.T) {
@@ -0,0 +1,244 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/weaviate/weaviate/cluster/replication/types"
+)
+
+// rebalanceTolerance is the share of the average load of a node by which
+// nodes may differ and still be considered balanced
+const rebalanceTolerance = 0.05
+
+// rebalanceShard is a shard as seen by the rebalance planner
+type rebalanceShard struct {
+	collection string
+	shard      string
+	replicas   []string
+	diskBytes  int64
+	// fixed shards count towards the load of their nodes but are never moved,
+	// e.g. inactive tenants or shards being split
+	fixed bool
+}
+
+// planRebalance plans the moves of shard replicas which give every available
+// node about the same share of the replicas and of their size on disk.
+//
+// Replicas held by nodes which are neither available nor unavailable belong
+// to nodes which left the cluster. They are moved to the least loaded nodes
+// first, copying from one of the available replicas. Shards with replicas on
+// unavailable nodes are not moved otherwise. At most maxMoves moves are
+// planned.
+func planRebalance(available, unavailable []string, shards []rebalanceShard, maxMoves int) types.RebalancePlan {
+	p := newRebalancePlanner(available, unavailable, shards)
+
+	var moves []types.RebalanceMove
+	current := p.nodeLoads()
+	for i := range p.shards {
+		for len(moves) < maxMoves {
+			move, ok := p.rehome(i)
+			if !ok {
+				break
+			}
+			moves = append(moves, move)
+		}
+	}
+	for len(moves) < maxMoves {
+		move, ok := p.balance()
+		if !ok {
+			break
+		}
+		moves = append(moves, move)
+	}
+	return types.RebalancePlan{Moves: moves, Current: current, Planned: p.nodeLoads()}
+}
+
+type rebalancePlanner struct {
+	nodes       []string
+	available   map[string]bool
+	unavailable map[string]bool
+	shards      []rebalanceShard
+	moved       []bool
+	loads       map[string]*types.NodeLoad
+
+	totalShards, totalBytes int64
+}
+
+func newRebalancePlanner(available, unavailable []string, shards []rebalanceShard) *rebalancePlanner {
+	p := &rebalancePlanner{
+		nodes:       slices.Clone(available),
+		available:   make(map[string]bool, len(available)),
+		unavailable: make(map[string]bool, len(unavailable)),
+		shards:      make([]rebalanceShard, len(shards)),
+		moved:       make([]bool, len(shards)),
+		loads:       make(map[string]*types.NodeLoad, len(available)),
+	}
+	slices.Sort(p.nodes)
+	for _, node := range available {
+		p.available[node] = true
+		p.loads[node] = &types.NodeLoad{Node: node}
+	}
+	for _, node := range unavailable {
+		p.unavailable[node] = true
+	}
+
+	copy(p.shards, shards)
+	slices.SortFunc(p.shards, func(a, b rebalanceShard) int {
+		return cmp.Or(cmp.Compare(a.collection, b.collection), cmp.Compare(a.shard, b.shard))
+	})
+	for i := range p.shards {
+		sh := &p.shards[i]
+		sh.replicas = slices.Clone(sh.replicas)
+		for _, replica := range sh.replicas {
+			if load, ok := p.loads[replica]; ok {
+				load.Shards++
+				load.DiskBytes += sh.diskBytes
+			}
+			// replicas of nodes which left the cluster are moved to available
+			// nodes, hence they count towards the total
+			if !p.unavailable[replica] {
+				p.totalShards++
+				p.totalBytes += sh.diskBytes
+			}
+		}
+	}
+	return p
+}
+
+func (p *rebalancePlanner) nodeLoads() []types.NodeLoad {
+	loads := make([]types.NodeLoad, len(p.nodes))
+	for i, node := range p.nodes {
+		loads[i] = *p.loads[node]
+	}
+	return loads
+}
+
+// score is the share of the replicas and the share of their size held by
+// load, the scores of all nodes add up to two
+func (p *rebalancePlanner) score(load *types.NodeLoad) float64 {
+	return p.weight(load.Shards, load.DiskBytes)
+}
+
+func (p *rebalancePlanner) weight(shards, diskBytes int64) float64 {
+	if p.totalShards == 0 {
+		return 0
+	}
+	w := float64(shards) / float64(p.totalShards)
+	if p.totalBytes > 0 {
+		w += float64(diskBytes) / float64(p.totalBytes)
+	}
+	return w
+}
+
+// rehome plans a move of a replica of shard i held by a node which left the
+// cluster to the least loaded available node not holding the shard yet
+func (p *rebalancePlanner) rehome(i int) (types.RebalanceMove, bool) {
+	sh := &p.shards[i]
+	if sh.fixed || !slices.ContainsFunc(sh.replicas, func(n string) bool { return p.available[n] }) {
+		return types.RebalanceMove{}, false
+	}
+	source := slices.IndexFunc(sh.replicas, func(n string) bool { return !p.available[n] && !p.unavailable[n] })
+	if source < 0 {
+		return types.RebalanceMove{}, false
+	}
+	var target *types.NodeLoad
+	for _, node := range p.nodes {
+		load := p.loads[node]
+		if slices.Contains(sh.replicas, node) {
+			continue
+		}
+		if target == nil || p.score(load) < p.score(target) {
+			target = load
+		}
+	}
+	if target == nil {
+		return types.RebalanceMove{}, false
+	}
+	return p.move(i, sh.replicas[source], target.Node), true
+}
+
+// balance plans the move from a node with a high load to a node with a low
+// load which brings their loads closest together. Every move must leave the
+// target less loaded than the source was, which guarantees progress.
+func (p *rebalancePlanner) balance() (types.RebalanceMove, bool) {
+	if len(p.nodes) < 2 || p.totalShards == 0 {
+		return types.RebalanceMove{}, false
+	}
+	tolerance := rebalanceTolerance * 2 / float64(len(p.nodes))
+
+	// only shards which are held by available nodes exclusively are moved
+	held := make(map[string][]int, len(p.nodes))
+	for i, sh := range p.shards {
+		if sh.fixed || p.moved[i] || slices.ContainsFunc(sh.replicas, func(n string) bool { return !p.available[n] }) {
+			continue
+		}
+		for _, replica := range sh.replicas {
+			held[replica] = append(held[replica], i)
+		}
+	}
+
+	byScore := slices.Clone(p.nodes)
+	slices.SortStableFunc(byScore, func(a, b string) int {
+		return cmp.Compare(p.score(p.loads[a]), p.score(p.loads[b]))
+	})
+	for s := len(byScore) - 1; s > 0; s-- {
+		source := p.loads[byScore[s]]
+		for _, node := range byScore[:s] {
+			target := p.loads[node]
+			diff := p.score(source) - p.score(target)
+			if diff <= tolerance {
+				break
+			}
+			best, bestDist := -1, math.Inf(1)
+			for _, i := range held[source.Node] {
+				sh := p.shards[i]
+				if slices.Contains(sh.replicas, target.Node) {
+					continue
+				}
+				w := p.weight(1, sh.diskBytes)
+				if w >= diff {
+					continue
+				}
+				if dist := math.Abs(diff - 2*w); dist < bestDist {
+					best, bestDist = i, dist
+				}
+			}
+			if best >= 0 {
+				return p.move(best, source.Node, target.Node), true
+			}
+		}
+	}
+	return types.RebalanceMove{}, false
+}
+
+func (p *rebalancePlanner) move(i int, source, target string) types.RebalanceMove {
+	sh := &p.shards[i]
+	sh.replicas[slices.Index(sh.replicas, source)] = target
+	p.moved[i] = true
+	if load, ok := p.loads[source]; ok {
+		load.Shards--
+		load.DiskBytes -= sh.diskBytes
+	}
+	p.loads[target].Shards++
+	p.loads[target].DiskBytes += sh.diskBytes
+	return types.RebalanceMove{
+		Collection: sh.collection,
+		Shard:      sh.shard,
+		SourceNode: source,
+		TargetNode: target,
+		DiskBytes:  sh.diskBytes,
+	}
+}
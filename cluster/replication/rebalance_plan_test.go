@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/cluster/replication/types"
+)
+
+func loadOf(t *testing.T, loads []types.NodeLoad, node string) types.NodeLoad {
+	t.Helper()
+	for _, load := range loads {
+		if load.Node == node {
+			return load
+		}
+	}
+	t.Fatalf("no load for node %q", node)
+	return types.NodeLoad{}
+}
+
+func TestPlanRebalance(t *testing.T) {
+	t.Run("new node receives shards", func(t *testing.T) {
+		var shards []rebalanceShard
+		for i := 0; i < 6; i++ {
+			shards = append(shards, rebalanceShard{
+				collection: "C", shard: fmt.Sprintf("s%d", i), replicas: []string{[]string{"n1", "n2"}[i%2]}, diskBytes: 100,
+			})
+		}
+
+		plan := planRebalance([]string{"n1", "n2", "n3"}, nil, shards, 100)
+
+		require.Len(t, plan.Moves, 2)
+		for _, move := range plan.Moves {
+			assert.Equal(t, "n3", move.TargetNode)
+		}
+		assert.Equal(t, int64(3), loadOf(t, plan.Current, "n1").Shards)
+		assert.Equal(t, int64(0), loadOf(t, plan.Current, "n3").Shards)
+		for _, node := range []string{"n1", "n2", "n3"} {
+			assert.Equal(t, int64(2), loadOf(t, plan.Planned, node).Shards)
+			assert.Equal(t, int64(200), loadOf(t, plan.Planned, node).DiskBytes)
+		}
+		// the input is not modified
+		assert.Equal(t, []string{"n1"}, shards[0].replicas)
+	})
+
+	t.Run("balanced cluster", func(t *testing.T) {
+		shards := []rebalanceShard{
+			{collection: "C", shard: "s1", replicas: []string{"n1"}, diskBytes: 100},
+			{collection: "C", shard: "s2", replicas: []string{"n2"}, diskBytes: 100},
+		}
+
+		plan := planRebalance([]string{"n1", "n2"}, nil, shards, 100)
+
+		assert.Empty(t, plan.Moves)
+		assert.Equal(t, plan.Current, plan.Planned)
+	})
+
+	t.Run("disk usage is balanced", func(t *testing.T) {
+		shards := []rebalanceShard{
+			{collection: "C", shard: "big", replicas: []string{"n1"}, diskBytes: 1000},
+			{collection: "C", shard: "s1", replicas: []string{"n1"}, diskBytes: 10},
+			{collection: "C", shard: "s2", replicas: []string{"n2"}, diskBytes: 10},
+			{collection: "C", shard: "s3", replicas: []string{"n2"}, diskBytes: 10},
+		}
+
+		plan := planRebalance([]string{"n1", "n2"}, nil, shards, 100)
+
+		require.Len(t, plan.Moves, 1)
+		assert.Equal(t, types.RebalanceMove{
+			Collection: "C", Shard: "s1", SourceNode: "n1", TargetNode: "n2", DiskBytes: 10,
+		}, plan.Moves[0])
+	})
+
+	t.Run("replicas are not placed twice on a node", func(t *testing.T) {
+		shards := []rebalanceShard{
+			{collection: "C", shard: "s1", replicas: []string{"n1", "n2"}, diskBytes: 100},
+			{collection: "C", shard: "s2", replicas: []string{"n1", "n2"}, diskBytes: 100},
+			{collection: "C", shard: "s3", replicas: []string{"n1", "n2"}, diskBytes: 100},
+		}
+
+		plan := planRebalance([]string{"n1", "n2", "n3"}, nil, shards, 100)
+
+		require.Len(t, plan.Moves, 2)
+		seen := map[string]bool{}
+		for _, move := range plan.Moves {
+			assert.Equal(t, "n3", move.TargetNode)
+			assert.False(t, seen[move.Shard], "shard %s moved twice", move.Shard)
+			seen[move.Shard] = true
+		}
+	})
+
+	t.Run("replicas of departed nodes are rehomed", func(t *testing.T) {
+		shards := []rebalanceShard{
+			{collection: "C", shard: "s1", replicas: []string{"n1", "gone"}, diskBytes: 100},
+			{collection: "C", shard: "s2", replicas: []string{"gone"}, diskBytes: 100},
+		}
+
+		plan := planRebalance([]string{"n1", "n2"}, nil, shards, 100)
+
+		require.Len(t, plan.Moves, 1)
+		assert.Equal(t, types.RebalanceMove{
+			Collection: "C", Shard: "s1", SourceNode: "gone", TargetNode: "n2", DiskBytes: 100,
+		}, plan.Moves[0])
+	})
+
+	t.Run("unavailable and fixed shards stay", func(t *testing.T) {
+		shards := []rebalanceShard{
+			{collection: "C", shard: "s1", replicas: []string{"n1"}, diskBytes: 100, fixed: true},
+			{collection: "C", shard: "s2", replicas: []string{"n1"}, diskBytes: 100, fixed: true},
+			{collection: "C", shard: "s3", replicas: []string{"n1", "down"}, diskBytes: 100},
+			{collection: "C", shard: "s4", replicas: []string{"n1", "down"}, diskBytes: 100},
+		}
+
+		plan := planRebalance([]string{"n1", "n2"}, []string{"down"}, shards, 100)
+
+		assert.Empty(t, plan.Moves)
+	})
+
+	t.Run("number of moves is capped", func(t *testing.T) {
+		var shards []rebalanceShard
+		for i := 0; i < 10; i++ {
+			shards = append(shards, rebalanceShard{
+				collection: "C", shard: fmt.Sprintf("s%d", i), replicas: []string{"n1"}, diskBytes: 100,
+			})
+		}
+
+		plan := planRebalance([]string{"n1", "n2"}, nil, shards, 3)
+
+		assert.Len(t, plan.Moves, 3)
+		assert.Equal(t, int64(3), loadOf(t, plan.Planned, "n2").Shards)
+	})
+
+	t.Run("plans are deterministic", func(t *testing.T) {
+		shards := []rebalanceShard{
+			{collection: "B", shard: "s1", replicas: []string{"n1"}, diskBytes: 100},
+			{collection: "A", shard: "s1", replicas: []string{"n1"}, diskBytes: 100},
+			{collection: "A", shard: "s2", replicas: []string{"n1"}, diskBytes: 100},
+			{collection: "A", shard: "s0", replicas: []string{"n1"}, diskBytes: 100},
+		}
+		reversed := []rebalanceShard{shards[3], shards[2], shards[1], shards[0]}
+
+		assert.Equal(t,
+			planRebalance([]string{"n1", "n2"}, nil, shards, 100),
+			planRebalance([]string{"n2", "n1"}, nil, reversed, 100))
+	})
+}
@@ -0,0 +1,490 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/cluster/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	entreplication "github.com/weaviate/weaviate/entities/replication"
+	"github.com/weaviate/weaviate/entities/verbosity"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+const (
+	rebalancerLogAction = "replication_rebalancer"
+	// rebalancerProgressInterval is how often the progress of moves is checked
+	rebalancerProgressInterval = 5 * time.Second
+)
+
+// Rebalancer moves shard replicas between nodes so that every node holds
+// about the same number of replicas and the same amount of data.
+//
+// A move replicates a shard to its target node through a replication op and
+// removes the replica of its source node once the new replica is ready. Only
+// a limited number of moves run at the same time. Pending moves can be paused
+// or canceled, moves which are already replicating always complete.
+//
+// A rebalance is coordinated by the node it was started on. With automatic
+// rebalancing enabled, the leader of the cluster starts a rebalance whenever
+// storage nodes join or leave the cluster.
+//
+// The status of a rebalance, including whether it is paused, lives in the
+// memory of the coordinating node and is not replicated through Raft. If that
+// node restarts, the pending moves are dropped and the status is reset. Moves
+// which were replicating complete their replication op, but the replica of
+// their source node is kept, so the shard has one more replica until it is
+// deleted. A new rebalance plans the remaining moves from the current
+// sharding state.
+type Rebalancer struct {
+	logger *logrus.Entry
+	config entreplication.RebalanceConfig
+
+	schemaReader   schema.SchemaReader
+	replicationFSM *ShardReplicationFSM
+	mover          types.ReplicaMover
+	cluster        types.ClusterReader
+	nodeStatus     types.NodeStatusGetter
+
+	sync.Mutex
+	status             types.RebalanceStatus
+	maxConcurrentMoves int
+	// members are the storage nodes at the last membership check
+	members     []string
+	lastChecked time.Time
+
+	stopChan chan struct{}
+	stopOnce sync.Once
+}
+
+func NewRebalancer(logger *logrus.Logger, config entreplication.RebalanceConfig, schemaReader schema.SchemaReader,
+	replicationFSM *ShardReplicationFSM, mover types.ReplicaMover, cluster types.ClusterReader,
+	nodeStatus types.NodeStatusGetter,
+) *Rebalancer {
+	return &Rebalancer{
+		logger:         logger.WithField("action", rebalancerLogAction),
+		config:         config,
+		schemaReader:   schemaReader,
+		replicationFSM: replicationFSM,
+		mover:          mover,
+		cluster:        cluster,
+		nodeStatus:     nodeStatus,
+		status:         types.RebalanceStatus{State: types.RebalanceStateIdle},
+		stopChan:       make(chan struct{}),
+	}
+}
+
+// Start tracks the progress of rebalances and the cluster membership in the
+// background
+func (r *Rebalancer) Start() {
+	enterrors.GoWrapper(r.monitor, r.logger)
+}
+
+// Stop stops tracking. Replication ops which were already issued complete.
+func (r *Rebalancer) Stop() {
+	r.stopOnce.Do(func() {
+		r.logger.Info("Stopping rebalancer")
+		close(r.stopChan)
+	})
+}
+
+func (r *Rebalancer) monitor() {
+	ticker := time.NewTicker(rebalancerProgressInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stopChan:
+			return
+		case <-ticker.C:
+			r.Lock()
+			r.progress()
+			r.Unlock()
+
+			if r.config.Auto {
+				r.checkMembership()
+			}
+		}
+	}
+}
+
+// Plan returns the moves a rebalance would carry out
+func (r *Rebalancer) Plan(ctx context.Context) (types.RebalancePlan, error) {
+	available, unavailable, shards, err := r.inputs(ctx)
+	if err != nil {
+		return types.RebalancePlan{}, err
+	}
+	return planRebalance(available, unavailable, shards, r.config.MaxMoves), nil
+}
+
+// Rebalance plans a rebalance and starts its first moves
+func (r *Rebalancer) Rebalance(ctx context.Context, maxConcurrentMoves int) (types.RebalanceStatus, error) {
+	if r.active() {
+		return types.RebalanceStatus{}, fmt.Errorf("%w: a rebalance is already running", types.ErrInvalidRequest)
+	}
+	plan, err := r.Plan(ctx)
+	if err != nil {
+		return types.RebalanceStatus{}, err
+	}
+	return r.start(plan, maxConcurrentMoves, false)
+}
+
+func (r *Rebalancer) start(plan types.RebalancePlan, maxConcurrentMoves int, automatic bool) (types.RebalanceStatus, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.activeLocked() {
+		return types.RebalanceStatus{}, fmt.Errorf("%w: a rebalance is already running", types.ErrInvalidRequest)
+	}
+	if maxConcurrentMoves <= 0 {
+		maxConcurrentMoves = r.config.MaxConcurrentMoves
+	}
+	moves := make([]types.RebalanceMoveStatus, len(plan.Moves))
+	for i, move := range plan.Moves {
+		moves[i] = types.RebalanceMoveStatus{RebalanceMove: move, State: types.RebalanceMovePending}
+	}
+	r.maxConcurrentMoves = maxConcurrentMoves
+	r.status = types.RebalanceStatus{
+		State:     types.RebalanceStateRunning,
+		Automatic: automatic,
+		StartedAt: time.Now(),
+		Moves:     moves,
+	}
+	r.logger.WithFields(logrus.Fields{
+		"moves":     len(moves),
+		"automatic": automatic,
+	}).Info("rebalance started")
+
+	r.progress()
+	return r.statusLocked(), nil
+}
+
+// Status returns the progress of the last rebalance
+func (r *Rebalancer) Status() types.RebalanceStatus {
+	r.Lock()
+	defer r.Unlock()
+	return r.statusLocked()
+}
+
+// Pause stops starting new moves
+func (r *Rebalancer) Pause() (types.RebalanceStatus, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.status.State != types.RebalanceStateRunning {
+		return types.RebalanceStatus{}, fmt.Errorf("%w: no rebalance is running", types.ErrInvalidRequest)
+	}
+	r.status.State = types.RebalanceStatePaused
+	r.logger.Info("rebalance paused")
+	return r.statusLocked(), nil
+}
+
+// Resume continues starting the pending moves of a paused rebalance
+func (r *Rebalancer) Resume() (types.RebalanceStatus, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.status.State != types.RebalanceStatePaused {
+		return types.RebalanceStatus{}, fmt.Errorf("%w: no rebalance is paused", types.ErrInvalidRequest)
+	}
+	r.status.State = types.RebalanceStateRunning
+	r.logger.Info("rebalance resumed")
+	r.progress()
+	return r.statusLocked(), nil
+}
+
+// Cancel drops the pending moves. Moves which are replicating complete.
+func (r *Rebalancer) Cancel() error {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.status.State != types.RebalanceStateRunning && r.status.State != types.RebalanceStatePaused {
+		return fmt.Errorf("%w: no rebalance is running", types.ErrInvalidRequest)
+	}
+	for i := range r.status.Moves {
+		if r.status.Moves[i].State == types.RebalanceMovePending {
+			r.status.Moves[i].State = types.RebalanceMoveCanceled
+		}
+	}
+	r.status.State = types.RebalanceStateCanceled
+	r.logger.Info("rebalance canceled")
+	return nil
+}
+
+func (r *Rebalancer) statusLocked() types.RebalanceStatus {
+	status := r.status
+	status.Moves = slices.Clone(r.status.Moves)
+	return status
+}
+
+func (r *Rebalancer) active() bool {
+	r.Lock()
+	defer r.Unlock()
+	return r.activeLocked()
+}
+
+// activeLocked returns whether moves are pending or replicating
+func (r *Rebalancer) activeLocked() bool {
+	if r.status.State == types.RebalanceStateRunning || r.status.State == types.RebalanceStatePaused {
+		return true
+	}
+	return slices.ContainsFunc(r.status.Moves, func(m types.RebalanceMoveStatus) bool {
+		return m.State == types.RebalanceMoveReplicating
+	})
+}
+
+// progress completes the moves whose new replica is ready and starts pending
+// moves as long as the rebalance is running. The caller must hold the lock.
+func (r *Rebalancer) progress() {
+	replicating := 0
+	for i := range r.status.Moves {
+		move := &r.status.Moves[i]
+		if move.State != types.RebalanceMoveReplicating {
+			continue
+		}
+		r.checkMove(move)
+		if move.State == types.RebalanceMoveReplicating {
+			replicating++
+		}
+	}
+
+	if r.status.State != types.RebalanceStateRunning {
+		return
+	}
+	pending := false
+	for i := range r.status.Moves {
+		move := &r.status.Moves[i]
+		if move.State != types.RebalanceMovePending {
+			continue
+		}
+		if replicating >= r.maxConcurrentMoves {
+			pending = true
+			break
+		}
+		r.startMove(move)
+		if move.State == types.RebalanceMoveReplicating {
+			replicating++
+		}
+	}
+	if !pending && replicating == 0 {
+		r.status.State = types.RebalanceStateFinished
+		r.logger.Info("rebalance finished")
+	}
+}
+
+func (r *Rebalancer) startMove(move *types.RebalanceMoveStatus) {
+	logger := r.moveLogger(move)
+
+	// replicas of nodes which left the cluster are copied from another replica
+	copyFrom := move.SourceNode
+	if nodes := r.cluster.StorageCandidates(); !slices.Contains(nodes, copyFrom) {
+		replicas, err := r.schemaReader.ShardReplicas(move.Collection, move.Shard)
+		if err != nil {
+			r.failMove(move, err)
+			return
+		}
+		i := slices.IndexFunc(replicas, func(n string) bool { return n != move.SourceNode && slices.Contains(nodes, n) })
+		if i < 0 {
+			r.failMove(move, fmt.Errorf("no replica left to copy from"))
+			return
+		}
+		copyFrom = replicas[i]
+	}
+
+	if err := r.mover.ReplicationReplicateReplica(copyFrom, move.Collection, move.Shard, move.TargetNode); err != nil {
+		r.failMove(move, err)
+		return
+	}
+	move.State = types.RebalanceMoveReplicating
+	logger.WithField("copyFrom", copyFrom).Info("move started")
+}
+
+// checkMove removes the source replica of move once the replica on the target
+// node is ready
+func (r *Rebalancer) checkMove(move *types.RebalanceMoveStatus) {
+	state, ok := r.replicationFSM.GetReplicaOpState(move.TargetNode, move.Collection, move.Shard)
+	if !ok {
+		// the op might have been removed after it completed
+		replicas, err := r.schemaReader.ShardReplicas(move.Collection, move.Shard)
+		if err != nil || !slices.Contains(replicas, move.TargetNode) {
+			r.failMove(move, fmt.Errorf("replication op not found"))
+			return
+		}
+		state = api.READY
+	}
+	switch state {
+	case api.READY:
+	case api.ABORTED:
+		r.failMove(move, fmt.Errorf("replication op aborted"))
+		return
+	default:
+		return
+	}
+
+	replicas, err := r.schemaReader.ShardReplicas(move.Collection, move.Shard)
+	if err != nil {
+		r.failMove(move, err)
+		return
+	}
+	if !slices.Contains(replicas, move.TargetNode) {
+		// the local schema did not catch up with the op yet
+		return
+	}
+	if slices.Contains(replicas, move.SourceNode) {
+		if err := r.mover.ReplicationDeleteReplica(move.SourceNode, move.Collection, move.Shard); err != nil {
+			// retried on the next check
+			r.moveLogger(move).WithError(err).Warn("could not delete source replica")
+			return
+		}
+	}
+	move.State = types.RebalanceMoveDone
+	r.moveLogger(move).Info("move done")
+}
+
+func (r *Rebalancer) failMove(move *types.RebalanceMoveStatus, err error) {
+	move.State = types.RebalanceMoveFailed
+	move.Error = err.Error()
+	r.moveLogger(move).WithError(err).Warn("move failed")
+}
+
+func (r *Rebalancer) moveLogger(move *types.RebalanceMoveStatus) *logrus.Entry {
+	return r.logger.WithFields(logrus.Fields{
+		"collection": move.Collection,
+		"shard":      move.Shard,
+		"source":     move.SourceNode,
+		"target":     move.TargetNode,
+	})
+}
+
+// checkMembership starts a rebalance on the leader when storage nodes joined
+// or left the cluster since the last check
+func (r *Rebalancer) checkMembership() {
+	r.Lock()
+	due := time.Since(r.lastChecked) >= r.config.Interval
+	if due {
+		r.lastChecked = time.Now()
+	}
+	r.Unlock()
+	if !due {
+		return
+	}
+
+	if !r.cluster.IsLeader() {
+		// a new leader takes its own snapshot of the members
+		r.Lock()
+		r.members = nil
+		r.Unlock()
+		return
+	}
+
+	members := slices.Clone(r.cluster.StorageCandidates())
+	slices.Sort(members)
+
+	r.Lock()
+	previous := r.members
+	if previous == nil {
+		r.members = members
+	}
+	r.Unlock()
+	if previous == nil || slices.Equal(previous, members) || r.active() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	plan, err := r.Plan(ctx)
+	if err != nil {
+		r.logger.WithError(err).Warn("could not plan rebalance")
+		return
+	}
+
+	r.logger.WithFields(logrus.Fields{
+		"previous": previous,
+		"members":  members,
+	}).Info("storage nodes changed")
+	if len(plan.Moves) > 0 {
+		if _, err := r.start(plan, 0, true); err != nil {
+			r.logger.WithError(err).Warn("could not start rebalance")
+			return
+		}
+	}
+
+	r.Lock()
+	r.members = members
+	r.Unlock()
+}
+
+// inputs collects the nodes and shards to plan a rebalance for. Nodes which
+// are storage candidates and report to be healthy are available, all other
+// storage candidates are unavailable.
+func (r *Rebalancer) inputs(ctx context.Context) (available, unavailable []string, shards []rebalanceShard, err error) {
+	statuses, err := r.nodeStatus.GetNodeStatus(ctx, "", verbosity.OutputVerbose)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("get nodes status: %w", err)
+	}
+
+	type shardKey struct{ collection, shard string }
+	diskBytes := map[shardKey]int64{}
+	healthy := map[string]bool{}
+	for _, status := range statuses {
+		if status.Status != nil && *status.Status == models.NodeStatusStatusHEALTHY {
+			healthy[status.Name] = true
+		}
+		for _, shard := range status.Shards {
+			key := shardKey{shard.Class, shard.Name}
+			diskBytes[key] = max(diskBytes[key], shard.DiskBytes)
+		}
+	}
+	for _, node := range r.cluster.StorageCandidates() {
+		if healthy[node] {
+			available = append(available, node)
+		} else {
+			unavailable = append(unavailable, node)
+		}
+	}
+
+	for _, class := range r.schemaReader.ReadOnlySchema().Classes {
+		err := r.schemaReader.Read(class.Class, func(_ *models.Class, state *sharding.State) error {
+			splitting := map[string]bool{}
+			for source, split := range state.Splits {
+				splitting[source] = true
+				for _, target := range split.TargetNames() {
+					splitting[target] = true
+				}
+			}
+			for name, physical := range state.Physical {
+				shards = append(shards, rebalanceShard{
+					collection: class.Class,
+					shard:      name,
+					replicas:   slices.Clone(physical.BelongsToNodes),
+					diskBytes:  diskBytes[shardKey{class.Class, name}],
+					fixed: splitting[name] ||
+						(physical.Status != "" && physical.Status != models.TenantActivityStatusHOT),
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("read sharding state of %s: %w", class.Class, err)
+		}
+	}
+	return available, unavailable, shards, nil
+}
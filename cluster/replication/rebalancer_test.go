@@ -0,0 +1,360 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/models"
+	entreplication "github.com/weaviate/weaviate/entities/replication"
+	"github.com/weaviate/weaviate/usecases/fakes"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func rebalanceApplyRequest(t *testing.T, class string, cmdType api.ApplyRequest_Type, subCmd interface{}) *api.ApplyRequest {
+	t.Helper()
+	subCommand, err := json.Marshal(subCmd)
+	require.NoError(t, err)
+	return &api.ApplyRequest{Type: cmdType, Class: class, SubCommand: subCommand}
+}
+
+// fakeRebalanceCluster serves as the cluster, the nodes status and the mover
+// of a rebalancer. Moves are registered in the replication FSM and applied to
+// the schema the way raft and the replication engine would.
+type fakeRebalanceCluster struct {
+	t         *testing.T
+	schema    *schema.SchemaManager
+	fsm       *ShardReplicationFSM
+	nodes     []string
+	leader    bool
+	nextOpID  uint64
+	copies    []string
+	deleteErr error
+}
+
+func newFakeRebalanceCluster(t *testing.T, nodes ...string) *fakeRebalanceCluster {
+	parser := fakes.NewMockParser()
+	parser.On("ParseClass", mock.Anything).Return(nil)
+	return &fakeRebalanceCluster{
+		t:      t,
+		schema: schema.NewSchemaManager("n1", nil, parser, prometheus.NewPedanticRegistry(), logrus.New()),
+		fsm:    newShardReplicationFSM(prometheus.NewPedanticRegistry()),
+		nodes:  nodes,
+		leader: true,
+	}
+}
+
+func (c *fakeRebalanceCluster) addClass(class string, physical map[string]sharding.Physical) {
+	require.NoError(c.t, c.schema.AddClass(rebalanceApplyRequest(c.t, class, api.ApplyRequest_TYPE_ADD_CLASS, api.AddClassRequest{
+		Class: &models.Class{Class: class, MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: false}},
+		State: &sharding.State{Physical: physical},
+	}), "n1", true, false))
+}
+
+func (c *fakeRebalanceCluster) StorageCandidates() []string { return c.nodes }
+
+func (c *fakeRebalanceCluster) IsLeader() bool { return c.leader }
+
+func (c *fakeRebalanceCluster) GetNodeStatus(ctx context.Context, className, verbosity string) ([]*models.NodeStatus, error) {
+	statuses := make([]*models.NodeStatus, len(c.nodes))
+	for i, node := range c.nodes {
+		healthy := models.NodeStatusStatusHEALTHY
+		statuses[i] = &models.NodeStatus{Name: node, Status: &healthy}
+	}
+	return statuses, nil
+}
+
+func (c *fakeRebalanceCluster) ReplicationReplicateReplica(sourceNode, sourceCollection, sourceShard, targetNode string) error {
+	c.nextOpID++
+	c.copies = append(c.copies, fmt.Sprintf("%s/%s %s->%s", sourceCollection, sourceShard, sourceNode, targetNode))
+	return c.fsm.Replicate(c.nextOpID, &api.ReplicationReplicateShardRequest{
+		SourceNode:       sourceNode,
+		SourceCollection: sourceCollection,
+		SourceShard:      sourceShard,
+		TargetNode:       targetNode,
+	})
+}
+
+func (c *fakeRebalanceCluster) ReplicationDeleteReplica(node, collection, shard string) error {
+	if c.deleteErr != nil {
+		return c.deleteErr
+	}
+	return c.schema.DeleteReplicaFromShard(rebalanceApplyRequest(c.t, collection, api.ApplyRequest_TYPE_REPLICATION_REPLICA_DELETE,
+		api.ReplicationDeleteReplicaRequest{Node: node, Collection: collection, Shard: shard}), true)
+}
+
+// finishOp completes the replication op of move like the replication engine
+func (c *fakeRebalanceCluster) finishOp(move types.RebalanceMove) {
+	op, ok := c.fsm.opsByTargetFQDN[newShardFQDN(move.TargetNode, move.Collection, move.Shard)]
+	require.True(c.t, ok)
+	require.NoError(c.t, c.schema.AddReplicaToShard(rebalanceApplyRequest(c.t, move.Collection, api.ApplyRequest_TYPE_ADD_REPLICA_TO_SHARD,
+		api.AddReplicaToShardRequest{Class: move.Collection, Shard: move.Shard, Replica: move.TargetNode}), true))
+	require.NoError(c.t, c.fsm.UpdateReplicationOpStatus(&api.ReplicationUpdateOpStateRequest{Id: op.id, State: api.READY}))
+}
+
+// removeOp deletes the op of move from the replication FSM
+func (c *fakeRebalanceCluster) removeOp(move types.RebalanceMove) {
+	op, ok := c.fsm.opsByTargetFQDN[newShardFQDN(move.TargetNode, move.Collection, move.Shard)]
+	require.True(c.t, ok)
+	require.NoError(c.t, c.fsm.DeleteReplicationOp(&api.ReplicationDeleteOpRequest{Id: op.id}))
+}
+
+func (c *fakeRebalanceCluster) abortOp(move types.RebalanceMove) {
+	op, ok := c.fsm.opsByTargetFQDN[newShardFQDN(move.TargetNode, move.Collection, move.Shard)]
+	require.True(c.t, ok)
+	require.NoError(c.t, c.fsm.UpdateReplicationOpStatus(&api.ReplicationUpdateOpStateRequest{Id: op.id, State: api.ABORTED}))
+}
+
+func (c *fakeRebalanceCluster) newRebalancer(config entreplication.RebalanceConfig) *Rebalancer {
+	return NewRebalancer(logrus.New(), config, c.schema.NewSchemaReader(), c.fsm, c, c, c)
+}
+
+func shardsOnNode(node string, n int) map[string]sharding.Physical {
+	physical := make(map[string]sharding.Physical, n)
+	for i := 0; i < n; i++ {
+		physical[fmt.Sprintf("s%d", i)] = sharding.Physical{Name: fmt.Sprintf("s%d", i), BelongsToNodes: []string{node}}
+	}
+	return physical
+}
+
+func moveStates(status types.RebalanceStatus) []types.RebalanceMoveState {
+	states := make([]types.RebalanceMoveState, len(status.Moves))
+	for i, move := range status.Moves {
+		states[i] = move.State
+	}
+	return states
+}
+
+func (r *Rebalancer) tick() types.RebalanceStatus {
+	r.Lock()
+	defer r.Unlock()
+	r.progress()
+	return r.statusLocked()
+}
+
+func TestRebalancerMoves(t *testing.T) {
+	cluster := newFakeRebalanceCluster(t, "n1", "n2")
+	cluster.addClass("C", shardsOnNode("n1", 4))
+	r := cluster.newRebalancer(entreplication.RebalanceConfig{MaxConcurrentMoves: 1, MaxMoves: 100})
+
+	plan, err := r.Plan(context.Background())
+	require.NoError(t, err)
+	require.Len(t, plan.Moves, 2)
+
+	status, err := r.Rebalance(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, types.RebalanceStateRunning, status.State)
+	assert.False(t, status.Automatic)
+	assert.Equal(t, []types.RebalanceMoveState{types.RebalanceMoveReplicating, types.RebalanceMovePending}, moveStates(status))
+	assert.Len(t, cluster.copies, 1)
+
+	_, err = r.Rebalance(context.Background(), 0)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// nothing changes until the op is ready
+	status = r.tick()
+	assert.Equal(t, []types.RebalanceMoveState{types.RebalanceMoveReplicating, types.RebalanceMovePending}, moveStates(status))
+
+	first := status.Moves[0].RebalanceMove
+	cluster.finishOp(first)
+	status = r.tick()
+	assert.Equal(t, []types.RebalanceMoveState{types.RebalanceMoveDone, types.RebalanceMoveReplicating}, moveStates(status))
+	replicas, err := cluster.schema.NewSchemaReader().ShardReplicas(first.Collection, first.Shard)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"n2"}, replicas)
+
+	cluster.finishOp(status.Moves[1].RebalanceMove)
+	status = r.tick()
+	assert.Equal(t, types.RebalanceStateFinished, status.State)
+	assert.Equal(t, []types.RebalanceMoveState{types.RebalanceMoveDone, types.RebalanceMoveDone}, moveStates(status))
+
+	plan, err = r.Plan(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, plan.Moves)
+}
+
+func TestRebalancerDeleteRetried(t *testing.T) {
+	cluster := newFakeRebalanceCluster(t, "n1", "n2")
+	cluster.addClass("C", shardsOnNode("n1", 2))
+	r := cluster.newRebalancer(entreplication.RebalanceConfig{MaxConcurrentMoves: 1, MaxMoves: 100})
+
+	status, err := r.Rebalance(context.Background(), 0)
+	require.NoError(t, err)
+	require.Len(t, status.Moves, 1)
+
+	cluster.finishOp(status.Moves[0].RebalanceMove)
+	cluster.deleteErr = fmt.Errorf("no leader")
+	status = r.tick()
+	assert.Equal(t, types.RebalanceMoveReplicating, status.Moves[0].State)
+
+	cluster.deleteErr = nil
+	status = r.tick()
+	assert.Equal(t, types.RebalanceMoveDone, status.Moves[0].State)
+	assert.Equal(t, types.RebalanceStateFinished, status.State)
+}
+
+func TestRebalancerRemovedOp(t *testing.T) {
+	cluster := newFakeRebalanceCluster(t, "n1", "n2")
+	cluster.addClass("C", shardsOnNode("n1", 4))
+	r := cluster.newRebalancer(entreplication.RebalanceConfig{MaxConcurrentMoves: 2, MaxMoves: 100})
+
+	status, err := r.Rebalance(context.Background(), 0)
+	require.NoError(t, err)
+	require.Len(t, status.Moves, 2)
+
+	// the op of a completed move is removed before it was checked
+	cluster.finishOp(status.Moves[0].RebalanceMove)
+	cluster.removeOp(status.Moves[0].RebalanceMove)
+	// the op of an incomplete move is removed
+	cluster.removeOp(status.Moves[1].RebalanceMove)
+
+	status = r.tick()
+	assert.Equal(t, []types.RebalanceMoveState{types.RebalanceMoveDone, types.RebalanceMoveFailed}, moveStates(status))
+	replicas, err := cluster.schema.NewSchemaReader().ShardReplicas("C", status.Moves[0].Shard)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"n2"}, replicas)
+}
+
+func TestRebalancerPauseResumeCancel(t *testing.T) {
+	cluster := newFakeRebalanceCluster(t, "n1", "n2", "n3")
+	cluster.addClass("C", shardsOnNode("n1", 6))
+	r := cluster.newRebalancer(entreplication.RebalanceConfig{MaxConcurrentMoves: 1, MaxMoves: 100})
+
+	_, err := r.Pause()
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	require.ErrorIs(t, r.Cancel(), types.ErrInvalidRequest)
+
+	status, err := r.Rebalance(context.Background(), 0)
+	require.NoError(t, err)
+	require.Len(t, status.Moves, 4)
+
+	status, err = r.Pause()
+	require.NoError(t, err)
+	assert.Equal(t, types.RebalanceStatePaused, status.State)
+	_, err = r.Pause()
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	// replicating moves complete while paused, pending moves wait
+	cluster.finishOp(status.Moves[0].RebalanceMove)
+	status = r.tick()
+	assert.Equal(t, types.RebalanceStatePaused, status.State)
+	assert.Equal(t, []types.RebalanceMoveState{
+		types.RebalanceMoveDone, types.RebalanceMovePending, types.RebalanceMovePending, types.RebalanceMovePending,
+	}, moveStates(status))
+
+	status, err = r.Resume()
+	require.NoError(t, err)
+	assert.Equal(t, types.RebalanceStateRunning, status.State)
+	assert.Equal(t, types.RebalanceMoveReplicating, status.Moves[1].State)
+
+	require.NoError(t, r.Cancel())
+	status = r.Status()
+	assert.Equal(t, types.RebalanceStateCanceled, status.State)
+	assert.Equal(t, []types.RebalanceMoveState{
+		types.RebalanceMoveDone, types.RebalanceMoveReplicating, types.RebalanceMoveCanceled, types.RebalanceMoveCanceled,
+	}, moveStates(status))
+
+	// a new rebalance waits for the replicating move
+	_, err = r.Rebalance(context.Background(), 0)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	cluster.finishOp(status.Moves[1].RebalanceMove)
+	status = r.tick()
+	assert.Equal(t, types.RebalanceStateCanceled, status.State)
+	assert.Equal(t, types.RebalanceMoveDone, status.Moves[1].State)
+
+	status, err = r.Rebalance(context.Background(), 2)
+	require.NoError(t, err)
+	assert.Equal(t, types.RebalanceStateRunning, status.State)
+	assert.Len(t, cluster.copies, 4)
+}
+
+func TestRebalancerFailedMove(t *testing.T) {
+	cluster := newFakeRebalanceCluster(t, "n1", "n2")
+	cluster.addClass("C", shardsOnNode("n1", 2))
+	r := cluster.newRebalancer(entreplication.RebalanceConfig{MaxConcurrentMoves: 1, MaxMoves: 100})
+
+	status, err := r.Rebalance(context.Background(), 0)
+	require.NoError(t, err)
+	require.Len(t, status.Moves, 1)
+
+	cluster.abortOp(status.Moves[0].RebalanceMove)
+	status = r.tick()
+	assert.Equal(t, types.RebalanceMoveFailed, status.Moves[0].State)
+	assert.NotEmpty(t, status.Moves[0].Error)
+	assert.Equal(t, types.RebalanceStateFinished, status.State)
+
+	// the source replica is kept
+	replicas, err := cluster.schema.NewSchemaReader().ShardReplicas("C", status.Moves[0].Shard)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"n1"}, replicas)
+}
+
+func TestRebalancerDepartedNode(t *testing.T) {
+	cluster := newFakeRebalanceCluster(t, "n1", "n2")
+	cluster.addClass("C", map[string]sharding.Physical{
+		"s0": {Name: "s0", BelongsToNodes: []string{"n1", "gone"}},
+	})
+	r := cluster.newRebalancer(entreplication.RebalanceConfig{MaxConcurrentMoves: 1, MaxMoves: 100})
+
+	status, err := r.Rebalance(context.Background(), 0)
+	require.NoError(t, err)
+	require.Len(t, status.Moves, 1)
+	assert.Equal(t, "gone", status.Moves[0].SourceNode)
+	assert.Equal(t, []string{"C/s0 n1->n2"}, cluster.copies)
+
+	cluster.finishOp(status.Moves[0].RebalanceMove)
+	status = r.tick()
+	assert.Equal(t, types.RebalanceMoveDone, status.Moves[0].State)
+	replicas, err := cluster.schema.NewSchemaReader().ShardReplicas("C", "s0")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"n1", "n2"}, replicas)
+}
+
+func TestRebalancerMembership(t *testing.T) {
+	cluster := newFakeRebalanceCluster(t, "n1")
+	cluster.addClass("C", shardsOnNode("n1", 4))
+	r := cluster.newRebalancer(entreplication.RebalanceConfig{Auto: true, Interval: time.Nanosecond, MaxConcurrentMoves: 1, MaxMoves: 100})
+
+	// the first check takes a snapshot of the members
+	r.checkMembership()
+	assert.Equal(t, types.RebalanceStateIdle, r.Status().State)
+
+	cluster.leader = false
+	cluster.nodes = []string{"n1", "n2"}
+	r.checkMembership()
+	assert.Equal(t, types.RebalanceStateIdle, r.Status().State)
+
+	// a new leader takes its own snapshot first
+	cluster.leader = true
+	r.checkMembership()
+	assert.Equal(t, types.RebalanceStateIdle, r.Status().State)
+
+	cluster.nodes = []string{"n1", "n2", "n3"}
+	r.checkMembership()
+	status := r.Status()
+	assert.Equal(t, types.RebalanceStateRunning, status.State)
+	assert.True(t, status.Automatic)
+	assert.NotEmpty(t, status.Moves)
+}
@@ -31,8 +31,17 @@ func (s *ShardReplicationFSM) Replicate(id uint64, c *api.ReplicationReplicateSh
 
 	srcFQDN := newShardFQDN(c.SourceNode, c.SourceCollection, c.SourceShard)
 	targetFQDN := newShardFQDN(c.TargetNode, c.SourceCollection, c.SourceShard)
-	if _, ok := s.opsByTargetFQDN[targetFQDN]; ok {
-		return ErrShardAlreadyReplicating
+	if existing, ok := s.opsByTargetFQDN[targetFQDN]; ok {
+		// A completed op does not prevent replicating the shard to the same
+		// node again, e.g. after the replica was moved away in the meantime
+		switch s.opsStatus[existing].state {
+		case api.READY, api.ABORTED:
+			if err := s.removeOp(existing); err != nil {
+				return err
+			}
+		default:
+			return ErrShardAlreadyReplicating
+		}
 	}
 
 	op := shardReplicationOp{
@@ -76,19 +85,24 @@ func (s *ShardReplicationFSM) deleteShardReplicationOp(id uint64) error {
 	s.opsLock.Lock()
 	defer s.opsLock.Unlock()
 
-	var err error
 	op, ok := s.opsById[id]
 	if !ok {
 		return ErrReplicationOpNotFound
 	}
+	return s.removeOp(op)
+}
 
-	ops, ok := s.opsByNode[op.sourceShard.nodeId]
+// removeOp removes op from all indexes, the caller must hold opsLock
+func (s *ShardReplicationFSM) removeOp(op shardReplicationOp) error {
+	var err error
+	// opsByNode is indexed by the target node of the op
+	ops, ok := s.opsByNode[op.targetShard.nodeId]
 	if !ok {
 		err = multierror.Append(err, fmt.Errorf("could not find op in ops by node, this should not happen"))
 	}
 	opsReplace, ok := findAndDeleteOp(op.id, ops)
 	if ok {
-		s.opsByNode[op.sourceShard.nodeId] = opsReplace
+		s.opsByNode[op.targetShard.nodeId] = opsReplace
 	}
 
 	ops, ok = s.opsByCollection[op.sourceShard.collectionId]
@@ -130,7 +144,7 @@ func findAndDeleteOp(id uint64, ops []shardReplicationOp) ([]shardReplicationOp,
 		}
 	}
 	if ok {
-		ops = slices.Delete(ops, indexToDelete, indexToDelete)
+		ops = slices.Delete(ops, indexToDelete, indexToDelete+1)
 	}
 	return ops, ok
 }
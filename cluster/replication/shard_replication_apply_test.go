@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+func TestFindAndDeleteOp(t *testing.T) {
+	ops := func(ids ...uint64) []shardReplicationOp {
+		out := make([]shardReplicationOp, 0, len(ids))
+		for _, id := range ids {
+			out = append(out, shardReplicationOp{id: id})
+		}
+		return out
+	}
+
+	tests := []struct {
+		name     string
+		id       uint64
+		ops      []shardReplicationOp
+		expected []shardReplicationOp
+		found    bool
+	}{
+		{name: "only op", id: 1, ops: ops(1), expected: ops(), found: true},
+		{name: "first op", id: 1, ops: ops(1, 2, 3), expected: ops(2, 3), found: true},
+		{name: "middle op", id: 2, ops: ops(1, 2, 3), expected: ops(1, 3), found: true},
+		{name: "last op", id: 3, ops: ops(1, 2, 3), expected: ops(1, 2), found: true},
+		{name: "missing op", id: 4, ops: ops(1, 2, 3), expected: ops(1, 2, 3), found: false},
+		{name: "no ops", id: 1, ops: nil, expected: nil, found: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := findAndDeleteOp(tt.id, tt.ops)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestShardReplicationFSM_DeleteReplicationOp(t *testing.T) {
+	fsm := newShardReplicationFSM(prometheus.NewPedanticRegistry())
+	for id, shard := range map[uint64]string{1: "shard1", 2: "shard2"} {
+		require.NoError(t, fsm.Replicate(id, &api.ReplicationReplicateShardRequest{
+			SourceCollection: "TestCollection",
+			SourceShard:      shard,
+			SourceNode:       "node1",
+			TargetNode:       "node2",
+		}))
+	}
+	require.Len(t, fsm.GetOpsForNode("node2"), 2)
+
+	require.NoError(t, fsm.DeleteReplicationOp(&api.ReplicationDeleteOpRequest{Id: 1}))
+	ops := fsm.GetOpsForNode("node2")
+	require.Len(t, ops, 1)
+	assert.Equal(t, uint64(2), ops[0].id)
+	assert.Empty(t, fsm.GetOpsForNode("node1"))
+
+	require.ErrorIs(t, fsm.DeleteReplicationOp(&api.ReplicationDeleteOpRequest{Id: 1}), ErrReplicationOpNotFound)
+	require.NoError(t, fsm.DeleteReplicationOp(&api.ReplicationDeleteOpRequest{Id: 2}))
+	assert.Empty(t, fsm.GetOpsForNode("node2"))
+}
@@ -181,6 +181,11 @@ func (s *ShardReplicationEngine) startShardReplication(op shardReplicationOp) {
 
 			logger.Info("added replica to sharding state")
 
+			// The replica now serves reads as well
+			if err := s.leaderClient.ReplicationUpdateReplicaOpStatus(op.id, api.READY); err != nil {
+				logger.WithError(err).Errorf("failed to update replica op state to %s", api.READY)
+			}
+
 			logger.Info("replica replication state updated")
 
 			// TODO Handle finalizing step for replica movement
@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/cluster/proto/api"
+)
+
+type fakeFSMUpdater struct {
+	sync.Mutex
+	fsm      *ShardReplicationFSM
+	states   []api.ShardReplicationState
+	replicas []string
+}
+
+func (f *fakeFSMUpdater) AddReplicaToShard(_ context.Context, collection, shard, node string) (uint64, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.replicas = append(f.replicas, newShardFQDN(node, collection, shard).String())
+	return 0, nil
+}
+
+func (f *fakeFSMUpdater) ReplicationUpdateReplicaOpStatus(id uint64, state api.ShardReplicationState) error {
+	f.Lock()
+	defer f.Unlock()
+	f.states = append(f.states, state)
+	return f.fsm.UpdateReplicationOpStatus(&api.ReplicationUpdateOpStateRequest{Id: id, State: state})
+}
+
+type fakeReplicaCopier struct{}
+
+func (fakeReplicaCopier) CopyReplica(context.Context, string, string, string) error {
+	return nil
+}
+
+func (fakeReplicaCopier) CopyShardSplit(context.Context, string, string, string, []string) error {
+	return nil
+}
+
+func (fakeReplicaCopier) FinishShardSplit(context.Context, string, string, []string) error {
+	return nil
+}
+
+func TestShardReplicationEngine_StartShardReplication(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	fsm := newShardReplicationFSM(prometheus.NewPedanticRegistry())
+	require.NoError(t, fsm.Replicate(1, &api.ReplicationReplicateShardRequest{
+		SourceCollection: "TestCollection",
+		SourceShard:      "shard1",
+		SourceNode:       "node1",
+		TargetNode:       "node2",
+	}))
+	updater := &fakeFSMUpdater{fsm: fsm}
+	engine := NewShardReplicationEngine(logger, "node2", fsm, updater, fakeReplicaCopier{})
+
+	_, newOps := engine.getShardReplicationOps()
+	require.Len(t, newOps, 1)
+	engine.startShardReplication(newOps[0])
+
+	assert.Equal(t, []api.ShardReplicationState{api.HYDRATING, api.FINALIZING, api.READY}, updater.states)
+	assert.Equal(t, []string{newShardFQDN("node2", "TestCollection", "shard1").String()}, updater.replicas)
+	assert.Equal(t, api.READY, fsm.GetOpState(newOps[0]).state)
+
+	// a ready op is neither started nor recovered again
+	ongoingOps, newOps := engine.getShardReplicationOps()
+	assert.Empty(t, ongoingOps)
+	assert.Empty(t, newOps)
+}
@@ -83,6 +83,18 @@ func (s *ShardReplicationFSM) GetOpState(op shardReplicationOp) shardReplication
 	return s.opsStatus[op]
 }
 
+// GetReplicaOpState returns the state of the op replicating shard of
+// collection to node, if there is one
+func (s *ShardReplicationFSM) GetReplicaOpState(node, collection, shard string) (api.ShardReplicationState, bool) {
+	s.opsLock.RLock()
+	defer s.opsLock.RUnlock()
+	op, ok := s.opsByTargetFQDN[newShardFQDN(node, collection, shard)]
+	if !ok {
+		return "", false
+	}
+	return s.opsStatus[op].state, true
+}
+
 func (s *ShardReplicationFSM) FilterOneShardReplicasReadWrite(collection string, shard string, shardReplicasLocation []string) ([]string, []string) {
 	s.opsLock.RLock()
 	defer s.opsLock.RUnlock()
@@ -0,0 +1,317 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replication
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/cluster/replication/types"
+	"github.com/weaviate/weaviate/cluster/schema"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+const splitEngineLogAction = "shard_split_engine"
+
+type splitKey struct {
+	collection string
+	source     string
+}
+
+type shardSplit struct {
+	splitKey
+	split    sharding.Split
+	replicas []string
+	ready    bool
+}
+
+// ShardSplitEngine carries out the splits of the local shard replicas. Each
+// replica of a shard copies its data into the targets of the split and marks
+// itself ready once its copies caught up. The first replica of the shard
+// commits the split once all replicas are ready, which atomically switches
+// the routing of the shard to its targets. Each replica then replaces its
+// local copy of the source with the targets in the background.
+type ShardSplitEngine struct {
+	node   string
+	logger *logrus.Entry
+
+	schemaReader  schema.SchemaReader
+	leaderClient  types.ShardSplitUpdater
+	replicaCopier types.ReplicaCopier
+
+	sync.Mutex
+	// ongoing copies of the local replica of a source into its targets
+	ongoing map[splitKey]context.CancelFunc
+	// copied are the splits for which the local replica is ready, the schema
+	// might not reflect it yet
+	copied map[splitKey]struct{}
+	// splits are the splits of local replicas seen by the last check
+	splits map[splitKey]shardSplit
+	// finishing are the committed splits whose local source is being replaced
+	// by its targets
+	finishing map[splitKey]context.CancelFunc
+
+	stopChan chan struct{}
+	stopOnce sync.Once
+}
+
+func NewShardSplitEngine(logger *logrus.Logger, node string, schemaReader schema.SchemaReader,
+	leaderClient types.ShardSplitUpdater, replicaCopier types.ReplicaCopier,
+) *ShardSplitEngine {
+	return &ShardSplitEngine{
+		node:          node,
+		logger:        logger.WithFields(logrus.Fields{"action": splitEngineLogAction, "node": node}),
+		schemaReader:  schemaReader,
+		leaderClient:  leaderClient,
+		replicaCopier: replicaCopier,
+		ongoing:       make(map[splitKey]context.CancelFunc),
+		copied:        make(map[splitKey]struct{}),
+		splits:        make(map[splitKey]shardSplit),
+		finishing:     make(map[splitKey]context.CancelFunc),
+		stopChan:      make(chan struct{}),
+	}
+}
+
+// Start monitors the schema for shard splits in the background
+func (s *ShardSplitEngine) Start() {
+	enterrors.GoWrapper(s.monitor, s.logger)
+}
+
+// Stop stops monitoring and cancels all ongoing copies and replacements
+func (s *ShardSplitEngine) Stop() {
+	s.stopOnce.Do(func() {
+		s.logger.Info("Stopping shard split engine")
+		close(s.stopChan)
+
+		s.Lock()
+		defer s.Unlock()
+		for _, cancel := range s.ongoing {
+			cancel()
+		}
+		for _, cancel := range s.finishing {
+			cancel()
+		}
+	})
+}
+
+func (s *ShardSplitEngine) monitor() {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stopChan:
+			return
+		case <-ticker.C:
+			s.process()
+		}
+	}
+}
+
+func (s *ShardSplitEngine) process() {
+	splits := s.localSplits()
+
+	s.Lock()
+	defer s.Unlock()
+
+	active := make(map[splitKey]shardSplit, len(splits))
+	for _, sp := range splits {
+		active[sp.splitKey] = sp
+
+		_, ongoing := s.ongoing[sp.splitKey]
+		_, copied := s.copied[sp.splitKey]
+		switch {
+		case ongoing:
+		case !slices.Contains(sp.split.Ready, s.node) && !copied:
+			s.startCopy(sp)
+		case sp.ready && sp.replicas[0] == s.node:
+			s.commit(sp)
+		}
+	}
+
+	// splits which were committed or aborted in the meantime
+	for key, cancel := range s.ongoing {
+		if _, ok := active[key]; !ok {
+			cancel()
+			delete(s.ongoing, key)
+		}
+	}
+	for key := range s.copied {
+		if _, ok := active[key]; !ok {
+			delete(s.copied, key)
+		}
+	}
+	for key, sp := range s.splits {
+		if _, ok := active[key]; ok {
+			continue
+		}
+		if _, ok := s.finishing[key]; !ok && s.committed(sp) {
+			s.finish(sp)
+		}
+	}
+	s.splits = active
+}
+
+// committed is true if the split of sp is not pending anymore because its
+// targets replaced the source in the sharding state
+func (s *ShardSplitEngine) committed(sp shardSplit) bool {
+	var committed bool
+	err := s.schemaReader.Read(sp.collection, func(_ *models.Class, state *sharding.State) error {
+		if _, ok := state.Physical[sp.source]; ok {
+			return nil
+		}
+		for _, target := range sp.split.TargetNames() {
+			if !slices.Contains(state.Physical[target].BelongsToNodes, s.node) {
+				return nil
+			}
+		}
+		committed = true
+		return nil
+	})
+	if err != nil {
+		s.logger.WithField("collection", sp.collection).WithError(err).Warn("failed to read shard split")
+	}
+	return committed
+}
+
+// localSplits returns the splits of shards with a replica on the local node
+func (s *ShardSplitEngine) localSplits() []shardSplit {
+	var splits []shardSplit
+	for _, class := range s.schemaReader.ReadOnlySchema().Classes {
+		err := s.schemaReader.Read(class.Class, func(_ *models.Class, state *sharding.State) error {
+			for source, split := range state.Splits {
+				replicas := state.Physical[source].BelongsToNodes
+				if !slices.Contains(replicas, s.node) {
+					continue
+				}
+				splits = append(splits, shardSplit{
+					splitKey: splitKey{collection: class.Class, source: source},
+					split:    split.DeepCopy(),
+					replicas: slices.Clone(replicas),
+					ready:    state.SplitReady(source),
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			s.logger.WithField("collection", class.Class).WithError(err).Warn("failed to read shard splits")
+		}
+	}
+	return splits
+}
+
+// startCopy must be called with the lock held
+func (s *ShardSplitEngine) startCopy(sp shardSplit) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.ongoing[sp.splitKey] = cancel
+
+	logger := s.logger.WithFields(logrus.Fields{
+		"collection": sp.collection,
+		"source":     sp.source,
+		"targets":    sp.split.TargetNames(),
+	})
+
+	enterrors.GoWrapper(func() {
+		err := backoff.Retry(func() error {
+			logger.Info("starting shard split copy")
+			if err := s.replicaCopier.CopyShardSplit(ctx, s.node, sp.collection, sp.source, sp.split.TargetNames()); err != nil {
+				logger.WithError(err).Warn("failed to copy shard into split targets")
+				return err
+			}
+			if _, err := s.leaderClient.SetShardSplitReady(ctx, sp.collection, sp.source, s.node); err != nil {
+				logger.WithError(err).Warn("failed to mark shard split ready")
+				return err
+			}
+			logger.Info("completed shard split copy")
+			return nil
+		}, backoff.WithContext(backoff.NewConstantBackOff(5*time.Second), ctx))
+
+		s.Lock()
+		defer s.Unlock()
+		if err == nil {
+			s.copied[sp.splitKey] = struct{}{}
+		}
+		if ctx.Err() == nil {
+			cancel()
+			delete(s.ongoing, sp.splitKey)
+		}
+	}, logger)
+}
+
+// commit must be called with the lock held, it runs in the background to
+// not block the monitor while the split is applied
+func (s *ShardSplitEngine) commit(sp shardSplit) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	s.ongoing[sp.splitKey] = cancel
+
+	logger := s.logger.WithFields(logrus.Fields{
+		"collection": sp.collection,
+		"source":     sp.source,
+		"targets":    sp.split.TargetNames(),
+	})
+
+	enterrors.GoWrapper(func() {
+		if _, err := s.leaderClient.CommitShardSplit(ctx, sp.collection, sp.source); err != nil {
+			logger.WithError(err).Warn("failed to commit shard split")
+		} else {
+			logger.Info("committed shard split")
+		}
+
+		s.Lock()
+		defer s.Unlock()
+		if ctx.Err() == nil {
+			delete(s.ongoing, sp.splitKey)
+		}
+		cancel()
+	}, logger)
+}
+
+// finish must be called with the lock held, it replaces the local replica of
+// the source of a committed split with its targets in the background and
+// retries until it succeeds
+func (s *ShardSplitEngine) finish(sp shardSplit) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.finishing[sp.splitKey] = cancel
+
+	logger := s.logger.WithFields(logrus.Fields{
+		"collection": sp.collection,
+		"source":     sp.source,
+		"targets":    sp.split.TargetNames(),
+	})
+
+	enterrors.GoWrapper(func() {
+		err := backoff.Retry(func() error {
+			if err := s.replicaCopier.FinishShardSplit(ctx, sp.collection, sp.source, sp.split.TargetNames()); err != nil {
+				logger.WithError(err).Warn("failed to replace shard with split targets")
+				return err
+			}
+			logger.Info("replaced shard with split targets")
+			return nil
+		}, backoff.WithContext(backoff.NewConstantBackOff(5*time.Second), ctx))
+		if err != nil {
+			logger.WithError(err).Warn("stopped replacing shard with split targets")
+		}
+
+		s.Lock()
+		defer s.Unlock()
+		if ctx.Err() == nil {
+			delete(s.finishing, sp.splitKey)
+		}
+		cancel()
+	}, logger)
+}
@@ -18,6 +18,12 @@ type Manager interface {
 	ReplicationDisableReplica(node string, collection string, shard string) error
 	ReplicationDeleteReplica(node string, collection string, shard string) error
 
+	// ReplicationSplitShard starts splitting a shard of a single-tenant
+	// collection into parts new shards and returns the names of the new shards
+	ReplicationSplitShard(collection string, shard string, parts int) ([]string, error)
+	// ReplicationCancelSplitShard aborts an ongoing split of a shard
+	ReplicationCancelSplitShard(collection string, shard string) error
+
 	// GetReplicationDetailsByReplicationId retrieves the details of a replication operation by its ID.
 	//
 	// Parameters:
@@ -0,0 +1,121 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package types
+
+import (
+	"context"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// RebalanceState is the state of a shard rebalance
+type RebalanceState string
+
+const (
+	RebalanceStateIdle     RebalanceState = "IDLE"
+	RebalanceStateRunning  RebalanceState = "RUNNING"
+	RebalanceStatePaused   RebalanceState = "PAUSED"
+	RebalanceStateCanceled RebalanceState = "CANCELED"
+	RebalanceStateFinished RebalanceState = "FINISHED"
+)
+
+// RebalanceMoveState is the state of a single move of a rebalance
+type RebalanceMoveState string
+
+const (
+	RebalanceMovePending     RebalanceMoveState = "PENDING"
+	RebalanceMoveReplicating RebalanceMoveState = "REPLICATING"
+	RebalanceMoveDone        RebalanceMoveState = "DONE"
+	RebalanceMoveFailed      RebalanceMoveState = "FAILED"
+	RebalanceMoveCanceled    RebalanceMoveState = "CANCELED"
+)
+
+// RebalanceMove moves the replica of a shard held by SourceNode to
+// TargetNode
+type RebalanceMove struct {
+	Collection string
+	Shard      string
+	SourceNode string
+	TargetNode string
+	// DiskBytes is the size of the shard at the time the move was planned
+	DiskBytes int64
+}
+
+// NodeLoad is the number of shard replicas held by a node and their size
+type NodeLoad struct {
+	Node      string
+	Shards    int64
+	DiskBytes int64
+}
+
+// RebalancePlan lists the moves balancing the shard replicas across the
+// nodes and the load of the nodes before and after them
+type RebalancePlan struct {
+	Moves   []RebalanceMove
+	Current []NodeLoad
+	Planned []NodeLoad
+}
+
+// RebalanceMoveStatus is the progress of a move of a started rebalance
+type RebalanceMoveStatus struct {
+	RebalanceMove
+	State RebalanceMoveState
+	Error string
+}
+
+// RebalanceStatus is the progress of a rebalance
+type RebalanceStatus struct {
+	State RebalanceState
+	// Automatic is set if the rebalance was started because nodes joined or
+	// left the cluster
+	Automatic bool
+	StartedAt time.Time
+	Moves     []RebalanceMoveStatus
+}
+
+// Rebalancer moves shard replicas between nodes to balance their number and
+// size, see cluster/replication.Rebalancer
+type Rebalancer interface {
+	// Plan returns the moves a rebalance would carry out without starting it
+	Plan(ctx context.Context) (RebalancePlan, error)
+	// Rebalance plans and starts a rebalance running at most
+	// maxConcurrentMoves moves at the same time. Zero uses the configured
+	// default.
+	Rebalance(ctx context.Context, maxConcurrentMoves int) (RebalanceStatus, error)
+	// Status returns the progress of the last rebalance
+	Status() RebalanceStatus
+	// Pause stops starting new moves of the ongoing rebalance
+	Pause() (RebalanceStatus, error)
+	// Resume continues starting the moves of a paused rebalance
+	Resume() (RebalanceStatus, error)
+	// Cancel drops the moves of the ongoing rebalance which did not start yet
+	Cancel() error
+}
+
+// NodeStatusGetter reports the status and the shards of all nodes
+type NodeStatusGetter interface {
+	GetNodeStatus(ctx context.Context, className, verbosity string) ([]*models.NodeStatus, error)
+}
+
+// ReplicaMover issues the replication ops moving shard replicas between nodes
+type ReplicaMover interface {
+	ReplicationReplicateReplica(sourceNode string, sourceCollection string, sourceShard string, targetNode string) error
+	ReplicationDeleteReplica(node string, collection string, shard string) error
+}
+
+// ClusterReader reports the storage nodes of the cluster and whether the
+// local node is its leader
+type ClusterReader interface {
+	StorageCandidates() []string
+	IsLeader() bool
+}
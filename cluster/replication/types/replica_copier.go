@@ -17,4 +17,8 @@ import "context"
 type ReplicaCopier interface {
 	// CopyReplica see cluster/replication/copier.Copier.CopyReplica
 	CopyReplica(ctx context.Context, sourceNode string, sourceCollection string, sourceShard string) error
+	// CopyShardSplit see cluster/replication/copier.Copier.CopyShardSplit
+	CopyShardSplit(ctx context.Context, sourceNode string, collection string, sourceShard string, targetShards []string) error
+	// FinishShardSplit see cluster/replication/copier.Copier.FinishShardSplit
+	FinishShardSplit(ctx context.Context, collection string, sourceShard string, targetShards []string) error
 }
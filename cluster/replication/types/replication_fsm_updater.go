@@ -21,3 +21,9 @@ type FSMUpdater interface {
 	AddReplicaToShard(context.Context, string, string, string) (uint64, error)
 	ReplicationUpdateReplicaOpStatus(id uint64, state api.ShardReplicationState) error
 }
+
+// ShardSplitUpdater updates the state of shard splits, see cluster.Raft
+type ShardSplitUpdater interface {
+	SetShardSplitReady(ctx context.Context, class, shard, node string) (uint64, error)
+	CommitShardSplit(ctx context.Context, class, shard string) (uint64, error)
+}
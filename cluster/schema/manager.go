@@ -25,6 +25,7 @@ import (
 
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
 var (
@@ -220,6 +221,7 @@ func (s *SchemaManager) UpdateClass(cmd *command.ApplyRequest, nodeID string, sc
 		meta.Class.VectorConfig = u.VectorConfig
 		meta.Class.ReplicationConfig = u.ReplicationConfig
 		meta.Class.MultiTenancyConfig = u.MultiTenancyConfig
+		meta.Class.ObjectTTLConfig = u.ObjectTTLConfig
 		meta.Class.Description = u.Description
 		meta.Class.Properties = u.Properties
 		meta.ClassVersion = cmd.Version
@@ -323,6 +325,105 @@ func (s *SchemaManager) AddReplicaToShard(cmd *command.ApplyRequest, schemaOnly
 	)
 }
 
+// DeleteReplicaFromShard removes a replica from the sharding state of a shard
+// and drops it when it is held by the local node
+func (s *SchemaManager) DeleteReplicaFromShard(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.ReplicationDeleteReplicaRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.deleteReplicaFromShard(cmd.Class, cmd.Version, req.Shard, req.Node) },
+			updateStore: func() error {
+				if req.Node == s.schema.nodeID {
+					return s.db.DeleteReplicaFromShard(req.Collection, req.Shard, req.Node)
+				}
+				return nil
+			},
+			schemaOnly: schemaOnly,
+		},
+	)
+}
+
+func (s *SchemaManager) StartShardSplit(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.StartShardSplitRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.startShardSplit(cmd.Class, cmd.Version, req.Split) },
+			updateStore:  func() error { return nil },
+			schemaOnly:   schemaOnly,
+		},
+	)
+}
+
+func (s *SchemaManager) SetShardSplitReady(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.ShardSplitRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return s.apply(
+		applyOp{
+			op:           cmd.GetType().String(),
+			updateSchema: func() error { return s.schema.setShardSplitReady(cmd.Class, cmd.Version, req.Shard, req.Node) },
+			updateStore:  func() error { return nil },
+			schemaOnly:   schemaOnly,
+		},
+	)
+}
+
+// CommitShardSplit switches the routing of the source shard to its targets.
+// It only updates the sharding state, the split engine of each replica
+// replaces its local copy of the source with the targets afterwards.
+func (s *SchemaManager) CommitShardSplit(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.ShardSplitRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	return s.apply(
+		applyOp{
+			op: cmd.GetType().String(),
+			updateSchema: func() error {
+				_, err := s.schema.commitShardSplit(cmd.Class, cmd.Version, req.Shard)
+				return err
+			},
+			updateStore: func() error { return nil },
+			schemaOnly:  schemaOnly,
+		},
+	)
+}
+
+// AbortShardSplit cancels the split of the source shard and drops local
+// copies of its targets
+func (s *SchemaManager) AbortShardSplit(cmd *command.ApplyRequest, schemaOnly bool) error {
+	req := command.ShardSplitRequest{}
+	if err := json.Unmarshal(cmd.SubCommand, &req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	var split sharding.Split
+	return s.apply(
+		applyOp{
+			op: cmd.GetType().String(),
+			updateSchema: func() (err error) {
+				split, err = s.schema.abortShardSplit(cmd.Class, cmd.Version, req.Shard)
+				return err
+			},
+			updateStore: func() error { return s.db.AbortShardSplit(req.Class, req.Shard, split.TargetNames()) },
+			schemaOnly:  schemaOnly,
+		},
+	)
+}
+
 func (s *SchemaManager) AddTenants(cmd *command.ApplyRequest, schemaOnly bool) error {
 	req := &command.AddTenantsRequest{}
 	if err := gproto.Unmarshal(cmd.SubCommand, req); err != nil {
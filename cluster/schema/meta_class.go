@@ -182,6 +182,64 @@ func (m *metaClass) AddReplicaToShard(v uint64, shard string, replica string) er
 	return nil
 }
 
+func (m *metaClass) DeleteReplicaFromShard(v uint64, shard string, replica string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	err := m.Sharding.DeleteReplicaFromShard(shard, replica)
+	if err != nil {
+		return err
+	}
+	m.ClassVersion = v
+	return nil
+}
+
+func (m *metaClass) StartShardSplit(v uint64, split sharding.Split) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if err := m.Sharding.StartSplit(split); err != nil {
+		return err
+	}
+	m.ClassVersion = v
+	return nil
+}
+
+func (m *metaClass) SetShardSplitReady(v uint64, shard, node string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if err := m.Sharding.SetSplitReady(shard, node); err != nil {
+		return err
+	}
+	m.ClassVersion = v
+	return nil
+}
+
+func (m *metaClass) CommitShardSplit(v uint64, shard string) (sharding.Split, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	split, err := m.Sharding.CommitSplit(shard)
+	if err != nil {
+		return sharding.Split{}, err
+	}
+	m.ClassVersion = v
+	return split, nil
+}
+
+func (m *metaClass) AbortShardSplit(v uint64, shard string) (sharding.Split, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	split, err := m.Sharding.AbortSplit(shard)
+	if err != nil {
+		return sharding.Split{}, err
+	}
+	m.ClassVersion = v
+	return split, nil
+}
+
 // MergeProps makes sure duplicates are not created by ignoring new props
 // with the same names as old props.
 // If property of nested type is present in both new and old slices,
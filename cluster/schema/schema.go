@@ -416,6 +416,56 @@ func (s *schema) addReplicaToShard(class string, v uint64, shard string, replica
 	return meta.AddReplicaToShard(v, shard, replica)
 }
 
+func (s *schema) deleteReplicaFromShard(class string, v uint64, shard string, replica string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.classes[class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.DeleteReplicaFromShard(v, shard, replica)
+}
+
+func (s *schema) startShardSplit(class string, v uint64, split sharding.Split) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.classes[class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.StartShardSplit(v, split)
+}
+
+func (s *schema) setShardSplitReady(class string, v uint64, shard, node string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.classes[class]
+	if meta == nil {
+		return ErrClassNotFound
+	}
+	return meta.SetShardSplitReady(v, shard, node)
+}
+
+func (s *schema) commitShardSplit(class string, v uint64, shard string) (sharding.Split, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.classes[class]
+	if meta == nil {
+		return sharding.Split{}, ErrClassNotFound
+	}
+	return meta.CommitShardSplit(v, shard)
+}
+
+func (s *schema) abortShardSplit(class string, v uint64, shard string) (sharding.Split, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	meta := s.classes[class]
+	if meta == nil {
+		return sharding.Split{}, ErrClassNotFound
+	}
+	return meta.AbortShardSplit(v, shard)
+}
+
 func (s *schema) addTenants(class string, v uint64, req *command.AddTenantsRequest) error {
 	req.Tenants = removeNilTenants(req.Tenants)
 
@@ -39,6 +39,8 @@ type Indexer interface {
 	UpdateTenantsProcess(class string, req *api.TenantProcessRequest) error
 	UpdateShardStatus(*api.UpdateShardStatusRequest) error
 	AddReplicaToShard(class, shard, replica string) error
+	DeleteReplicaFromShard(class, shard, replica string) error
+	AbortShardSplit(class, source string, targets []string) error
 	GetShardsStatus(class, tenant string) (models.ShardStatusList, error)
 	UpdateIndex(api.UpdateClassRequest) error
 
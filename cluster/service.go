@@ -35,6 +35,8 @@ type Service struct {
 	*Raft
 
 	replicationEngine *replication.ShardReplicationEngine
+	splitEngine       *replication.ShardSplitEngine
+	rebalancer        *replication.Rebalancer
 	raftAddr          string
 	config            *Config
 
@@ -59,11 +61,16 @@ func New(cfg Config, svrMetrics *monitoring.GRPCServerMetrics) *Service {
 	fsm := NewFSM(cfg, prometheus.DefaultRegisterer)
 	raft := NewRaft(cfg.NodeSelector, &fsm, client)
 	replicationEngine := replication.NewShardReplicationEngine(cfg.Logger, cfg.NodeSelector.LocalName(), fsm.replicationManager.GetReplicationFSM(), raft, cfg.ReplicaCopier)
+	splitEngine := replication.NewShardSplitEngine(cfg.Logger, cfg.NodeSelector.LocalName(), fsm.schemaManager.NewSchemaReader(), raft, cfg.ReplicaCopier)
+	rebalancer := replication.NewRebalancer(cfg.Logger, cfg.Rebalance, fsm.schemaManager.NewSchemaReader(),
+		fsm.replicationManager.GetReplicationFSM(), raft, raft, cfg.NodeStatus)
 	svr := rpc.NewServer(&fsm, raft, rpcListenAddress, cfg.RaftRPCMessageMaxSize, cfg.SentryEnabled, svrMetrics, cfg.Logger)
 
 	return &Service{
 		Raft:               raft,
 		replicationEngine:  replicationEngine,
+		splitEngine:        splitEngine,
+		rebalancer:         rebalancer,
 		raftAddr:           raftAdvertisedAddress,
 		config:             &cfg,
 		rpcClient:          client,
@@ -84,6 +91,10 @@ func (c *Service) onFSMCaughtUp() {
 		case <-ticker.C:
 			if c.Raft.store.FSMHasCaughtUp() {
 				c.logger.Infof("Metadata FSM reported caught up, starting replication engine")
+				// the split engine and the rebalancer start in the background,
+				// the replication engine blocks
+				c.splitEngine.Start()
+				c.rebalancer.Start()
 				c.replicationEngine.Start()
 				return
 			}
@@ -162,6 +173,9 @@ func (c *Service) Close(ctx context.Context) error {
 		c.closeOnFSMCaughtUp <- struct{}{}
 	}, c.logger)
 
+	c.splitEngine.Stop()
+	c.rebalancer.Stop()
+
 	c.logger.Info("closing raft FSM store ...")
 	if err := c.Raft.Close(ctx); err != nil {
 		return err
@@ -186,6 +200,11 @@ func (c *Service) LeaderWithID() (string, string) {
 	return c.Raft.LeaderWithID()
 }
 
+// Rebalancer returns the rebalancer of the shard replicas across the nodes
+func (c *Service) Rebalancer() *replication.Rebalancer {
+	return c.rebalancer
+}
+
 func (c *Service) StorageCandidates() []string {
 	return c.Raft.StorageCandidates()
 }
@@ -35,6 +35,7 @@ import (
 	"github.com/weaviate/weaviate/cluster/schema"
 	"github.com/weaviate/weaviate/cluster/types"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	entreplication "github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/usecases/auth/authentication/apikey"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/cluster"
@@ -156,6 +157,11 @@ type Config struct {
 
 	// ReplicaCopier copies shard replicas between nodes
 	ReplicaCopier replicationTypes.ReplicaCopier
+	// NodeStatus reports the shards held by the nodes, it is used to plan
+	// rebalancing shard replicas
+	NodeStatus replicationTypes.NodeStatusGetter
+	// Rebalance configures the rebalancing of shard replicas across nodes
+	Rebalance entreplication.RebalanceConfig
 }
 
 // Store is the implementation of RAFT on this local node. It will handle the local schema and RAFT operations (startup,
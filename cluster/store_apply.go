@@ -168,6 +168,22 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 		f = func() {
 			ret.Error = st.schemaManager.AddReplicaToShard(&cmd, schemaOnly)
 		}
+	case api.ApplyRequest_TYPE_START_SHARD_SPLIT:
+		f = func() {
+			ret.Error = st.schemaManager.StartShardSplit(&cmd, schemaOnly)
+		}
+	case api.ApplyRequest_TYPE_SHARD_SPLIT_READY:
+		f = func() {
+			ret.Error = st.schemaManager.SetShardSplitReady(&cmd, schemaOnly)
+		}
+	case api.ApplyRequest_TYPE_COMMIT_SHARD_SPLIT:
+		f = func() {
+			ret.Error = st.schemaManager.CommitShardSplit(&cmd, schemaOnly)
+		}
+	case api.ApplyRequest_TYPE_ABORT_SHARD_SPLIT:
+		f = func() {
+			ret.Error = st.schemaManager.AbortShardSplit(&cmd, schemaOnly)
+		}
 
 	case api.ApplyRequest_TYPE_ADD_TENANT:
 		f = func() {
@@ -243,6 +259,10 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 		f = func() {
 			ret.Error = st.replicationManager.UpdateReplicateOpState(&cmd)
 		}
+	case api.ApplyRequest_TYPE_REPLICATION_REPLICA_DELETE:
+		f = func() {
+			ret.Error = st.schemaManager.DeleteReplicaFromShard(&cmd, schemaOnly)
+		}
 
 	default:
 		// This could occur when a new command has been introduced in a later app version
@@ -34,6 +34,7 @@ import (
 	"github.com/weaviate/weaviate/usecases/cluster/mocks"
 	"github.com/weaviate/weaviate/usecases/fakes"
 	"github.com/weaviate/weaviate/usecases/sharding"
+	shardingConfig "github.com/weaviate/weaviate/usecases/sharding/config"
 )
 
 var (
@@ -56,6 +57,36 @@ func TestStoreApply(t *testing.T) {
 		BelongsToNodes: []string{"THIS"},
 	}}}
 
+	splitCfg, err := shardingConfig.ParseConfig(map[string]interface{}{"desiredCount": float64(1)}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	splitCls := &models.Class{Class: "C2"}
+	splitSS, err := sharding.InitState("C2", splitCfg, "Node-1", []string{"Node-1"}, 1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	splitSource := splitSS.AllPhysicalShards()[0]
+	split, err := splitSS.PlanSplit(splitSource, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	startSplit := func(m *MockStore, ready bool) {
+		doFirst(m)
+		m.indexer.On("AddClass", mock.Anything).Return(nil)
+		m.store.Apply(&raft.Log{
+			Data: cmdAsBytes("C2", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: splitCls, State: splitSS}, nil),
+		})
+		m.store.Apply(&raft.Log{
+			Data: cmdAsBytes("C2", cmd.ApplyRequest_TYPE_START_SHARD_SPLIT, cmd.StartShardSplitRequest{Class: "C2", Split: split}, nil),
+		})
+		if ready {
+			m.store.Apply(&raft.Log{
+				Data: cmdAsBytes("C2", cmd.ApplyRequest_TYPE_SHARD_SPLIT_READY, cmd.ShardSplitRequest{Class: "C2", Shard: splitSource, Node: "Node-1"}, nil),
+			})
+		}
+	}
+
 	tests := []struct {
 		name     string
 		req      raft.Log
@@ -611,6 +642,125 @@ func TestStoreApply(t *testing.T) {
 				})
 			},
 		},
+		{
+			name: "DeleteReplicaFromShard/Success/UpdateDB",
+			req:  raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_REPLICATION_REPLICA_DELETE, cmd.ReplicationDeleteReplicaRequest{Node: "Node-1", Collection: "C1", Shard: "T1"}, nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				m.parser.On("ParseClass", mock.Anything).Return(nil)
+				m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.indexer.On("AddReplicaToShard", "C1", "T1", "Node-1").Return(nil)
+				m.indexer.On("DeleteReplicaFromShard", "C1", "T1", "Node-1").Return(nil)
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+				})
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_REPLICA_TO_SHARD, cmd.AddReplicaToShardRequest{Class: "C1", Shard: "T1", Replica: "Node-1"}, nil),
+				})
+			},
+			doAfter: func(ms *MockStore) error {
+				replicas, err := ms.store.SchemaReader().ShardReplicas("C1", "T1")
+				if err != nil {
+					return err
+				}
+				if !reflect.DeepEqual(replicas, []string{"THIS"}) {
+					return fmt.Errorf("replicas for coll C1 shard T1 got=%v want=[\"THIS\"]", replicas)
+				}
+				return nil
+			},
+		},
+		{
+			name: "DeleteReplicaFromShard/Success/NotUpdateDB",
+			req:  raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_REPLICATION_REPLICA_DELETE, cmd.ReplicationDeleteReplicaRequest{Node: "THIS", Collection: "C1", Shard: "T1"}, nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				m.parser.On("ParseClass", mock.Anything).Return(nil)
+				m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+				})
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_REPLICA_TO_SHARD, cmd.AddReplicaToShardRequest{Class: "C1", Shard: "T1", Replica: "Node-3"}, nil),
+				})
+			},
+			doAfter: func(ms *MockStore) error {
+				replicas, err := ms.store.SchemaReader().ShardReplicas("C1", "T1")
+				if err != nil {
+					return err
+				}
+				if !reflect.DeepEqual(replicas, []string{"Node-3"}) {
+					return fmt.Errorf("replicas for coll C1 shard T1 got=%v want=[\"Node-3\"]", replicas)
+				}
+				return nil
+			},
+		},
+		{
+			name: "DeleteReplicaFromShard/FailLastReplica",
+			req:  raft.Log{Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_REPLICATION_REPLICA_DELETE, cmd.ReplicationDeleteReplicaRequest{Node: "THIS", Collection: "C1", Shard: "T1"}, nil)},
+			resp: Response{Error: schema.ErrSchema},
+			doBefore: func(m *MockStore) {
+				m.parser.On("ParseClass", mock.Anything).Return(nil)
+				m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+				m.indexer.On("AddClass", mock.Anything).Return(nil)
+				m.store.Apply(&raft.Log{
+					Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS, cmd.AddClassRequest{Class: cls, State: ss}, nil),
+				})
+			},
+		},
+		{
+			name: "CommitShardSplit/Success",
+			req:  raft.Log{Data: cmdAsBytes("C2", cmd.ApplyRequest_TYPE_COMMIT_SHARD_SPLIT, cmd.ShardSplitRequest{Class: "C2", Shard: splitSource}, nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				startSplit(m, true)
+			},
+			doAfter: func(ms *MockStore) error {
+				state := ms.store.SchemaReader().CopyShardingState("C2")
+				if _, ok := state.Physical[splitSource]; ok {
+					return fmt.Errorf("split source %s must be removed", splitSource)
+				}
+				if got, want := state.AllPhysicalShards(), split.TargetNames(); !reflect.DeepEqual(got, want) {
+					return fmt.Errorf("physical shards want: %v got: %v", want, got)
+				}
+				if len(state.Splits) != 0 {
+					return fmt.Errorf("split must be removed after commit")
+				}
+				return nil
+			},
+		},
+		{
+			name:     "CommitShardSplit/NotReady",
+			req:      raft.Log{Data: cmdAsBytes("C2", cmd.ApplyRequest_TYPE_COMMIT_SHARD_SPLIT, cmd.ShardSplitRequest{Class: "C2", Shard: splitSource}, nil)},
+			resp:     Response{Error: schema.ErrSchema},
+			doBefore: func(m *MockStore) { startSplit(m, false) },
+		},
+		{
+			name: "AbortShardSplit/Success",
+			req:  raft.Log{Data: cmdAsBytes("C2", cmd.ApplyRequest_TYPE_ABORT_SHARD_SPLIT, cmd.ShardSplitRequest{Class: "C2", Shard: splitSource}, nil)},
+			resp: Response{Error: nil},
+			doBefore: func(m *MockStore) {
+				startSplit(m, false)
+				m.indexer.On("AbortShardSplit", "C2", splitSource, split.TargetNames()).Return(nil)
+			},
+			doAfter: func(ms *MockStore) error {
+				state := ms.store.SchemaReader().CopyShardingState("C2")
+				if got, want := state.AllPhysicalShards(), []string{splitSource}; !reflect.DeepEqual(got, want) {
+					return fmt.Errorf("physical shards want: %v got: %v", want, got)
+				}
+				if len(state.Splits) != 0 {
+					return fmt.Errorf("split must be removed after abort")
+				}
+				return nil
+			},
+		},
+		{
+			name:     "AbortShardSplit/NotSplitting",
+			req:      raft.Log{Data: cmdAsBytes("C2", cmd.ApplyRequest_TYPE_ABORT_SHARD_SPLIT, cmd.ShardSplitRequest{Class: "C2", Shard: "unknown"}, nil)},
+			resp:     Response{Error: schema.ErrSchema},
+			doBefore: func(m *MockStore) { startSplit(m, false) },
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+const (
+	DefaultHighlightFragmentSize = 100
+	DefaultHighlightFragments    = 3
+	DefaultHighlightPreTag       = "<em>"
+	DefaultHighlightPostTag      = "</em>"
+)
+
+// HighlightParams configures the highlight additional property. Properties
+// limits the highlighted properties, all searched text properties are
+// highlighted if empty. FragmentSize is the length of a fragment in
+// characters, Fragments the maximum number of fragments per property value.
+type HighlightParams struct {
+	Properties   []string `json:"properties"`
+	FragmentSize int      `json:"fragmentSize"`
+	Fragments    int      `json:"fragments"`
+	PreTag       string   `json:"preTag"`
+	PostTag      string   `json:"postTag"`
+}
+
+// WithDefaults returns a copy of the params with every unset option replaced
+// by its default
+func (p HighlightParams) WithDefaults() HighlightParams {
+	if p.FragmentSize <= 0 {
+		p.FragmentSize = DefaultHighlightFragmentSize
+	}
+	if p.Fragments <= 0 {
+		p.Fragments = DefaultHighlightFragments
+	}
+	if p.PreTag == "" {
+		p.PreTag = DefaultHighlightPreTag
+	}
+	if p.PostTag == "" {
+		p.PostTag = DefaultHighlightPostTag
+	}
+	return p
+}
+
+// Highlight holds the query terms found in a single value of a text property.
+// Index is the position of the value for text[] properties and 0 otherwise.
+type Highlight struct {
+	Property  string           `json:"property"`
+	Index     int              `json:"index"`
+	Matches   []HighlightMatch `json:"matches"`
+	Fragments []string         `json:"fragments"`
+}
+
+// HighlightMatch is a single occurrence of a matched term, Start and End are
+// character offsets into the property value
+type HighlightMatch struct {
+	Term  string `json:"term"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+}
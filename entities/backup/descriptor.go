@@ -13,6 +13,7 @@ package backup
 
 import (
 	"fmt"
+	"sort"
 	"time"
 )
 
@@ -35,6 +36,9 @@ type DistributedBackupDescriptor struct {
 	ServerVersion string                     `json:"serverVersion"`
 	Leader        string                     `json:"leader"`
 	Error         string                     `json:"error"`
+
+	// BaseBackupID is the backup this one is incremental to, if any.
+	BaseBackupID string `json:"baseBackupId,omitempty"`
 }
 
 // Len returns how many nodes exist in d
@@ -224,6 +228,28 @@ type ShardDescriptor struct {
 	ShardVersionPath      string `json:"shardVersionPath,omitempty"`
 	Version               []byte `json:"version,omitempty"`
 	Chunk                 int32  `json:"chunk"`
+
+	// EncryptionKeyIDs lists the keys which files of this shard are encrypted
+	// with. They need to be available when restoring the shard.
+	EncryptionKeyIDs []string `json:"encryptionKeyIds,omitempty"`
+
+	// Fingerprints identify the content of immutable files by their size and
+	// modification time. An incremental backup compares them to decide which
+	// files have not changed since its base backup.
+	Fingerprints map[string]string `json:"fingerprints,omitempty"`
+
+	// BaseFiles maps files which are unchanged since the base backup to the
+	// id of the backup that stores them. They are not part of Files.
+	BaseFiles map[string]string `json:"baseFiles,omitempty"`
+}
+
+// Owner returns the id of the backup storing relPath, which is either a
+// previous backup or self if the file is part of this backup.
+func (s *ShardDescriptor) Owner(self, relPath string) string {
+	if id, ok := s.BaseFiles[relPath]; ok {
+		return id
+	}
+	return self
 }
 
 // ClearTemporary clears fields that are no longer needed once compression is done.
@@ -259,6 +285,9 @@ type BackupDescriptor struct {
 	Version       string            `json:"version"` //
 	ServerVersion string            `json:"serverVersion"`
 	Error         string            `json:"error"`
+
+	// BaseBackupID is the backup this one is incremental to, if any.
+	BaseBackupID string `json:"baseBackupId,omitempty"`
 }
 
 // List all existing classes in d
@@ -334,6 +363,44 @@ func (d *BackupDescriptor) Filter(pred func(s string) bool) {
 	d.Classes = cs
 }
 
+// EncryptionKeyIDs returns the sorted set of all keys which are needed to
+// decrypt the files of d
+func (d *BackupDescriptor) EncryptionKeyIDs() []string {
+	set := map[string]struct{}{}
+	for _, c := range d.Classes {
+		for _, s := range c.Shards {
+			for _, id := range s.EncryptionKeyIDs {
+				set[id] = struct{}{}
+			}
+		}
+	}
+	ids := make([]string, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// BaseBackupIDs returns the sorted ids of all previous backups which store
+// files of d
+func (d *BackupDescriptor) BaseBackupIDs() []string {
+	set := map[string]struct{}{}
+	for _, c := range d.Classes {
+		for _, s := range c.Shards {
+			for _, id := range s.BaseFiles {
+				set[id] = struct{}{}
+			}
+		}
+	}
+	ids := make([]string, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
 // ValidateV1 validates d
 func (d *BackupDescriptor) validateV1() error {
 	for _, c := range d.Classes {
@@ -396,6 +463,7 @@ func (d *BackupDescriptor) ToDistributed() *DistributedBackupDescriptor {
 		Version:       d.Version,
 		ServerVersion: d.ServerVersion,
 		Error:         d.Error,
+		BaseBackupID:  d.BaseBackupID,
 	}
 	if node != "" && len(cs) > 0 {
 		result.Nodes = map[string]*NodeDescriptor{node: {Classes: cs}}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package changelog contains the types shared between the per-shard change
+// log (change data capture) and the APIs that expose it.
+package changelog
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+type Operation uint8
+
+const (
+	OperationUpsert Operation = iota + 1
+	OperationDelete
+)
+
+func (o Operation) String() string {
+	switch o {
+	case OperationUpsert:
+		return "upsert"
+	case OperationDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("unknown(%d)", o)
+	}
+}
+
+const recordVersion uint8 = 1
+
+// recordHeaderLength is version(1) + operation(1) + sequence(8) +
+// timestamp(8) + uuid(16)
+const recordHeaderLength = 1 + 1 + 8 + 8 + 16
+
+// Record is a single entry of a shard's change log. Sequence numbers are
+// strictly increasing within a shard, but have no meaning across shards or
+// across the replicas of a shard.
+type Record struct {
+	Sequence  uint64
+	Operation Operation
+	UUID      strfmt.UUID
+	// Timestamp is the time of the change in unix milliseconds, i.e. the
+	// object's last update time for upserts and the deletion time for deletes.
+	Timestamp int64
+	// Object holds the binary representation (storobj) of the object as it was
+	// written. It is empty for deletes.
+	Object []byte
+}
+
+func (r *Record) MarshalBinary() ([]byte, error) {
+	id, err := uuid.Parse(r.UUID.String())
+	if err != nil {
+		return nil, fmt.Errorf("parse uuid: %w", err)
+	}
+
+	buf := make([]byte, recordHeaderLength+len(r.Object))
+	buf[0] = recordVersion
+	buf[1] = byte(r.Operation)
+	binary.LittleEndian.PutUint64(buf[2:10], r.Sequence)
+	binary.LittleEndian.PutUint64(buf[10:18], uint64(r.Timestamp))
+	copy(buf[18:34], id[:])
+	copy(buf[34:], r.Object)
+
+	return buf, nil
+}
+
+func (r *Record) UnmarshalBinary(data []byte) error {
+	if len(data) < recordHeaderLength {
+		return fmt.Errorf("change log record too short: %d bytes", len(data))
+	}
+	if data[0] != recordVersion {
+		return fmt.Errorf("unsupported change log record version %d", data[0])
+	}
+
+	id, err := uuid.FromBytes(data[18:34])
+	if err != nil {
+		return fmt.Errorf("parse uuid: %w", err)
+	}
+
+	r.Operation = Operation(data[1])
+	r.Sequence = binary.LittleEndian.Uint64(data[2:10])
+	r.Timestamp = int64(binary.LittleEndian.Uint64(data[10:18]))
+	r.UUID = strfmt.UUID(id.String())
+	r.Object = nil
+	if len(data) > recordHeaderLength {
+		r.Object = make([]byte, len(data)-recordHeaderLength)
+		copy(r.Object, data[recordHeaderLength:])
+	}
+
+	return nil
+}
+
+// Page is the result of a single read from a shard's change log.
+type Page struct {
+	// Node and Shard identify the shard replica the records were read from.
+	Node    string
+	Shard   string
+	Records []Record
+	// Appended is closed as soon as a record newer than the ones contained in
+	// the page is appended to the log. Consumers that caught up can wait on
+	// it instead of polling.
+	Appended <-chan struct{}
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package changelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord_MarshalUnmarshal(t *testing.T) {
+	tests := []struct {
+		name string
+		rec  Record
+	}{
+		{
+			name: "upsert",
+			rec: Record{
+				Sequence:  17,
+				Operation: OperationUpsert,
+				UUID:      "73f2eb5f-5abf-447a-81ca-74b1dd168247",
+				Timestamp: 1714564800000,
+				Object:    []byte{1, 2, 3},
+			},
+		},
+		{
+			name: "delete",
+			rec: Record{
+				Sequence:  18,
+				Operation: OperationDelete,
+				UUID:      "73f2eb5f-5abf-447a-81ca-74b1dd168247",
+				Timestamp: 1714564800001,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.rec.MarshalBinary()
+			require.Nil(t, err)
+
+			var rec Record
+			require.Nil(t, rec.UnmarshalBinary(data))
+			assert.Equal(t, tt.rec, rec)
+		})
+	}
+
+	t.Run("too short", func(t *testing.T) {
+		var rec Record
+		assert.NotNil(t, rec.UnmarshalBinary([]byte{recordVersion, 1}))
+	})
+}
+
+func TestCheckpoint(t *testing.T) {
+	t.Run("encode and parse", func(t *testing.T) {
+		in := Checkpoint{Node: "node1", Shard: "tenant1", Sequence: 42}
+
+		out, err := ParseCheckpoint(in.Encode())
+		require.Nil(t, err)
+		assert.Equal(t, in, out)
+	})
+
+	t.Run("invalid tokens", func(t *testing.T) {
+		valid := Checkpoint{Node: "node1", Shard: "tenant1", Sequence: 42}.Encode()
+
+		for _, token := range []string{"", "not base64!", valid[:len(valid)-2], valid + "AA"} {
+			_, err := ParseCheckpoint(token)
+			assert.NotNil(t, err, token)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package changelog
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+)
+
+const checkpointVersion uint8 = 1
+
+// Checkpoint identifies a position in the change log of a shard replica.
+// Consumers receive it with every change and pass the last one they
+// processed to resume the stream after it.
+type Checkpoint struct {
+	Node     string
+	Shard    string
+	Sequence uint64
+}
+
+// Encode returns the opaque token representation of the checkpoint.
+func (c Checkpoint) Encode() string {
+	buf := make([]byte, 0, 1+8+2+len(c.Node)+2+len(c.Shard))
+	buf = append(buf, checkpointVersion)
+	buf = binary.LittleEndian.AppendUint64(buf, c.Sequence)
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(c.Node)))
+	buf = append(buf, c.Node...)
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(c.Shard)))
+	buf = append(buf, c.Shard...)
+
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// ParseCheckpoint decodes a token previously created with Checkpoint.Encode.
+func ParseCheckpoint(token string) (Checkpoint, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Checkpoint{}, fmt.Errorf("invalid checkpoint: %w", err)
+	}
+
+	if len(buf) < 1+8+2 || buf[0] != checkpointVersion {
+		return Checkpoint{}, fmt.Errorf("invalid checkpoint")
+	}
+
+	c := Checkpoint{Sequence: binary.LittleEndian.Uint64(buf[1:9])}
+	rest := buf[9:]
+
+	readString := func() (string, bool) {
+		if len(rest) < 2 {
+			return "", false
+		}
+		l := int(binary.LittleEndian.Uint16(rest[:2]))
+		if len(rest) < 2+l {
+			return "", false
+		}
+		s := string(rest[2 : 2+l])
+		rest = rest[2+l:]
+		return s, true
+	}
+
+	var ok bool
+	if c.Node, ok = readString(); !ok {
+		return Checkpoint{}, fmt.Errorf("invalid checkpoint")
+	}
+	if c.Shard, ok = readString(); !ok || len(rest) != 0 {
+		return Checkpoint{}, fmt.Errorf("invalid checkpoint")
+	}
+
+	return c, nil
+}
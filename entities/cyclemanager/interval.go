@@ -88,3 +88,26 @@ func HnswCommitLoggerCycleIntervals() CycleIntervals {
 func HnswCommitLoggerCycleTicker() CycleTicker {
 	return NewFixedTicker(hnswCommitLoggerMinInterval)
 }
+
+const (
+	objectTTLMinInterval = 10 * time.Second
+	objectTTLMaxInterval = 5 * time.Minute
+	objectTTLBase        = uint(2)
+	objectTTLSteps       = uint(4)
+)
+
+// 10s . 29.3s .. 1m8s .... 2m25s ........ 5m
+func ObjectTTLCycleIntervals() CycleIntervals {
+	return NewExpIntervals(objectTTLMinInterval, objectTTLMaxInterval,
+		objectTTLBase, objectTTLSteps)
+}
+
+// run cycle ticker with fixed minimal interval and let each shard
+// take care of its intervals
+func ObjectTTLCycleTicker() CycleTicker {
+	return NewFixedTicker(objectTTLMinInterval)
+}
+
+// ChangeLogRetentionInterval is the fixed interval in which change logs are
+// trimmed to their configured retention
+const ChangeLogRetentionInterval = time.Minute
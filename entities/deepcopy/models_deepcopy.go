@@ -92,13 +92,25 @@ func InvertedIndexConfig(i *models.InvertedIndexConfig) *models.InvertedIndexCon
 		stopwords = &models.StopwordConfig{Additions: i.Stopwords.Additions, Preset: i.Stopwords.Preset, Removals: i.Stopwords.Removals}
 	}
 
+	var synonyms *models.SynonymConfig = nil
+	if i.Synonyms != nil {
+		synonyms = &models.SynonymConfig{Sets: make([]*models.SynonymSet, len(i.Synonyms.Sets))}
+		for j, set := range i.Synonyms.Sets {
+			if set != nil {
+				synonyms.Sets[j] = &models.SynonymSet{Terms: set.Terms, Weight: set.Weight}
+			}
+		}
+	}
+
 	return &models.InvertedIndexConfig{
 		Bm25:                   bm25,
 		CleanupIntervalSeconds: i.CleanupIntervalSeconds,
 		IndexNullState:         i.IndexNullState,
 		IndexPropertyLength:    i.IndexPropertyLength,
+		IndexTermPositions:     i.IndexTermPositions,
 		IndexTimestamps:        i.IndexTimestamps,
 		Stopwords:              stopwords,
+		Synonyms:               synonyms,
 		UsingBlockMaxWAND:      i.UsingBlockMaxWAND,
 	}
 }
@@ -0,0 +1,250 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// BlockSize is the plaintext size of every block of a block file but the
+// last one.
+const BlockSize = 64 * 1024
+
+const blockSlotSize = nonceSize + BlockSize + tagSize
+
+// BlockWriter encrypts a file in fixed-size blocks. It is meant for files that
+// are written sequentially, except for a header at the beginning of the file
+// which is patched once the rest of the file is known. To allow for that the
+// first block is kept in memory and rewritten on every flush. Apart from the
+// first block only the last, incomplete block can be written to.
+//
+// The index of a block and whether it is the last one are authenticated
+// along with it, so that blocks cannot be reordered and a file truncated at a
+// block boundary is detected.
+type BlockWriter struct {
+	f      *os.File
+	header *header
+
+	first     []byte
+	tail      []byte
+	tailBlock int64
+	pos       int64
+	size      int64
+	sealed    []byte
+}
+
+// NewBlockWriter writes a new encryption header to the empty file f, using
+// the current key of the provider.
+func NewBlockWriter(f *os.File, keys KeyProvider) (*BlockWriter, error) {
+	h, raw, err := newHeader(modeBlock, keys)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.WriteAt(raw, 0); err != nil {
+		return nil, fmt.Errorf("write encryption header: %w", err)
+	}
+
+	return &BlockWriter{
+		f:         f,
+		header:    h,
+		first:     make([]byte, 0, BlockSize),
+		tail:      make([]byte, 0, BlockSize),
+		tailBlock: 1,
+	}, nil
+}
+
+func (w *BlockWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		block, offset := w.pos/BlockSize, int(w.pos%BlockSize)
+
+		var buf *[]byte
+		switch {
+		case block == 0:
+			buf = &w.first
+		case block == w.tailBlock:
+			buf = &w.tail
+		case block == w.tailBlock+1 && offset == 0 && len(w.tail) == BlockSize:
+			if err := w.writeBlock(w.tailBlock, w.tail, false); err != nil {
+				return written, err
+			}
+			w.tailBlock++
+			w.tail = w.tail[:0]
+			buf = &w.tail
+		default:
+			return written, fmt.Errorf("encrypted block writer: cannot write at position %d", w.pos)
+		}
+
+		n := min(len(p), BlockSize-offset)
+		if end := offset + n; end > len(*buf) {
+			*buf = (*buf)[:end]
+		}
+		copy((*buf)[offset:], p[:n])
+
+		written += n
+		p = p[n:]
+		w.pos += int64(n)
+		w.size = max(w.size, w.pos)
+	}
+	return written, nil
+}
+
+// Seek supports any position within the plaintext written so far, writes
+// are however limited to the first and the last block.
+func (w *BlockWriter) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekCurrent:
+		offset += w.pos
+	case io.SeekEnd:
+		offset += w.size
+	}
+	if offset < 0 || offset > w.size {
+		return w.pos, fmt.Errorf("encrypted block writer: seek to %d outside of [0, %d]", offset, w.size)
+	}
+	w.pos = offset
+	return w.pos, nil
+}
+
+// Flush persists the blocks which are still held in memory. It can be called
+// multiple times, blocks are sealed again with a new nonce every time.
+func (w *BlockWriter) Flush() error {
+	if len(w.first) > 0 {
+		if err := w.writeBlock(0, w.first, w.size <= BlockSize); err != nil {
+			return err
+		}
+	}
+	if len(w.tail) > 0 {
+		return w.writeBlock(w.tailBlock, w.tail, true)
+	}
+	return nil
+}
+
+func (w *BlockWriter) Sync() error {
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return w.f.Sync()
+}
+
+func (w *BlockWriter) Close() error {
+	if err := w.Flush(); err != nil {
+		w.f.Close()
+		return err
+	}
+	return w.f.Close()
+}
+
+func (w *BlockWriter) writeBlock(index int64, plain []byte, final bool) error {
+	w.sealed = seal(w.header.aead, w.sealed[:0], plain, blockAdditionalData(index, final))
+	if _, err := w.f.WriteAt(w.sealed, w.header.size+index*blockSlotSize); err != nil {
+		return fmt.Errorf("write encrypted block %d: %w", index, err)
+	}
+	return nil
+}
+
+func blockAdditionalData(index int64, final bool) []byte {
+	ad := binary.LittleEndian.AppendUint64(nil, uint64(index))
+	if final {
+		return append(ad, 1)
+	}
+	return append(ad, 0)
+}
+
+func openBlock(h *header, dst, sealed []byte, index uint64, final bool) ([]byte, error) {
+	plain, err := open(h.aead, dst, sealed, blockAdditionalData(int64(index), final))
+	if err != nil {
+		return nil, fmt.Errorf("block %d: %w", index, err)
+	}
+	return plain, nil
+}
+
+// ReaderAt provides random access to the plaintext of a block file.
+type ReaderAt struct {
+	r      io.ReaderAt
+	header *header
+	size   int64
+}
+
+// NewReaderAt opens the block file r of fileSize bytes. It returns
+// ErrNotEncrypted if r is a plaintext file.
+func NewReaderAt(r io.ReaderAt, fileSize int64, keys KeyProvider) (*ReaderAt, error) {
+	h, err := openHeader(io.NewSectionReader(r, 0, fileSize), keys)
+	if err != nil {
+		return nil, err
+	}
+	if h.mode != modeBlock {
+		return nil, fmt.Errorf("not an encrypted block file")
+	}
+
+	body := fileSize - h.size
+	size := body / blockSlotSize * BlockSize
+	if rem := body % blockSlotSize; rem > 0 {
+		if rem <= nonceSize+tagSize {
+			return nil, fmt.Errorf("%w: truncated block", ErrCorrupted)
+		}
+		size += rem - nonceSize - tagSize
+	}
+
+	return &ReaderAt{r: r, header: h, size: size}, nil
+}
+
+// Size is the plaintext size of the file.
+func (r *ReaderAt) Size() int64 {
+	return r.size
+}
+
+// KeyID is the id of the key encryption key of the file.
+func (r *ReaderAt) KeyID() string {
+	return r.header.keyID
+}
+
+func (r *ReaderAt) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, fmt.Errorf("encrypted reader: negative offset %d", off)
+	}
+
+	var (
+		sealed = make([]byte, blockSlotSize)
+		plain  = make([]byte, 0, BlockSize)
+		n      int
+	)
+	last := (r.size - 1) / BlockSize
+	for n < len(p) && off < r.size {
+		block := off / BlockSize
+		sealedLen := min(BlockSize, r.size-block*BlockSize) + nonceSize + tagSize
+
+		read, err := r.r.ReadAt(sealed[:sealedLen], r.header.size+block*blockSlotSize)
+		if int64(read) < sealedLen {
+			if err == nil || errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return n, fmt.Errorf("read encrypted block %d: %w", block, err)
+		}
+
+		plain, err = openBlock(r.header, plain[:0], sealed[:sealedLen], uint64(block), block == last)
+		if err != nil {
+			return n, err
+		}
+		copied := copy(p[n:], plain[off%BlockSize:])
+		n += copied
+		off += int64(copied)
+	}
+
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
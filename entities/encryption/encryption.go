@@ -0,0 +1,252 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package encryption implements envelope encryption for files persisted by
+// the storage layer. Every file is encrypted with its own randomly generated
+// data key which is stored in the file header, wrapped with a key encryption
+// key obtained from a KeyProvider. Rotating the key encryption key therefore
+// only affects files written after the rotation, existing files remain
+// readable as long as the provider still knows the key they were written
+// with.
+//
+// Two file layouts are supported. Stream files (write-ahead logs, HNSW commit
+// logs) are append-only and made of self-contained frames, so a torn write
+// can only ever affect the last frame. Block files (LSM segments) are made of
+// fixed-size blocks so that any position can be located without reading the
+// file sequentially.
+package encryption
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// KeySize is the size in bytes of both key encryption keys and data keys,
+// all encryption uses AES-256-GCM.
+const KeySize = 32
+
+var (
+	ErrKeyNotFound  = errors.New("encryption key not found")
+	ErrNotEncrypted = errors.New("file is not encrypted")
+	ErrCorrupted    = errors.New("encrypted file is corrupted")
+)
+
+// Key is a key encryption key. The ID is persisted in the header of every file
+// whose data key was wrapped with it.
+type Key struct {
+	ID       string
+	Material []byte
+}
+
+// KeyProvider supplies the key encryption keys. CurrentKey is used for every
+// newly written file, Key is used to open existing files and must keep
+// returning retired keys for as long as files written with them exist.
+type KeyProvider interface {
+	CurrentKey() (Key, error)
+	Key(id string) (Key, error)
+}
+
+type mode uint8
+
+const (
+	modeStream mode = 1
+	modeBlock  mode = 2
+)
+
+const (
+	formatVersion = 1
+	nonceSize     = 12
+	tagSize       = 16
+	// magic cannot collide with plaintext files: segments start with their
+	// (small) level, logs start with a (small) commit type
+	magic = "WVEF"
+)
+
+type header struct {
+	mode  mode
+	keyID string
+	aead  cipher.AEAD
+	size  int64
+}
+
+func newHeader(m mode, keys KeyProvider) (*header, []byte, error) {
+	kek, err := keys.CurrentKey()
+	if err != nil {
+		return nil, nil, fmt.Errorf("current encryption key: %w", err)
+	}
+
+	dek := make([]byte, KeySize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, nil, fmt.Errorf("generate data key: %w", err)
+	}
+
+	prefix := headerPrefix(m, kek.ID)
+	wrapper, err := newAEAD(kek.Material)
+	if err != nil {
+		return nil, nil, fmt.Errorf("key %q: %w", kek.ID, err)
+	}
+	wrapped := seal(wrapper, nil, dek, prefix)
+
+	buf := bytes.NewBuffer(prefix)
+	binary.Write(buf, binary.LittleEndian, uint16(len(wrapped)))
+	buf.Write(wrapped)
+
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &header{
+		mode:  m,
+		keyID: kek.ID,
+		aead:  aead,
+		size:  int64(buf.Len()),
+	}, buf.Bytes(), nil
+}
+
+func headerPrefix(m mode, keyID string) []byte {
+	prefix := make([]byte, 0, len(magic)+4+len(keyID))
+	prefix = append(prefix, magic...)
+	prefix = append(prefix, formatVersion, byte(m))
+	prefix = binary.LittleEndian.AppendUint16(prefix, uint16(len(keyID)))
+	return append(prefix, keyID...)
+}
+
+// readHeader parses the header without unwrapping the data key, it returns
+// ErrNotEncrypted if r does not start with an encryption header.
+func readHeader(r io.Reader) (m mode, keyID string, wrapped []byte, size int64, err error) {
+	var fixed [len(magic) + 4]byte
+	if n, err := io.ReadFull(r, fixed[:]); err != nil {
+		if n < len(magic) || string(fixed[:len(magic)]) != magic {
+			return 0, "", nil, 0, ErrNotEncrypted
+		}
+		return 0, "", nil, 0, fmt.Errorf("%w: read header: %w", ErrCorrupted, err)
+	}
+	if string(fixed[:len(magic)]) != magic {
+		return 0, "", nil, 0, ErrNotEncrypted
+	}
+	if v := fixed[len(magic)]; v != formatVersion {
+		return 0, "", nil, 0, fmt.Errorf("unsupported encryption format version %d", v)
+	}
+
+	m = mode(fixed[len(magic)+1])
+	id := make([]byte, binary.LittleEndian.Uint16(fixed[len(magic)+2:]))
+	if _, err := io.ReadFull(r, id); err != nil {
+		return 0, "", nil, 0, fmt.Errorf("%w: read key id: %w", ErrCorrupted, err)
+	}
+
+	var wrappedLen uint16
+	if err := binary.Read(r, binary.LittleEndian, &wrappedLen); err != nil {
+		return 0, "", nil, 0, fmt.Errorf("%w: read data key: %w", ErrCorrupted, err)
+	}
+	wrapped = make([]byte, wrappedLen)
+	if _, err := io.ReadFull(r, wrapped); err != nil {
+		return 0, "", nil, 0, fmt.Errorf("%w: read data key: %w", ErrCorrupted, err)
+	}
+
+	size = int64(len(fixed) + len(id) + 2 + len(wrapped))
+	return m, string(id), wrapped, size, nil
+}
+
+func openHeader(r io.Reader, keys KeyProvider) (*header, error) {
+	m, keyID, wrapped, size, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if keys == nil {
+		return nil, fmt.Errorf("file encrypted with key %q, but no key provider is configured", keyID)
+	}
+	kek, err := keys.Key(keyID)
+	if err != nil {
+		return nil, fmt.Errorf("encryption key %q: %w", keyID, err)
+	}
+	wrapper, err := newAEAD(kek.Material)
+	if err != nil {
+		return nil, fmt.Errorf("key %q: %w", keyID, err)
+	}
+	dek, err := open(wrapper, nil, wrapped, headerPrefix(m, keyID))
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key with key %q: %w", keyID, err)
+	}
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+
+	return &header{mode: m, keyID: keyID, aead: aead, size: size}, nil
+}
+
+// KeyID returns the id of the key the file at path was encrypted with, or an
+// empty string if the file is not encrypted.
+func KeyID(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	_, keyID, _, _, err := readHeader(f)
+	if errors.Is(err, ErrNotEncrypted) {
+		return "", nil
+	}
+	return keyID, err
+}
+
+// IsEncrypted reports whether r starts with an encryption header.
+func IsEncrypted(r io.ReaderAt) (bool, error) {
+	var buf [len(magic)]byte
+	n, err := r.ReadAt(buf[:], 0)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+	return n == len(magic) && string(buf[:]) == magic, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("invalid key size %d, expected %d", len(key), KeySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext with a random nonce and appends nonce, ciphertext
+// and tag to dst.
+func seal(aead cipher.AEAD, dst, plaintext, additional []byte) []byte {
+	start := len(dst)
+	dst = append(dst, make([]byte, nonceSize)...)
+	// crypto/rand never returns an error on supported platforms
+	rand.Read(dst[start:])
+	return aead.Seal(dst, dst[start:start+nonceSize], plaintext, additional)
+}
+
+// open reverses seal, it appends the plaintext to dst.
+func open(aead cipher.AEAD, dst, sealed, additional []byte) ([]byte, error) {
+	if len(sealed) < nonceSize+tagSize {
+		return nil, ErrCorrupted
+	}
+	out, err := aead.Open(dst, sealed[:nonceSize], sealed[nonceSize:], additional)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCorrupted, err)
+	}
+	return out, nil
+}
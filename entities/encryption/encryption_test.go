@@ -0,0 +1,390 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeKeyfile(t *testing.T, path, current string, ids ...string) map[string][]byte {
+	t.Helper()
+
+	keys := map[string][]byte{}
+	for _, id := range ids {
+		key, err := GenerateKey(id)
+		require.NoError(t, err)
+		keys[id] = key.Material
+	}
+	keepKeys(t, path, current, keys)
+	return keys
+}
+
+// keepKeys rewrites the keyfile with the given material, so that rotations
+// keep the existing keys.
+func keepKeys(t *testing.T, path, current string, keys map[string][]byte) {
+	t.Helper()
+
+	var list []Key
+	for id, material := range keys {
+		list = append(list, Key{ID: id, Material: material})
+	}
+	require.NoError(t, WriteKeyfile(path, current, list))
+	// make sure the modification is detected on filesystems with coarse
+	// timestamps
+	later := time.Now().Add(time.Second)
+	require.NoError(t, os.Chtimes(path, later, later))
+}
+
+func newTestProvider(t *testing.T) (*KeyfileProvider, string) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	writeKeyfile(t, path, "k1", "k1")
+	p, err := NewKeyfileProvider(path)
+	require.NoError(t, err)
+	return p, path
+}
+
+func TestKeyfileProvider(t *testing.T) {
+	t.Run("rotation", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "keys.json")
+		keys := writeKeyfile(t, path, "k1", "k1")
+
+		p, err := NewKeyfileProvider(path)
+		require.NoError(t, err)
+		current, err := p.CurrentKey()
+		require.NoError(t, err)
+		assert.Equal(t, "k1", current.ID)
+		assert.Equal(t, keys["k1"], current.Material)
+
+		material := make([]byte, KeySize)
+		material[0] = 1
+		keys["k2"] = material
+		keepKeys(t, path, "k2", keys)
+
+		current, err = p.CurrentKey()
+		require.NoError(t, err)
+		assert.Equal(t, "k2", current.ID)
+		old, err := p.Key("k1")
+		require.NoError(t, err)
+		assert.Equal(t, keys["k1"], old.Material)
+
+		_, err = p.Key("k3")
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		dir := t.TempDir()
+		for name, content := range map[string]string{
+			"missing current": `{"current":"k2","keys":[{"id":"k1","key":"` + base64.StdEncoding.EncodeToString(make([]byte, KeySize)) + `"}]}`,
+			"short key":       `{"current":"k1","keys":[{"id":"k1","key":"` + base64.StdEncoding.EncodeToString(make([]byte, 16)) + `"}]}`,
+			"not json":        `k1`,
+		} {
+			path := filepath.Join(dir, name)
+			require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+			_, err := NewKeyfileProvider(path)
+			assert.Error(t, err, name)
+		}
+
+		key, err := GenerateKey("k1")
+		require.NoError(t, err)
+		assert.Error(t, WriteKeyfile(filepath.Join(dir, "keys.json"), "k2", []Key{key}))
+		assert.Error(t, WriteKeyfile(filepath.Join(dir, "keys.json"), "k1",
+			[]Key{{ID: "k1", Material: key.Material[:16]}}))
+	})
+}
+
+func TestStream(t *testing.T) {
+	keys, keyfilePath := newTestProvider(t)
+	path := filepath.Join(t.TempDir(), "log")
+
+	openAppend := func() *os.File {
+		f, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0o666)
+		require.NoError(t, err)
+		return f
+	}
+	readAll := func() []byte {
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+		r, err := NewReader(f, keys)
+		require.NoError(t, err)
+		out, err := io.ReadAll(r)
+		require.NoError(t, err)
+		return out
+	}
+
+	f := openAppend()
+	w, err := NewStreamWriter(f, keys)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("hello "))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	// rotating the key does not affect appending to an existing file
+	keepKeys(t, keyfilePath, "k2", map[string][]byte{"k1": keyMaterial(t, keys, "k1"), "k2": make([]byte, KeySize)})
+
+	f = openAppend()
+	w, err = NewStreamWriter(f, keys)
+	require.NoError(t, err)
+	large := bytes.Repeat([]byte("x"), maxFrameSize+10)
+	_, err = w.Write(large)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	expected := append([]byte("hello "), large...)
+	assert.Equal(t, expected, readAll())
+
+	keyID, err := KeyID(path)
+	require.NoError(t, err)
+	assert.Equal(t, "k1", keyID)
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "hello")
+
+	t.Run("torn frame", func(t *testing.T) {
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.NoError(t, os.Truncate(path, info.Size()-10))
+
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+		r, err := NewReader(f, keys)
+		require.NoError(t, err)
+		out, err := io.ReadAll(r)
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		// the large write was split into two frames, only the second is torn
+		assert.Equal(t, expected[:len("hello ")+maxFrameSize], out)
+	})
+
+	t.Run("truncate", func(t *testing.T) {
+		require.NoError(t, Truncate(path, 3, keys))
+		assert.Equal(t, []byte("hel"), readAll())
+
+		f := openAppend()
+		w, err := NewStreamWriter(f, keys)
+		require.NoError(t, err)
+		_, err = w.Write([]byte("lo"))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		assert.Equal(t, []byte("hello"), readAll())
+	})
+
+	t.Run("reordered frames", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "log")
+		f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o666)
+		require.NoError(t, err)
+		w, err := NewStreamWriter(f, keys)
+		require.NoError(t, err)
+		for _, frame := range []string{"a", "bb", "ccc"} {
+			_, err = w.Write([]byte(frame))
+			require.NoError(t, err)
+		}
+		require.NoError(t, f.Close())
+
+		raw, err := os.ReadFile(path)
+		require.NoError(t, err)
+		frameLen := func(plain int) int { return 4 + nonceSize + plain + tagSize }
+		third := len(raw) - frameLen(3)
+		second := third - frameLen(2)
+		first := second - frameLen(1)
+		header, frames := raw[:first], [][]byte{raw[first:second], raw[second:third], raw[third:]}
+
+		read := func(frames ...[]byte) ([]byte, error) {
+			r, err := NewReader(bytes.NewReader(bytes.Join(append([][]byte{header}, frames...), nil)), keys)
+			require.NoError(t, err)
+			return io.ReadAll(r)
+		}
+		out, err := read(frames...)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("abbccc"), out)
+
+		out, err = read(frames[0], frames[2], frames[1])
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		assert.Equal(t, []byte("a"), out)
+
+		out, err = read(frames[0], frames[0], frames[1])
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		assert.Equal(t, []byte("a"), out)
+
+		out, err = read(frames[0], frames[2])
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+		assert.Equal(t, []byte("a"), out)
+	})
+
+	t.Run("plaintext", func(t *testing.T) {
+		plainPath := filepath.Join(t.TempDir(), "plain")
+		require.NoError(t, os.WriteFile(plainPath, []byte("abc"), 0o666))
+
+		f, err := os.OpenFile(plainPath, os.O_RDWR|os.O_APPEND, 0o666)
+		require.NoError(t, err)
+		defer f.Close()
+		_, err = NewStreamWriter(f, keys)
+		assert.ErrorIs(t, err, ErrNotEncrypted)
+
+		r, err := NewReader(f, keys)
+		require.NoError(t, err)
+		out, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("abc"), out)
+
+		require.NoError(t, Truncate(plainPath, 1, keys))
+		raw, err := os.ReadFile(plainPath)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("a"), raw)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		otherPath := filepath.Join(t.TempDir(), "keys.json")
+		writeKeyfile(t, otherPath, "other", "other")
+		other, err := NewKeyfileProvider(otherPath)
+		require.NoError(t, err)
+
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		defer f.Close()
+		_, err = NewReader(f, other)
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+}
+
+func keyMaterial(t *testing.T, keys KeyProvider, id string) []byte {
+	k, err := keys.Key(id)
+	require.NoError(t, err)
+	return k.Material
+}
+
+func TestBlocks(t *testing.T) {
+	keys, _ := newTestProvider(t)
+
+	for _, size := range []int{10, BlockSize, 3*BlockSize + 17} {
+		path := filepath.Join(t.TempDir(), "segment")
+		expected := make([]byte, size)
+		_, err := rand.Read(expected)
+		require.NoError(t, err)
+
+		f, err := os.Create(path)
+		require.NoError(t, err)
+		w, err := NewBlockWriter(f, keys)
+		require.NoError(t, err)
+
+		// write a dummy header, then the body in odd sized chunks and patch the
+		// header at the end, the way segments are written
+		_, err = w.Write(make([]byte, 8))
+		require.NoError(t, err)
+		for pos := 8; pos < size; pos += 1000 {
+			_, err = w.Write(expected[pos:min(pos+1000, size)])
+			require.NoError(t, err)
+			if pos%7 == 0 {
+				require.NoError(t, w.Flush())
+			}
+		}
+		_, err = w.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+		_, err = w.Write(expected[:8])
+		require.NoError(t, err)
+		end, err := w.Seek(0, io.SeekEnd)
+		require.NoError(t, err)
+		require.Equal(t, int64(size), end)
+		require.NoError(t, w.Sync())
+		require.NoError(t, w.Close())
+
+		f, err = os.Open(path)
+		require.NoError(t, err)
+		info, err := f.Stat()
+		require.NoError(t, err)
+
+		r, err := NewReaderAt(f, info.Size(), keys)
+		require.NoError(t, err)
+		require.Equal(t, int64(size), r.Size())
+		assert.Equal(t, "k1", r.KeyID())
+
+		actual := make([]byte, size)
+		n, err := r.ReadAt(actual, 0)
+		require.NoError(t, err)
+		require.Equal(t, size, n)
+		assert.Equal(t, expected, actual)
+
+		partial := make([]byte, 20)
+		n, err = r.ReadAt(partial, int64(size-5))
+		assert.ErrorIs(t, err, io.EOF)
+		assert.Equal(t, expected[size-5:], partial[:n])
+
+		sequential, err := NewReader(f, keys)
+		require.NoError(t, err)
+		actual, err = io.ReadAll(sequential)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+		require.NoError(t, f.Close())
+	}
+
+	t.Run("truncated at a block boundary", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "segment")
+		f, err := os.Create(path)
+		require.NoError(t, err)
+		w, err := NewBlockWriter(f, keys)
+		require.NoError(t, err)
+		_, err = w.Write(make([]byte, 2*BlockSize+17))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		headerSize := info.Size() - 2*blockSlotSize - (17 + nonceSize + tagSize)
+		for _, blocks := range []int64{1, 2} {
+			require.NoError(t, os.Truncate(path, headerSize+blocks*blockSlotSize))
+			f, err := os.Open(path)
+			require.NoError(t, err)
+
+			r, err := NewReaderAt(f, headerSize+blocks*blockSlotSize, keys)
+			require.NoError(t, err)
+			_, err = r.ReadAt(make([]byte, r.Size()), 0)
+			assert.ErrorIs(t, err, ErrCorrupted)
+
+			sequential, err := NewReader(f, keys)
+			require.NoError(t, err)
+			_, err = io.ReadAll(sequential)
+			assert.ErrorIs(t, err, ErrCorrupted)
+			require.NoError(t, f.Close())
+		}
+	})
+
+	t.Run("rewriting flushed blocks is rejected", func(t *testing.T) {
+		f, err := os.Create(filepath.Join(t.TempDir(), "segment"))
+		require.NoError(t, err)
+		w, err := NewBlockWriter(f, keys)
+		require.NoError(t, err)
+		defer w.Close()
+
+		_, err = w.Write(make([]byte, 3*BlockSize))
+		require.NoError(t, err)
+		_, err = w.Seek(BlockSize, io.SeekStart)
+		require.NoError(t, err)
+		_, err = w.Write([]byte{1})
+		assert.Error(t, err)
+	})
+
+	t.Run("not encrypted", func(t *testing.T) {
+		_, err := NewReaderAt(bytes.NewReader([]byte("plaintext")), 9, keys)
+		assert.ErrorIs(t, err, ErrNotEncrypted)
+	})
+}
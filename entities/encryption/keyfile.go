@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// keyfile is the on-disk format read by the KeyfileProvider, keys are
+// base64-encoded 32 byte AES keys:
+//
+//	{
+//	  "current": "2024-10",
+//	  "keys": [
+//	    {"id": "2024-01", "key": "..."},
+//	    {"id": "2024-10", "key": "..."}
+//	  ]
+//	}
+type keyfile struct {
+	Current string `json:"current"`
+	Keys    []struct {
+		ID  string `json:"id"`
+		Key string `json:"key"`
+	} `json:"keys"`
+}
+
+// KeyfileProvider is a KeyProvider backed by a local keyfile. The file is
+// re-read whenever it changes, so keys can be rotated without a restart by
+// adding a new key and making it the current one. Retired keys have to stay
+// in the file until no persisted file references them anymore.
+type KeyfileProvider struct {
+	path string
+
+	sync.Mutex
+	modTime time.Time
+	size    int64
+	current string
+	keys    map[string][]byte
+}
+
+func NewKeyfileProvider(path string) (*KeyfileProvider, error) {
+	p := &KeyfileProvider{path: path}
+	if err := p.mayReload(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *KeyfileProvider) CurrentKey() (Key, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if err := p.mayReload(); err != nil {
+		return Key{}, err
+	}
+	return Key{ID: p.current, Material: p.keys[p.current]}, nil
+}
+
+func (p *KeyfileProvider) Key(id string) (Key, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	if err := p.mayReload(); err != nil {
+		return Key{}, err
+	}
+	material, ok := p.keys[id]
+	if !ok {
+		return Key{}, fmt.Errorf("%w: %q in keyfile %q", ErrKeyNotFound, id, p.path)
+	}
+	return Key{ID: id, Material: material}, nil
+}
+
+// GenerateKey returns a new random key with the given id.
+func GenerateKey(id string) (Key, error) {
+	material := make([]byte, KeySize)
+	if _, err := rand.Read(material); err != nil {
+		return Key{}, fmt.Errorf("generate key: %w", err)
+	}
+	return Key{ID: id, Material: material}, nil
+}
+
+// WriteKeyfile atomically replaces the keyfile at path with the given keys,
+// current has to be the id of one of them.
+func WriteKeyfile(path, current string, keys []Key) error {
+	var kf keyfile
+	kf.Current = current
+	found := false
+	for _, k := range keys {
+		if len(k.Material) != KeySize {
+			return fmt.Errorf("key %q must be %d bytes, got %d", k.ID, KeySize, len(k.Material))
+		}
+		found = found || k.ID == current
+		kf.Keys = append(kf.Keys, struct {
+			ID  string `json:"id"`
+			Key string `json:"key"`
+		}{ID: k.ID, Key: base64.StdEncoding.EncodeToString(k.Material)})
+	}
+	if !found {
+		return fmt.Errorf("current key %q not found", current)
+	}
+
+	raw, err := json.Marshal(kf)
+	if err != nil {
+		return fmt.Errorf("marshal keyfile: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, raw, 0o600); err != nil {
+		return fmt.Errorf("write keyfile: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("rename keyfile: %w", err)
+	}
+	return nil
+}
+
+func (p *KeyfileProvider) mayReload() error {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return fmt.Errorf("stat keyfile: %w", err)
+	}
+	if p.keys != nil && info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return nil
+	}
+
+	raw, err := os.ReadFile(p.path)
+	if err != nil {
+		return fmt.Errorf("read keyfile: %w", err)
+	}
+	var kf keyfile
+	if err := json.Unmarshal(raw, &kf); err != nil {
+		return fmt.Errorf("parse keyfile %q: %w", p.path, err)
+	}
+
+	keys := make(map[string][]byte, len(kf.Keys))
+	for _, k := range kf.Keys {
+		if k.ID == "" {
+			return fmt.Errorf("keyfile %q: key without id", p.path)
+		}
+		if _, ok := keys[k.ID]; ok {
+			return fmt.Errorf("keyfile %q: duplicate key id %q", p.path, k.ID)
+		}
+		material, err := base64.StdEncoding.DecodeString(k.Key)
+		if err != nil {
+			return fmt.Errorf("keyfile %q: decode key %q: %w", p.path, k.ID, err)
+		}
+		if len(material) != KeySize {
+			return fmt.Errorf("keyfile %q: key %q must be %d bytes, got %d",
+				p.path, k.ID, KeySize, len(material))
+		}
+		keys[k.ID] = material
+	}
+	if _, ok := keys[kf.Current]; !ok {
+		return fmt.Errorf("keyfile %q: current key %q not found", p.path, kf.Current)
+	}
+
+	p.modTime = info.ModTime()
+	p.size = info.Size()
+	p.current = kf.Current
+	p.keys = keys
+	return nil
+}
@@ -0,0 +1,271 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// maxFrameSize limits the plaintext of a single stream frame, larger writes
+// are split into several frames.
+const maxFrameSize = 1024 * 1024
+
+// StreamWriter encrypts every write as a self-contained frame, consisting of
+// the length of the sealed frame, followed by nonce, ciphertext and tag. The
+// offset of a frame within the file is authenticated along with it, so that
+// frames cannot be reordered, duplicated or removed from the middle of a
+// stream. Writes are not buffered, callers are expected to write through a
+// buffered writer so that frames are reasonably large.
+type StreamWriter struct {
+	w      io.Writer
+	header *header
+	frame  []byte
+	// offset of the next frame in the file
+	offset int64
+}
+
+// NewStreamWriter prepares f, which must be positioned at its end and be
+// opened for reading and writing, for appending encrypted frames. A new
+// header is written to empty files, the data key of existing files is
+// recovered from their header. ErrNotEncrypted is returned for non-empty
+// plaintext files.
+func NewStreamWriter(f *os.File, keys KeyProvider) (*StreamWriter, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("stat %q: %w", f.Name(), err)
+	}
+
+	if info.Size() == 0 {
+		h, raw, err := newHeader(modeStream, keys)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := f.Write(raw); err != nil {
+			return nil, fmt.Errorf("write encryption header: %w", err)
+		}
+		return &StreamWriter{w: f, header: h, offset: h.size}, nil
+	}
+
+	h, err := openHeader(io.NewSectionReader(f, 0, info.Size()), keys)
+	if err != nil {
+		return nil, err
+	}
+	if h.mode != modeStream {
+		return nil, fmt.Errorf("%q is not an encrypted stream", f.Name())
+	}
+	return &StreamWriter{w: f, header: h, offset: info.Size()}, nil
+}
+
+func (w *StreamWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), maxFrameSize)
+		w.frame = append(w.frame[:0], 0, 0, 0, 0)
+		w.frame = seal(w.header.aead, w.frame, p[:n], frameAdditionalData(w.offset))
+		binary.LittleEndian.PutUint32(w.frame, uint32(len(w.frame)-4))
+		m, err := w.w.Write(w.frame)
+		w.offset += int64(m)
+		if err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+func frameAdditionalData(offset int64) []byte {
+	return binary.LittleEndian.AppendUint64(nil, uint64(offset))
+}
+
+// readFrame reads and decrypts the next frame of a stream, which starts at
+// offset of the file, appending the plaintext to dst. It returns io.EOF at a
+// frame boundary. Incomplete frames and frames that fail authentication are
+// reported as io.ErrUnexpectedEOF, from the perspective of a log reader they
+// are indistinguishable from a torn write.
+func readFrame(r io.Reader, h *header, dst []byte, offset int64) ([]byte, int64, error) {
+	var length [4]byte
+	if _, err := io.ReadFull(r, length[:]); err != nil {
+		return nil, 0, err
+	}
+
+	sealedLen := binary.LittleEndian.Uint32(length[:])
+	if sealedLen < nonceSize+tagSize || sealedLen > maxFrameSize+nonceSize+tagSize {
+		return nil, 0, fmt.Errorf("%w: invalid frame length %d: %w",
+			ErrCorrupted, sealedLen, io.ErrUnexpectedEOF)
+	}
+
+	sealed := make([]byte, sealedLen)
+	if _, err := io.ReadFull(r, sealed); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, 0, err
+	}
+
+	plain, err := open(h.aead, dst, sealed, frameAdditionalData(offset))
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %w", err, io.ErrUnexpectedEOF)
+	}
+	return plain, int64(len(length)) + int64(sealedLen), nil
+}
+
+// NewReader returns a reader for the plaintext of a file written by either a
+// StreamWriter or a BlockWriter. Files that are not encrypted are passed
+// through unchanged, so that files written before encryption was enabled
+// remain readable.
+func NewReader(r io.Reader, keys KeyProvider) (io.Reader, error) {
+	var prefix [len(magic)]byte
+	n, err := io.ReadFull(r, prefix[:])
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, err
+	}
+	if n < len(magic) || string(prefix[:]) != magic {
+		return io.MultiReader(bytes.NewReader(prefix[:n]), r), nil
+	}
+
+	h, err := openHeader(io.MultiReader(bytes.NewReader(prefix[:]), r), keys)
+	if err != nil {
+		return nil, err
+	}
+	switch h.mode {
+	case modeStream, modeBlock:
+		return &reader{r: r, header: h, offset: h.size}, nil
+	default:
+		return nil, fmt.Errorf("unsupported encryption mode %d", h.mode)
+	}
+}
+
+type reader struct {
+	r      io.Reader
+	header *header
+	buf    []byte
+	plain  []byte
+	block  uint64
+	// final is set once the last block of a block file was read
+	final bool
+	// offset of the next stream frame in the file
+	offset int64
+	err    error
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.plain) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.plain, r.err = r.next()
+	}
+
+	n := copy(p, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
+
+func (r *reader) next() ([]byte, error) {
+	if r.header.mode == modeStream {
+		plain, n, err := readFrame(r.r, r.header, r.buf[:0], r.offset)
+		r.buf = plain
+		r.offset += n
+		return plain, err
+	}
+
+	sealed := make([]byte, blockSlotSize)
+	n, err := io.ReadFull(r.r, sealed)
+	if err != nil && (!errors.Is(err, io.ErrUnexpectedEOF) || n == 0) {
+		if errors.Is(err, io.EOF) && r.block > 0 && !r.final {
+			return nil, fmt.Errorf("%w: last block %d missing: %w", ErrCorrupted, r.block, io.ErrUnexpectedEOF)
+		}
+		return nil, err
+	}
+	if r.final {
+		return nil, fmt.Errorf("%w: block %d after the last block", ErrCorrupted, r.block)
+	}
+
+	// a partial block is always the last one, a full one is only known to be
+	// the last one once it authenticates as such
+	r.final = n < blockSlotSize
+	plain, err := openBlock(r.header, r.buf[:0], sealed[:n], r.block, r.final)
+	if err != nil && !r.final {
+		r.final = true
+		plain, err = openBlock(r.header, r.buf[:0], sealed[:n], r.block, r.final)
+	}
+	if err != nil {
+		return nil, err
+	}
+	r.buf = plain
+	r.block++
+	if n < blockSlotSize {
+		return plain, io.EOF
+	}
+	return plain, nil
+}
+
+// Truncate cuts the plaintext of the file at path to size bytes. For stream
+// files all frames before the cut are kept, the part of the frame that
+// crosses it is re-encrypted into a new frame. Incomplete or corrupted frames
+// after the cut are dropped. Plaintext files are truncated directly.
+func Truncate(path string, size int64, keys KeyProvider) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0o666)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	br := bufio.NewReader(io.NewSectionReader(f, 0, info.Size()))
+
+	h, err := openHeader(br, keys)
+	if errors.Is(err, ErrNotEncrypted) {
+		return f.Truncate(size)
+	} else if err != nil {
+		return err
+	}
+	if h.mode != modeStream {
+		return fmt.Errorf("%q is not an encrypted stream", path)
+	}
+
+	offset, plainOffset := h.size, int64(0)
+	var tail []byte
+	for plainOffset < size {
+		plain, n, err := readFrame(br, h, nil, offset)
+		if err != nil {
+			break
+		}
+		if plainOffset+int64(len(plain)) > size {
+			tail = plain[:size-plainOffset]
+			break
+		}
+		offset += n
+		plainOffset += int64(len(plain))
+	}
+
+	if err := f.Truncate(offset); err != nil {
+		return err
+	}
+	if len(tail) > 0 {
+		w := &StreamWriter{w: io.NewOffsetWriter(f, offset), header: h, offset: offset}
+		if _, err := w.Write(tail); err != nil {
+			return fmt.Errorf("rewrite truncated frame: %w", err)
+		}
+	}
+	return f.Sync()
+}
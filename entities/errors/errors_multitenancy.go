@@ -16,10 +16,18 @@ import (
 )
 
 var (
-	ErrTenantNotActive = errors.New("tenant not active")
-	ErrTenantNotFound  = errors.New("tenant not found")
+	ErrTenantNotActive     = errors.New("tenant not active")
+	ErrTenantNotFound      = errors.New("tenant not found")
+	ErrTenantQuotaExceeded = errors.New("tenant quota exceeded")
 )
 
 func IsTenantNotFound(err error) bool {
 	return errors.Is(errors.Unwrap(err), ErrTenantNotFound)
 }
+
+// IsTenantQuotaExceeded is true if err wraps ErrTenantQuotaExceeded. Errors
+// returned by other nodes are only detected if the client restored the
+// sentinel from the status code of the response.
+func IsTenantQuotaExceeded(err error) bool {
+	return errors.Is(err, ErrTenantQuotaExceeded)
+}
@@ -34,6 +34,9 @@ const (
 	OperatorIsNull
 	ContainsAny
 	ContainsAll
+	// OperatorNot negates its single operand, which can be any clause
+	// including nested ones
+	OperatorNot
 )
 
 func (o Operator) OnValue() bool {
@@ -83,6 +86,8 @@ func (o Operator) Name() string {
 		return "ContainsAny"
 	case ContainsAll:
 		return "ContainsAll"
+	case OperatorNot:
+		return "Not"
 	default:
 		panic("Unknown operator")
 	}
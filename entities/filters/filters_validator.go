@@ -41,6 +41,11 @@ func ValidateFilters(authorizedGetClass func(string) (*models.Class, error), fil
 }
 
 func validateClause(authorizedGetClass func(string) (*models.Class, error), cw *clauseWrapper) error {
+	if cw.getOperator() == OperatorNot && len(cw.getOperands()) != 1 {
+		return errors.Errorf("operator Not requires exactly one operand, got %d",
+			len(cw.getOperands()))
+	}
+
 	// check if nested
 	if cw.getOperands() != nil {
 		var errs []error
@@ -86,6 +86,71 @@ func TestValidateIsNullOperator(t *testing.T) {
 	}
 }
 
+func TestValidateNotOperator(t *testing.T) {
+	horsepower := func(value int) Clause {
+		return Clause{
+			Operator: OperatorGreaterThan,
+			Value:    &Value{Value: value, Type: schema.DataTypeInt},
+			On:       &Path{Class: "Car", Property: "horsepower"},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		operands []Clause
+		valid    bool
+	}{
+		{
+			name:     "single operand",
+			operands: []Clause{horsepower(100)},
+			valid:    true,
+		},
+		{
+			name: "single nested operand",
+			operands: []Clause{{
+				Operator: OperatorOr,
+				Operands: []Clause{horsepower(100), horsepower(200)},
+			}},
+			valid: true,
+		},
+		{
+			name:     "no operands",
+			operands: []Clause{},
+			valid:    false,
+		},
+		{
+			name:     "multiple operands",
+			operands: []Clause{horsepower(100), horsepower(200)},
+			valid:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator: OperatorNot,
+				Operands: tt.operands,
+			}
+
+			f := &fakeFinder{}
+			f.On("ReadOnlyClass", mock.Anything).Return(
+				&models.Class{
+					Class: "Car",
+					Properties: []*models.Property{
+						{Name: "horsepower", DataType: []string{"int"}},
+					},
+				},
+			)
+			err := validateClause(f.ReadOnlyClass, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
 func TestValidatePropertyLength(t *testing.T) {
 	tests := []struct {
 		name       string
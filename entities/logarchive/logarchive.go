@@ -0,0 +1,23 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package logarchive defines the hook through which complete write-ahead-logs
+// are archived, so that they can later be replayed on top of a backup to
+// restore to a point in time.
+package logarchive
+
+// Archiver keeps the content of write-ahead-logs beyond their lifetime
+type Archiver interface {
+	// Retire is called after the last write to the log at path. The file
+	// may be deleted or rewritten as soon as Retire returns, so the archiver
+	// has to keep its content. A missing file is not an error.
+	Retire(path string) error
+}
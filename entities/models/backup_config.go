@@ -51,6 +51,9 @@ type BackupConfig struct {
 	// name of the endpoint, e.g. s3.amazonaws.com
 	Endpoint string `json:"Endpoint,omitempty"`
 
+	// ID of a previous backup on the same backend. Files which have not changed since then are referenced instead of being copied again, the base backup must be kept as long as this one.
+	IncrementalBaseBackupID string `json:"IncrementalBaseBackupID,omitempty"`
+
 	// Path or key within the bucket
 	Path string `json:"Path,omitempty"`
 }
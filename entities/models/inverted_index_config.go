@@ -41,12 +41,18 @@ type InvertedIndexConfig struct {
 	// Index length of properties (default: 'false').
 	IndexPropertyLength bool `json:"indexPropertyLength,omitempty"`
 
+	// Index the positions of terms in text properties, required for phrase and proximity queries in BM25 (default: 'false').
+	IndexTermPositions bool `json:"indexTermPositions,omitempty"`
+
 	// Index each object by its internal timestamps (default: 'false').
 	IndexTimestamps bool `json:"indexTimestamps,omitempty"`
 
 	// stopwords
 	Stopwords *StopwordConfig `json:"stopwords,omitempty"`
 
+	// synonyms
+	Synonyms *SynonymConfig `json:"synonyms,omitempty"`
+
 	// Using BlockMax WAND for query execution (default: 'false', will be 'true' for new collections created after 1.30).
 	UsingBlockMaxWAND bool `json:"usingBlockMaxWAND,omitempty"`
 }
@@ -63,6 +69,10 @@ func (m *InvertedIndexConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSynonyms(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -107,6 +117,25 @@ func (m *InvertedIndexConfig) validateStopwords(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *InvertedIndexConfig) validateSynonyms(formats strfmt.Registry) error {
+	if swag.IsZero(m.Synonyms) { // not required
+		return nil
+	}
+
+	if m.Synonyms != nil {
+		if err := m.Synonyms.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("synonyms")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("synonyms")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this inverted index config based on the context it is used
 func (m *InvertedIndexConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -119,6 +148,10 @@ func (m *InvertedIndexConfig) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSynonyms(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -157,6 +190,22 @@ func (m *InvertedIndexConfig) contextValidateStopwords(ctx context.Context, form
 	return nil
 }
 
+func (m *InvertedIndexConfig) contextValidateSynonyms(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Synonyms != nil {
+		if err := m.Synonyms.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("synonyms")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("synonyms")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *InvertedIndexConfig) MarshalBinary() ([]byte, error) {
 	if m == nil {
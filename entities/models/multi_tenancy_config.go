@@ -19,6 +19,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -36,15 +37,71 @@ type MultiTenancyConfig struct {
 
 	// Whether or not multi-tenancy is enabled for this class (default: false).
 	Enabled bool `json:"enabled"`
+
+	// tenant quota
+	TenantQuota *TenantQuota `json:"tenantQuota,omitempty"`
 }
 
 // Validate validates this multi tenancy config
 func (m *MultiTenancyConfig) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTenantQuota(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *MultiTenancyConfig) validateTenantQuota(formats strfmt.Registry) error {
+	if swag.IsZero(m.TenantQuota) { // not required
+		return nil
+	}
+
+	if m.TenantQuota != nil {
+		if err := m.TenantQuota.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tenantQuota")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("tenantQuota")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
-// ContextValidate validates this multi tenancy config based on context it is used
+// ContextValidate validate this multi tenancy config based on the context it is used
 func (m *MultiTenancyConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTenantQuota(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *MultiTenancyConfig) contextValidateTenantQuota(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.TenantQuota != nil {
+		if err := m.TenantQuota.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("tenantQuota")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("tenantQuota")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -34,6 +34,9 @@ type NodeShardStatus struct {
 	// The status of vector compression/quantization.
 	Compressed bool `json:"compressed"`
 
+	// The size of the shard on disk in bytes.
+	DiskBytes int64 `json:"diskBytes"`
+
 	// The load status of the shard.
 	Loaded bool `json:"loaded"`
 
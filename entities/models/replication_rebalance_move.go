@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ReplicationRebalanceMove A move of a shard replica from one node to another
+//
+// swagger:model ReplicationRebalanceMove
+type ReplicationRebalanceMove struct {
+
+	// The collection name holding the shard
+	CollectionID string `json:"collectionId,omitempty"`
+
+	// The size of the shard on disk at the time the move was planned
+	DiskBytes int64 `json:"diskBytes,omitempty"`
+
+	// The reason a move failed
+	Error string `json:"error,omitempty"`
+
+	// The id of the moved shard
+	ShardID string `json:"shardId,omitempty"`
+
+	// The node the replica is moved from
+	SourceNodeID string `json:"sourceNodeId,omitempty"`
+
+	// The state of the move, only set for moves of a started rebalance
+	// Enum: [PENDING REPLICATING DONE FAILED CANCELED]
+	Status string `json:"status,omitempty"`
+
+	// The node the replica is moved to
+	TargetNodeID string `json:"targetNodeId,omitempty"`
+}
+
+// Validate validates this replication rebalance move
+func (m *ReplicationRebalanceMove) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var replicationRebalanceMoveTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["PENDING","REPLICATING","DONE","FAILED","CANCELED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replicationRebalanceMoveTypeStatusPropEnum = append(replicationRebalanceMoveTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplicationRebalanceMoveStatusPENDING captures enum value "PENDING"
+	ReplicationRebalanceMoveStatusPENDING string = "PENDING"
+
+	// ReplicationRebalanceMoveStatusREPLICATING captures enum value "REPLICATING"
+	ReplicationRebalanceMoveStatusREPLICATING string = "REPLICATING"
+
+	// ReplicationRebalanceMoveStatusDONE captures enum value "DONE"
+	ReplicationRebalanceMoveStatusDONE string = "DONE"
+
+	// ReplicationRebalanceMoveStatusFAILED captures enum value "FAILED"
+	ReplicationRebalanceMoveStatusFAILED string = "FAILED"
+
+	// ReplicationRebalanceMoveStatusCANCELED captures enum value "CANCELED"
+	ReplicationRebalanceMoveStatusCANCELED string = "CANCELED"
+)
+
+// prop value enum
+func (m *ReplicationRebalanceMove) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, replicationRebalanceMoveTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ReplicationRebalanceMove) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this replication rebalance move based on context it is used
+func (m *ReplicationRebalanceMove) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReplicationRebalanceMove) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReplicationRebalanceMove) UnmarshalBinary(b []byte) error {
+	var res ReplicationRebalanceMove
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
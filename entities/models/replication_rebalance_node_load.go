@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ReplicationRebalanceNodeLoad The shard replicas held by a node
+//
+// swagger:model ReplicationRebalanceNodeLoad
+type ReplicationRebalanceNodeLoad struct {
+
+	// The size of the shard replicas held by the node on disk
+	DiskBytes int64 `json:"diskBytes,omitempty"`
+
+	// The name of the node
+	NodeID string `json:"nodeId,omitempty"`
+
+	// The number of shard replicas held by the node
+	ShardCount int64 `json:"shardCount,omitempty"`
+}
+
+// Validate validates this replication rebalance node load
+func (m *ReplicationRebalanceNodeLoad) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this replication rebalance node load based on context it is used
+func (m *ReplicationRebalanceNodeLoad) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReplicationRebalanceNodeLoad) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReplicationRebalanceNodeLoad) UnmarshalBinary(b []byte) error {
+	var res ReplicationRebalanceNodeLoad
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
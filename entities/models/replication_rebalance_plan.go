@@ -0,0 +1,241 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ReplicationRebalancePlan The moves which balance the shard replicas across the nodes
+//
+// swagger:model ReplicationRebalancePlan
+type ReplicationRebalancePlan struct {
+
+	// The load of the nodes before the moves
+	Current []*ReplicationRebalanceNodeLoad `json:"current"`
+
+	// The planned moves
+	Moves []*ReplicationRebalanceMove `json:"moves"`
+
+	// The load of the nodes once all moves completed
+	Planned []*ReplicationRebalanceNodeLoad `json:"planned"`
+}
+
+// Validate validates this replication rebalance plan
+func (m *ReplicationRebalancePlan) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCurrent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMoves(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePlanned(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReplicationRebalancePlan) validateCurrent(formats strfmt.Registry) error {
+	if swag.IsZero(m.Current) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Current); i++ {
+		if swag.IsZero(m.Current[i]) { // not required
+			continue
+		}
+
+		if m.Current[i] != nil {
+			if err := m.Current[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("current" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("current" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ReplicationRebalancePlan) validateMoves(formats strfmt.Registry) error {
+	if swag.IsZero(m.Moves) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Moves); i++ {
+		if swag.IsZero(m.Moves[i]) { // not required
+			continue
+		}
+
+		if m.Moves[i] != nil {
+			if err := m.Moves[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("moves" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("moves" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ReplicationRebalancePlan) validatePlanned(formats strfmt.Registry) error {
+	if swag.IsZero(m.Planned) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Planned); i++ {
+		if swag.IsZero(m.Planned[i]) { // not required
+			continue
+		}
+
+		if m.Planned[i] != nil {
+			if err := m.Planned[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("planned" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("planned" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this replication rebalance plan based on the context it is used
+func (m *ReplicationRebalancePlan) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCurrent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateMoves(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePlanned(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReplicationRebalancePlan) contextValidateCurrent(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Current); i++ {
+
+		if m.Current[i] != nil {
+			if err := m.Current[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("current" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("current" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ReplicationRebalancePlan) contextValidateMoves(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Moves); i++ {
+
+		if m.Moves[i] != nil {
+			if err := m.Moves[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("moves" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("moves" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ReplicationRebalancePlan) contextValidatePlanned(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Planned); i++ {
+
+		if m.Planned[i] != nil {
+			if err := m.Planned[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("planned" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("planned" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReplicationRebalancePlan) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReplicationRebalancePlan) UnmarshalBinary(b []byte) error {
+	var res ReplicationRebalancePlan
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
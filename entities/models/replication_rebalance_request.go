@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ReplicationRebalanceRequest Options of a shard rebalance
+//
+// swagger:model ReplicationRebalanceRequest
+type ReplicationRebalanceRequest struct {
+
+	// The maximum number of moves replicating shards at the same time
+	// Minimum: 1
+	MaxConcurrentMoves int64 `json:"maxConcurrentMoves,omitempty"`
+}
+
+// Validate validates this replication rebalance request
+func (m *ReplicationRebalanceRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMaxConcurrentMoves(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReplicationRebalanceRequest) validateMaxConcurrentMoves(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxConcurrentMoves) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("maxConcurrentMoves", "body", m.MaxConcurrentMoves, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this replication rebalance request based on context it is used
+func (m *ReplicationRebalanceRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReplicationRebalanceRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReplicationRebalanceRequest) UnmarshalBinary(b []byte) error {
+	var res ReplicationRebalanceRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
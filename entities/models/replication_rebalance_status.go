@@ -0,0 +1,211 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ReplicationRebalanceStatus The state of a shard rebalance
+//
+// swagger:model ReplicationRebalanceStatus
+type ReplicationRebalanceStatus struct {
+
+	// Whether the rebalance was started because nodes joined or left the cluster
+	Automatic bool `json:"automatic,omitempty"`
+
+	// The moves of the rebalance
+	Moves []*ReplicationRebalanceMove `json:"moves"`
+
+	// Timestamp of when the rebalance started
+	// Format: date-time
+	StartedAt strfmt.DateTime `json:"startedAt,omitempty"`
+
+	// The state of the rebalance
+	// Enum: [IDLE RUNNING PAUSED CANCELED FINISHED]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this replication rebalance status
+func (m *ReplicationRebalanceStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMoves(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReplicationRebalanceStatus) validateMoves(formats strfmt.Registry) error {
+	if swag.IsZero(m.Moves) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Moves); i++ {
+		if swag.IsZero(m.Moves[i]) { // not required
+			continue
+		}
+
+		if m.Moves[i] != nil {
+			if err := m.Moves[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("moves" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("moves" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *ReplicationRebalanceStatus) validateStartedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("startedAt", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var replicationRebalanceStatusTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["IDLE","RUNNING","PAUSED","CANCELED","FINISHED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replicationRebalanceStatusTypeStatusPropEnum = append(replicationRebalanceStatusTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ReplicationRebalanceStatusStatusIDLE captures enum value "IDLE"
+	ReplicationRebalanceStatusStatusIDLE string = "IDLE"
+
+	// ReplicationRebalanceStatusStatusRUNNING captures enum value "RUNNING"
+	ReplicationRebalanceStatusStatusRUNNING string = "RUNNING"
+
+	// ReplicationRebalanceStatusStatusPAUSED captures enum value "PAUSED"
+	ReplicationRebalanceStatusStatusPAUSED string = "PAUSED"
+
+	// ReplicationRebalanceStatusStatusCANCELED captures enum value "CANCELED"
+	ReplicationRebalanceStatusStatusCANCELED string = "CANCELED"
+
+	// ReplicationRebalanceStatusStatusFINISHED captures enum value "FINISHED"
+	ReplicationRebalanceStatusStatusFINISHED string = "FINISHED"
+)
+
+// prop value enum
+func (m *ReplicationRebalanceStatus) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, replicationRebalanceStatusTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ReplicationRebalanceStatus) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this replication rebalance status based on the context it is used
+func (m *ReplicationRebalanceStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateMoves(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReplicationRebalanceStatus) contextValidateMoves(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Moves); i++ {
+
+		if m.Moves[i] != nil {
+			if err := m.Moves[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("moves" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("moves" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReplicationRebalanceStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReplicationRebalanceStatus) UnmarshalBinary(b []byte) error {
+	var res ReplicationRebalanceStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
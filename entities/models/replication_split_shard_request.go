@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ReplicationSplitShardRequest Request body to split a shard of a single-tenant collection into new shards
+//
+// swagger:model ReplicationSplitShardRequest
+type ReplicationSplitShardRequest struct {
+
+	// The collection name holding the shard
+	// Required: true
+	CollectionID *string `json:"collectionId"`
+
+	// The number of shards to split the shard into
+	Parts *int64 `json:"parts,omitempty"`
+
+	// The shard id of the shard to be split
+	// Required: true
+	ShardID *string `json:"shardId"`
+}
+
+// Validate validates this replication split shard request
+func (m *ReplicationSplitShardRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCollectionID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateShardID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReplicationSplitShardRequest) validateCollectionID(formats strfmt.Registry) error {
+
+	if err := validate.Required("collectionId", "body", m.CollectionID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ReplicationSplitShardRequest) validateShardID(formats strfmt.Registry) error {
+
+	if err := validate.Required("shardId", "body", m.ShardID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this replication split shard request based on context it is used
+func (m *ReplicationSplitShardRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReplicationSplitShardRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReplicationSplitShardRequest) UnmarshalBinary(b []byte) error {
+	var res ReplicationSplitShardRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
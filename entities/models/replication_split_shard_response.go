@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ReplicationSplitShardResponse The shards a shard is being split into
+//
+// swagger:model ReplicationSplitShardResponse
+type ReplicationSplitShardResponse struct {
+
+	// The collection name holding the shard
+	CollectionID string `json:"collectionId,omitempty"`
+
+	// The shard id of the shard being split
+	ShardID string `json:"shardId,omitempty"`
+
+	// The ids of the new shards, which replace the shard once the split completed
+	TargetShardIds []string `json:"targetShardIds"`
+}
+
+// Validate validates this replication split shard response
+func (m *ReplicationSplitShardResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this replication split shard response based on context it is used
+func (m *ReplicationSplitShardResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReplicationSplitShardResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReplicationSplitShardResponse) UnmarshalBinary(b []byte) error {
+	var res ReplicationSplitShardResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -43,6 +43,9 @@ type RestoreConfig struct {
 
 	// Path within the bucket
 	Path string `json:"Path,omitempty"`
+
+	// Point in time, in milliseconds since epoch UTC, up to which the logs archived after the backup are replayed. Requires log archiving to have been enabled when the backup was created. Logs are archived in rounds, every PERSISTENCE_LOG_ARCHIVE_INTERVAL_SECONDS, and only rounds archived up to this time are replayed, so writes made up to one interval before it may not be restored. The time actually restored to is logged by each node.
+	RestoreUntilUnix int64 `json:"RestoreUntilUnix,omitempty"`
 }
 
 // Validate validates this restore config
@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SynonymConfig Synonyms that the terms of keyword (BM25) queries are expanded with. Synonyms are matched case-insensitively and only apply to properties with 'word' or 'lowercase' tokenization.
+//
+// swagger:model SynonymConfig
+type SynonymConfig struct {
+
+	// Groups of equivalent terms (default: []).
+	Sets []*SynonymSet `json:"sets"`
+}
+
+// Validate validates this synonym config
+func (m *SynonymConfig) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSets(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SynonymConfig) validateSets(formats strfmt.Registry) error {
+	if swag.IsZero(m.Sets) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Sets); i++ {
+		if swag.IsZero(m.Sets[i]) { // not required
+			continue
+		}
+
+		if m.Sets[i] != nil {
+			if err := m.Sets[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("sets" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("sets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this synonym config based on the context it is used
+func (m *SynonymConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSets(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SynonymConfig) contextValidateSets(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Sets); i++ {
+
+		if m.Sets[i] != nil {
+			if err := m.Sets[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("sets" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("sets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SynonymConfig) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SynonymConfig) UnmarshalBinary(b []byte) error {
+	var res SynonymConfig
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SynonymSet A group of equivalent terms, a query containing one of them also matches the others
+//
+// swagger:model SynonymSet
+type SynonymSet struct {
+
+	// The equivalent terms, each a single token.
+	Terms []string `json:"terms"`
+
+	// Weight of matches through a synonym relative to matches of the query term itself, between 0 and 1 (default: 1).
+	Weight float32 `json:"weight,omitempty"`
+}
+
+// Validate validates this synonym set
+func (m *SynonymSet) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this synonym set based on context it is used
+func (m *SynonymSet) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SynonymSet) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SynonymSet) UnmarshalBinary(b []byte) error {
+	var res SynonymSet
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,34 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modulecapabilities
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/entities/encryption"
+)
+
+// KeyProvider manages per-tenant data keys for encryption at rest. Deleting
+// the keys of a tenant crypto-shreds its data, including any backups of it.
+type KeyProvider interface {
+	// TenantKeys returns the keys the files of the given tenant are encrypted
+	// with. It returns an error wrapping encryption.ErrKeyNotFound if the
+	// tenant has no key.
+	TenantKeys(ctx context.Context, class, tenant string) (encryption.KeyProvider, error)
+	// CreateTenantKeys generates a key for the given tenant, unless it already
+	// has one, and returns its keys.
+	CreateTenantKeys(ctx context.Context, class, tenant string) (encryption.KeyProvider, error)
+	// DeleteTenantKeys deletes the keys of the given tenants of class
+	DeleteTenantKeys(ctx context.Context, class string, tenants []string) error
+	// DeleteClassKeys deletes the keys of all tenants of class
+	DeleteClassKeys(ctx context.Context, class string) error
+}
@@ -26,6 +26,7 @@ const (
 	Backup              ModuleType = "Backup"
 	Extension           ModuleType = "Extension"
 	Img2Vec             ModuleType = "Img2Vec"
+	KeyManagement       ModuleType = "KeyManagement"
 	Multi2Vec           ModuleType = "Multi2Vec"
 	Ref2Vec             ModuleType = "Ref2Vec"
 	Text2MultiVec       ModuleType = "Text2MultiVec"
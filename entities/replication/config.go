@@ -11,6 +11,8 @@
 
 package replication
 
+import "time"
+
 // GlobalConfig represents system-wide config that may restrict settings of an
 // individual class
 type GlobalConfig struct {
@@ -24,4 +26,23 @@ type GlobalConfig struct {
 	MinimumFactor int `json:"minimum_factor" yaml:"minimum_factor"`
 
 	DeletionStrategy string `json:"deletion_strategy" yaml:"deletion_strategy"`
+
+	// Rebalance configures how shard replicas are moved between nodes to
+	// balance their number and size
+	Rebalance RebalanceConfig `json:"rebalance" yaml:"rebalance"`
+}
+
+// RebalanceConfig configures the rebalancing of shard replicas across nodes
+type RebalanceConfig struct {
+	// Auto starts a rebalance whenever storage nodes join or leave the cluster.
+	// It is carried out by the leader of the cluster.
+	Auto bool `json:"auto" yaml:"auto"`
+	// Interval is how often the progress of moves and the cluster membership
+	// are checked
+	Interval time.Duration `json:"interval" yaml:"interval"`
+	// MaxConcurrentMoves is the default number of moves replicating shards at
+	// the same time
+	MaxConcurrentMoves int `json:"max_concurrent_moves" yaml:"max_concurrent_moves"`
+	// MaxMoves limits the number of moves of a single rebalance
+	MaxMoves int `json:"max_moves" yaml:"max_moves"`
 }
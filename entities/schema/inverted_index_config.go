@@ -18,10 +18,12 @@ import (
 type InvertedIndexConfig struct {
 	BM25                   BM25Config
 	Stopwords              models.StopwordConfig
+	Synonyms               models.SynonymConfig
 	CleanupIntervalSeconds uint64
 	IndexTimestamps        bool
 	IndexNullState         bool
 	IndexPropertyLength    bool
+	IndexTermPositions     bool
 	UsingBlockMaxWAND      bool
 }
 
@@ -40,10 +42,14 @@ func InvertedIndexConfigFromModel(m models.InvertedIndexConfig) InvertedIndexCon
 	if m.Stopwords != nil {
 		i.Stopwords = *m.Stopwords
 	}
+	if m.Synonyms != nil {
+		i.Synonyms = *m.Synonyms
+	}
 	i.CleanupIntervalSeconds = uint64(m.CleanupIntervalSeconds)
 	i.IndexTimestamps = m.IndexTimestamps
 	i.IndexNullState = m.IndexNullState
 	i.IndexPropertyLength = m.IndexPropertyLength
+	i.IndexTermPositions = m.IndexTermPositions
 	i.UsingBlockMaxWAND = m.UsingBlockMaxWAND
 
 	return i
@@ -60,10 +66,16 @@ func InvertedIndexConfigToModel(i InvertedIndexConfig) models.InvertedIndexConfi
 	// Force a copy to avoid references
 	*m.Stopwords = i.Stopwords
 
+	if len(i.Synonyms.Sets) > 0 {
+		m.Synonyms = &models.SynonymConfig{}
+		*m.Synonyms = i.Synonyms
+	}
+
 	m.CleanupIntervalSeconds = int64(i.CleanupIntervalSeconds)
 	m.IndexTimestamps = i.IndexTimestamps
 	m.IndexNullState = i.IndexNullState
 	m.IndexPropertyLength = i.IndexPropertyLength
+	m.IndexTermPositions = i.IndexTermPositions
 	m.UsingBlockMaxWAND = i.UsingBlockMaxWAND
 
 	return m
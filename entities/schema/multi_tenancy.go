@@ -46,6 +46,20 @@ func AutoTenantActivationEnabled(class *models.Class) bool {
 	return false
 }
 
+// TenantQuota returns the limits of each tenant of the class, nil if there
+// are none
+func TenantQuota(class *models.Class) *models.TenantQuota {
+	if !MultiTenancyEnabled(class) {
+		return nil
+	}
+
+	quota := class.MultiTenancyConfig.TenantQuota
+	if quota == nil || (quota.MaxObjectCount <= 0 && quota.MaxDiskBytes <= 0) {
+		return nil
+	}
+	return quota
+}
+
 func ActivityStatus(status string) string {
 	if status == "" {
 		return models.TenantActivityStatusHOT
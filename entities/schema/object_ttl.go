@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// ObjectTTLDeleteOnCreationTime and ObjectTTLDeleteOnUpdateTime are the
+// internal timestamps an object's time-to-live can be measured from. They
+// mirror filters.InternalPropCreationTimeUnix and
+// filters.InternalPropLastUpdateTimeUnix.
+const (
+	ObjectTTLDeleteOnCreationTime = "_creationTimeUnix"
+	ObjectTTLDeleteOnUpdateTime   = "_lastUpdateTimeUnix"
+)
+
+func ObjectTTLEnabled(class *models.Class) bool {
+	if class == nil {
+		return false
+	}
+
+	if class.ObjectTTLConfig != nil {
+		return class.ObjectTTLConfig.Enabled && class.ObjectTTLConfig.DefaultTTL > 0
+	}
+	return false
+}
+
+// ObjectTTL returns the configured time-to-live of the objects of the class.
+// It is zero if expiry is not enabled.
+func ObjectTTL(class *models.Class) time.Duration {
+	if !ObjectTTLEnabled(class) {
+		return 0
+	}
+	return time.Duration(class.ObjectTTLConfig.DefaultTTL) * time.Second
+}
+
+// ObjectTTLDeleteOn returns the name of the timestamp the time-to-live is
+// measured from, falling back to the object's creation time.
+func ObjectTTLDeleteOn(class *models.Class) string {
+	if class == nil || class.ObjectTTLConfig == nil || class.ObjectTTLConfig.DeleteOn == "" {
+		return ObjectTTLDeleteOnCreationTime
+	}
+	return class.ObjectTTLConfig.DeleteOn
+}
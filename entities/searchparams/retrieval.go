@@ -13,6 +13,7 @@ package searchparams
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/weaviate/weaviate/entities/search"
@@ -34,6 +35,117 @@ type KeywordRanking struct {
 	Properties             []string `json:"properties"`
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
+	// Phrase only matches objects containing the query terms in order, Slop is
+	// the number of positions the terms may be moved apart or closer together
+	Phrase bool `json:"phrase"`
+	Slop   int  `json:"slop"`
+	// MaxEdits enables fuzzy matching, every query term also matches the terms
+	// within this edit distance, at most MaxExpansions of them
+	MaxEdits      int `json:"maxEdits"`
+	MaxExpansions int `json:"maxExpansions"`
+	// SearchOperator decides how many of the query tokens an object has to
+	// contain, all of them for SearchOperatorAnd and MinimumShouldMatch of
+	// them for SearchOperatorOr, see MinimumTokensMatch
+	SearchOperator     string `json:"searchOperator"`
+	MinimumShouldMatch string `json:"minimumShouldMatch"`
+}
+
+const (
+	SearchOperatorOr  = "Or"
+	SearchOperatorAnd = "And"
+)
+
+// ValidatePhrase checks the phrase and proximity settings of the ranking
+func (k *KeywordRanking) ValidatePhrase() error {
+	if k.Slop < 0 {
+		return fmt.Errorf("slop must not be negative, got %d", k.Slop)
+	}
+	if k.Slop > 0 && !k.Phrase {
+		return fmt.Errorf("slop can only be set for phrase queries")
+	}
+	return nil
+}
+
+// ValidateFuzzy checks the fuzzy matching settings of the ranking
+func (k *KeywordRanking) ValidateFuzzy() error {
+	if k.MaxEdits < 0 || k.MaxEdits > 2 {
+		return fmt.Errorf("maxEdits must be between 0 and 2, got %d", k.MaxEdits)
+	}
+	if k.MaxExpansions < 0 {
+		return fmt.Errorf("maxExpansions must not be negative, got %d", k.MaxExpansions)
+	}
+	if k.MaxExpansions > 0 && k.MaxEdits == 0 {
+		return fmt.Errorf("maxExpansions can only be set for fuzzy queries")
+	}
+	if k.MaxEdits > 0 && k.Phrase {
+		return fmt.Errorf("fuzzy matching cannot be combined with phrase queries")
+	}
+	return nil
+}
+
+// ValidateSearchOperator checks the search operator and minimum should
+// match settings of the ranking
+func (k *KeywordRanking) ValidateSearchOperator() error {
+	switch k.SearchOperator {
+	case "", SearchOperatorOr:
+	case SearchOperatorAnd:
+		if k.MinimumShouldMatch != "" {
+			return fmt.Errorf("minimumShouldMatch can only be set for the %s operator", SearchOperatorOr)
+		}
+	default:
+		return fmt.Errorf("search operator must be one of %s, %s, got %q",
+			SearchOperatorAnd, SearchOperatorOr, k.SearchOperator)
+	}
+	if _, _, err := parseMinimumShouldMatch(k.MinimumShouldMatch); err != nil {
+		return err
+	}
+	if k.Phrase && k.RequiresMultipleTokens() {
+		return fmt.Errorf("search operators cannot be combined with phrase queries")
+	}
+	return nil
+}
+
+// RequiresMultipleTokens is true if objects matching a single query token
+// might not match the ranking
+func (k *KeywordRanking) RequiresMultipleTokens() bool {
+	return k.SearchOperator == SearchOperatorAnd || k.MinimumShouldMatch != ""
+}
+
+// MinimumTokensMatch returns how many of the given number of query tokens an
+// object has to contain. MinimumShouldMatch is either a number of tokens or a
+// percentage of them, rounded down, negative values are the number or
+// percentage of tokens that may be missing. The result is at least one and at
+// most all tokens.
+func (k *KeywordRanking) MinimumTokensMatch(tokens int) int {
+	if k.SearchOperator == SearchOperatorAnd {
+		return tokens
+	}
+	value, percentage, err := parseMinimumShouldMatch(k.MinimumShouldMatch)
+	if err != nil || (value == 0 && !percentage) {
+		return min(1, tokens)
+	}
+	if value < 0 {
+		if percentage {
+			value = -(tokens * -value / 100)
+		}
+		value += tokens
+	} else if percentage {
+		value = tokens * value / 100
+	}
+	return max(min(value, tokens), min(1, tokens))
+}
+
+func parseMinimumShouldMatch(in string) (value int, percentage bool, err error) {
+	if in == "" {
+		return 0, false, nil
+	}
+	number, percentage := strings.CutSuffix(in, "%")
+	value, err = strconv.Atoi(strings.TrimSpace(number))
+	if err != nil || (percentage && (value < -100 || value > 100)) {
+		return 0, false, fmt.Errorf("minimumShouldMatch must be a number of tokens or a percentage "+
+			"between -100%% and 100%%, got %q", in)
+	}
+	return value, percentage, nil
 }
 
 // Indicates whether property should be indexed
@@ -110,6 +222,16 @@ type HybridSearch struct {
 	WithDistance     bool          `json:"withDistance"`
 	NearTextParams   *NearTextParams
 	NearVectorParams *NearVector
+	// Phrase, Slop, MaxEdits and MaxExpansions are passed on to the keyword
+	// part of the search
+	Phrase        bool `json:"phrase"`
+	Slop          int  `json:"slop"`
+	MaxEdits      int  `json:"maxEdits"`
+	MaxExpansions int  `json:"maxExpansions"`
+	// SearchOperator and MinimumShouldMatch are passed on to the keyword
+	// part of the search as well
+	SearchOperator     string `json:"searchOperator"`
+	MinimumShouldMatch string `json:"minimumShouldMatch"`
 }
 
 type NearObject struct {
@@ -0,0 +1,88 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package searchparams
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeywordRankingMinimumTokensMatch(t *testing.T) {
+	tests := []struct {
+		operator           string
+		minimumShouldMatch string
+		tokens             int
+		expected           int
+	}{
+		{operator: "", minimumShouldMatch: "", tokens: 4, expected: 1},
+		{operator: SearchOperatorOr, minimumShouldMatch: "", tokens: 4, expected: 1},
+		{operator: SearchOperatorAnd, minimumShouldMatch: "", tokens: 4, expected: 4},
+		{operator: SearchOperatorOr, minimumShouldMatch: "2", tokens: 4, expected: 2},
+		{operator: SearchOperatorOr, minimumShouldMatch: "6", tokens: 4, expected: 4},
+		{operator: SearchOperatorOr, minimumShouldMatch: "-1", tokens: 4, expected: 3},
+		{operator: SearchOperatorOr, minimumShouldMatch: "-5", tokens: 4, expected: 1},
+		{operator: SearchOperatorOr, minimumShouldMatch: "75%", tokens: 3, expected: 2},
+		{operator: SearchOperatorOr, minimumShouldMatch: "-25%", tokens: 4, expected: 3},
+		{operator: SearchOperatorOr, minimumShouldMatch: "-33%", tokens: 3, expected: 3},
+		{operator: SearchOperatorOr, minimumShouldMatch: "10%", tokens: 4, expected: 1},
+		{operator: SearchOperatorOr, minimumShouldMatch: "100%", tokens: 0, expected: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.operator+" "+test.minimumShouldMatch, func(t *testing.T) {
+			k := KeywordRanking{SearchOperator: test.operator, MinimumShouldMatch: test.minimumShouldMatch}
+			assert.Nil(t, k.ValidateSearchOperator())
+			assert.Equal(t, test.expected, k.MinimumTokensMatch(test.tokens))
+		})
+	}
+}
+
+func TestKeywordRankingValidateSearchOperator(t *testing.T) {
+	tests := []struct {
+		name     string
+		ranking  KeywordRanking
+		errorMsg string
+	}{
+		{
+			name:     "unknown operator",
+			ranking:  KeywordRanking{SearchOperator: "Xor"},
+			errorMsg: `search operator must be one of And, Or, got "Xor"`,
+		},
+		{
+			name:     "minimum with and",
+			ranking:  KeywordRanking{SearchOperator: SearchOperatorAnd, MinimumShouldMatch: "2"},
+			errorMsg: "minimumShouldMatch can only be set for the Or operator",
+		},
+		{
+			name:     "invalid minimum",
+			ranking:  KeywordRanking{MinimumShouldMatch: "two"},
+			errorMsg: "minimumShouldMatch must be a number of tokens or a percentage",
+		},
+		{
+			name:     "percentage out of range",
+			ranking:  KeywordRanking{MinimumShouldMatch: "150%"},
+			errorMsg: "minimumShouldMatch must be a number of tokens or a percentage",
+		},
+		{
+			name:     "phrase",
+			ranking:  KeywordRanking{SearchOperator: SearchOperatorAnd, Phrase: true},
+			errorMsg: "search operators cannot be combined with phrase queries",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.ErrorContains(t, test.ranking.ValidateSearchOperator(), test.errorMsg)
+		})
+	}
+}
@@ -100,3 +100,32 @@ func OptionalStringFromMap(in map[string]interface{}, name string,
 	setFn(asString)
 	return nil
 }
+
+func OptionalFloatFromMap(in map[string]interface{}, name string,
+	setFn func(v float64),
+) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	var asFloat64 float64
+	var err error
+
+	// depending on whether we get the results from disk or from the REST API,
+	// numbers may be represented slightly differently
+	switch typed := value.(type) {
+	case json.Number:
+		asFloat64, err = typed.Float64()
+	case float64:
+		asFloat64 = typed
+	default:
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "json.Number to float64 for %q", name)
+	}
+
+	setFn(asFloat64)
+	return nil
+}
@@ -15,9 +15,11 @@ import (
 	"fmt"
 
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/ivf"
 )
 
 const (
@@ -25,6 +27,8 @@ const (
 	VectorIndexTypeHNSW    = "hnsw"
 	VectorIndexTypeFLAT    = "flat"
 	VectorIndexTypeDYNAMIC = "dynamic"
+	VectorIndexTypeDISKANN = "diskann"
+	VectorIndexTypeIVF     = "ivf"
 )
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -41,7 +45,11 @@ func ParseAndValidateConfig(input interface{}, vectorIndexType string, isMultiVe
 		return flat.ParseAndValidateConfig(input)
 	case VectorIndexTypeDYNAMIC:
 		return dynamic.ParseAndValidateConfig(input, isMultiVector)
+	case VectorIndexTypeDISKANN:
+		return diskann.ParseAndValidateConfig(input)
+	case VectorIndexTypeIVF:
+		return ivf.ParseAndValidateConfig(input)
 	default:
-		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw and flat", vectorIndexType)
+		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw, flat, dynamic, diskann and ivf", vectorIndexType)
 	}
 }
@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"fmt"
+
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultMaxDegree             = 64
+	DefaultBuildListSize         = 128
+	DefaultSearchListSize        = 100
+	DefaultAlpha                 = 1.2
+	DefaultBeamWidth             = 4
+	DefaultFlatSearchCutoff      = 40000
+	DefaultVectorCacheMaxObjects = 1e12
+
+	// the degree is stored as uint16 in the graph records
+	maxDegree = 1 << 15
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings
+// of a disk-resident (Vamana) vector index. The graph and the full vectors are
+// kept on disk, only binary quantized vectors are held in memory to guide the
+// search.
+type UserConfig struct {
+	Distance string `json:"distance"`
+	// MaxDegree is the maximum number of neighbors per node (R)
+	MaxDegree int `json:"maxDegree"`
+	// BuildListSize is the size of the candidate list used when inserting (L)
+	BuildListSize int `json:"buildListSize"`
+	// SearchListSize is the size of the candidate list used when querying
+	SearchListSize int `json:"searchListSize"`
+	// Alpha controls how aggressively long edges are kept when pruning
+	Alpha float64 `json:"alpha"`
+	// BeamWidth is the number of nodes read from disk per search iteration
+	BeamWidth             int `json:"beamWidth"`
+	FlatSearchCutoff      int `json:"flatSearchCutoff"`
+	VectorCacheMaxObjects int `json:"vectorCacheMaxObjects"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "diskann"
+}
+
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+func (u UserConfig) IsMultiVector() bool {
+	return false
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = vectorindexcommon.DefaultDistanceMetric
+	u.MaxDegree = DefaultMaxDegree
+	u.BuildListSize = DefaultBuildListSize
+	u.SearchListSize = DefaultSearchListSize
+	u.Alpha = DefaultAlpha
+	u.BeamWidth = DefaultBeamWidth
+	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.VectorCacheMaxObjects = DefaultVectorCacheMaxObjects
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := vectorindexcommon.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	ints := []struct {
+		name  string
+		field *int
+	}{
+		{"maxDegree", &uc.MaxDegree},
+		{"buildListSize", &uc.BuildListSize},
+		{"searchListSize", &uc.SearchListSize},
+		{"beamWidth", &uc.BeamWidth},
+		{"flatSearchCutoff", &uc.FlatSearchCutoff},
+		{"vectorCacheMaxObjects", &uc.VectorCacheMaxObjects},
+	}
+	for _, i := range ints {
+		field := i.field
+		if err := vectorindexcommon.OptionalIntFromMap(asMap, i.name, func(v int) {
+			*field = v
+		}); err != nil {
+			return uc, err
+		}
+	}
+
+	if err := vectorindexcommon.OptionalFloatFromMap(asMap, "alpha", func(v float64) {
+		uc.Alpha = v
+	}); err != nil {
+		return uc, err
+	}
+
+	return uc, uc.validate()
+}
+
+func (u *UserConfig) validate() error {
+	if u.MaxDegree < 2 || u.MaxDegree > maxDegree {
+		return fmt.Errorf("invalid diskann config: maxDegree must be between 2 and %d", maxDegree)
+	}
+	if u.BuildListSize < u.MaxDegree {
+		return fmt.Errorf("invalid diskann config: buildListSize must be at least maxDegree (%d)", u.MaxDegree)
+	}
+	if u.SearchListSize < 1 {
+		return fmt.Errorf("invalid diskann config: searchListSize must be greater than 0")
+	}
+	if u.Alpha < 1 {
+		return fmt.Errorf("invalid diskann config: alpha must be at least 1")
+	}
+	if u.BeamWidth < 1 {
+		return fmt.Errorf("invalid diskann config: beamWidth must be greater than 0")
+	}
+	return nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
@@ -0,0 +1,102 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_DiskANNUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:     "nothing specified, all defaults",
+			input:    nil,
+			expected: NewDefaultUserConfig(),
+		},
+		{
+			name: "all fields specified",
+			input: map[string]interface{}{
+				"distance":              "l2-squared",
+				"maxDegree":             float64(32),
+				"buildListSize":         float64(64),
+				"searchListSize":        float64(50),
+				"alpha":                 float64(1.5),
+				"beamWidth":             float64(8),
+				"flatSearchCutoff":      float64(1000),
+				"vectorCacheMaxObjects": float64(100),
+			},
+			expected: UserConfig{
+				Distance:              common.DistanceL2Squared,
+				MaxDegree:             32,
+				BuildListSize:         64,
+				SearchListSize:        50,
+				Alpha:                 1.5,
+				BeamWidth:             8,
+				FlatSearchCutoff:      1000,
+				VectorCacheMaxObjects: 100,
+			},
+		},
+		{
+			name: "build list smaller than max degree",
+			input: map[string]interface{}{
+				"maxDegree":     float64(32),
+				"buildListSize": float64(16),
+			},
+			expectErrMsg: "invalid diskann config: buildListSize must be at least maxDegree (32)",
+		},
+		{
+			name: "max degree too large",
+			input: map[string]interface{}{
+				"maxDegree": float64(1 << 16),
+			},
+			expectErrMsg: "invalid diskann config: maxDegree must be between 2 and 32768",
+		},
+		{
+			name: "alpha below 1",
+			input: map[string]interface{}{
+				"alpha": float64(0.9),
+			},
+			expectErrMsg: "invalid diskann config: alpha must be at least 1",
+		},
+		{
+			name: "beam width of 0",
+			input: map[string]interface{}{
+				"beamWidth": float64(0),
+			},
+			expectErrMsg: "invalid diskann config: beamWidth must be greater than 0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErrMsg != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectErrMsg, err.Error())
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, cfg)
+		})
+	}
+}
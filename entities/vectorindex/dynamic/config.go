@@ -18,17 +18,25 @@ import (
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/ivf"
 )
 
 const (
 	DefaultThreshold = 10_000
+	DefaultUpgradeTo = UpgradeToHNSW
+
+	// index types the flat index can be upgraded to
+	UpgradeToHNSW = "hnsw"
+	UpgradeToIVF  = "ivf"
 )
 
 type UserConfig struct {
 	Distance  string          `json:"distance"`
 	Threshold uint64          `json:"threshold"`
+	UpgradeTo string          `json:"upgradeTo"`
 	HnswUC    hnsw.UserConfig `json:"hnsw"`
 	FlatUC    flat.UserConfig `json:"flat"`
+	IvfUC     ivf.UserConfig  `json:"ivf"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -49,8 +57,10 @@ func (u UserConfig) IsMultiVector() bool {
 func (u *UserConfig) SetDefaults() {
 	u.Threshold = DefaultThreshold
 	u.Distance = common.DefaultDistanceMetric
+	u.UpgradeTo = DefaultUpgradeTo
 	u.HnswUC = hnsw.NewDefaultUserConfig()
 	u.FlatUC = flat.NewDefaultUserConfig()
+	u.IvfUC = ivf.NewDefaultUserConfig()
 }
 
 func NewDefaultUserConfig() UserConfig {
@@ -86,6 +96,18 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig
 		return uc, err
 	}
 
+	if err := common.OptionalStringFromMap(asMap, "upgradeTo", func(v string) {
+		uc.UpgradeTo = v
+	}); err != nil {
+		return uc, err
+	}
+	switch uc.UpgradeTo {
+	case UpgradeToHNSW, UpgradeToIVF:
+	default:
+		return uc, fmt.Errorf("invalid upgradeTo %q, the dynamic index can be upgraded to %q or %q",
+			uc.UpgradeTo, UpgradeToHNSW, UpgradeToIVF)
+	}
+
 	hnswConfig, ok := asMap["hnsw"]
 	if ok && hnswConfig != nil {
 		hnswUC, err := hnsw.ParseAndValidateConfig(hnswConfig, isMultiVector)
@@ -104,6 +126,20 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig
 
 	}
 
+	ivfConfig, ok := asMap["ivf"]
+	if ok && ivfConfig != nil {
+		ivfUC, err := ivf.ParseAndValidateConfig(ivfConfig)
+		if err != nil {
+			return uc, err
+		}
+
+		castedIvfUC, ok := ivfUC.(ivf.UserConfig)
+		if !ok {
+			return uc, fmt.Errorf("invalid ivf configuration")
+		}
+		uc.IvfUC = castedIvfUC
+	}
+
 	flatConfig, ok := asMap["flat"]
 	if !ok || flatConfig == nil {
 		return uc, nil
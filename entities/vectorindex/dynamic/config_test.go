@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/ivf"
 )
 
 func Test_DynamicUserConfig(t *testing.T) {
@@ -37,6 +38,8 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: DefaultThreshold,
+				UpgradeTo: DefaultUpgradeTo,
+				IvfUC:     ivf.NewDefaultUserConfig(),
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -64,10 +67,21 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
+						Muvera: hnsw.MuveraConfig{
+							Enabled:      hnsw.DefaultMuveraEnabled,
+							KSim:         hnsw.DefaultMuveraKSim,
+							DProjections: hnsw.DefaultMuveraDProjections,
+							Repetitions:  hnsw.DefaultMuveraRepetitions,
+						},
 					},
 				},
 				FlatUC: flat.UserConfig{
@@ -88,6 +102,11 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.RQUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Bits:         flat.DefaultRQBits,
+					},
 				},
 			},
 		},
@@ -99,6 +118,8 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: 100,
+				UpgradeTo: DefaultUpgradeTo,
+				IvfUC:     ivf.NewDefaultUserConfig(),
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -126,10 +147,21 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
+						Muvera: hnsw.MuveraConfig{
+							Enabled:      hnsw.DefaultMuveraEnabled,
+							KSim:         hnsw.DefaultMuveraKSim,
+							DProjections: hnsw.DefaultMuveraDProjections,
+							Repetitions:  hnsw.DefaultMuveraRepetitions,
+						},
 					},
 				},
 				FlatUC: flat.UserConfig{
@@ -150,6 +182,11 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.RQUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Bits:         flat.DefaultRQBits,
+					},
 				},
 			},
 		},
@@ -182,6 +219,8 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: DefaultThreshold,
+				UpgradeTo: DefaultUpgradeTo,
+				IvfUC:     ivf.NewDefaultUserConfig(),
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: 11,
 					MaxConnections:         12,
@@ -208,10 +247,21 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.FilterStrategyAcorn,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
+						Muvera: hnsw.MuveraConfig{
+							Enabled:      hnsw.DefaultMuveraEnabled,
+							KSim:         hnsw.DefaultMuveraKSim,
+							DProjections: hnsw.DefaultMuveraDProjections,
+							Repetitions:  hnsw.DefaultMuveraRepetitions,
+						},
 					},
 				},
 				FlatUC: flat.UserConfig{
@@ -232,6 +282,11 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.RQUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Bits:         flat.DefaultRQBits,
+					},
 				},
 			},
 		},
@@ -263,6 +318,8 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: DefaultThreshold,
+				UpgradeTo: DefaultUpgradeTo,
+				IvfUC:     ivf.NewDefaultUserConfig(),
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -290,10 +347,21 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
+						Muvera: hnsw.MuveraConfig{
+							Enabled:      hnsw.DefaultMuveraEnabled,
+							KSim:         hnsw.DefaultMuveraKSim,
+							DProjections: hnsw.DefaultMuveraDProjections,
+							Repetitions:  hnsw.DefaultMuveraRepetitions,
+						},
 					},
 				},
 				FlatUC: flat.UserConfig{
@@ -314,6 +382,11 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.RQUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Bits:         flat.DefaultRQBits,
+					},
 				},
 			},
 		},
@@ -333,6 +406,44 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "PQ is not currently supported for flat indices",
 		},
+		{
+			name: "upgrade to ivf",
+			input: map[string]interface{}{
+				"upgradeTo": "ivf",
+				"ivf": map[string]interface{}{
+					"nlists": float64(64),
+					"nprobe": float64(8),
+				},
+			},
+			expected: func() UserConfig {
+				uc := UserConfig{}
+				uc.SetDefaults()
+				uc.UpgradeTo = UpgradeToIVF
+				uc.IvfUC.NLists = 64
+				uc.IvfUC.NProbe = 8
+				return uc
+			}(),
+		},
+		{
+			name: "invalid ivf config",
+			input: map[string]interface{}{
+				"upgradeTo": "ivf",
+				"ivf": map[string]interface{}{
+					"nlists": float64(8),
+					"nprobe": float64(16),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid ivf config: nprobe must be between 1 and nlists (8)",
+		},
+		{
+			name: "unknown upgrade target",
+			input: map[string]interface{}{
+				"upgradeTo": "diskann",
+			},
+			expectErr:    true,
+			expectErrMsg: `invalid upgradeTo "diskann"`,
+		},
 	}
 
 	for _, test := range tests {
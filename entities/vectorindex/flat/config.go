@@ -24,6 +24,7 @@ const (
 	DefaultVectorCacheMaxObjects = 1e12
 	DefaultCompressionEnabled    = false
 	DefaultCompressionRescore    = -1 // indicates "let Weaviate pick"
+	DefaultRQBits                = 8
 )
 
 type CompressionUserConfig struct {
@@ -32,12 +33,22 @@ type CompressionUserConfig struct {
 	Cache        bool `json:"cache"`
 }
 
+// RQUserConfig configures rotational quantization. Unlike the other
+// compression methods there is no cache, as the codes are only ever read
+// sequentially while scanning the bucket.
+type RQUserConfig struct {
+	Enabled      bool `json:"enabled"`
+	RescoreLimit int  `json:"rescoreLimit"`
+	Bits         int  `json:"bits"`
+}
+
 type UserConfig struct {
 	Distance              string                `json:"distance"`
 	VectorCacheMaxObjects int                   `json:"vectorCacheMaxObjects"`
 	PQ                    CompressionUserConfig `json:"pq"`
 	BQ                    CompressionUserConfig `json:"bq"`
 	SQ                    CompressionUserConfig `json:"sq"`
+	RQ                    RQUserConfig          `json:"rq"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -66,6 +77,9 @@ func (u *UserConfig) SetDefaults() {
 	u.BQ.RescoreLimit = DefaultCompressionRescore
 	u.SQ.Enabled = DefaultCompressionEnabled
 	u.SQ.RescoreLimit = DefaultCompressionRescore
+	u.RQ.Enabled = DefaultCompressionEnabled
+	u.RQ.RescoreLimit = DefaultCompressionRescore
+	u.RQ.Bits = DefaultRQBits
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -126,12 +140,37 @@ func parseCompressionMap(in interface{}, cuc *CompressionUserConfig) error {
 	return nil
 }
 
+func parseRQMap(in interface{}, rq *RQUserConfig) error {
+	configMap, ok := in.(map[string]interface{})
+	if ok {
+		if err := vectorindexcommon.OptionalBoolFromMap(configMap, "enabled", func(v bool) {
+			rq.Enabled = v
+		}); err != nil {
+			return err
+		}
+
+		if err := vectorindexcommon.OptionalIntFromMap(configMap, "rescoreLimit", func(v int) {
+			rq.RescoreLimit = v
+		}); err != nil {
+			return err
+		}
+
+		if err := vectorindexcommon.OptionalIntFromMap(configMap, "bits", func(v int) {
+			rq.Bits = v
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 	pqConfigValue, pqOk := in["pq"]
 	bqConfigValue, bqOk := in["bq"]
 	sqConfigValue, sqOk := in["sq"]
+	rqConfigValue, rqOk := in["rq"]
 
-	if !pqOk && !bqOk && !sqOk {
+	if !pqOk && !bqOk && !sqOk && !rqOk {
 		return nil
 	}
 
@@ -156,8 +195,18 @@ func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 		}
 	}
 
+	if rqOk {
+		err := parseRQMap(rqConfigValue, &uc.RQ)
+		if err != nil {
+			return err
+		}
+	}
+
 	compressionConfigs := []CompressionUserConfig{uc.PQ, uc.BQ, uc.SQ}
 	totalEnabled := 0
+	if uc.RQ.Enabled {
+		totalEnabled++
+	}
 
 	for _, compressionConfig := range compressionConfigs {
 		if compressionConfig.Cache && !compressionConfig.Enabled {
@@ -179,6 +228,13 @@ func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 	if uc.SQ.Enabled {
 		return errors.New("SQ is not currently supported for flat indices")
 	}
+	if uc.RQ.Enabled {
+		switch uc.RQ.Bits {
+		case 1, 2, 4, 8:
+		default:
+			return fmt.Errorf("invalid rq bits %d, must be 1, 2, 4 or 8", uc.RQ.Bits)
+		}
+	}
 
 	return nil
 }
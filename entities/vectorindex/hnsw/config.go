@@ -58,6 +58,7 @@ type UserConfig struct {
 	PQ                     PQConfig          `json:"pq"`
 	BQ                     BQConfig          `json:"bq"`
 	SQ                     SQConfig          `json:"sq"`
+	RQ                     RQConfig          `json:"rq"`
 	FilterStrategy         string            `json:"filterStrategy"`
 	Multivector            MultivectorConfig `json:"multivector"`
 }
@@ -108,6 +109,11 @@ func (u *UserConfig) SetDefaults() {
 		TrainingLimit: DefaultSQTrainingLimit,
 		RescoreLimit:  DefaultSQRescoreLimit,
 	}
+	u.RQ = RQConfig{
+		Enabled:      DefaultRQEnabled,
+		Bits:         DefaultRQBits,
+		RescoreLimit: DefaultRQRescoreLimit,
+	}
 	if strategy := os.Getenv("HNSW_DEFAULT_FILTER_STRATEGY"); strategy == FilterStrategyAcorn {
 		u.FilterStrategy = FilterStrategyAcorn
 	} else {
@@ -116,6 +122,12 @@ func (u *UserConfig) SetDefaults() {
 	u.Multivector = MultivectorConfig{
 		Enabled:     DefaultMultivectorEnabled,
 		Aggregation: DefaultMultivectorAggregation,
+		Muvera: MuveraConfig{
+			Enabled:      DefaultMuveraEnabled,
+			KSim:         DefaultMuveraKSim,
+			DProjections: DefaultMuveraDProjections,
+			Repetitions:  DefaultMuveraRepetitions,
+		},
 	}
 }
 
@@ -212,6 +224,10 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (config.Vecto
 		return uc, err
 	}
 
+	if err := parseRQMap(asMap, &uc.RQ); err != nil {
+		return uc, err
+	}
+
 	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "filterStrategy", func(v string) {
 		uc.FilterStrategy = v
 	}); err != nil {
@@ -260,9 +276,22 @@ func (u *UserConfig) validate() error {
 	if u.SQ.Enabled {
 		enabled++
 	}
+	if u.RQ.Enabled {
+		enabled++
+	}
 	if enabled > 1 {
 		return fmt.Errorf("invalid hnsw config: more than a single compression methods enabled")
 	}
+	if err := ValidateRQConfig(u.RQ); err != nil {
+		return fmt.Errorf("invalid hnsw config: %w", err)
+	}
+
+	if u.Multivector.Muvera.Enabled && !u.Multivector.Enabled {
+		return fmt.Errorf("invalid hnsw config: muvera encoding requires multivector to be enabled")
+	}
+	if err := validateMuveraConfig(u.Multivector.Muvera); err != nil {
+		return fmt.Errorf("invalid hnsw config: %w", err)
+	}
 
 	return nil
 }
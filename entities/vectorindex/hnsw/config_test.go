@@ -63,10 +63,21 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      DefaultMuveraEnabled,
+						KSim:         DefaultMuveraKSim,
+						DProjections: DefaultMuveraDProjections,
+						Repetitions:  DefaultMuveraRepetitions,
+					},
 				},
 			},
 		},
@@ -103,10 +114,21 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      DefaultMuveraEnabled,
+						KSim:         DefaultMuveraKSim,
+						DProjections: DefaultMuveraDProjections,
+						Repetitions:  DefaultMuveraRepetitions,
+					},
 				},
 			},
 		},
@@ -154,10 +176,21 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      DefaultMuveraEnabled,
+						KSim:         DefaultMuveraKSim,
+						DProjections: DefaultMuveraDProjections,
+						Repetitions:  DefaultMuveraRepetitions,
+					},
 				},
 			},
 		},
@@ -205,10 +238,21 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      DefaultMuveraEnabled,
+						KSim:         DefaultMuveraKSim,
+						DProjections: DefaultMuveraDProjections,
+						Repetitions:  DefaultMuveraRepetitions,
+					},
 				},
 			},
 		},
@@ -257,10 +301,21 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      DefaultMuveraEnabled,
+						KSim:         DefaultMuveraKSim,
+						DProjections: DefaultMuveraDProjections,
+						Repetitions:  DefaultMuveraRepetitions,
+					},
 				},
 			},
 		},
@@ -306,10 +361,21 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      DefaultMuveraEnabled,
+						KSim:         DefaultMuveraKSim,
+						DProjections: DefaultMuveraDProjections,
+						Repetitions:  DefaultMuveraRepetitions,
+					},
 				},
 			},
 		},
@@ -364,10 +430,21 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      DefaultMuveraEnabled,
+						KSim:         DefaultMuveraKSim,
+						DProjections: DefaultMuveraDProjections,
+						Repetitions:  DefaultMuveraRepetitions,
+					},
 				},
 			},
 		},
@@ -421,10 +498,21 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      DefaultMuveraEnabled,
+						KSim:         DefaultMuveraKSim,
+						DProjections: DefaultMuveraDProjections,
+						Repetitions:  DefaultMuveraRepetitions,
+					},
 				},
 			},
 		},
@@ -498,10 +586,21 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      DefaultMuveraEnabled,
+						KSim:         DefaultMuveraKSim,
+						DProjections: DefaultMuveraDProjections,
+						Repetitions:  DefaultMuveraRepetitions,
+					},
 				},
 			},
 		},
@@ -586,10 +685,21 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      DefaultMuveraEnabled,
+						KSim:         DefaultMuveraKSim,
+						DProjections: DefaultMuveraDProjections,
+						Repetitions:  DefaultMuveraRepetitions,
+					},
 				},
 			},
 		},
@@ -635,13 +745,174 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
+				FilterStrategy: DefaultFilterStrategy,
+				Multivector: MultivectorConfig{
+					Enabled:     DefaultMultivectorEnabled,
+					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      DefaultMuveraEnabled,
+						KSim:         DefaultMuveraKSim,
+						DProjections: DefaultMuveraDProjections,
+						Repetitions:  DefaultMuveraRepetitions,
+					},
+				},
+			},
+		},
+		{
+			name: "with rq",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled":      true,
+					"bits":         float64(4),
+					"rescoreLimit": float64(50),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:       false,
+					Segments:      0,
+					Centroids:     DefaultPQCentroids,
+					TrainingLimit: DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				RQ: RQConfig{
+					Enabled:      true,
+					Bits:         4,
+					RescoreLimit: 50,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      DefaultMuveraEnabled,
+						KSim:         DefaultMuveraKSim,
+						DProjections: DefaultMuveraDProjections,
+						Repetitions:  DefaultMuveraRepetitions,
+					},
 				},
 			},
 		},
+		{
+			name: "with invalid rq bits",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled": true,
+					"bits":    float64(3),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: invalid rq bits 3, must be 1, 2, 4 or 8",
+		},
+		{
+			name: "with muvera",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled": true,
+					"muvera": map[string]interface{}{
+						"enabled":      true,
+						"ksim":         json.Number("3"),
+						"dprojections": json.Number("8"),
+						"repetitions":  json.Number("20"),
+					},
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
+				FilterStrategy: DefaultFilterStrategy,
+				Multivector: MultivectorConfig{
+					Enabled:     true,
+					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      true,
+						KSim:         3,
+						DProjections: 8,
+						Repetitions:  20,
+					},
+				},
+			},
+		},
+		{
+			name: "with muvera but without multivector",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"muvera": map[string]interface{}{
+						"enabled": true,
+					},
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: muvera encoding requires multivector to be enabled",
+		},
+		{
+			name: "with muvera encodings that are too large",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled": true,
+					"muvera": map[string]interface{}{
+						"enabled":      true,
+						"ksim":         json.Number("10"),
+						"dprojections": json.Number("64"),
+					},
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "muvera encoding would have 655360 dimensions",
+		},
 		{
 			name: "with invalid compression",
 			input: map[string]interface{}{
@@ -699,10 +970,21 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: FilterStrategyAcorn,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      DefaultMuveraEnabled,
+						KSim:         DefaultMuveraKSim,
+						DProjections: DefaultMuveraDProjections,
+						Repetitions:  DefaultMuveraRepetitions,
+					},
 				},
 			},
 		},
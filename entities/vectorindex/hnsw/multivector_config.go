@@ -24,12 +24,43 @@ const (
 const (
 	DefaultMultivectorEnabled     = false
 	DefaultMultivectorAggregation = "maxSim"
+
+	DefaultMuveraEnabled      = false
+	DefaultMuveraKSim         = 4
+	DefaultMuveraDProjections = 16
+	DefaultMuveraRepetitions  = 10
+
+	// the encoding has repetitions * 2^ksim * dprojections dimensions, the
+	// limits keep it within a size that can still be indexed
+	maxMuveraKSim         = 10
+	maxMuveraEncodingDims = 65536
 )
 
 // Multivector configuration
 type MultivectorConfig struct {
-	Enabled     bool   `json:"enabled"`
-	Aggregation string `json:"aggregation"`
+	Enabled     bool         `json:"enabled"`
+	Aggregation string       `json:"aggregation"`
+	Muvera      MuveraConfig `json:"muvera"`
+}
+
+// MuveraConfig configures the MUVERA fixed dimensional encoding. If enabled,
+// a single encoding per object is indexed instead of every vector of the
+// multi vector. Candidates found through the encodings are re-scored with the
+// exact late interaction score.
+type MuveraConfig struct {
+	Enabled bool `json:"enabled"`
+	// KSim is the number of SimHash planes, the vectors are partitioned into
+	// 2^KSim buckets
+	KSim int `json:"ksim"`
+	// DProjections is the number of dimensions every bucket is projected to
+	DProjections int `json:"dprojections"`
+	// Repetitions is the number of independent encodings concatenated
+	Repetitions int `json:"repetitions"`
+}
+
+// EncodingDimensions returns the number of dimensions of a single encoding.
+func (c MuveraConfig) EncodingDimensions() int {
+	return c.Repetitions * (1 << c.KSim) * c.DProjections
 }
 
 func validAggregation(v string) error {
@@ -51,6 +82,26 @@ func ValidateMultivectorConfig(cfg MultivectorConfig) error {
 		return err
 	}
 
+	return validateMuveraConfig(cfg.Muvera)
+}
+
+func validateMuveraConfig(cfg MuveraConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.KSim < 1 || cfg.KSim > maxMuveraKSim {
+		return fmt.Errorf("muvera ksim must be between 1 and %d, got %d", maxMuveraKSim, cfg.KSim)
+	}
+	if cfg.DProjections < 1 {
+		return fmt.Errorf("muvera dprojections must be greater than 0, got %d", cfg.DProjections)
+	}
+	if cfg.Repetitions < 1 {
+		return fmt.Errorf("muvera repetitions must be greater than 0, got %d", cfg.Repetitions)
+	}
+	if dims := cfg.EncodingDimensions(); dims > maxMuveraEncodingDims {
+		return fmt.Errorf("muvera encoding would have %d dimensions, the maximum is %d "+
+			"(repetitions * 2^ksim * dprojections)", dims, maxMuveraEncodingDims)
+	}
 	return nil
 }
 
@@ -82,5 +133,43 @@ func parseMultivectorMap(in map[string]interface{}, multivector *MultivectorConf
 		return err
 	}
 
+	return parseMuveraMap(multivectorConfigMap, &multivector.Muvera)
+}
+
+func parseMuveraMap(in map[string]interface{}, muvera *MuveraConfig) error {
+	muveraConfigValue, ok := in["muvera"]
+	if !ok {
+		return nil
+	}
+
+	muveraConfigMap, ok := muveraConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalBoolFromMap(muveraConfigMap, "enabled", func(v bool) {
+		muvera.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(muveraConfigMap, "ksim", func(v int) {
+		muvera.KSim = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(muveraConfigMap, "dprojections", func(v int) {
+		muvera.DProjections = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(muveraConfigMap, "repetitions", func(v int) {
+		muvera.Repetitions = v
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,209 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"errors"
+	"fmt"
+
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultNLists            = 256
+	DefaultNProbe            = 16
+	DefaultTrainingThreshold = 10_000
+	DefaultRescoreLimit      = 100
+	DefaultFlatSearchCutoff  = 40000
+	DefaultPQEnabled         = true
+	DefaultPQSegments        = 0
+	DefaultPQCentroids       = 256
+	DefaultBQEnabled         = false
+)
+
+// UserConfig bundles all values settable by a user in the per-class settings
+// of an inverted file (IVF) vector index. Vectors are clustered into nLists
+// lists using k-means once trainingThreshold vectors have been imported, a
+// query then only scans the compressed vectors of the nProbe closest lists
+// and rescores the best candidates with the uncompressed vectors.
+type UserConfig struct {
+	Distance          string   `json:"distance"`
+	NLists            int      `json:"nlists"`
+	NProbe            int      `json:"nprobe"`
+	TrainingThreshold int      `json:"trainingThreshold"`
+	RescoreLimit      int      `json:"rescoreLimit"`
+	FlatSearchCutoff  int      `json:"flatSearchCutoff"`
+	PQ                PQConfig `json:"pq"`
+	BQ                BQConfig `json:"bq"`
+}
+
+// PQConfig configures the product quantization of the vectors in the lists.
+// Segments of 0 picks a segment count based on the dimensions.
+type PQConfig struct {
+	Enabled   bool `json:"enabled"`
+	Segments  int  `json:"segments"`
+	Centroids int  `json:"centroids"`
+}
+
+// BQConfig configures the binary quantization of the vectors in the lists.
+type BQConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "ivf"
+}
+
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+func (u UserConfig) IsMultiVector() bool {
+	return false
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = vectorindexcommon.DefaultDistanceMetric
+	u.NLists = DefaultNLists
+	u.NProbe = DefaultNProbe
+	u.TrainingThreshold = DefaultTrainingThreshold
+	u.RescoreLimit = DefaultRescoreLimit
+	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.PQ = PQConfig{
+		Enabled:   DefaultPQEnabled,
+		Segments:  DefaultPQSegments,
+		Centroids: DefaultPQCentroids,
+	}
+	u.BQ = BQConfig{Enabled: DefaultBQEnabled}
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := vectorindexcommon.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	ints := []struct {
+		name  string
+		field *int
+	}{
+		{"nlists", &uc.NLists},
+		{"nprobe", &uc.NProbe},
+		{"trainingThreshold", &uc.TrainingThreshold},
+		{"rescoreLimit", &uc.RescoreLimit},
+		{"flatSearchCutoff", &uc.FlatSearchCutoff},
+	}
+	for _, i := range ints {
+		field := i.field
+		if err := vectorindexcommon.OptionalIntFromMap(asMap, i.name, func(v int) {
+			*field = v
+		}); err != nil {
+			return uc, err
+		}
+	}
+
+	if err := parseCompression(asMap, &uc); err != nil {
+		return uc, err
+	}
+
+	return uc, uc.validate()
+}
+
+func parseCompression(in map[string]interface{}, uc *UserConfig) error {
+	if pq, ok := in["pq"].(map[string]interface{}); ok {
+		if err := vectorindexcommon.OptionalBoolFromMap(pq, "enabled", func(v bool) {
+			uc.PQ.Enabled = v
+		}); err != nil {
+			return err
+		}
+		if err := vectorindexcommon.OptionalIntFromMap(pq, "segments", func(v int) {
+			uc.PQ.Segments = v
+		}); err != nil {
+			return err
+		}
+		if err := vectorindexcommon.OptionalIntFromMap(pq, "centroids", func(v int) {
+			uc.PQ.Centroids = v
+		}); err != nil {
+			return err
+		}
+	}
+
+	if bq, ok := in["bq"].(map[string]interface{}); ok {
+		if err := vectorindexcommon.OptionalBoolFromMap(bq, "enabled", func(v bool) {
+			uc.BQ.Enabled = v
+		}); err != nil {
+			return err
+		}
+		// pq is enabled by default, enabling bq explicitly replaces it
+		if _, pqSet := in["pq"]; uc.BQ.Enabled && !pqSet {
+			uc.PQ.Enabled = false
+		}
+	}
+
+	return nil
+}
+
+func (u *UserConfig) validate() error {
+	if u.NLists < 1 {
+		return errors.New("invalid ivf config: nlists must be greater than 0")
+	}
+	if u.NProbe < 1 || u.NProbe > u.NLists {
+		return fmt.Errorf("invalid ivf config: nprobe must be between 1 and nlists (%d)", u.NLists)
+	}
+	if u.TrainingThreshold < u.NLists {
+		return fmt.Errorf("invalid ivf config: trainingThreshold must be at least nlists (%d)", u.NLists)
+	}
+	if u.RescoreLimit < 0 {
+		return errors.New("invalid ivf config: rescoreLimit cannot be negative")
+	}
+	if u.PQ.Enabled == u.BQ.Enabled {
+		return errors.New("invalid ivf config: exactly one of pq and bq must be enabled")
+	}
+	if u.PQ.Enabled {
+		if u.PQ.Segments < 0 {
+			return errors.New("invalid ivf config: pq segments cannot be negative")
+		}
+		if u.PQ.Centroids < 1 || u.PQ.Centroids > 256 {
+			return errors.New("invalid ivf config: pq centroids must be between 1 and 256")
+		}
+		if u.TrainingThreshold < u.PQ.Centroids {
+			return fmt.Errorf("invalid ivf config: trainingThreshold must be at least pq centroids (%d)",
+				u.PQ.Centroids)
+		}
+	}
+	return nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
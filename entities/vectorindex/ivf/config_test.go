@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_IVFUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:     "nothing specified, all defaults",
+			input:    nil,
+			expected: NewDefaultUserConfig(),
+		},
+		{
+			name: "all fields specified",
+			input: map[string]interface{}{
+				"distance":          "l2-squared",
+				"nlists":            float64(64),
+				"nprobe":            float64(8),
+				"trainingThreshold": float64(2000),
+				"rescoreLimit":      float64(50),
+				"flatSearchCutoff":  float64(1000),
+				"pq": map[string]interface{}{
+					"enabled":   true,
+					"segments":  float64(16),
+					"centroids": float64(128),
+				},
+			},
+			expected: UserConfig{
+				Distance:          common.DistanceL2Squared,
+				NLists:            64,
+				NProbe:            8,
+				TrainingThreshold: 2000,
+				RescoreLimit:      50,
+				FlatSearchCutoff:  1000,
+				PQ:                PQConfig{Enabled: true, Segments: 16, Centroids: 128},
+			},
+		},
+		{
+			name: "bq enabled replaces the default pq",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.PQ.Enabled = false
+				uc.BQ.Enabled = true
+				return uc
+			}(),
+		},
+		{
+			name: "pq and bq enabled",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{"enabled": true},
+				"bq": map[string]interface{}{"enabled": true},
+			},
+			expectErrMsg: "invalid ivf config: exactly one of pq and bq must be enabled",
+		},
+		{
+			name: "nprobe larger than nlists",
+			input: map[string]interface{}{
+				"nlists": float64(16),
+				"nprobe": float64(32),
+			},
+			expectErrMsg: "invalid ivf config: nprobe must be between 1 and nlists (16)",
+		},
+		{
+			name: "training threshold smaller than nlists",
+			input: map[string]interface{}{
+				"nlists":            float64(512),
+				"trainingThreshold": float64(100),
+			},
+			expectErrMsg: "invalid ivf config: trainingThreshold must be at least nlists (512)",
+		},
+		{
+			name: "too many pq centroids",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{"centroids": float64(512)},
+			},
+			expectErrMsg: "invalid ivf config: pq centroids must be between 1 and 256",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErrMsg != "" {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectErrMsg, err.Error())
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, test.expected, cfg)
+		})
+	}
+}
@@ -0,0 +1,590 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+
+package protocol
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BatchStreamReply_FlowControl_Reason int32
+
+const (
+	BatchStreamReply_FlowControl_REASON_UNSPECIFIED        BatchStreamReply_FlowControl_Reason = 0
+	BatchStreamReply_FlowControl_REASON_VECTOR_INDEX_QUEUE BatchStreamReply_FlowControl_Reason = 1
+	BatchStreamReply_FlowControl_REASON_MEMORY             BatchStreamReply_FlowControl_Reason = 2
+)
+
+// Enum value maps for BatchStreamReply_FlowControl_Reason.
+var (
+	BatchStreamReply_FlowControl_Reason_name = map[int32]string{
+		0: "REASON_UNSPECIFIED",
+		1: "REASON_VECTOR_INDEX_QUEUE",
+		2: "REASON_MEMORY",
+	}
+	BatchStreamReply_FlowControl_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED":        0,
+		"REASON_VECTOR_INDEX_QUEUE": 1,
+		"REASON_MEMORY":             2,
+	}
+)
+
+func (x BatchStreamReply_FlowControl_Reason) Enum() *BatchStreamReply_FlowControl_Reason {
+	p := new(BatchStreamReply_FlowControl_Reason)
+	*p = x
+	return p
+}
+
+func (x BatchStreamReply_FlowControl_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchStreamReply_FlowControl_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_batch_stream_proto_enumTypes[0].Descriptor()
+}
+
+func (BatchStreamReply_FlowControl_Reason) Type() protoreflect.EnumType {
+	return &file_v1_batch_stream_proto_enumTypes[0]
+}
+
+func (x BatchStreamReply_FlowControl_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatchStreamReply_FlowControl_Reason.Descriptor instead.
+func (BatchStreamReply_FlowControl_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_v1_batch_stream_proto_rawDescGZIP(), []int{1, 1, 0}
+}
+
+// Every request carries a chunk of objects of a continuous import. Objects
+// are numbered by their position in the stream across all requests, results
+// refer to objects by this index.
+type BatchStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Objects          []*BatchObject    `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	ConsistencyLevel *ConsistencyLevel `protobuf:"varint,2,opt,name=consistency_level,json=consistencyLevel,proto3,enum=weaviate.v1.ConsistencyLevel,oneof" json:"consistency_level,omitempty"`
+}
+
+func (x *BatchStreamRequest) Reset() {
+	*x = BatchStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_stream_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStreamRequest) ProtoMessage() {}
+
+func (x *BatchStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_stream_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStreamRequest.ProtoReflect.Descriptor instead.
+func (*BatchStreamRequest) Descriptor() ([]byte, []int) {
+	return file_v1_batch_stream_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchStreamRequest) GetObjects() []*BatchObject {
+	if x != nil {
+		return x.Objects
+	}
+	return nil
+}
+
+func (x *BatchStreamRequest) GetConsistencyLevel() ConsistencyLevel {
+	if x != nil && x.ConsistencyLevel != nil {
+		return *x.ConsistencyLevel
+	}
+	return ConsistencyLevel_CONSISTENCY_LEVEL_UNSPECIFIED
+}
+
+type BatchStreamReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//	*BatchStreamReply_Results_
+	//	*BatchStreamReply_FlowControl_
+	Message isBatchStreamReply_Message `protobuf_oneof:"message"`
+}
+
+func (x *BatchStreamReply) Reset() {
+	*x = BatchStreamReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_stream_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchStreamReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStreamReply) ProtoMessage() {}
+
+func (x *BatchStreamReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_stream_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStreamReply.ProtoReflect.Descriptor instead.
+func (*BatchStreamReply) Descriptor() ([]byte, []int) {
+	return file_v1_batch_stream_proto_rawDescGZIP(), []int{1}
+}
+
+func (m *BatchStreamReply) GetMessage() isBatchStreamReply_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *BatchStreamReply) GetResults() *BatchStreamReply_Results {
+	if x, ok := x.GetMessage().(*BatchStreamReply_Results_); ok {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchStreamReply) GetFlowControl() *BatchStreamReply_FlowControl {
+	if x, ok := x.GetMessage().(*BatchStreamReply_FlowControl_); ok {
+		return x.FlowControl
+	}
+	return nil
+}
+
+type isBatchStreamReply_Message interface {
+	isBatchStreamReply_Message()
+}
+
+type BatchStreamReply_Results_ struct {
+	Results *BatchStreamReply_Results `protobuf:"bytes,1,opt,name=results,proto3,oneof"`
+}
+
+type BatchStreamReply_FlowControl_ struct {
+	FlowControl *BatchStreamReply_FlowControl `protobuf:"bytes,2,opt,name=flow_control,json=flowControl,proto3,oneof"`
+}
+
+func (*BatchStreamReply_Results_) isBatchStreamReply_Message() {}
+
+func (*BatchStreamReply_FlowControl_) isBatchStreamReply_Message() {}
+
+type BatchStreamReply_Results struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Took   float32                            `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+	Values []*BatchStreamReply_Results_Result `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *BatchStreamReply_Results) Reset() {
+	*x = BatchStreamReply_Results{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_stream_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchStreamReply_Results) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStreamReply_Results) ProtoMessage() {}
+
+func (x *BatchStreamReply_Results) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_stream_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStreamReply_Results.ProtoReflect.Descriptor instead.
+func (*BatchStreamReply_Results) Descriptor() ([]byte, []int) {
+	return file_v1_batch_stream_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *BatchStreamReply_Results) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+func (x *BatchStreamReply_Results) GetValues() []*BatchStreamReply_Results_Result {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Flow control signals are sent at the beginning of the stream and every
+// time the load of the server changes. Clients should not send more than
+// batch_size objects per request and wait backoff_ms before sending the
+// next one.
+type BatchStreamReply_FlowControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BatchSize int32                               `protobuf:"varint,1,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	BackoffMs uint32                              `protobuf:"varint,2,opt,name=backoff_ms,json=backoffMs,proto3" json:"backoff_ms,omitempty"`
+	Reason    BatchStreamReply_FlowControl_Reason `protobuf:"varint,3,opt,name=reason,proto3,enum=weaviate.v1.BatchStreamReply_FlowControl_Reason" json:"reason,omitempty"`
+}
+
+func (x *BatchStreamReply_FlowControl) Reset() {
+	*x = BatchStreamReply_FlowControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_stream_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchStreamReply_FlowControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStreamReply_FlowControl) ProtoMessage() {}
+
+func (x *BatchStreamReply_FlowControl) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_stream_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStreamReply_FlowControl.ProtoReflect.Descriptor instead.
+func (*BatchStreamReply_FlowControl) Descriptor() ([]byte, []int) {
+	return file_v1_batch_stream_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *BatchStreamReply_FlowControl) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *BatchStreamReply_FlowControl) GetBackoffMs() uint32 {
+	if x != nil {
+		return x.BackoffMs
+	}
+	return 0
+}
+
+func (x *BatchStreamReply_FlowControl) GetReason() BatchStreamReply_FlowControl_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return BatchStreamReply_FlowControl_REASON_UNSPECIFIED
+}
+
+type BatchStreamReply_Results_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Uuid  string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// empty if the object was imported successfully
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchStreamReply_Results_Result) Reset() {
+	*x = BatchStreamReply_Results_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_batch_stream_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchStreamReply_Results_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchStreamReply_Results_Result) ProtoMessage() {}
+
+func (x *BatchStreamReply_Results_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_batch_stream_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchStreamReply_Results_Result.ProtoReflect.Descriptor instead.
+func (*BatchStreamReply_Results_Result) Descriptor() ([]byte, []int) {
+	return file_v1_batch_stream_proto_rawDescGZIP(), []int{1, 0, 0}
+}
+
+func (x *BatchStreamReply_Results_Result) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchStreamReply_Results_Result) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *BatchStreamReply_Results_Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_v1_batch_stream_proto protoreflect.FileDescriptor
+
+var file_v1_batch_stream_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x1a, 0x0d, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0e, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x01, 0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x07, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x4f,
+	0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x48, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x88, 0x01, 0x01, 0x42,
+	0x14, 0x0a, 0x12, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xcc, 0x04, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x41, 0x0a, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x4e, 0x0a,
+	0x0c, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x48, 0x00,
+	0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x1a, 0xad, 0x01,
+	0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x12, 0x44, 0x0a,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x1a, 0x48, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0xe9, 0x01,
+	0x0a, 0x0b, 0x46, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x1d, 0x0a,
+	0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x73, 0x12, 0x48, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x52, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x12, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x56, 0x45, 0x43, 0x54, 0x4f, 0x52, 0x5f, 0x49, 0x4e, 0x44, 0x45, 0x58, 0x5f, 0x51,
+	0x55, 0x45, 0x55, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x10, 0x02, 0x42, 0x09, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x42, 0x75, 0x0a, 0x23, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x18, 0x57, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1_batch_stream_proto_rawDescOnce sync.Once
+	file_v1_batch_stream_proto_rawDescData = file_v1_batch_stream_proto_rawDesc
+)
+
+func file_v1_batch_stream_proto_rawDescGZIP() []byte {
+	file_v1_batch_stream_proto_rawDescOnce.Do(func() {
+		file_v1_batch_stream_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_batch_stream_proto_rawDescData)
+	})
+	return file_v1_batch_stream_proto_rawDescData
+}
+
+var file_v1_batch_stream_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_batch_stream_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_batch_stream_proto_goTypes = []interface{}{
+	(BatchStreamReply_FlowControl_Reason)(0), // 0: weaviate.v1.BatchStreamReply.FlowControl.Reason
+	(*BatchStreamRequest)(nil),               // 1: weaviate.v1.BatchStreamRequest
+	(*BatchStreamReply)(nil),                 // 2: weaviate.v1.BatchStreamReply
+	(*BatchStreamReply_Results)(nil),         // 3: weaviate.v1.BatchStreamReply.Results
+	(*BatchStreamReply_FlowControl)(nil),     // 4: weaviate.v1.BatchStreamReply.FlowControl
+	(*BatchStreamReply_Results_Result)(nil),  // 5: weaviate.v1.BatchStreamReply.Results.Result
+	(*BatchObject)(nil),                      // 6: weaviate.v1.BatchObject
+	(ConsistencyLevel)(0),                    // 7: weaviate.v1.ConsistencyLevel
+}
+var file_v1_batch_stream_proto_depIdxs = []int32{
+	6, // 0: weaviate.v1.BatchStreamRequest.objects:type_name -> weaviate.v1.BatchObject
+	7, // 1: weaviate.v1.BatchStreamRequest.consistency_level:type_name -> weaviate.v1.ConsistencyLevel
+	3, // 2: weaviate.v1.BatchStreamReply.results:type_name -> weaviate.v1.BatchStreamReply.Results
+	4, // 3: weaviate.v1.BatchStreamReply.flow_control:type_name -> weaviate.v1.BatchStreamReply.FlowControl
+	5, // 4: weaviate.v1.BatchStreamReply.Results.values:type_name -> weaviate.v1.BatchStreamReply.Results.Result
+	0, // 5: weaviate.v1.BatchStreamReply.FlowControl.reason:type_name -> weaviate.v1.BatchStreamReply.FlowControl.Reason
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_v1_batch_stream_proto_init() }
+func file_v1_batch_stream_proto_init() {
+	if File_v1_batch_stream_proto != nil {
+		return
+	}
+	file_v1_base_proto_init()
+	file_v1_batch_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_v1_batch_stream_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_stream_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchStreamReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_stream_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchStreamReply_Results); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_stream_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchStreamReply_FlowControl); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_batch_stream_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchStreamReply_Results_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_v1_batch_stream_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_v1_batch_stream_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*BatchStreamReply_Results_)(nil),
+		(*BatchStreamReply_FlowControl_)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v1_batch_stream_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_batch_stream_proto_goTypes,
+		DependencyIndexes: file_v1_batch_stream_proto_depIdxs,
+		EnumInfos:         file_v1_batch_stream_proto_enumTypes,
+		MessageInfos:      file_v1_batch_stream_proto_msgTypes,
+	}.Build()
+	File_v1_batch_stream_proto = out.File
+	file_v1_batch_stream_proto_rawDesc = nil
+	file_v1_batch_stream_proto_goTypes = nil
+	file_v1_batch_stream_proto_depIdxs = nil
+}
@@ -0,0 +1,415 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+
+package protocol
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ChangeStreamReply_Operation int32
+
+const (
+	ChangeStreamReply_OPERATION_UNSPECIFIED ChangeStreamReply_Operation = 0
+	ChangeStreamReply_OPERATION_UPSERT      ChangeStreamReply_Operation = 1
+	ChangeStreamReply_OPERATION_DELETE      ChangeStreamReply_Operation = 2
+)
+
+// Enum value maps for ChangeStreamReply_Operation.
+var (
+	ChangeStreamReply_Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "OPERATION_UPSERT",
+		2: "OPERATION_DELETE",
+	}
+	ChangeStreamReply_Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"OPERATION_UPSERT":      1,
+		"OPERATION_DELETE":      2,
+	}
+)
+
+func (x ChangeStreamReply_Operation) Enum() *ChangeStreamReply_Operation {
+	p := new(ChangeStreamReply_Operation)
+	*p = x
+	return p
+}
+
+func (x ChangeStreamReply_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChangeStreamReply_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_change_stream_proto_enumTypes[0].Descriptor()
+}
+
+func (ChangeStreamReply_Operation) Type() protoreflect.EnumType {
+	return &file_v1_change_stream_proto_enumTypes[0]
+}
+
+func (x ChangeStreamReply_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChangeStreamReply_Operation.Descriptor instead.
+func (ChangeStreamReply_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_v1_change_stream_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// Streams the change log of a single shard replica. The change log is local
+// to the node serving the stream, checkpoints can only be resumed on the
+// node that issued them.
+type ChangeStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	// required for multi-tenant collections, the tenant is the shard to stream
+	Tenant *string `protobuf:"bytes,2,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
+	// required for collections with more than one shard
+	Shard *string `protobuf:"bytes,3,opt,name=shard,proto3,oneof" json:"shard,omitempty"`
+	// checkpoint of the last processed change, the stream starts with the
+	// oldest retained change if not set
+	Checkpoint *string `protobuf:"bytes,4,opt,name=checkpoint,proto3,oneof" json:"checkpoint,omitempty"`
+}
+
+func (x *ChangeStreamRequest) Reset() {
+	*x = ChangeStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_change_stream_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeStreamRequest) ProtoMessage() {}
+
+func (x *ChangeStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_change_stream_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeStreamRequest.ProtoReflect.Descriptor instead.
+func (*ChangeStreamRequest) Descriptor() ([]byte, []int) {
+	return file_v1_change_stream_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ChangeStreamRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *ChangeStreamRequest) GetTenant() string {
+	if x != nil && x.Tenant != nil {
+		return *x.Tenant
+	}
+	return ""
+}
+
+func (x *ChangeStreamRequest) GetShard() string {
+	if x != nil && x.Shard != nil {
+		return *x.Shard
+	}
+	return ""
+}
+
+func (x *ChangeStreamRequest) GetCheckpoint() string {
+	if x != nil && x.Checkpoint != nil {
+		return *x.Checkpoint
+	}
+	return ""
+}
+
+type ChangeStreamReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation  ChangeStreamReply_Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=weaviate.v1.ChangeStreamReply_Operation" json:"operation,omitempty"`
+	Collection string                      `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+	Shard      string                      `protobuf:"bytes,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	Uuid       string                      `protobuf:"bytes,4,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// last update time for upserts and deletion time for deletes
+	TimestampUnixMs int64 `protobuf:"varint,5,opt,name=timestamp_unix_ms,json=timestampUnixMs,proto3" json:"timestamp_unix_ms,omitempty"`
+	// the object as it was written, not set for deletes
+	Properties  *structpb.Struct `protobuf:"bytes,6,opt,name=properties,proto3" json:"properties,omitempty"`
+	VectorBytes []byte           `protobuf:"bytes,7,opt,name=vector_bytes,json=vectorBytes,proto3" json:"vector_bytes,omitempty"`
+	Vectors     []*Vectors       `protobuf:"bytes,8,rep,name=vectors,proto3" json:"vectors,omitempty"`
+	Sequence    uint64           `protobuf:"varint,9,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// opaque token to resume the stream after this change
+	Checkpoint string `protobuf:"bytes,10,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+}
+
+func (x *ChangeStreamReply) Reset() {
+	*x = ChangeStreamReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_change_stream_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeStreamReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeStreamReply) ProtoMessage() {}
+
+func (x *ChangeStreamReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_change_stream_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeStreamReply.ProtoReflect.Descriptor instead.
+func (*ChangeStreamReply) Descriptor() ([]byte, []int) {
+	return file_v1_change_stream_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChangeStreamReply) GetOperation() ChangeStreamReply_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return ChangeStreamReply_OPERATION_UNSPECIFIED
+}
+
+func (x *ChangeStreamReply) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *ChangeStreamReply) GetShard() string {
+	if x != nil {
+		return x.Shard
+	}
+	return ""
+}
+
+func (x *ChangeStreamReply) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ChangeStreamReply) GetTimestampUnixMs() int64 {
+	if x != nil {
+		return x.TimestampUnixMs
+	}
+	return 0
+}
+
+func (x *ChangeStreamReply) GetProperties() *structpb.Struct {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *ChangeStreamReply) GetVectorBytes() []byte {
+	if x != nil {
+		return x.VectorBytes
+	}
+	return nil
+}
+
+func (x *ChangeStreamReply) GetVectors() []*Vectors {
+	if x != nil {
+		return x.Vectors
+	}
+	return nil
+}
+
+func (x *ChangeStreamReply) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ChangeStreamReply) GetCheckpoint() string {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return ""
+}
+
+var File_v1_change_stream_proto protoreflect.FileDescriptor
+
+var file_v1_change_stream_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xed, 0x03, 0x0a, 0x11,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x46, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x55, 0x6e, 0x69, 0x78, 0x4d, 0x73, 0x12,
+	0x37, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x52, 0x07, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x50, 0x53,
+	0x45, 0x52, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x02, 0x42, 0x76, 0x0a, 0x23, 0x69,
+	0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x76, 0x31, 0x42, 0x19, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5a, 0x34, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1_change_stream_proto_rawDescOnce sync.Once
+	file_v1_change_stream_proto_rawDescData = file_v1_change_stream_proto_rawDesc
+)
+
+func file_v1_change_stream_proto_rawDescGZIP() []byte {
+	file_v1_change_stream_proto_rawDescOnce.Do(func() {
+		file_v1_change_stream_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_change_stream_proto_rawDescData)
+	})
+	return file_v1_change_stream_proto_rawDescData
+}
+
+var file_v1_change_stream_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_v1_change_stream_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_v1_change_stream_proto_goTypes = []interface{}{
+	(ChangeStreamReply_Operation)(0), // 0: weaviate.v1.ChangeStreamReply.Operation
+	(*ChangeStreamRequest)(nil),      // 1: weaviate.v1.ChangeStreamRequest
+	(*ChangeStreamReply)(nil),        // 2: weaviate.v1.ChangeStreamReply
+	(*structpb.Struct)(nil),          // 3: google.protobuf.Struct
+	(*Vectors)(nil),                  // 4: weaviate.v1.Vectors
+}
+var file_v1_change_stream_proto_depIdxs = []int32{
+	0, // 0: weaviate.v1.ChangeStreamReply.operation:type_name -> weaviate.v1.ChangeStreamReply.Operation
+	3, // 1: weaviate.v1.ChangeStreamReply.properties:type_name -> google.protobuf.Struct
+	4, // 2: weaviate.v1.ChangeStreamReply.vectors:type_name -> weaviate.v1.Vectors
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_v1_change_stream_proto_init() }
+func file_v1_change_stream_proto_init() {
+	if File_v1_change_stream_proto != nil {
+		return
+	}
+	file_v1_base_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_v1_change_stream_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeStreamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_change_stream_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeStreamReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_v1_change_stream_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v1_change_stream_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_change_stream_proto_goTypes,
+		DependencyIndexes: file_v1_change_stream_proto_depIdxs,
+		EnumInfos:         file_v1_change_stream_proto_enumTypes,
+		MessageInfos:      file_v1_change_stream_proto_msgTypes,
+	}.Build()
+	File_v1_change_stream_proto = out.File
+	file_v1_change_stream_proto_rawDesc = nil
+	file_v1_change_stream_proto_goTypes = nil
+	file_v1_change_stream_proto_depIdxs = nil
+}
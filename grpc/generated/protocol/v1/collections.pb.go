@@ -0,0 +1,2360 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+
+package protocol
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Collection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description         string                   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Properties          []*Property              `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty"`
+	InvertedIndexConfig *InvertedIndexConfig     `protobuf:"bytes,4,opt,name=inverted_index_config,json=invertedIndexConfig,proto3" json:"inverted_index_config,omitempty"`
+	MultiTenancyConfig  *MultiTenancyConfig      `protobuf:"bytes,5,opt,name=multi_tenancy_config,json=multiTenancyConfig,proto3" json:"multi_tenancy_config,omitempty"`
+	ReplicationConfig   *ReplicationConfig       `protobuf:"bytes,6,opt,name=replication_config,json=replicationConfig,proto3" json:"replication_config,omitempty"`
+	ObjectTtlConfig     *ObjectTTLConfig         `protobuf:"bytes,7,opt,name=object_ttl_config,json=objectTtlConfig,proto3" json:"object_ttl_config,omitempty"`
+	Vectorizer          string                   `protobuf:"bytes,8,opt,name=vectorizer,proto3" json:"vectorizer,omitempty"`
+	VectorIndexType     string                   `protobuf:"bytes,9,opt,name=vector_index_type,json=vectorIndexType,proto3" json:"vector_index_type,omitempty"`
+	VectorIndexConfig   *structpb.Struct         `protobuf:"bytes,10,opt,name=vector_index_config,json=vectorIndexConfig,proto3" json:"vector_index_config,omitempty"`
+	VectorConfig        map[string]*VectorConfig `protobuf:"bytes,11,rep,name=vector_config,json=vectorConfig,proto3" json:"vector_config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ModuleConfig        *structpb.Struct         `protobuf:"bytes,12,opt,name=module_config,json=moduleConfig,proto3" json:"module_config,omitempty"`
+	ShardingConfig      *ShardingConfig          `protobuf:"bytes,13,opt,name=sharding_config,json=shardingConfig,proto3" json:"sharding_config,omitempty"`
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Collection) GetProperties() []*Property {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *Collection) GetInvertedIndexConfig() *InvertedIndexConfig {
+	if x != nil {
+		return x.InvertedIndexConfig
+	}
+	return nil
+}
+
+func (x *Collection) GetMultiTenancyConfig() *MultiTenancyConfig {
+	if x != nil {
+		return x.MultiTenancyConfig
+	}
+	return nil
+}
+
+func (x *Collection) GetReplicationConfig() *ReplicationConfig {
+	if x != nil {
+		return x.ReplicationConfig
+	}
+	return nil
+}
+
+func (x *Collection) GetObjectTtlConfig() *ObjectTTLConfig {
+	if x != nil {
+		return x.ObjectTtlConfig
+	}
+	return nil
+}
+
+func (x *Collection) GetVectorizer() string {
+	if x != nil {
+		return x.Vectorizer
+	}
+	return ""
+}
+
+func (x *Collection) GetVectorIndexType() string {
+	if x != nil {
+		return x.VectorIndexType
+	}
+	return ""
+}
+
+func (x *Collection) GetVectorIndexConfig() *structpb.Struct {
+	if x != nil {
+		return x.VectorIndexConfig
+	}
+	return nil
+}
+
+func (x *Collection) GetVectorConfig() map[string]*VectorConfig {
+	if x != nil {
+		return x.VectorConfig
+	}
+	return nil
+}
+
+func (x *Collection) GetModuleConfig() *structpb.Struct {
+	if x != nil {
+		return x.ModuleConfig
+	}
+	return nil
+}
+
+func (x *Collection) GetShardingConfig() *ShardingConfig {
+	if x != nil {
+		return x.ShardingConfig
+	}
+	return nil
+}
+
+type Property struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataType          []string `protobuf:"bytes,2,rep,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Description       string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Tokenization      string   `protobuf:"bytes,4,opt,name=tokenization,proto3" json:"tokenization,omitempty"`
+	IndexFilterable   *bool    `protobuf:"varint,5,opt,name=index_filterable,json=indexFilterable,proto3,oneof" json:"index_filterable,omitempty"`
+	IndexSearchable   *bool    `protobuf:"varint,6,opt,name=index_searchable,json=indexSearchable,proto3,oneof" json:"index_searchable,omitempty"`
+	IndexRangeFilters *bool    `protobuf:"varint,7,opt,name=index_range_filters,json=indexRangeFilters,proto3,oneof" json:"index_range_filters,omitempty"`
+	// deprecated in favor of index_filterable and index_searchable
+	IndexInverted    *bool             `protobuf:"varint,8,opt,name=index_inverted,json=indexInverted,proto3,oneof" json:"index_inverted,omitempty"`
+	NestedProperties []*NestedProperty `protobuf:"bytes,9,rep,name=nested_properties,json=nestedProperties,proto3" json:"nested_properties,omitempty"`
+	ModuleConfig     *structpb.Struct  `protobuf:"bytes,10,opt,name=module_config,json=moduleConfig,proto3" json:"module_config,omitempty"`
+}
+
+func (x *Property) Reset() {
+	*x = Property{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Property) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Property) ProtoMessage() {}
+
+func (x *Property) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Property.ProtoReflect.Descriptor instead.
+func (*Property) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Property) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Property) GetDataType() []string {
+	if x != nil {
+		return x.DataType
+	}
+	return nil
+}
+
+func (x *Property) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Property) GetTokenization() string {
+	if x != nil {
+		return x.Tokenization
+	}
+	return ""
+}
+
+func (x *Property) GetIndexFilterable() bool {
+	if x != nil && x.IndexFilterable != nil {
+		return *x.IndexFilterable
+	}
+	return false
+}
+
+func (x *Property) GetIndexSearchable() bool {
+	if x != nil && x.IndexSearchable != nil {
+		return *x.IndexSearchable
+	}
+	return false
+}
+
+func (x *Property) GetIndexRangeFilters() bool {
+	if x != nil && x.IndexRangeFilters != nil {
+		return *x.IndexRangeFilters
+	}
+	return false
+}
+
+func (x *Property) GetIndexInverted() bool {
+	if x != nil && x.IndexInverted != nil {
+		return *x.IndexInverted
+	}
+	return false
+}
+
+func (x *Property) GetNestedProperties() []*NestedProperty {
+	if x != nil {
+		return x.NestedProperties
+	}
+	return nil
+}
+
+func (x *Property) GetModuleConfig() *structpb.Struct {
+	if x != nil {
+		return x.ModuleConfig
+	}
+	return nil
+}
+
+type NestedProperty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name              string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DataType          []string          `protobuf:"bytes,2,rep,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	Description       string            `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Tokenization      string            `protobuf:"bytes,4,opt,name=tokenization,proto3" json:"tokenization,omitempty"`
+	IndexFilterable   *bool             `protobuf:"varint,5,opt,name=index_filterable,json=indexFilterable,proto3,oneof" json:"index_filterable,omitempty"`
+	IndexSearchable   *bool             `protobuf:"varint,6,opt,name=index_searchable,json=indexSearchable,proto3,oneof" json:"index_searchable,omitempty"`
+	IndexRangeFilters *bool             `protobuf:"varint,7,opt,name=index_range_filters,json=indexRangeFilters,proto3,oneof" json:"index_range_filters,omitempty"`
+	NestedProperties  []*NestedProperty `protobuf:"bytes,8,rep,name=nested_properties,json=nestedProperties,proto3" json:"nested_properties,omitempty"`
+}
+
+func (x *NestedProperty) Reset() {
+	*x = NestedProperty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NestedProperty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NestedProperty) ProtoMessage() {}
+
+func (x *NestedProperty) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NestedProperty.ProtoReflect.Descriptor instead.
+func (*NestedProperty) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NestedProperty) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NestedProperty) GetDataType() []string {
+	if x != nil {
+		return x.DataType
+	}
+	return nil
+}
+
+func (x *NestedProperty) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *NestedProperty) GetTokenization() string {
+	if x != nil {
+		return x.Tokenization
+	}
+	return ""
+}
+
+func (x *NestedProperty) GetIndexFilterable() bool {
+	if x != nil && x.IndexFilterable != nil {
+		return *x.IndexFilterable
+	}
+	return false
+}
+
+func (x *NestedProperty) GetIndexSearchable() bool {
+	if x != nil && x.IndexSearchable != nil {
+		return *x.IndexSearchable
+	}
+	return false
+}
+
+func (x *NestedProperty) GetIndexRangeFilters() bool {
+	if x != nil && x.IndexRangeFilters != nil {
+		return *x.IndexRangeFilters
+	}
+	return false
+}
+
+func (x *NestedProperty) GetNestedProperties() []*NestedProperty {
+	if x != nil {
+		return x.NestedProperties
+	}
+	return nil
+}
+
+type InvertedIndexConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bm25                   *BM25Config     `protobuf:"bytes,1,opt,name=bm25,proto3" json:"bm25,omitempty"`
+	Stopwords              *StopwordConfig `protobuf:"bytes,2,opt,name=stopwords,proto3" json:"stopwords,omitempty"`
+	Synonyms               *SynonymConfig  `protobuf:"bytes,3,opt,name=synonyms,proto3" json:"synonyms,omitempty"`
+	CleanupIntervalSeconds int64           `protobuf:"varint,4,opt,name=cleanup_interval_seconds,json=cleanupIntervalSeconds,proto3" json:"cleanup_interval_seconds,omitempty"`
+	IndexTimestamps        bool            `protobuf:"varint,5,opt,name=index_timestamps,json=indexTimestamps,proto3" json:"index_timestamps,omitempty"`
+	IndexNullState         bool            `protobuf:"varint,6,opt,name=index_null_state,json=indexNullState,proto3" json:"index_null_state,omitempty"`
+	IndexPropertyLength    bool            `protobuf:"varint,7,opt,name=index_property_length,json=indexPropertyLength,proto3" json:"index_property_length,omitempty"`
+	IndexTermPositions     bool            `protobuf:"varint,8,opt,name=index_term_positions,json=indexTermPositions,proto3" json:"index_term_positions,omitempty"`
+	UsingBlockMaxWand      bool            `protobuf:"varint,9,opt,name=using_block_max_wand,json=usingBlockMaxWand,proto3" json:"using_block_max_wand,omitempty"`
+}
+
+func (x *InvertedIndexConfig) Reset() {
+	*x = InvertedIndexConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvertedIndexConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvertedIndexConfig) ProtoMessage() {}
+
+func (x *InvertedIndexConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvertedIndexConfig.ProtoReflect.Descriptor instead.
+func (*InvertedIndexConfig) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InvertedIndexConfig) GetBm25() *BM25Config {
+	if x != nil {
+		return x.Bm25
+	}
+	return nil
+}
+
+func (x *InvertedIndexConfig) GetStopwords() *StopwordConfig {
+	if x != nil {
+		return x.Stopwords
+	}
+	return nil
+}
+
+func (x *InvertedIndexConfig) GetSynonyms() *SynonymConfig {
+	if x != nil {
+		return x.Synonyms
+	}
+	return nil
+}
+
+func (x *InvertedIndexConfig) GetCleanupIntervalSeconds() int64 {
+	if x != nil {
+		return x.CleanupIntervalSeconds
+	}
+	return 0
+}
+
+func (x *InvertedIndexConfig) GetIndexTimestamps() bool {
+	if x != nil {
+		return x.IndexTimestamps
+	}
+	return false
+}
+
+func (x *InvertedIndexConfig) GetIndexNullState() bool {
+	if x != nil {
+		return x.IndexNullState
+	}
+	return false
+}
+
+func (x *InvertedIndexConfig) GetIndexPropertyLength() bool {
+	if x != nil {
+		return x.IndexPropertyLength
+	}
+	return false
+}
+
+func (x *InvertedIndexConfig) GetIndexTermPositions() bool {
+	if x != nil {
+		return x.IndexTermPositions
+	}
+	return false
+}
+
+func (x *InvertedIndexConfig) GetUsingBlockMaxWand() bool {
+	if x != nil {
+		return x.UsingBlockMaxWand
+	}
+	return false
+}
+
+type BM25Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	B  float32 `protobuf:"fixed32,1,opt,name=b,proto3" json:"b,omitempty"`
+	K1 float32 `protobuf:"fixed32,2,opt,name=k1,proto3" json:"k1,omitempty"`
+}
+
+func (x *BM25Config) Reset() {
+	*x = BM25Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BM25Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BM25Config) ProtoMessage() {}
+
+func (x *BM25Config) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BM25Config.ProtoReflect.Descriptor instead.
+func (*BM25Config) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BM25Config) GetB() float32 {
+	if x != nil {
+		return x.B
+	}
+	return 0
+}
+
+func (x *BM25Config) GetK1() float32 {
+	if x != nil {
+		return x.K1
+	}
+	return 0
+}
+
+type StopwordConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preset    string   `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
+	Additions []string `protobuf:"bytes,2,rep,name=additions,proto3" json:"additions,omitempty"`
+	Removals  []string `protobuf:"bytes,3,rep,name=removals,proto3" json:"removals,omitempty"`
+}
+
+func (x *StopwordConfig) Reset() {
+	*x = StopwordConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopwordConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopwordConfig) ProtoMessage() {}
+
+func (x *StopwordConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopwordConfig.ProtoReflect.Descriptor instead.
+func (*StopwordConfig) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StopwordConfig) GetPreset() string {
+	if x != nil {
+		return x.Preset
+	}
+	return ""
+}
+
+func (x *StopwordConfig) GetAdditions() []string {
+	if x != nil {
+		return x.Additions
+	}
+	return nil
+}
+
+func (x *StopwordConfig) GetRemovals() []string {
+	if x != nil {
+		return x.Removals
+	}
+	return nil
+}
+
+type SynonymConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sets []*SynonymConfig_Set `protobuf:"bytes,1,rep,name=sets,proto3" json:"sets,omitempty"`
+}
+
+func (x *SynonymConfig) Reset() {
+	*x = SynonymConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SynonymConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SynonymConfig) ProtoMessage() {}
+
+func (x *SynonymConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SynonymConfig.ProtoReflect.Descriptor instead.
+func (*SynonymConfig) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SynonymConfig) GetSets() []*SynonymConfig_Set {
+	if x != nil {
+		return x.Sets
+	}
+	return nil
+}
+
+type MultiTenancyConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled              bool         `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AutoTenantCreation   bool         `protobuf:"varint,2,opt,name=auto_tenant_creation,json=autoTenantCreation,proto3" json:"auto_tenant_creation,omitempty"`
+	AutoTenantActivation bool         `protobuf:"varint,3,opt,name=auto_tenant_activation,json=autoTenantActivation,proto3" json:"auto_tenant_activation,omitempty"`
+	TenantQuota          *TenantQuota `protobuf:"bytes,4,opt,name=tenant_quota,json=tenantQuota,proto3" json:"tenant_quota,omitempty"`
+}
+
+func (x *MultiTenancyConfig) Reset() {
+	*x = MultiTenancyConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiTenancyConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiTenancyConfig) ProtoMessage() {}
+
+func (x *MultiTenancyConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiTenancyConfig.ProtoReflect.Descriptor instead.
+func (*MultiTenancyConfig) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MultiTenancyConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *MultiTenancyConfig) GetAutoTenantCreation() bool {
+	if x != nil {
+		return x.AutoTenantCreation
+	}
+	return false
+}
+
+func (x *MultiTenancyConfig) GetAutoTenantActivation() bool {
+	if x != nil {
+		return x.AutoTenantActivation
+	}
+	return false
+}
+
+func (x *MultiTenancyConfig) GetTenantQuota() *TenantQuota {
+	if x != nil {
+		return x.TenantQuota
+	}
+	return nil
+}
+
+type TenantQuota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxObjectCount int64 `protobuf:"varint,1,opt,name=max_object_count,json=maxObjectCount,proto3" json:"max_object_count,omitempty"`
+	MaxDiskBytes   int64 `protobuf:"varint,2,opt,name=max_disk_bytes,json=maxDiskBytes,proto3" json:"max_disk_bytes,omitempty"`
+}
+
+func (x *TenantQuota) Reset() {
+	*x = TenantQuota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TenantQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TenantQuota) ProtoMessage() {}
+
+func (x *TenantQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TenantQuota.ProtoReflect.Descriptor instead.
+func (*TenantQuota) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TenantQuota) GetMaxObjectCount() int64 {
+	if x != nil {
+		return x.MaxObjectCount
+	}
+	return 0
+}
+
+func (x *TenantQuota) GetMaxDiskBytes() int64 {
+	if x != nil {
+		return x.MaxDiskBytes
+	}
+	return 0
+}
+
+type ReplicationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Factor           int64  `protobuf:"varint,1,opt,name=factor,proto3" json:"factor,omitempty"`
+	AsyncEnabled     bool   `protobuf:"varint,2,opt,name=async_enabled,json=asyncEnabled,proto3" json:"async_enabled,omitempty"`
+	DeletionStrategy string `protobuf:"bytes,3,opt,name=deletion_strategy,json=deletionStrategy,proto3" json:"deletion_strategy,omitempty"`
+}
+
+func (x *ReplicationConfig) Reset() {
+	*x = ReplicationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplicationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplicationConfig) ProtoMessage() {}
+
+func (x *ReplicationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplicationConfig.ProtoReflect.Descriptor instead.
+func (*ReplicationConfig) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReplicationConfig) GetFactor() int64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+func (x *ReplicationConfig) GetAsyncEnabled() bool {
+	if x != nil {
+		return x.AsyncEnabled
+	}
+	return false
+}
+
+func (x *ReplicationConfig) GetDeletionStrategy() string {
+	if x != nil {
+		return x.DeletionStrategy
+	}
+	return ""
+}
+
+type ObjectTTLConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled    bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	DefaultTtl int64  `protobuf:"varint,2,opt,name=default_ttl,json=defaultTtl,proto3" json:"default_ttl,omitempty"`
+	DeleteOn   string `protobuf:"bytes,3,opt,name=delete_on,json=deleteOn,proto3" json:"delete_on,omitempty"`
+}
+
+func (x *ObjectTTLConfig) Reset() {
+	*x = ObjectTTLConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectTTLConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectTTLConfig) ProtoMessage() {}
+
+func (x *ObjectTTLConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectTTLConfig.ProtoReflect.Descriptor instead.
+func (*ObjectTTLConfig) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ObjectTTLConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ObjectTTLConfig) GetDefaultTtl() int64 {
+	if x != nil {
+		return x.DefaultTtl
+	}
+	return 0
+}
+
+func (x *ObjectTTLConfig) GetDeleteOn() string {
+	if x != nil {
+		return x.DeleteOn
+	}
+	return ""
+}
+
+// unset fields of a collection to create use their defaults
+type ShardingConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VirtualPerPhysical  *int64  `protobuf:"varint,1,opt,name=virtual_per_physical,json=virtualPerPhysical,proto3,oneof" json:"virtual_per_physical,omitempty"`
+	DesiredCount        *int64  `protobuf:"varint,2,opt,name=desired_count,json=desiredCount,proto3,oneof" json:"desired_count,omitempty"`
+	ActualCount         *int64  `protobuf:"varint,3,opt,name=actual_count,json=actualCount,proto3,oneof" json:"actual_count,omitempty"`
+	DesiredVirtualCount *int64  `protobuf:"varint,4,opt,name=desired_virtual_count,json=desiredVirtualCount,proto3,oneof" json:"desired_virtual_count,omitempty"`
+	ActualVirtualCount  *int64  `protobuf:"varint,5,opt,name=actual_virtual_count,json=actualVirtualCount,proto3,oneof" json:"actual_virtual_count,omitempty"`
+	Key                 *string `protobuf:"bytes,6,opt,name=key,proto3,oneof" json:"key,omitempty"`
+	Strategy            *string `protobuf:"bytes,7,opt,name=strategy,proto3,oneof" json:"strategy,omitempty"`
+	Function            *string `protobuf:"bytes,8,opt,name=function,proto3,oneof" json:"function,omitempty"`
+}
+
+func (x *ShardingConfig) Reset() {
+	*x = ShardingConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShardingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShardingConfig) ProtoMessage() {}
+
+func (x *ShardingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShardingConfig.ProtoReflect.Descriptor instead.
+func (*ShardingConfig) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ShardingConfig) GetVirtualPerPhysical() int64 {
+	if x != nil && x.VirtualPerPhysical != nil {
+		return *x.VirtualPerPhysical
+	}
+	return 0
+}
+
+func (x *ShardingConfig) GetDesiredCount() int64 {
+	if x != nil && x.DesiredCount != nil {
+		return *x.DesiredCount
+	}
+	return 0
+}
+
+func (x *ShardingConfig) GetActualCount() int64 {
+	if x != nil && x.ActualCount != nil {
+		return *x.ActualCount
+	}
+	return 0
+}
+
+func (x *ShardingConfig) GetDesiredVirtualCount() int64 {
+	if x != nil && x.DesiredVirtualCount != nil {
+		return *x.DesiredVirtualCount
+	}
+	return 0
+}
+
+func (x *ShardingConfig) GetActualVirtualCount() int64 {
+	if x != nil && x.ActualVirtualCount != nil {
+		return *x.ActualVirtualCount
+	}
+	return 0
+}
+
+func (x *ShardingConfig) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+func (x *ShardingConfig) GetStrategy() string {
+	if x != nil && x.Strategy != nil {
+		return *x.Strategy
+	}
+	return ""
+}
+
+func (x *ShardingConfig) GetFunction() string {
+	if x != nil && x.Function != nil {
+		return *x.Function
+	}
+	return ""
+}
+
+type VectorConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vectorizer        *structpb.Struct `protobuf:"bytes,1,opt,name=vectorizer,proto3" json:"vectorizer,omitempty"`
+	VectorIndexType   string           `protobuf:"bytes,2,opt,name=vector_index_type,json=vectorIndexType,proto3" json:"vector_index_type,omitempty"`
+	VectorIndexConfig *structpb.Struct `protobuf:"bytes,3,opt,name=vector_index_config,json=vectorIndexConfig,proto3" json:"vector_index_config,omitempty"`
+}
+
+func (x *VectorConfig) Reset() {
+	*x = VectorConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VectorConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VectorConfig) ProtoMessage() {}
+
+func (x *VectorConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VectorConfig.ProtoReflect.Descriptor instead.
+func (*VectorConfig) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VectorConfig) GetVectorizer() *structpb.Struct {
+	if x != nil {
+		return x.Vectorizer
+	}
+	return nil
+}
+
+func (x *VectorConfig) GetVectorIndexType() string {
+	if x != nil {
+		return x.VectorIndexType
+	}
+	return ""
+}
+
+func (x *VectorConfig) GetVectorIndexConfig() *structpb.Struct {
+	if x != nil {
+		return x.VectorIndexConfig
+	}
+	return nil
+}
+
+type CollectionGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	// if true, the collection is read from the leader
+	Consistency *bool `protobuf:"varint,2,opt,name=consistency,proto3,oneof" json:"consistency,omitempty"`
+}
+
+func (x *CollectionGetRequest) Reset() {
+	*x = CollectionGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionGetRequest) ProtoMessage() {}
+
+func (x *CollectionGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionGetRequest.ProtoReflect.Descriptor instead.
+func (*CollectionGetRequest) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CollectionGetRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *CollectionGetRequest) GetConsistency() bool {
+	if x != nil && x.Consistency != nil {
+		return *x.Consistency
+	}
+	return false
+}
+
+type CollectionGetReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Took       float32     `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+	Collection *Collection `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *CollectionGetReply) Reset() {
+	*x = CollectionGetReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionGetReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionGetReply) ProtoMessage() {}
+
+func (x *CollectionGetReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionGetReply.ProtoReflect.Descriptor instead.
+func (*CollectionGetReply) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CollectionGetReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+func (x *CollectionGetReply) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type CollectionCreateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection *Collection `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *CollectionCreateRequest) Reset() {
+	*x = CollectionCreateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionCreateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionCreateRequest) ProtoMessage() {}
+
+func (x *CollectionCreateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionCreateRequest.ProtoReflect.Descriptor instead.
+func (*CollectionCreateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CollectionCreateRequest) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type CollectionCreateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Took float32 `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+	// the created collection including all defaults that were set
+	Collection *Collection `protobuf:"bytes,2,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *CollectionCreateReply) Reset() {
+	*x = CollectionCreateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionCreateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionCreateReply) ProtoMessage() {}
+
+func (x *CollectionCreateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionCreateReply.ProtoReflect.Descriptor instead.
+func (*CollectionCreateReply) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CollectionCreateReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+func (x *CollectionCreateReply) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type CollectionUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string      `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Config     *Collection `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *CollectionUpdateRequest) Reset() {
+	*x = CollectionUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionUpdateRequest) ProtoMessage() {}
+
+func (x *CollectionUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionUpdateRequest.ProtoReflect.Descriptor instead.
+func (*CollectionUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CollectionUpdateRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *CollectionUpdateRequest) GetConfig() *Collection {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type CollectionUpdateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Took float32 `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+}
+
+func (x *CollectionUpdateReply) Reset() {
+	*x = CollectionUpdateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionUpdateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionUpdateReply) ProtoMessage() {}
+
+func (x *CollectionUpdateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionUpdateReply.ProtoReflect.Descriptor instead.
+func (*CollectionUpdateReply) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CollectionUpdateReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+type CollectionDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+}
+
+func (x *CollectionDeleteRequest) Reset() {
+	*x = CollectionDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionDeleteRequest) ProtoMessage() {}
+
+func (x *CollectionDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionDeleteRequest.ProtoReflect.Descriptor instead.
+func (*CollectionDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CollectionDeleteRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+type CollectionDeleteReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Took float32 `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+}
+
+func (x *CollectionDeleteReply) Reset() {
+	*x = CollectionDeleteReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CollectionDeleteReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionDeleteReply) ProtoMessage() {}
+
+func (x *CollectionDeleteReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionDeleteReply.ProtoReflect.Descriptor instead.
+func (*CollectionDeleteReply) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CollectionDeleteReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+type PropertyAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string    `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Property   *Property `protobuf:"bytes,2,opt,name=property,proto3" json:"property,omitempty"`
+}
+
+func (x *PropertyAddRequest) Reset() {
+	*x = PropertyAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PropertyAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyAddRequest) ProtoMessage() {}
+
+func (x *PropertyAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyAddRequest.ProtoReflect.Descriptor instead.
+func (*PropertyAddRequest) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PropertyAddRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *PropertyAddRequest) GetProperty() *Property {
+	if x != nil {
+		return x.Property
+	}
+	return nil
+}
+
+type PropertyAddReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Took     float32   `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+	Property *Property `protobuf:"bytes,2,opt,name=property,proto3" json:"property,omitempty"`
+}
+
+func (x *PropertyAddReply) Reset() {
+	*x = PropertyAddReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PropertyAddReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PropertyAddReply) ProtoMessage() {}
+
+func (x *PropertyAddReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PropertyAddReply.ProtoReflect.Descriptor instead.
+func (*PropertyAddReply) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PropertyAddReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+func (x *PropertyAddReply) GetProperty() *Property {
+	if x != nil {
+		return x.Property
+	}
+	return nil
+}
+
+type SynonymConfig_Set struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Terms  []string `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	Weight float32  `protobuf:"fixed32,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *SynonymConfig_Set) Reset() {
+	*x = SynonymConfig_Set{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_collections_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SynonymConfig_Set) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SynonymConfig_Set) ProtoMessage() {}
+
+func (x *SynonymConfig_Set) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_collections_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SynonymConfig_Set.ProtoReflect.Descriptor instead.
+func (*SynonymConfig_Set) Descriptor() ([]byte, []int) {
+	return file_v1_collections_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *SynonymConfig_Set) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+func (x *SynonymConfig_Set) GetWeight() float32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+var File_v1_collections_proto protoreflect.FileDescriptor
+
+var file_v1_collections_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x80, 0x07, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x54, 0x0a,
+	0x15, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x72,
+	0x74, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x13,
+	0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x51, 0x0a, 0x14, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x74, 0x65, 0x6e,
+	0x61, 0x6e, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x12, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x4d, 0x0a, 0x12, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x11, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x48, 0x0a, 0x11, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x74, 0x74, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0f,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x74, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x12,
+	0x2a, 0x0a, 0x11, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x54, 0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x13, 0x76,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x52, 0x11, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x4e, 0x0a, 0x0d, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x44, 0x0a, 0x0f, 0x73, 0x68, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x5a, 0x0a, 0x11, 0x56, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x9f, 0x04, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x10, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x0f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x01, 0x52, 0x0f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x13, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x11, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a,
+	0x0e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x69, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x49, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x11, 0x6e, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x79, 0x52, 0x10, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x0c, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x69, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x22, 0xa8, 0x03, 0x0a, 0x0e, 0x4e, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2e, 0x0a, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x2e, 0x0a, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x33, 0x0a, 0x13, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52,
+	0x11, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x11, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x10, 0x6e,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x61, 0x62, 0x6c, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x73,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0xdb, 0x03, 0x0a, 0x13, 0x49, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x0a, 0x04, 0x62, 0x6d, 0x32,
+	0x35, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x4d, 0x32, 0x35, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x04, 0x62, 0x6d, 0x32, 0x35, 0x12, 0x39, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x77, 0x6f, 0x72, 0x64,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x08, 0x73, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x75, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x63, 0x6c, 0x65,
+	0x61, 0x6e, 0x75, 0x70, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4e,
+	0x75, 0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x14,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x54, 0x65, 0x72, 0x6d, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f,
+	0x0a, 0x14, 0x75, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x77, 0x61, 0x6e, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x75, 0x73,
+	0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x61, 0x78, 0x57, 0x61, 0x6e, 0x64, 0x22,
+	0x2a, 0x0a, 0x0a, 0x42, 0x4d, 0x32, 0x35, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x0c, 0x0a,
+	0x01, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x01, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x6b,
+	0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x02, 0x6b, 0x31, 0x22, 0x62, 0x0a, 0x0e, 0x53,
+	0x74, 0x6f, 0x70, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x22,
+	0x78, 0x0a, 0x0d, 0x53, 0x79, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x32, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e,
+	0x6f, 0x6e, 0x79, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x04,
+	0x73, 0x65, 0x74, 0x73, 0x1a, 0x33, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x65, 0x72, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x72, 0x6d,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xd3, 0x01, 0x0a, 0x12, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x75,
+	0x74, 0x6f, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x61, 0x75, 0x74, 0x6f, 0x54, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16,
+	0x61, 0x75, 0x74, 0x6f, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x75,
+	0x74, 0x6f, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x0c, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x52, 0x0b, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22,
+	0x5d, 0x0a, 0x0b, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x28,
+	0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
+	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x6d, 0x61, 0x78, 0x44, 0x69, 0x73, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x7d,
+	0x0a, 0x11, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x73, 0x79, 0x6e, 0x63, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x61, 0x73, 0x79, 0x6e, 0x63, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x2b, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x22, 0x69, 0x0a,
+	0x0f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x54, 0x4c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x74, 0x6c, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4f, 0x6e, 0x22, 0xf3, 0x03, 0x0a, 0x0e, 0x53, 0x68, 0x61,
+	0x72, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x14, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x68, 0x79, 0x73, 0x69,
+	0x63, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x12, 0x76, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x50, 0x65, 0x72, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x88,
+	0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0c, 0x64, 0x65, 0x73,
+	0x69, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c,
+	0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x02, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x5f,
+	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x13, 0x64, 0x65, 0x73, 0x69, 0x72, 0x65, 0x64, 0x56, 0x69,
+	0x72, 0x74, 0x75, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a,
+	0x14, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x12, 0x61,
+	0x63, 0x74, 0x75, 0x61, 0x6c, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52,
+	0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07,
+	0x52, 0x08, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x17, 0x0a,
+	0x15, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x68,
+	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x65, 0x73, 0x69, 0x72,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x63, 0x74,
+	0x75, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x64, 0x65,
+	0x73, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x76,
+	0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x06, 0x0a, 0x04,
+	0x5f, 0x6b, 0x65, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbc,
+	0x01, 0x0a, 0x0c, 0x56, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x37, 0x0a, 0x0a, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x76, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x47, 0x0a, 0x13, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x11, 0x76, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x6d, 0x0a,
+	0x14, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x22, 0x61, 0x0a, 0x12,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x52, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x64, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b,
+	0x12, 0x37, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x17, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x2b, 0x0a, 0x15, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f,
+	0x6f, 0x6b, 0x22, 0x39, 0x0a, 0x17, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2b, 0x0a,
+	0x15, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x22, 0x67, 0x0a, 0x12, 0x50, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x31, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65,
+	0x72, 0x74, 0x79, 0x22, 0x59, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x41,
+	0x64, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x12, 0x31, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x42, 0x75,
+	0x0a, 0x23, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x18, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5a,
+	0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1_collections_proto_rawDescOnce sync.Once
+	file_v1_collections_proto_rawDescData = file_v1_collections_proto_rawDesc
+)
+
+func file_v1_collections_proto_rawDescGZIP() []byte {
+	file_v1_collections_proto_rawDescOnce.Do(func() {
+		file_v1_collections_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_collections_proto_rawDescData)
+	})
+	return file_v1_collections_proto_rawDescData
+}
+
+var file_v1_collections_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_v1_collections_proto_goTypes = []interface{}{
+	(*Collection)(nil),              // 0: weaviate.v1.Collection
+	(*Property)(nil),                // 1: weaviate.v1.Property
+	(*NestedProperty)(nil),          // 2: weaviate.v1.NestedProperty
+	(*InvertedIndexConfig)(nil),     // 3: weaviate.v1.InvertedIndexConfig
+	(*BM25Config)(nil),              // 4: weaviate.v1.BM25Config
+	(*StopwordConfig)(nil),          // 5: weaviate.v1.StopwordConfig
+	(*SynonymConfig)(nil),           // 6: weaviate.v1.SynonymConfig
+	(*MultiTenancyConfig)(nil),      // 7: weaviate.v1.MultiTenancyConfig
+	(*TenantQuota)(nil),             // 8: weaviate.v1.TenantQuota
+	(*ReplicationConfig)(nil),       // 9: weaviate.v1.ReplicationConfig
+	(*ObjectTTLConfig)(nil),         // 10: weaviate.v1.ObjectTTLConfig
+	(*ShardingConfig)(nil),          // 11: weaviate.v1.ShardingConfig
+	(*VectorConfig)(nil),            // 12: weaviate.v1.VectorConfig
+	(*CollectionGetRequest)(nil),    // 13: weaviate.v1.CollectionGetRequest
+	(*CollectionGetReply)(nil),      // 14: weaviate.v1.CollectionGetReply
+	(*CollectionCreateRequest)(nil), // 15: weaviate.v1.CollectionCreateRequest
+	(*CollectionCreateReply)(nil),   // 16: weaviate.v1.CollectionCreateReply
+	(*CollectionUpdateRequest)(nil), // 17: weaviate.v1.CollectionUpdateRequest
+	(*CollectionUpdateReply)(nil),   // 18: weaviate.v1.CollectionUpdateReply
+	(*CollectionDeleteRequest)(nil), // 19: weaviate.v1.CollectionDeleteRequest
+	(*CollectionDeleteReply)(nil),   // 20: weaviate.v1.CollectionDeleteReply
+	(*PropertyAddRequest)(nil),      // 21: weaviate.v1.PropertyAddRequest
+	(*PropertyAddReply)(nil),        // 22: weaviate.v1.PropertyAddReply
+	nil,                             // 23: weaviate.v1.Collection.VectorConfigEntry
+	(*SynonymConfig_Set)(nil),       // 24: weaviate.v1.SynonymConfig.Set
+	(*structpb.Struct)(nil),         // 25: google.protobuf.Struct
+}
+var file_v1_collections_proto_depIdxs = []int32{
+	1,  // 0: weaviate.v1.Collection.properties:type_name -> weaviate.v1.Property
+	3,  // 1: weaviate.v1.Collection.inverted_index_config:type_name -> weaviate.v1.InvertedIndexConfig
+	7,  // 2: weaviate.v1.Collection.multi_tenancy_config:type_name -> weaviate.v1.MultiTenancyConfig
+	9,  // 3: weaviate.v1.Collection.replication_config:type_name -> weaviate.v1.ReplicationConfig
+	10, // 4: weaviate.v1.Collection.object_ttl_config:type_name -> weaviate.v1.ObjectTTLConfig
+	25, // 5: weaviate.v1.Collection.vector_index_config:type_name -> google.protobuf.Struct
+	23, // 6: weaviate.v1.Collection.vector_config:type_name -> weaviate.v1.Collection.VectorConfigEntry
+	25, // 7: weaviate.v1.Collection.module_config:type_name -> google.protobuf.Struct
+	11, // 8: weaviate.v1.Collection.sharding_config:type_name -> weaviate.v1.ShardingConfig
+	2,  // 9: weaviate.v1.Property.nested_properties:type_name -> weaviate.v1.NestedProperty
+	25, // 10: weaviate.v1.Property.module_config:type_name -> google.protobuf.Struct
+	2,  // 11: weaviate.v1.NestedProperty.nested_properties:type_name -> weaviate.v1.NestedProperty
+	4,  // 12: weaviate.v1.InvertedIndexConfig.bm25:type_name -> weaviate.v1.BM25Config
+	5,  // 13: weaviate.v1.InvertedIndexConfig.stopwords:type_name -> weaviate.v1.StopwordConfig
+	6,  // 14: weaviate.v1.InvertedIndexConfig.synonyms:type_name -> weaviate.v1.SynonymConfig
+	24, // 15: weaviate.v1.SynonymConfig.sets:type_name -> weaviate.v1.SynonymConfig.Set
+	8,  // 16: weaviate.v1.MultiTenancyConfig.tenant_quota:type_name -> weaviate.v1.TenantQuota
+	25, // 17: weaviate.v1.VectorConfig.vectorizer:type_name -> google.protobuf.Struct
+	25, // 18: weaviate.v1.VectorConfig.vector_index_config:type_name -> google.protobuf.Struct
+	0,  // 19: weaviate.v1.CollectionGetReply.collection:type_name -> weaviate.v1.Collection
+	0,  // 20: weaviate.v1.CollectionCreateRequest.collection:type_name -> weaviate.v1.Collection
+	0,  // 21: weaviate.v1.CollectionCreateReply.collection:type_name -> weaviate.v1.Collection
+	0,  // 22: weaviate.v1.CollectionUpdateRequest.config:type_name -> weaviate.v1.Collection
+	1,  // 23: weaviate.v1.PropertyAddRequest.property:type_name -> weaviate.v1.Property
+	1,  // 24: weaviate.v1.PropertyAddReply.property:type_name -> weaviate.v1.Property
+	12, // 25: weaviate.v1.Collection.VectorConfigEntry.value:type_name -> weaviate.v1.VectorConfig
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_v1_collections_proto_init() }
+func file_v1_collections_proto_init() {
+	if File_v1_collections_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_v1_collections_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Property); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NestedProperty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvertedIndexConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BM25Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopwordConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SynonymConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiTenancyConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TenantQuota); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplicationConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObjectTTLConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShardingConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VectorConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionGetReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionCreateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionCreateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionUpdateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CollectionDeleteReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PropertyAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PropertyAddReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_collections_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SynonymConfig_Set); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_v1_collections_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	file_v1_collections_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_v1_collections_proto_msgTypes[11].OneofWrappers = []interface{}{}
+	file_v1_collections_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v1_collections_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_collections_proto_goTypes,
+		DependencyIndexes: file_v1_collections_proto_depIdxs,
+		MessageInfos:      file_v1_collections_proto_msgTypes,
+	}.Build()
+	File_v1_collections_proto = out.File
+	file_v1_collections_proto_rawDesc = nil
+	file_v1_collections_proto_goTypes = nil
+	file_v1_collections_proto_depIdxs = nil
+}
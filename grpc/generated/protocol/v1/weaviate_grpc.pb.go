@@ -21,9 +21,27 @@ const _ = grpc.SupportPackageIsVersion7
 type WeaviateClient interface {
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
 	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
+	BatchStream(ctx context.Context, opts ...grpc.CallOption) (Weaviate_BatchStreamClient, error)
 	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
 	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error)
 	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error)
+	ChangeStream(ctx context.Context, in *ChangeStreamRequest, opts ...grpc.CallOption) (Weaviate_ChangeStreamClient, error)
+	CollectionGet(ctx context.Context, in *CollectionGetRequest, opts ...grpc.CallOption) (*CollectionGetReply, error)
+	CollectionCreate(ctx context.Context, in *CollectionCreateRequest, opts ...grpc.CallOption) (*CollectionCreateReply, error)
+	CollectionUpdate(ctx context.Context, in *CollectionUpdateRequest, opts ...grpc.CallOption) (*CollectionUpdateReply, error)
+	CollectionDelete(ctx context.Context, in *CollectionDeleteRequest, opts ...grpc.CallOption) (*CollectionDeleteReply, error)
+	PropertyAdd(ctx context.Context, in *PropertyAddRequest, opts ...grpc.CallOption) (*PropertyAddReply, error)
+	TenantsCreate(ctx context.Context, in *TenantsCreateRequest, opts ...grpc.CallOption) (*TenantsCreateReply, error)
+	TenantsUpdate(ctx context.Context, in *TenantsUpdateRequest, opts ...grpc.CallOption) (*TenantsUpdateReply, error)
+	TenantsDelete(ctx context.Context, in *TenantsDeleteRequest, opts ...grpc.CallOption) (*TenantsDeleteReply, error)
+	ObjectGet(ctx context.Context, in *ObjectGetRequest, opts ...grpc.CallOption) (*ObjectGetReply, error)
+	ObjectExists(ctx context.Context, in *ObjectExistsRequest, opts ...grpc.CallOption) (*ObjectExistsReply, error)
+	ObjectInsert(ctx context.Context, in *ObjectInsertRequest, opts ...grpc.CallOption) (*ObjectInsertReply, error)
+	ObjectReplace(ctx context.Context, in *ObjectReplaceRequest, opts ...grpc.CallOption) (*ObjectReplaceReply, error)
+	ObjectMerge(ctx context.Context, in *ObjectMergeRequest, opts ...grpc.CallOption) (*ObjectMergeReply, error)
+	ObjectDelete(ctx context.Context, in *ObjectDeleteRequest, opts ...grpc.CallOption) (*ObjectDeleteReply, error)
+	ReferenceAdd(ctx context.Context, in *ReferenceAddRequest, opts ...grpc.CallOption) (*ReferenceAddReply, error)
+	ReferenceDelete(ctx context.Context, in *ReferenceDeleteRequest, opts ...grpc.CallOption) (*ReferenceDeleteReply, error)
 }
 
 type weaviateClient struct {
@@ -52,6 +70,37 @@ func (c *weaviateClient) BatchObjects(ctx context.Context, in *BatchObjectsReque
 	return out, nil
 }
 
+func (c *weaviateClient) BatchStream(ctx context.Context, opts ...grpc.CallOption) (Weaviate_BatchStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[0], "/weaviate.v1.Weaviate/BatchStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateBatchStreamClient{stream}
+	return x, nil
+}
+
+type Weaviate_BatchStreamClient interface {
+	Send(*BatchStreamRequest) error
+	Recv() (*BatchStreamReply, error)
+	grpc.ClientStream
+}
+
+type weaviateBatchStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateBatchStreamClient) Send(m *BatchStreamRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *weaviateBatchStreamClient) Recv() (*BatchStreamReply, error) {
+	m := new(BatchStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *weaviateClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error) {
 	out := new(BatchDeleteReply)
 	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/BatchDelete", in, out, opts...)
@@ -79,15 +128,209 @@ func (c *weaviateClient) Aggregate(ctx context.Context, in *AggregateRequest, op
 	return out, nil
 }
 
+func (c *weaviateClient) ChangeStream(ctx context.Context, in *ChangeStreamRequest, opts ...grpc.CallOption) (Weaviate_ChangeStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Weaviate_ServiceDesc.Streams[1], "/weaviate.v1.Weaviate/ChangeStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &weaviateChangeStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Weaviate_ChangeStreamClient interface {
+	Recv() (*ChangeStreamReply, error)
+	grpc.ClientStream
+}
+
+type weaviateChangeStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *weaviateChangeStreamClient) Recv() (*ChangeStreamReply, error) {
+	m := new(ChangeStreamReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *weaviateClient) CollectionGet(ctx context.Context, in *CollectionGetRequest, opts ...grpc.CallOption) (*CollectionGetReply, error) {
+	out := new(CollectionGetReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/CollectionGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) CollectionCreate(ctx context.Context, in *CollectionCreateRequest, opts ...grpc.CallOption) (*CollectionCreateReply, error) {
+	out := new(CollectionCreateReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/CollectionCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) CollectionUpdate(ctx context.Context, in *CollectionUpdateRequest, opts ...grpc.CallOption) (*CollectionUpdateReply, error) {
+	out := new(CollectionUpdateReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/CollectionUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) CollectionDelete(ctx context.Context, in *CollectionDeleteRequest, opts ...grpc.CallOption) (*CollectionDeleteReply, error) {
+	out := new(CollectionDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/CollectionDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) PropertyAdd(ctx context.Context, in *PropertyAddRequest, opts ...grpc.CallOption) (*PropertyAddReply, error) {
+	out := new(PropertyAddReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/PropertyAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsCreate(ctx context.Context, in *TenantsCreateRequest, opts ...grpc.CallOption) (*TenantsCreateReply, error) {
+	out := new(TenantsCreateReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/TenantsCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsUpdate(ctx context.Context, in *TenantsUpdateRequest, opts ...grpc.CallOption) (*TenantsUpdateReply, error) {
+	out := new(TenantsUpdateReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/TenantsUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) TenantsDelete(ctx context.Context, in *TenantsDeleteRequest, opts ...grpc.CallOption) (*TenantsDeleteReply, error) {
+	out := new(TenantsDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/TenantsDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectGet(ctx context.Context, in *ObjectGetRequest, opts ...grpc.CallOption) (*ObjectGetReply, error) {
+	out := new(ObjectGetReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ObjectGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectExists(ctx context.Context, in *ObjectExistsRequest, opts ...grpc.CallOption) (*ObjectExistsReply, error) {
+	out := new(ObjectExistsReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ObjectExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectInsert(ctx context.Context, in *ObjectInsertRequest, opts ...grpc.CallOption) (*ObjectInsertReply, error) {
+	out := new(ObjectInsertReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ObjectInsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectReplace(ctx context.Context, in *ObjectReplaceRequest, opts ...grpc.CallOption) (*ObjectReplaceReply, error) {
+	out := new(ObjectReplaceReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ObjectReplace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectMerge(ctx context.Context, in *ObjectMergeRequest, opts ...grpc.CallOption) (*ObjectMergeReply, error) {
+	out := new(ObjectMergeReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ObjectMerge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ObjectDelete(ctx context.Context, in *ObjectDeleteRequest, opts ...grpc.CallOption) (*ObjectDeleteReply, error) {
+	out := new(ObjectDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ObjectDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ReferenceAdd(ctx context.Context, in *ReferenceAddRequest, opts ...grpc.CallOption) (*ReferenceAddReply, error) {
+	out := new(ReferenceAddReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ReferenceAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) ReferenceDelete(ctx context.Context, in *ReferenceDeleteRequest, opts ...grpc.CallOption) (*ReferenceDeleteReply, error) {
+	out := new(ReferenceDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/ReferenceDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
 type WeaviateServer interface {
 	Search(context.Context, *SearchRequest) (*SearchReply, error)
 	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
+	BatchStream(Weaviate_BatchStreamServer) error
 	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
 	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error)
 	Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error)
+	ChangeStream(*ChangeStreamRequest, Weaviate_ChangeStreamServer) error
+	CollectionGet(context.Context, *CollectionGetRequest) (*CollectionGetReply, error)
+	CollectionCreate(context.Context, *CollectionCreateRequest) (*CollectionCreateReply, error)
+	CollectionUpdate(context.Context, *CollectionUpdateRequest) (*CollectionUpdateReply, error)
+	CollectionDelete(context.Context, *CollectionDeleteRequest) (*CollectionDeleteReply, error)
+	PropertyAdd(context.Context, *PropertyAddRequest) (*PropertyAddReply, error)
+	TenantsCreate(context.Context, *TenantsCreateRequest) (*TenantsCreateReply, error)
+	TenantsUpdate(context.Context, *TenantsUpdateRequest) (*TenantsUpdateReply, error)
+	TenantsDelete(context.Context, *TenantsDeleteRequest) (*TenantsDeleteReply, error)
+	ObjectGet(context.Context, *ObjectGetRequest) (*ObjectGetReply, error)
+	ObjectExists(context.Context, *ObjectExistsRequest) (*ObjectExistsReply, error)
+	ObjectInsert(context.Context, *ObjectInsertRequest) (*ObjectInsertReply, error)
+	ObjectReplace(context.Context, *ObjectReplaceRequest) (*ObjectReplaceReply, error)
+	ObjectMerge(context.Context, *ObjectMergeRequest) (*ObjectMergeReply, error)
+	ObjectDelete(context.Context, *ObjectDeleteRequest) (*ObjectDeleteReply, error)
+	ReferenceAdd(context.Context, *ReferenceAddRequest) (*ReferenceAddReply, error)
+	ReferenceDelete(context.Context, *ReferenceDeleteRequest) (*ReferenceDeleteReply, error)
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -101,6 +344,9 @@ func (UnimplementedWeaviateServer) Search(context.Context, *SearchRequest) (*Sea
 func (UnimplementedWeaviateServer) BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchObjects not implemented")
 }
+func (UnimplementedWeaviateServer) BatchStream(Weaviate_BatchStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchStream not implemented")
+}
 func (UnimplementedWeaviateServer) BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchDelete not implemented")
 }
@@ -110,6 +356,57 @@ func (UnimplementedWeaviateServer) TenantsGet(context.Context, *TenantsGetReques
 func (UnimplementedWeaviateServer) Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
 }
+func (UnimplementedWeaviateServer) ChangeStream(*ChangeStreamRequest, Weaviate_ChangeStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ChangeStream not implemented")
+}
+func (UnimplementedWeaviateServer) CollectionGet(context.Context, *CollectionGetRequest) (*CollectionGetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionGet not implemented")
+}
+func (UnimplementedWeaviateServer) CollectionCreate(context.Context, *CollectionCreateRequest) (*CollectionCreateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionCreate not implemented")
+}
+func (UnimplementedWeaviateServer) CollectionUpdate(context.Context, *CollectionUpdateRequest) (*CollectionUpdateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionUpdate not implemented")
+}
+func (UnimplementedWeaviateServer) CollectionDelete(context.Context, *CollectionDeleteRequest) (*CollectionDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectionDelete not implemented")
+}
+func (UnimplementedWeaviateServer) PropertyAdd(context.Context, *PropertyAddRequest) (*PropertyAddReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PropertyAdd not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsCreate(context.Context, *TenantsCreateRequest) (*TenantsCreateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsCreate not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsUpdate(context.Context, *TenantsUpdateRequest) (*TenantsUpdateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsUpdate not implemented")
+}
+func (UnimplementedWeaviateServer) TenantsDelete(context.Context, *TenantsDeleteRequest) (*TenantsDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsDelete not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectGet(context.Context, *ObjectGetRequest) (*ObjectGetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectGet not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectExists(context.Context, *ObjectExistsRequest) (*ObjectExistsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectExists not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectInsert(context.Context, *ObjectInsertRequest) (*ObjectInsertReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectInsert not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectReplace(context.Context, *ObjectReplaceRequest) (*ObjectReplaceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectReplace not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectMerge(context.Context, *ObjectMergeRequest) (*ObjectMergeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectMerge not implemented")
+}
+func (UnimplementedWeaviateServer) ObjectDelete(context.Context, *ObjectDeleteRequest) (*ObjectDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ObjectDelete not implemented")
+}
+func (UnimplementedWeaviateServer) ReferenceAdd(context.Context, *ReferenceAddRequest) (*ReferenceAddReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferenceAdd not implemented")
+}
+func (UnimplementedWeaviateServer) ReferenceDelete(context.Context, *ReferenceDeleteRequest) (*ReferenceDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReferenceDelete not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -159,6 +456,32 @@ func _Weaviate_BatchObjects_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_BatchStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WeaviateServer).BatchStream(&weaviateBatchStreamServer{stream})
+}
+
+type Weaviate_BatchStreamServer interface {
+	Send(*BatchStreamReply) error
+	Recv() (*BatchStreamRequest, error)
+	grpc.ServerStream
+}
+
+type weaviateBatchStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateBatchStreamServer) Send(m *BatchStreamReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *weaviateBatchStreamServer) Recv() (*BatchStreamRequest, error) {
+	m := new(BatchStreamRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Weaviate_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BatchDeleteRequest)
 	if err := dec(in); err != nil {
@@ -213,6 +536,315 @@ func _Weaviate_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_ChangeStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChangeStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WeaviateServer).ChangeStream(m, &weaviateChangeStreamServer{stream})
+}
+
+type Weaviate_ChangeStreamServer interface {
+	Send(*ChangeStreamReply) error
+	grpc.ServerStream
+}
+
+type weaviateChangeStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *weaviateChangeStreamServer) Send(m *ChangeStreamReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Weaviate_CollectionGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CollectionGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/CollectionGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CollectionGet(ctx, req.(*CollectionGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_CollectionCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CollectionCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/CollectionCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CollectionCreate(ctx, req.(*CollectionCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_CollectionUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CollectionUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/CollectionUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CollectionUpdate(ctx, req.(*CollectionUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_CollectionDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).CollectionDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/CollectionDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).CollectionDelete(ctx, req.(*CollectionDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_PropertyAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PropertyAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).PropertyAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/PropertyAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).PropertyAdd(ctx, req.(*PropertyAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/TenantsCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsCreate(ctx, req.(*TenantsCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/TenantsUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsUpdate(ctx, req.(*TenantsUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_TenantsDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TenantsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).TenantsDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/TenantsDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).TenantsDelete(ctx, req.(*TenantsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ObjectGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectGet(ctx, req.(*ObjectGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectExistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ObjectExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectExists(ctx, req.(*ObjectExistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectInsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectInsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectInsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ObjectInsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectInsert(ctx, req.(*ObjectInsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectReplace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectReplaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectReplace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ObjectReplace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectReplace(ctx, req.(*ObjectReplaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ObjectMerge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectMerge(ctx, req.(*ObjectMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ObjectDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ObjectDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ObjectDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ObjectDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ObjectDelete(ctx, req.(*ObjectDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ReferenceAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ReferenceAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ReferenceAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ReferenceAdd(ctx, req.(*ReferenceAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_ReferenceDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).ReferenceDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/ReferenceDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).ReferenceDelete(ctx, req.(*ReferenceDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -240,7 +872,83 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Aggregate",
 			Handler:    _Weaviate_Aggregate_Handler,
 		},
+		{
+			MethodName: "CollectionGet",
+			Handler:    _Weaviate_CollectionGet_Handler,
+		},
+		{
+			MethodName: "CollectionCreate",
+			Handler:    _Weaviate_CollectionCreate_Handler,
+		},
+		{
+			MethodName: "CollectionUpdate",
+			Handler:    _Weaviate_CollectionUpdate_Handler,
+		},
+		{
+			MethodName: "CollectionDelete",
+			Handler:    _Weaviate_CollectionDelete_Handler,
+		},
+		{
+			MethodName: "PropertyAdd",
+			Handler:    _Weaviate_PropertyAdd_Handler,
+		},
+		{
+			MethodName: "TenantsCreate",
+			Handler:    _Weaviate_TenantsCreate_Handler,
+		},
+		{
+			MethodName: "TenantsUpdate",
+			Handler:    _Weaviate_TenantsUpdate_Handler,
+		},
+		{
+			MethodName: "TenantsDelete",
+			Handler:    _Weaviate_TenantsDelete_Handler,
+		},
+		{
+			MethodName: "ObjectGet",
+			Handler:    _Weaviate_ObjectGet_Handler,
+		},
+		{
+			MethodName: "ObjectExists",
+			Handler:    _Weaviate_ObjectExists_Handler,
+		},
+		{
+			MethodName: "ObjectInsert",
+			Handler:    _Weaviate_ObjectInsert_Handler,
+		},
+		{
+			MethodName: "ObjectReplace",
+			Handler:    _Weaviate_ObjectReplace_Handler,
+		},
+		{
+			MethodName: "ObjectMerge",
+			Handler:    _Weaviate_ObjectMerge_Handler,
+		},
+		{
+			MethodName: "ObjectDelete",
+			Handler:    _Weaviate_ObjectDelete_Handler,
+		},
+		{
+			MethodName: "ReferenceAdd",
+			Handler:    _Weaviate_ReferenceAdd_Handler,
+		},
+		{
+			MethodName: "ReferenceDelete",
+			Handler:    _Weaviate_ReferenceDelete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BatchStream",
+			Handler:       _Weaviate_BatchStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ChangeStream",
+			Handler:       _Weaviate_ChangeStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/weaviate.proto",
 }
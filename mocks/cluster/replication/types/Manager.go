@@ -87,6 +87,53 @@ func (_c *Manager_GetReplicationDetailsByReplicationId_Call) RunAndReturn(run fu
 	return _c
 }
 
+// ReplicationCancelSplitShard provides a mock function with given fields: collection, shard
+func (_m *Manager) ReplicationCancelSplitShard(collection string, shard string) error {
+	ret := _m.Called(collection, shard)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplicationCancelSplitShard")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(collection, shard)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Manager_ReplicationCancelSplitShard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplicationCancelSplitShard'
+type Manager_ReplicationCancelSplitShard_Call struct {
+	*mock.Call
+}
+
+// ReplicationCancelSplitShard is a helper method to define mock.On call
+//   - collection string
+//   - shard string
+func (_e *Manager_Expecter) ReplicationCancelSplitShard(collection interface{}, shard interface{}) *Manager_ReplicationCancelSplitShard_Call {
+	return &Manager_ReplicationCancelSplitShard_Call{Call: _e.mock.On("ReplicationCancelSplitShard", collection, shard)}
+}
+
+func (_c *Manager_ReplicationCancelSplitShard_Call) Run(run func(collection string, shard string)) *Manager_ReplicationCancelSplitShard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Manager_ReplicationCancelSplitShard_Call) Return(_a0 error) *Manager_ReplicationCancelSplitShard_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Manager_ReplicationCancelSplitShard_Call) RunAndReturn(run func(string, string) error) *Manager_ReplicationCancelSplitShard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplicationDeleteReplica provides a mock function with given fields: node, collection, shard
 func (_m *Manager) ReplicationDeleteReplica(node string, collection string, shard string) error {
 	ret := _m.Called(node, collection, shard)
@@ -232,6 +279,66 @@ func (_c *Manager_ReplicationReplicateReplica_Call) RunAndReturn(run func(string
 	return _c
 }
 
+// ReplicationSplitShard provides a mock function with given fields: collection, shard, parts
+func (_m *Manager) ReplicationSplitShard(collection string, shard string, parts int) ([]string, error) {
+	ret := _m.Called(collection, shard, parts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplicationSplitShard")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int) ([]string, error)); ok {
+		return rf(collection, shard, parts)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int) []string); ok {
+		r0 = rf(collection, shard, parts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int) error); ok {
+		r1 = rf(collection, shard, parts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Manager_ReplicationSplitShard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplicationSplitShard'
+type Manager_ReplicationSplitShard_Call struct {
+	*mock.Call
+}
+
+// ReplicationSplitShard is a helper method to define mock.On call
+//   - collection string
+//   - shard string
+//   - parts int
+func (_e *Manager_Expecter) ReplicationSplitShard(collection interface{}, shard interface{}, parts interface{}) *Manager_ReplicationSplitShard_Call {
+	return &Manager_ReplicationSplitShard_Call{Call: _e.mock.On("ReplicationSplitShard", collection, shard, parts)}
+}
+
+func (_c *Manager_ReplicationSplitShard_Call) Run(run func(collection string, shard string, parts int)) *Manager_ReplicationSplitShard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *Manager_ReplicationSplitShard_Call) Return(_a0 []string, _a1 error) *Manager_ReplicationSplitShard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Manager_ReplicationSplitShard_Call) RunAndReturn(run func(string, string, int) ([]string, error)) *Manager_ReplicationSplitShard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewManager creates a new instance of Manager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewManager(t interface {
@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modkmsfs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/encryption"
+)
+
+func (m *Module) TenantKeys(ctx context.Context, class, tenant string,
+) (encryption.KeyProvider, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	keys, err := m.tenantKeys(class, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (m *Module) CreateTenantKeys(ctx context.Context, class, tenant string,
+) (encryption.KeyProvider, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	if keys, err := m.tenantKeys(class, tenant); err == nil {
+		return keys, nil
+	} else if !errors.Is(err, encryption.ErrKeyNotFound) {
+		return nil, err
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("generate key id: %w", err)
+	}
+	key, err := encryption.GenerateKey(hex.EncodeToString(id))
+	if err != nil {
+		return nil, err
+	}
+
+	path := m.keyfilePath(class, tenant)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("make dir %s: %w", filepath.Dir(path), err)
+	}
+	if err := encryption.WriteKeyfile(path, key.ID, []encryption.Key{key}); err != nil {
+		return nil, fmt.Errorf("tenant %q of class %q: %w", tenant, class, err)
+	}
+	keys, err := m.tenantKeys(class, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (m *Module) DeleteTenantKeys(ctx context.Context, class string, tenants []string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	for _, tenant := range tenants {
+		path := m.keyfilePath(class, tenant)
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("delete key of tenant %q of class %q: %w", tenant, class, err)
+		}
+		delete(m.providers, path)
+	}
+	return nil
+}
+
+func (m *Module) DeleteClassKeys(ctx context.Context, class string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	dir := filepath.Join(m.keysPath, class)
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("delete keys of class %q: %w", class, err)
+	}
+	for path := range m.providers {
+		if strings.HasPrefix(path, dir+string(filepath.Separator)) {
+			delete(m.providers, path)
+		}
+	}
+	return nil
+}
+
+func (m *Module) tenantKeys(class, tenant string) (*encryption.KeyfileProvider, error) {
+	path := m.keyfilePath(class, tenant)
+	if p, ok := m.providers[path]; ok {
+		return p, nil
+	}
+
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: tenant %q of class %q", encryption.ErrKeyNotFound, tenant, class)
+	}
+	p, err := encryption.NewKeyfileProvider(path)
+	if err != nil {
+		return nil, fmt.Errorf("tenant %q of class %q: %w", tenant, class, err)
+	}
+	m.providers[path] = p
+	return p, nil
+}
+
+func (m *Module) keyfilePath(class, tenant string) string {
+	return filepath.Join(m.keysPath, class, tenant+".json")
+}
@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modkmsfs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/encryption"
+)
+
+func TestKeys(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("fails init with invalid keys path", func(t *testing.T) {
+		err := New().initKeysPath("")
+		assert.ErrorContains(t, err, "empty keys path provided")
+
+		err = New().initKeysPath(filepath.Join("keys", "dir"))
+		assert.ErrorContains(t, err, "relative keys path provided")
+	})
+
+	keysPath := t.TempDir()
+	module := New()
+	require.Nil(t, module.initKeysPath(keysPath))
+
+	var keyID string
+
+	t.Run("tenant without key", func(t *testing.T) {
+		_, err := module.TenantKeys(ctx, "Class", "tenant1")
+		assert.ErrorIs(t, err, encryption.ErrKeyNotFound)
+	})
+
+	t.Run("create tenant key", func(t *testing.T) {
+		keys, err := module.CreateTenantKeys(ctx, "Class", "tenant1")
+		require.Nil(t, err)
+		current, err := keys.CurrentKey()
+		require.Nil(t, err)
+		assert.Len(t, current.Material, encryption.KeySize)
+		keyID = current.ID
+
+		// creating it again keeps the existing key
+		keys, err = module.CreateTenantKeys(ctx, "Class", "tenant1")
+		require.Nil(t, err)
+		again, err := keys.CurrentKey()
+		require.Nil(t, err)
+		assert.Equal(t, current, again)
+
+		// other tenants get different keys
+		keys, err = module.CreateTenantKeys(ctx, "Class", "tenant2")
+		require.Nil(t, err)
+		other, err := keys.CurrentKey()
+		require.Nil(t, err)
+		assert.NotEqual(t, current.ID, other.ID)
+		assert.NotEqual(t, current.Material, other.Material)
+	})
+
+	t.Run("keys survive a restart", func(t *testing.T) {
+		restarted := New()
+		require.Nil(t, restarted.initKeysPath(keysPath))
+
+		keys, err := restarted.TenantKeys(ctx, "Class", "tenant1")
+		require.Nil(t, err)
+		key, err := keys.Key(keyID)
+		require.Nil(t, err)
+		assert.Equal(t, keyID, key.ID)
+	})
+
+	t.Run("delete tenant key", func(t *testing.T) {
+		keys, err := module.TenantKeys(ctx, "Class", "tenant1")
+		require.Nil(t, err)
+
+		require.Nil(t, module.DeleteTenantKeys(ctx, "Class", []string{"tenant1", "missing"}))
+
+		_, err = module.TenantKeys(ctx, "Class", "tenant1")
+		assert.ErrorIs(t, err, encryption.ErrKeyNotFound)
+		// providers handed out before can no longer decrypt either
+		_, err = keys.Key(keyID)
+		assert.NotNil(t, err)
+
+		_, err = module.TenantKeys(ctx, "Class", "tenant2")
+		assert.Nil(t, err)
+	})
+
+	t.Run("delete class keys", func(t *testing.T) {
+		require.Nil(t, module.DeleteClassKeys(ctx, "Class"))
+
+		_, err := module.TenantKeys(ctx, "Class", "tenant2")
+		assert.ErrorIs(t, err, encryption.ErrKeyNotFound)
+		_, err = os.Stat(filepath.Join(keysPath, "Class"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
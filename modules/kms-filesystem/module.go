@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package modkmsfs
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/encryption"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+)
+
+const (
+	Name         = "kms-filesystem"
+	keysPathName = "KMS_FILESYSTEM_PATH"
+)
+
+// Module is a local stand-in for a key management service. Every tenant has
+// its own keyfile at {keysPath}/{className}/{tenantName}.json, deleting it
+// makes the tenant's data unreadable.
+type Module struct {
+	logger   logrus.FieldLogger
+	keysPath string
+
+	sync.Mutex
+	providers map[string]*encryption.KeyfileProvider // by keyfile path
+}
+
+func New() *Module {
+	return &Module{providers: map[string]*encryption.KeyfileProvider{}}
+}
+
+func (m *Module) Name() string {
+	return Name
+}
+
+func (m *Module) IsExternal() bool {
+	return false
+}
+
+func (m *Module) Type() modulecapabilities.ModuleType {
+	return modulecapabilities.KeyManagement
+}
+
+func (m *Module) Init(ctx context.Context,
+	params moduletools.ModuleInitParams,
+) error {
+	m.logger = params.GetLogger()
+	if err := m.initKeysPath(os.Getenv(keysPathName)); err != nil {
+		return errors.Wrap(err, "init key store")
+	}
+
+	return nil
+}
+
+func (m *Module) initKeysPath(keysPath string) error {
+	if keysPath == "" {
+		return errors.Errorf("empty keys path provided")
+	}
+	if !filepath.IsAbs(keysPath) {
+		return errors.Errorf("relative keys path provided")
+	}
+	if err := os.MkdirAll(keysPath, 0o700); err != nil {
+		return errors.Wrapf(err, "make dir %s", keysPath)
+	}
+	m.keysPath = keysPath
+	return nil
+}
+
+func (m *Module) RootHandler() http.Handler {
+	// TODO: remove once this is a capability interface
+	return nil
+}
+
+func (m *Module) MetaInfo() (map[string]interface{}, error) {
+	metaInfo := make(map[string]interface{})
+	metaInfo["keysPath"] = m.keysPath
+	return metaInfo, nil
+}
+
+// verify we implement the modules.Module interface
+var (
+	_ = modulecapabilities.Module(New())
+	_ = modulecapabilities.KeyProvider(New())
+	_ = modulecapabilities.MetaProvider(New())
+)
@@ -0,0 +1,597 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+)
+
+const (
+	// ArchiveDirectory is the directory, relative to the data path, in which
+	// the log archiver keeps its state. It is also the prefix under which the
+	// logs of each node are archived on the backend. As a dot is not allowed
+	// in backup IDs, the archive cannot collide with a backup.
+	ArchiveDirectory = ".archive"
+
+	archiveHeadFile  = "head.json"
+	archiveStateFile = "state.json"
+	archiveStaging   = "retired"
+	counterFile      = "indexcount"
+	hnswLogDirSuffix = ".hnsw.commitlog.d"
+)
+
+// archiveHead is the entry point of a node's archive
+type archiveHead struct {
+	// Started is when the node started archiving. Logs written before are
+	// not part of the archive.
+	Started time.Time `json:"started"`
+	// Seq is the sequence number of the latest round
+	Seq int64 `json:"seq"`
+}
+
+// archiveRound lists the pieces shipped by one round of the archiver.
+// Pieces only contain data written before Time.
+type archiveRound struct {
+	Time   time.Time      `json:"time"`
+	Pieces []archivePiece `json:"pieces"`
+}
+
+// archivePiece is the section of a file starting at Offset. The file is
+// relative to the data path. A snapshot replaces the whole file.
+type archivePiece struct {
+	Path     string `json:"path"`
+	Offset   int64  `json:"offset"`
+	Key      string `json:"key"`
+	Snapshot bool   `json:"snapshot,omitempty"`
+}
+
+// archiveState is what has been archived so far. It is persisted locally, so
+// that a restart does not ship all logs again.
+type archiveState struct {
+	Offsets  map[string]int64  `json:"offsets"`
+	Counters map[string][]byte `json:"counters"`
+}
+
+func archiveStore(backend modulecapabilities.BackupBackend, node string) objectStore {
+	return objectStore{backend: backend, backupId: path.Join(ArchiveDirectory, node)}
+}
+
+// nodeArchiveStore is the archive of the node which created the backup
+// stored in store
+func nodeArchiveStore(store nodeStore, backupID string) objectStore {
+	node := strings.TrimPrefix(store.backupId, backupID+"/")
+	return archiveStore(store.backend, node)
+}
+
+func roundKey(seq int64) string { return fmt.Sprintf("rounds/%d.json", seq) }
+
+func pieceKey(seq int64, i int) string { return fmt.Sprintf("pieces/%d/%d", seq, i) }
+
+// LogArchiver continuously ships the commit logs of the lsm stores and of
+// the HNSW indexes to a backup backend. Restoring a backup can then replay
+// them to recover the state at any point in time after the backup.
+//
+// Active logs are shipped as they grow. Logs which are about to be deleted
+// are retired first: they are hard linked into a staging directory and
+// removed from there once shipped completely.
+type LogArchiver struct {
+	dataPath string
+	dir      string
+	logger   logrus.FieldLogger
+
+	staging sync.Mutex // guards the staging directory
+
+	sync.Mutex // serializes rounds
+	store      objectStore
+	head       archiveHead
+	state      archiveState
+}
+
+// NewLogArchiver creates an archiver of the logs within dataPath. Logs can
+// be retired right away, but are only shipped once Run is called.
+func NewLogArchiver(dataPath string, logger logrus.FieldLogger) (*LogArchiver, error) {
+	a := &LogArchiver{
+		dataPath: dataPath,
+		dir:      filepath.Join(dataPath, ArchiveDirectory),
+		logger:   logger.WithField("action", "archive_logs"),
+		state: archiveState{
+			Offsets:  map[string]int64{},
+			Counters: map[string][]byte{},
+		},
+	}
+	if err := os.MkdirAll(filepath.Join(a.dir, archiveStaging), os.ModePerm); err != nil {
+		return nil, fmt.Errorf("create archive directory: %w", err)
+	}
+	data, err := os.ReadFile(filepath.Join(a.dir, archiveStateFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("read archive state: %w", err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &a.state); err != nil {
+			return nil, fmt.Errorf("unmarshal archive state: %w", err)
+		}
+	}
+	return a, nil
+}
+
+// Retire keeps the log at path until it has been archived. It must be called
+// once nothing is appended to the log anymore and before it is deleted.
+func (a *LogArchiver) Retire(file string) error {
+	rel, err := filepath.Rel(a.dataPath, file)
+	if err != nil || !filepath.IsLocal(rel) {
+		return fmt.Errorf("retire %s: not within data path %s", file, a.dataPath)
+	}
+	dst := filepath.Join(a.dir, archiveStaging, rel)
+
+	a.staging.Lock()
+	defer a.staging.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+		return fmt.Errorf("retire %s: %w", rel, err)
+	}
+	// a log reusing the name of a previous one contains it
+	if err := os.Remove(dst); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("retire %s: %w", rel, err)
+	}
+	if err := os.Link(file, dst); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("retire %s: %w", rel, err)
+	}
+	return nil
+}
+
+// Run archives the logs of node on backend every interval until ctx is done
+func (a *LogArchiver) Run(ctx context.Context, backend modulecapabilities.BackupBackend,
+	node string, interval time.Duration,
+) error {
+	if err := a.init(ctx, archiveStore(backend, node)); err != nil {
+		return err
+	}
+	a.logger.WithField("backend", backend.Name()).
+		WithField("interval", interval).
+		Info("archiving logs")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := a.ship(ctx); err != nil {
+				a.logger.WithError(err).Error("archive logs")
+			}
+		}
+	}
+}
+
+func (a *LogArchiver) init(ctx context.Context, store objectStore) error {
+	a.Lock()
+	defer a.Unlock()
+
+	a.store = store
+	if err := store.Initialize(ctx, "", ""); err != nil {
+		return fmt.Errorf("init archive: %w", err)
+	}
+	err := store.meta(ctx, archiveHeadFile, "", "", &a.head)
+	if err == nil {
+		return nil
+	}
+	if !errors.As(err, &backup.ErrNotFound{}) {
+		return fmt.Errorf("get archive head: %w", err)
+	}
+	a.head = archiveHead{Started: time.Now().UTC()}
+	return store.putMeta(ctx, archiveHeadFile, "", "", a.head)
+}
+
+// archiveSource is a log to be shipped from its current offset
+type archiveSource struct {
+	rel    string
+	file   *os.File
+	info   os.FileInfo
+	offset int64
+	staged bool
+}
+
+// ship runs one round: it uploads what has been appended to the logs
+// since the previous round and the shard counters which have changed.
+func (a *LogArchiver) ship(ctx context.Context) error {
+	a.Lock()
+	defer a.Unlock()
+
+	logs, counters, err := a.collect()
+	if err != nil {
+		return err
+	}
+	var sources []*archiveSource
+	defer func() {
+		for _, s := range sources {
+			s.file.Close()
+		}
+	}()
+	for rel, file := range logs {
+		f, err := os.Open(file)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return err
+		}
+		info, err := f.Stat()
+		if err != nil {
+			f.Close()
+			return err
+		}
+		sources = append(sources, &archiveSource{
+			rel:    rel,
+			file:   f,
+			info:   info,
+			offset: a.state.Offsets[rel],
+			staged: strings.HasPrefix(file, filepath.Join(a.dir, archiveStaging)),
+		})
+	}
+	// counters are read after the logs, so that they cover all ids in them
+	snapshots := map[string][]byte{}
+	for _, rel := range counters {
+		data, err := os.ReadFile(filepath.Join(a.dataPath, rel))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return err
+		}
+		snapshots[rel] = data
+	}
+	round := archiveRound{Time: time.Now().UTC()}
+
+	seq := a.head.Seq + 1
+	for _, s := range sources {
+		size := s.info.Size()
+		if size < s.offset { // replaced by a new log of the same name
+			s.offset = 0
+		}
+		if size == s.offset {
+			continue
+		}
+		key := pieceKey(seq, len(round.Pieces))
+		r := io.NopCloser(io.NewSectionReader(s.file, s.offset, size-s.offset))
+		if _, err := a.store.Write(ctx, key, "", "", r); err != nil {
+			return fmt.Errorf("upload %s: %w", s.rel, err)
+		}
+		round.Pieces = append(round.Pieces, archivePiece{Path: s.rel, Offset: s.offset, Key: key})
+	}
+	for rel, data := range snapshots {
+		if bytes.Equal(a.state.Counters[rel], data) {
+			continue
+		}
+		key := pieceKey(seq, len(round.Pieces))
+		if _, err := a.store.Write(ctx, key, "", "", io.NopCloser(bytes.NewReader(data))); err != nil {
+			return fmt.Errorf("upload %s: %w", rel, err)
+		}
+		round.Pieces = append(round.Pieces, archivePiece{Path: rel, Key: key, Snapshot: true})
+	}
+
+	if len(round.Pieces) > 0 {
+		if err := a.store.putMeta(ctx, roundKey(seq), "", "", round); err != nil {
+			return err
+		}
+		a.head.Seq = seq
+		if err := a.store.putMeta(ctx, archiveHeadFile, "", "", a.head); err != nil {
+			return err
+		}
+	}
+
+	state := archiveState{
+		Offsets:  make(map[string]int64, len(sources)),
+		Counters: snapshots,
+	}
+	for _, s := range sources {
+		if !s.staged {
+			state.Offsets[s.rel] = s.info.Size()
+			continue
+		}
+		if err := a.release(s); err != nil {
+			return err
+		}
+	}
+	a.state = state
+	return a.saveState()
+}
+
+// collect finds the logs and counters to archive by their path relative to
+// the data path. Retired logs take precedence over active ones.
+func (a *LogArchiver) collect() (logs map[string]string, counters []string, err error) {
+	logs = map[string]string{}
+	err = filepath.WalkDir(a.dataPath, func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() {
+			if file == a.dir || d.Name() == TempDirectory {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		rel, err := filepath.Rel(a.dataPath, file)
+		if err != nil {
+			return err
+		}
+		switch {
+		case d.Name() == counterFile:
+			counters = append(counters, rel)
+		case isActiveLog(file):
+			logs[rel] = file
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("collect logs: %w", err)
+	}
+
+	staging := filepath.Join(a.dir, archiveStaging)
+	err = filepath.WalkDir(staging, func(file string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(staging, file)
+		if err != nil {
+			return err
+		}
+		logs[rel] = file
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("collect retired logs: %w", err)
+	}
+	return logs, counters, nil
+}
+
+// release removes a retired log which has been shipped, unless it has been
+// retired again in the meantime
+func (a *LogArchiver) release(s *archiveSource) error {
+	a.staging.Lock()
+	defer a.staging.Unlock()
+
+	file := filepath.Join(a.dir, archiveStaging, s.rel)
+	if info, err := os.Stat(file); err != nil || !os.SameFile(info, s.info) {
+		return nil
+	}
+	if err := os.Remove(file); err != nil {
+		return fmt.Errorf("release %s: %w", s.rel, err)
+	}
+	return nil
+}
+
+func (a *LogArchiver) saveState() error {
+	data, err := json.Marshal(a.state)
+	if err != nil {
+		return fmt.Errorf("marshal archive state: %w", err)
+	}
+	file := filepath.Join(a.dir, archiveStateFile)
+	if err := os.WriteFile(file+".tmp", data, 0o666); err != nil {
+		return fmt.Errorf("write archive state: %w", err)
+	}
+	return os.Rename(file+".tmp", file)
+}
+
+// isActiveLog is true for the write-ahead-logs of lsm buckets and the raw
+// commit logs of HNSW indexes
+func isActiveLog(file string) bool {
+	if filepath.Ext(file) == ".wal" {
+		return true
+	}
+	if !strings.HasSuffix(filepath.Dir(file), hnswLogDirSuffix) {
+		return false
+	}
+	_, err := strconv.ParseInt(filepath.Base(file), 10, 64)
+	return err == nil
+}
+
+// replayArchive writes the logs archived after since and up to until into
+// the files of the shards restored in classDir. Logs which are not newer
+// than the files of the backup are skipped, as the backup contains them.
+// The logs are replayed when the shards are loaded.
+//
+// Logs are archived in rounds, one per archive interval, and a round holds
+// byte ranges of logs rather than timestamped writes. A round is therefore
+// replayed completely or not at all: writes archived in the round after
+// until are not restored, even if they happened before until. The returned
+// time is the time of the last replayed round, which is the point in time
+// the data is actually restored to. It is since if no round was replayed.
+func replayArchive(ctx context.Context, store objectStore, classDir string, since, until time.Time) (time.Time, error) {
+	rounds, err := archiveRounds(ctx, store, since, until)
+	if err != nil {
+		return time.Time{}, err
+	}
+	r := logReplayer{
+		store:    store,
+		classDir: classDir,
+		shards:   map[string]bool{},
+		latest:   map[string]int64{},
+	}
+	restored := since
+	for _, round := range rounds {
+		for _, p := range round.Pieces {
+			if err := r.replay(ctx, p); err != nil {
+				return time.Time{}, fmt.Errorf("replay %s: %w", p.Path, err)
+			}
+		}
+		restored = round.Time
+	}
+	return restored, nil
+}
+
+// archiveRounds returns the rounds shipped between since and until in the
+// order they were shipped
+func archiveRounds(ctx context.Context, store objectStore, since, until time.Time) ([]archiveRound, error) {
+	head, err := checkArchive(ctx, store, since)
+	if err != nil {
+		return nil, err
+	}
+	var rounds []archiveRound
+	for seq := head.Seq; seq > 0; seq-- {
+		var round archiveRound
+		if err := store.meta(ctx, roundKey(seq), "", "", &round); err != nil {
+			return nil, fmt.Errorf("get archive round %d: %w", seq, err)
+		}
+		if round.Time.Before(since) {
+			break
+		}
+		if !round.Time.After(until) {
+			rounds = append(rounds, round)
+		}
+	}
+	sort.SliceStable(rounds, func(i, j int) bool { return rounds[i].Time.Before(rounds[j].Time) })
+	return rounds, nil
+}
+
+// checkArchive makes sure logs have been archived since the given time
+func checkArchive(ctx context.Context, store objectStore, since time.Time) (head archiveHead, err error) {
+	if err := store.meta(ctx, archiveHeadFile, "", "", &head); err != nil {
+		return head, fmt.Errorf("get archive head: %w", err)
+	}
+	if head.Started.After(since) {
+		return head, fmt.Errorf("logs are archived since %s only", head.Started.Format(time.RFC3339))
+	}
+	return head, nil
+}
+
+type logReplayer struct {
+	store    objectStore
+	classDir string
+	shards   map[string]bool  // shard directory -> restored
+	latest   map[string]int64 // log directory -> id of its latest file within the backup
+}
+
+func (r *logReplayer) replay(ctx context.Context, p archivePiece) error {
+	rel := filepath.FromSlash(p.Path)
+	parts := strings.SplitN(rel, string(filepath.Separator), 3)
+	if len(parts) < 3 || !r.restored(filepath.Join(parts[0], parts[1])) {
+		return nil
+	}
+	file := filepath.Join(r.classDir, rel)
+
+	if p.Snapshot {
+		return r.replaceCounter(ctx, file, p.Key)
+	}
+	if !r.newer(file) {
+		return nil
+	}
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE, 0o666)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = r.store.Read(ctx, p.Key, "", "", nopWriteCloser{io.NewOffsetWriter(f, p.Offset)})
+	return err
+}
+
+func (r *logReplayer) restored(shard string) bool {
+	ok, found := r.shards[shard]
+	if !found {
+		info, err := os.Stat(filepath.Join(r.classDir, shard))
+		ok = err == nil && info.IsDir()
+		r.shards[shard] = ok
+	}
+	return ok
+}
+
+// newer is true if file is not part of the backup, i.e. if its id is greater
+// than the ids of the segments or HNSW logs the backup holds in its directory
+func (r *logReplayer) newer(file string) bool {
+	dir := filepath.Dir(file)
+	latest, found := r.latest[dir]
+	if !found {
+		latest = -1
+		entries, _ := os.ReadDir(dir)
+		for _, e := range entries {
+			if filepath.Ext(e.Name()) == ".wal" { // replayed
+				continue
+			}
+			if id, ok := fileID(e.Name()); ok && id > latest {
+				latest = id
+			}
+		}
+		r.latest[dir] = latest
+	}
+	id, ok := fileID(filepath.Base(file))
+	return ok && id > latest
+}
+
+// fileID parses the timestamp based id of lsm segments and write-ahead-logs
+// ("segment-<id>.db", "segment-<id>.wal") and of HNSW commit logs ("<id>",
+// "<id>.condensed")
+func fileID(name string) (int64, bool) {
+	if strings.HasPrefix(name, "segment-") {
+		ext := filepath.Ext(name)
+		if ext != ".db" && ext != ".wal" {
+			return 0, false
+		}
+		name = strings.TrimSuffix(strings.TrimPrefix(name, "segment-"), ext)
+		if i := strings.LastIndexByte(name, '_'); i >= 0 {
+			name = name[i+1:]
+		}
+	} else {
+		name = strings.TrimSuffix(name, ".condensed")
+	}
+	id, err := strconv.ParseInt(name, 10, 64)
+	return id, err == nil
+}
+
+// replaceCounter replaces the shard's counter if the archived one is ahead,
+// so that the ids of replayed objects are not handed out again
+func (r *logReplayer) replaceCounter(ctx context.Context, file, key string) error {
+	buf := &bufferCloser{}
+	if _, err := r.store.Read(ctx, key, "", "", buf); err != nil {
+		return err
+	}
+	if len(buf.Bytes()) != 8 {
+		return fmt.Errorf("invalid counter of %d bytes", len(buf.Bytes()))
+	}
+	if current, err := os.ReadFile(file); err == nil && len(current) == 8 &&
+		binary.LittleEndian.Uint64(current) >= binary.LittleEndian.Uint64(buf.Bytes()) {
+		return nil
+	}
+	return os.WriteFile(file, buf.Bytes(), 0o666)
+}
+
+type nopWriteCloser struct{ io.Writer }
+
+func (nopWriteCloser) Close() error { return nil }
+
+type bufferCloser struct{ bytes.Buffer }
+
+func (*bufferCloser) Close() error { return nil }
@@ -0,0 +1,174 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogArchiver(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		src       = t.TempDir()
+		objects   = &memObjects{m: map[string][]byte{}}
+		backend   = &memBackend{objects: objects, dataPath: src}
+		store     = archiveStore(backend, "node1")
+		lsm       = filepath.Join("c1", "s1", "lsm", "objects")
+		hnsw      = filepath.Join("c1", "s1", "main.hnsw.commitlog.d")
+	)
+	write := func(rel, data string) {
+		file := filepath.Join(src, rel)
+		require.NoError(t, os.MkdirAll(filepath.Dir(file), os.ModePerm))
+		f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o666)
+		require.NoError(t, err)
+		_, err = f.WriteString(data)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+	}
+	counter := func(n uint64) string {
+		return string(binary.LittleEndian.AppendUint64(nil, n))
+	}
+	tick := func() time.Time {
+		time.Sleep(2 * time.Millisecond)
+		now := time.Now()
+		time.Sleep(2 * time.Millisecond)
+		return now
+	}
+
+	a, err := NewLogArchiver(src, logger)
+	require.NoError(t, err)
+	require.NoError(t, a.init(ctx, store))
+
+	// before the backup
+	write(filepath.Join(lsm, "segment-100.wal"), "old")
+	write(filepath.Join(hnsw, "1000"), "h1")
+	write(filepath.Join("c1", "s1", counterFile), counter(5))
+	require.NoError(t, a.ship(ctx))
+
+	since := tick()
+	write(filepath.Join(lsm, "segment-100.wal"), "+flushed")
+	require.NoError(t, a.Retire(filepath.Join(src, lsm, "segment-100.wal")))
+	require.NoError(t, os.Remove(filepath.Join(src, lsm, "segment-100.wal")))
+	write(filepath.Join(lsm, "segment-100.db"), "segment")
+
+	// the backup holds the flushed segment and the first hnsw log
+	dest := t.TempDir()
+	classDir := filepath.Join(dest, TempDirectory, "c1")
+	for _, rel := range []string{filepath.Join(lsm, "segment-100.db"), filepath.Join(hnsw, "1000"), filepath.Join("c1", "s1", counterFile)} {
+		data, err := os.ReadFile(filepath.Join(src, rel))
+		require.NoError(t, err)
+		require.NoError(t, os.MkdirAll(filepath.Join(classDir, filepath.Dir(rel)), os.ModePerm))
+		require.NoError(t, os.WriteFile(filepath.Join(classDir, rel), data, 0o666))
+	}
+
+	// after the backup
+	write(filepath.Join(lsm, "segment-200.wal"), "abc")
+	write(filepath.Join(hnsw, "2000"), "h2")
+	require.NoError(t, os.WriteFile(filepath.Join(src, "c1", "s1", counterFile), []byte(counter(7)), 0o666))
+	write(filepath.Join("c2", "s1", "lsm", "objects", "segment-300.wal"), "other class")
+	require.NoError(t, a.ship(ctx))
+	until := tick()
+
+	write(filepath.Join(lsm, "segment-200.wal"), "def")
+	require.NoError(t, a.ship(ctx))
+
+	t.Run("retired logs are released once shipped", func(t *testing.T) {
+		entries, err := os.ReadDir(filepath.Join(src, ArchiveDirectory, archiveStaging, lsm))
+		require.NoError(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("state survives restarts", func(t *testing.T) {
+		b, err := NewLogArchiver(src, logger)
+		require.NoError(t, err)
+		assert.Equal(t, int64(6), b.state.Offsets[filepath.Join(lsm, "segment-200.wal")])
+	})
+
+	read := func(rel string) string {
+		data, err := os.ReadFile(filepath.Join(classDir, rel))
+		if err != nil {
+			return ""
+		}
+		return string(data)
+	}
+
+	t.Run("replay nothing", func(t *testing.T) {
+		restored, err := replayArchive(ctx, store, classDir, since, since)
+		require.NoError(t, err)
+		assert.Equal(t, since, restored)
+		assert.Empty(t, read(filepath.Join(lsm, "segment-200.wal")))
+	})
+
+	t.Run("replay until", func(t *testing.T) {
+		restored, err := replayArchive(ctx, store, classDir, since, until)
+		require.NoError(t, err)
+		assert.True(t, restored.After(since), "restored to the round shipped after the backup")
+		assert.False(t, restored.After(until), "restored to the last round before until")
+		assert.Equal(t, "abc", read(filepath.Join(lsm, "segment-200.wal")))
+		assert.Equal(t, "h1", read(filepath.Join(hnsw, "1000")))
+		assert.Equal(t, "h2", read(filepath.Join(hnsw, "2000")))
+		assert.Equal(t, counter(7), read(filepath.Join("c1", "s1", counterFile)))
+		assert.Empty(t, read(filepath.Join(lsm, "segment-100.wal")), "contained in the backup")
+		assert.NoDirExists(t, filepath.Join(classDir, "c2"), "not restored")
+	})
+
+	t.Run("replay all", func(t *testing.T) {
+		restored, err := replayArchive(ctx, store, classDir, since, time.Now())
+		require.NoError(t, err)
+		assert.True(t, restored.After(until))
+		assert.Equal(t, "abcdef", read(filepath.Join(lsm, "segment-200.wal")))
+	})
+
+	t.Run("archive started after backup", func(t *testing.T) {
+		_, err := checkArchive(ctx, store, since.Add(-time.Hour))
+		assert.ErrorContains(t, err, "archived since")
+	})
+
+	t.Run("no archive", func(t *testing.T) {
+		_, err := checkArchive(ctx, archiveStore(backend, "node2"), since)
+		assert.Error(t, err)
+	})
+}
+
+func TestArchiveFileID(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		id   int64
+		ok   bool
+	}{
+		{"segment-123.db", 123, true},
+		{"segment-123.wal", 123, true},
+		{"segment-12_123.db", 123, true},
+		{"segment-123.bloom", 0, false},
+		{"1700000000", 1700000000, true},
+		{"1700000000.condensed", 1700000000, true},
+		{"1700000000.scratch.tmp", 0, false},
+	} {
+		id, ok := fileID(tc.name)
+		assert.Equal(t, tc.ok, ok, tc.name)
+		assert.Equal(t, tc.id, id, tc.name)
+	}
+	assert.True(t, isActiveLog("c/s/lsm/objects/segment-1.wal"))
+	assert.True(t, isActiveLog("c/s/main.hnsw.commitlog.d/1700000000"))
+	assert.False(t, isActiveLog("c/s/main.hnsw.commitlog.d/1700000000.condensed"))
+	assert.False(t, isActiveLog("c/s/lsm/objects/segment-1.db"))
+}
@@ -186,6 +186,7 @@ type uploader struct {
 	zipConfig
 	setStatus func(st backup.Status)
 	log       logrus.FieldLogger
+	base      *baseBackup // previous backup, nil if this is a full backup
 }
 
 func newUploader(sourcer Sourcer, backend nodeStore,
@@ -201,6 +202,7 @@ func newUploader(sourcer Sourcer, backend nodeStore,
 		}),
 		setstatus,
 		l,
+		nil,
 	}
 }
 
@@ -209,6 +211,13 @@ func (u *uploader) withCompression(cfg zipConfig) *uploader {
 	return u
 }
 
+// withBase makes the upload incremental, unchanged files are not uploaded
+// again but referenced from base
+func (u *uploader) withBase(base *baseBackup) *uploader {
+	u.base = base
+	return u
+}
+
 // all uploads all files in addition to the metadata file
 func (u *uploader) all(ctx context.Context, classes []string, desc *backup.BackupDescriptor, overrideBucket, overridePath string) (err error) {
 	u.setStatus(backup.Transferring)
@@ -415,6 +424,9 @@ func (u *uploader) compress(ctx context.Context,
 			if err := ctx.Err(); err != nil {
 				return err
 			}
+			if err := u.base.split(zip.sourcePath, class, shard); err != nil {
+				return err
+			}
 			if _, err := zip.WriteShard(ctx, shard); err != nil {
 				return err
 			}
@@ -456,6 +468,7 @@ type fileWriter struct {
 	compressed bool
 	GoPoolSize int
 	migrator   func(classPath string) error
+	baseStore  func(backupID string) nodeStore // store of files kept by previous backups
 	logger     logrus.FieldLogger
 }
 
@@ -482,6 +495,8 @@ func (fw *fileWriter) WithPoolPercentage(p int) *fileWriter {
 
 func (fw *fileWriter) setMigrator(m func(classPath string) error) { fw.migrator = m }
 
+func (fw *fileWriter) setBaseStore(f func(backupID string) nodeStore) { fw.baseStore = f }
+
 // Write downloads files and put them in the destination directory
 func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor, overrideBucket, overridePath string) (err error) {
 	if len(desc.Shards) == 0 { // nothing to copy
@@ -492,6 +507,9 @@ func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor, o
 	if err := fw.writeTempFiles(ctx, classTempDir, overrideBucket, overridePath, desc); err != nil {
 		return fmt.Errorf("get files: %w", err)
 	}
+	if err := fw.writeBaseFiles(ctx, classTempDir, overrideBucket, overridePath, desc); err != nil {
+		return fmt.Errorf("get files of previous backups: %w", err)
+	}
 
 	if fw.migrator != nil {
 		if err := fw.migrator(classTempDir); err != nil {
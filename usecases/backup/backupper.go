@@ -142,6 +142,17 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 	if prevID := b.lastOp.renew(id, store.HomeDir(req.Bucket, req.Path), req.Bucket, req.Path); prevID != "" {
 		return ret, fmt.Errorf("backup %s already in progress", prevID)
 	}
+	var base *baseBackup
+	if req.BaseBackupID != "" {
+		bstore, err := nodeBackend(b.node, b.backends, req.Backend, req.BaseBackupID, req.Bucket, req.Path)
+		if err == nil {
+			base, err = loadBaseBackup(context.Background(), bstore, req.BaseBackupID, req.Bucket, req.Path)
+		}
+		if err != nil {
+			b.lastOp.reset()
+			return ret, err
+		}
+	}
 	b.waitingForCoordinatorToCommit.Store(true) // is set to false by wait()
 	// waits for ack from coordinator in order to processed with the backup
 	f := func() {
@@ -154,7 +165,8 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 
 		}
 		provider := newUploader(b.sourcer, store, req.ID, b.lastOp.set, b.logger).
-			withCompression(newZipConfig(req.Compression))
+			withCompression(newZipConfig(req.Compression)).
+			withBase(base)
 
 		result := backup.BackupDescriptor{
 			StartedAt:     time.Now().UTC(),
@@ -162,6 +174,7 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 			Classes:       make([]backup.ClassDescriptor, 0, len(req.Classes)),
 			Version:       Version,
 			ServerVersion: config.ServerVersion,
+			BaseBackupID:  req.BaseBackupID,
 		}
 
 		// the coordinator might want to abort the backup
@@ -177,6 +177,7 @@ func (c *coordinator) Backup(ctx context.Context, cstore coordStore, req *Reques
 		Version:       Version,
 		ServerVersion: config.ServerVersion,
 		Leader:        leader,
+		BaseBackupID:  req.BaseBackupID,
 	}
 
 	for key := range c.Participants {
@@ -388,15 +389,17 @@ func (c *coordinator) canCommit(ctx context.Context, req *Request) (map[string]s
 			reqChan <- pair{
 				nodeHost{node, host},
 				&Request{
-					Method:      req.Method,
-					ID:          id,
-					Backend:     req.Backend,
-					Classes:     gr.Classes,
-					Duration:    _BookingPeriod,
-					NodeMapping: nodeMapping,
-					Compression: req.Compression,
-					Bucket:      req.Bucket,
-					Path:        req.Path,
+					Method:       req.Method,
+					ID:           id,
+					Backend:      req.Backend,
+					Classes:      gr.Classes,
+					Duration:     _BookingPeriod,
+					NodeMapping:  nodeMapping,
+					Compression:  req.Compression,
+					Bucket:       req.Bucket,
+					Path:         req.Path,
+					BaseBackupID: req.BaseBackupID,
+					RestoreUntil: req.RestoreUntil,
 				},
 			}
 		}
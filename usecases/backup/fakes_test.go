@@ -82,6 +82,11 @@ func (s *fakeSourcer) ClassExists(name string) bool {
 	return args.Bool(0)
 }
 
+func (s *fakeSourcer) ValidateEncryptionKeys(ctx context.Context, desc *backup.BackupDescriptor) error {
+	args := s.Called(ctx, desc)
+	return args.Error(0)
+}
+
 type fakeBackend struct {
 	mock.Mock
 	sync.RWMutex
@@ -26,8 +26,10 @@ import (
 // Version of backup structure
 const (
 	// Version > version1 support compression
+	// "2.2" support incremental backups
+	Version = "2.2"
 	// "2.1" support restore on 2 phases
-	Version = "2.1"
+	// Version = "2.1"
 	// "2.0" support compression
 	// Version = "2.0"
 	// version1 store plain files without compression
@@ -142,6 +144,16 @@ type BackupRequest struct {
 
 	// Override path (optional) - replaces environement variable for one call
 	Path string
+
+	// BaseBackupID (optional) of a previous backup on the same backend.
+	// Files which have not changed since then are referenced instead of
+	// being copied again. The base backup must be kept as long as this one.
+	BaseBackupID string
+
+	// RestoreUntil (optional) replays the logs archived after the backup up
+	// to this point in time. The logs are archived in rounds, so the data is
+	// restored to the last round before it, see replayArchive.
+	RestoreUntil time.Time
 }
 
 // OnCanCommit will be triggered when coordinator asks the node to participate
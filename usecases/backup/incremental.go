@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// baseBackup is the node descriptor of the backup an incremental backup
+// builds upon, indexed by class and shard
+type baseBackup struct {
+	id     string
+	shards map[string]*backup.ShardDescriptor
+}
+
+// loadBaseBackup fetches the node's descriptor of the base backup id.
+// A node which did not take part in the base backup has nothing to reuse
+// and creates a full backup, in which case nil is returned.
+func loadBaseBackup(ctx context.Context, store nodeStore, id, overrideBucket, overridePath string) (*baseBackup, error) {
+	ctx, cancel := context.WithTimeout(ctx, metaTimeout)
+	defer cancel()
+
+	meta, err := store.Meta(ctx, id, overrideBucket, overridePath, false)
+	if err != nil {
+		if notFound := (backup.ErrNotFound{}); errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("base backup %q: %w", id, err)
+	}
+	if meta.Status != string(backup.Success) {
+		return nil, fmt.Errorf("base backup %q: invalid status %s", id, meta.Status)
+	}
+	if meta.Version <= version1 {
+		return nil, fmt.Errorf("base backup %q: version %s does not support incremental backups", id, meta.Version)
+	}
+
+	b := &baseBackup{id: id, shards: make(map[string]*backup.ShardDescriptor)}
+	for _, c := range meta.Classes {
+		for _, s := range c.Shards {
+			b.shards[shardKey(c.Name, s.Name)] = s
+		}
+	}
+	return b, nil
+}
+
+// split fingerprints the immutable files of sd and moves those which have not
+// changed since the base backup from sd.Files to sd.BaseFiles. b may be nil,
+// in which case only the fingerprints are recorded, so that later backups can
+// build upon this one.
+func (b *baseBackup) split(sourcePath, class string, sd *backup.ShardDescriptor) error {
+	var prev *backup.ShardDescriptor
+	if b != nil {
+		prev = b.shards[shardKey(class, sd.Name)]
+	}
+
+	files := make([]string, 0, len(sd.Files))
+	for _, relPath := range sd.Files {
+		if !isImmutable(relPath) {
+			files = append(files, relPath)
+			continue
+		}
+		info, err := os.Stat(filepath.Join(sourcePath, relPath))
+		if err != nil {
+			return fmt.Errorf("stat %s: %w", relPath, err)
+		}
+		fp := fingerprint(info)
+		if sd.Fingerprints == nil {
+			sd.Fingerprints = make(map[string]string)
+		}
+		sd.Fingerprints[relPath] = fp
+
+		if prev == nil || prev.Fingerprints[relPath] != fp {
+			files = append(files, relPath)
+			continue
+		}
+		if sd.BaseFiles == nil {
+			sd.BaseFiles = make(map[string]string)
+		}
+		// point to the backup which actually stores the file, so that
+		// restoring never needs to walk the chain
+		sd.BaseFiles[relPath] = prev.Owner(b.id, relPath)
+	}
+	sd.Files = files
+	return nil
+}
+
+// baseNodeStore returns the store holding this node's files of the previous
+// backup baseID, given the node's store of backupID
+func baseNodeStore(store nodeStore, backupID, baseID string) nodeStore {
+	node := strings.TrimPrefix(store.backupId, backupID+"/")
+	return nodeStore{objectStore{store.backend, fmt.Sprintf("%s/%s", baseID, node), store.bucket, store.path}}
+}
+
+// writeBaseFiles extracts the files of desc which are stored by previous
+// backups into classTempDir. Only the chunks containing the shards in
+// question are downloaded, and only the requested files are written.
+func (fw *fileWriter) writeBaseFiles(ctx context.Context, classTempDir, overrideBucket, overridePath string, desc *backup.ClassDescriptor) error {
+	// owner backup -> shard -> files
+	wanted := make(map[string]map[string][]string)
+	for _, sd := range desc.Shards {
+		for relPath, id := range sd.BaseFiles {
+			if wanted[id] == nil {
+				wanted[id] = make(map[string][]string)
+			}
+			wanted[id][sd.Name] = append(wanted[id][sd.Name], relPath)
+		}
+	}
+	if len(wanted) == 0 {
+		return nil
+	}
+	if fw.baseStore == nil {
+		return fmt.Errorf("class %s references files of previous backups", desc.Name)
+	}
+
+	eg, ctx := enterrors.NewErrorGroupWithContextWrapper(fw.logger, ctx)
+	eg.SetLimit(fw.GoPoolSize)
+	for id, shards := range wanted {
+		store := fw.baseStore(id)
+		meta, err := store.Meta(ctx, id, overrideBucket, overridePath, false)
+		if err != nil {
+			return fmt.Errorf("base backup %q: %w", id, err)
+		}
+		chunks, err := baseChunks(meta, desc.Name, shards)
+		if err != nil {
+			return fmt.Errorf("base backup %q: %w", id, err)
+		}
+		for chunk, files := range chunks {
+			key := chunkKey(desc.Name, chunk)
+			eg.Go(func() error {
+				uz, w := NewUnzip(classTempDir)
+				defer uz.Close()
+				uz.files = files
+				enterrors.GoWrapper(func() {
+					store.Read(ctx, key, overrideBucket, overridePath, w)
+				}, fw.logger)
+				if _, err := uz.ReadChunk(); err != nil {
+					return fmt.Errorf("base backup %q: %s: %w", id, key, err)
+				}
+				for relPath := range files {
+					return fmt.Errorf("base backup %q: %s: file %s not found", id, key, relPath)
+				}
+				return nil
+			})
+		}
+	}
+	return eg.Wait()
+}
+
+// baseChunks groups the wanted files of a class by the chunk of the base
+// backup which contains them
+func baseChunks(meta *backup.BackupDescriptor, class string, shards map[string][]string) (map[int32]map[string]struct{}, error) {
+	var cdesc *backup.ClassDescriptor
+	for i := range meta.Classes {
+		if meta.Classes[i].Name == class {
+			cdesc = &meta.Classes[i]
+			break
+		}
+	}
+	if cdesc == nil {
+		return nil, fmt.Errorf("class %s not found", class)
+	}
+
+	chunks := make(map[int32]map[string]struct{})
+	for name, files := range shards {
+		var sd *backup.ShardDescriptor
+		for _, s := range cdesc.Shards {
+			if s.Name == name {
+				sd = s
+				break
+			}
+		}
+		if sd == nil {
+			return nil, fmt.Errorf("shard %s/%s not found", class, name)
+		}
+		if chunks[sd.Chunk] == nil {
+			chunks[sd.Chunk] = make(map[string]struct{})
+		}
+		for _, f := range files {
+			chunks[sd.Chunk][f] = struct{}{}
+		}
+	}
+	return chunks, nil
+}
+
+// isImmutable reports whether relPath is an LSM segment or one of its
+// precomputed files. These are never modified once written, compactions
+// replace them with new files instead.
+func isImmutable(relPath string) bool {
+	name := filepath.Base(relPath)
+	if !strings.HasPrefix(name, "segment-") {
+		return false
+	}
+	switch filepath.Ext(name) {
+	case ".db", ".bloom", ".cna":
+		return true
+	default:
+		return false
+	}
+}
+
+// fingerprint identifies the content of an immutable file. The name alone is
+// not sufficient since compacted segments take over the name of one of their
+// sources.
+func fingerprint(info os.FileInfo) string {
+	return fmt.Sprintf("%d-%d", info.Size(), info.ModTime().UnixNano())
+}
+
+func shardKey(class, shard string) string {
+	return class + "/" + shard
+}
@@ -0,0 +1,294 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestIncrementalBackup(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		src       = t.TempDir()
+		objects   = &memObjects{m: map[string][]byte{}}
+		backend   = &memBackend{objects: objects, dataPath: src}
+		class     = "c1"
+		lsm       = "c1/s1/lsm/objects/"
+	)
+	sourcer := &fakeSourcer{}
+	sourcer.On("ReleaseBackup", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	write := func(relPath, content string) {
+		path := filepath.Join(src, relPath)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	listFiles := func() []string {
+		var files []string
+		require.NoError(t, filepath.Walk(filepath.Join(src, "c1"), func(path string, info os.FileInfo, err error) error {
+			if err == nil && info.Mode().IsRegular() {
+				rel, _ := filepath.Rel(src, path)
+				files = append(files, rel)
+			}
+			return err
+		}))
+		return files
+	}
+	// create runs a backup of all files and returns the shard descriptor
+	create := func(id, baseID string) *backup.ShardDescriptor {
+		store := nodeStore{objectStore{backend, id + "/node1", "", ""}}
+		u := newUploader(sourcer, store, id, func(backup.Status) {}, logger)
+		if baseID != "" {
+			base, err := loadBaseBackup(ctx, nodeStore{objectStore{backend, baseID + "/node1", "", ""}}, baseID, "", "")
+			require.NoError(t, err)
+			require.NotNil(t, base)
+			u.withBase(base)
+		}
+		sd := &backup.ShardDescriptor{
+			Name:                  "s1",
+			Node:                  "node1",
+			Files:                 listFiles(),
+			DocIDCounterPath:      "c1/s1/indexcount",
+			DocIDCounter:          []byte("1"),
+			PropLengthTrackerPath: "c1/s1/proplengths",
+			PropLengthTracker:     []byte("2"),
+			ShardVersionPath:      "c1/s1/version",
+			Version:               []byte("3"),
+		}
+		desc := backup.BackupDescriptor{
+			ID:            id,
+			StartedAt:     time.Now(),
+			Version:       Version,
+			ServerVersion: "1.27.0",
+			Status:        string(backup.Success),
+			BaseBackupID:  baseID,
+			Classes: []backup.ClassDescriptor{{
+				Name:          class,
+				Shards:        []*backup.ShardDescriptor{sd},
+				Schema:        []byte("{}"),
+				ShardingState: []byte("{}"),
+			}},
+		}
+		require.NoError(t, u.class(ctx, id, &desc.Classes[0], "", ""))
+		require.NoError(t, store.PutMeta(ctx, &desc, "", ""))
+		return sd
+	}
+	restore := func(id string) (string, error) {
+		dest := t.TempDir()
+		store := nodeStore{objectStore{&memBackend{objects: objects, dataPath: dest}, id + "/node1", "", ""}}
+		meta, err := store.Meta(ctx, id, "", "", false)
+		require.NoError(t, err)
+
+		fw := newFileWriter(sourcer, store, true, logger)
+		fw.setBaseStore(func(baseID string) nodeStore { return baseNodeStore(store, id, baseID) })
+		if err := fw.Write(ctx, &meta.Classes[0], "", ""); err != nil {
+			return "", err
+		}
+		require.NoError(t, RestoreClassDir(dest)(class))
+		return dest, nil
+	}
+	assertRestored := func(dest string) {
+		for _, relPath := range listFiles() {
+			expected, err := os.ReadFile(filepath.Join(src, relPath))
+			require.NoError(t, err)
+			actual, err := os.ReadFile(filepath.Join(dest, relPath))
+			require.NoError(t, err, relPath)
+			assert.Equal(t, expected, actual, relPath)
+		}
+	}
+
+	write(lsm+"segment-1.db", "first segment")
+	write(lsm+"segment-1.bloom", "first bloom")
+	write(lsm+"segment-2.db", "second segment")
+	write(lsm+"segment-3.wal", "wal")
+
+	sd := create("b1", "")
+	assert.Len(t, sd.Files, 4)
+	assert.Len(t, sd.Fingerprints, 3, "the wal is not immutable")
+	assert.Empty(t, sd.BaseFiles)
+
+	// compacting 1 and 2 keeps the name of the right segment
+	require.NoError(t, os.Remove(filepath.Join(src, lsm+"segment-1.db")))
+	write(lsm+"segment-2.db", "first segment, second segment")
+	write(lsm+"segment-3.wal", "wal grown")
+	write(lsm+"segment-4.db", "fourth segment")
+	require.NoError(t, os.Remove(filepath.Join(src, lsm+"segment-1.bloom")))
+	write(lsm+"segment-1.bloom", "first bloom")
+	require.NoError(t, os.Chtimes(filepath.Join(src, lsm+"segment-1.bloom"), time.Now().Add(time.Hour), time.Now().Add(time.Hour)))
+
+	sd = create("b2", "b1")
+	assert.ElementsMatch(t, []string{lsm + "segment-1.bloom", lsm + "segment-2.db", lsm + "segment-3.wal", lsm + "segment-4.db"}, sd.Files)
+	assert.Empty(t, sd.BaseFiles, "every immutable file changed")
+
+	write(lsm+"segment-3.wal", "wal grown again")
+	write(lsm+"segment-5.db", "fifth segment")
+	sd = create("b3", "b2")
+	assert.ElementsMatch(t, []string{lsm + "segment-3.wal", lsm + "segment-5.db"}, sd.Files)
+	assert.Equal(t, map[string]string{
+		lsm + "segment-1.bloom": "b2",
+		lsm + "segment-2.db":    "b2",
+		lsm + "segment-4.db":    "b2",
+	}, sd.BaseFiles)
+
+	write(lsm+"segment-3.wal", "wal")
+	sd = create("b4", "b3")
+	assert.ElementsMatch(t, []string{lsm + "segment-3.wal"}, sd.Files)
+	assert.Equal(t, map[string]string{
+		lsm + "segment-1.bloom": "b2",
+		lsm + "segment-2.db":    "b2",
+		lsm + "segment-4.db":    "b2",
+		lsm + "segment-5.db":    "b3",
+	}, sd.BaseFiles, "files are referenced from the backup storing them")
+
+	t.Run("restore resolves the chain", func(t *testing.T) {
+		dest, err := restore("b4")
+		require.NoError(t, err)
+		assertRestored(dest)
+
+		_, err = os.Stat(filepath.Join(dest, lsm+"segment-1.db"))
+		assert.True(t, os.IsNotExist(err), "compacted segment of the first backup must not be restored")
+	})
+
+	t.Run("missing base backup", func(t *testing.T) {
+		objects.deletePrefix("b3/")
+		_, err := restore("b4")
+		assert.ErrorContains(t, err, `base backup "b3"`)
+	})
+
+	t.Run("node without base backup", func(t *testing.T) {
+		base, err := loadBaseBackup(ctx, nodeStore{objectStore{backend, "b1/node2", "", ""}}, "b1", "", "")
+		require.NoError(t, err)
+		assert.Nil(t, base)
+	})
+}
+
+func TestIsImmutable(t *testing.T) {
+	for path, expected := range map[string]bool{
+		"c1/s1/lsm/objects/segment-1.db":                true,
+		"c1/s1/lsm/objects/segment-1.bloom":             true,
+		"c1/s1/lsm/objects/segment-1.secondary.0.bloom": true,
+		"c1/s1/lsm/objects/segment-1.cna":               true,
+		"c1/s1/lsm/objects/segment-1.wal":               false,
+		"c1/s1/lsm/objects/segment-1.db.tmp":            false,
+		"c1/s1/main.hnsw.commitlog.d/1700000000":        false,
+		"c1/s1/indexcount":                              false,
+	} {
+		assert.Equal(t, expected, isImmutable(path), path)
+	}
+}
+
+type memObjects struct {
+	sync.Mutex
+	m map[string][]byte
+}
+
+func (o *memObjects) get(key string) ([]byte, bool) {
+	o.Lock()
+	defer o.Unlock()
+	data, ok := o.m[key]
+	return data, ok
+}
+
+func (o *memObjects) put(key string, data []byte) {
+	o.Lock()
+	defer o.Unlock()
+	o.m[key] = data
+}
+
+func (o *memObjects) deletePrefix(prefix string) {
+	o.Lock()
+	defer o.Unlock()
+	for k := range o.m {
+		if strings.HasPrefix(k, prefix) {
+			delete(o.m, k)
+		}
+	}
+}
+
+// memBackend keeps objects in memory, several instances can share objects
+// to simulate different nodes
+type memBackend struct {
+	objects  *memObjects
+	dataPath string
+}
+
+func (b *memBackend) IsExternal() bool { return true }
+func (b *memBackend) Name() string     { return "memory" }
+func (b *memBackend) HomeDir(backupID, overrideBucket, overridePath string) string {
+	return "memory://" + backupID
+}
+
+func (b *memBackend) GetObject(ctx context.Context, backupID, key, overrideBucket, overridePath string) ([]byte, error) {
+	data, ok := b.objects.get(backupID + "/" + key)
+	if !ok {
+		return nil, backup.NewErrNotFound(os.ErrNotExist)
+	}
+	return data, nil
+}
+
+func (b *memBackend) AllBackups(ctx context.Context) ([]*backup.DistributedBackupDescriptor, error) {
+	return nil, nil
+}
+
+func (b *memBackend) WriteToFile(ctx context.Context, backupID, key, destPath, overrideBucket, overridePath string) error {
+	data, err := b.GetObject(ctx, backupID, key, overrideBucket, overridePath)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(destPath, data, 0o644)
+}
+
+func (b *memBackend) SourceDataPath() string { return b.dataPath }
+
+func (b *memBackend) PutObject(ctx context.Context, backupID, key, overrideBucket, overridePath string, data []byte) error {
+	b.objects.put(backupID+"/"+key, data)
+	return nil
+}
+
+func (b *memBackend) Initialize(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	return nil
+}
+
+func (b *memBackend) Write(ctx context.Context, backupID, key, overrideBucket, overridePath string, r io.ReadCloser) (int64, error) {
+	defer r.Close()
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return 0, err
+	}
+	b.objects.put(backupID+"/"+key, data)
+	return int64(len(data)), nil
+}
+
+func (b *memBackend) Read(ctx context.Context, backupID, key, overrideBucket, overridePath string, w io.WriteCloser) (int64, error) {
+	defer w.Close()
+	data, err := b.GetObject(ctx, backupID, key, overrideBucket, overridePath)
+	if err != nil {
+		return 0, err
+	}
+	return io.Copy(w, bytes.NewReader(data))
+}
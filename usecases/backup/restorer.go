@@ -16,6 +16,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
 	"reflect"
 	"sync"
 	"time"
@@ -112,7 +113,7 @@ func (r *restorer) restore(
 		overrideBucket := req.Bucket
 		overridePath := req.Path
 
-		err = r.restoreAll(context.Background(), desc, req.CPUPercentage, store, overrideBucket, overridePath)
+		err = r.restoreAll(context.Background(), desc, req.CPUPercentage, store, overrideBucket, overridePath, req.RestoreUntil)
 		logFields := logrus.Fields{"action": "restore", "backup_id": req.ID}
 		if err != nil {
 			r.logger.WithFields(logFields).Error(err)
@@ -130,13 +131,29 @@ func (r *restorer) restore(
 func (r *restorer) restoreAll(ctx context.Context,
 	desc *backup.BackupDescriptor, cpuPercentage int,
 	store nodeStore, overrideBucket, overridePath string,
+	until time.Time,
 ) (err error) {
 	compressed := desc.Version > version1
 	r.lastOp.set(backup.Transferring)
 	for _, cdesc := range desc.Classes {
-		if err := r.restoreOne(ctx, &cdesc, desc.ServerVersion, compressed, cpuPercentage, store, overrideBucket, overridePath); err != nil {
+		if err := r.restoreOne(ctx, &cdesc, desc.ID, desc.ServerVersion, compressed, cpuPercentage, store, overrideBucket, overridePath); err != nil {
 			return fmt.Errorf("restore class %s: %w", cdesc.Name, err)
 		}
+		if !until.IsZero() && len(cdesc.Shards) > 0 {
+			classDir := path.Join(store.SourceDataPath(), TempDirectory, cdesc.Name)
+			restored, err := replayArchive(ctx, nodeArchiveStore(store, desc.ID), classDir, desc.StartedAt, until)
+			if err != nil {
+				return fmt.Errorf("restore class %s until %s: %w", cdesc.Name, until.Format(time.RFC3339), err)
+			}
+			// the archive is replayed in rounds, so the data is restored to the
+			// last round before until rather than until itself
+			r.logger.WithField("action", "restore").
+				WithField("backup_id", desc.ID).
+				WithField("class", cdesc.Name).
+				WithField("restore_until", until.Format(time.RFC3339Nano)).
+				WithField("restored_until", restored.Format(time.RFC3339Nano)).
+				Info("replayed archived logs")
+		}
 		r.logger.WithField("action", "restore").
 			WithField("backup_id", desc.ID).
 			WithField("class", cdesc.Name).Info("successfully restored")
@@ -153,7 +170,7 @@ func getType(myvar interface{}) string {
 }
 
 func (r *restorer) restoreOne(ctx context.Context,
-	desc *backup.ClassDescriptor, serverVersion string,
+	desc *backup.ClassDescriptor, backupID, serverVersion string,
 	compressed bool, cpuPercentage int, store nodeStore,
 	overrideBucket, overridePath string,
 ) (err error) {
@@ -169,6 +186,7 @@ func (r *restorer) restoreOne(ctx context.Context,
 
 	fw := newFileWriter(r.sourcer, store, compressed, r.logger).
 		WithPoolPercentage(cpuPercentage)
+	fw.setBaseStore(func(id string) nodeStore { return baseNodeStore(store, backupID, id) })
 
 	// Pre-v1.23 versions store files in a flat format
 	if serverVersion < "1.23" {
@@ -234,6 +252,27 @@ func (r *restorer) validate(ctx context.Context, store *nodeStore, req *Request)
 		}
 		meta.Include(req.Classes)
 	}
+	if len(meta.EncryptionKeyIDs()) > 0 {
+		if err := r.sourcer.ValidateEncryptionKeys(ctx, meta); err != nil {
+			return nil, cs, fmt.Errorf("backup %s: %w", req.ID, err)
+		}
+	}
+	if !req.RestoreUntil.IsZero() {
+		if _, err := checkArchive(ctx, nodeArchiveStore(*store, req.ID), meta.StartedAt); err != nil {
+			return nil, cs, fmt.Errorf("backup %s cannot be restored until %s: %w",
+				req.ID, req.RestoreUntil.Format(time.RFC3339), err)
+		}
+	}
+	for _, id := range meta.BaseBackupIDs() {
+		base := baseNodeStore(*store, req.ID, id)
+		bmeta, err := base.Meta(ctx, id, req.Bucket, req.Path, false)
+		if err != nil {
+			return nil, cs, fmt.Errorf("backup %s builds upon backup %s: %w", req.ID, id, err)
+		}
+		if bmeta.Status != string(backup.Success) {
+			return nil, cs, fmt.Errorf("backup %s builds upon backup %s with status %s", req.ID, id, bmeta.Status)
+		}
+	}
 	return meta, cs, nil
 }
 
@@ -452,6 +452,33 @@ func TestManagerRestoreBackup(t *testing.T) {
 		assert.Equal(t, backup.Success, lastStatus.Status)
 	})
 
+	t.Run("MissingEncryptionKey", func(t *testing.T) {
+		var (
+			req1 = BackupRequest{
+				ID:      backupID,
+				Include: []string{cls},
+				Backend: backendName,
+			}
+			backend = newFakeBackend()
+			sourcer = &fakeSourcer{}
+			meta    = meta2
+		)
+		meta.Classes = []backup.ClassDescriptor{meta2.Classes[0]}
+		meta.Classes[0].Shards = []*backup.ShardDescriptor{{
+			Name: "Shard1", Node: "Node-1", Chunk: 1,
+			EncryptionKeyIDs: []string{"k2", "k1"},
+		}}
+		sourcer.On("ValidateEncryptionKeys", mock.Anything, mock.Anything).Return(ErrAny)
+		backend.On("GetObject", ctx, nodeHome, BackupFile).Return(marshalMeta(meta), nil)
+		backend.On("HomeDir", mock.Anything, mock.Anything, mock.Anything).Return(path)
+		m := createManager(sourcer, nil, backend, nil)
+		resp, err := m.Restore(ctx, nil, &req1)
+		assert.Nil(t, resp)
+		assert.ErrorContains(t, err, ErrAny.Error())
+		assert.IsType(t, backup.ErrUnprocessable{}, err)
+		sourcer.AssertExpectations(t)
+	})
+
 	readSourceFile := func(t *testing.T, newVersion bool) {
 		req1 := BackupRequest{
 			ID:      backupID,
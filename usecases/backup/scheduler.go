@@ -133,13 +133,14 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		return nil, backup.NewErrUnprocessable(fmt.Errorf("init uploader: %w", err))
 	}
 	breq := Request{
-		Method:      OpCreate,
-		ID:          req.ID,
-		Backend:     req.Backend,
-		Classes:     classes,
-		Compression: req.Compression,
-		Bucket:      req.Bucket,
-		Path:        req.Path,
+		Method:       OpCreate,
+		ID:           req.ID,
+		Backend:      req.Backend,
+		Classes:      classes,
+		Compression:  req.Compression,
+		Bucket:       req.Bucket,
+		Path:         req.Path,
+		BaseBackupID: req.BaseBackupID,
 	}
 	if err := s.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -196,13 +197,14 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 	}
 
 	rReq := Request{
-		Method:      OpRestore,
-		ID:          req.ID,
-		Backend:     req.Backend,
-		Compression: req.Compression,
-		Classes:     meta.Classes(),
-		Bucket:      req.Bucket,
-		Path:        req.Path,
+		Method:       OpRestore,
+		ID:           req.ID,
+		Backend:      req.Backend,
+		Compression:  req.Compression,
+		Classes:      meta.Classes(),
+		Bucket:       req.Bucket,
+		Path:         req.Path,
+		RestoreUntil: req.RestoreUntil,
 	}
 	err = s.restorer.Restore(ctx, store, &rReq, meta, schema)
 	if err != nil {
@@ -356,9 +358,34 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if err := s.checkIfBackupExists(ctx, store, req); err != nil {
 		return nil, err
 	}
+	if req.BaseBackupID != "" {
+		if err := s.checkBaseBackup(ctx, store, req); err != nil {
+			return nil, err
+		}
+	}
 	return classes, nil
 }
 
+// checkBaseBackup makes sure an incremental backup builds upon a successful
+// backup on the same backend
+func (s *Scheduler) checkBaseBackup(ctx context.Context, store coordStore, req *BackupRequest) error {
+	if err := validateID(req.BaseBackupID); err != nil {
+		return fmt.Errorf("base backup: %w", err)
+	}
+	if req.BaseBackupID == req.ID {
+		return fmt.Errorf("backup %q cannot be its own base backup", req.ID)
+	}
+	base := coordStore{objectStore{store.backend, req.BaseBackupID, store.bucket, store.path}}
+	meta, err := base.Meta(ctx, GlobalBackupFile, req.Bucket, req.Path)
+	if err != nil {
+		return fmt.Errorf("base backup %q: %w", req.BaseBackupID, err)
+	}
+	if meta.Status != backup.Success {
+		return fmt.Errorf("base backup %q has status %s", req.BaseBackupID, meta.Status)
+	}
+	return nil
+}
+
 func (s *Scheduler) checkIfBackupExists(ctx context.Context, store coordStore, req *BackupRequest) error {
 	destPath := store.HomeDir(req.Bucket, req.Path)
 	// there is no backup with given id on the backend, regardless of its state (valid or corrupted)
@@ -404,6 +431,10 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 	if v := meta.Version; v[0] > Version[0] {
 		return nil, fmt.Errorf("%s: %s > %s", errMsgHigherVersion, v, Version)
 	}
+	if until := req.RestoreUntil; !until.IsZero() && !until.After(meta.CompletedAt) {
+		return nil, fmt.Errorf("point in time %s must be after the backup completed at %s",
+			until.Format(time.RFC3339), meta.CompletedAt.Format(time.RFC3339))
+	}
 	cs := meta.Classes()
 	if len(req.Include) > 0 {
 		if first := meta.AllExist(req.Include); first != "" {
@@ -42,4 +42,8 @@ type Sourcer interface { // implemented by the index
 	//
 	// A class cannot be backed up either if it doesn't exist or if it has more than one physical shard.
 	ListBackupable() []string
+
+	// ValidateEncryptionKeys returns an error if any of the keys the files
+	// in desc are encrypted with is not available to decrypt them.
+	ValidateEncryptionKeys(ctx context.Context, desc *backup.BackupDescriptor) error
 }
@@ -54,6 +54,12 @@ type Request struct {
 
 	// Additional path prefix override
 	Path string
+
+	// BaseBackupID makes the backup incremental to the given backup
+	BaseBackupID string
+
+	// RestoreUntil makes the restore replay the archived logs up to this time
+	RestoreUntil time.Time
 }
 
 type CanCommitResponse struct {
@@ -179,6 +179,10 @@ type unzip struct {
 	gzr        *gzip.Reader
 	r          *tar.Reader
 	pipeReader *io.PipeReader
+
+	// files restricts extraction to the given paths if not nil. Extracted
+	// files are removed from the set.
+	files map[string]struct{}
 }
 
 func NewUnzip(dst string) (unzip, io.WriteCloser) {
@@ -242,6 +246,12 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 				return written, fmt.Errorf("crateDir %s: %w", target, err)
 			}
 		case tar.TypeReg:
+			if u.files != nil {
+				if _, ok := u.files[header.Name]; !ok {
+					continue
+				}
+				delete(u.files, header.Name)
+			}
 			if pp := filepath.Dir(target); pp != parentPath {
 				parentPath = pp
 				if err := os.MkdirAll(parentPath, 0o755); err != nil {
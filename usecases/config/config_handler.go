@@ -33,6 +33,8 @@ import (
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/usecases/cluster"
 	"github.com/weaviate/weaviate/usecases/monitoring"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+	"github.com/weaviate/weaviate/usecases/tracing"
 )
 
 // ServerVersion is deprecated. Use `build.Version`. It's there for backward compatiblility.
@@ -103,54 +105,58 @@ type RuntimeOverrides struct {
 
 // Config outline of the config file
 type Config struct {
-	Name                                string                   `json:"name" yaml:"name"`
-	Debug                               bool                     `json:"debug" yaml:"debug"`
-	QueryDefaults                       QueryDefaults            `json:"query_defaults" yaml:"query_defaults"`
-	QueryMaximumResults                 int64                    `json:"query_maximum_results" yaml:"query_maximum_results"`
-	QueryNestedCrossReferenceLimit      int64                    `json:"query_nested_cross_reference_limit" yaml:"query_nested_cross_reference_limit"`
-	QueryCrossReferenceDepthLimit       int                      `json:"query_cross_reference_depth_limit" yaml:"query_cross_reference_depth_limit"`
-	Contextionary                       Contextionary            `json:"contextionary" yaml:"contextionary"`
-	Authentication                      Authentication           `json:"authentication" yaml:"authentication"`
-	Authorization                       Authorization            `json:"authorization" yaml:"authorization"`
-	Origin                              string                   `json:"origin" yaml:"origin"`
-	Persistence                         Persistence              `json:"persistence" yaml:"persistence"`
-	DefaultVectorizerModule             string                   `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
-	DefaultVectorDistanceMetric         string                   `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
-	EnableModules                       string                   `json:"enable_modules" yaml:"enable_modules"`
-	EnableApiBasedModules               bool                     `json:"enable_api_based_modules" yaml:"enable_api_based_modules"`
-	ModulesPath                         string                   `json:"modules_path" yaml:"modules_path"`
-	ModuleHttpClientTimeout             time.Duration            `json:"modules_client_timeout" yaml:"modules_client_timeout"`
-	AutoSchema                          AutoSchema               `json:"auto_schema" yaml:"auto_schema"`
-	Cluster                             cluster.Config           `json:"cluster" yaml:"cluster"`
-	Replication                         replication.GlobalConfig `json:"replication" yaml:"replication"`
-	Monitoring                          monitoring.Config        `json:"monitoring" yaml:"monitoring"`
-	GRPC                                GRPC                     `json:"grpc" yaml:"grpc"`
-	Profiling                           Profiling                `json:"profiling" yaml:"profiling"`
-	ResourceUsage                       ResourceUsage            `json:"resource_usage" yaml:"resource_usage"`
-	MaxImportGoroutinesFactor           float64                  `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
-	MaximumConcurrentGetRequests        int                      `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
-	MaximumConcurrentShardLoads         int                      `json:"maximum_concurrent_shard_loads" yaml:"maximum_concurrent_shard_loads"`
-	TrackVectorDimensions               bool                     `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
-	ReindexVectorDimensionsAtStartup    bool                     `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
-	DisableLazyLoadShards               bool                     `json:"disable_lazy_load_shards" yaml:"disable_lazy_load_shards"`
-	ForceFullReplicasSearch             bool                     `json:"force_full_replicas_search" yaml:"force_full_replicas_search"`
-	RecountPropertiesAtStartup          bool                     `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
-	ReindexSetToRoaringsetAtStartup     bool                     `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
-	ReindexerGoroutinesFactor           float64                  `json:"reindexer_goroutines_factor" yaml:"reindexer_goroutines_factor"`
-	ReindexMapToBlockmaxAtStartup       bool                     `json:"reindex_map_to_blockmax_at_startup" yaml:"reindex_map_to_blockmax_at_startup"`
-	ReindexMapToBlockmaxConfig          MapToBlockamaxConfig     `json:"reindex_map_to_blockmax_config" yaml:"reindex_map_to_blockmax_config"`
-	IndexMissingTextFilterableAtStartup bool                     `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
-	DisableGraphQL                      bool                     `json:"disable_graphql" yaml:"disable_graphql"`
-	AvoidMmap                           bool                     `json:"avoid_mmap" yaml:"avoid_mmap"`
-	CORS                                CORS                     `json:"cors" yaml:"cors"`
-	DisableTelemetry                    bool                     `json:"disable_telemetry" yaml:"disable_telemetry"`
-	HNSWStartupWaitForVectorCache       bool                     `json:"hnsw_startup_wait_for_vector_cache" yaml:"hnsw_startup_wait_for_vector_cache"`
-	HNSWVisitedListPoolMaxSize          int                      `json:"hnsw_visited_list_pool_max_size" yaml:"hnsw_visited_list_pool_max_size"`
-	HNSWFlatSearchConcurrency           int                      `json:"hnsw_flat_search_concurrency" yaml:"hnsw_flat_search_concurrency"`
-	HNSWAcornFilterRatio                float64                  `json:"hnsw_acorn_filter_ratio" yaml:"hnsw_acorn_filter_ratio"`
-	Sentry                              *entsentry.ConfigOpts    `json:"sentry" yaml:"sentry"`
-	MetadataServer                      MetadataServer           `json:"metadata_server" yaml:"metadata_server"`
-	SchemaHandlerConfig                 SchemaHandlerConfig      `json:"schema" yaml:"schema"`
+	Name                                string                     `json:"name" yaml:"name"`
+	Debug                               bool                       `json:"debug" yaml:"debug"`
+	QueryDefaults                       QueryDefaults              `json:"query_defaults" yaml:"query_defaults"`
+	QueryMaximumResults                 int64                      `json:"query_maximum_results" yaml:"query_maximum_results"`
+	QueryNestedCrossReferenceLimit      int64                      `json:"query_nested_cross_reference_limit" yaml:"query_nested_cross_reference_limit"`
+	QueryCrossReferenceDepthLimit       int                        `json:"query_cross_reference_depth_limit" yaml:"query_cross_reference_depth_limit"`
+	Contextionary                       Contextionary              `json:"contextionary" yaml:"contextionary"`
+	Authentication                      Authentication             `json:"authentication" yaml:"authentication"`
+	Authorization                       Authorization              `json:"authorization" yaml:"authorization"`
+	Origin                              string                     `json:"origin" yaml:"origin"`
+	Persistence                         Persistence                `json:"persistence" yaml:"persistence"`
+	DefaultVectorizerModule             string                     `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
+	DefaultVectorDistanceMetric         string                     `json:"default_vector_distance_metric" yaml:"default_vector_distance_metric"`
+	EnableModules                       string                     `json:"enable_modules" yaml:"enable_modules"`
+	EnableApiBasedModules               bool                       `json:"enable_api_based_modules" yaml:"enable_api_based_modules"`
+	ModulesPath                         string                     `json:"modules_path" yaml:"modules_path"`
+	ModuleHttpClientTimeout             time.Duration              `json:"modules_client_timeout" yaml:"modules_client_timeout"`
+	AutoSchema                          AutoSchema                 `json:"auto_schema" yaml:"auto_schema"`
+	Cluster                             cluster.Config             `json:"cluster" yaml:"cluster"`
+	Replication                         replication.GlobalConfig   `json:"replication" yaml:"replication"`
+	Monitoring                          monitoring.Config          `json:"monitoring" yaml:"monitoring"`
+	Tracing                             tracing.Config             `json:"tracing" yaml:"tracing"`
+	RateLimits                          ratelimiter.Config         `json:"rate_limits" yaml:"rate_limits"`
+	RateLimitsFn                        func() *ratelimiter.Config `json:"-" yaml:"-"`
+	GRPC                                GRPC                       `json:"grpc" yaml:"grpc"`
+	Profiling                           Profiling                  `json:"profiling" yaml:"profiling"`
+	ResourceUsage                       ResourceUsage              `json:"resource_usage" yaml:"resource_usage"`
+	MaxImportGoroutinesFactor           float64                    `json:"max_import_goroutine_factor" yaml:"max_import_goroutine_factor"`
+	MaximumConcurrentGetRequests        int                        `json:"maximum_concurrent_get_requests" yaml:"maximum_concurrent_get_requests"`
+	MaximumConcurrentShardLoads         int                        `json:"maximum_concurrent_shard_loads" yaml:"maximum_concurrent_shard_loads"`
+	TrackVectorDimensions               bool                       `json:"track_vector_dimensions" yaml:"track_vector_dimensions"`
+	ChangeDataCapture                   ChangeDataCapture          `json:"change_data_capture" yaml:"change_data_capture"`
+	ReindexVectorDimensionsAtStartup    bool                       `json:"reindex_vector_dimensions_at_startup" yaml:"reindex_vector_dimensions_at_startup"`
+	DisableLazyLoadShards               bool                       `json:"disable_lazy_load_shards" yaml:"disable_lazy_load_shards"`
+	ForceFullReplicasSearch             bool                       `json:"force_full_replicas_search" yaml:"force_full_replicas_search"`
+	RecountPropertiesAtStartup          bool                       `json:"recount_properties_at_startup" yaml:"recount_properties_at_startup"`
+	ReindexSetToRoaringsetAtStartup     bool                       `json:"reindex_set_to_roaringset_at_startup" yaml:"reindex_set_to_roaringset_at_startup"`
+	ReindexerGoroutinesFactor           float64                    `json:"reindexer_goroutines_factor" yaml:"reindexer_goroutines_factor"`
+	ReindexMapToBlockmaxAtStartup       bool                       `json:"reindex_map_to_blockmax_at_startup" yaml:"reindex_map_to_blockmax_at_startup"`
+	ReindexMapToBlockmaxConfig          MapToBlockamaxConfig       `json:"reindex_map_to_blockmax_config" yaml:"reindex_map_to_blockmax_config"`
+	IndexMissingTextFilterableAtStartup bool                       `json:"index_missing_text_filterable_at_startup" yaml:"index_missing_text_filterable_at_startup"`
+	DisableGraphQL                      bool                       `json:"disable_graphql" yaml:"disable_graphql"`
+	AvoidMmap                           bool                       `json:"avoid_mmap" yaml:"avoid_mmap"`
+	CORS                                CORS                       `json:"cors" yaml:"cors"`
+	DisableTelemetry                    bool                       `json:"disable_telemetry" yaml:"disable_telemetry"`
+	HNSWStartupWaitForVectorCache       bool                       `json:"hnsw_startup_wait_for_vector_cache" yaml:"hnsw_startup_wait_for_vector_cache"`
+	HNSWVisitedListPoolMaxSize          int                        `json:"hnsw_visited_list_pool_max_size" yaml:"hnsw_visited_list_pool_max_size"`
+	HNSWFlatSearchConcurrency           int                        `json:"hnsw_flat_search_concurrency" yaml:"hnsw_flat_search_concurrency"`
+	HNSWAcornFilterRatio                float64                    `json:"hnsw_acorn_filter_ratio" yaml:"hnsw_acorn_filter_ratio"`
+	Sentry                              *entsentry.ConfigOpts      `json:"sentry" yaml:"sentry"`
+	MetadataServer                      MetadataServer             `json:"metadata_server" yaml:"metadata_server"`
+	SchemaHandlerConfig                 SchemaHandlerConfig        `json:"schema" yaml:"schema"`
 
 	// Raft Specific configuration
 	// TODO-RAFT: Do we want to be able to specify these with config file as well ?
@@ -162,6 +168,15 @@ type Config struct {
 	RuntimeOverrides RuntimeOverrides `json:"runtime_overrides" yaml:"runtime_overrides"`
 }
 
+// ChangeDataCapture configures the per-shard change log of object mutations
+// which can be streamed by consumers.
+type ChangeDataCapture struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Retention is the duration changes are kept for. Changes are never
+	// removed if it is zero.
+	Retention time.Duration `json:"retention" yaml:"retention"`
+}
+
 type MapToBlockamaxConfig struct {
 	SwapBuckets               bool                     `json:"swap_buckets" yaml:"swap_buckets"`
 	UnswapBuckets             bool                     `json:"unswap_buckets" yaml:"unswap_buckets"`
@@ -210,6 +225,14 @@ func (c *Config) Validate() error {
 		return configErr(err)
 	}
 
+	if err := c.Tracing.Validate(); err != nil {
+		return configErr(err)
+	}
+
+	if err := c.RateLimits.Validate(); err != nil {
+		return configErr(err)
+	}
+
 	return nil
 }
 
@@ -288,10 +311,25 @@ type Contextionary struct {
 
 // Support independent TLS credentials for gRPC
 type GRPC struct {
-	Port       int    `json:"port" yaml:"port"`
-	CertFile   string `json:"certFile" yaml:"certFile"`
-	KeyFile    string `json:"keyFile" yaml:"keyFile"`
-	MaxMsgSize int    `json:"maxMsgSize" yaml:"maxMsgSize"`
+	Port        int             `json:"port" yaml:"port"`
+	CertFile    string          `json:"certFile" yaml:"certFile"`
+	KeyFile     string          `json:"keyFile" yaml:"keyFile"`
+	MaxMsgSize  int             `json:"maxMsgSize" yaml:"maxMsgSize"`
+	BatchStream GRPCBatchStream `json:"batchStream" yaml:"batchStream"`
+}
+
+// GRPCBatchStream configures the flow control of streaming batch imports.
+// Clients are asked to slow down once the vector index queues or the memory
+// usage grow beyond the configured thresholds.
+type GRPCBatchStream struct {
+	// MaxQueueBacklog is the number of vectors waiting in the vector index
+	// queues of this node at which clients are asked to back off completely.
+	MaxQueueBacklog int64 `json:"maxQueueBacklog" yaml:"maxQueueBacklog"`
+	// MemoryThreshold is the ratio of the memory limit above which clients
+	// are asked to slow down.
+	MemoryThreshold float64 `json:"memoryThreshold" yaml:"memoryThreshold"`
+	// MaxBackoff is the longest pause requested from clients.
+	MaxBackoff time.Duration `json:"maxBackoff" yaml:"maxBackoff"`
 }
 
 type Profiling struct {
@@ -311,8 +349,13 @@ type Persistence struct {
 	LSMSegmentsCleanupIntervalSeconds   int    `json:"lsmSegmentsCleanupIntervalSeconds" yaml:"lsmSegmentsCleanupIntervalSeconds"`
 	LSMSeparateObjectsCompactions       bool   `json:"lsmSeparateObjectsCompactions" yaml:"lsmSeparateObjectsCompactions"`
 	LSMEnableSegmentsChecksumValidation bool   `json:"lsmEnableSegmentsChecksumValidation" yaml:"lsmEnableSegmentsChecksumValidation"`
+	LSMObjectsBlockCompression          string `json:"lsmObjectsBlockCompression" yaml:"lsmObjectsBlockCompression"`
 	LSMCycleManagerRoutinesFactor       int    `json:"lsmCycleManagerRoutinesFactor" yaml:"lsmCycleManagerRoutinesFactor"`
 	HNSWMaxLogSize                      int64  `json:"hnswMaxLogSize" yaml:"hnswMaxLogSize"`
+	EncryptionKeyfile                   string `json:"encryptionKeyfile" yaml:"encryptionKeyfile"`
+	EncryptionCreateMissingTenantKeys   bool   `json:"encryptionCreateMissingTenantKeys" yaml:"encryptionCreateMissingTenantKeys"`
+	LogArchiveBackend                   string `json:"logArchiveBackend" yaml:"logArchiveBackend"`
+	LogArchiveIntervalSeconds           int    `json:"logArchiveIntervalSeconds" yaml:"logArchiveIntervalSeconds"`
 }
 
 // DefaultPersistenceDataPath is the default location for data directory when no location is provided
@@ -333,6 +376,10 @@ const DefaultPersistenceLSMCycleManagerRoutinesFactor = 2
 
 const DefaultPersistenceHNSWMaxLogSize = 500 * 1024 * 1024 // 500MB for backward compatibility
 
+// DefaultPersistenceLogArchiveIntervalSeconds is how often the commit logs
+// are shipped to the archive, if archiving is enabled
+const DefaultPersistenceLogArchiveIntervalSeconds = 10
+
 const (
 	DefaultReindexerGoroutinesFactor = 0.5
 
@@ -363,6 +410,13 @@ func (p Persistence) Validate() error {
 		return fmt.Errorf("persistence.dataPath must be set")
 	}
 
+	switch p.LSMObjectsBlockCompression {
+	case "", "none", "snappy", "zstd":
+	default:
+		return fmt.Errorf("persistence.lsmObjectsBlockCompression must be one of "+
+			"none, snappy or zstd, got %q", p.LSMObjectsBlockCompression)
+	}
+
 	return nil
 }
 
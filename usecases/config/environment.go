@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/sentry"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/tracing"
 )
 
 const (
@@ -41,6 +42,12 @@ const (
 	DefaultHNSWAcornFilterRatio = 0.4
 
 	DefaultRuntimeOverridesLoadInterval = 2 * time.Minute
+
+	DefaultChangeDataCaptureRetention = 24 * time.Hour
+
+	DefaultGRPCBatchStreamMaxQueueBacklog = 1_000_000
+	DefaultGRPCBatchStreamMemoryThreshold = 0.8
+	DefaultGRPCBatchStreamMaxBackoff      = 5 * time.Second
 )
 
 // FromEnv takes a *Config as it will respect initial config that has been
@@ -84,6 +91,23 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if entcfg.Enabled(os.Getenv("CHANGE_DATA_CAPTURE_ENABLED")) {
+		config.ChangeDataCapture.Enabled = true
+	}
+
+	if v := os.Getenv("CHANGE_DATA_CAPTURE_RETENTION"); v != "" {
+		retention, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse CHANGE_DATA_CAPTURE_RETENTION as time.Duration: %w", err)
+		}
+		if retention < 0 {
+			return fmt.Errorf("CHANGE_DATA_CAPTURE_RETENTION must not be negative")
+		}
+		config.ChangeDataCapture.Retention = retention
+	} else {
+		config.ChangeDataCapture.Retention = DefaultChangeDataCaptureRetention
+	}
+
 	if entcfg.Enabled(os.Getenv("DISABLE_LAZY_LOAD_SHARDS")) {
 		config.DisableLazyLoadShards = true
 	}
@@ -131,6 +155,55 @@ func FromEnv(config *Config) error {
 		config.Monitoring.Port = asInt
 	}
 
+	if entcfg.Enabled(os.Getenv("TRACING_ENABLED")) {
+		config.Tracing.Enabled = true
+		config.Tracing.Endpoint = os.Getenv("TRACING_OTLP_ENDPOINT")
+		if config.Tracing.Endpoint == "" {
+			return fmt.Errorf("TRACING_OTLP_ENDPOINT must be set if tracing is enabled")
+		}
+
+		config.Tracing.ServiceName = tracing.DefaultServiceName
+		if v := strings.TrimSpace(os.Getenv("TRACING_SERVICE_NAME")); v != "" {
+			config.Tracing.ServiceName = v
+		}
+
+		if err := parsePercentage("TRACING_SAMPLE_RATIO", func(val float64) {
+			config.Tracing.SampleRatio = val
+		}, tracing.DefaultSampleRatio); err != nil {
+			return err
+		}
+
+		// variable expects string in format "key1=value1,key2=value2"
+		if v := os.Getenv("TRACING_OTLP_HEADERS"); v != "" {
+			config.Tracing.Headers = map[string]string{}
+			for _, header := range strings.Split(v, ",") {
+				key, value, ok := strings.Cut(header, "=")
+				if !ok || strings.TrimSpace(key) == "" {
+					return fmt.Errorf("parse TRACING_OTLP_HEADERS: expected key=value, got %q", header)
+				}
+				config.Tracing.Headers[strings.TrimSpace(key)] = strings.TrimSpace(value)
+			}
+		}
+	}
+
+	if err := parseNonNegativeFloat("RATE_LIMITS_REQUESTS_PER_SECOND", func(val float64) {
+		config.RateLimits.Default.RequestsPerSecond = val
+	}, 0); err != nil {
+		return err
+	}
+
+	if err := parseNonNegativeInt("RATE_LIMITS_MAX_CONCURRENT_REQUESTS", func(val int) {
+		config.RateLimits.Default.MaxConcurrentRequests = val
+	}, 0); err != nil {
+		return err
+	}
+
+	if err := parseNonNegativeFloat("RATE_LIMITS_INGEST_BYTES_PER_SECOND", func(val float64) {
+		config.RateLimits.Default.IngestBytesPerSecond = val
+	}, 0); err != nil {
+		return err
+	}
+
 	if v := os.Getenv("GO_PROFILING_PORT"); v != "" {
 		asInt, err := strconv.Atoi(v)
 		if err != nil {
@@ -276,6 +349,30 @@ func FromEnv(config *Config) error {
 		config.Persistence.LSMEnableSegmentsChecksumValidation = true
 	}
 
+	if v := os.Getenv("PERSISTENCE_LSM_OBJECTS_BLOCK_COMPRESSION"); v != "" {
+		config.Persistence.LSMObjectsBlockCompression = v
+	}
+
+	if v := os.Getenv("PERSISTENCE_ENCRYPTION_KEYFILE"); v != "" {
+		config.Persistence.EncryptionKeyfile = v
+	}
+
+	if entcfg.Enabled(os.Getenv("PERSISTENCE_ENCRYPTION_CREATE_MISSING_TENANT_KEYS")) {
+		config.Persistence.EncryptionCreateMissingTenantKeys = true
+	}
+
+	if v := os.Getenv("PERSISTENCE_LOG_ARCHIVE_BACKEND"); v != "" {
+		config.Persistence.LogArchiveBackend = v
+	}
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_LOG_ARCHIVE_INTERVAL_SECONDS",
+		func(seconds int) { config.Persistence.LogArchiveIntervalSeconds = seconds },
+		DefaultPersistenceLogArchiveIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
 	if err := parseInt(
 		"PERSISTENCE_LSM_CYCLEMANAGER_ROUTINES_FACTOR",
 		func(factor int) { config.Persistence.LSMCycleManagerRoutinesFactor = factor },
@@ -556,6 +653,32 @@ func FromEnv(config *Config) error {
 	if v := os.Getenv("GRPC_KEY_FILE"); v != "" {
 		config.GRPC.KeyFile = v
 	}
+	if err := parsePositiveInt(
+		"GRPC_BATCH_STREAM_MAX_QUEUE_BACKLOG",
+		func(val int) { config.GRPC.BatchStream.MaxQueueBacklog = int64(val) },
+		DefaultGRPCBatchStreamMaxQueueBacklog,
+	); err != nil {
+		return err
+	}
+	if err := parsePercentage(
+		"GRPC_BATCH_STREAM_MEMORY_THRESHOLD",
+		func(val float64) { config.GRPC.BatchStream.MemoryThreshold = val },
+		DefaultGRPCBatchStreamMemoryThreshold,
+	); err != nil {
+		return err
+	}
+	if v := os.Getenv("GRPC_BATCH_STREAM_MAX_BACKOFF"); v != "" {
+		backoff, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("parse GRPC_BATCH_STREAM_MAX_BACKOFF as time.Duration: %w", err)
+		}
+		if backoff <= 0 {
+			return fmt.Errorf("GRPC_BATCH_STREAM_MAX_BACKOFF must be positive")
+		}
+		config.GRPC.BatchStream.MaxBackoff = backoff
+	} else {
+		config.GRPC.BatchStream.MaxBackoff = DefaultGRPCBatchStreamMaxBackoff
+	}
 
 	config.DisableGraphQL = entcfg.Enabled(os.Getenv("DISABLE_GRAPHQL"))
 
@@ -577,6 +700,32 @@ func FromEnv(config *Config) error {
 		config.Replication.DeletionStrategy = v
 	}
 
+	config.Replication.Rebalance.Auto = entcfg.Enabled(os.Getenv("REPLICATION_AUTO_REBALANCE_ENABLED"))
+
+	if err := parsePositiveInt(
+		"REPLICATION_REBALANCE_INTERVAL_SECONDS",
+		func(val int) { config.Replication.Rebalance.Interval = time.Duration(val) * time.Second },
+		DefaultReplicationRebalanceIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"REPLICATION_REBALANCE_MAX_CONCURRENT_MOVES",
+		func(val int) { config.Replication.Rebalance.MaxConcurrentMoves = val },
+		DefaultReplicationRebalanceMaxConcurrentMoves,
+	); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"REPLICATION_REBALANCE_MAX_MOVES",
+		func(val int) { config.Replication.Rebalance.MaxMoves = val },
+		DefaultReplicationRebalanceMaxMoves,
+	); err != nil {
+		return err
+	}
+
 	config.DisableTelemetry = false
 	if entcfg.Enabled(os.Getenv("DISABLE_TELEMETRY")) {
 		config.DisableTelemetry = true
@@ -896,14 +1045,14 @@ func parsePositiveFloat(envName string, cb func(val float64), defaultValue float
 	})
 }
 
-// func parseNonNegativeFloat(envName string, cb func(val float64), defaultValue float64) error {
-// 	return parseFloatVerify(envName, defaultValue, cb, func(val float64) error {
-// 		if val < 0 {
-// 			return fmt.Errorf("%s must be a float greater than or equal 0. Got %v", envName, val)
-// 		}
-// 		return nil
-// 	})
-// }
+func parseNonNegativeFloat(envName string, cb func(val float64), defaultValue float64) error {
+	return parseFloatVerify(envName, defaultValue, cb, func(val float64) error {
+		if val < 0 {
+			return fmt.Errorf("%s must be a float greater than or equal 0. Got %v", envName, val)
+		}
+		return nil
+	})
+}
 
 func parseFloatVerify(envName string, defaultValue float64, cb func(val float64), verify func(val float64) error) error {
 	var err error
@@ -932,16 +1081,19 @@ const (
 )
 
 const (
-	DefaultPersistenceMemtablesFlushDirtyAfter = 60
-	DefaultPersistenceMemtablesMaxSize         = 200
-	DefaultPersistenceMemtablesMinDuration     = 15
-	DefaultPersistenceMemtablesMaxDuration     = 45
-	DefaultMaxConcurrentGetRequests            = 0
-	DefaultMaxConcurrentShardLoads             = 500
-	DefaultGRPCPort                            = 50051
-	DefaultGRPCMaxMsgSize                      = 104858000 // 100 * 1024 * 1024 + 400
-	DefaultMinimumReplicationFactor            = 1
-	DefaultMaximumAllowedCollectionsCount      = -1 // unlimited
+	DefaultPersistenceMemtablesFlushDirtyAfter    = 60
+	DefaultPersistenceMemtablesMaxSize            = 200
+	DefaultPersistenceMemtablesMinDuration        = 15
+	DefaultPersistenceMemtablesMaxDuration        = 45
+	DefaultMaxConcurrentGetRequests               = 0
+	DefaultMaxConcurrentShardLoads                = 500
+	DefaultGRPCPort                               = 50051
+	DefaultGRPCMaxMsgSize                         = 104858000 // 100 * 1024 * 1024 + 400
+	DefaultMinimumReplicationFactor               = 1
+	DefaultReplicationRebalanceIntervalSeconds    = 60
+	DefaultReplicationRebalanceMaxConcurrentMoves = 2
+	DefaultReplicationRebalanceMaxMoves           = 100
+	DefaultMaximumAllowedCollectionsCount         = -1 // unlimited
 )
 
 const VectorizerModuleNone = "none"
@@ -16,10 +16,13 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/usecases/cluster"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
+	"github.com/weaviate/weaviate/usecases/tracing"
 )
 
 const DefaultGoroutineFactor = 1.5
@@ -165,6 +168,35 @@ func TestEnvironmentPersistence_dataPath(t *testing.T) {
 	}
 }
 
+func TestEnvironmentPersistence_objectsBlockCompression(t *testing.T) {
+	factors := []struct {
+		name        string
+		value       []string
+		expected    string
+		expectedErr bool
+	}{
+		{"not given", []string{}, "", false},
+		{"snappy", []string{"snappy"}, "snappy", false},
+		{"zstd", []string{"zstd"}, "zstd", false},
+		{"unsupported", []string{"gzip"}, "gzip", true},
+	}
+	for _, tt := range factors {
+		t.Run(tt.name, func(t *testing.T) {
+			if len(tt.value) == 1 {
+				t.Setenv("PERSISTENCE_LSM_OBJECTS_BLOCK_COMPRESSION", tt.value[0])
+			}
+			conf := Config{Persistence: Persistence{DataPath: DefaultPersistenceDataPath}}
+			require.Nil(t, FromEnv(&conf))
+			require.Equal(t, tt.expected, conf.Persistence.LSMObjectsBlockCompression)
+			if tt.expectedErr {
+				require.NotNil(t, conf.Persistence.Validate())
+			} else {
+				require.Nil(t, conf.Persistence.Validate())
+			}
+		})
+	}
+}
+
 func TestEnvironmentMemtable_MaxSize(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -397,6 +429,76 @@ func TestEnvironmentMaxConcurrentGetRequests(t *testing.T) {
 	}
 }
 
+func TestEnvironmentTracing(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		t.Setenv("TRACING_OTLP_ENDPOINT", "http://collector:4318/v1/traces")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, tracing.Config{}, conf.Tracing)
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv("TRACING_ENABLED", "true")
+		t.Setenv("TRACING_OTLP_ENDPOINT", "http://collector:4318/v1/traces")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, tracing.Config{
+			Enabled:     true,
+			Endpoint:    "http://collector:4318/v1/traces",
+			ServiceName: tracing.DefaultServiceName,
+			SampleRatio: tracing.DefaultSampleRatio,
+		}, conf.Tracing)
+	})
+
+	t.Run("all set", func(t *testing.T) {
+		t.Setenv("TRACING_ENABLED", "true")
+		t.Setenv("TRACING_OTLP_ENDPOINT", "https://collector/v1/traces")
+		t.Setenv("TRACING_SERVICE_NAME", "weaviate-prod")
+		t.Setenv("TRACING_SAMPLE_RATIO", "0.25")
+		t.Setenv("TRACING_OTLP_HEADERS", "Authorization=Bearer abc, X-Tenant=a=b")
+		conf := Config{}
+		require.Nil(t, FromEnv(&conf))
+		assert.Equal(t, tracing.Config{
+			Enabled:     true,
+			Endpoint:    "https://collector/v1/traces",
+			ServiceName: "weaviate-prod",
+			SampleRatio: 0.25,
+			Headers:     map[string]string{"Authorization": "Bearer abc", "X-Tenant": "a=b"},
+		}, conf.Tracing)
+	})
+
+	for name, env := range map[string]map[string]string{
+		"missing endpoint": {},
+		"invalid ratio":    {"TRACING_OTLP_ENDPOINT": "http://collector", "TRACING_SAMPLE_RATIO": "2"},
+		"invalid headers":  {"TRACING_OTLP_ENDPOINT": "http://collector", "TRACING_OTLP_HEADERS": "token"},
+		"unparsable ratio": {"TRACING_OTLP_ENDPOINT": "http://collector", "TRACING_SAMPLE_RATIO": "all"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("TRACING_ENABLED", "true")
+			for k, v := range env {
+				t.Setenv(k, v)
+			}
+			require.NotNil(t, FromEnv(&Config{}))
+		})
+	}
+}
+
+func TestEnvironmentRateLimits(t *testing.T) {
+	t.Setenv("RATE_LIMITS_REQUESTS_PER_SECOND", "12.5")
+	t.Setenv("RATE_LIMITS_MAX_CONCURRENT_REQUESTS", "8")
+	t.Setenv("RATE_LIMITS_INGEST_BYTES_PER_SECOND", "1000000")
+	conf := Config{}
+	require.Nil(t, FromEnv(&conf))
+	assert.Equal(t, ratelimiter.Limits{
+		RequestsPerSecond:     12.5,
+		MaxConcurrentRequests: 8,
+		IngestBytesPerSecond:  1000000,
+	}, conf.RateLimits.Default)
+
+	t.Setenv("RATE_LIMITS_MAX_CONCURRENT_REQUESTS", "-1")
+	require.NotNil(t, FromEnv(&Config{}))
+}
+
 func TestEnvironmentCORS_Origin(t *testing.T) {
 	factors := []struct {
 		name        string
@@ -639,6 +741,35 @@ func TestEnvironmentMinimumReplicationFactor(t *testing.T) {
 	}
 }
 
+func TestEnvironmentReplicationRebalance(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+		assert.False(t, conf.Replication.Rebalance.Auto)
+		assert.Equal(t, DefaultReplicationRebalanceIntervalSeconds*time.Second, conf.Replication.Rebalance.Interval)
+		assert.Equal(t, DefaultReplicationRebalanceMaxConcurrentMoves, conf.Replication.Rebalance.MaxConcurrentMoves)
+		assert.Equal(t, DefaultReplicationRebalanceMaxMoves, conf.Replication.Rebalance.MaxMoves)
+	})
+
+	t.Run("given", func(t *testing.T) {
+		t.Setenv("REPLICATION_AUTO_REBALANCE_ENABLED", "true")
+		t.Setenv("REPLICATION_REBALANCE_INTERVAL_SECONDS", "10")
+		t.Setenv("REPLICATION_REBALANCE_MAX_CONCURRENT_MOVES", "4")
+		t.Setenv("REPLICATION_REBALANCE_MAX_MOVES", "20")
+		conf := Config{}
+		require.NoError(t, FromEnv(&conf))
+		assert.True(t, conf.Replication.Rebalance.Auto)
+		assert.Equal(t, 10*time.Second, conf.Replication.Rebalance.Interval)
+		assert.Equal(t, 4, conf.Replication.Rebalance.MaxConcurrentMoves)
+		assert.Equal(t, 20, conf.Replication.Rebalance.MaxMoves)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("REPLICATION_REBALANCE_MAX_CONCURRENT_MOVES", "0")
+		require.Error(t, FromEnv(&Config{}))
+	})
+}
+
 func TestEnvironmentQueryDefaults_Limit(t *testing.T) {
 	factors := []struct {
 		name     string
@@ -16,6 +16,7 @@ import (
 	"errors"
 	"io"
 
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 	"gopkg.in/yaml.v2"
 )
 
@@ -31,6 +32,9 @@ type WeaviateRuntimeConfig struct {
 
 	AsyncReplicationDisabled *bool `json:"async_replication_disabled" yaml:"async_replication_disabled"`
 
+	// RateLimits replace the limits per principal as a whole
+	RateLimits *ratelimiter.Config `json:"rate_limits" yaml:"rate_limits"`
+
 	// config manager that keep the runtime config up to date
 	cm ConfigManager
 }
@@ -62,6 +66,13 @@ func (rc *WeaviateRuntimeConfig) GetAsyncReplicationDisabled() *bool {
 	return nil
 }
 
+func (rc *WeaviateRuntimeConfig) GetRateLimits() *ratelimiter.Config {
+	if cfg, err := rc.cm.Config(); err == nil {
+		return cfg.RateLimits
+	}
+	return nil
+}
+
 func ParseYaml(buf []byte) (*WeaviateRuntimeConfig, error) {
 	var conf WeaviateRuntimeConfig
 
@@ -73,5 +84,10 @@ func ParseYaml(buf []byte) (*WeaviateRuntimeConfig, error) {
 	if err := dec.Decode(&conf); err != nil && !errors.Is(err, io.EOF) {
 		return nil, err
 	}
+	if conf.RateLimits != nil {
+		if err := conf.RateLimits.Validate(); err != nil {
+			return nil, err
+		}
+	}
 	return &conf, nil
 }
@@ -15,6 +15,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/ratelimiter"
 )
 
 func TestRuntimeConfig(t *testing.T) {
@@ -85,6 +86,37 @@ non_exist_filed: 78
 		_, err = ParseYaml(b)
 		require.Error(t, err)
 	})
+
+	t.Run("rate limits", func(t *testing.T) {
+		val := `
+rate_limits:
+  default:
+    requests_per_second: 10
+  users:
+    alice:
+      max_concurrent_requests: 4
+  roles:
+    importers:
+      ingest_bytes_per_second: 1048576
+`
+		v, err := ParseYaml([]byte(val))
+		require.NoError(t, err)
+		require.Equal(t, &ratelimiter.Config{
+			Default: ratelimiter.Limits{RequestsPerSecond: 10},
+			Users:   map[string]ratelimiter.Limits{"alice": {MaxConcurrentRequests: 4}},
+			Roles:   map[string]ratelimiter.Limits{"importers": {IngestBytesPerSecond: 1048576}},
+		}, v.RateLimits)
+
+		rm := NewWeaviateRuntimeConfig(&mockManager{c: v})
+		require.Equal(t, v.RateLimits, rm.GetRateLimits())
+
+		_, err = ParseYaml([]byte(`
+rate_limits:
+  default:
+    requests_per_second: -1
+`))
+		require.Error(t, err)
+	})
 }
 
 type mockManager struct {
@@ -48,6 +48,16 @@ func (m *MockSchemaExecutor) AddReplicaToShard(class string, shard string, repli
 	return args.Error(0)
 }
 
+func (m *MockSchemaExecutor) DeleteReplicaFromShard(class string, shard string, replica string) error {
+	args := m.Called(class, shard, replica)
+	return args.Error(0)
+}
+
+func (m *MockSchemaExecutor) AbortShardSplit(class, source string, targets []string) error {
+	args := m.Called(class, source, targets)
+	return args.Error(0)
+}
+
 func (m *MockSchemaExecutor) UpdateIndex(req cmd.UpdateClassRequest) error {
 	args := m.Called(req)
 	return args.Error(0)
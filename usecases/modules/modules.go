@@ -1102,6 +1102,17 @@ func (p *Provider) OffloadBackend(backend string) (modulecapabilities.OffloadClo
 	return nil, false
 }
 
+// KeyProvider returns the enabled key management module, if any
+func (p *Provider) KeyProvider() (modulecapabilities.KeyProvider, bool) {
+	for _, mod := range p.GetAll() {
+		if provider, ok := mod.(modulecapabilities.KeyProvider); ok &&
+			mod.Type() == modulecapabilities.KeyManagement {
+			return provider, true
+		}
+	}
+	return nil, false
+}
+
 func (p *Provider) EnabledBackupBackends() []modulecapabilities.BackupBackend {
 	var backends []modulecapabilities.BackupBackend
 	for _, mod := range p.GetAll() {
@@ -18,6 +18,8 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func vectorFromSearchParam[T dto.Embedding](
@@ -46,7 +48,10 @@ func vectorFromSearchParam[T dto.Embedding](
 	if vectorSearches != nil {
 		if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
 			cfg := NewClassBasedModuleConfig(class, moduleName, tenant, targetVector)
+			ctx, span := tracing.Start(ctx, "modules.VectorForParams", attribute.String("module", moduleName),
+				attribute.String("class", class.Class), attribute.String("param", param))
 			vector, err := searchVectorFn.VectorForParams(ctx, params, class.Class, findVectorFn, cfg)
+			tracing.End(span, err)
 			if err != nil {
 				return true, nil, errors.Errorf("vectorize params: %v", err)
 			}
@@ -69,7 +74,10 @@ func crossClassVectorFromSearchParam[T dto.Embedding](
 		if vectorSearches := searcher.VectorSearches(); vectorSearches != nil {
 			if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
 				cfg := NewCrossClassModuleConfig()
+				ctx, span := tracing.Start(ctx, "modules.VectorForParams", attribute.String("module", mod.Name()),
+					attribute.String("param", param))
 				vector, err := searchVectorFn.VectorForParams(ctx, params, "", findVectorFn, cfg)
+				tracing.End(span, err)
 				if err != nil {
 					return true, nil, "", errors.Errorf("vectorize params: %v", err)
 				}
@@ -97,7 +105,10 @@ func vectorFromInput[T dto.Embedding](
 	if vectorizer, ok := mod.(modulecapabilities.InputVectorizer[T]); ok {
 		// does not access any objects, therefore tenant is irrelevant
 		cfg := NewClassBasedModuleConfig(class, mod.Name(), "", targetVector)
+		ctx, span := tracing.Start(ctx, "modules.VectorizeInput", attribute.String("module", mod.Name()),
+			attribute.String("class", class.Class))
 		vector, err := vectorizer.VectorizeInput(ctx, input, cfg)
+		tracing.End(span, err)
 		return true, vector, err
 	}
 	return false, nil, nil
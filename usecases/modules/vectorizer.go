@@ -25,10 +25,14 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/ivf"
 	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 var _NUMCPU = runtime.NumCPU()
@@ -175,13 +179,18 @@ func (p *Provider) shouldVectorizeClass(class *models.Class, targetVector string
 func (p *Provider) batchUpdateVector(ctx context.Context, objects []*models.Object, class *models.Class,
 	findObjectFn modulecapabilities.FindObjectFn,
 	targetVector string, modConfig map[string]interface{},
-) (map[int]error, error) {
+) (_ map[int]error, err error) {
 	found := p.getModule(modConfig)
 	if found == nil {
 		return nil, fmt.Errorf("no vectorizer found for class %q", class.Class)
 	}
 	cfg := NewClassBasedModuleConfig(class, found.Name(), "", targetVector)
 
+	ctx, span := tracing.Start(ctx, "modules.VectorizeBatch", attribute.String("module", found.Name()),
+		attribute.String("class", class.Class), attribute.String("target_vector", targetVector),
+		attribute.Int("objects", len(objects)))
+	defer func() { tracing.End(span, err) }()
+
 	if vectorizer, ok := found.(modulecapabilities.Vectorizer[[]float32]); ok {
 		// each target vector can have its own associated properties, and we need to determine for each one if we should
 		// skip it or not. To simplify things, we create a boolean slice that indicates for each object if the given
@@ -353,7 +362,7 @@ func (p *Provider) vectorizeOne(ctx context.Context, object *models.Object, clas
 func (p *Provider) vectorize(ctx context.Context, object *models.Object, class *models.Class,
 	findObjectFn modulecapabilities.FindObjectFn,
 	targetVector string, modConfig map[string]interface{},
-) error {
+) (err error) {
 	found := p.getModule(modConfig)
 	if found == nil {
 		return fmt.Errorf(
@@ -362,6 +371,10 @@ func (p *Provider) vectorize(ctx context.Context, object *models.Object, class *
 
 	cfg := NewClassBasedModuleConfig(class, found.Name(), "", targetVector)
 
+	ctx, span := tracing.Start(ctx, "modules.VectorizeObject", attribute.String("module", found.Name()),
+		attribute.String("class", class.Class), attribute.String("target_vector", targetVector))
+	defer func() { tracing.End(span, err) }()
+
 	if vectorizer, ok := found.(modulecapabilities.Vectorizer[[]float32]); ok {
 		if p.shouldVectorizeObject(object, cfg) {
 			var targetProperties []string
@@ -509,7 +522,9 @@ func (p *Provider) getVectorIndexConfig(class *models.Class, targetVector string
 	hnswConfig, okHnsw := vectorIndexConfig.(hnsw.UserConfig)
 	_, okFlat := vectorIndexConfig.(flat.UserConfig)
 	_, okDynamic := vectorIndexConfig.(dynamic.UserConfig)
-	if !(okHnsw || okFlat || okDynamic) {
+	_, okDiskANN := vectorIndexConfig.(diskann.UserConfig)
+	_, okIVF := vectorIndexConfig.(ivf.UserConfig)
+	if !(okHnsw || okFlat || okDynamic || okDiskANN || okIVF) {
 		return hnsw.UserConfig{}, fmt.Errorf(errorVectorIndexType, vectorIndexConfig)
 	}
 	return hnswConfig, nil
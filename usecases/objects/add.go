@@ -110,6 +110,9 @@ func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *
 	}
 	err = m.vectorRepo.PutObject(ctx, object, object.Vector, vectors, multiVectors, repl, schemaVersion)
 	if err != nil {
+		if enterrors.IsTenantQuotaExceeded(err) {
+			return nil, NewErrMultiTenancy(fmt.Errorf("put object: %w", err))
+		}
 		return nil, fmt.Errorf("put object: %w", err)
 	}
 
@@ -23,6 +23,7 @@ import (
 	"github.com/go-openapi/strfmt"
 
 	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
@@ -165,6 +166,9 @@ func (m *Manager) patchObject(ctx context.Context, prevObj, updates *models.Obje
 			m.logger.WithError(err).Debugf("object %s/%s not found, possibly due to replication consistency races", cls, id)
 			return &Error{"not found", StatusNotFound, err}
 		}
+		if enterrors.IsTenantQuotaExceeded(err) {
+			return &Error{"repo.merge", StatusUnprocessableEntity, err}
+		}
 		return &Error{"repo.merge", StatusInternalServerError, err}
 	}
 
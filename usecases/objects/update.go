@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/classcache"
 	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/memwatch"
@@ -118,6 +119,9 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context,
 	}
 	err = m.vectorRepo.PutObject(ctx, updates, updates.Vector, vectors, multiVectors, repl, maxSchemaVersion)
 	if err != nil {
+		if enterrors.IsTenantQuotaExceeded(err) {
+			return nil, NewErrMultiTenancy(fmt.Errorf("put object: %w", err))
+		}
 		return nil, fmt.Errorf("put object: %w", err)
 	}
 
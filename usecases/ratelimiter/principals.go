@@ -0,0 +1,400 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ratelimiter
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	// limits of a principal are resolved again after this interval, to pick
+	// up changed role assignments and runtime overrides
+	resolveInterval = 10 * time.Second
+	// principals without requests are forgotten after this interval
+	idleTimeout   = 10 * time.Minute
+	sweepInterval = time.Minute
+
+	anonymous = "anonymous"
+)
+
+// Limit names, used in errors and as metric labels
+const (
+	LimitRequestsPerSecond     = "requests_per_second"
+	LimitMaxConcurrentRequests = "max_concurrent_requests"
+	LimitIngestBytesPerSecond  = "ingest_bytes_per_second"
+)
+
+// Limits restrict the requests of a single principal. Zero values are
+// unlimited.
+type Limits struct {
+	RequestsPerSecond     float64 `json:"requests_per_second" yaml:"requests_per_second"`
+	MaxConcurrentRequests int     `json:"max_concurrent_requests" yaml:"max_concurrent_requests"`
+	// IngestBytesPerSecond limits the size of the objects and references
+	// imported per second
+	IngestBytesPerSecond float64 `json:"ingest_bytes_per_second" yaml:"ingest_bytes_per_second"`
+}
+
+// Config of the limits per principal. The limits of a user are the ones
+// configured for the user if any, otherwise the most permissive ones of the
+// roles of the user, otherwise Default. Anonymous requests share the limits
+// of the user "anonymous".
+type Config struct {
+	Default Limits            `json:"default" yaml:"default"`
+	Users   map[string]Limits `json:"users" yaml:"users"`
+	Roles   map[string]Limits `json:"roles" yaml:"roles"`
+}
+
+func (c Config) Validate() error {
+	validate := func(name string, l Limits) error {
+		if l.RequestsPerSecond < 0 || l.MaxConcurrentRequests < 0 || l.IngestBytesPerSecond < 0 {
+			return fmt.Errorf("rate limits of %s: must not be negative", name)
+		}
+		return nil
+	}
+	if err := validate("default", c.Default); err != nil {
+		return err
+	}
+	for user, l := range c.Users {
+		if err := validate("user "+user, l); err != nil {
+			return err
+		}
+	}
+	for role, l := range c.Roles {
+		if err := validate("role "+role, l); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c Config) empty() bool {
+	return c.Default == (Limits{}) && len(c.Users) == 0 && len(c.Roles) == 0
+}
+
+// limits of a user and the config entry they come from, e.g. "user:alice",
+// "role:admin,viewer" or "default", used as metric label instead of the
+// unbounded user names
+func (c Config) limits(user string, roles []string) (Limits, string) {
+	if l, ok := c.Users[user]; ok {
+		return l, "user:" + user
+	}
+	var (
+		out     Limits
+		matched []string
+	)
+	permissive := func(a, b float64) float64 {
+		if a == 0 || b == 0 {
+			return 0
+		}
+		return math.Max(a, b)
+	}
+	for _, role := range roles {
+		l, ok := c.Roles[role]
+		if !ok {
+			continue
+		}
+		matched = append(matched, role)
+		if len(matched) == 1 {
+			out = l
+			continue
+		}
+		out.RequestsPerSecond = permissive(out.RequestsPerSecond, l.RequestsPerSecond)
+		out.IngestBytesPerSecond = permissive(out.IngestBytesPerSecond, l.IngestBytesPerSecond)
+		out.MaxConcurrentRequests = int(permissive(float64(out.MaxConcurrentRequests), float64(l.MaxConcurrentRequests)))
+	}
+	if len(matched) > 0 {
+		sort.Strings(matched)
+		return out, "role:" + strings.Join(matched, ",")
+	}
+	return c.Default, "default"
+}
+
+// RolesFn returns the names of the roles of a user
+type RolesFn func(username string, userType models.UserTypeInput) ([]string, error)
+
+// LimitError is returned if a principal has exceeded one of its limits
+type LimitError struct {
+	Principal string
+	Limit     string
+	// RetryAfter is an estimate of when the request would be admitted
+	RetryAfter time.Duration
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("429 Too many requests: %s of %q exceeded", e.Limit, e.Principal)
+}
+
+// Principals limits the requests of each principal. Its limits are read on
+// every request, so that they can be changed at runtime.
+type Principals struct {
+	config  func() Config
+	roles   RolesFn
+	metrics *principalMetrics
+	now     func() time.Time
+
+	sync.Mutex
+	states    map[string]*principalState
+	lastSweep time.Time
+}
+
+// NewPrincipals creates a limiter enforcing the limits returned by config.
+// roles may be nil if roles are not supported, e.g. without RBAC.
+func NewPrincipals(config func() Config, roles RolesFn, reg prometheus.Registerer) *Principals {
+	return &Principals{
+		config:  config,
+		roles:   roles,
+		metrics: newPrincipalMetrics(reg),
+		now:     time.Now,
+		states:  map[string]*principalState{},
+	}
+}
+
+type principalState struct {
+	limits     Limits
+	label      string
+	resolvedAt time.Time
+	lastSeen   time.Time
+	concurrent int
+	requests   bucket
+	ingest     bucket
+}
+
+// Acquire admits a request of principal, which may be nil for anonymous
+// requests. The returned permit must be released once the request is done.
+func (p *Principals) Acquire(principal *models.Principal) (*Permit, error) {
+	cfg := p.config()
+	if cfg.empty() {
+		return nil, nil
+	}
+
+	name, userType := anonymous, models.UserTypeInput("")
+	if principal != nil {
+		name, userType = principal.Username, principal.UserType
+	}
+
+	now := p.now()
+	if permit, ok, err := p.acquire(name, now, nil); ok {
+		return permit, err
+	}
+	// the roles are resolved outside of the lock, looking them up may be
+	// slow and must not hold up the requests of other principals
+	var roles []string
+	if p.roles != nil && principal != nil {
+		// without its roles the principal is limited like any other user
+		roles, _ = p.roles(name, userType)
+	}
+	limits, label := cfg.limits(name, roles)
+	permit, _, err := p.acquire(name, now, &principalState{limits: limits, label: label})
+	return permit, err
+}
+
+// acquire admits a request of principal with the limits of resolved. If
+// resolved is nil the limits resolved earlier are used, unless they are
+// missing or outdated in which case it returns false.
+func (p *Principals) acquire(name string, now time.Time, resolved *principalState) (*Permit, bool, error) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.sweep(now)
+	s, ok := p.states[name]
+	if resolved == nil && (!ok || now.Sub(s.resolvedAt) >= resolveInterval) {
+		return nil, false, nil
+	}
+	if !ok {
+		s = &principalState{}
+		p.states[name] = s
+	}
+	s.lastSeen = now
+	if resolved != nil && !now.Before(s.resolvedAt) {
+		s.limits, s.label = resolved.limits, resolved.label
+		s.resolvedAt = now
+		s.requests.setRate(s.limits.RequestsPerSecond, now)
+		s.ingest.setRate(s.limits.IngestBytesPerSecond, now)
+	}
+
+	if max := s.limits.MaxConcurrentRequests; max > 0 && s.concurrent >= max {
+		return nil, true, p.reject(name, s.label, LimitMaxConcurrentRequests, 0)
+	}
+	if wait, ok := s.requests.take(1, now); !ok {
+		return nil, true, p.reject(name, s.label, LimitRequestsPerSecond, wait)
+	}
+	s.concurrent++
+	p.metrics.concurrent.WithLabelValues(s.label).Inc()
+	return &Permit{limiter: p, principal: name, label: s.label, state: s}, true, nil
+}
+
+func (p *Principals) reject(principal, label, limit string, retryAfter time.Duration) error {
+	p.metrics.rejected.WithLabelValues(label, limit).Inc()
+	return &LimitError{Principal: principal, Limit: limit, RetryAfter: retryAfter}
+}
+
+// sweep forgets principals which have been idle for a while
+func (p *Principals) sweep(now time.Time) {
+	if now.Sub(p.lastSweep) < sweepInterval {
+		return
+	}
+	p.lastSweep = now
+	for name, s := range p.states {
+		if s.concurrent == 0 && now.Sub(s.lastSeen) >= idleTimeout {
+			delete(p.states, name)
+		}
+	}
+}
+
+// Permit of an admitted request. A nil permit is valid and unlimited.
+type Permit struct {
+	limiter   *Principals
+	principal string
+	// metric label at the time the request was admitted, so that the
+	// metrics stay balanced if the limits of the principal change
+	label    string
+	state    *principalState
+	released bool
+}
+
+// Ingest accounts n bytes imported by the request. It fails if the
+// principal has exceeded its ingestion volume, in which case the bytes must
+// not be imported and are not accounted. Passing n=0 checks if the principal
+// is still in debt from previous imports.
+func (p *Permit) Ingest(n int64) error {
+	if p == nil {
+		return nil
+	}
+	l := p.limiter
+	l.Lock()
+	defer l.Unlock()
+
+	if wait, ok := p.state.ingest.take(float64(n), l.now()); !ok {
+		return l.reject(p.principal, p.label, LimitIngestBytesPerSecond, wait)
+	}
+	l.metrics.ingested.WithLabelValues(p.label).Add(float64(n))
+	return nil
+}
+
+// Account accounts n bytes which have been imported already, e.g. when the
+// size of a request is only known once it has been read. It never fails,
+// exceeding the ingestion volume delays the next imports instead.
+func (p *Permit) Account(n int64) {
+	if p == nil {
+		return
+	}
+	l := p.limiter
+	l.Lock()
+	defer l.Unlock()
+
+	p.state.ingest.charge(float64(n), l.now())
+	l.metrics.ingested.WithLabelValues(p.label).Add(float64(n))
+}
+
+// Release ends the request, it is safe to call more than once
+func (p *Permit) Release() {
+	if p == nil {
+		return
+	}
+	l := p.limiter
+	l.Lock()
+	defer l.Unlock()
+
+	if p.released {
+		return
+	}
+	p.released = true
+	p.state.concurrent--
+	l.metrics.concurrent.WithLabelValues(p.label).Dec()
+}
+
+// bucket is a token bucket which may go into debt: a request larger than
+// the burst is admitted once the bucket is full, but delays the following
+// ones accordingly.
+type bucket struct {
+	rate   float64 // tokens per second, 0 is unlimited
+	tokens float64
+	last   time.Time
+}
+
+func (b *bucket) setRate(rate float64, now time.Time) {
+	b.refill(now)
+	if b.rate == 0 {
+		// start full
+		b.tokens = rate
+	}
+	b.rate = rate
+	b.tokens = math.Min(b.tokens, b.burst())
+}
+
+// burst is a second worth of tokens, but at least one
+func (b *bucket) burst() float64 {
+	return math.Max(b.rate, 1)
+}
+
+func (b *bucket) refill(now time.Time) {
+	if !b.last.IsZero() && now.After(b.last) {
+		b.tokens = math.Min(b.tokens+now.Sub(b.last).Seconds()*b.rate, b.burst())
+	}
+	b.last = now
+}
+
+// take n tokens if there are enough, otherwise return the time until there
+// will be
+func (b *bucket) take(n float64, now time.Time) (time.Duration, bool) {
+	if b.rate == 0 {
+		return 0, true
+	}
+	b.refill(now)
+	if need := math.Min(n, b.burst()); b.tokens < need {
+		return time.Duration(math.Ceil((need - b.tokens) / b.rate * float64(time.Second))), false
+	}
+	b.tokens -= n
+	return 0, true
+}
+
+// charge takes n tokens regardless of how many there are
+func (b *bucket) charge(n float64, now time.Time) {
+	if b.rate == 0 {
+		return
+	}
+	b.refill(now)
+	b.tokens -= n
+}
+
+type principalMetrics struct {
+	rejected   *prometheus.CounterVec
+	concurrent *prometheus.GaugeVec
+	ingested   *prometheus.CounterVec
+}
+
+func newPrincipalMetrics(reg prometheus.Registerer) *principalMetrics {
+	r := promauto.With(reg)
+	return &principalMetrics{
+		rejected: r.NewCounterVec(prometheus.CounterOpts{
+			Name: "principal_requests_rejected_total",
+			Help: "Number of requests rejected because a principal exceeded a limit, by the config entry of the limits",
+		}, []string{"limits", "limit"}),
+		concurrent: r.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "principal_concurrent_requests",
+			Help: "Number of requests in progress, by the config entry of the limits of their principal",
+		}, []string{"limits"}),
+		ingested: r.NewCounterVec(prometheus.CounterOpts{
+			Name: "principal_ingested_bytes_total",
+			Help: "Size of the objects and references imported, by the config entry of the limits of their principal",
+		}, []string{"limits"}),
+	}
+}
@@ -0,0 +1,231 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ratelimiter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+type fakeClock struct{ now time.Time }
+
+func (c *fakeClock) Now() time.Time          { return c.now }
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+func newPrincipalsForTest(cfg *Config, roles RolesFn) (*Principals, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(1700000000, 0)}
+	p := NewPrincipals(func() Config { return *cfg }, roles, nil)
+	p.now = clock.Now
+	return p, clock
+}
+
+func requireLimit(t *testing.T, err error, limit string) *LimitError {
+	t.Helper()
+	var le *LimitError
+	require.True(t, errors.As(err, &le), "expected limit error, got %v", err)
+	assert.Equal(t, limit, le.Limit)
+	return le
+}
+
+func TestPrincipals(t *testing.T) {
+	alice := &models.Principal{Username: "alice", UserType: models.UserTypeInputDb}
+	bob := &models.Principal{Username: "bob", UserType: models.UserTypeInputDb}
+
+	t.Run("unlimited without config", func(t *testing.T) {
+		p, _ := newPrincipalsForTest(&Config{}, nil)
+		for i := 0; i < 100; i++ {
+			permit, err := p.Acquire(alice)
+			require.Nil(t, err)
+			require.Nil(t, permit.Ingest(1<<30))
+		}
+	})
+
+	t.Run("requests per second", func(t *testing.T) {
+		p, clock := newPrincipalsForTest(&Config{Default: Limits{RequestsPerSecond: 2}}, nil)
+		for i := 0; i < 2; i++ {
+			permit, err := p.Acquire(alice)
+			require.Nil(t, err)
+			permit.Release()
+		}
+		_, err := p.Acquire(alice)
+		le := requireLimit(t, err, LimitRequestsPerSecond)
+		assert.Equal(t, "alice", le.Principal)
+		assert.Equal(t, 500*time.Millisecond, le.RetryAfter)
+
+		// others are not affected
+		_, err = p.Acquire(bob)
+		require.Nil(t, err)
+
+		clock.Advance(500 * time.Millisecond)
+		_, err = p.Acquire(alice)
+		require.Nil(t, err)
+	})
+
+	t.Run("concurrent requests", func(t *testing.T) {
+		p, _ := newPrincipalsForTest(&Config{Default: Limits{MaxConcurrentRequests: 2}}, nil)
+		first, err := p.Acquire(alice)
+		require.Nil(t, err)
+		_, err = p.Acquire(alice)
+		require.Nil(t, err)
+		_, err = p.Acquire(alice)
+		requireLimit(t, err, LimitMaxConcurrentRequests)
+
+		first.Release()
+		first.Release() // no-op
+		_, err = p.Acquire(alice)
+		require.Nil(t, err)
+		_, err = p.Acquire(alice)
+		requireLimit(t, err, LimitMaxConcurrentRequests)
+	})
+
+	t.Run("ingestion volume", func(t *testing.T) {
+		p, clock := newPrincipalsForTest(&Config{Default: Limits{IngestBytesPerSecond: 1000}}, nil)
+		permit, err := p.Acquire(alice)
+		require.Nil(t, err)
+		// larger than a second worth, but admitted
+		require.Nil(t, permit.Ingest(3000))
+		le := requireLimit(t, permit.Ingest(0), LimitIngestBytesPerSecond)
+		assert.Equal(t, 2*time.Second, le.RetryAfter)
+
+		clock.Advance(2 * time.Second)
+		require.Nil(t, permit.Ingest(0))
+		le = requireLimit(t, permit.Ingest(10), LimitIngestBytesPerSecond)
+		assert.Equal(t, 10*time.Millisecond, le.RetryAfter)
+		clock.Advance(10 * time.Millisecond)
+		require.Nil(t, permit.Ingest(10))
+
+		// accounted after the fact, even when in debt
+		permit.Account(1000)
+		permit.Account(1000)
+		le = requireLimit(t, permit.Ingest(0), LimitIngestBytesPerSecond)
+		assert.Equal(t, 2*time.Second, le.RetryAfter)
+	})
+
+	t.Run("users and roles", func(t *testing.T) {
+		cfg := &Config{
+			Default: Limits{MaxConcurrentRequests: 1},
+			Users:   map[string]Limits{"alice": {MaxConcurrentRequests: 3}},
+			Roles: map[string]Limits{
+				"small": {MaxConcurrentRequests: 2, RequestsPerSecond: 10},
+				"large": {MaxConcurrentRequests: 4},
+			},
+		}
+		roles := map[string][]string{"alice": {"large"}, "bob": {"small", "large"}, "carol": {"small"}}
+		p, _ := newPrincipalsForTest(cfg, func(username string, _ models.UserTypeInput) ([]string, error) {
+			return roles[username], nil
+		})
+
+		concurrent := func(principal *models.Principal) int {
+			n := 0
+			for ; n < 10; n++ {
+				if _, err := p.Acquire(principal); err != nil {
+					break
+				}
+			}
+			return n
+		}
+		// user limits take precedence over the roles
+		assert.Equal(t, 3, concurrent(alice))
+		// most permissive of all roles
+		assert.Equal(t, 4, concurrent(bob))
+		assert.Equal(t, 2, concurrent(&models.Principal{Username: "carol"}))
+		assert.Equal(t, 1, concurrent(&models.Principal{Username: "dave"}))
+		assert.Equal(t, 1, concurrent(nil))
+	})
+
+	t.Run("metrics by config entry", func(t *testing.T) {
+		cfg := &Config{
+			Default: Limits{MaxConcurrentRequests: 10},
+			Users:   map[string]Limits{"alice": {MaxConcurrentRequests: 10}},
+			Roles: map[string]Limits{
+				"small": {MaxConcurrentRequests: 1},
+				"large": {MaxConcurrentRequests: 10},
+			},
+		}
+		roles := map[string][]string{"bob": {"small", "large"}, "carol": {"large", "small"}}
+		p := NewPrincipals(func() Config { return *cfg }, func(username string, _ models.UserTypeInput) ([]string, error) {
+			return roles[username], nil
+		}, prometheus.NewPedanticRegistry())
+
+		for _, principal := range []*models.Principal{alice, bob, {Username: "carol"}, {Username: "dave"}, nil} {
+			_, err := p.Acquire(principal)
+			require.Nil(t, err)
+		}
+		permit, err := p.Acquire(&models.Principal{Username: "erin"})
+		require.Nil(t, err)
+		require.Nil(t, permit.Ingest(100))
+		permit.Release()
+
+		concurrent := p.metrics.concurrent
+		assert.Equal(t, 3, testutil.CollectAndCount(concurrent))
+		assert.Equal(t, 1.0, testutil.ToFloat64(concurrent.WithLabelValues("user:alice")))
+		// the users with the same roles share a label
+		assert.Equal(t, 2.0, testutil.ToFloat64(concurrent.WithLabelValues("role:large,small")))
+		assert.Equal(t, 2.0, testutil.ToFloat64(concurrent.WithLabelValues("default")))
+		assert.Equal(t, 100.0, testutil.ToFloat64(p.metrics.ingested.WithLabelValues("default")))
+	})
+
+	t.Run("roles are resolved outside of the lock", func(t *testing.T) {
+		var p *Principals
+		p, _ = newPrincipalsForTest(&Config{Default: Limits{MaxConcurrentRequests: 1}}, func(string, models.UserTypeInput) ([]string, error) {
+			require.True(t, p.TryLock())
+			p.Unlock()
+			return nil, nil
+		})
+		_, err := p.Acquire(alice)
+		require.Nil(t, err)
+	})
+
+	t.Run("limits changed at runtime", func(t *testing.T) {
+		cfg := &Config{Default: Limits{MaxConcurrentRequests: 1}}
+		p, clock := newPrincipalsForTest(cfg, nil)
+		_, err := p.Acquire(alice)
+		require.Nil(t, err)
+		_, err = p.Acquire(alice)
+		requireLimit(t, err, LimitMaxConcurrentRequests)
+
+		cfg.Default.MaxConcurrentRequests = 2
+		clock.Advance(resolveInterval)
+		_, err = p.Acquire(alice)
+		require.Nil(t, err)
+
+		*cfg = Config{}
+		_, err = p.Acquire(alice)
+		require.Nil(t, err)
+	})
+
+	t.Run("idle principals are forgotten", func(t *testing.T) {
+		p, clock := newPrincipalsForTest(&Config{Default: Limits{MaxConcurrentRequests: 1}}, nil)
+		permit, err := p.Acquire(alice)
+		require.Nil(t, err)
+		_, err = p.Acquire(bob)
+		require.Nil(t, err)
+		permit.Release()
+
+		clock.Advance(idleTimeout)
+		_, err = p.Acquire(&models.Principal{Username: "carol"})
+		require.Nil(t, err)
+		assert.NotContains(t, p.states, "alice")
+		assert.Contains(t, p.states, "bob")
+	})
+}
+
+func TestPrincipalsConfigValidate(t *testing.T) {
+	assert.Nil(t, Config{Default: Limits{RequestsPerSecond: 1}}.Validate())
+	assert.NotNil(t, Config{Roles: map[string]Limits{"r": {MaxConcurrentRequests: -1}}}.Validate())
+}
@@ -13,6 +13,7 @@ package replica
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -21,6 +22,10 @@ import (
 	"github.com/weaviate/weaviate/cluster/router/types"
 	"github.com/weaviate/weaviate/cluster/utils"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/sirupsen/logrus"
 )
@@ -52,6 +57,9 @@ type (
 		pullBackOffPreInitialInterval time.Duration
 		pullBackOffMaxElapsedTime     time.Duration // stop retrying after this long
 		deletionStrategy              string
+		// rejected is the first error a replica rejected the request with
+		// if the broadcast was aborted
+		rejected error
 	}
 )
 
@@ -102,7 +110,9 @@ func (c *coordinator[T]) broadcast(ctx context.Context,
 				replica := replica
 				g := func() {
 					defer wg.Done()
+					ctx, span := tracing.Start(ctx, "replica.Prepare", attribute.String("host", replica))
 					err := op(ctx, replica, c.TxID)
+					tracing.End(span, err)
 					resChan <- _Result[string]{replica, err}
 				}
 				enterrors.GoWrapper(g, c.log)
@@ -118,9 +128,14 @@ func (c *coordinator[T]) broadcast(ctx context.Context,
 	f := func() {
 		defer close(replicaCh)
 		actives := make([]string, 0, level) // cache for active replicas
+		var rejected error
 		for r := range prepare() {
 			if r.Err != nil { // connection error
 				c.log.WithField("op", "broadcast").Error(r.Err)
+				var replicaErr *Error
+				if rejected == nil && errors.As(r.Err, &replicaErr) {
+					rejected = r.Err
+				}
 				continue
 			}
 
@@ -139,6 +154,10 @@ func (c *coordinator[T]) broadcast(ctx context.Context,
 		if level > 0 { // abort: nothing has been sent to the caller
 			fs := logrus.Fields{"op": "broadcast", "active": len(actives), "total": len(replicas)}
 			c.log.WithFields(fs).Error("abort")
+			trace.SpanFromContext(ctx).SetStatus(codes.Error, "consistency level not reached")
+			if rejected != nil {
+				c.rejected = fmt.Errorf("broadcast: %w: %w", errReplicas, rejected)
+			}
 			for _, node := range replicas {
 				c.Abort(ctx, node, c.Class, c.Shard, c.TxID)
 			}
@@ -156,18 +175,26 @@ func (c *coordinator[T]) commitAll(ctx context.Context,
 ) <-chan _Result[T] {
 	replyCh := make(chan _Result[T], cap(replicaCh))
 	f := func() { // tells active replicas to commit
+		// ends the span of Push, which covers both phases
+		defer trace.SpanFromContext(ctx).End()
 		wg := sync.WaitGroup{}
 		for replica := range replicaCh {
 			wg.Add(1)
 			replica := replica
 			g := func() {
 				defer wg.Done()
+				ctx, span := tracing.Start(ctx, "replica.Commit", attribute.String("host", replica))
 				resp, err := op(ctx, replica, c.TxID)
+				tracing.End(span, err)
 				replyCh <- _Result[T]{resp, err}
 			}
 			enterrors.GoWrapper(g, c.log)
 		}
 		wg.Wait()
+		if c.rejected != nil {
+			// no replica committed, report why they rejected the request
+			replyCh <- _Result[T]{Err: c.rejected}
+		}
 		close(replyCh)
 	}
 	enterrors.GoWrapper(f, c.log)
@@ -181,24 +208,32 @@ func (c *coordinator[T]) Push(ctx context.Context,
 	ask readyOp,
 	com commitOp[T],
 ) (<-chan _Result[T], int, error) {
+	_, span := tracing.Start(ctx, "replica.Push", attribute.String("class", c.Class),
+		attribute.String("shard", c.Shard), attribute.String("consistency_level", string(cl)))
 	routingPlan, err := c.Router.BuildWriteRoutingPlan(types.RoutingPlanBuildOptions{
 		Collection:       c.Class,
 		Shard:            c.Shard,
 		ConsistencyLevel: cl,
 	})
 	if err != nil {
-		return nil, 0, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
+		err = fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
+		tracing.End(span, err)
+		return nil, 0, err
 	}
 	level := routingPlan.IntConsistencyLevel
+	span.SetAttributes(attribute.Int("level", level))
+	// the replicas are contacted in the background, the span ends once they
+	// have all replied
+	spanCtx := tracing.WithSpan(context.Background(), span)
 	//nolint:govet // we expressely don't want to cancel that context as the timeout will take care of it
-	ctxWithTimeout, _ := context.WithTimeout(context.Background(), 20*time.Second)
+	ctxWithTimeout, _ := context.WithTimeout(spanCtx, 20*time.Second)
 	c.log.WithFields(logrus.Fields{
 		"action":   "coordinator_push",
 		"duration": 20 * time.Second,
 		"level":    level,
 	}).Debug("context.WithTimeout")
 	nodeCh := c.broadcast(ctxWithTimeout, routingPlan.ReplicasHostAddrs, ask, level)
-	return c.commitAll(context.Background(), nodeCh, com), level, nil
+	return c.commitAll(spanCtx, nodeCh, com), level, nil
 }
 
 // Pull data from replica depending on consistency level, trying to reach level successful calls
@@ -217,6 +252,8 @@ func (c *coordinator[T]) Pull(ctx context.Context,
 	op readOp[T], directCandidate string,
 	timeout time.Duration,
 ) (<-chan _Result[T], int, error) {
+	ctx, span := tracing.Start(ctx, "replica.Pull", attribute.String("class", c.Class),
+		attribute.String("shard", c.Shard), attribute.String("consistency_level", string(cl)))
 	routingPlan, err := c.Router.BuildReadRoutingPlan(types.RoutingPlanBuildOptions{
 		Collection:             c.Class,
 		Shard:                  c.Shard,
@@ -224,9 +261,19 @@ func (c *coordinator[T]) Pull(ctx context.Context,
 		DirectCandidateReplica: directCandidate,
 	})
 	if err != nil {
-		return nil, 0, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
+		err = fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
+		tracing.End(span, err)
+		return nil, 0, err
 	}
 	level := routingPlan.IntConsistencyLevel
+	span.SetAttributes(attribute.Int("level", level))
+	read := func(ctx context.Context, host string, fullRead bool) (T, error) {
+		ctx, span := tracing.Start(ctx, "replica.Read",
+			attribute.String("host", host), attribute.Bool("full_read", fullRead))
+		resp, err := op(ctx, host, fullRead)
+		tracing.End(span, err)
+		return resp, err
+	}
 	hosts := routingPlan.ReplicasHostAddrs
 	replyCh := make(chan _Result[T], level)
 	f := func() {
@@ -255,7 +302,7 @@ func (c *coordinator[T]) Pull(ctx context.Context,
 				// because that will be the direct candidate (if a direct candidate was provided),
 				// if we only used the retry queue then we would not have the guarantee that the
 				// fullRead will be tried on hosts[0] first.
-				resp, err := op(workerCtx, hosts[hostIndex], isFullReadWorker)
+				resp, err := read(workerCtx, hosts[hostIndex], isFullReadWorker)
 				// TODO return retryable info here, for now should be fine since most errors are considered retryable
 				// TODO have increasing timeout passed into each op (eg 1s, 2s, 4s, 8s, 16s, 32s, with some max) similar to backoff? future PR? or should we just set timeout once per worker in Pull?
 				if err == nil {
@@ -270,7 +317,7 @@ func (c *coordinator[T]) Pull(ctx context.Context,
 
 				// let's fallback to the backups in the retry queue
 				for hr := range hostRetryQueue {
-					resp, err := op(workerCtx, hr.host, isFullReadWorker)
+					resp, err := read(workerCtx, hr.host, isFullReadWorker)
 					if err == nil {
 						replyCh <- _Result[T]{resp, err}
 						return
@@ -300,6 +347,7 @@ func (c *coordinator[T]) Pull(ctx context.Context,
 			enterrors.GoWrapper(workerFunc, c.log)
 		}
 		wg.Wait()
+		span.End()
 		// callers of this function rely on replyCh being closed
 		close(replyCh)
 	}
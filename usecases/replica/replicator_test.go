@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	clusterRouter "github.com/weaviate/weaviate/cluster/router"
 	"github.com/weaviate/weaviate/cluster/router/types"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
 	replicationMocks "github.com/weaviate/weaviate/mocks/cluster/replication/types"
@@ -207,6 +208,21 @@ func TestReplicatorPutObject(t *testing.T) {
 		assert.ErrorIs(t, err, errReplicas)
 	})
 
+	t.Run("PhaseOneTenantQuotaExceeded", func(t *testing.T) {
+		f := newFakeFactory(t, "C1", shard, nodes)
+		rep := f.newReplicator()
+		resp := SimpleResponse{}
+		f.WClient.On("PutObject", mock.Anything, nodes[0], cls, shard, anyVal, obj, uint64(123)).Return(resp, nil)
+		resp2 := SimpleResponse{[]Error{{Code: StatusTenantQuotaExceeded, Msg: "tenant quota exceeded"}}}
+		f.WClient.On("PutObject", mock.Anything, nodes[1], cls, shard, anyVal, obj, uint64(123)).Return(resp2, nil)
+		f.WClient.On("Abort", mock.Anything, nodes[0], "C1", shard, anyVal).Return(resp, nil)
+		f.WClient.On("Abort", mock.Anything, nodes[1], "C1", shard, anyVal).Return(resp, nil)
+
+		err := rep.PutObject(ctx, shard, obj, types.ConsistencyLevelAll, 123)
+		assert.ErrorIs(t, err, errReplicas)
+		assert.True(t, enterrors.IsTenantQuotaExceeded(err), "expected quota error, got %v", err)
+	})
+
 	t.Run("Commit", func(t *testing.T) {
 		f := newFakeFactory(t, "C1", shard, nodes)
 		rep := f.newReplicator()
@@ -19,6 +19,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/cluster/router/types"
 	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -52,6 +53,7 @@ const (
 	StatusPreconditionFailed
 	StatusReadOnly
 	StatusObjectNotFound
+	StatusTenantQuotaExceeded
 )
 
 // Error reports error happening during replication
@@ -82,6 +84,13 @@ func (e *Error) Error() string {
 	return fmt.Sprintf("%s %q: %v", statusText(e.Code), e.Msg, e.Err)
 }
 
+// Is reports whether the error is target. A replica rejecting a write
+// because of the tenant quota is only known by its code, as the underlying
+// error is not sent over the wire.
+func (e *Error) Is(target error) bool {
+	return e.Code == StatusTenantQuotaExceeded && target == enterrors.ErrTenantQuotaExceeded
+}
+
 func (e *Error) IsStatusCode(sc StatusCode) bool {
 	return e.Code == sc
 }
@@ -110,6 +119,8 @@ func statusText(code StatusCode) string {
 		return "read only"
 	case StatusObjectNotFound:
 		return "object not found"
+	case StatusTenantQuotaExceeded:
+		return "tenant quota exceeded"
 	default:
 		return ""
 	}
@@ -674,6 +674,10 @@ func (h *Handler) validateClassInvariants(
 		return err
 	}
 
+	if err := validateObjectTTL(class); err != nil {
+		return err
+	}
+
 	if err := replica.ValidateConfig(class, h.config.Replication); err != nil {
 		return err
 	}
@@ -827,7 +831,8 @@ func (h *Handler) validateVectorizer(vectorizer string) error {
 
 func (h *Handler) validateVectorIndexType(vectorIndexType string) error {
 	switch vectorIndexType {
-	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT:
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDISKANN,
+		vectorindex.VectorIndexTypeIVF:
 		return nil
 	case vectorindex.VectorIndexTypeDYNAMIC:
 		if !h.asyncIndexingEnabled {
@@ -850,6 +855,74 @@ func validateMT(class *models.Class) error {
 		return fmt.Errorf("can't enable autoTenantActivation on a non-multi-tenant class")
 	}
 
+	return validateTenantQuota(class)
+}
+
+func validateTenantQuota(class *models.Class) error {
+	if class.MultiTenancyConfig == nil || class.MultiTenancyConfig.TenantQuota == nil {
+		return nil
+	}
+
+	if !schema.MultiTenancyEnabled(class) {
+		return fmt.Errorf("can't set a tenantQuota on a non-multi-tenant class")
+	}
+
+	quota := class.MultiTenancyConfig.TenantQuota
+	if quota.MaxObjectCount < 0 || quota.MaxDiskBytes < 0 {
+		return fmt.Errorf("tenantQuota: maxObjectCount and maxDiskBytes must not be negative")
+	}
+	return nil
+}
+
+// validateObjectTTL makes sure the timestamp objects expire on can actually be
+// filtered on, as the background expiry sweep relies on the inverted index.
+func validateObjectTTL(class *models.Class) error {
+	if class.ObjectTTLConfig == nil {
+		return nil
+	}
+
+	if class.ObjectTTLConfig.DefaultTTL < 0 {
+		return fmt.Errorf("objectTtlConfig: defaultTtl must not be negative, got %d",
+			class.ObjectTTLConfig.DefaultTTL)
+	}
+
+	if !class.ObjectTTLConfig.Enabled {
+		return nil
+	}
+
+	if class.ObjectTTLConfig.DefaultTTL == 0 {
+		return fmt.Errorf("objectTtlConfig: defaultTtl must be set when expiry is enabled")
+	}
+
+	switch deleteOn := schema.ObjectTTLDeleteOn(class); deleteOn {
+	case schema.ObjectTTLDeleteOnCreationTime, schema.ObjectTTLDeleteOnUpdateTime:
+		if class.InvertedIndexConfig == nil || !class.InvertedIndexConfig.IndexTimestamps {
+			return fmt.Errorf("objectTtlConfig: expiring objects on %q requires "+
+				"`indexTimestamps: true` in the invertedIndexConfig", deleteOn)
+		}
+	default:
+		var prop *models.Property
+		for _, p := range class.Properties {
+			if strings.EqualFold(p.Name, deleteOn) {
+				prop = p
+				break
+			}
+		}
+		if prop == nil {
+			return fmt.Errorf("objectTtlConfig: deleteOn property %q not found", deleteOn)
+		}
+		if dt, ok := schema.AsPrimitive(prop.DataType); !ok || dt != schema.DataTypeDate {
+			return fmt.Errorf("objectTtlConfig: deleteOn property %q must be of data type %q",
+				deleteOn, schema.DataTypeDate)
+		}
+		filterable := prop.IndexFilterable == nil || *prop.IndexFilterable
+		rangeable := prop.IndexRangeFilters != nil && *prop.IndexRangeFilters
+		if !filterable && !rangeable {
+			return fmt.Errorf("objectTtlConfig: deleteOn property %q must be indexed "+
+				"with indexFilterable or indexRangeFilters", deleteOn)
+		}
+	}
+
 	return nil
 }
 
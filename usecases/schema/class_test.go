@@ -2204,6 +2204,167 @@ func Test_AddClass_MultiTenancy(t *testing.T) {
 	})
 }
 
+func Test_AddClass_ObjectTTL(t *testing.T) {
+	ctx := context.Background()
+	vTrue := true
+	vFalse := false
+
+	tests := []struct {
+		name          string
+		invertedIndex *models.InvertedIndexConfig
+		properties    []*models.Property
+		ttl           *models.ObjectTTLConfig
+		expectedError string
+	}{
+		{
+			name: "disabled",
+			ttl:  &models.ObjectTTLConfig{Enabled: false},
+		},
+		{
+			name:          "on creation time with indexed timestamps",
+			invertedIndex: &models.InvertedIndexConfig{IndexTimestamps: true},
+			ttl:           &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 3600},
+		},
+		{
+			name:          "on update time with indexed timestamps",
+			invertedIndex: &models.InvertedIndexConfig{IndexTimestamps: true},
+			ttl: &models.ObjectTTLConfig{
+				Enabled: true, DefaultTTL: 3600, DeleteOn: "_lastUpdateTimeUnix",
+			},
+		},
+		{
+			name:          "on creation time without indexed timestamps",
+			ttl:           &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 3600},
+			expectedError: "requires `indexTimestamps: true`",
+		},
+		{
+			name:          "without ttl",
+			invertedIndex: &models.InvertedIndexConfig{IndexTimestamps: true},
+			ttl:           &models.ObjectTTLConfig{Enabled: true},
+			expectedError: "defaultTtl must be set",
+		},
+		{
+			name:          "with negative ttl",
+			ttl:           &models.ObjectTTLConfig{DefaultTTL: -1},
+			expectedError: "defaultTtl must not be negative",
+		},
+		{
+			name: "on date property",
+			properties: []*models.Property{{
+				Name: "expiresAt", DataType: schema.DataTypeDate.PropString(),
+			}},
+			ttl: &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60, DeleteOn: "expiresAt"},
+		},
+		{
+			name: "on range filterable date property",
+			properties: []*models.Property{{
+				Name: "expiresAt", DataType: schema.DataTypeDate.PropString(),
+				IndexFilterable: &vFalse, IndexRangeFilters: &vTrue,
+			}},
+			ttl: &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60, DeleteOn: "expiresAt"},
+		},
+		{
+			name:          "on missing property",
+			ttl:           &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60, DeleteOn: "expiresAt"},
+			expectedError: "not found",
+		},
+		{
+			name: "on non-date property",
+			properties: []*models.Property{{
+				Name: "expiresAt", DataType: schema.DataTypeInt.PropString(),
+			}},
+			ttl:           &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60, DeleteOn: "expiresAt"},
+			expectedError: "must be of data type",
+		},
+		{
+			name: "on non-filterable date property",
+			properties: []*models.Property{{
+				Name: "expiresAt", DataType: schema.DataTypeDate.PropString(),
+				IndexFilterable: &vFalse,
+			}},
+			ttl:           &models.ObjectTTLConfig{Enabled: true, DefaultTTL: 60, DeleteOn: "expiresAt"},
+			expectedError: "must be indexed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			class := models.Class{
+				Class:               "NewClass",
+				Vectorizer:          "none",
+				InvertedIndexConfig: tt.invertedIndex,
+				Properties:          tt.properties,
+				ObjectTTLConfig:     tt.ttl,
+			}
+
+			fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+			fakeSchemaManager.On("QueryCollectionsCount").Return(0, nil)
+			handler.schemaConfig.MaximumAllowedCollectionsCount = -1
+			_, _, err := handler.AddClass(ctx, nil, &class)
+			if tt.expectedError != "" {
+				require.ErrorContains(t, err, tt.expectedError)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
+func Test_AddClass_TenantQuota(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		mt            *models.MultiTenancyConfig
+		expectedError string
+	}{
+		{
+			name: "multi-tenant",
+			mt: &models.MultiTenancyConfig{
+				Enabled:     true,
+				TenantQuota: &models.TenantQuota{MaxObjectCount: 1000, MaxDiskBytes: 1 << 30},
+			},
+		},
+		{
+			name: "non-multi-tenant",
+			mt: &models.MultiTenancyConfig{
+				TenantQuota: &models.TenantQuota{MaxObjectCount: 1000},
+			},
+			expectedError: "non-multi-tenant class",
+		},
+		{
+			name: "negative",
+			mt: &models.MultiTenancyConfig{
+				Enabled:     true,
+				TenantQuota: &models.TenantQuota{MaxDiskBytes: -1},
+			},
+			expectedError: "must not be negative",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			class := models.Class{
+				Class:              "NewClass",
+				Vectorizer:         "none",
+				MultiTenancyConfig: tt.mt,
+			}
+
+			fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+			fakeSchemaManager.On("QueryCollectionsCount").Return(0, nil)
+			handler.schemaConfig.MaximumAllowedCollectionsCount = -1
+			_, _, err := handler.AddClass(ctx, nil, &class)
+			if tt.expectedError != "" {
+				require.ErrorContains(t, err, tt.expectedError)
+			} else {
+				require.Nil(t, err)
+			}
+		})
+	}
+}
+
 func Test_SetClassDefaults(t *testing.T) {
 	globalCfg := replication.GlobalConfig{MinimumFactor: 3}
 	tests := []struct {
@@ -114,6 +114,14 @@ func (e *executor) AddReplicaToShard(class string, shard string, replica string)
 	return e.migrator.AddReplicaToShard(ctx, class, shard)
 }
 
+func (e *executor) DeleteReplicaFromShard(class string, shard string, replica string) error {
+	return e.migrator.DeleteReplicaFromShard(context.Background(), class, shard)
+}
+
+func (e *executor) AbortShardSplit(class, source string, targets []string) error {
+	return e.migrator.AbortShardSplit(context.Background(), class, source, targets)
+}
+
 // RestoreClassDir restores classes on the filesystem directly from the temporary class backup stored on disk.
 // This function is invoked by the Raft store when a restoration request is sent by the backup coordinator.
 func (e *executor) RestoreClassDir(class string) error {
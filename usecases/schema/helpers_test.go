@@ -103,6 +103,14 @@ func (f *fakeDB) AddReplicaToShard(class string, shard string, replica string) e
 	return nil
 }
 
+func (f *fakeDB) DeleteReplicaFromShard(class string, shard string, replica string) error {
+	return nil
+}
+
+func (f *fakeDB) AbortShardSplit(class, source string, targets []string) error {
+	return nil
+}
+
 func (f *fakeDB) UpdateClass(cmd command.UpdateClassRequest) error {
 	return nil
 }
@@ -307,6 +315,16 @@ func (f *fakeMigrator) AddReplicaToShard(ctx context.Context, class string, shar
 	return args.Error(0)
 }
 
+func (f *fakeMigrator) DeleteReplicaFromShard(ctx context.Context, class string, shard string) error {
+	args := f.Called(ctx, class, shard)
+	return args.Error(0)
+}
+
+func (f *fakeMigrator) AbortShardSplit(ctx context.Context, class, source string, targets []string) error {
+	args := f.Called(ctx, class, source, targets)
+	return args.Error(0)
+}
+
 func (f *fakeMigrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
 	return nil
 }
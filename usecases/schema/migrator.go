@@ -39,6 +39,11 @@ type Migrator interface {
 	// UpdateClass(ctx context.Context, className string,newClassName *string) error
 	GetShardsQueueSize(ctx context.Context, className, tenant string) (map[string]int64, error)
 	AddReplicaToShard(ctx context.Context, class, shard string) error
+	// DeleteReplicaFromShard drops the local replica of shard after it was
+	// removed from the sharding state
+	DeleteReplicaFromShard(ctx context.Context, class, shard string) error
+	// AbortShardSplit drops local copies of the targets of an aborted split
+	AbortShardSplit(ctx context.Context, class, source string, targets []string) error
 
 	AddProperty(ctx context.Context, className string,
 		props ...*models.Property) error
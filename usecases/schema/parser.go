@@ -213,7 +213,8 @@ func (p *Parser) parseTargetVectorsIndexConfig(class *models.Class) error {
 func (p *Parser) parseGivenVectorIndexConfig(vectorIndexType string,
 	vectorIndexConfig interface{}, isMultiVector bool,
 ) (schemaConfig.VectorIndexConfig, error) {
-	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT && vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC {
+	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT && vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC &&
+		vectorIndexType != vectorindex.VectorIndexTypeDISKANN && vectorIndexType != vectorindex.VectorIndexTypeIVF {
 		return nil, errors.Errorf(
 			"parse vector index config: unsupported vector index type: %q",
 			vectorIndexType)
@@ -246,6 +247,14 @@ func (p *Parser) ParseClassUpdate(class, update *models.Class) (*models.Class, e
 		return nil, err
 	}
 
+	if err := validateObjectTTL(update); err != nil {
+		return nil, err
+	}
+
+	if err := validateTenantQuota(update); err != nil {
+		return nil, err
+	}
+
 	if err := p.validateModuleConfigsParityAndImmutables(class, update); err != nil {
 		return nil, err
 	}
@@ -34,6 +34,9 @@ import (
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type RemoteIndex struct {
@@ -255,6 +258,12 @@ func (ri *RemoteIndex) MultiGetObjects(ctx context.Context, shardName string,
 	return ri.client.MultiGetObjects(ctx, host, ri.class, shardName, ids)
 }
 
+// startSpan starts the span of a request to the replica of shard on node
+func (ri *RemoteIndex) startSpan(ctx context.Context, name, shard, node string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, name, attribute.String("class", ri.class),
+		attribute.String("shard", shard), attribute.String("node", node))
+}
+
 type ReplicasSearchResult struct {
 	Objects []*storobj.Object
 	Scores  []float32
@@ -280,8 +289,10 @@ func (ri *RemoteIndex) SearchAllReplicas(ctx context.Context,
 	properties []string,
 ) ([]ReplicasSearchResult, error) {
 	remoteShardQuery := func(node, host string) (ReplicasSearchResult, error) {
+		ctx, span := ri.startSpan(ctx, "remote.SearchShard", shard, node)
 		objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shard,
 			queryVec, targetVector, distance, limit, filters, keywordRanking, sort, cursor, groupBy, adds, targetCombination, properties)
+		tracing.End(span, err)
 		if err != nil {
 			return ReplicasSearchResult{}, err
 		}
@@ -310,8 +321,10 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shard string,
 		second []float32
 	}
 	f := func(node, host string) (interface{}, error) {
+		ctx, span := ri.startSpan(ctx, "remote.SearchShard", shard, node)
 		objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shard,
 			queryVec, targetVector, distance, limit, filters, keywordRanking, sort, cursor, groupBy, adds, targetCombination, properties)
+		tracing.End(span, err)
 		if err != nil {
 			return nil, err
 		}
@@ -330,8 +343,10 @@ func (ri *RemoteIndex) Aggregate(
 	shard string,
 	params aggregation.Params,
 ) (*aggregation.Result, error) {
-	f := func(_, host string) (interface{}, error) {
+	f := func(node, host string) (interface{}, error) {
+		ctx, span := ri.startSpan(ctx, "remote.Aggregate", shard, node)
 		r, err := ri.client.Aggregate(ctx, host, ri.class, shard, params)
+		tracing.End(span, err)
 		if err != nil {
 			return nil, err
 		}
@@ -21,6 +21,7 @@ import (
 type RemoteNodeClient interface {
 	GetNodeStatus(ctx context.Context, hostName, className, output string) (*models.NodeStatus, error)
 	GetStatistics(ctx context.Context, hostName string) (*models.Statistics, error)
+	GetTenantUsage(ctx context.Context, hostName, className string, tenants []string) (map[string]*models.TenantUsage, error)
 }
 
 type RemoteNode struct {
@@ -50,3 +51,11 @@ func (rn *RemoteNode) GetStatistics(ctx context.Context, nodeName string) (*mode
 	}
 	return rn.client.GetStatistics(ctx, host)
 }
+
+func (rn *RemoteNode) GetTenantUsage(ctx context.Context, nodeName, className string, tenants []string) (map[string]*models.TenantUsage, error) {
+	host, ok := rn.nodeResolver.NodeHostname(nodeName)
+	if !ok {
+		return nil, fmt.Errorf("resolve node name %q to host", nodeName)
+	}
+	return rn.client.GetTenantUsage(ctx, host, className, tenants)
+}
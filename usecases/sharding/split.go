@@ -0,0 +1,296 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/spaolacci/murmur3"
+)
+
+// Split of a physical shard into new physical shards on the same nodes. The
+// targets are not physical shards until the split is committed, so that the
+// source keeps serving all reads and writes while each of its replicas
+// copies its data into the targets. Committing the split reassigns the
+// virtual shards of the source to the targets and removes the source.
+type Split struct {
+	Source string `json:"source"`
+	// Targets maps the new physical shards to the virtual shards they will own
+	Targets map[string][]string `json:"targets"`
+	// Ready are the replicas of the source which hold complete copies of all
+	// targets
+	Ready []string `json:"ready,omitempty"`
+}
+
+// TargetNames returns the sorted names of the new physical shards
+func (sp Split) TargetNames() []string {
+	names := make([]string, 0, len(sp.Targets))
+	for name := range sp.Targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (sp Split) DeepCopy() Split {
+	targets := make(map[string][]string, len(sp.Targets))
+	for name, virtual := range sp.Targets {
+		targets[name] = slices.Clone(virtual)
+	}
+	return Split{
+		Source:  sp.Source,
+		Targets: targets,
+		Ready:   slices.Clone(sp.Ready),
+	}
+}
+
+// PlanSplit divides the virtual shards of a physical shard among parts new
+// physical shards. Virtual shards are assigned in token order, so that each
+// target owns neighbouring token ranges, and each target owns about the same
+// share of the source. It doesn't change the state, the names of the targets
+// are random and hence must be chosen before the split is started.
+func (s *State) PlanSplit(shard string, parts int) (Split, error) {
+	if s.PartitioningEnabled {
+		return Split{}, fmt.Errorf("shards of multi-tenant collections can't be split")
+	}
+	physical, ok := s.Physical[shard]
+	if !ok {
+		return Split{}, fmt.Errorf("could not find shard %s", shard)
+	}
+	if _, ok := s.Splits[shard]; ok {
+		return Split{}, fmt.Errorf("shard %s is already being split", shard)
+	}
+	if parts < 2 {
+		return Split{}, fmt.Errorf("a shard must be split into at least 2 shards, got %d", parts)
+	}
+	if n := len(physical.OwnsVirtual); parts > n {
+		return Split{}, fmt.Errorf("shard %s owns %d virtual shards and can't be split into %d shards", shard, n, parts)
+	}
+
+	virtual := make([]*Virtual, 0, len(physical.OwnsVirtual))
+	var total float64
+	for _, name := range physical.OwnsVirtual {
+		v := s.VirtualByName(name)
+		if v == nil {
+			return Split{}, fmt.Errorf("could not find virtual shard %s of shard %s", name, shard)
+		}
+		virtual = append(virtual, v)
+		total += v.OwnsPercentage
+	}
+	sort.Slice(virtual, func(a, b int) bool {
+		return virtual[a].Upper < virtual[b].Upper
+	})
+
+	names := make([]string, parts)
+	for i := range names {
+		for names[i] == "" || s.shardNameTaken(names[i]) || slices.Contains(names[:i], names[i]) {
+			names[i] = generateShardName()
+		}
+	}
+
+	split := Split{Source: shard, Targets: make(map[string][]string, parts)}
+	var owned float64
+	part := 0
+	for i, v := range virtual {
+		// move on once the target owns its share, but leave at least one
+		// virtual shard for each of the following targets
+		current := split.Targets[names[part]]
+		if part < parts-1 && len(current) > 0 &&
+			(owned >= total*float64(part+1)/float64(parts) || len(virtual)-i == parts-1-part) {
+			part++
+		}
+		split.Targets[names[part]] = append(split.Targets[names[part]], v.Name)
+		owned += v.OwnsPercentage
+	}
+
+	return split, nil
+}
+
+func (s *State) shardNameTaken(name string) bool {
+	if _, ok := s.Physical[name]; ok {
+		return true
+	}
+	for _, split := range s.Splits {
+		if _, ok := split.Targets[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// StartSplit registers a split planned with PlanSplit. It fails if the
+// split doesn't match the state anymore, e.g. because the source was split
+// concurrently.
+func (s *State) StartSplit(split Split) error {
+	if s.PartitioningEnabled {
+		return fmt.Errorf("shards of multi-tenant collections can't be split")
+	}
+	physical, ok := s.Physical[split.Source]
+	if !ok {
+		return fmt.Errorf("could not find shard %s", split.Source)
+	}
+	if _, ok := s.Splits[split.Source]; ok {
+		return fmt.Errorf("shard %s is already being split", split.Source)
+	}
+	if len(split.Targets) < 2 {
+		return fmt.Errorf("a shard must be split into at least 2 shards, got %d", len(split.Targets))
+	}
+
+	owned := make(map[string]bool, len(physical.OwnsVirtual))
+	for _, name := range physical.OwnsVirtual {
+		owned[name] = false
+	}
+	for _, target := range split.TargetNames() {
+		if s.shardNameTaken(target) {
+			return fmt.Errorf("shard %s already exists", target)
+		}
+		if len(split.Targets[target]) == 0 {
+			return fmt.Errorf("target shard %s owns no virtual shards", target)
+		}
+		for _, name := range split.Targets[target] {
+			assigned, ok := owned[name]
+			if !ok {
+				return fmt.Errorf("virtual shard %s is not owned by shard %s", name, split.Source)
+			}
+			if assigned {
+				return fmt.Errorf("virtual shard %s is assigned to more than one target", name)
+			}
+			owned[name] = true
+		}
+	}
+	for name, assigned := range owned {
+		if !assigned {
+			return fmt.Errorf("virtual shard %s is not assigned to any target", name)
+		}
+	}
+
+	if s.Splits == nil {
+		s.Splits = make(map[string]Split, 1)
+	}
+	split = split.DeepCopy()
+	split.Ready = nil
+	s.Splits[split.Source] = split
+	return nil
+}
+
+// SetSplitReady records that node holds complete copies of all targets of
+// the split of source
+func (s *State) SetSplitReady(source, node string) error {
+	split, ok := s.Splits[source]
+	if !ok {
+		return fmt.Errorf("shard %s is not being split", source)
+	}
+	if !slices.Contains(s.Physical[source].BelongsToNodes, node) {
+		return fmt.Errorf("node %s is not a replica of shard %s", node, source)
+	}
+	if !slices.Contains(split.Ready, node) {
+		split.Ready = append(split.Ready, node)
+		s.Splits[source] = split
+	}
+	return nil
+}
+
+// SplitReady returns whether all replicas of source are ready for the split
+// to be committed
+func (s *State) SplitReady(source string) bool {
+	split, ok := s.Splits[source]
+	if !ok {
+		return false
+	}
+	for _, node := range s.Physical[source].BelongsToNodes {
+		if !slices.Contains(split.Ready, node) {
+			return false
+		}
+	}
+	return true
+}
+
+// CommitSplit replaces the source with the targets of its split. All objects
+// are routed to the targets from now on.
+func (s *State) CommitSplit(source string) (Split, error) {
+	split, ok := s.Splits[source]
+	if !ok {
+		return Split{}, fmt.Errorf("shard %s is not being split", source)
+	}
+	if !s.SplitReady(source) {
+		return Split{}, fmt.Errorf("not all replicas of shard %s are ready to be split", source)
+	}
+
+	physical := s.Physical[source]
+	for _, target := range split.TargetNames() {
+		p := Physical{
+			Name:           target,
+			OwnsVirtual:    slices.Clone(split.Targets[target]),
+			BelongsToNodes: slices.Clone(physical.BelongsToNodes),
+			Status:         physical.Status,
+		}
+		for _, name := range p.OwnsVirtual {
+			v := s.VirtualByName(name)
+			v.AssignedToPhysical = target
+			p.OwnsPercentage += v.OwnsPercentage
+		}
+		s.Physical[target] = p
+	}
+	delete(s.Physical, source)
+	delete(s.Splits, source)
+	s.Config.ActualCount = len(s.Physical)
+
+	return split, nil
+}
+
+// AbortSplit removes the split of source, which keeps all its objects
+func (s *State) AbortSplit(source string) (Split, error) {
+	split, ok := s.Splits[source]
+	if !ok {
+		return Split{}, fmt.Errorf("shard %s is not being split", source)
+	}
+	delete(s.Splits, source)
+	return split, nil
+}
+
+// SplitTargets returns a function which returns the target of the split of
+// source an object will belong to. The function doesn't depend on the state
+// and can be used concurrently.
+func (s *State) SplitTargets(source string) (func(objectID string) string, bool) {
+	split, ok := s.Splits[source]
+	if !ok {
+		return nil, false
+	}
+
+	targetOf := make(map[string]string)
+	for target, virtual := range split.Targets {
+		for _, name := range virtual {
+			targetOf[name] = target
+		}
+	}
+	uppers := make([]uint64, len(s.Virtual))
+	targets := make([]string, len(s.Virtual))
+	for i, v := range s.Virtual {
+		uppers[i], targets[i] = v.Upper, targetOf[v.Name]
+	}
+
+	return func(objectID string) string {
+		h := murmur3.New64()
+		h.Write([]byte(objectID))
+		token := h.Sum64()
+
+		// the virtual shards are sorted by their upper token, the first one
+		// owns all tokens above the last one
+		i := sort.Search(len(uppers), func(i int) bool { return uppers[i] >= token })
+		if i == len(uppers) {
+			i = 0
+		}
+		return targets[i]
+	}, true
+}
@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sharding
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/cluster/mocks"
+	"github.com/weaviate/weaviate/usecases/sharding/config"
+)
+
+func TestStateSplit(t *testing.T) {
+	cfg, err := config.ParseConfig(map[string]interface{}{"desiredCount": float64(2)}, 14)
+	require.Nil(t, err)
+
+	nodes := mocks.NewMockNodeSelector("node1", "node2")
+	state, err := InitState("my-index", cfg, nodes.LocalName(), nodes.StorageCandidates(), 2, false)
+	require.Nil(t, err)
+
+	source := state.AllPhysicalShards()[0]
+	replicas := state.Physical[source].BelongsToNodes
+	require.Len(t, replicas, 2)
+
+	ids := make([]string, 1000)
+	before := map[string]string{}
+	for i := range ids {
+		ids[i] = uuid.NewString()
+		before[ids[i]] = state.PhysicalShard([]byte(ids[i]))
+	}
+
+	split, err := state.PlanSplit(source, 3)
+	require.Nil(t, err)
+	assert.Equal(t, source, split.Source)
+	require.Len(t, split.Targets, 3)
+	var virtual []string
+	for _, target := range split.TargetNames() {
+		assert.NotEmpty(t, split.Targets[target])
+		assert.NotContains(t, state.Physical, target)
+		virtual = append(virtual, split.Targets[target]...)
+	}
+	assert.ElementsMatch(t, state.Physical[source].OwnsVirtual, virtual)
+
+	// planning doesn't change the state
+	_, ok := state.SplitTargets(source)
+	assert.False(t, ok)
+
+	require.Nil(t, state.StartSplit(split))
+	assert.NotNil(t, state.StartSplit(split), "already splitting")
+	_, err = state.PlanSplit(source, 2)
+	assert.NotNil(t, err)
+
+	// the split survives serialization and copies
+	bytes, err := state.JSON()
+	require.Nil(t, err)
+	reloaded, err := StateFromJSON(bytes, nodes)
+	require.Nil(t, err)
+	assert.Equal(t, state.Splits, reloaded.Splits)
+	copied := state.DeepCopy()
+	assert.Equal(t, state.Splits, copied.Splits)
+
+	// the source keeps all its objects until the split is committed
+	targetOf, ok := state.SplitTargets(source)
+	require.True(t, ok)
+	for _, id := range ids {
+		assert.Equal(t, before[id], state.PhysicalShard([]byte(id)))
+	}
+
+	_, err = state.CommitSplit(source)
+	assert.NotNil(t, err, "no replica is ready")
+	assert.NotNil(t, state.SetSplitReady(source, "node3"))
+	require.Nil(t, state.SetSplitReady(source, replicas[0]))
+	require.Nil(t, state.SetSplitReady(source, replicas[0]))
+	assert.False(t, state.SplitReady(source))
+	require.Nil(t, state.SetSplitReady(source, replicas[1]))
+	assert.True(t, state.SplitReady(source))
+
+	committed, err := state.CommitSplit(source)
+	require.Nil(t, err)
+	assert.Equal(t, split.Targets, committed.Targets)
+	assert.Empty(t, state.Splits)
+	assert.NotContains(t, state.Physical, source)
+	assert.Len(t, state.Physical, 4)
+	assert.Equal(t, 4, state.Config.ActualCount)
+
+	var total float64
+	for _, target := range split.TargetNames() {
+		physical := state.Physical[target]
+		assert.ElementsMatch(t, replicas, physical.BelongsToNodes)
+		assert.ElementsMatch(t, split.Targets[target], physical.OwnsVirtual)
+		total += physical.OwnsPercentage
+	}
+	assert.InDelta(t, copied.Physical[source].OwnsPercentage, total, 1e-9)
+
+	// objects of the source move to the planned targets, all others stay
+	for _, id := range ids {
+		if before[id] == source {
+			assert.Equal(t, targetOf(id), state.PhysicalShard([]byte(id)))
+		} else {
+			assert.Equal(t, before[id], state.PhysicalShard([]byte(id)))
+		}
+	}
+}
+
+func TestStateSplitErrors(t *testing.T) {
+	cfg, err := config.ParseConfig(map[string]interface{}{"desiredCount": float64(2)}, 14)
+	require.Nil(t, err)
+
+	nodes := mocks.NewMockNodeSelector("node1")
+	state, err := InitState("my-index", cfg, nodes.LocalName(), nodes.StorageCandidates(), 1, false)
+	require.Nil(t, err)
+	shards := state.AllPhysicalShards()
+
+	t.Run("plan", func(t *testing.T) {
+		_, err := state.PlanSplit("unknown", 2)
+		assert.NotNil(t, err)
+		_, err = state.PlanSplit(shards[0], 1)
+		assert.NotNil(t, err)
+		_, err = state.PlanSplit(shards[0], len(state.Physical[shards[0]].OwnsVirtual)+1)
+		assert.NotNil(t, err)
+		_, err = state.PlanSplit(shards[0], len(state.Physical[shards[0]].OwnsVirtual))
+		assert.Nil(t, err)
+	})
+
+	t.Run("start with virtual shards of another shard", func(t *testing.T) {
+		split, err := state.PlanSplit(shards[0], 2)
+		require.Nil(t, err)
+		target := split.TargetNames()[0]
+		split.Targets[target] = append(split.Targets[target], state.Physical[shards[1]].OwnsVirtual[0])
+		assert.NotNil(t, state.StartSplit(split))
+	})
+
+	t.Run("start without all virtual shards", func(t *testing.T) {
+		split, err := state.PlanSplit(shards[0], 2)
+		require.Nil(t, err)
+		target := split.TargetNames()[0]
+		split.Targets[target] = split.Targets[target][1:]
+		assert.NotNil(t, state.StartSplit(split))
+	})
+
+	t.Run("start with existing target", func(t *testing.T) {
+		split, err := state.PlanSplit(shards[0], 2)
+		require.Nil(t, err)
+		target := split.TargetNames()[0]
+		split.Targets[shards[1]] = split.Targets[target]
+		delete(split.Targets, target)
+		assert.NotNil(t, state.StartSplit(split))
+	})
+
+	t.Run("abort", func(t *testing.T) {
+		_, err := state.AbortSplit(shards[0])
+		assert.NotNil(t, err)
+
+		split, err := state.PlanSplit(shards[0], 2)
+		require.Nil(t, err)
+		require.Nil(t, state.StartSplit(split))
+		aborted, err := state.AbortSplit(shards[0])
+		require.Nil(t, err)
+		assert.Equal(t, split.Targets, aborted.Targets)
+		assert.Empty(t, state.Splits)
+		assert.Len(t, state.Physical, 2)
+	})
+
+	t.Run("multi tenancy", func(t *testing.T) {
+		mt, err := InitState("my-index", cfg, nodes.LocalName(), nodes.StorageCandidates(), 1, true)
+		require.Nil(t, err)
+		_, err = mt.PlanSplit("tenant", 2)
+		assert.NotNil(t, err)
+	})
+}
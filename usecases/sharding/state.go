@@ -33,6 +33,8 @@ type State struct {
 	Physical            map[string]Physical `json:"physical"`
 	Virtual             []Virtual           `json:"virtual"`
 	PartitioningEnabled bool                `json:"partitioningEnabled"`
+	// Splits are the ongoing splits of physical shards keyed by the source
+	Splits map[string]Split `json:"splits,omitempty"`
 
 	// different for each node, not to be serialized
 	localNodeName string // TODO: localNodeName is static it is better to store just once
@@ -97,6 +99,32 @@ func (p *Physical) AddReplica(replica string) error {
 	return nil
 }
 
+// DeleteReplicaFromShard removes replica from the replica set of shard. The
+// last replica of a shard can not be removed.
+func (s *State) DeleteReplicaFromShard(shard string, replica string) error {
+	phys, ok := s.Physical[shard]
+	if !ok {
+		return fmt.Errorf("could not find shard %s", shard)
+	}
+	if err := phys.DeleteReplica(replica); err != nil {
+		return err
+	}
+	s.Physical[shard] = phys
+	return nil
+}
+
+func (p *Physical) DeleteReplica(replica string) error {
+	i := slices.Index(p.BelongsToNodes, replica)
+	if i < 0 {
+		return fmt.Errorf("replica %s does not exist", replica)
+	}
+	if len(p.BelongsToNodes) == 1 {
+		return fmt.Errorf("cannot delete the last replica %s", replica)
+	}
+	p.BelongsToNodes = slices.Delete(slices.Clone(p.BelongsToNodes), i, i+1)
+	return nil
+}
+
 // AdjustReplicas shrinks or extends the replica set (p.BelongsToNodes)
 func (p *Physical) AdjustReplicas(count int, nodes cluster.NodeSelector) error {
 	if count < 0 {
@@ -522,6 +550,14 @@ func (s State) DeepCopy() State {
 		virtualCopy[i] = virtual.DeepCopy()
 	}
 
+	var splitsCopy map[string]Split
+	if len(s.Splits) > 0 {
+		splitsCopy = make(map[string]Split, len(s.Splits))
+	}
+	for source, split := range s.Splits {
+		splitsCopy[source] = split.DeepCopy()
+	}
+
 	return State{
 		localNodeName:       s.localNodeName,
 		IndexID:             s.IndexID,
@@ -529,6 +565,7 @@ func (s State) DeepCopy() State {
 		Physical:            physicalCopy,
 		Virtual:             virtualCopy,
 		PartitioningEnabled: s.PartitioningEnabled,
+		Splits:              splitsCopy,
 	}
 }
 
@@ -550,3 +550,18 @@ func TestApplyNodeMapping(t *testing.T) {
 		})
 	}
 }
+
+func TestStateDeleteReplicaFromShard(t *testing.T) {
+	s := State{Physical: map[string]Physical{
+		"A": {Name: "A", BelongsToNodes: []string{"node1", "node2"}},
+	}}
+	nodes := s.Physical["A"].BelongsToNodes
+
+	require.NoError(t, s.DeleteReplicaFromShard("A", "node1"))
+	assert.Equal(t, []string{"node2"}, s.Physical["A"].BelongsToNodes)
+	assert.Equal(t, []string{"node1", "node2"}, nodes, "replica set must not be modified in place")
+
+	assert.ErrorContains(t, s.DeleteReplicaFromShard("A", "node2"), "last replica")
+	assert.ErrorContains(t, s.DeleteReplicaFromShard("A", "node3"), "does not exist")
+	assert.ErrorContains(t, s.DeleteReplicaFromShard("B", "node2"), "could not find shard")
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// queueSize bounds the spans waiting to be exported. Spans are dropped
+// rather than blocking the caller if the endpoint does not keep up.
+const queueSize = 4096
+
+// newProvider returns a tracer provider which samples the traces started by
+// this node by ratio and exports the spans in batches to the OTLP/HTTP
+// endpoint of cfg. Traces started by a caller follow the caller's decision.
+func newProvider(ctx context.Context, cfg Config, node, version string) (*sdktrace.TracerProvider, error) {
+	exporter, err := otlptracehttp.New(ctx,
+		otlptracehttp.WithEndpointURL(cfg.Endpoint),
+		otlptracehttp.WithHeaders(cfg.Headers))
+	if err != nil {
+		return nil, fmt.Errorf("tracing exporter: %w", err)
+	}
+
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = DefaultServiceName
+	}
+	res := resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(serviceName),
+		semconv.ServiceVersion(version),
+		semconv.ServiceInstanceID(node),
+	)
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter, sdktrace.WithMaxQueueSize(queueSize)),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	), nil
+}
+
+// errorHandler logs the errors of the tracing SDK, e.g. failed exports
+type errorHandler struct {
+	logger logrus.FieldLogger
+}
+
+func (h errorHandler) Handle(err error) {
+	h.logger.WithField("action", "tracing_export").WithError(err).Warn("tracing")
+}
@@ -0,0 +1,132 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package tracing provides distributed tracing based on OpenTelemetry.
+//
+// Code creates spans through the OpenTelemetry API, usually with [Start] and
+// [End]. If tracing is enabled, [Init] installs a tracer provider which
+// samples traces by their ID and exports the spans in batches to an
+// OTLP/HTTP endpoint. Otherwise spans are no-ops, but the trace context of
+// incoming requests is still propagated to outgoing ones.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// instrumentationScope names the tracer of all spans created by Weaviate
+const instrumentationScope = "github.com/weaviate/weaviate"
+
+// enabled is set by Init, spans are only started if tracing is enabled
+var enabled atomic.Bool
+
+const (
+	DefaultServiceName = "weaviate"
+	DefaultSampleRatio = 1.0
+)
+
+type Config struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Endpoint is the URL spans are posted to in the OTLP/HTTP protobuf
+	// encoding, e.g. http://otel-collector:4318/v1/traces
+	Endpoint string `json:"endpoint" yaml:"endpoint"`
+	// Headers are added to each export request, e.g. for authentication
+	Headers     map[string]string `json:"headers" yaml:"headers"`
+	ServiceName string            `json:"service_name" yaml:"service_name"`
+	// SampleRatio is the fraction of traces started by this node which are
+	// recorded. Traces started by a caller follow the caller's decision.
+	SampleRatio float64 `json:"sample_ratio" yaml:"sample_ratio"`
+}
+
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	u, err := url.Parse(c.Endpoint)
+	if err != nil {
+		return fmt.Errorf("tracing endpoint: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("tracing endpoint %q: must be an http or https URL", c.Endpoint)
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("tracing sample ratio %v: must be between 0 and 1", c.SampleRatio)
+	}
+	return nil
+}
+
+// Init installs the W3C trace context propagator and, if tracing is
+// enabled, a tracer provider exporting the spans of this node. The returned
+// function flushes pending spans and must be called on shutdown.
+func Init(cfg Config, node, version string, logger logrus.FieldLogger) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	provider, err := newProvider(context.Background(), cfg, node, version)
+	if err != nil {
+		return nil, err
+	}
+	otel.SetErrorHandler(errorHandler{logger: logger})
+	otel.SetTracerProvider(provider)
+	enabled.Store(true)
+
+	logger.WithField("action", "startup").
+		WithField("endpoint", cfg.Endpoint).
+		WithField("sample_ratio", cfg.SampleRatio).
+		Info("tracing enabled")
+	return provider.Shutdown, nil
+}
+
+// Start starts a span as child of the span in ctx, if any. If tracing is
+// disabled, ctx is returned as is with a no-op span.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if !enabled.Load() {
+		return ctx, noop.Span{}
+	}
+	return otel.Tracer(instrumentationScope).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// WithSpan returns a copy of ctx carrying span, e.g. to continue a trace in
+// work which outlives the context of the request. ctx is returned as is if
+// span is a no-op.
+func WithSpan(ctx context.Context, span trace.Span) context.Context {
+	if !span.SpanContext().IsValid() {
+		return ctx
+	}
+	return trace.ContextWithSpan(ctx, span)
+}
+
+// End ends span and marks it as failed if err is not nil
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,199 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type collector struct {
+	sync.Mutex
+	server   *httptest.Server
+	headers  http.Header
+	resource map[string]string
+	spans    []*tracepb.Span
+}
+
+func newCollector(t *testing.T) *collector {
+	c := &collector{}
+	c.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		var req coltracepb.ExportTraceServiceRequest
+		if err := proto.Unmarshal(body, &req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		c.Lock()
+		defer c.Unlock()
+		c.headers = r.Header
+		for _, rs := range req.ResourceSpans {
+			c.resource = map[string]string{}
+			for _, kv := range rs.Resource.Attributes {
+				c.resource[kv.Key] = kv.Value.GetStringValue()
+			}
+			for _, ss := range rs.ScopeSpans {
+				c.spans = append(c.spans, ss.Spans...)
+			}
+		}
+	}))
+	t.Cleanup(c.server.Close)
+	return c
+}
+
+func (c *collector) provider(t *testing.T, ratio float64) *sdktrace.TracerProvider {
+	p, err := newProvider(context.Background(), Config{
+		Endpoint:    c.server.URL + "/v1/traces",
+		Headers:     map[string]string{"Authorization": "Bearer secret"},
+		SampleRatio: ratio,
+	}, "node1", "1.0.0")
+	require.Nil(t, err)
+	return p
+}
+
+func TestTracing(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("export spans", func(t *testing.T) {
+		c := newCollector(t)
+		p := c.provider(t, 1)
+		tr := p.Tracer(instrumentationScope)
+
+		ctx, parent := tr.Start(ctx, "parent", trace.WithSpanKind(trace.SpanKindServer))
+		parent.SetAttributes(attribute.Int("limit", 10), attribute.StringSlice("classes", []string{"A", "B"}))
+		_, child := tr.Start(ctx, "child")
+		End(child, errors.New("shard not found"))
+		End(parent, nil)
+		require.Nil(t, p.Shutdown(ctx))
+
+		require.Len(t, c.spans, 2)
+		assert.Equal(t, "Bearer secret", c.headers.Get("Authorization"))
+		assert.Equal(t, DefaultServiceName, c.resource["service.name"])
+		assert.Equal(t, "node1", c.resource["service.instance.id"])
+		gotChild, gotParent := c.spans[0], c.spans[1]
+
+		assert.Equal(t, "parent", gotParent.Name)
+		assert.Equal(t, tracepb.Span_SPAN_KIND_SERVER, gotParent.Kind)
+		assert.Empty(t, gotParent.ParentSpanId)
+		assert.Equal(t, tracepb.Status_STATUS_CODE_UNSET, gotParent.Status.GetCode())
+		traceID := parent.SpanContext().TraceID()
+		assert.Equal(t, traceID[:], gotParent.TraceId)
+		require.Len(t, gotParent.Attributes, 2)
+		assert.Equal(t, int64(10), gotParent.Attributes[0].Value.GetIntValue())
+		assert.Len(t, gotParent.Attributes[1].Value.GetArrayValue().Values, 2)
+
+		assert.Equal(t, "child", gotChild.Name)
+		assert.Equal(t, tracepb.Span_SPAN_KIND_INTERNAL, gotChild.Kind)
+		assert.Equal(t, gotParent.TraceId, gotChild.TraceId)
+		assert.Equal(t, gotParent.SpanId, gotChild.ParentSpanId)
+		assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, gotChild.Status.GetCode())
+		assert.Equal(t, "shard not found", gotChild.Status.GetMessage())
+		require.Len(t, gotChild.Events, 1)
+		assert.Equal(t, "exception", gotChild.Events[0].Name)
+	})
+
+	t.Run("unsampled traces are not exported", func(t *testing.T) {
+		c := newCollector(t)
+		p := c.provider(t, 0)
+		tr := p.Tracer(instrumentationScope)
+
+		ctx, parent := tr.Start(ctx, "parent")
+		_, child := tr.Start(ctx, "child")
+		assert.False(t, parent.IsRecording())
+		assert.True(t, parent.SpanContext().IsValid())
+		assert.Equal(t, parent.SpanContext().TraceID(), child.SpanContext().TraceID())
+		child.End()
+		parent.End()
+		require.Nil(t, p.Shutdown(ctx))
+		assert.Empty(t, c.spans)
+	})
+
+	t.Run("remote parent decides sampling", func(t *testing.T) {
+		c := newCollector(t)
+		caller := c.provider(t, 1).Tracer(instrumentationScope)
+		p := c.provider(t, 0)
+
+		callerCtx, remote := caller.Start(ctx, "remote")
+		header := http.Header{}
+		propagator := propagation.TraceContext{}
+		propagator.Inject(callerCtx, propagation.HeaderCarrier(header))
+
+		ctx := propagator.Extract(ctx, propagation.HeaderCarrier(header))
+		_, local := p.Tracer(instrumentationScope).Start(ctx, "local")
+		assert.True(t, local.IsRecording())
+		local.End()
+		require.Nil(t, p.Shutdown(ctx))
+
+		require.Len(t, c.spans, 1)
+		traceID, spanID := remote.SpanContext().TraceID(), remote.SpanContext().SpanID()
+		assert.Equal(t, traceID[:], c.spans[0].TraceId)
+		assert.Equal(t, spanID[:], c.spans[0].ParentSpanId)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		spanCtx, span := Start(ctx, "span")
+		assert.Equal(t, ctx, spanCtx)
+		assert.False(t, span.IsRecording())
+		assert.Equal(t, ctx, WithSpan(ctx, span))
+	})
+
+	t.Run("export errors are reported", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+		p, err := newProvider(ctx, Config{Endpoint: server.URL, SampleRatio: 1}, "node1", "1.0.0")
+		require.Nil(t, err)
+
+		_, span := p.Tracer(instrumentationScope).Start(ctx, "span")
+		span.End()
+		assert.ErrorContains(t, p.ForceFlush(ctx), "400")
+		require.Nil(t, p.Shutdown(ctx))
+	})
+
+	t.Run("errors of background exports are logged", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		otel.SetErrorHandler(errorHandler{logger: logger})
+		otel.Handle(errors.New("traces export: 503 Service Unavailable"))
+		require.NotNil(t, hook.LastEntry())
+		assert.Contains(t, hook.LastEntry().Data["error"].(error).Error(), "503")
+	})
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.Nil(t, Config{}.Validate())
+	assert.Nil(t, Config{Enabled: true, Endpoint: "http://collector:4318/v1/traces", SampleRatio: 0.5}.Validate())
+	assert.NotNil(t, Config{Enabled: true, Endpoint: "collector:4318"}.Validate())
+	assert.NotNil(t, Config{Enabled: true, Endpoint: "http://collector:4318", SampleRatio: 2}.Validate())
+}
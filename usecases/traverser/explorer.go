@@ -56,6 +56,7 @@ type Explorer struct {
 	targetParamHelper *TargetVectorParamHelper
 	metrics           explorerMetrics
 	config            config.Config
+	highlightAnalyzer HighlightAnalyzer
 }
 
 type explorerMetrics interface {
@@ -127,6 +128,10 @@ func (e *Explorer) SetSchemaGetter(sg uc.SchemaGetter) {
 	e.schemaGetter = sg
 }
 
+func (e *Explorer) SetHighlightAnalyzer(analyzer HighlightAnalyzer) {
+	e.highlightAnalyzer = analyzer
+}
+
 // GetClass from search and connector repo
 func (e *Explorer) GetClass(ctx context.Context,
 	params dto.GetParams,
@@ -146,6 +151,10 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
 	}
 
+	if err := e.validateHighlight(&params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'highlight' additional property")
+	}
+
 	if params.KeywordRanking != nil {
 		res, err := e.getClassKeywordBased(ctx, params)
 		if err != nil {
@@ -411,6 +420,12 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context, input []searc
 		return nil, err
 	}
 
+	if params.AdditionalProperties.Highlight != nil {
+		if err := e.highlightResults(results, params); err != nil {
+			return nil, fmt.Errorf("search results to get response: highlight: %w", err)
+		}
+	}
+
 	if params.GroupBy != nil {
 		for _, result := range results {
 			wrapper := map[string]interface{}{}
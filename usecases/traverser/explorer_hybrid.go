@@ -27,15 +27,23 @@ import (
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	nearText2 "github.com/weaviate/weaviate/usecases/modulecomponents/arguments/nearText"
+	"github.com/weaviate/weaviate/usecases/tracing"
 	"github.com/weaviate/weaviate/usecases/traverser/hybrid"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Do a bm25 search.  The results will be used in the hybrid algorithm
 func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*search.Result, string, error) {
 	params.KeywordRanking = &searchparams.KeywordRanking{
-		Query:      params.HybridSearch.Query,
-		Type:       "bm25",
-		Properties: params.HybridSearch.Properties,
+		Query:              params.HybridSearch.Query,
+		Type:               "bm25",
+		Properties:         params.HybridSearch.Properties,
+		Phrase:             params.HybridSearch.Phrase,
+		Slop:               params.HybridSearch.Slop,
+		MaxEdits:           params.HybridSearch.MaxEdits,
+		MaxExpansions:      params.HybridSearch.MaxExpansions,
+		SearchOperator:     params.HybridSearch.SearchOperator,
+		MinimumShouldMatch: params.HybridSearch.MinimumShouldMatch,
 	}
 
 	params.Group = nil
@@ -180,6 +188,14 @@ func nearTextSubSearch(ctx context.Context, e *Explorer, params dto.GetParams, t
 
 // Hybrid search.  This is the main entry point to the hybrid search algorithm
 func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.Result, error) {
+	ctx, span := tracing.Start(ctx, "explorer.Hybrid",
+		attribute.String("class", params.ClassName), attribute.String("tenant", params.Tenant))
+	res, err := e.hybridSearch(ctx, params)
+	tracing.End(span, err)
+	return res, err
+}
+
+func (e *Explorer) hybridSearch(ctx context.Context, params dto.GetParams) ([]search.Result, error) {
 	var err error
 	var results [][]*search.Result
 	var weights []float64
@@ -0,0 +1,310 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// HighlightAnalyzer analyzes queries and property values like the inverted
+// index does
+type HighlightAnalyzer interface {
+	// QueryTerms returns the terms a property with the given tokenization is
+	// searched for, after stopword removal and synonym expansion
+	QueryTerms(class *models.Class, tokenization, query string) ([]string, error)
+	// Matches tokenizes the value and returns the occurrences of the terms
+	// match returns true for
+	Matches(tokenization, value string, match func(term string) bool) []additional.HighlightMatch
+	// WithinEditDistance reports whether the term is at most maxEdits edits
+	// away from the query term
+	WithinEditDistance(query, term string, maxEdits int) bool
+}
+
+// highlighter marks the terms of a keyword query in the returned text
+// properties. The property values are tokenized again with the tokenization
+// of their property and the query terms go through the same stopword removal
+// and synonym expansion as in the BM25 search, so the highlighted terms are
+// the ones the object was scored for.
+type highlighter struct {
+	analyzer   HighlightAnalyzer
+	params     additional.HighlightParams
+	properties []*models.Property
+	// query terms by tokenization
+	terms    map[string][]string
+	maxEdits int
+}
+
+// validateHighlight validates the highlight additional property and adds the
+// highlighted properties to the selected ones, as the highlights are computed
+// from the returned property values
+func (e *Explorer) validateHighlight(params *dto.GetParams) error {
+	if params.AdditionalProperties.Highlight == nil {
+		return nil
+	}
+	if params.GroupBy != nil {
+		return fmt.Errorf("highlight cannot be combined with groupBy")
+	}
+
+	h, err := newHighlighter(e.highlightAnalyzer, e.schemaGetter.ReadOnlyClass(params.ClassName), *params)
+	if err != nil {
+		return err
+	}
+	for _, prop := range h.properties {
+		if params.Properties.FindProperty(prop.Name) == nil {
+			params.Properties = append(params.Properties, search.SelectProperty{Name: prop.Name, IsPrimitive: true})
+		}
+	}
+	params.AdditionalProperties.NoProps = false
+	return nil
+}
+
+func newHighlighter(analyzer HighlightAnalyzer, class *models.Class, params dto.GetParams) (*highlighter, error) {
+	if analyzer == nil {
+		return nil, fmt.Errorf("highlight is not supported")
+	}
+	if class == nil {
+		return nil, fmt.Errorf("class %q not found", params.ClassName)
+	}
+
+	var query string
+	var searched []string
+	var maxEdits int
+	switch {
+	case params.KeywordRanking != nil:
+		query = params.KeywordRanking.Query
+		searched = params.KeywordRanking.Properties
+		maxEdits = params.KeywordRanking.MaxEdits
+	case params.HybridSearch != nil:
+		query = params.HybridSearch.Query
+		searched = params.HybridSearch.Properties
+		maxEdits = params.HybridSearch.MaxEdits
+	default:
+		return nil, fmt.Errorf("highlight can only be used with bm25 or hybrid search")
+	}
+
+	h := &highlighter{
+		analyzer: analyzer,
+		params:   params.AdditionalProperties.Highlight.WithDefaults(),
+		terms:    map[string][]string{},
+		maxEdits: maxEdits,
+	}
+
+	if len(h.params.Properties) > 0 {
+		searched = h.params.Properties
+	}
+	if len(searched) == 0 {
+		for _, prop := range class.Properties {
+			if searchparams.HasSearchableIndex(prop) {
+				h.properties = append(h.properties, prop)
+			}
+		}
+	}
+	for _, name := range searched {
+		prop, err := schema.GetPropertyByName(class, strings.Split(name, "^")[0])
+		if err != nil {
+			return nil, err
+		}
+		if !searchparams.HasSearchableIndex(prop) {
+			return nil, fmt.Errorf("property %q is not a searchable text property", prop.Name)
+		}
+		h.properties = append(h.properties, prop)
+	}
+
+	for _, prop := range h.properties {
+		if _, ok := h.terms[prop.Tokenization]; ok {
+			continue
+		}
+		terms, err := analyzer.QueryTerms(class, prop.Tokenization, query)
+		if err != nil {
+			return nil, err
+		}
+		h.terms[prop.Tokenization] = terms
+	}
+
+	return h, nil
+}
+
+func (h *highlighter) matches(tokenization, term string) bool {
+	for _, queryTerm := range h.terms[tokenization] {
+		if term == queryTerm {
+			return true
+		}
+		// like the fuzzy search, only terms longer than the allowed number of
+		// edits are expanded
+		if h.maxEdits > 0 && utf8.RuneCountInString(queryTerm) > h.maxEdits &&
+			h.analyzer.WithinEditDistance(queryTerm, term, h.maxEdits) {
+			return true
+		}
+	}
+	return false
+}
+
+// highlight returns the highlights of every value of the highlighted
+// properties that contains at least one query term
+func (h *highlighter) highlight(properties map[string]interface{}) []*additional.Highlight {
+	out := []*additional.Highlight{}
+	for _, prop := range h.properties {
+		var values []string
+		switch v := properties[prop.Name].(type) {
+		case string:
+			values = []string{v}
+		case []string:
+			values = v
+		case []interface{}:
+			for _, elem := range v {
+				value, _ := elem.(string)
+				values = append(values, value)
+			}
+		}
+
+		for i, value := range values {
+			tokenization := prop.Tokenization
+			matches := h.analyzer.Matches(tokenization, value, func(term string) bool {
+				return h.matches(tokenization, term)
+			})
+			if len(matches) == 0 {
+				continue
+			}
+			out = append(out, &additional.Highlight{
+				Property:  prop.Name,
+				Index:     i,
+				Matches:   matches,
+				Fragments: h.fragments([]rune(value), matches),
+			})
+		}
+	}
+	return out
+}
+
+type highlightFragment struct {
+	start, end int
+	matches    []additional.HighlightMatch
+	distinct   int
+}
+
+// fragments cuts the value into fragments of about the configured size
+// around the matches. The fragments with the most distinct terms are
+// returned in the order they appear in the value.
+func (h *highlighter) fragments(value []rune, matches []additional.HighlightMatch) []string {
+	var candidates []highlightFragment
+	for i := 0; i < len(matches); {
+		start, end := fragmentBounds(value, matches[i], h.params.FragmentSize)
+		j := i + 1
+		for j < len(matches) && matches[j].End <= end {
+			j++
+		}
+		distinct := map[string]struct{}{}
+		for _, match := range matches[i:j] {
+			distinct[strings.ToLower(match.Term)] = struct{}{}
+		}
+		candidates = append(candidates, highlightFragment{
+			start: start, end: end, matches: matches[i:j], distinct: len(distinct),
+		})
+		i = j
+	}
+
+	sort.SliceStable(candidates, func(a, b int) bool {
+		if candidates[a].distinct != candidates[b].distinct {
+			return candidates[a].distinct > candidates[b].distinct
+		}
+		return len(candidates[a].matches) > len(candidates[b].matches)
+	})
+	if len(candidates) > h.params.Fragments {
+		candidates = candidates[:h.params.Fragments]
+	}
+	sort.Slice(candidates, func(a, b int) bool {
+		return candidates[a].start < candidates[b].start
+	})
+
+	fragments := make([]string, len(candidates))
+	for i, candidate := range candidates {
+		fragments[i] = h.render(value, candidate)
+	}
+	return fragments
+}
+
+func (h *highlighter) render(value []rune, fragment highlightFragment) string {
+	var sb strings.Builder
+	pos := fragment.start
+	for _, match := range fragment.matches {
+		// dictionary based tokenizers may return overlapping terms
+		if match.Start < pos {
+			continue
+		}
+		sb.WriteString(string(value[pos:match.Start]))
+		sb.WriteString(h.params.PreTag)
+		sb.WriteString(string(value[match.Start:match.End]))
+		sb.WriteString(h.params.PostTag)
+		pos = match.End
+	}
+	sb.WriteString(string(value[pos:fragment.end]))
+	return sb.String()
+}
+
+// fragmentBounds centers a window of the given size on the match. Words cut
+// in half at the edges of the window are left out, as is surrounding
+// whitespace.
+func fragmentBounds(value []rune, match additional.HighlightMatch, size int) (int, int) {
+	length := match.End - match.Start
+	if length >= size {
+		return match.Start, match.End
+	}
+
+	end := min(len(value), max(0, match.Start-(size-length)/2)+size)
+	start := max(0, end-size)
+
+	for start > 0 && start < match.Start && !unicode.IsSpace(value[start-1]) {
+		start++
+	}
+	for end < len(value) && end > match.End && !unicode.IsSpace(value[end]) {
+		end--
+	}
+	for start < match.Start && unicode.IsSpace(value[start]) {
+		start++
+	}
+	for end > match.End && unicode.IsSpace(value[end-1]) {
+		end--
+	}
+	return start, end
+}
+
+// highlightResults adds the highlight additional property to every result
+func (e *Explorer) highlightResults(results []search.Result, params dto.GetParams) error {
+	h, err := newHighlighter(e.highlightAnalyzer, e.schemaGetter.ReadOnlyClass(params.ClassName), params)
+	if err != nil {
+		return err
+	}
+	for _, res := range results {
+		properties, ok := res.Schema.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		additionalProperties, ok := properties["_additional"].(map[string]interface{})
+		if !ok {
+			additionalProperties = map[string]interface{}{}
+			properties["_additional"] = additionalProperties
+		}
+		additionalProperties["highlight"] = h.highlight(properties)
+	}
+	return nil
+}
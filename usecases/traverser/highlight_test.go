@@ -0,0 +1,204 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func highlightTestClass() *models.Class {
+	vTrue := true
+	return &models.Class{
+		Class: "Article",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Stopwords: &models.StopwordConfig{Preset: "en"},
+			Synonyms: &models.SynonymConfig{
+				Sets: []*models.SynonymSet{{Terms: []string{"car", "automobile"}}},
+			},
+		},
+		Properties: []*models.Property{
+			{
+				Name:            "body",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexSearchable: &vTrue,
+			},
+			{
+				Name:            "tags",
+				DataType:        schema.DataTypeTextArray.PropString(),
+				Tokenization:    models.PropertyTokenizationLowercase,
+				IndexSearchable: &vTrue,
+			},
+			{
+				Name:     "count",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+		},
+	}
+}
+
+func TestHighlighter(t *testing.T) {
+	class := highlightTestClass()
+	body := "The car is fast. An automobile is a car."
+
+	bm25Params := func(query string, highlight additional.HighlightParams) dto.GetParams {
+		return dto.GetParams{
+			ClassName:            class.Class,
+			KeywordRanking:       &searchparams.KeywordRanking{Type: "bm25", Query: query},
+			AdditionalProperties: additional.Properties{Highlight: &highlight},
+		}
+	}
+
+	t.Run("stopwords and synonyms", func(t *testing.T) {
+		h, err := newHighlighter(inverted.NewHighlightAnalyzer(), class, bm25Params("the car", additional.HighlightParams{}))
+		require.Nil(t, err)
+
+		highlights := h.highlight(map[string]interface{}{"body": body, "count": 3})
+		require.Len(t, highlights, 1)
+		assert.Equal(t, "body", highlights[0].Property)
+		assert.Equal(t, []additional.HighlightMatch{
+			{Term: "car", Start: 4, End: 7},
+			{Term: "automobile", Start: 20, End: 30},
+			{Term: "car", Start: 36, End: 39},
+		}, highlights[0].Matches)
+		assert.Equal(t, []string{
+			"The <em>car</em> is fast. An <em>automobile</em> is a <em>car</em>.",
+		}, highlights[0].Fragments)
+	})
+
+	t.Run("text array", func(t *testing.T) {
+		h, err := newHighlighter(inverted.NewHighlightAnalyzer(), class, bm25Params("the car", additional.HighlightParams{
+			Properties: []string{"tags"},
+			PreTag:     "[",
+			PostTag:    "]",
+		}))
+		require.Nil(t, err)
+
+		highlights := h.highlight(map[string]interface{}{
+			"body": body,
+			"tags": []string{"blue", "Red CAR", "car!"},
+		})
+		assert.Equal(t, []*additional.Highlight{{
+			Property:  "tags",
+			Index:     1,
+			Matches:   []additional.HighlightMatch{{Term: "CAR", Start: 4, End: 7}},
+			Fragments: []string{"Red [CAR]"},
+		}}, highlights)
+	})
+
+	t.Run("fuzzy", func(t *testing.T) {
+		params := bm25Params("cars", additional.HighlightParams{})
+		params.KeywordRanking.MaxEdits = 1
+		h, err := newHighlighter(inverted.NewHighlightAnalyzer(), class, params)
+		require.Nil(t, err)
+
+		highlights := h.highlight(map[string]interface{}{"body": body})
+		require.Len(t, highlights, 1)
+		assert.Equal(t, []additional.HighlightMatch{
+			{Term: "car", Start: 4, End: 7},
+			{Term: "car", Start: 36, End: 39},
+		}, highlights[0].Matches)
+	})
+
+	t.Run("fragments", func(t *testing.T) {
+		h, err := newHighlighter(inverted.NewHighlightAnalyzer(), class, bm25Params("car fox", additional.HighlightParams{
+			FragmentSize: 20,
+			Fragments:    2,
+		}))
+		require.Nil(t, err)
+
+		value := "a car drove by, then nothing happened for a long while until a fox " +
+			"and a car met at the end of the road"
+		highlights := h.highlight(map[string]interface{}{"body": value})
+		require.Len(t, highlights, 1)
+		assert.Len(t, highlights[0].Matches, 3)
+		// fragments are centered on their first match and cut at whitespace
+		assert.Equal(t, []string{
+			"a <em>car</em> drove by, then",
+			"until a <em>fox</em> and a",
+		}, highlights[0].Fragments)
+	})
+
+	t.Run("hybrid", func(t *testing.T) {
+		h, err := newHighlighter(inverted.NewHighlightAnalyzer(), class, dto.GetParams{
+			ClassName:            class.Class,
+			HybridSearch:         &searchparams.HybridSearch{Query: "fast", Properties: []string{"body^2"}},
+			AdditionalProperties: additional.Properties{Highlight: &additional.HighlightParams{}},
+		})
+		require.Nil(t, err)
+
+		highlights := h.highlight(map[string]interface{}{"body": body})
+		require.Len(t, highlights, 1)
+		assert.Equal(t, []string{"The car is <em>fast</em>. An automobile is a car."}, highlights[0].Fragments)
+	})
+
+	t.Run("requires a keyword query", func(t *testing.T) {
+		_, err := newHighlighter(inverted.NewHighlightAnalyzer(), class, dto.GetParams{
+			ClassName:            class.Class,
+			AdditionalProperties: additional.Properties{Highlight: &additional.HighlightParams{}},
+		})
+		assert.ErrorContains(t, err, "highlight can only be used with bm25 or hybrid search")
+	})
+
+	t.Run("non text property", func(t *testing.T) {
+		_, err := newHighlighter(inverted.NewHighlightAnalyzer(), class, bm25Params("car", additional.HighlightParams{
+			Properties: []string{"count"},
+		}))
+		assert.ErrorContains(t, err, `property "count" is not a searchable text property`)
+	})
+}
+
+func TestValidateHighlight(t *testing.T) {
+	class := highlightTestClass()
+	explorer := &Explorer{
+		schemaGetter: &fakeSchemaGetter{schema: schema.Schema{
+			Objects: &models.Schema{Classes: []*models.Class{class}},
+		}},
+		highlightAnalyzer: inverted.NewHighlightAnalyzer(),
+	}
+
+	t.Run("adds the highlighted properties to the selected ones", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:      class.Class,
+			KeywordRanking: &searchparams.KeywordRanking{Type: "bm25", Query: "car"},
+			Properties:     search.SelectProperties{{Name: "count", IsPrimitive: true}},
+			AdditionalProperties: additional.Properties{
+				Highlight: &additional.HighlightParams{Properties: []string{"body"}},
+			},
+		}
+		require.Nil(t, explorer.validateHighlight(&params))
+		assert.Equal(t, search.SelectProperties{
+			{Name: "count", IsPrimitive: true},
+			{Name: "body", IsPrimitive: true},
+		}, params.Properties)
+	})
+
+	t.Run("rejects groupBy", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:            class.Class,
+			KeywordRanking:       &searchparams.KeywordRanking{Type: "bm25", Query: "car"},
+			GroupBy:              &searchparams.GroupBy{Property: "tags", Groups: 2, ObjectsPerGroup: 2},
+			AdditionalProperties: additional.Properties{Highlight: &additional.HighlightParams{}},
+		}
+		assert.ErrorContains(t, explorer.validateHighlight(&params), "highlight cannot be combined with groupBy")
+	})
+}
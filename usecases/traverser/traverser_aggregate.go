@@ -19,12 +19,18 @@ import (
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/usecases/modules"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 // Aggregate resolves meta queries
 func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	params *aggregation.Params,
-) (interface{}, error) {
+) (_ interface{}, err error) {
+	ctx, span := tracing.Start(ctx, "traverser.Aggregate",
+		attribute.String("class", params.ClassName.String()), attribute.String("tenant", params.Tenant))
+	defer func() { tracing.End(span, err) }()
+
 	t.metrics.QueriesAggregateInc(params.ClassName.String())
 	defer t.metrics.QueriesAggregateDec(params.ClassName.String())
 
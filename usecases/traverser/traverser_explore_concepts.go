@@ -17,12 +17,16 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/tracing"
 )
 
 // Explore through unstructured search terms
 func (t *Traverser) Explore(ctx context.Context,
 	principal *models.Principal, params ExploreParams,
-) ([]search.Result, error) {
+) (res []search.Result, err error) {
+	ctx, span := tracing.Start(ctx, "traverser.Explore")
+	defer func() { tracing.End(span, err) }()
+
 	if params.Limit == 0 {
 		params.Limit = 20
 	}
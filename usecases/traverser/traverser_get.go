@@ -24,11 +24,17 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	params dto.GetParams,
-) ([]interface{}, error) {
+) (res []interface{}, err error) {
+	ctx, span := tracing.Start(ctx, "traverser.GetClass",
+		attribute.String("class", params.ClassName), attribute.String("tenant", params.Tenant))
+	defer func() { tracing.End(span, err) }()
+
 	before := time.Now()
 
 	ok := t.ratelimiter.TryInc()